@@ -28,6 +28,7 @@ const (
 	pathCommit    = "/backups/commit"
 	pathStatus    = "/backups/status"
 	pathAbort     = "/backups/abort"
+	pathCancel    = "/backups/cancel"
 )
 
 type ClusterBackups struct {
@@ -162,6 +163,34 @@ func (c *ClusterBackups) Abort(_ context.Context,
 	return nil
 }
 
+func (c *ClusterBackups) Cancel(_ context.Context,
+	host string, req *backup.AbortRequest,
+) error {
+	url := url.URL{Scheme: "http", Host: host, Path: pathCancel}
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal cancel request: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, url.String(), bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("new cancel request: %w", err)
+	}
+
+	respBody, statusCode, err := c.do(httpReq)
+	if err != nil {
+		return fmt.Errorf("cancel request: %w", err)
+	}
+
+	if statusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status code %d (%s)",
+			statusCode, respBody)
+	}
+
+	return nil
+}
+
 func (c *ClusterBackups) do(req *http.Request) (body []byte, statusCode int, err error) {
 	httpResp, err := c.client.Do(req)
 	if err != nil {
@@ -63,3 +63,29 @@ func (c *RemoteNode) GetNodeStatus(ctx context.Context, hostName string, classNa
 
 	return &nodeStatus, nil
 }
+
+// SetDraining marks the node as being drained or not
+func (c *RemoteNode) SetDraining(ctx context.Context, hostName string, draining bool) error {
+	method := http.MethodDelete
+	if draining {
+		method = http.MethodPut
+	}
+	url := url.URL{Scheme: "http", Host: hostName, Path: "/nodes/draining"}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -27,6 +28,8 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -36,6 +39,11 @@ import (
 
 type RemoteIndex struct {
 	retryClient
+	schemaGetter schemaGetter
+}
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
 }
 
 func NewRemoteIndex(httpClient *http.Client) *RemoteIndex {
@@ -45,6 +53,20 @@ func NewRemoteIndex(httpClient *http.Client) *RemoteIndex {
 	}}
 }
 
+// SetSchemaGetter sets the schema used to parse objects received from other
+// nodes, without it their property types are guessed from the values
+func (c *RemoteIndex) SetSchemaGetter(sg schemaGetter) {
+	c.schemaGetter = sg
+}
+
+func (c *RemoteIndex) class(indexName string) *models.Class {
+	if c.schemaGetter == nil {
+		return nil
+	}
+	sch := c.schemaGetter.GetSchemaSkipAuth()
+	return sch.GetClass(schema.ClassName(indexName))
+}
+
 func (c *RemoteIndex) PutObject(ctx context.Context, hostName, indexName,
 	shardName string, obj *storobj.Object,
 ) error {
@@ -237,7 +259,7 @@ func (c *RemoteIndex) GetObject(ctx context.Context, hostName, indexName,
 		return nil, errors.Wrap(err, "read body")
 	}
 
-	obj, err := clusterapi.IndicesPayloads.SingleObject.Unmarshal(objBytes)
+	obj, err := clusterapi.IndicesPayloads.SingleObject.Unmarshal(objBytes, c.class(indexName))
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal body")
 	}
@@ -399,7 +421,7 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 		return nil, errors.Wrap(err, "read response body")
 	}
 
-	objs, err := clusterapi.IndicesPayloads.ObjectList.Unmarshal(bodyBytes)
+	objs, err := clusterapi.IndicesPayloads.ObjectList.Unmarshal(bodyBytes, c.class(indexName))
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal objects")
 	}
@@ -408,7 +430,7 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string,
-	vector []float32, limit int,
+	vector []float32, targetVector string, limit int,
 	filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort,
@@ -418,7 +440,7 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 ) ([]*storobj.Object, []float32, error) {
 	// new request
 	body, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+		Marshal(vector, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
 	if err != nil {
 		return nil, nil, fmt.Errorf("marshal request payload: %w", err)
 	}
@@ -434,7 +456,7 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 	clusterapi.IndicesPayloads.SearchParams.SetContentTypeHeaderReq(req)
 
 	// send request
-	resp := &searchShardResp{}
+	resp := &searchShardResp{class: c.class(index)}
 	err = c.doWithCustomMarshaller(c.timeoutUnit*20, req, body, resp.decode)
 	return resp.Objects, resp.Distributions, err
 }
@@ -442,10 +464,11 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 type searchShardResp struct {
 	Objects       []*storobj.Object
 	Distributions []float32
+	class         *models.Class
 }
 
 func (r *searchShardResp) decode(data []byte) (err error) {
-	r.Objects, r.Distributions, err = clusterapi.IndicesPayloads.SearchResults.Unmarshal(data)
+	r.Objects, r.Distributions, err = clusterapi.IndicesPayloads.SearchResults.Unmarshal(data, r.class)
 	return
 }
 
@@ -631,6 +654,54 @@ func (c *RemoteIndex) GetShardStatus(ctx context.Context,
 	return status, c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) TenantsLastUsed(ctx context.Context,
+	hostName, indexName string, shardNames []string,
+) (map[string]time.Time, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.TenantsLastUsedParams.Marshal(shardNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+	path := fmt.Sprintf("/indices/%s/shards:last-used", indexName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	var lastUsed map[string]time.Time
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(paramsBytes))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+		clusterapi.IndicesPayloads.TenantsLastUsedParams.SetContentTypeHeaderReq(req)
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.TenantsLastUsedResults.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		lastUsed, err = clusterapi.IndicesPayloads.TenantsLastUsedResults.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+	return lastUsed, c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string,
 ) error {
@@ -794,3 +865,36 @@ func (c *RemoteIndex) IncreaseReplicationFactor(ctx context.Context,
 	}
 	return c.retry(ctx, 34, try)
 }
+
+func (c *RemoteIndex) CopyShards(ctx context.Context,
+	hostName, indexName string, dist scaler.CopyDist,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/shards:copy", indexName)
+
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	body, err := clusterapi.IndicesPayloads.CopyShards.Marshall(dist)
+	if err != nil {
+		return err
+	}
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(body))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 34, try)
+}
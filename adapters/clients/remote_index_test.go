@@ -67,6 +67,35 @@ func TestRemoteIndexIncreaseRF(t *testing.T) {
 	})
 }
 
+func TestRemoteIndexCopyShards(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := "/replicas/indices/C1/shards:copy"
+	fs := newFakeRemoteIndexServer(t, http.MethodPut, path)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+	t.Run("ConnectionError", func(t *testing.T) {
+		err := client.CopyShards(ctx, "", "C1", nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "connect")
+	})
+	n := 0
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		if n == 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+		} else {
+			w.WriteHeader(http.StatusNoContent)
+		}
+		n++
+	}
+	t.Run("Success", func(t *testing.T) {
+		err := client.CopyShards(ctx, fs.host, "C1", nil)
+		assert.Nil(t, err)
+	})
+}
+
 func TestRemoteIndexReInitShardIn(t *testing.T) {
 	t.Parallel()
 
@@ -130,6 +130,12 @@ func classField(class *models.Class, description string,
 		Resolve: makeResolveClass(modulesProvider, class),
 	}
 
+	if schema.NamedVectorsEnabled(class) {
+		for _, name := range []string{"nearVector", "nearObject", "hybrid"} {
+			fieldsField.Args[name] = common_filters.AddTargetVectorsField(fieldsField.Args[name])
+		}
+	}
+
 	if modulesProvider != nil {
 		for name, argument := range modulesProvider.AggregateArguments(class) {
 			fieldsField.Args[name] = argument
@@ -240,6 +246,9 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
 		// not aggregatable
 		return nil, nil
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		// not aggregatable
+		return nil, nil
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
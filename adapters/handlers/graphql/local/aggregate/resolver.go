@@ -141,6 +141,11 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		hybridParams = p
 	}
 
+	targetVector, err := common_filters.ExtractTargetVector(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
 	var tenant string
 	if tk, ok := p.Args["tenant"]; ok {
 		tenant = tk.(string)
@@ -158,6 +163,7 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		NearObject:       nearObjectParams,
 		ModuleParams:     moduleParams,
 		Hybrid:           hybridParams,
+		TargetVector:     targetVector,
 		Tenant:           tenant,
 	}
 
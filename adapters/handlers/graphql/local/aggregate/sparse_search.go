@@ -25,5 +25,13 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "The number of edits (0 to 2) a term may differ from a query term by and still match",
+			Type:        graphql.Int,
+		},
+		"prefix": &graphql.InputObjectFieldConfig{
+			Description: "Match the last query term as the prefix of terms (search-as-you-type)",
+			Type:        graphql.Boolean,
+		},
 	}
 }
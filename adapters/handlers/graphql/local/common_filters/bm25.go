@@ -31,6 +31,16 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	fuzziness, ok := source["fuzziness"]
+	if ok {
+		args.Fuzziness = fuzziness.(int)
+	}
+
+	prefix, ok := source["prefix"]
+	if ok {
+		args.Prefix = prefix.(bool)
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
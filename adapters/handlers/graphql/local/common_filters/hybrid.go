@@ -105,6 +105,16 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	fuzziness, ok := source["fuzziness"]
+	if ok {
+		args.Fuzziness = fuzziness.(int)
+	}
+
+	prefix, ok := source["prefix"]
+	if ok {
+		args.Prefix = prefix.(bool)
+	}
+
 	if _, ok := source["properties"]; ok {
 		properties := source["properties"].([]interface{})
 		args.Properties = make([]string, len(properties))
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+// AddTargetVectorsField adds the "targetVectors" field to a search argument,
+// so that searches on classes with named vectors can pick the vector to
+// search on
+func AddTargetVectorsField(argument *graphql.ArgumentConfig) *graphql.ArgumentConfig {
+	if inputObject, ok := argument.Type.(*graphql.InputObject); ok {
+		inputObject.AddFieldConfig("targetVectors", &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVectors,
+			Type:        graphql.NewList(graphql.String),
+		})
+	}
+
+	return argument
+}
+
+// ExtractTargetVector returns the target vector set on any of the search
+// arguments. Only a single target vector is supported per search.
+func ExtractTargetVector(args map[string]interface{}) (string, error) {
+	var targetVectors []string
+	for _, arg := range args {
+		asMap, ok := arg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		values, ok := asMap["targetVectors"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, value := range values {
+			targetVectors = append(targetVectors, value.(string))
+		}
+	}
+
+	switch len(targetVectors) {
+	case 0:
+		return "", nil
+	case 1:
+		return targetVectors[0], nil
+	default:
+		return "", fmt.Errorf("multiple target vectors are not supported")
+	}
+}
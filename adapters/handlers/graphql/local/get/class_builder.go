@@ -132,6 +132,9 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 				if propertyType.IsPrimitive() {
 					classProperties[property.Name] = b.primitiveField(propertyType, property,
 						class.Class)
+				} else if propertyType.IsNested() {
+					classProperties[property.Name] = b.nestedField(propertyType, property,
+						class.Class)
 				} else {
 					classProperties[property.Name] = b.referenceField(propertyType, property,
 						class.Class)
@@ -152,11 +155,15 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["certainty"] = b.additionalCertaintyField(class)
 	additionalProperties["distance"] = b.additionalDistanceField(class)
 	additionalProperties["vector"] = b.additionalVectorField(class)
+	if schema.NamedVectorsEnabled(class) {
+		additionalProperties["vectors"] = b.additionalVectorsField(class)
+	}
 	additionalProperties["id"] = b.additionalIDField()
 	additionalProperties["creationTimeUnix"] = b.additionalCreationTimeUnix()
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["highlight"] = b.additionalHighlightField(class)
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
@@ -215,6 +222,31 @@ func (b *classBuilder) additionalVectorField(class *models.Class) *graphql.Field
 	}
 }
 
+func (b *classBuilder) additionalVectorsField(class *models.Class) *graphql.Field {
+	resolve := func(p graphql.ResolveParams) (interface{}, error) {
+		vectors, ok := p.Source.(map[string][]float32)
+		if !ok {
+			return nil, fmt.Errorf("expected vectors to be a map, but was %T", p.Source)
+		}
+		return vectors[p.Info.FieldName], nil
+	}
+
+	fields := graphql.Fields{}
+	for _, targetVector := range schema.TargetVectors(class) {
+		fields[targetVector] = &graphql.Field{
+			Type:    graphql.NewList(graphql.Float),
+			Resolve: resolve,
+		}
+	}
+
+	return &graphql.Field{
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name:   fmt.Sprintf("%sAdditionalVectors", class.Class),
+			Fields: fields,
+		}),
+	}
+}
+
 func (b *classBuilder) additionalCreationTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
@@ -233,6 +265,18 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalHighlightField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlight", class.Class),
+			Fields: graphql.Fields{
+				"property":  &graphql.Field{Type: graphql.String},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
@@ -238,6 +238,12 @@ func buildGetClassField(classObject *graphql.Object,
 	field.Args["bm25"] = bm25Argument(class.Class)
 	field.Args["hybrid"] = hybridArgument(classObject, class, modulesProvider, fusionEnum)
 
+	if schema.NamedVectorsEnabled(class) {
+		for _, name := range []string{"nearVector", "nearObject", "hybrid"} {
+			field.Args[name] = common_filters.AddTargetVectorsField(field.Args[name])
+		}
+	}
+
 	if modulesProvider != nil {
 		for name, argument := range modulesProvider.GetArguments(class) {
 			field.Args[name] = argument
@@ -402,6 +408,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 			return nil, fmt.Errorf("bm25 search is not compatible with sort")
 		}
 		p := common_filters.ExtractBM25(bm25.(map[string]interface{}), addlProps.ExplainScore)
+		p.Highlight = addlProps.Highlight
 		keywordRankingParams = &p
 	}
 
@@ -435,6 +442,11 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		groupByParams = &p
 	}
 
+	targetVector, err := common_filters.ExtractTargetVector(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
 	var tenant string
 	if tk, ok := p.Args["tenant"]; ok {
 		tenant = tk.(string)
@@ -456,6 +468,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		HybridSearch:          hybridParams,
 		ReplicationProperties: replProps,
 		GroupBy:               groupByParams,
+		TargetVector:          targetVector,
 		Tenant:                tenant,
 	}
 
@@ -562,10 +575,10 @@ type additionalCheck struct {
 
 func (ac *additionalCheck) isAdditional(name string) bool {
 	if name == "classification" || name == "certainty" ||
-		name == "distance" || name == "id" || name == "vector" ||
+		name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 		name == "score" || name == "explainScore" || name == "isConsistent" ||
-		name == "group" {
+		name == "group" || name == "highlight" {
 		return true
 	}
 	if ac.isModuleAdditional(name) {
@@ -615,6 +628,11 @@ func extractProperties(className string, selections *ast.SelectionSet,
 		property := search.SelectProperty{Name: name}
 
 		property.IsPrimitive = isPrimitive(field.SelectionSet)
+		if !property.IsPrimitive && name != "_additional" && isNestedSelection(field.SelectionSet) {
+			// object and object[] properties are stored as part of the object,
+			// so they are treated like primitive properties
+			property.IsPrimitive = true
+		}
 		if !property.IsPrimitive {
 			// We can interpret this property in different ways
 			for _, subSelection := range field.SelectionSet.Selections {
@@ -646,6 +664,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.Vector = true
 							continue
 						}
+						if additionalProperty == "vectors" {
+							additionalProps.Vectors = extractTargetVectorNames(s)
+							continue
+						}
 						if additionalProperty == "creationTimeUnix" {
 							additionalProps.CreationTimeUnix = true
 							continue
@@ -658,6 +680,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.ExplainScore = true
 							continue
 						}
+						if additionalProperty == "highlight" {
+							additionalProps.Highlight = true
+							continue
+						}
 						if additionalProperty == "lastUpdateTimeUnix" {
 							additionalProps.LastUpdateTimeUnix = true
 							continue
@@ -766,6 +792,22 @@ func getModuleParams(moduleParams map[string]interface{}) map[string]interface{}
 	return moduleParams
 }
 
+// extractTargetVectorNames returns the named vectors selected in the
+// _additional { vectors { ... } } field
+func extractTargetVectorNames(field *ast.Field) []string {
+	if field.SelectionSet == nil {
+		return nil
+	}
+
+	var targetVectors []string
+	for _, selection := range field.SelectionSet.Selections {
+		if s, ok := selection.(*ast.Field); ok && s.Name.Value != "__typename" {
+			targetVectors = append(targetVectors, s.Name.Value)
+		}
+	}
+	return targetVectors
+}
+
 func extractInlineFragment(class string, fragment *ast.InlineFragment,
 	fragments map[string]ast.Definition,
 	modulesProvider ModulesProvider,
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func (b *classBuilder) nestedField(propertyType schema.PropertyDataType,
+	property *models.Property, className string,
+) *graphql.Field {
+	obj := newNestedObject(fmt.Sprintf("%s%s", className, property.Name),
+		property.Description, property.NestedProperties)
+
+	var fieldType graphql.Output = obj
+	if propertyType.AsNested() == schema.DataTypeObjectArray {
+		fieldType = graphql.NewList(obj)
+	}
+
+	return &graphql.Field{
+		Description: property.Description,
+		Name:        property.Name,
+		Type:        fieldType,
+		Resolve:     resolveNested,
+	}
+}
+
+// newNestedObject builds the object type of an object or object[] property.
+// The names of nested object types are prefixed with the names of their
+// parents, so they are unique across the whole schema.
+func newNestedObject(prefix string, description string,
+	nestedProps []*models.NestedProperty,
+) *graphql.Object {
+	fields := graphql.Fields{}
+	for _, nestedProp := range nestedProps {
+		fields[nestedProp.Name] = nestedPropertyField(prefix, nestedProp)
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%sObj", prefix),
+		Description: description,
+		Fields:      fields,
+	})
+}
+
+func nestedPropertyField(prefix string, nestedProp *models.NestedProperty) *graphql.Field {
+	var fieldType graphql.Output
+
+	switch dt, _ := schema.AsNestedPropertyDataType(nestedProp.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeBlob:
+		fieldType = graphql.String
+	case schema.DataTypeDate:
+		fieldType = graphql.String // String since no graphql date datatype exists
+	case schema.DataTypeUUID:
+		fieldType = graphql.String // Always return UUID as string representation to the user
+	case schema.DataTypeInt:
+		fieldType = graphql.Int
+	case schema.DataTypeNumber:
+		fieldType = graphql.Float
+	case schema.DataTypeBoolean:
+		fieldType = graphql.Boolean
+	case schema.DataTypeTextArray, schema.DataTypeDateArray, schema.DataTypeUUIDArray:
+		fieldType = graphql.NewList(graphql.String)
+	case schema.DataTypeIntArray:
+		fieldType = graphql.NewList(graphql.Int)
+	case schema.DataTypeNumberArray:
+		fieldType = graphql.NewList(graphql.Float)
+	case schema.DataTypeBooleanArray:
+		fieldType = graphql.NewList(graphql.Boolean)
+	case schema.DataTypeObject:
+		fieldType = newNestedObject(fmt.Sprintf("%s_%s", prefix, nestedProp.Name),
+			nestedProp.Description, nestedProp.NestedProperties)
+	case schema.DataTypeObjectArray:
+		fieldType = graphql.NewList(newNestedObject(fmt.Sprintf("%s_%s", prefix, nestedProp.Name),
+			nestedProp.Description, nestedProp.NestedProperties))
+	default:
+		panic(fmt.Sprintf("buildGetClass: unknown nested type for %s.%s; %v",
+			prefix, nestedProp.Name, nestedProp.DataType))
+	}
+
+	return &graphql.Field{
+		Description: nestedProp.Description,
+		Name:        nestedProp.Name,
+		Type:        fieldType,
+	}
+}
+
+func resolveNested(p graphql.ResolveParams) (interface{}, error) {
+	field := p.Source.(map[string]interface{})[p.Info.FieldName]
+	switch field.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}, []interface{}:
+		return field, nil
+	default:
+		// Nested objects are stored without their schema, so an object which
+		// happens to look like another map-type property (e.g. geo
+		// coordinates) is parsed as such. Turn it back into a plain map.
+		asJSON, err := json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("marshal nested property %q: %w", p.Info.FieldName, err)
+		}
+		var out interface{}
+		if err := json.Unmarshal(asJSON, &out); err != nil {
+			return nil, fmt.Errorf("unmarshal nested property %q: %w", p.Info.FieldName, err)
+		}
+		return out, nil
+	}
+}
+
+// isNestedSelection checks whether the selection set selects the nested
+// properties of an object or object[] property. Selections on cross-refs
+// only contain fragments and __typename, so any other field must be nested.
+func isNestedSelection(selectionSet *ast.SelectionSet) bool {
+	if selectionSet == nil {
+		return false
+	}
+
+	for _, subSelection := range selectionSet.Selections {
+		if subsectionField, ok := subSelection.(*ast.Field); ok {
+			if subsectionField.Name.Value != "__typename" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
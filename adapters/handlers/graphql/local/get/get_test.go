@@ -1852,6 +1852,54 @@ func TestBM25WithSort(t *testing.T) {
 	resolver.AssertFailToResolve(t, query, "bm25 search is not compatible with sort")
 }
 
+func TestBM25FuzzyPrefix(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      "aple pi",
+			Properties: []string{"name"},
+			Fuzziness:  1,
+			Prefix:     true,
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{Get{SomeAction(bm25:{query:"aple pi",properties:["name"],fuzziness:1,prefix:true}){intField}}}`
+	resolver.AssertResolve(t, query)
+}
+
+func TestBM25Highlight(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := dto.GetParams{
+		ClassName:            "SomeAction",
+		Properties:           []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		AdditionalProperties: additional.Properties{Highlight: true},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      "apple pie",
+			Properties: []string{"name"},
+			Highlight:  true,
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{Get{SomeAction(bm25:{query:"apple pie",properties:["name"]}){intField _additional{highlight{property fragments}}}}}`
+	resolver.AssertResolve(t, query)
+}
+
 func TestHybridWithSort(t *testing.T) {
 	t.Parallel()
 	resolver := newMockResolverWithNoModules()
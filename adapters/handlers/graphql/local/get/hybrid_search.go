@@ -64,6 +64,14 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Algorithm used for fusing results from vector and keyword search",
 			Type:        fusionEnum,
 		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "The number of edits (0 to 2) a term may differ from a query term by in the keyword search",
+			Type:        graphql.Int,
+		},
+		"prefix": &graphql.InputObjectFieldConfig{
+			Description: "Match the last query term as the prefix of terms in the keyword search (search-as-you-type)",
+			Type:        graphql.Boolean,
+		},
 	}
 
 	if os.Getenv("ENABLE_EXPERIMENTAL_HYBRID_OPERANDS") != "" {
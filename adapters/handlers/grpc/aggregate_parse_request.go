@@ -0,0 +1,286 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+)
+
+func aggregateParamsFromProto(req *pb.AggregateRequest, scheme schema.Schema) (*aggregation.Params, error) {
+	class, err := schema.GetClassByName(scheme.Objects, req.ClassName)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &aggregation.Params{
+		ClassName:        schema.ClassName(req.ClassName),
+		Tenant:           req.Tenant,
+		IncludeMetaCount: req.ObjectsCount,
+	}
+
+	out.Properties, err = extractAggregationProperties(req.Aggregations, class)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupBy != nil {
+		propName := schema.LowercaseFirstLetter(req.GroupBy.Property)
+		if _, err := schema.GetPropertyByName(class, propName); err != nil {
+			return nil, fmt.Errorf("group_by: %w", err)
+		}
+		out.GroupBy = &filters.Path{
+			Class:    schema.ClassName(req.ClassName),
+			Property: schema.PropertyName(propName),
+		}
+	}
+
+	if req.Limit != nil {
+		limit := int(*req.Limit)
+		out.Limit = &limit
+	}
+
+	if req.ObjectLimit != nil {
+		if *req.ObjectLimit == 0 {
+			return nil, fmt.Errorf("object_limit must be a positive integer")
+		}
+		objectLimit := int(*req.ObjectLimit)
+		out.ObjectLimit = &objectLimit
+	}
+
+	if req.Filters != nil {
+		clause, err := extractFilters(req.Filters, scheme, req.ClassName)
+		if err != nil {
+			return nil, err
+		}
+		filter := &filters.LocalFilter{Root: &clause}
+		if err := filters.ValidateFilters(scheme, filter); err != nil {
+			return nil, err
+		}
+		out.Filters = filter
+	}
+
+	if err := extractAggregateNearParams(req, out); err != nil {
+		return nil, err
+	}
+
+	// we might support object_limit without near searches later, e.g. with sort
+	if out.ObjectLimit != nil && out.NearVector == nil && out.NearObject == nil &&
+		len(out.ModuleParams) == 0 {
+		return nil, fmt.Errorf("object_limit can only be used with a near search")
+	}
+
+	return out, nil
+}
+
+func extractAggregateNearParams(req *pb.AggregateRequest, out *aggregation.Params) error {
+	var err error
+	var targetVectors []string
+
+	if nv := req.NearVector; nv != nil {
+		out.NearVector, err = parseNearVector(nv)
+		if err != nil {
+			return err
+		}
+		targetVectors = append(targetVectors, nv.TargetVectors...)
+	}
+
+	if no := req.NearObject; no != nil {
+		out.NearObject, err = parseNearObject(no)
+		if err != nil {
+			return err
+		}
+		targetVectors = append(targetVectors, no.TargetVectors...)
+	}
+
+	moduleParams := map[string]interface{}{}
+	if nt := req.NearText; nt != nil {
+		limit := 0
+		if out.ObjectLimit != nil {
+			limit = *out.ObjectLimit
+		}
+		moduleParams["nearText"], err = parseNearText(req.ClassName, nt, limit)
+		if err != nil {
+			return err
+		}
+		targetVectors = append(targetVectors, nt.TargetVectors...)
+	}
+
+	if ni := req.NearImage; ni != nil {
+		moduleParams["nearImage"], err = parseNearImage(ni)
+		if err != nil {
+			return err
+		}
+		targetVectors = append(targetVectors, ni.TargetVectors...)
+	}
+
+	if na := req.NearAudio; na != nil {
+		moduleParams["nearAudio"], err = parseNearAudio(na)
+		if err != nil {
+			return err
+		}
+		targetVectors = append(targetVectors, na.TargetVectors...)
+	}
+
+	if nv := req.NearVideo; nv != nil {
+		moduleParams["nearVideo"], err = parseNearVideo(nv)
+		if err != nil {
+			return err
+		}
+		targetVectors = append(targetVectors, nv.TargetVectors...)
+	}
+
+	if len(moduleParams) > 0 {
+		out.ModuleParams = moduleParams
+	}
+
+	out.TargetVector, err = singleTargetVector(targetVectors)
+	return err
+}
+
+func extractAggregationProperties(aggs []*pb.AggregateRequest_Aggregation,
+	class *models.Class,
+) ([]aggregation.ParamProperty, error) {
+	out := make([]aggregation.ParamProperty, 0, len(aggs))
+	for _, agg := range aggs {
+		propName := schema.LowercaseFirstLetter(agg.Property)
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, err
+		}
+
+		var aggregators []aggregation.Aggregator
+		switch a := agg.Aggregation.(type) {
+		case *pb.AggregateRequest_Aggregation_Int:
+			err = checkAggregationDataType(prop, "int", schema.DataTypeInt, schema.DataTypeIntArray)
+			aggregators = numericalAggregators(a.Int.Count, a.Int.Type, a.Int.Sum, a.Int.Mean,
+				a.Int.Mode, a.Int.Median, a.Int.Maximum, a.Int.Minimum)
+		case *pb.AggregateRequest_Aggregation_Number_:
+			err = checkAggregationDataType(prop, "number", schema.DataTypeNumber, schema.DataTypeNumberArray)
+			aggregators = numericalAggregators(a.Number.Count, a.Number.Type, a.Number.Sum, a.Number.Mean,
+				a.Number.Mode, a.Number.Median, a.Number.Maximum, a.Number.Minimum)
+		case *pb.AggregateRequest_Aggregation_Text_:
+			err = checkAggregationDataType(prop, "text", schema.DataTypeText, schema.DataTypeTextArray)
+			aggregators = textAggregators(a.Text)
+		case *pb.AggregateRequest_Aggregation_Boolean_:
+			err = checkAggregationDataType(prop, "boolean", schema.DataTypeBoolean, schema.DataTypeBooleanArray)
+			aggregators = booleanAggregators(a.Boolean)
+		case *pb.AggregateRequest_Aggregation_Date_:
+			err = checkAggregationDataType(prop, "date", schema.DataTypeDate, schema.DataTypeDateArray)
+			aggregators = dateAggregators(a.Date)
+		case *pb.AggregateRequest_Aggregation_Reference_:
+			if !schema.IsRefDataType(prop.DataType) {
+				err = fmt.Errorf("reference aggregation is not supported for property '%s' "+
+					"of data type %v", prop.Name, prop.DataType)
+			}
+			aggregators = referenceAggregators(a.Reference)
+		default:
+			return nil, fmt.Errorf("no aggregation set for property '%s'", agg.Property)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, aggregation.ParamProperty{
+			Name:        schema.PropertyName(propName),
+			Aggregators: aggregators,
+		})
+	}
+
+	return out, nil
+}
+
+func checkAggregationDataType(prop *models.Property, aggregationType string,
+	dataTypes ...schema.DataType,
+) error {
+	if dataType, ok := schema.AsPrimitive(prop.DataType); ok {
+		for _, dt := range dataTypes {
+			if dataType == dt {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%s aggregation is not supported for property '%s' of data type %v",
+		aggregationType, prop.Name, prop.DataType)
+}
+
+func numericalAggregators(count, typ, sum, mean, mode, median, maximum, minimum bool) []aggregation.Aggregator {
+	var out []aggregation.Aggregator
+	out = appendAggregatorIf(out, count, aggregation.CountAggregator)
+	out = appendAggregatorIf(out, typ, aggregation.TypeAggregator)
+	out = appendAggregatorIf(out, sum, aggregation.SumAggregator)
+	out = appendAggregatorIf(out, mean, aggregation.MeanAggregator)
+	out = appendAggregatorIf(out, mode, aggregation.ModeAggregator)
+	out = appendAggregatorIf(out, median, aggregation.MedianAggregator)
+	out = appendAggregatorIf(out, maximum, aggregation.MaximumAggregator)
+	out = appendAggregatorIf(out, minimum, aggregation.MinimumAggregator)
+	return out
+}
+
+func textAggregators(text *pb.AggregateRequest_Aggregation_Text) []aggregation.Aggregator {
+	var out []aggregation.Aggregator
+	out = appendAggregatorIf(out, text.Count, aggregation.CountAggregator)
+	out = appendAggregatorIf(out, text.Type, aggregation.TypeAggregator)
+	if text.TopOccurrences {
+		// same default limit as in the GraphQL API
+		limit := 5
+		if text.TopOccurrencesLimit != nil {
+			limit = int(*text.TopOccurrencesLimit)
+		}
+		out = append(out, aggregation.NewTopOccurrencesAggregator(&limit))
+	}
+	return out
+}
+
+func booleanAggregators(boolean *pb.AggregateRequest_Aggregation_Boolean) []aggregation.Aggregator {
+	var out []aggregation.Aggregator
+	out = appendAggregatorIf(out, boolean.Count, aggregation.CountAggregator)
+	out = appendAggregatorIf(out, boolean.Type, aggregation.TypeAggregator)
+	out = appendAggregatorIf(out, boolean.TotalTrue, aggregation.TotalTrueAggregator)
+	out = appendAggregatorIf(out, boolean.TotalFalse, aggregation.TotalFalseAggregator)
+	out = appendAggregatorIf(out, boolean.PercentageTrue, aggregation.PercentageTrueAggregator)
+	out = appendAggregatorIf(out, boolean.PercentageFalse, aggregation.PercentageFalseAggregator)
+	return out
+}
+
+func dateAggregators(date *pb.AggregateRequest_Aggregation_Date) []aggregation.Aggregator {
+	var out []aggregation.Aggregator
+	out = appendAggregatorIf(out, date.Count, aggregation.CountAggregator)
+	out = appendAggregatorIf(out, date.Type, aggregation.TypeAggregator)
+	out = appendAggregatorIf(out, date.Median, aggregation.MedianAggregator)
+	out = appendAggregatorIf(out, date.Mode, aggregation.ModeAggregator)
+	out = appendAggregatorIf(out, date.Maximum, aggregation.MaximumAggregator)
+	out = appendAggregatorIf(out, date.Minimum, aggregation.MinimumAggregator)
+	return out
+}
+
+func referenceAggregators(ref *pb.AggregateRequest_Aggregation_Reference) []aggregation.Aggregator {
+	var out []aggregation.Aggregator
+	out = appendAggregatorIf(out, ref.Type, aggregation.TypeAggregator)
+	out = appendAggregatorIf(out, ref.PointingTo, aggregation.PointingToAggregator)
+	return out
+}
+
+func appendAggregatorIf(aggs []aggregation.Aggregator, cond bool,
+	agg aggregation.Aggregator,
+) []aggregation.Aggregator {
+	if cond {
+		return append(aggs, agg)
+	}
+	return aggs
+}
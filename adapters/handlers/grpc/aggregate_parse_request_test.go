@@ -0,0 +1,304 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	nearText2 "github.com/weaviate/weaviate/usecases/modulecomponents/nearText"
+)
+
+func TestGRPCAggregateRequest(t *testing.T) {
+	classname := "TestClass"
+	refClass := "OtherClass"
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: classname,
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "number", DataType: schema.DataTypeInt.PropString()},
+						{Name: "floats", DataType: schema.DataTypeNumberArray.PropString()},
+						{Name: "isCool", DataType: schema.DataTypeBoolean.PropString()},
+						{Name: "created", DataType: schema.DataTypeDate.PropString()},
+						{Name: "ref", DataType: []string{refClass}},
+					},
+				},
+				{
+					Class: refClass,
+					Properties: []*models.Property{
+						{Name: "something", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+			},
+		},
+	}
+	limit := uint32(3)
+	objectLimit := uint32(10)
+	zero := uint32(0)
+	certainty := 0.8
+	expectedLimit := 3
+	expectedObjectLimit := 10
+	expectedTopOccurrencesLimit := 5
+	expectedCustomTopOccurrencesLimit := 3
+
+	tests := []struct {
+		name  string
+		req   *pb.AggregateRequest
+		out   *aggregation.Params
+		error bool
+	}{
+		{
+			name:  "No classname",
+			req:   &pb.AggregateRequest{},
+			error: true,
+		},
+		{
+			name: "Meta count only",
+			req:  &pb.AggregateRequest{ClassName: classname, ObjectsCount: true, Tenant: "tenant1"},
+			out: &aggregation.Params{
+				ClassName:        schema.ClassName(classname),
+				Tenant:           "tenant1",
+				IncludeMetaCount: true,
+				Properties:       []aggregation.ParamProperty{},
+			},
+		},
+		{
+			name: "Aggregations of all types",
+			req: &pb.AggregateRequest{ClassName: classname, Aggregations: []*pb.AggregateRequest_Aggregation{
+				{Property: "number", Aggregation: &pb.AggregateRequest_Aggregation_Int{
+					Int: &pb.AggregateRequest_Aggregation_Integer{Count: true, Mean: true, Maximum: true},
+				}},
+				{Property: "floats", Aggregation: &pb.AggregateRequest_Aggregation_Number_{
+					Number: &pb.AggregateRequest_Aggregation_Number{Type: true, Sum: true, Minimum: true},
+				}},
+				{Property: "name", Aggregation: &pb.AggregateRequest_Aggregation_Text_{
+					Text: &pb.AggregateRequest_Aggregation_Text{Count: true, TopOccurrences: true},
+				}},
+				{Property: "isCool", Aggregation: &pb.AggregateRequest_Aggregation_Boolean_{
+					Boolean: &pb.AggregateRequest_Aggregation_Boolean{TotalTrue: true, PercentageFalse: true},
+				}},
+				{Property: "created", Aggregation: &pb.AggregateRequest_Aggregation_Date_{
+					Date: &pb.AggregateRequest_Aggregation_Date{Median: true, Mode: true},
+				}},
+				{Property: "ref", Aggregation: &pb.AggregateRequest_Aggregation_Reference_{
+					Reference: &pb.AggregateRequest_Aggregation_Reference{Type: true, PointingTo: true},
+				}},
+			}},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(classname),
+				Properties: []aggregation.ParamProperty{
+					{Name: "number", Aggregators: []aggregation.Aggregator{
+						aggregation.CountAggregator, aggregation.MeanAggregator, aggregation.MaximumAggregator,
+					}},
+					{Name: "floats", Aggregators: []aggregation.Aggregator{
+						aggregation.TypeAggregator, aggregation.SumAggregator, aggregation.MinimumAggregator,
+					}},
+					{Name: "name", Aggregators: []aggregation.Aggregator{
+						aggregation.CountAggregator, aggregation.NewTopOccurrencesAggregator(&expectedTopOccurrencesLimit),
+					}},
+					{Name: "isCool", Aggregators: []aggregation.Aggregator{
+						aggregation.TotalTrueAggregator, aggregation.PercentageFalseAggregator,
+					}},
+					{Name: "created", Aggregators: []aggregation.Aggregator{
+						aggregation.MedianAggregator, aggregation.ModeAggregator,
+					}},
+					{Name: "ref", Aggregators: []aggregation.Aggregator{
+						aggregation.TypeAggregator, aggregation.PointingToAggregator,
+					}},
+				},
+			},
+		},
+		{
+			name: "Top occurrences with limit",
+			req: &pb.AggregateRequest{ClassName: classname, Aggregations: []*pb.AggregateRequest_Aggregation{
+				{Property: "name", Aggregation: &pb.AggregateRequest_Aggregation_Text_{
+					Text: &pb.AggregateRequest_Aggregation_Text{TopOccurrences: true, TopOccurrencesLimit: &limit},
+				}},
+			}},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(classname),
+				Properties: []aggregation.ParamProperty{
+					{Name: "name", Aggregators: []aggregation.Aggregator{
+						aggregation.NewTopOccurrencesAggregator(&expectedCustomTopOccurrencesLimit),
+					}},
+				},
+			},
+		},
+		{
+			name: "Aggregation does not match data type",
+			req: &pb.AggregateRequest{ClassName: classname, Aggregations: []*pb.AggregateRequest_Aggregation{
+				{Property: "name", Aggregation: &pb.AggregateRequest_Aggregation_Int{
+					Int: &pb.AggregateRequest_Aggregation_Integer{Count: true},
+				}},
+			}},
+			error: true,
+		},
+		{
+			name: "Reference aggregation on primitive property",
+			req: &pb.AggregateRequest{ClassName: classname, Aggregations: []*pb.AggregateRequest_Aggregation{
+				{Property: "name", Aggregation: &pb.AggregateRequest_Aggregation_Reference_{
+					Reference: &pb.AggregateRequest_Aggregation_Reference{PointingTo: true},
+				}},
+			}},
+			error: true,
+		},
+		{
+			name: "No aggregation set",
+			req: &pb.AggregateRequest{ClassName: classname, Aggregations: []*pb.AggregateRequest_Aggregation{
+				{Property: "name"},
+			}},
+			error: true,
+		},
+		{
+			name: "Unknown property",
+			req: &pb.AggregateRequest{ClassName: classname, Aggregations: []*pb.AggregateRequest_Aggregation{
+				{Property: "doesNotExist", Aggregation: &pb.AggregateRequest_Aggregation_Text_{
+					Text: &pb.AggregateRequest_Aggregation_Text{Count: true},
+				}},
+			}},
+			error: true,
+		},
+		{
+			name: "Group by with limit",
+			req: &pb.AggregateRequest{
+				ClassName: classname, ObjectsCount: true, Limit: &limit,
+				GroupBy: &pb.AggregateRequest_GroupBy{Property: "name"},
+			},
+			out: &aggregation.Params{
+				ClassName:        schema.ClassName(classname),
+				IncludeMetaCount: true,
+				Properties:       []aggregation.ParamProperty{},
+				Limit:            &expectedLimit,
+				GroupBy:          &filters.Path{Class: schema.ClassName(classname), Property: "name"},
+			},
+		},
+		{
+			name: "Group by unknown property",
+			req: &pb.AggregateRequest{
+				ClassName: classname, GroupBy: &pb.AggregateRequest_GroupBy{Property: "doesNotExist"},
+			},
+			error: true,
+		},
+		{
+			name: "Filters",
+			req: &pb.AggregateRequest{
+				ClassName: classname, ObjectsCount: true,
+				Filters: &pb.Filters{
+					Operator:  pb.Filters_OPERATOR_EQUAL,
+					TestValue: &pb.Filters_ValueText{ValueText: "test"},
+					On:        []string{"name"},
+				},
+			},
+			out: &aggregation.Params{
+				ClassName:        schema.ClassName(classname),
+				IncludeMetaCount: true,
+				Properties:       []aggregation.ParamProperty{},
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						On:       &filters.Path{Class: schema.ClassName(classname), Property: "name"},
+						Operator: filters.OperatorEqual,
+						Value:    &filters.Value{Value: "test", Type: schema.DataTypeText},
+					},
+				},
+			},
+		},
+		{
+			name: "Near vector with object limit",
+			req: &pb.AggregateRequest{
+				ClassName: classname, ObjectsCount: true, ObjectLimit: &objectLimit,
+				NearVector: &pb.NearVectorParams{Vector: []float32{1, 2, 3}, Certainty: &certainty},
+			},
+			out: &aggregation.Params{
+				ClassName:        schema.ClassName(classname),
+				IncludeMetaCount: true,
+				Properties:       []aggregation.ParamProperty{},
+				ObjectLimit:      &expectedObjectLimit,
+				NearVector:       &searchparams.NearVector{Vector: []float32{1, 2, 3}, Certainty: certainty},
+			},
+		},
+		{
+			name: "Near object with target vector",
+			req: &pb.AggregateRequest{
+				ClassName: classname, ObjectsCount: true,
+				NearObject: &pb.NearObjectParams{Id: UUID1.String(), Certainty: &certainty, TargetVectors: []string{"title"}},
+			},
+			out: &aggregation.Params{
+				ClassName:        schema.ClassName(classname),
+				IncludeMetaCount: true,
+				Properties:       []aggregation.ParamProperty{},
+				NearObject:       &searchparams.NearObject{ID: UUID1.String(), Certainty: certainty},
+				TargetVector:     "title",
+			},
+		},
+		{
+			name: "Near text with object limit",
+			req: &pb.AggregateRequest{
+				ClassName: classname, ObjectsCount: true, ObjectLimit: &objectLimit,
+				NearText: &pb.NearTextSearchParams{Query: []string{"first and", "second"}},
+			},
+			out: &aggregation.Params{
+				ClassName:        schema.ClassName(classname),
+				IncludeMetaCount: true,
+				Properties:       []aggregation.ParamProperty{},
+				ObjectLimit:      &expectedObjectLimit,
+				ModuleParams: map[string]interface{}{"nearText": &nearText2.NearTextParams{
+					Values: []string{"first and", "second"},
+					Limit:  expectedObjectLimit,
+				}},
+			},
+		},
+		{
+			name: "Near vector with distance and certainty",
+			req: &pb.AggregateRequest{
+				ClassName:  classname,
+				NearVector: &pb.NearVectorParams{Vector: []float32{1, 2, 3}, Certainty: &certainty, Distance: &certainty},
+			},
+			error: true,
+		},
+		{
+			name: "Object limit without near search",
+			req: &pb.AggregateRequest{
+				ClassName: classname, ObjectsCount: true, ObjectLimit: &objectLimit,
+			},
+			error: true,
+		},
+		{
+			name: "Object limit of zero",
+			req: &pb.AggregateRequest{
+				ClassName: classname, ObjectsCount: true, ObjectLimit: &zero,
+				NearVector: &pb.NearVectorParams{Vector: []float32{1, 2, 3}},
+			},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := aggregateParamsFromProto(tt.req, scheme)
+			if tt.error {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.out, out)
+			}
+		})
+	}
+}
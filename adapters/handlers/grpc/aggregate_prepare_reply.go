@@ -0,0 +1,406 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+)
+
+func aggregateResultsToProto(res interface{}, start time.Time, req *pb.AggregateRequest,
+	scheme schema.Schema,
+) (*pb.AggregateReply, error) {
+	out := &pb.AggregateReply{
+		Took: float32(time.Since(start).Seconds()),
+	}
+
+	var groups []aggregation.Group
+	switch parsed := res.(type) {
+	case *aggregation.Result:
+		if parsed != nil {
+			groups = parsed.Groups
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("unexpected aggregation result of type %T", res)
+	}
+
+	if req.GroupBy == nil {
+		single := &pb.AggregateReply_Single{
+			Aggregations: &pb.AggregateReply_Aggregations{},
+		}
+		if len(groups) > 0 {
+			aggs, err := extractAggregationsReply(groups[0].Properties, req.Aggregations)
+			if err != nil {
+				return nil, err
+			}
+			single.Aggregations = aggs
+		}
+		if req.ObjectsCount {
+			count := int64(0)
+			if len(groups) > 0 {
+				count = int64(groups[0].Count)
+			}
+			single.ObjectsCount = &count
+		}
+		out.Result = &pb.AggregateReply_SingleResult{SingleResult: single}
+		return out, nil
+	}
+
+	groupByDataType, err := groupByPropertyDataType(scheme, req)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := &pb.AggregateReply_Grouped{
+		Groups: make([]*pb.AggregateReply_Group, 0, len(groups)),
+	}
+	for _, group := range groups {
+		groupOut := &pb.AggregateReply_Group{}
+
+		aggs, err := extractAggregationsReply(group.Properties, req.Aggregations)
+		if err != nil {
+			return nil, err
+		}
+		groupOut.Aggregations = aggs
+
+		if req.ObjectsCount {
+			count := int64(group.Count)
+			groupOut.ObjectsCount = &count
+		}
+
+		if group.GroupedBy != nil {
+			groupOut.GroupedBy, err = extractGroupedByReply(group.GroupedBy, groupByDataType)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		grouped.Groups = append(grouped.Groups, groupOut)
+	}
+	out.Result = &pb.AggregateReply_GroupedResults{GroupedResults: grouped}
+
+	return out, nil
+}
+
+func groupByPropertyDataType(scheme schema.Schema, req *pb.AggregateRequest) (schema.DataType, error) {
+	class := scheme.GetClass(schema.ClassName(req.ClassName))
+	dataType, err := schema.GetPropertyDataType(class, schema.LowercaseFirstLetter(req.GroupBy.Property))
+	if err != nil {
+		return "", err
+	}
+	return *dataType, nil
+}
+
+func extractGroupedByReply(groupedBy *aggregation.GroupedBy,
+	dataType schema.DataType,
+) (*pb.AggregateReply_Group_GroupedBy, error) {
+	out := &pb.AggregateReply_Group_GroupedBy{Path: groupedBy.Path}
+
+	switch val := groupedBy.Value.(type) {
+	case string:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Text{Text: val}
+	case bool:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Boolean{Boolean: val}
+	case float64:
+		// all numerical values are grouped as float64, only the schema can tell
+		// whether they are integers
+		if dataType == schema.DataTypeInt || dataType == schema.DataTypeIntArray {
+			out.Value = &pb.AggregateReply_Group_GroupedBy_Int{Int: int64(val)}
+		} else {
+			out.Value = &pb.AggregateReply_Group_GroupedBy_Number{Number: val}
+		}
+	case int64:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Int{Int: val}
+	case int:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Int{Int: int64(val)}
+	case fmt.Stringer:
+		// e.g. the beacons of cross-references
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Text{Text: val.String()}
+	default:
+		return nil, fmt.Errorf("grouped by value %v of type %T not supported", val, val)
+	}
+
+	return out, nil
+}
+
+func extractAggregationsReply(props map[string]aggregation.Property,
+	aggs []*pb.AggregateRequest_Aggregation,
+) (*pb.AggregateReply_Aggregations, error) {
+	out := &pb.AggregateReply_Aggregations{
+		Aggregations: make([]*pb.AggregateReply_Aggregations_Aggregation, 0, len(aggs)),
+	}
+
+	for _, agg := range aggs {
+		propName := schema.LowercaseFirstLetter(agg.Property)
+		prop := props[propName]
+		aggOut := &pb.AggregateReply_Aggregations_Aggregation{Property: agg.Property}
+
+		switch a := agg.Aggregation.(type) {
+		case *pb.AggregateRequest_Aggregation_Int:
+			intOut, err := extractIntegerAggregation(prop, a.Int)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", agg.Property, err)
+			}
+			aggOut.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Int{Int: intOut}
+		case *pb.AggregateRequest_Aggregation_Number_:
+			numberOut, err := extractNumberAggregation(prop, a.Number)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", agg.Property, err)
+			}
+			aggOut.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Number_{Number: numberOut}
+		case *pb.AggregateRequest_Aggregation_Text_:
+			aggOut.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Text_{
+				Text: extractTextAggregation(prop, a.Text),
+			}
+		case *pb.AggregateRequest_Aggregation_Boolean_:
+			aggOut.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Boolean_{
+				Boolean: extractBooleanAggregation(prop, a.Boolean),
+			}
+		case *pb.AggregateRequest_Aggregation_Date_:
+			dateOut, err := extractDateAggregation(prop, a.Date)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", agg.Property, err)
+			}
+			aggOut.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Date_{Date: dateOut}
+		case *pb.AggregateRequest_Aggregation_Reference_:
+			aggOut.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Reference_{
+				Reference: extractReferenceAggregation(prop, a.Reference),
+			}
+		default:
+			return nil, fmt.Errorf("no aggregation set for property '%s'", agg.Property)
+		}
+
+		out.Aggregations = append(out.Aggregations, aggOut)
+	}
+
+	return out, nil
+}
+
+func extractIntegerAggregation(prop aggregation.Property,
+	req *pb.AggregateRequest_Aggregation_Integer,
+) (*pb.AggregateReply_Aggregations_Aggregation_Integer, error) {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Integer{}
+	if req.Type {
+		out.Type = &prop.SchemaType
+	}
+
+	var err error
+	aggs := prop.NumericalAggregations
+	if out.Count, err = int64Aggregation(aggs, aggregation.CountAggregator); err != nil {
+		return nil, err
+	}
+	if out.Mean, err = float64Aggregation(aggs, aggregation.MeanAggregator); err != nil {
+		return nil, err
+	}
+	if out.Median, err = float64Aggregation(aggs, aggregation.MedianAggregator); err != nil {
+		return nil, err
+	}
+	if out.Mode, err = int64Aggregation(aggs, aggregation.ModeAggregator); err != nil {
+		return nil, err
+	}
+	if out.Maximum, err = int64Aggregation(aggs, aggregation.MaximumAggregator); err != nil {
+		return nil, err
+	}
+	if out.Minimum, err = int64Aggregation(aggs, aggregation.MinimumAggregator); err != nil {
+		return nil, err
+	}
+	if out.Sum, err = int64Aggregation(aggs, aggregation.SumAggregator); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func extractNumberAggregation(prop aggregation.Property,
+	req *pb.AggregateRequest_Aggregation_Number,
+) (*pb.AggregateReply_Aggregations_Aggregation_Number, error) {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Number{}
+	if req.Type {
+		out.Type = &prop.SchemaType
+	}
+
+	var err error
+	aggs := prop.NumericalAggregations
+	if out.Count, err = int64Aggregation(aggs, aggregation.CountAggregator); err != nil {
+		return nil, err
+	}
+	if out.Mean, err = float64Aggregation(aggs, aggregation.MeanAggregator); err != nil {
+		return nil, err
+	}
+	if out.Median, err = float64Aggregation(aggs, aggregation.MedianAggregator); err != nil {
+		return nil, err
+	}
+	if out.Mode, err = float64Aggregation(aggs, aggregation.ModeAggregator); err != nil {
+		return nil, err
+	}
+	if out.Maximum, err = float64Aggregation(aggs, aggregation.MaximumAggregator); err != nil {
+		return nil, err
+	}
+	if out.Minimum, err = float64Aggregation(aggs, aggregation.MinimumAggregator); err != nil {
+		return nil, err
+	}
+	if out.Sum, err = float64Aggregation(aggs, aggregation.SumAggregator); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func extractTextAggregation(prop aggregation.Property,
+	req *pb.AggregateRequest_Aggregation_Text,
+) *pb.AggregateReply_Aggregations_Aggregation_Text {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Text{}
+	if req.Type {
+		out.Type = &prop.SchemaType
+	}
+	if req.Count {
+		count := int64(prop.TextAggregation.Count)
+		out.Count = &count
+	}
+	if req.TopOccurrences {
+		items := make([]*pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence,
+			len(prop.TextAggregation.Items))
+		for i, item := range prop.TextAggregation.Items {
+			items[i] = &pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence{
+				Value:  item.Value,
+				Occurs: int64(item.Occurs),
+			}
+		}
+		out.TopOccurrences = &pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences{Items: items}
+	}
+
+	return out
+}
+
+func extractBooleanAggregation(prop aggregation.Property,
+	req *pb.AggregateRequest_Aggregation_Boolean,
+) *pb.AggregateReply_Aggregations_Aggregation_Boolean {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Boolean{}
+	agg := prop.BooleanAggregation
+	if req.Type {
+		out.Type = &prop.SchemaType
+	}
+	if req.Count {
+		count := int64(agg.Count)
+		out.Count = &count
+	}
+	if req.TotalTrue {
+		totalTrue := int64(agg.TotalTrue)
+		out.TotalTrue = &totalTrue
+	}
+	if req.TotalFalse {
+		totalFalse := int64(agg.TotalFalse)
+		out.TotalFalse = &totalFalse
+	}
+	if req.PercentageTrue {
+		out.PercentageTrue = &agg.PercentageTrue
+	}
+	if req.PercentageFalse {
+		out.PercentageFalse = &agg.PercentageFalse
+	}
+
+	return out
+}
+
+func extractDateAggregation(prop aggregation.Property,
+	req *pb.AggregateRequest_Aggregation_Date,
+) (*pb.AggregateReply_Aggregations_Aggregation_Date, error) {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Date{}
+	if req.Type {
+		out.Type = &prop.SchemaType
+	}
+
+	var err error
+	aggs := prop.DateAggregations
+	if out.Count, err = int64Aggregation(aggs, aggregation.CountAggregator); err != nil {
+		return nil, err
+	}
+	if out.Median, err = stringAggregation(aggs, aggregation.MedianAggregator); err != nil {
+		return nil, err
+	}
+	if out.Mode, err = stringAggregation(aggs, aggregation.ModeAggregator); err != nil {
+		return nil, err
+	}
+	if out.Maximum, err = stringAggregation(aggs, aggregation.MaximumAggregator); err != nil {
+		return nil, err
+	}
+	if out.Minimum, err = stringAggregation(aggs, aggregation.MinimumAggregator); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func extractReferenceAggregation(prop aggregation.Property,
+	req *pb.AggregateRequest_Aggregation_Reference,
+) *pb.AggregateReply_Aggregations_Aggregation_Reference {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Reference{}
+	if req.Type {
+		out.Type = &prop.SchemaType
+	}
+	if req.PointingTo {
+		out.PointingTo = prop.ReferenceAggregation.PointingTo
+	}
+
+	return out
+}
+
+// the aggregations of a property only contain the requested aggregators, and
+// not even those if there was nothing to aggregate over. Missing entries are
+// returned as nil.
+func float64Aggregation(aggs map[string]interface{}, agg aggregation.Aggregator) (*float64, error) {
+	raw, ok := aggs[agg.String()]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	var out float64
+	switch val := raw.(type) {
+	case float64:
+		out = val
+	case int64:
+		out = float64(val)
+	case int:
+		out = float64(val)
+	default:
+		return nil, fmt.Errorf("%s aggregation needs to be a number, got %T", agg, raw)
+	}
+	return &out, nil
+}
+
+func int64Aggregation(aggs map[string]interface{}, agg aggregation.Aggregator) (*int64, error) {
+	asFloat, err := float64Aggregation(aggs, agg)
+	if err != nil || asFloat == nil {
+		return nil, err
+	}
+
+	out := int64(*asFloat)
+	return &out, nil
+}
+
+func stringAggregation(aggs map[string]interface{}, agg aggregation.Aggregator) (*string, error) {
+	raw, ok := aggs[agg.String()]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	out, ok := raw.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s aggregation needs to be a string, got %T", agg, raw)
+	}
+	return &out, nil
+}
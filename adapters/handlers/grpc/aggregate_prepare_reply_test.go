@@ -0,0 +1,293 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+)
+
+func TestGRPCAggregateReply(t *testing.T) {
+	className := "className"
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: className,
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "age", DataType: schema.DataTypeInt.PropString()},
+						{Name: "price", DataType: schema.DataTypeNumber.PropString()},
+						{Name: "isCool", DataType: schema.DataTypeBoolean.PropString()},
+						{Name: "created", DataType: schema.DataTypeDate.PropString()},
+						{Name: "ref", DataType: []string{"OtherClass"}},
+					},
+				},
+			},
+		},
+	}
+
+	int64Ptr := func(in int64) *int64 { return &in }
+	float64Ptr := func(in float64) *float64 { return &in }
+	stringPtr := func(in string) *string { return &in }
+
+	allAggregations := []*pb.AggregateRequest_Aggregation{
+		{Property: "age", Aggregation: &pb.AggregateRequest_Aggregation_Int{
+			Int: &pb.AggregateRequest_Aggregation_Integer{Count: true, Type: true, Mean: true, Mode: true, Maximum: true},
+		}},
+		{Property: "price", Aggregation: &pb.AggregateRequest_Aggregation_Number_{
+			Number: &pb.AggregateRequest_Aggregation_Number{Sum: true, Median: true, Minimum: true},
+		}},
+		{Property: "name", Aggregation: &pb.AggregateRequest_Aggregation_Text_{
+			Text: &pb.AggregateRequest_Aggregation_Text{Count: true, TopOccurrences: true},
+		}},
+		{Property: "isCool", Aggregation: &pb.AggregateRequest_Aggregation_Boolean_{
+			Boolean: &pb.AggregateRequest_Aggregation_Boolean{TotalTrue: true, PercentageTrue: true},
+		}},
+		{Property: "created", Aggregation: &pb.AggregateRequest_Aggregation_Date_{
+			Date: &pb.AggregateRequest_Aggregation_Date{Count: true, Maximum: true},
+		}},
+		{Property: "ref", Aggregation: &pb.AggregateRequest_Aggregation_Reference_{
+			Reference: &pb.AggregateRequest_Aggregation_Reference{PointingTo: true},
+		}},
+	}
+	allProperties := map[string]aggregation.Property{
+		"age": {
+			Type:       aggregation.PropertyTypeNumerical,
+			SchemaType: "int",
+			NumericalAggregations: map[string]interface{}{
+				"count": float64(4), "mean": 21.5, "mode": float64(20), "maximum": float64(26),
+			},
+		},
+		"price": {
+			Type: aggregation.PropertyTypeNumerical,
+			NumericalAggregations: map[string]interface{}{
+				"sum": 10.5, "median": 2.5, "minimum": 0.5,
+			},
+		},
+		"name": {
+			Type: aggregation.PropertyTypeText,
+			TextAggregation: aggregation.Text{
+				Count: 4,
+				Items: []aggregation.TextOccurrence{{Value: "a", Occurs: 3}, {Value: "b", Occurs: 1}},
+			},
+		},
+		"isCool": {
+			Type: aggregation.PropertyTypeBoolean,
+			BooleanAggregation: aggregation.Boolean{
+				Count: 4, TotalTrue: 3, TotalFalse: 1, PercentageTrue: 0.75, PercentageFalse: 0.25,
+			},
+		},
+		"created": {
+			Type: aggregation.PropertyTypeDate,
+			DateAggregations: map[string]interface{}{
+				"count": int64(4), "maximum": "2023-01-01T00:00:00Z",
+			},
+		},
+		"ref": {
+			Type:                 aggregation.PropertyTypeReference,
+			ReferenceAggregation: aggregation.Reference{PointingTo: []string{"OtherClass"}},
+		},
+	}
+	allAggregationsOut := &pb.AggregateReply_Aggregations{
+		Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{
+			{Property: "age", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Int{
+				Int: &pb.AggregateReply_Aggregations_Aggregation_Integer{
+					Count: int64Ptr(4), Type: stringPtr("int"), Mean: float64Ptr(21.5),
+					Mode: int64Ptr(20), Maximum: int64Ptr(26),
+				},
+			}},
+			{Property: "price", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Number_{
+				Number: &pb.AggregateReply_Aggregations_Aggregation_Number{
+					Sum: float64Ptr(10.5), Median: float64Ptr(2.5), Minimum: float64Ptr(0.5),
+				},
+			}},
+			{Property: "name", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Text_{
+				Text: &pb.AggregateReply_Aggregations_Aggregation_Text{
+					Count: int64Ptr(4),
+					TopOccurrences: &pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences{
+						Items: []*pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence{
+							{Value: "a", Occurs: 3}, {Value: "b", Occurs: 1},
+						},
+					},
+				},
+			}},
+			{Property: "isCool", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Boolean_{
+				Boolean: &pb.AggregateReply_Aggregations_Aggregation_Boolean{
+					TotalTrue: int64Ptr(3), PercentageTrue: float64Ptr(0.75),
+				},
+			}},
+			{Property: "created", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Date_{
+				Date: &pb.AggregateReply_Aggregations_Aggregation_Date{
+					Count: int64Ptr(4), Maximum: stringPtr("2023-01-01T00:00:00Z"),
+				},
+			}},
+			{Property: "ref", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Reference_{
+				Reference: &pb.AggregateReply_Aggregations_Aggregation_Reference{
+					PointingTo: []string{"OtherClass"},
+				},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		req      *pb.AggregateRequest
+		res      interface{}
+		outReply *pb.AggregateReply
+		error    bool
+	}{
+		{
+			name: "meta count only",
+			req:  &pb.AggregateRequest{ClassName: className, ObjectsCount: true},
+			res:  &aggregation.Result{Groups: []aggregation.Group{{Count: 7}}},
+			outReply: &pb.AggregateReply{Result: &pb.AggregateReply_SingleResult{
+				SingleResult: &pb.AggregateReply_Single{
+					ObjectsCount: int64Ptr(7),
+					Aggregations: &pb.AggregateReply_Aggregations{
+						Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{},
+					},
+				},
+			}},
+		},
+		{
+			name: "meta count without results",
+			req:  &pb.AggregateRequest{ClassName: className, ObjectsCount: true},
+			res:  &aggregation.Result{},
+			outReply: &pb.AggregateReply{Result: &pb.AggregateReply_SingleResult{
+				SingleResult: &pb.AggregateReply_Single{
+					ObjectsCount: int64Ptr(0),
+					Aggregations: &pb.AggregateReply_Aggregations{},
+				},
+			}},
+		},
+		{
+			name: "all aggregation types",
+			req:  &pb.AggregateRequest{ClassName: className, Aggregations: allAggregations},
+			res:  &aggregation.Result{Groups: []aggregation.Group{{Count: 4, Properties: allProperties}}},
+			outReply: &pb.AggregateReply{Result: &pb.AggregateReply_SingleResult{
+				SingleResult: &pb.AggregateReply_Single{Aggregations: allAggregationsOut},
+			}},
+		},
+		{
+			name: "numerical aggregations without matching objects",
+			req: &pb.AggregateRequest{ClassName: className, Aggregations: []*pb.AggregateRequest_Aggregation{
+				{Property: "age", Aggregation: &pb.AggregateRequest_Aggregation_Int{
+					Int: &pb.AggregateRequest_Aggregation_Integer{Count: true, Mean: true},
+				}},
+			}},
+			res: &aggregation.Result{Groups: []aggregation.Group{{Properties: map[string]aggregation.Property{
+				"age": {NumericalAggregations: map[string]interface{}{"count": float64(0)}},
+			}}}},
+			outReply: &pb.AggregateReply{Result: &pb.AggregateReply_SingleResult{
+				SingleResult: &pb.AggregateReply_Single{Aggregations: &pb.AggregateReply_Aggregations{
+					Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{
+						{Property: "age", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Int{
+							Int: &pb.AggregateReply_Aggregations_Aggregation_Integer{Count: int64Ptr(0)},
+						}},
+					},
+				}},
+			}},
+		},
+		{
+			name: "grouped by int property",
+			req: &pb.AggregateRequest{
+				ClassName: className, ObjectsCount: true,
+				GroupBy: &pb.AggregateRequest_GroupBy{Property: "age"},
+			},
+			res: &aggregation.Result{Groups: []aggregation.Group{
+				{Count: 3, GroupedBy: &aggregation.GroupedBy{Value: float64(20), Path: []string{"age"}}},
+				{Count: 1, GroupedBy: &aggregation.GroupedBy{Value: float64(26), Path: []string{"age"}}},
+			}},
+			outReply: &pb.AggregateReply{Result: &pb.AggregateReply_GroupedResults{
+				GroupedResults: &pb.AggregateReply_Grouped{Groups: []*pb.AggregateReply_Group{
+					{
+						ObjectsCount: int64Ptr(3),
+						Aggregations: &pb.AggregateReply_Aggregations{
+							Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{},
+						},
+						GroupedBy: &pb.AggregateReply_Group_GroupedBy{
+							Path: []string{"age"}, Value: &pb.AggregateReply_Group_GroupedBy_Int{Int: 20},
+						},
+					},
+					{
+						ObjectsCount: int64Ptr(1),
+						Aggregations: &pb.AggregateReply_Aggregations{
+							Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{},
+						},
+						GroupedBy: &pb.AggregateReply_Group_GroupedBy{
+							Path: []string{"age"}, Value: &pb.AggregateReply_Group_GroupedBy_Int{Int: 26},
+						},
+					},
+				}},
+			}},
+		},
+		{
+			name: "grouped by text property with aggregations",
+			req: &pb.AggregateRequest{
+				ClassName: className, Aggregations: allAggregations,
+				GroupBy: &pb.AggregateRequest_GroupBy{Property: "name"},
+			},
+			res: &aggregation.Result{Groups: []aggregation.Group{
+				{Count: 4, Properties: allProperties, GroupedBy: &aggregation.GroupedBy{Value: "a", Path: []string{"name"}}},
+			}},
+			outReply: &pb.AggregateReply{Result: &pb.AggregateReply_GroupedResults{
+				GroupedResults: &pb.AggregateReply_Grouped{Groups: []*pb.AggregateReply_Group{
+					{
+						Aggregations: allAggregationsOut,
+						GroupedBy: &pb.AggregateReply_Group_GroupedBy{
+							Path: []string{"name"}, Value: &pb.AggregateReply_Group_GroupedBy_Text{Text: "a"},
+						},
+					},
+				}},
+			}},
+		},
+		{
+			name: "wrong type of numerical aggregation",
+			req: &pb.AggregateRequest{ClassName: className, Aggregations: []*pb.AggregateRequest_Aggregation{
+				{Property: "age", Aggregation: &pb.AggregateRequest_Aggregation_Int{
+					Int: &pb.AggregateRequest_Aggregation_Integer{Mean: true},
+				}},
+			}},
+			res: &aggregation.Result{Groups: []aggregation.Group{{Properties: map[string]aggregation.Property{
+				"age": {NumericalAggregations: map[string]interface{}{"mean": "not a number"}},
+			}}}},
+			error: true,
+		},
+		{
+			name:  "unexpected result type",
+			req:   &pb.AggregateRequest{ClassName: className, ObjectsCount: true},
+			res:   []interface{}{},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := aggregateResultsToProto(tt.res, time.Now(), tt.req, scheme)
+			if tt.error {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				// took is not deterministic
+				out.Took = 0
+				require.Equal(t, tt.outReply, out)
+			}
+		})
+	}
+}
@@ -34,57 +34,74 @@ func batchFromProto(req *pb.BatchObjectsRequest, scheme schema.Schema) ([]*model
 	objectsBatch := req.Objects
 	objs := make([]*models.Object, len(objectsBatch))
 	for i, obj := range objectsBatch {
-		class := scheme.GetClass(schema.ClassName(obj.ClassName))
-		var props map[string]interface{}
-		if obj.Properties != nil {
-			if obj.Properties.NonRefProperties != nil {
-				props = obj.Properties.NonRefProperties.AsMap()
-			} else {
-				props = make(map[string]interface{})
-			}
+		var err error
+		objs[i], err = objectFromProto(obj, scheme)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objs, nil
+}
 
-			// arrays cannot be part of a GRPC map, so we need to handle each type separately
-			if obj.Properties.BooleanArrayProperties != nil {
-				for j := range obj.Properties.BooleanArrayProperties {
-					props[obj.Properties.BooleanArrayProperties[j].PropName] = sliceToInterface(obj.Properties.BooleanArrayProperties[j].Values)
-				}
-			}
+func objectFromProto(obj *pb.BatchObject, scheme schema.Schema) (*models.Object, error) {
+	class := scheme.GetClass(schema.ClassName(obj.ClassName))
+	var props map[string]interface{}
+	if obj.Properties != nil {
+		if obj.Properties.NonRefProperties != nil {
+			props = obj.Properties.NonRefProperties.AsMap()
+		} else {
+			props = make(map[string]interface{})
+		}
 
-			if obj.Properties.NumberArrayProperties != nil {
-				for j := range obj.Properties.NumberArrayProperties {
-					props[obj.Properties.NumberArrayProperties[j].PropName] = sliceToInterface(obj.Properties.NumberArrayProperties[j].Values)
-				}
+		// arrays cannot be part of a GRPC map, so we need to handle each type separately
+		if obj.Properties.BooleanArrayProperties != nil {
+			for j := range obj.Properties.BooleanArrayProperties {
+				props[obj.Properties.BooleanArrayProperties[j].PropName] = sliceToInterface(obj.Properties.BooleanArrayProperties[j].Values)
 			}
+		}
 
-			if obj.Properties.TextArrayProperties != nil {
-				for j := range obj.Properties.TextArrayProperties {
-					props[obj.Properties.TextArrayProperties[j].PropName] = sliceToInterface(obj.Properties.TextArrayProperties[j].Values)
-				}
+		if obj.Properties.NumberArrayProperties != nil {
+			for j := range obj.Properties.NumberArrayProperties {
+				props[obj.Properties.NumberArrayProperties[j].PropName] = sliceToInterface(obj.Properties.NumberArrayProperties[j].Values)
 			}
+		}
 
-			if obj.Properties.IntArrayProperties != nil {
-				for j := range obj.Properties.IntArrayProperties {
-					props[obj.Properties.IntArrayProperties[j].PropName] = sliceToInterface(obj.Properties.IntArrayProperties[j].Values)
-				}
+		if obj.Properties.TextArrayProperties != nil {
+			for j := range obj.Properties.TextArrayProperties {
+				props[obj.Properties.TextArrayProperties[j].PropName] = sliceToInterface(obj.Properties.TextArrayProperties[j].Values)
 			}
+		}
 
-			if err := extractSingleRefTarget(class, obj, props); err != nil {
-				return nil, err
-			}
-			if err := extractMultiRefTarget(class, obj, props); err != nil {
-				return nil, err
+		if obj.Properties.IntArrayProperties != nil {
+			for j := range obj.Properties.IntArrayProperties {
+				props[obj.Properties.IntArrayProperties[j].PropName] = sliceToInterface(obj.Properties.IntArrayProperties[j].Values)
 			}
 		}
 
-		objs[i] = &models.Object{
-			Class:      obj.ClassName,
-			Tenant:     obj.Tenant,
-			Vector:     obj.Vector,
-			Properties: props,
-			ID:         strfmt.UUID(obj.Uuid),
+		if err := extractSingleRefTarget(class, obj, props); err != nil {
+			return nil, err
+		}
+		if err := extractMultiRefTarget(class, obj, props); err != nil {
+			return nil, err
 		}
 	}
-	return objs, nil
+
+	var vectors models.Vectors
+	if len(obj.Vectors) > 0 {
+		vectors = make(models.Vectors, len(obj.Vectors))
+		for _, vector := range obj.Vectors {
+			vectors[vector.Name] = vector.Vector
+		}
+	}
+
+	return &models.Object{
+		Class:      obj.ClassName,
+		Tenant:     obj.Tenant,
+		Vector:     obj.Vector,
+		Vectors:    vectors,
+		Properties: props,
+		ID:         strfmt.UUID(obj.Uuid),
+	}, nil
 }
 
 func extractSingleRefTarget(class *models.Class, obj *pb.BatchObject, props map[string]interface{}) error {
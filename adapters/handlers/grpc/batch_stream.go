@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// BatchStream imports objects and references while the client sends them and
+// answers each of them as soon as its batch is imported
+func (s *Server) BatchStream(stream pb.Weaviate_BatchStreamServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	pending, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	var repl *additional.ReplicationProperties
+	if start := pending.GetStart(); start != nil {
+		repl = extractReplicationProperties(start.ConsistencyLevel)
+		pending = nil
+	}
+
+	recv := func() (*objects.BatchStreamItem, error) {
+		req := pending
+		pending = nil
+		if req == nil {
+			var err error
+			if req, err = stream.Recv(); err != nil {
+				return nil, err
+			}
+		}
+		return batchStreamItemFromProto(req, s.schemaManager.GetSchemaSkipAuth()), nil
+	}
+
+	send := func(res objects.BatchStreamResult) error {
+		return stream.Send(batchStreamResultToProto(res))
+	}
+
+	return toRPCError(s.batchManager.ImportStream(ctx, principal, repl, recv, send))
+}
+
+// batchStreamItemFromProto never fails, invalid messages are answered with
+// an error like objects that fail validation
+func batchStreamItemFromProto(req *pb.BatchStreamRequest, scheme schema.Schema) *objects.BatchStreamItem {
+	switch msg := req.Message.(type) {
+	case *pb.BatchStreamRequest_Object:
+		obj, err := objectFromProto(msg.Object, scheme)
+		if err != nil {
+			return &objects.BatchStreamItem{Err: err}
+		}
+		return &objects.BatchStreamItem{Object: obj}
+	case *pb.BatchStreamRequest_Reference:
+		ref, err := batchReferenceFromProto(msg.Reference)
+		if err != nil {
+			return &objects.BatchStreamItem{Err: err}
+		}
+		return &objects.BatchStreamItem{Reference: ref}
+	case *pb.BatchStreamRequest_Start_:
+		return &objects.BatchStreamItem{
+			Err: fmt.Errorf("start message must be the first message of a stream"),
+		}
+	default:
+		return &objects.BatchStreamItem{Err: fmt.Errorf("neither an object nor a reference")}
+	}
+}
+
+// batchReferenceFromProto builds the beacons of a batch reference. The target
+// collection of single-target references is filled in from the schema when
+// the batch is imported.
+func batchReferenceFromProto(ref *pb.BatchReference) (*models.BatchReference, error) {
+	if ref.ClassName == "" || ref.ReferenceProperty == "" {
+		return nil, fmt.Errorf("class name and reference property are required")
+	}
+	id, err := extractObjectID(ref.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := extractObjectID(ref.TargetUuid)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	source := crossref.NewSource(schema.ClassName(ref.ClassName),
+		schema.PropertyName(ref.ReferenceProperty), id)
+	target := crossref.NewLocalhost(ref.TargetCollection, targetID)
+
+	return &models.BatchReference{
+		From:   strfmt.URI(source.String()),
+		To:     strfmt.URI(target.String()),
+		Tenant: ref.Tenant,
+	}, nil
+}
+
+func batchStreamResultToProto(res objects.BatchStreamResult) *pb.BatchStreamReply {
+	out := &pb.BatchStreamReply{
+		Index: int64(res.Index),
+		Uuid:  res.UUID.String(),
+	}
+	if res.Err != nil {
+		errMsg := res.Err.Error()
+		out.Error = &errMsg
+	}
+	return out
+}
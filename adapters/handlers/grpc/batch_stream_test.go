@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestGRPCBatchStreamRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    *pb.BatchStreamRequest
+		out   *objects.BatchStreamItem
+		error bool
+	}{
+		{
+			name: "object",
+			in: &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Object{
+				Object: &pb.BatchObject{
+					ClassName: "Article", Uuid: UUID1.String(), Tenant: "tenant1",
+					Properties: &pb.BatchObject_Properties{
+						NonRefProperties: newStruct(t, map[string]interface{}{"title": "hello"}),
+					},
+				},
+			}},
+			out: &objects.BatchStreamItem{Object: &models.Object{
+				Class: "Article", ID: UUID1, Tenant: "tenant1",
+				Properties: map[string]interface{}{"title": "hello"},
+			}},
+		},
+		{
+			name: "single-target reference",
+			in: &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Reference{
+				Reference: &pb.BatchReference{
+					ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "author",
+					TargetUuid: UUID2.String(), Tenant: "tenant1",
+				},
+			}},
+			out: &objects.BatchStreamItem{Reference: &models.BatchReference{
+				From:   strfmt.URI("weaviate://localhost/Article/" + UUID1 + "/author"),
+				To:     strfmt.URI("weaviate://localhost/" + UUID2),
+				Tenant: "tenant1",
+			}},
+		},
+		{
+			name: "multi-target reference",
+			in: &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Reference{
+				Reference: &pb.BatchReference{
+					ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "mentions",
+					TargetUuid: UUID2.String(), TargetCollection: "Author",
+				},
+			}},
+			out: &objects.BatchStreamItem{Reference: &models.BatchReference{
+				From: strfmt.URI("weaviate://localhost/Article/" + UUID1 + "/mentions"),
+				To:   strfmt.URI("weaviate://localhost/Author/" + UUID2),
+			}},
+		},
+		{
+			name: "reference with invalid target",
+			in: &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Reference{
+				Reference: &pb.BatchReference{
+					ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "author",
+					TargetUuid: "foo",
+				},
+			}},
+			error: true,
+		},
+		{
+			name: "reference without property",
+			in: &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Reference{
+				Reference: &pb.BatchReference{
+					ClassName: "Article", Uuid: UUID1.String(), TargetUuid: UUID2.String(),
+				},
+			}},
+			error: true,
+		},
+		{
+			name: "start message after the first message",
+			in: &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Start_{
+				Start: &pb.BatchStreamRequest_Start{},
+			}},
+			error: true,
+		},
+		{
+			name:  "empty message",
+			in:    &pb.BatchStreamRequest{},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := batchStreamItemFromProto(tt.in, objectsScheme)
+			if tt.error {
+				require.NotNil(t, out.Err)
+				require.Nil(t, out.Object)
+				require.Nil(t, out.Reference)
+			} else {
+				require.Equal(t, tt.out, out)
+			}
+		})
+	}
+}
+
+func TestGRPCBatchStreamReply(t *testing.T) {
+	errMsg := "invalid object"
+
+	require.Equal(t, &pb.BatchStreamReply{Index: 3, Uuid: UUID1.String()},
+		batchStreamResultToProto(objects.BatchStreamResult{Index: 3, UUID: UUID1}))
+	require.Equal(t, &pb.BatchStreamReply{Index: 4, Error: &errMsg},
+		batchStreamResultToProto(objects.BatchStreamResult{Index: 4, Err: errors.New(errMsg)}))
+}
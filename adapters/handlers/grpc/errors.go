@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"errors"
+
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toRPCError maps the errors of the objects and schema usecases to gRPC
+// status codes, the same way the REST handlers map them to HTTP status codes.
+// Errors that cannot be mapped are returned unchanged.
+func toRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var objErr *objects.Error
+	if errors.As(err, &objErr) {
+		switch {
+		case objErr.Forbidden():
+			return status.Error(codes.PermissionDenied, err.Error())
+		case objErr.NotFound():
+			return status.Error(codes.NotFound, err.Error())
+		case objErr.BadRequest(), objErr.UnprocessableEntity():
+			return status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	switch {
+	case errors.As(err, &autherrs.Forbidden{}):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &objects.ErrNotFound{}), errors.Is(err, schemaManager.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &objects.ErrInvalidUserInput{}), errors.As(err, &objects.ErrMultiTenancy{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &objects.ErrResourceExhausted{}):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.As(err, &objects.ErrInternal{}):
+		return status.Error(codes.Internal, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToRPCError(t *testing.T) {
+	tests := []struct {
+		name string
+		in   error
+		code codes.Code
+	}{
+		{
+			name: "forbidden",
+			in:   autherrs.NewForbidden(&models.Principal{Username: "john"}, "get", "objects"),
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "object not found",
+			in:   objects.NewErrNotFound("no object with id '%s'", UUID1),
+			code: codes.NotFound,
+		},
+		{
+			name: "class not found",
+			in:   fmt.Errorf("class %q: %w", "Article", schemaManager.ErrNotFound),
+			code: codes.NotFound,
+		},
+		{
+			name: "invalid user input",
+			in:   objects.NewErrInvalidUserInput("invalid object"),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "multi-tenancy",
+			in:   objects.NewErrMultiTenancy(errors.New("tenant not found")),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "resource exhausted",
+			in:   objects.NewErrResourceExhausted(errors.New("disk usage too high")),
+			code: codes.ResourceExhausted,
+		},
+		{
+			name: "objects error forbidden",
+			in:   &objects.Error{Code: objects.StatusForbidden},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "objects error not found",
+			in:   &objects.Error{Code: objects.StatusNotFound},
+			code: codes.NotFound,
+		},
+		{
+			name: "objects error unprocessable",
+			in:   &objects.Error{Code: objects.StatusUnprocessableEntity},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "objects error internal",
+			in:   &objects.Error{Code: objects.StatusInternalServerError},
+			code: codes.Internal,
+		},
+		{
+			name: "unknown error",
+			in:   errors.New("something went wrong"),
+			code: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := toRPCError(tt.in)
+			require.NotNil(t, err)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+
+	require.Nil(t, toRPCError(nil))
+}
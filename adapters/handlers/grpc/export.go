@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Export streams all objects of a class. Every reply carries a checkpoint
+// which can be used to resume the export if the stream breaks.
+func (s *Server) Export(req *pb.ExportRequest, stream pb.Weaviate_ExportServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	params, err := exportParamsFromProto(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.objectsManager.Export(ctx, principal, params, func(page objects.ExportPage) error {
+		out := make([]*pb.Object, len(page.Objects))
+		for i, obj := range page.Objects {
+			out[i], err = objectToProto(obj)
+			if err != nil {
+				return err
+			}
+		}
+
+		return stream.Send(&pb.ExportReply{
+			Objects:    out,
+			Checkpoint: page.Checkpoint.Token(),
+		})
+	})
+	if err != nil {
+		return toRPCError(err)
+	}
+
+	return nil
+}
+
+func exportParamsFromProto(req *pb.ExportRequest) (objects.ExportParams, error) {
+	if req.ClassName == "" {
+		return objects.ExportParams{}, fmt.Errorf("class name is required")
+	}
+
+	params := objects.ExportParams{
+		Class:  req.ClassName,
+		Tenant: req.Tenant,
+	}
+	if req.PageSize != nil {
+		if *req.PageSize == 0 {
+			return objects.ExportParams{}, fmt.Errorf("page size must be a positive integer")
+		}
+		params.PageSize = int(*req.PageSize)
+	}
+	if req.Checkpoint != nil {
+		checkpoint, err := objects.ParseExportCheckpoint(*req.Checkpoint)
+		if err != nil {
+			return objects.ExportParams{}, err
+		}
+		params.Checkpoint = checkpoint
+	}
+
+	return params, nil
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestGRPCExportRequest(t *testing.T) {
+	pageSize := uint32(10)
+	zero := uint32(0)
+	checkpoint := objects.ExportCheckpoint{Shard: "shard1", After: UUID1}
+	token := checkpoint.Token()
+	invalidToken := "not a checkpoint"
+
+	tests := []struct {
+		name  string
+		in    *pb.ExportRequest
+		out   objects.ExportParams
+		error bool
+	}{
+		{
+			name: "class only",
+			in:   &pb.ExportRequest{ClassName: "Article"},
+			out:  objects.ExportParams{Class: "Article"},
+		},
+		{
+			name: "tenant, page size and checkpoint",
+			in: &pb.ExportRequest{
+				ClassName: "Article", Tenant: "tenant1", PageSize: &pageSize, Checkpoint: &token,
+			},
+			out: objects.ExportParams{
+				Class: "Article", Tenant: "tenant1", PageSize: 10, Checkpoint: &checkpoint,
+			},
+		},
+		{
+			name:  "no class",
+			in:    &pb.ExportRequest{},
+			error: true,
+		},
+		{
+			name:  "zero page size",
+			in:    &pb.ExportRequest{ClassName: "Article", PageSize: &zero},
+			error: true,
+		},
+		{
+			name:  "invalid checkpoint",
+			in:    &pb.ExportRequest{ClassName: "Article", Checkpoint: &invalidToken},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := exportParamsFromProto(tt.in)
+			if tt.error {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.out, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ObjectsGet(ctx context.Context, req *pb.ObjectsGetRequest) (*pb.ObjectsGetReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	id, err := extractObjectID(req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	props := additional.Properties{Vector: req.Vector, Vectors: req.Vectors}
+	obj, err := s.objectsManager.GetObject(ctx, principal, req.ClassName, id, props,
+		extractReplicationProperties(req.ConsistencyLevel), req.Tenant)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	out, err := objectToProto(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ObjectsGetReply{
+		Object: out,
+		Took:   float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Server) ObjectsExists(ctx context.Context, req *pb.ObjectsExistsRequest) (*pb.ObjectsExistsReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	id, err := extractObjectID(req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	exists, objErr := s.objectsManager.HeadObject(ctx, principal, req.ClassName, id,
+		extractReplicationProperties(req.ConsistencyLevel), req.Tenant)
+	if objErr != nil {
+		return nil, toRPCError(objErr)
+	}
+
+	return &pb.ObjectsExistsReply{
+		Exists: exists,
+		Took:   float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Server) ObjectsPut(ctx context.Context, req *pb.ObjectsPutRequest) (*pb.ObjectsPutReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	obj, err := singleObjectFromProto(req.Object, s.schemaManager.GetSchemaSkipAuth())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updated, err := s.objectsManager.UpdateObject(ctx, principal, obj.Class, obj.ID, obj,
+		extractReplicationProperties(req.ConsistencyLevel))
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	out, err := objectToProto(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ObjectsPutReply{
+		Object: out,
+		Took:   float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Server) ObjectsMerge(ctx context.Context, req *pb.ObjectsMergeRequest) (*pb.ObjectsMergeReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	obj, err := singleObjectFromProto(req.Object, s.schemaManager.GetSchemaSkipAuth())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if objErr := s.objectsManager.MergeObject(ctx, principal, obj,
+		extractReplicationProperties(req.ConsistencyLevel)); objErr != nil {
+		return nil, toRPCError(objErr)
+	}
+
+	return &pb.ObjectsMergeReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) ObjectsDelete(ctx context.Context, req *pb.ObjectsDeleteRequest) (*pb.ObjectsDeleteReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	id, err := extractObjectID(req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.objectsManager.DeleteObject(ctx, principal, req.ClassName, id,
+		extractReplicationProperties(req.ConsistencyLevel), req.Tenant); err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.ObjectsDeleteReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) ReferenceAdd(ctx context.Context, req *pb.ReferenceRequest) (*pb.ReferenceReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	id, err := extractObjectID(req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ref, err := referenceFromProto(req, s.schemaManager.GetSchemaSkipAuth())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	input := &objects.AddReferenceInput{
+		Class:    req.ClassName,
+		ID:       id,
+		Property: req.ReferenceProperty,
+		Ref:      ref,
+	}
+	if objErr := s.objectsManager.AddObjectReference(ctx, principal, input,
+		extractReplicationProperties(req.ConsistencyLevel), req.Tenant); objErr != nil {
+		return nil, toRPCError(objErr)
+	}
+
+	return &pb.ReferenceReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) ReferenceDelete(ctx context.Context, req *pb.ReferenceRequest) (*pb.ReferenceReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	id, err := extractObjectID(req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ref, err := referenceFromProto(req, s.schemaManager.GetSchemaSkipAuth())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	input := &objects.DeleteReferenceInput{
+		Class:     req.ClassName,
+		ID:        id,
+		Property:  req.ReferenceProperty,
+		Reference: ref,
+	}
+	if objErr := s.objectsManager.DeleteObjectReference(ctx, principal, input,
+		extractReplicationProperties(req.ConsistencyLevel), req.Tenant); objErr != nil {
+		return nil, toRPCError(objErr)
+	}
+
+	return &pb.ReferenceReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	params, err := batchDeleteParamsFromProto(req, s.schemaManager.GetSchemaSkipAuth())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.batchManager.DeleteObjectsFromGRPC(ctx, principal, params,
+		extractReplicationProperties(req.ConsistencyLevel), req.Tenant)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	out := batchDeleteResultsToProto(res, req.Verbose)
+	out.Took = float32(time.Since(before).Seconds())
+	return out, nil
+}
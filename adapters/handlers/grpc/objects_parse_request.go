@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func extractObjectID(id string) (strfmt.UUID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return "", fmt.Errorf("invalid uuid '%s': %w", id, err)
+	}
+	return strfmt.UUID(id), nil
+}
+
+func singleObjectFromProto(obj *pb.BatchObject, scheme schema.Schema) (*models.Object, error) {
+	if obj == nil {
+		return nil, fmt.Errorf("no object given")
+	}
+	if _, err := schema.GetClassByName(scheme.Objects, obj.ClassName); err != nil {
+		return nil, err
+	}
+	if _, err := extractObjectID(obj.Uuid); err != nil {
+		return nil, err
+	}
+
+	return objectFromProto(obj, scheme)
+}
+
+// referenceFromProto builds the beacon of the referenced object. For
+// single-target references the target collection is taken from the schema,
+// multi-target references need to set it explicitly.
+func referenceFromProto(req *pb.ReferenceRequest, scheme schema.Schema) (models.SingleRef, error) {
+	class, err := schema.GetClassByName(scheme.Objects, req.ClassName)
+	if err != nil {
+		return models.SingleRef{}, err
+	}
+	prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(req.ReferenceProperty))
+	if err != nil {
+		return models.SingleRef{}, err
+	}
+	if !schema.IsRefDataType(prop.DataType) {
+		return models.SingleRef{}, fmt.Errorf("property '%s' is not a reference property", prop.Name)
+	}
+
+	targetID, err := extractObjectID(req.TargetUuid)
+	if err != nil {
+		return models.SingleRef{}, fmt.Errorf("target: %w", err)
+	}
+
+	targetCollection := req.TargetCollection
+	if targetCollection == "" {
+		if len(prop.DataType) > 1 {
+			return models.SingleRef{}, fmt.Errorf("target collection is required for multi-target "+
+				"reference property '%s'", prop.Name)
+		}
+		targetCollection = prop.DataType[0]
+	}
+
+	return *crossref.NewLocalhost(targetCollection, targetID).SingleRef(), nil
+}
+
+func batchDeleteParamsFromProto(req *pb.BatchDeleteRequest, scheme schema.Schema) (objects.BatchDeleteParams, error) {
+	params := objects.BatchDeleteParams{
+		ClassName: schema.ClassName(req.ClassName),
+		DryRun:    req.DryRun,
+		Output:    objects.OutputMinimal,
+	}
+	if req.Verbose {
+		params.Output = objects.OutputVerbose
+	}
+
+	if req.Filters == nil {
+		return objects.BatchDeleteParams{}, fmt.Errorf("no filters given")
+	}
+	clause, err := extractFilters(req.Filters, scheme, req.ClassName)
+	if err != nil {
+		return objects.BatchDeleteParams{}, err
+	}
+	params.Filters = &filters.LocalFilter{Root: &clause}
+
+	return params, nil
+}
@@ -0,0 +1,240 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var objectsScheme = schema.Schema{
+	Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Article",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString()},
+					{Name: "author", DataType: []string{"Author"}},
+					{Name: "mentions", DataType: []string{"Author", "Article"}},
+				},
+			},
+			{
+				Class: "Author",
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		},
+	},
+}
+
+func TestGRPCSingleObjectRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    *pb.BatchObject
+		out   *models.Object
+		error bool
+	}{
+		{
+			name: "object with properties and references",
+			in: &pb.BatchObject{
+				ClassName: "Article",
+				Uuid:      UUID1.String(),
+				Tenant:    "tenant1",
+				Vector:    []float32{1, 2, 3},
+				Properties: &pb.BatchObject_Properties{
+					NonRefProperties: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"title": structpb.NewStringValue("hello"),
+					}},
+					RefPropsSingle: []*pb.BatchObject_RefPropertiesSingleTarget{
+						{PropName: "author", Uuids: []string{UUID2.String()}},
+					},
+				},
+			},
+			out: &models.Object{
+				Class:  "Article",
+				ID:     UUID1,
+				Tenant: "tenant1",
+				Vector: []float32{1, 2, 3},
+				Properties: map[string]interface{}{
+					"title": "hello",
+					"author": []interface{}{
+						map[string]interface{}{"beacon": BEACON_START + "Author/" + UUID2.String()},
+					},
+				},
+			},
+		},
+		{
+			name:  "no object",
+			error: true,
+		},
+		{
+			name:  "unknown class",
+			in:    &pb.BatchObject{ClassName: "DoesNotExist", Uuid: UUID1.String()},
+			error: true,
+		},
+		{
+			name:  "invalid uuid",
+			in:    &pb.BatchObject{ClassName: "Article", Uuid: "not-a-uuid"},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := singleObjectFromProto(tt.in, objectsScheme)
+			if tt.error {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.out, out)
+			}
+		})
+	}
+}
+
+func TestGRPCReferenceRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    *pb.ReferenceRequest
+		out   models.SingleRef
+		error bool
+	}{
+		{
+			name: "single target reference",
+			in: &pb.ReferenceRequest{
+				ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "author",
+				TargetUuid: UUID2.String(),
+			},
+			out: models.SingleRef{Beacon: strfmt.URI(BEACON_START + "Author/" + UUID2.String())},
+		},
+		{
+			name: "multi target reference",
+			in: &pb.ReferenceRequest{
+				ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "mentions",
+				TargetUuid: UUID2.String(), TargetCollection: "Article",
+			},
+			out: models.SingleRef{Beacon: strfmt.URI(BEACON_START + "Article/" + UUID2.String())},
+		},
+		{
+			name: "multi target reference without target collection",
+			in: &pb.ReferenceRequest{
+				ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "mentions",
+				TargetUuid: UUID2.String(),
+			},
+			error: true,
+		},
+		{
+			name: "not a reference property",
+			in: &pb.ReferenceRequest{
+				ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "title",
+				TargetUuid: UUID2.String(),
+			},
+			error: true,
+		},
+		{
+			name: "unknown property",
+			in: &pb.ReferenceRequest{
+				ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "doesNotExist",
+				TargetUuid: UUID2.String(),
+			},
+			error: true,
+		},
+		{
+			name: "invalid target uuid",
+			in: &pb.ReferenceRequest{
+				ClassName: "Article", Uuid: UUID1.String(), ReferenceProperty: "author",
+				TargetUuid: "not-a-uuid",
+			},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := referenceFromProto(tt.in, objectsScheme)
+			if tt.error {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.out, out)
+			}
+		})
+	}
+}
+
+func TestGRPCBatchDeleteRequest(t *testing.T) {
+	filter := &pb.Filters{
+		Operator:  pb.Filters_OPERATOR_EQUAL,
+		TestValue: &pb.Filters_ValueText{ValueText: "hello"},
+		On:        []string{"title"},
+	}
+	expectedFilter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			On:       &filters.Path{Class: "Article", Property: "title"},
+			Operator: filters.OperatorEqual,
+			Value:    &filters.Value{Value: "hello", Type: schema.DataTypeText},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		in    *pb.BatchDeleteRequest
+		out   objects.BatchDeleteParams
+		error bool
+	}{
+		{
+			name: "minimal output",
+			in:   &pb.BatchDeleteRequest{ClassName: "Article", Filters: filter},
+			out: objects.BatchDeleteParams{
+				ClassName: "Article",
+				Filters:   expectedFilter,
+				Output:    objects.OutputMinimal,
+			},
+		},
+		{
+			name: "verbose dry run",
+			in:   &pb.BatchDeleteRequest{ClassName: "Article", Filters: filter, Verbose: true, DryRun: true},
+			out: objects.BatchDeleteParams{
+				ClassName: "Article",
+				Filters:   expectedFilter,
+				Output:    objects.OutputVerbose,
+				DryRun:    true,
+			},
+		},
+		{
+			name:  "no filters",
+			in:    &pb.BatchDeleteRequest{ClassName: "Article"},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := batchDeleteParamsFromProto(tt.in, objectsScheme)
+			if tt.error {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.out, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func objectToProto(obj *models.Object) (*pb.Object, error) {
+	// references, geo coordinates and phone numbers end up in the same JSON
+	// representation as in the REST API
+	props, err := toStruct(obj.Properties)
+	if err != nil {
+		return nil, errors.Wrap(err, "properties")
+	}
+
+	var vectors []*pb.Vectors
+	if len(obj.Vectors) > 0 {
+		names := make([]string, 0, len(obj.Vectors))
+		for name := range obj.Vectors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		vectors = make([]*pb.Vectors, len(names))
+		for i, name := range names {
+			vectors[i] = &pb.Vectors{Name: name, Vector: obj.Vectors[name]}
+		}
+	}
+
+	return &pb.Object{
+		Uuid:               obj.ID.String(),
+		ClassName:          obj.Class,
+		Tenant:             obj.Tenant,
+		Properties:         props,
+		Vector:             obj.Vector,
+		Vectors:            vectors,
+		CreationTimeUnix:   obj.CreationTimeUnix,
+		LastUpdateTimeUnix: obj.LastUpdateTimeUnix,
+	}, nil
+}
+
+func batchDeleteResultsToProto(res objects.BatchDeleteResult, verbose bool) *pb.BatchDeleteReply {
+	out := &pb.BatchDeleteReply{Matches: res.Matches}
+	for _, obj := range res.Objects {
+		result := &pb.BatchDeleteReply_Object{Uuid: obj.UUID.String()}
+		switch {
+		case res.DryRun:
+		case obj.Err != nil:
+			errMsg := obj.Err.Error()
+			result.Error = &errMsg
+			out.Failed++
+		default:
+			result.Successful = true
+			out.Successful++
+		}
+
+		// same as in the REST API, failed objects are always part of the reply
+		if verbose || result.Error != nil {
+			out.Objects = append(out.Objects, result)
+		}
+	}
+	return out
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestGRPCObjectReply(t *testing.T) {
+	obj := &models.Object{
+		Class:              "Article",
+		ID:                 UUID1,
+		Tenant:             "tenant1",
+		CreationTimeUnix:   100,
+		LastUpdateTimeUnix: 200,
+		Vector:             []float32{1, 2, 3},
+		Vectors: models.Vectors{
+			"title":   []float32{4, 5},
+			"content": []float32{6, 7},
+		},
+		Properties: map[string]interface{}{
+			"title": "hello",
+			"count": int64(3),
+			"author": models.MultipleRef{
+				{Beacon: strfmt.URI("weaviate://localhost/Author/" + UUID2)},
+			},
+		},
+	}
+
+	out, err := objectToProto(obj)
+	require.Nil(t, err)
+	require.Equal(t, &pb.Object{
+		Uuid:               UUID1.String(),
+		ClassName:          "Article",
+		Tenant:             "tenant1",
+		CreationTimeUnix:   100,
+		LastUpdateTimeUnix: 200,
+		Vector:             []float32{1, 2, 3},
+		Vectors: []*pb.Vectors{
+			{Name: "content", Vector: []float32{6, 7}},
+			{Name: "title", Vector: []float32{4, 5}},
+		},
+		Properties: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"title": structpb.NewStringValue("hello"),
+			"count": structpb.NewNumberValue(3),
+			"author": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+				structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"beacon": structpb.NewStringValue("weaviate://localhost/Author/" + UUID2.String()),
+				}}),
+			}}),
+		}},
+	}, out)
+}
+
+func TestGRPCBatchDeleteReply(t *testing.T) {
+	errMsg := "failed"
+	uuid3 := strfmt.UUID("e3a4d9a4-7c5f-4b5e-9d0a-3c1f4b7e8a21")
+	res := objects.BatchDeleteResult{
+		Matches: 3,
+		Objects: objects.BatchSimpleObjects{
+			{UUID: UUID1},
+			{UUID: UUID2, Err: errors.New(errMsg)},
+			{UUID: uuid3},
+		},
+	}
+
+	t.Run("minimal", func(t *testing.T) {
+		out := batchDeleteResultsToProto(res, false)
+		require.Equal(t, &pb.BatchDeleteReply{
+			Matches:    3,
+			Successful: 2,
+			Failed:     1,
+			Objects: []*pb.BatchDeleteReply_Object{
+				{Uuid: UUID2.String(), Error: &errMsg},
+			},
+		}, out)
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		out := batchDeleteResultsToProto(res, true)
+		require.Equal(t, &pb.BatchDeleteReply{
+			Matches:    3,
+			Successful: 2,
+			Failed:     1,
+			Objects: []*pb.BatchDeleteReply_Object{
+				{Uuid: UUID1.String(), Successful: true},
+				{Uuid: UUID2.String(), Error: &errMsg},
+				{Uuid: uuid3.String(), Successful: true},
+			},
+		}, out)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		dryRun := objects.BatchDeleteResult{
+			Matches: 1,
+			DryRun:  true,
+			Objects: objects.BatchSimpleObjects{{UUID: UUID1}},
+		}
+		out := batchDeleteResultsToProto(dryRun, true)
+		require.Equal(t, &pb.BatchDeleteReply{
+			Matches: 1,
+			Objects: []*pb.BatchDeleteReply_Object{{Uuid: UUID1.String()}},
+		}, out)
+	})
+}
@@ -17,7 +17,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 
 	"github.com/pkg/errors"
-	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
 
@@ -56,6 +56,11 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 
 	out.Tenant = req.Tenant
 
+	out.TargetVector, err = extractTargetVector(req)
+	if err != nil {
+		return dto.GetParams{}, err
+	}
+
 	if req.AdditionalProperties != nil {
 		out.AdditionalProperties.ID = req.AdditionalProperties.Uuid
 		out.AdditionalProperties.Vector = req.AdditionalProperties.Vector
@@ -66,6 +71,8 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 		out.AdditionalProperties.Score = req.AdditionalProperties.Score
 		out.AdditionalProperties.ExplainScore = req.AdditionalProperties.ExplainScore
 		out.AdditionalProperties.IsConsistent = req.AdditionalProperties.IsConsistent
+		out.AdditionalProperties.Vectors = req.AdditionalProperties.Vectors
+		out.AdditionalProperties.Highlight = req.AdditionalProperties.Highlight
 	}
 
 	out.Properties, err = extractPropertiesRequest(req.Properties, scheme, req.ClassName)
@@ -84,7 +91,7 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 			return dto.GetParams{}, err
 		}
 
-		addProps, err := setAllCheapAdditionalPropsToTrue(class)
+		addProps, err := setAllCheapAdditionalPropsToTrue(class, out.TargetVector)
 		if err != nil {
 			return dto.GetParams{}, err
 		}
@@ -97,54 +104,24 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 		if hs.FusionType == pb.HybridSearchParams_FUSION_TYPE_RELATIVE_SCORE {
 			fusionType = common_filters.HybridRelativeScoreFusion
 		}
-		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: schema.LowercaseFirstLetterOfStrings(hs.Properties), Vector: hs.Vector, Alpha: float64(hs.Alpha), FusionAlgorithm: fusionType}
+		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: schema.LowercaseFirstLetterOfStrings(hs.Properties), Vector: hs.Vector, Alpha: float64(hs.Alpha), FusionAlgorithm: fusionType, Fuzziness: int(hs.Fuzziness), Prefix: hs.Prefix}
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
-		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore}
+		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore, Fuzziness: int(bm25.Fuzziness), Prefix: bm25.Prefix, Highlight: out.AdditionalProperties.Highlight}
 	}
 
 	if nv := req.NearVector; nv != nil {
-		out.NearVector = &searchparams.NearVector{
-			Vector: nv.Vector,
-		}
-
-		// The following business logic should not sit in the API. However, it is
-		// also part of the GraphQL API, so we need to duplicate it in order to get
-		// the same behavior
-		if nv.Distance != nil && nv.Certainty != nil {
-			return out, fmt.Errorf("near_vector: cannot provide distance and certainty")
-		}
-
-		if nv.Certainty != nil {
-			out.NearVector.Certainty = *nv.Certainty
-		}
-
-		if nv.Distance != nil {
-			out.NearVector.Distance = *nv.Distance
-			out.NearVector.WithDistance = true
+		out.NearVector, err = parseNearVector(nv)
+		if err != nil {
+			return out, err
 		}
 	}
 
 	if no := req.NearObject; no != nil {
-		out.NearObject = &searchparams.NearObject{
-			ID: req.NearObject.Id,
-		}
-
-		// The following business logic should not sit in the API. However, it is
-		// also part of the GraphQL API, so we need to duplicate it in order to get
-		// the same behavior
-		if no.Distance != nil && no.Certainty != nil {
-			return out, fmt.Errorf("near_object: cannot provide distance and certainty")
-		}
-
-		if no.Certainty != nil {
-			out.NearObject.Certainty = *no.Certainty
-		}
-
-		if no.Distance != nil {
-			out.NearObject.Distance = *no.Distance
-			out.NearObject.WithDistance = true
+		out.NearObject, err = parseNearObject(no)
+		if err != nil {
+			return out, err
 		}
 	}
 
@@ -191,29 +168,10 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 	}
 
 	if req.NearText != nil {
-		moveAwayOut, err := extractNearTextMove(req.ClassName, req.NearText.MoveAway)
+		nearText, err := parseNearText(req.ClassName, req.NearText, out.Pagination.Limit)
 		if err != nil {
 			return dto.GetParams{}, err
 		}
-		moveToOut, err := extractNearTextMove(req.ClassName, req.NearText.MoveTo)
-		if err != nil {
-			return dto.GetParams{}, err
-		}
-
-		nearText := &nearText2.NearTextParams{
-			Values:       req.NearText.Query,
-			Limit:        out.Pagination.Limit,
-			MoveAwayFrom: moveAwayOut,
-			MoveTo:       moveToOut,
-		}
-
-		if req.NearText.Certainty != nil {
-			nearText.Certainty = *req.NearText.Certainty
-		}
-		if req.NearText.Distance != nil {
-			nearText.Distance = *req.NearText.Distance
-			nearText.WithDistance = true
-		}
 		if out.ModuleParams == nil {
 			out.ModuleParams = make(map[string]interface{})
 		}
@@ -335,11 +293,15 @@ func extractNearTextMove(classname string, Move *pb.NearTextSearchParams_Move) (
 
 func extractFilters(filterIn *pb.Filters, scheme schema.Schema, className string) (filters.Clause, error) {
 	returnFilter := filters.Clause{}
-	if filterIn.Operator == pb.Filters_OPERATOR_AND || filterIn.Operator == pb.Filters_OPERATOR_OR {
-		if filterIn.Operator == pb.Filters_OPERATOR_AND {
+	if filterIn.Operator == pb.Filters_OPERATOR_AND || filterIn.Operator == pb.Filters_OPERATOR_OR ||
+		filterIn.Operator == pb.Filters_OPERATOR_NOT {
+		switch filterIn.Operator {
+		case pb.Filters_OPERATOR_AND:
 			returnFilter.Operator = filters.OperatorAnd
-		} else {
+		case pb.Filters_OPERATOR_OR:
 			returnFilter.Operator = filters.OperatorOr
+		default:
+			returnFilter.Operator = filters.OperatorNot
 		}
 
 		clauses := make([]filters.Clause, len(filterIn.Filters))
@@ -388,6 +350,8 @@ func extractFilters(filterIn *pb.Filters, scheme schema.Schema, className string
 			returnFilter.Operator = filters.ContainsAny
 		case pb.Filters_OPERATOR_CONTAINS_ALL:
 			returnFilter.Operator = filters.ContainsAll
+		case pb.Filters_OPERATOR_CONTAINS_NONE:
+			returnFilter.Operator = filters.ContainsNone
 
 		default:
 			return filters.Clause{}, fmt.Errorf("unknown filter operator %v", filterIn.Operator)
@@ -438,7 +402,8 @@ func extractFilters(filterIn *pb.Filters, scheme schema.Schema, className string
 		}
 
 		// correct type for containsXXX in case users send int for a float array
-		if (returnFilter.Operator == filters.ContainsAll || returnFilter.Operator == filters.ContainsAny) && dataType == schema.DataTypeNumber {
+		if (returnFilter.Operator == filters.ContainsAll || returnFilter.Operator == filters.ContainsAny ||
+			returnFilter.Operator == filters.ContainsNone) && dataType == schema.DataTypeNumber {
 			valSlice, ok := val.([]int)
 			if ok {
 				val64 := make([]float64, len(valSlice))
@@ -488,7 +453,7 @@ func extractDataType(scheme schema.Schema, operator filters.Operator, classname
 		dataType = schema.DataType(prop.DataType[0])
 	}
 
-	if operator == filters.ContainsAll || operator == filters.ContainsAny {
+	if operator == filters.ContainsAll || operator == filters.ContainsAny || operator == filters.ContainsNone {
 		if baseType, isArray := schema.IsArrayType(dataType); isArray {
 			return baseType, nil
 		}
@@ -564,7 +529,7 @@ func extractPropertiesRequest(reqProps *pb.Properties, scheme schema.Schema, cla
 				}
 
 				linkedClass := scheme.GetClass(schema.ClassName(linkedClassName))
-				metaData, err = setAllCheapAdditionalPropsToTrue(linkedClass)
+				metaData, err = setAllCheapAdditionalPropsToTrue(linkedClass, "")
 				if err != nil {
 					return nil, err
 				}
@@ -620,7 +585,47 @@ func getAllNonRefNonBlobProperties(scheme schema.Schema, className string) ([]se
 	return props, nil
 }
 
-func setAllCheapAdditionalPropsToTrue(class *models.Class) (additional.Properties, error) {
+// extractTargetVector collects the target vectors set on the search params of
+// the request. Only a single target vector is supported per search.
+func extractTargetVector(req *pb.SearchRequest) (string, error) {
+	var targetVectors []string
+	if req.NearVector != nil {
+		targetVectors = append(targetVectors, req.NearVector.TargetVectors...)
+	}
+	if req.NearObject != nil {
+		targetVectors = append(targetVectors, req.NearObject.TargetVectors...)
+	}
+	if req.HybridSearch != nil {
+		targetVectors = append(targetVectors, req.HybridSearch.TargetVectors...)
+	}
+	if req.NearText != nil {
+		targetVectors = append(targetVectors, req.NearText.TargetVectors...)
+	}
+	if req.NearImage != nil {
+		targetVectors = append(targetVectors, req.NearImage.TargetVectors...)
+	}
+	if req.NearAudio != nil {
+		targetVectors = append(targetVectors, req.NearAudio.TargetVectors...)
+	}
+	if req.NearVideo != nil {
+		targetVectors = append(targetVectors, req.NearVideo.TargetVectors...)
+	}
+
+	return singleTargetVector(targetVectors)
+}
+
+func singleTargetVector(targetVectors []string) (string, error) {
+	switch len(targetVectors) {
+	case 0:
+		return "", nil
+	case 1:
+		return targetVectors[0], nil
+	default:
+		return "", fmt.Errorf("multiple target vectors are not supported")
+	}
+}
+
+func setAllCheapAdditionalPropsToTrue(class *models.Class, targetVector string) (additional.Properties, error) {
 	out := additional.Properties{
 		ID:                 true,
 		Distance:           true,
@@ -631,19 +636,102 @@ func setAllCheapAdditionalPropsToTrue(class *models.Class) (additional.Propertie
 		Vector:             false, // can be expensive
 	}
 
+	targetVector, err := schema.ResolveTargetVector(class, targetVector)
+	if err != nil {
+		// without a vector index there is no certainty to return, an invalid
+		// target vector is reported by the search itself
+		return out, nil
+	}
+
 	// certainty is not compatible with dot distance
-	vectorIndex, err := hnsw.TypeAssertVectorIndex(class)
+	vectorIndex, err := schema.TypeAssertVectorIndex(class, targetVector)
 	if err != nil {
 		return out, err
 	}
 
-	if vectorIndex.Distance == hnsw.DistanceCosine {
+	if vectorIndex.DistanceName() == common.DistanceCosine {
 		out.Certainty = true
 	}
 
 	return out, nil
 }
 
+func parseNearVector(nv *pb.NearVectorParams) (*searchparams.NearVector, error) {
+	nearVectorOut := &searchparams.NearVector{
+		Vector: nv.Vector,
+	}
+
+	// The following business logic should not sit in the API. However, it is
+	// also part of the GraphQL API, so we need to duplicate it in order to get
+	// the same behavior
+	if nv.Distance != nil && nv.Certainty != nil {
+		return nil, fmt.Errorf("near_vector: cannot provide distance and certainty")
+	}
+
+	if nv.Certainty != nil {
+		nearVectorOut.Certainty = *nv.Certainty
+	}
+
+	if nv.Distance != nil {
+		nearVectorOut.Distance = *nv.Distance
+		nearVectorOut.WithDistance = true
+	}
+
+	return nearVectorOut, nil
+}
+
+func parseNearObject(no *pb.NearObjectParams) (*searchparams.NearObject, error) {
+	nearObjectOut := &searchparams.NearObject{
+		ID: no.Id,
+	}
+
+	// The following business logic should not sit in the API. However, it is
+	// also part of the GraphQL API, so we need to duplicate it in order to get
+	// the same behavior
+	if no.Distance != nil && no.Certainty != nil {
+		return nil, fmt.Errorf("near_object: cannot provide distance and certainty")
+	}
+
+	if no.Certainty != nil {
+		nearObjectOut.Certainty = *no.Certainty
+	}
+
+	if no.Distance != nil {
+		nearObjectOut.Distance = *no.Distance
+		nearObjectOut.WithDistance = true
+	}
+
+	return nearObjectOut, nil
+}
+
+func parseNearText(className string, nt *pb.NearTextSearchParams, limit int) (*nearText2.NearTextParams, error) {
+	moveAwayOut, err := extractNearTextMove(className, nt.MoveAway)
+	if err != nil {
+		return nil, err
+	}
+	moveToOut, err := extractNearTextMove(className, nt.MoveTo)
+	if err != nil {
+		return nil, err
+	}
+
+	nearTextOut := &nearText2.NearTextParams{
+		Values:       nt.Query,
+		Limit:        limit,
+		MoveAwayFrom: moveAwayOut,
+		MoveTo:       moveToOut,
+	}
+
+	if nt.Certainty != nil {
+		nearTextOut.Certainty = *nt.Certainty
+	}
+	if nt.Distance != nil {
+		nearTextOut.Distance = *nt.Distance
+		nearTextOut.WithDistance = true
+	}
+
+	return nearTextOut, nil
+}
+
 func parseNearImage(ni *pb.NearImageSearchParams) (*nearImage.NearImageParams, error) {
 	nearImageOut := &nearImage.NearImageParams{
 		Image: ni.Image,
@@ -271,6 +271,45 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "bm25 fuzzy prefix",
+			req: &pb.SearchRequest{
+				ClassName: classname, AdditionalProperties: &pb.AdditionalProperties{Vector: true},
+				Bm25Search: &pb.BM25SearchParams{Query: "qeury", Properties: []string{"name"}, Fuzziness: 1, Prefix: true},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: "qeury", Properties: []string{"name"}, Type: "bm25", Fuzziness: 1, Prefix: true},
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: true},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 highlight",
+			req: &pb.SearchRequest{
+				ClassName: classname, AdditionalProperties: &pb.AdditionalProperties{Highlight: true},
+				Bm25Search: &pb.BM25SearchParams{Query: "query", Properties: []string{"name"}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: "query", Properties: []string{"name"}, Type: "bm25", Highlight: true},
+				AdditionalProperties: additional.Properties{Highlight: true, NoProps: true},
+			},
+			error: false,
+		},
+		{
+			name: "hybrid fuzzy prefix",
+			req: &pb.SearchRequest{
+				ClassName: classname, AdditionalProperties: &pb.AdditionalProperties{Vector: true},
+				HybridSearch: &pb.HybridSearchParams{Query: "qeury", Fuzziness: 2, Prefix: true},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				HybridSearch:         &searchparams.HybridSearch{Query: "qeury", FusionAlgorithm: common_filters.HybridRankedFusion, Fuzziness: 2, Prefix: true},
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: true},
+			},
+			error: false,
+		},
 		{
 			name: "filter simple",
 			req: &pb.SearchRequest{
@@ -341,6 +380,44 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "filter not",
+			req: &pb.SearchRequest{
+				ClassName: classname, AdditionalProperties: &pb.AdditionalProperties{Vector: true},
+				Filters: &pb.Filters{Operator: pb.Filters_OPERATOR_NOT, Filters: []*pb.Filters{
+					{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: "test"}, On: []string{"name"}},
+				}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: true},
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorNot,
+						Operands: []filters.Clause{
+							{
+								Value:    &filters.Value{Value: "test", Type: schema.DataTypeText},
+								On:       &filters.Path{Class: schema.ClassName(classname), Property: "name"},
+								Operator: filters.OperatorEqual,
+							},
+						},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "filter not with multiple operands",
+			req: &pb.SearchRequest{
+				ClassName: classname, AdditionalProperties: &pb.AdditionalProperties{Vector: true},
+				Filters: &pb.Filters{Operator: pb.Filters_OPERATOR_NOT, Filters: []*pb.Filters{
+					{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: "test"}, On: []string{"name"}},
+					{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: "other"}, On: []string{"name"}},
+				}},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "filter reference",
 			req: &pb.SearchRequest{
@@ -493,6 +570,32 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "contains none filter with int value on float prop",
+			req: &pb.SearchRequest{
+				ClassName: classname, AdditionalProperties: &pb.AdditionalProperties{Vector: true},
+				Filters: &pb.Filters{
+					Operator:  pb.Filters_OPERATOR_CONTAINS_NONE,
+					TestValue: &pb.Filters_ValueIntArray{ValueIntArray: &pb.IntArray{Values: []int64{3}}},
+					On:        []string{"floats"},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: true},
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						On: &filters.Path{
+							Class:    schema.ClassName(classname),
+							Property: "floats",
+						},
+						Operator: filters.ContainsNone,
+						Value:    &filters.Value{Value: []float64{3}, Type: schema.DataTypeNumber},
+					},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "near text search",
 			req: &pb.SearchRequest{
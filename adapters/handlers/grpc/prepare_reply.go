@@ -12,6 +12,7 @@
 package grpc
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -176,6 +177,22 @@ func extractAdditionalProps(asMap map[string]any, additionalPropsParams addition
 		}
 	}
 
+	if len(additionalPropsParams.Vectors) > 0 {
+		vectors, ok := additionalPropertiesMap["vectors"]
+		if ok {
+			vectorsfmt, ok2 := vectors.(map[string][]float32)
+			if ok2 {
+				additionalProps.Vectors = make([]*pb.Vectors, 0, len(vectorsfmt))
+				for _, name := range additionalPropsParams.Vectors {
+					if vector, ok3 := vectorsfmt[name]; ok3 {
+						additionalProps.Vectors = append(additionalProps.Vectors,
+							&pb.Vectors{Name: name, Vector: vector})
+					}
+				}
+			}
+		}
+	}
+
 	if additionalPropsParams.Certainty {
 		additionalProps.CertaintyPresent = false
 		certainty, ok := additionalPropertiesMap["certainty"]
@@ -236,6 +253,19 @@ func extractAdditionalProps(asMap map[string]any, additionalPropsParams addition
 		}
 	}
 
+	if additionalPropsParams.Highlight {
+		highlights, ok := additionalPropertiesMap["highlight"].([]*additional.Highlight)
+		if ok {
+			additionalProps.Highlights = make([]*pb.Highlight, len(highlights))
+			for i, highlight := range highlights {
+				additionalProps.Highlights[i] = &pb.Highlight{
+					Property:  highlight.Property,
+					Fragments: highlight.Fragments,
+				}
+			}
+		}
+	}
+
 	if additionalPropsParams.Score {
 		additionalProps.ScorePresent = false
 		score, ok := additionalPropertiesMap["score"]
@@ -287,6 +317,7 @@ func extractGroup(raw any, searchParams dto.GetParams, scheme schema.Schema) (*p
 	searchParams.AdditionalProperties = additional.Properties{
 		ID:       searchParams.AdditionalProperties.ID,
 		Vector:   searchParams.AdditionalProperties.Vector,
+		Vectors:  searchParams.AdditionalProperties.Vectors,
 		Distance: searchParams.AdditionalProperties.Distance,
 	}
 
@@ -421,6 +452,12 @@ func extractArrayTypes(scheme schema.Schema, className string, props *pb.ResultP
 			}
 			props.BooleanArrayProperties = append(props.BooleanArrayProperties, &pb.BooleanArrayProperties{PropName: propName, Values: propBool})
 			delete(nonRefProps, propName)
+		case schema.DataTypeObject, schema.DataTypeObjectArray:
+			propNested, err := nestedToStructValue(prop)
+			if err != nil {
+				return fmt.Errorf("property %v with datatype %v: %w", propName, dataType, err)
+			}
+			nonRefProps[propName] = propNested
 		default:
 			_, isArray := schema.IsArrayType(*dataType)
 			if isArray {
@@ -432,3 +469,36 @@ func extractArrayTypes(scheme schema.Schema, className string, props *pb.ResultP
 	}
 	return nil
 }
+
+// nested objects are stored without their schema, so some of their values
+// might have been parsed into typed values (e.g. geo coordinates) which cannot
+// be part of a grpc struct. Turn them back into plain json values.
+func nestedToStructValue(prop interface{}) (interface{}, error) {
+	asJSON, err := json.Marshal(prop)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal nested value")
+	}
+	var out interface{}
+	if err := json.Unmarshal(asJSON, &out); err != nil {
+		return nil, errors.Wrap(err, "unmarshal nested value")
+	}
+	return out, nil
+}
+
+// toStruct converts anything with a JSON object representation, such as the
+// properties of an object or a module config, to a protobuf struct. Empty
+// values result in a nil struct.
+func toStruct(in interface{}) (*structpb.Struct, error) {
+	value, err := nestedToStructValue(in)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a JSON object, got %T", value)
+	}
+	return structpb.NewStruct(asMap)
+}
@@ -54,6 +54,7 @@ func TestGRPCReply(t *testing.T) {
 		IsConsistent:       true,
 	}}
 	truePointer := true
+	latitude, longitude := float32(1.5), float32(2.5)
 
 	refClass1 := "RefClass1"
 	refClass2 := "RefClass2"
@@ -68,6 +69,7 @@ func TestGRPCReply(t *testing.T) {
 						{Name: "other", DataType: []string{"int"}},
 						{Name: "age", DataType: []string{"int"}},
 						{Name: "nums", DataType: schema.DataTypeIntArray.PropString()},
+						{Name: "variant", DataType: schema.DataTypeObject.PropString()},
 						{Name: "ref", DataType: []string{refClass1}},
 						{Name: "multiRef", DataType: []string{refClass1, refClass2}},
 					},
@@ -188,6 +190,33 @@ func TestGRPCReply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "highlight",
+			res: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlight": []*additional.Highlight{
+							{Property: "title", Fragments: []string{"a <em>word</em>", "<em>words</em>"}},
+						},
+					},
+				},
+				map[string]interface{}{
+					"_additional": map[string]interface{}{},
+				},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{Highlight: true}},
+			outSearch: []*pb.SearchResult{
+				{
+					AdditionalProperties: &pb.ResultAdditionalProps{
+						Highlights: []*pb.Highlight{
+							{Property: "title", Fragments: []string{"a <em>word</em>", "<em>words</em>"}},
+						},
+					},
+					Properties: &pb.ResultProperties{},
+				},
+				{AdditionalProperties: &pb.ResultAdditionalProps{}, Properties: &pb.ResultProperties{}},
+			},
+		},
 		{
 			name: "primitive properties",
 			res: []interface{}{
@@ -247,6 +276,43 @@ func TestGRPCReply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "nested properties",
+			res: []interface{}{
+				map[string]interface{}{
+					"variant": map[string]interface{}{
+						"color": "red",
+						"sizes": []interface{}{"S", "M"},
+						"location": &models.GeoCoordinates{
+							Latitude:  &latitude,
+							Longitude: &longitude,
+						},
+					},
+				},
+			},
+			searchParams: dto.GetParams{
+				ClassName:  className,
+				Properties: search.SelectProperties{{Name: "variant", IsPrimitive: true}},
+			},
+			outSearch: []*pb.SearchResult{
+				{
+					AdditionalProperties: &pb.ResultAdditionalProps{},
+					Properties: &pb.ResultProperties{
+						ClassName: className,
+						NonRefProperties: newStruct(t, map[string]interface{}{
+							"variant": map[string]interface{}{
+								"color": "red",
+								"sizes": []interface{}{"S", "M"},
+								"location": map[string]interface{}{
+									"latitude":  1.5,
+									"longitude": 2.5,
+								},
+							},
+						}),
+					},
+				},
+			},
+		},
 		{
 			name: "primitive and ref properties",
 			res: []interface{}{
@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) SchemaGet(ctx context.Context, req *pb.SchemaGetRequest) (*pb.SchemaGetReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	scheme, err := s.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	out := &pb.SchemaGetReply{}
+	if scheme.Objects != nil {
+		out.Classes = make([]*pb.Class, len(scheme.Objects.Classes))
+		for i, class := range scheme.Objects.Classes {
+			out.Classes[i], err = classToProto(class)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	out.Took = float32(time.Since(before).Seconds())
+	return out, nil
+}
+
+func (s *Server) ClassGet(ctx context.Context, req *pb.ClassGetRequest) (*pb.ClassGetReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	class, err := s.schemaManager.GetClass(ctx, principal, req.ClassName)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+	if class == nil {
+		return nil, status.Errorf(codes.NotFound, "class %q: %v", req.ClassName, schemaManager.ErrNotFound)
+	}
+
+	out, err := classToProto(class)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ClassGetReply{
+		Class: out,
+		Took:  float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Server) ClassCreate(ctx context.Context, req *pb.ClassCreateRequest) (*pb.SchemaReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	class, err := classFromProto(req.Class)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.schemaManager.AddClass(ctx, principal, class); err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.SchemaReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) ClassUpdate(ctx context.Context, req *pb.ClassUpdateRequest) (*pb.SchemaReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	class, err := classFromProto(req.Class)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.schemaManager.UpdateClass(ctx, principal, class.Class, class); err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.SchemaReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) ClassDelete(ctx context.Context, req *pb.ClassDeleteRequest) (*pb.SchemaReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if err := s.schemaManager.DeleteClass(ctx, principal, req.ClassName); err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.SchemaReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) PropertyAdd(ctx context.Context, req *pb.PropertyAddRequest) (*pb.SchemaReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	prop, err := propertyFromProto(req.Property)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.schemaManager.AddClassProperty(ctx, principal, req.ClassName, prop); err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.SchemaReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) TenantsGet(ctx context.Context, req *pb.TenantsGetRequest) (*pb.TenantsGetReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	tenants, err := s.schemaManager.GetTenants(ctx, principal, req.ClassName)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.TenantsGetReply{
+		Tenants: tenantsToProto(tenants),
+		Took:    float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Server) TenantsAdd(ctx context.Context, req *pb.TenantsRequest) (*pb.SchemaReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	tenants, err := tenantsFromProto(req.Tenants)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := s.schemaManager.AddTenants(ctx, principal, req.ClassName, tenants); err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.SchemaReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) TenantsUpdate(ctx context.Context, req *pb.TenantsRequest) (*pb.SchemaReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	tenants, err := tenantsFromProto(req.Tenants)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.schemaManager.UpdateTenants(ctx, principal, req.ClassName, tenants); err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.SchemaReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Server) TenantsDelete(ctx context.Context, req *pb.TenantsDeleteRequest) (*pb.SchemaReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if err := s.schemaManager.DeleteTenants(ctx, principal, req.ClassName, req.Tenants); err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return &pb.SchemaReply{Took: float32(time.Since(before).Seconds())}, nil
+}
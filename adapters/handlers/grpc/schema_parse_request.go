@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func classFromProto(in *pb.Class) (*models.Class, error) {
+	if in == nil {
+		return nil, fmt.Errorf("no class given")
+	}
+
+	class := &models.Class{
+		Class:           in.ClassName,
+		Description:     in.Description,
+		Vectorizer:      in.Vectorizer,
+		VectorIndexType: in.VectorIndexType,
+		Properties:      make([]*models.Property, len(in.Properties)),
+	}
+	for i, prop := range in.Properties {
+		var err error
+		class.Properties[i], err = propertyFromProto(prop)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// configs without a fixed structure are passed on as they would be decoded
+	// from the JSON body of a REST request
+	class.VectorIndexConfig = mapFromStruct(in.VectorIndexConfig)
+	class.ModuleConfig = mapFromStruct(in.ModuleConfig)
+	class.ShardingConfig = mapFromStruct(in.ShardingConfig)
+
+	for name, cfg := range map[string]struct {
+		in  *structpb.Struct
+		out interface{}
+	}{
+		"vector_config":         {in.VectorConfig, &class.VectorConfig},
+		"inverted_index_config": {in.InvertedIndexConfig, &class.InvertedIndexConfig},
+		"replication_config":    {in.ReplicationConfig, &class.ReplicationConfig},
+		"multi_tenancy_config":  {in.MultiTenancyConfig, &class.MultiTenancyConfig},
+	} {
+		if err := fromStruct(cfg.in, cfg.out); err != nil {
+			return nil, errors.Wrap(err, name)
+		}
+	}
+
+	return class, nil
+}
+
+func propertyFromProto(in *pb.Property) (*models.Property, error) {
+	if in == nil {
+		return nil, fmt.Errorf("no property given")
+	}
+
+	prop := &models.Property{
+		Name:            in.Name,
+		DataType:        in.DataType,
+		Description:     in.Description,
+		Tokenization:    in.Tokenization,
+		IndexFilterable: in.IndexFilterable,
+		IndexSearchable: in.IndexSearchable,
+		ModuleConfig:    mapFromStruct(in.ModuleConfig),
+	}
+
+	if len(in.NestedProperties) > 0 {
+		nested, err := nestedPropertiesFromProto(in.NestedProperties)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property '%s'", in.Name)
+		}
+		prop.NestedProperties = nested
+	}
+
+	return prop, nil
+}
+
+func nestedPropertiesFromProto(in []*pb.Property) ([]*models.NestedProperty, error) {
+	out := make([]*models.NestedProperty, len(in))
+	for i, prop := range in {
+		if prop.ModuleConfig != nil {
+			return nil, fmt.Errorf("nested property '%s': module config is not supported", prop.Name)
+		}
+
+		out[i] = &models.NestedProperty{
+			Name:            prop.Name,
+			DataType:        prop.DataType,
+			Description:     prop.Description,
+			Tokenization:    prop.Tokenization,
+			IndexFilterable: prop.IndexFilterable,
+			IndexSearchable: prop.IndexSearchable,
+		}
+		if len(prop.NestedProperties) > 0 {
+			nested, err := nestedPropertiesFromProto(prop.NestedProperties)
+			if err != nil {
+				return nil, errors.Wrapf(err, "nested property '%s'", prop.Name)
+			}
+			out[i].NestedProperties = nested
+		}
+	}
+	return out, nil
+}
+
+func tenantsFromProto(in []*pb.Tenant) ([]*models.Tenant, error) {
+	out := make([]*models.Tenant, len(in))
+	for i, tenant := range in {
+		out[i] = &models.Tenant{Name: tenant.Name}
+		switch tenant.ActivityStatus {
+		case pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED:
+		case pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT:
+			out[i].ActivityStatus = models.TenantActivityStatusHOT
+		case pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD:
+			out[i].ActivityStatus = models.TenantActivityStatusCOLD
+		case pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_FROZEN:
+			out[i].ActivityStatus = models.TenantActivityStatusFROZEN
+		case pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_FREEZING:
+			out[i].ActivityStatus = models.TenantActivityStatusFREEZING
+		case pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNFREEZING:
+			out[i].ActivityStatus = models.TenantActivityStatusUNFREEZING
+		default:
+			return nil, fmt.Errorf("tenant '%s': unknown activity status %v",
+				tenant.Name, tenant.ActivityStatus)
+		}
+	}
+	return out, nil
+}
+
+func mapFromStruct(in *structpb.Struct) interface{} {
+	if in == nil {
+		return nil
+	}
+	return in.AsMap()
+}
+
+// fromStruct decodes a protobuf struct into a model with a fixed structure,
+// using the same JSON representation as the REST API
+func fromStruct(in *structpb.Struct, out interface{}) error {
+	if in == nil {
+		return nil
+	}
+	asJSON, err := json.Marshal(in.AsMap())
+	if err != nil {
+		return errors.Wrap(err, "marshal struct")
+	}
+	if err := json.Unmarshal(asJSON, out); err != nil {
+		return errors.Wrap(err, "unmarshal struct")
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestGRPCClassRequest(t *testing.T) {
+	vTrue := true
+	vFalse := false
+
+	tests := []struct {
+		name  string
+		in    *pb.Class
+		out   *models.Class
+		error bool
+	}{
+		{
+			name: "class with properties and configs",
+			in: &pb.Class{
+				ClassName:       "Article",
+				Description:     "some articles",
+				Vectorizer:      "none",
+				VectorIndexType: "hnsw",
+				VectorIndexConfig: newStruct(t, map[string]interface{}{
+					"distance": "cosine", "efConstruction": 64,
+				}),
+				ModuleConfig: newStruct(t, map[string]interface{}{
+					"text2vec-contextionary": map[string]interface{}{"vectorizeClassName": false},
+				}),
+				InvertedIndexConfig: newStruct(t, map[string]interface{}{"indexTimestamps": true}),
+				ReplicationConfig:   newStruct(t, map[string]interface{}{"factor": 3}),
+				MultiTenancyConfig:  newStruct(t, map[string]interface{}{"enabled": true}),
+				Properties: []*pb.Property{
+					{
+						Name: "title", DataType: schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWord, IndexFilterable: &vTrue,
+						IndexSearchable: &vFalse,
+						ModuleConfig: newStruct(t, map[string]interface{}{
+							"text2vec-contextionary": map[string]interface{}{"skip": true},
+						}),
+					},
+					{
+						Name: "meta", DataType: schema.DataTypeObject.PropString(),
+						NestedProperties: []*pb.Property{
+							{Name: "source", DataType: schema.DataTypeText.PropString()},
+							{
+								Name: "location", DataType: schema.DataTypeObject.PropString(),
+								NestedProperties: []*pb.Property{
+									{Name: "city", DataType: schema.DataTypeText.PropString()},
+								},
+							},
+						},
+					},
+				},
+			},
+			out: &models.Class{
+				Class:           "Article",
+				Description:     "some articles",
+				Vectorizer:      "none",
+				VectorIndexType: "hnsw",
+				VectorIndexConfig: map[string]interface{}{
+					"distance": "cosine", "efConstruction": float64(64),
+				},
+				ModuleConfig: map[string]interface{}{
+					"text2vec-contextionary": map[string]interface{}{"vectorizeClassName": false},
+				},
+				InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+				ReplicationConfig:   &models.ReplicationConfig{Factor: 3},
+				MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+				Properties: []*models.Property{
+					{
+						Name: "title", DataType: schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWord, IndexFilterable: &vTrue,
+						IndexSearchable: &vFalse,
+						ModuleConfig: map[string]interface{}{
+							"text2vec-contextionary": map[string]interface{}{"skip": true},
+						},
+					},
+					{
+						Name: "meta", DataType: schema.DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "source", DataType: schema.DataTypeText.PropString()},
+							{
+								Name: "location", DataType: schema.DataTypeObject.PropString(),
+								NestedProperties: []*models.NestedProperty{
+									{Name: "city", DataType: schema.DataTypeText.PropString()},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "no class",
+			error: true,
+		},
+		{
+			name: "module config on nested property",
+			in: &pb.Class{
+				ClassName: "Article",
+				Properties: []*pb.Property{
+					{
+						Name: "meta", DataType: schema.DataTypeObject.PropString(),
+						NestedProperties: []*pb.Property{
+							{
+								Name: "source", DataType: schema.DataTypeText.PropString(),
+								ModuleConfig: &structpb.Struct{},
+							},
+						},
+					},
+				},
+			},
+			error: true,
+		},
+		{
+			name: "config of wrong structure",
+			in: &pb.Class{
+				ClassName:         "Article",
+				ReplicationConfig: newStruct(t, map[string]interface{}{"factor": "three"}),
+			},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := classFromProto(tt.in)
+			if tt.error {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.out, out)
+			}
+		})
+	}
+}
+
+func TestGRPCTenantsRequest(t *testing.T) {
+	out, err := tenantsFromProto([]*pb.Tenant{
+		{Name: "tenant1"},
+		{Name: "tenant2", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT},
+		{Name: "tenant3", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD},
+		{Name: "tenant4", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_FROZEN},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*models.Tenant{
+		{Name: "tenant1"},
+		{Name: "tenant2", ActivityStatus: models.TenantActivityStatusHOT},
+		{Name: "tenant3", ActivityStatus: models.TenantActivityStatusCOLD},
+		{Name: "tenant4", ActivityStatus: models.TenantActivityStatusFROZEN},
+	}, out)
+
+	_, err = tenantsFromProto([]*pb.Tenant{{Name: "tenant1", ActivityStatus: 42}})
+	require.NotNil(t, err)
+}
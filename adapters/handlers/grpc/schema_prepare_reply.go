@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func classToProto(class *models.Class) (*pb.Class, error) {
+	out := &pb.Class{
+		ClassName:       class.Class,
+		Description:     class.Description,
+		Vectorizer:      class.Vectorizer,
+		VectorIndexType: class.VectorIndexType,
+		Properties:      make([]*pb.Property, len(class.Properties)),
+	}
+	for i, prop := range class.Properties {
+		var err error
+		out.Properties[i], err = propertyToProto(prop)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for name, cfg := range map[string]struct {
+		in  interface{}
+		out **structpb.Struct
+	}{
+		"vector_index_config":   {class.VectorIndexConfig, &out.VectorIndexConfig},
+		"vector_config":         {class.VectorConfig, &out.VectorConfig},
+		"module_config":         {class.ModuleConfig, &out.ModuleConfig},
+		"inverted_index_config": {class.InvertedIndexConfig, &out.InvertedIndexConfig},
+		"replication_config":    {class.ReplicationConfig, &out.ReplicationConfig},
+		"sharding_config":       {class.ShardingConfig, &out.ShardingConfig},
+		"multi_tenancy_config":  {class.MultiTenancyConfig, &out.MultiTenancyConfig},
+	} {
+		converted, err := toStruct(cfg.in)
+		if err != nil {
+			return nil, errors.Wrapf(err, "class '%s': %s", class.Class, name)
+		}
+		*cfg.out = converted
+	}
+
+	return out, nil
+}
+
+func propertyToProto(prop *models.Property) (*pb.Property, error) {
+	moduleConfig, err := toStruct(prop.ModuleConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "property '%s': module_config", prop.Name)
+	}
+
+	return &pb.Property{
+		Name:             prop.Name,
+		DataType:         prop.DataType,
+		Description:      prop.Description,
+		Tokenization:     prop.Tokenization,
+		IndexFilterable:  prop.IndexFilterable,
+		IndexSearchable:  prop.IndexSearchable,
+		NestedProperties: nestedPropertiesToProto(prop.NestedProperties),
+		ModuleConfig:     moduleConfig,
+	}, nil
+}
+
+func nestedPropertiesToProto(props []*models.NestedProperty) []*pb.Property {
+	if len(props) == 0 {
+		return nil
+	}
+
+	out := make([]*pb.Property, len(props))
+	for i, prop := range props {
+		out[i] = &pb.Property{
+			Name:             prop.Name,
+			DataType:         prop.DataType,
+			Description:      prop.Description,
+			Tokenization:     prop.Tokenization,
+			IndexFilterable:  prop.IndexFilterable,
+			IndexSearchable:  prop.IndexSearchable,
+			NestedProperties: nestedPropertiesToProto(prop.NestedProperties),
+		}
+	}
+	return out
+}
+
+func tenantsToProto(tenants []*models.Tenant) []*pb.Tenant {
+	// tenants are kept in a map, sort them for a stable reply
+	sort.Slice(tenants, func(i, j int) bool {
+		return tenants[i].Name < tenants[j].Name
+	})
+
+	out := make([]*pb.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		out[i] = &pb.Tenant{Name: tenant.Name}
+		switch tenant.ActivityStatus {
+		case models.TenantActivityStatusHOT:
+			out[i].ActivityStatus = pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT
+		case models.TenantActivityStatusCOLD:
+			out[i].ActivityStatus = pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD
+		case models.TenantActivityStatusFROZEN:
+			out[i].ActivityStatus = pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_FROZEN
+		case models.TenantActivityStatusFREEZING:
+			out[i].ActivityStatus = pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_FREEZING
+		case models.TenantActivityStatusUNFREEZING:
+			out[i].ActivityStatus = pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNFREEZING
+		default:
+			out[i].ActivityStatus = pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED
+		}
+	}
+	return out
+}
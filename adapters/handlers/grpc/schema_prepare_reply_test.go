@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestGRPCClassReply(t *testing.T) {
+	vTrue := true
+	class := &models.Class{
+		Class:               "Article",
+		Description:         "some articles",
+		Vectorizer:          "none",
+		VectorIndexType:     "flat",
+		VectorIndexConfig:   map[string]interface{}{"distance": "dot"},
+		InvertedIndexConfig: &models.InvertedIndexConfig{IndexNullState: true},
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		ShardingConfig:      sharding.Config{DesiredCount: 2},
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString(), IndexFilterable: &vTrue},
+			{
+				Name: "meta", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "source", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		},
+	}
+
+	out, err := classToProto(class)
+	require.Nil(t, err)
+
+	require.Equal(t, "Article", out.ClassName)
+	require.Equal(t, "some articles", out.Description)
+	require.Equal(t, "none", out.Vectorizer)
+	require.Equal(t, "flat", out.VectorIndexType)
+	require.Equal(t, newStruct(t, map[string]interface{}{"distance": "dot"}), out.VectorIndexConfig)
+	require.Equal(t, true, out.InvertedIndexConfig.AsMap()["indexNullState"])
+	require.Equal(t, newStruct(t, map[string]interface{}{"enabled": true}), out.MultiTenancyConfig)
+	require.Equal(t, float64(2), out.ShardingConfig.AsMap()["desiredCount"])
+	require.Nil(t, out.ModuleConfig)
+	require.Nil(t, out.ReplicationConfig)
+	require.Nil(t, out.VectorConfig)
+	require.Equal(t, []*pb.Property{
+		{Name: "title", DataType: schema.DataTypeText.PropString(), IndexFilterable: &vTrue},
+		{
+			Name: "meta", DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*pb.Property{
+				{Name: "source", DataType: schema.DataTypeText.PropString()},
+			},
+		},
+	}, out.Properties)
+
+	t.Run("converting back results in the same class", func(t *testing.T) {
+		back, err := classFromProto(out)
+		require.Nil(t, err)
+		require.Equal(t, class.Properties, back.Properties)
+		require.Equal(t, class.InvertedIndexConfig, back.InvertedIndexConfig)
+		require.Equal(t, class.MultiTenancyConfig, back.MultiTenancyConfig)
+		require.Equal(t, class.VectorIndexConfig, back.VectorIndexConfig)
+	})
+}
+
+func TestGRPCTenantsReply(t *testing.T) {
+	out := tenantsToProto([]*models.Tenant{
+		{Name: "tenant2", ActivityStatus: models.TenantActivityStatusCOLD},
+		{Name: "tenant1", ActivityStatus: models.TenantActivityStatusHOT},
+		{Name: "tenant3", ActivityStatus: models.TenantActivityStatusFREEZING},
+	})
+	require.Equal(t, []*pb.Tenant{
+		{Name: "tenant1", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT},
+		{Name: "tenant2", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD},
+		{Name: "tenant3", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_FREEZING},
+	}, out)
+}
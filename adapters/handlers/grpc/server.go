@@ -46,6 +46,7 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		allowAnonymousAccess: state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		schemaManager:        state.SchemaManager,
 		batchManager:         state.BatchManager,
+		objectsManager:       state.ObjectsManager,
 	})
 
 	return &GRPCServer{s}
@@ -77,6 +78,7 @@ type Server struct {
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
 	batchManager         *objects.BatchManager
+	objectsManager       *objects.Manager
 }
 
 func (s *Server) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest) (*pb.BatchObjectsReply, error) {
@@ -173,6 +175,60 @@ func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchR
 	return res.Result, res.Error
 }
 
+func (s *Server) Aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	scheme := s.schemaManager.GetSchemaSkipAuth()
+
+	type reply struct {
+		Result *pb.AggregateReply
+		Error  error
+	}
+
+	c := make(chan reply, 1)
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				c <- reply{
+					Result: nil,
+					Error:  fmt.Errorf("panic occurred: %v", err),
+				}
+			}
+		}()
+
+		params, err := aggregateParamsFromProto(req, scheme)
+		if err != nil {
+			c <- reply{
+				Result: nil,
+				Error:  fmt.Errorf("extract params: %w", err),
+			}
+			return
+		}
+
+		res, err := s.traverser.Aggregate(ctx, principal, params)
+		if err != nil {
+			c <- reply{
+				Result: nil,
+				Error:  err,
+			}
+			return
+		}
+
+		proto, err := aggregateResultsToProto(res, before, req, scheme)
+		c <- reply{
+			Result: proto,
+			Error:  err,
+		}
+	}()
+	res := <-c
+	return res.Result, res.Error
+}
+
 func (s *Server) validateClassAndProperty(searchParams dto.GetParams) error {
 	scheme := s.schemaManager.GetSchemaSkipAuth()
 	class, err := schema.GetClassByName(scheme.Objects, searchParams.ClassName)
@@ -0,0 +1,25 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import "github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+
+// apiKeys replicates the api keys which were created at runtime
+type apiKeys struct {
+	txHandler
+}
+
+func NewAPIKeys(manager txManager, auth auth) *apiKeys {
+	return &apiKeys{txHandler{
+		manager: manager, auth: auth, unmarshal: apikey.UnmarshalTransaction,
+	}}
+}
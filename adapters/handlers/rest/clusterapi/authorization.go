@@ -0,0 +1,25 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import "github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+
+// authorization replicates the rbac policy
+type authorization struct {
+	txHandler
+}
+
+func NewAuthorization(manager txManager, auth auth) *authorization {
+	return &authorization{txHandler{
+		manager: manager, auth: auth, unmarshal: rbac.UnmarshalTransaction,
+	}}
+}
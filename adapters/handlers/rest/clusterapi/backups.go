@@ -28,13 +28,18 @@ type backupManager interface {
 	OnStatus(ctx context.Context, req *backup.StatusRequest) *backup.StatusResponse
 }
 
+type backupScheduler interface {
+	OnCancel(ctx context.Context, req *backup.AbortRequest) error
+}
+
 type backups struct {
-	manager backupManager
-	auth    auth
+	manager   backupManager
+	scheduler backupScheduler
+	auth      auth
 }
 
-func NewBackups(manager backupManager, auth auth) *backups {
-	return &backups{manager: manager, auth: auth}
+func NewBackups(manager backupManager, scheduler backupScheduler, auth auth) *backups {
+	return &backups{manager: manager, scheduler: scheduler, auth: auth}
 }
 
 func (b *backups) CanCommit() http.Handler {
@@ -125,6 +130,33 @@ func (b *backups) Abort() http.Handler {
 	})
 }
 
+func (b *backups) Cancel() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("read request body: %w", err).Error(), status)
+			return
+		}
+		defer r.Body.Close()
+
+		var req backup.AbortRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("unmarshal request: %w", err).Error(), status)
+			return
+		}
+
+		if err := b.scheduler.OnCancel(r.Context(), &req); err != nil {
+			status := http.StatusNotFound
+			http.Error(w, fmt.Errorf("cancel: %w", err).Error(), status)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (b *backups) Status() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		body, err := io.ReadAll(r.Body)
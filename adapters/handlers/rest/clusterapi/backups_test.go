@@ -13,6 +13,7 @@ package clusterapi_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -30,10 +31,12 @@ func TestInternalBackupsAPI(t *testing.T) {
 		{
 			name:          "node1",
 			backupManager: &fakeBackupManager{},
+			scheduler:     &fakeBackupScheduler{},
 		},
 		{
 			name:          "node2",
 			backupManager: &fakeBackupManager{},
+			scheduler:     &fakeBackupScheduler{},
 		},
 	}
 	hosts := setupClusterAPI(t, nodes)
@@ -44,6 +47,9 @@ func TestInternalBackupsAPI(t *testing.T) {
 		node.backupManager.On("OnCommit", &backup.StatusRequest{}).Return(nil)
 		node.backupManager.On("OnAbort", &backup.AbortRequest{}).Return(nil)
 	}
+	cancelReq := &backup.AbortRequest{Method: backup.OpCreate, ID: "backup1"}
+	nodes[0].scheduler.On("OnCancel", cancelReq).Return(nil)
+	nodes[1].scheduler.On("OnCancel", cancelReq).Return(errors.New("not running"))
 
 	coord := newFakeCoordinator(newFakeNodeResolver(hosts))
 
@@ -56,18 +62,26 @@ func TestInternalBackupsAPI(t *testing.T) {
 		err := coord.Backup(context.Background(), &backup.Request{Method: backup.OpCreate}, true)
 		require.Nil(t, err)
 	})
+
+	t.Run("cancel", func(t *testing.T) {
+		client := clients.NewClusterBackups(&http.Client{})
+		require.Nil(t, client.Cancel(context.Background(), hosts["node1"], cancelReq))
+		require.NotNil(t, client.Cancel(context.Background(), hosts["node2"], cancelReq))
+	})
 }
 
 func setupClusterAPI(t *testing.T, nodes []*backupNode) map[string]string {
 	hosts := make(map[string]string)
 
 	for _, node := range nodes {
-		backupsHandler := clusterapi.NewBackups(node.backupManager, clusterapi.NewNoopAuthHandler())
+		backupsHandler := clusterapi.NewBackups(node.backupManager, node.scheduler,
+			clusterapi.NewNoopAuthHandler())
 
 		mux := http.NewServeMux()
 		mux.Handle("/backups/can-commit", backupsHandler.CanCommit())
 		mux.Handle("/backups/commit", backupsHandler.Commit())
 		mux.Handle("/backups/abort", backupsHandler.Abort())
+		mux.Handle("/backups/cancel", backupsHandler.Cancel())
 		mux.Handle("/backups/status", backupsHandler.Status())
 		server := httptest.NewServer(mux)
 
@@ -83,6 +97,7 @@ func setupClusterAPI(t *testing.T, nodes []*backupNode) map[string]string {
 type backupNode struct {
 	name          string
 	backupManager *fakeBackupManager
+	scheduler     *fakeBackupScheduler
 }
 
 func newFakeNodeResolver(hosts map[string]string) *fakeNodeResolver {
@@ -178,3 +193,12 @@ func (m *fakeBackupManager) OnStatus(ctx context.Context, req *backup.StatusRequ
 	args := m.Called(req)
 	return args.Get(0).(*backup.StatusResponse)
 }
+
+type fakeBackupScheduler struct {
+	mock.Mock
+}
+
+func (m *fakeBackupScheduler) OnCancel(ctx context.Context, req *backup.AbortRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
@@ -11,10 +11,14 @@
 
 package clusterapi
 
+import "github.com/weaviate/weaviate/usecases/classification"
+
 type classifications struct {
 	txHandler
 }
 
 func NewClassifications(manager txManager, auth auth) *classifications {
-	return &classifications{txHandler{manager: manager, auth: auth}}
+	return &classifications{txHandler{
+		manager: manager, auth: auth, unmarshal: classification.UnmarshalTransaction,
+	}}
 }
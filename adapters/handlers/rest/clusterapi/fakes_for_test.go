@@ -77,7 +77,11 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
-func dummyParseVectorConfig(in interface{}) (schemaent.VectorIndexConfig, error) {
+func (f fakeVectorConfig) DistanceName() string {
+	return "fake"
+}
+
+func dummyParseVectorConfig(in interface{}, vectorIndexType string) (schemaent.VectorIndexConfig, error) {
 	return fakeVectorConfig(in.(map[string]interface{})), nil
 }
 
@@ -202,6 +206,14 @@ func (n *NilMigrator) GetShardsStatus(ctx context.Context, className string) (ma
 	return nil, nil
 }
 
+func (n *NilMigrator) TenantTransitions(className string) map[string]string {
+	return nil
+}
+
+func (n *NilMigrator) UpdateShardingState(ctx context.Context, className string, state *sharding.State) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string) error {
 	return nil
 }
@@ -214,6 +226,10 @@ func (n *NilMigrator) NewTenants(ctx context.Context, class *models.Class, creat
 	return nil, nil
 }
 
+func (n *NilMigrator) PrepareUpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) (commit func(success bool), err error) {
 	return nil, nil
 }
@@ -242,6 +258,10 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfigs(ctx context.Context, className string, updated map[string]schemaent.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context, old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
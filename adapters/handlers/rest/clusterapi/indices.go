@@ -19,12 +19,14 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	entschema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -36,6 +38,7 @@ import (
 type indices struct {
 	shards                    shards
 	db                        db
+	schema                    schemaGetter
 	auth                      auth
 	regexpObjects             *regexp.Regexp
 	regexpObjectsOverwrite    *regexp.Regexp
@@ -46,6 +49,7 @@ type indices struct {
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
+	regexpTenantsLastUsed     *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
 	regexpShard               *regexp.Regexp
 	regexpShardReinit         *regexp.Regexp
@@ -74,6 +78,8 @@ const (
 		`\/shards\/(` + sh + `)\/references`
 	urlPatternShardsStatus = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/status`
+	urlPatternTenantsLastUsed = `\/indices\/(` + cl + `)` +
+		`\/shards:last-used`
 	urlPatternShardFiles = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/files/(.*)`
 	urlPatternShard = `\/indices\/(` + cl + `)` +
@@ -101,7 +107,7 @@ type shards interface {
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
 		id []strfmt.UUID) ([]*storobj.Object, error)
 	Search(ctx context.Context, indexName, shardName string,
-		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
+		vector []float32, targetVector string, distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties,
@@ -115,6 +121,8 @@ type shards interface {
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string) error
+	TenantsLastUsed(ctx context.Context, indexName string,
+		shardNames []string) (map[string]time.Time, error)
 
 	// Replication-specific
 	OverwriteObjects(ctx context.Context, indexName, shardName string,
@@ -133,7 +141,18 @@ type db interface {
 	StartupComplete() bool
 }
 
-func NewIndices(shards shards, db db, auth auth) *indices {
+type schemaGetter interface {
+	GetSchemaSkipAuth() entschema.Schema
+}
+
+// classOf returns the schema of the given class, incoming objects are parsed
+// with it so that their properties get the types of the class
+func classOf(sg schemaGetter, className string) *models.Class {
+	sch := sg.GetSchemaSkipAuth()
+	return sch.GetClass(entschema.ClassName(className))
+}
+
+func NewIndices(shards shards, db db, schema schemaGetter, auth auth) *indices {
 	return &indices{
 		regexpObjects:             regexp.MustCompile(urlPatternObjects),
 		regexpObjectsOverwrite:    regexp.MustCompile(urlPatternObjectsOverwrite),
@@ -144,11 +163,13 @@ func NewIndices(shards shards, db db, auth auth) *indices {
 		regexpObject:              regexp.MustCompile(urlPatternObject),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShardsStatus:        regexp.MustCompile(urlPatternShardsStatus),
+		regexpTenantsLastUsed:     regexp.MustCompile(urlPatternTenantsLastUsed),
 		regexpShardFiles:          regexp.MustCompile(urlPatternShardFiles),
 		regexpShard:               regexp.MustCompile(urlPatternShard),
 		regexpShardReinit:         regexp.MustCompile(urlPatternShardReinit),
 		shards:                    shards,
 		db:                        db,
+		schema:                    schema,
 		auth:                      auth,
 	}
 }
@@ -251,6 +272,15 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case i.regexpTenantsLastUsed.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postTenantsLastUsed().ServeHTTP(w, r)
+			return
+
 		case i.regexpShardFiles.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.postShardFile().ServeHTTP(w, r)
@@ -320,7 +350,7 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes)
+	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes, classOf(i.schema, index))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -343,7 +373,7 @@ func (i *indices) postObjectBatch(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes)
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes, classOf(i.schema, index))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -594,7 +624,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, err := IndicesPayloads.SearchParams.
+		vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -603,7 +633,7 @@ func (i *indices) postSearchObjects() http.Handler {
 		}
 
 		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+			vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -923,6 +953,54 @@ func (i *indices) getGetShardStatus() http.Handler {
 	})
 }
 
+func (i *indices) postTenantsLastUsed() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpTenantsLastUsed.FindStringSubmatch(r.URL.Path)
+		if len(args) != 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index := args[1]
+
+		defer r.Body.Close()
+
+		ct, ok := IndicesPayloads.TenantsLastUsedParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		shardNames, err := IndicesPayloads.TenantsLastUsedParams.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		lastUsed, err := i.shards.TenantsLastUsed(r.Context(), index, shardNames)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := IndicesPayloads.TenantsLastUsedResults.Marshal(lastUsed)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.TenantsLastUsedResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
 func (i *indices) postUpdateShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
@@ -19,11 +19,13 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -53,6 +55,9 @@ type indicesPayloads struct {
 	UpdateShardsStatusResults updateShardsStatusResultsPayload
 	ShardFiles                shardFilesPayload
 	IncreaseReplicationFactor increaseReplicationFactorPayload
+	CopyShards                copyShardsPayload
+	TenantsLastUsedParams     tenantsLastUsedParamsPayload
+	TenantsLastUsedResults    tenantsLastUsedResultsPayload
 }
 
 type increaseReplicationFactorPayload struct{}
@@ -79,6 +84,30 @@ func (p increaseReplicationFactorPayload) Unmarshal(in []byte) (scaler.ShardDist
 	return pay.ShardDist, nil
 }
 
+type copyShardsPayload struct{}
+
+func (p copyShardsPayload) Marshall(dist scaler.CopyDist) ([]byte, error) {
+	type payload struct {
+		CopyDist scaler.CopyDist `json:"copy_distribution"`
+	}
+
+	pay := payload{CopyDist: dist}
+	return json.Marshal(pay)
+}
+
+func (p copyShardsPayload) Unmarshal(in []byte) (scaler.CopyDist, error) {
+	type payload struct {
+		CopyDist scaler.CopyDist `json:"copy_distribution"`
+	}
+
+	pay := payload{}
+	if err := json.Unmarshal(in, &pay); err != nil {
+		return nil, fmt.Errorf("unmarshal copy shards payload: %w", err)
+	}
+
+	return pay.CopyDist, nil
+}
+
 type errorListPayload struct{}
 
 func (e errorListPayload) MIME() string {
@@ -147,8 +176,10 @@ func (p singleObjectPayload) Marshal(in *storobj.Object) ([]byte, error) {
 	return in.MarshalBinary()
 }
 
-func (p singleObjectPayload) Unmarshal(in []byte) (*storobj.Object, error) {
-	return storobj.FromBinary(in)
+// Unmarshal parses the object with the schema of its class, class may be nil
+// if it is unknown to this node
+func (p singleObjectPayload) Unmarshal(in []byte, class *models.Class) (*storobj.Object, error) {
+	return storobj.FromBinaryWithClass(in, class)
 }
 
 type objectListPayload struct{}
@@ -194,7 +225,9 @@ func (p objectListPayload) Marshal(in []*storobj.Object) ([]byte, error) {
 	return out, nil
 }
 
-func (p objectListPayload) Unmarshal(in []byte) ([]*storobj.Object, error) {
+// Unmarshal parses the objects with the schema of their class, class may be
+// nil if it is unknown to this node
+func (p objectListPayload) Unmarshal(in []byte, class *models.Class) ([]*storobj.Object, error) {
 	var out []*storobj.Object
 
 	reusableLengthBuf := make([]byte, 8)
@@ -215,7 +248,7 @@ func (p objectListPayload) Unmarshal(in []byte) ([]*storobj.Object, error) {
 			return nil, err
 		}
 
-		obj, err := storobj.FromBinary(payloadBytes)
+		obj, err := storobj.FromBinaryWithClass(payloadBytes, class)
 		if err != nil {
 			return nil, err
 		}
@@ -340,13 +373,14 @@ func (p mergeDocPayload) Unmarshal(in []byte) (objects.MergeDocument, error) {
 
 type searchParamsPayload struct{}
 
-func (p searchParamsPayload) Marshal(vector []float32, limit int,
+func (p searchParamsPayload) Marshal(vector []float32, targetVector string, limit int,
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	addP additional.Properties,
 ) ([]byte, error) {
 	type params struct {
 		SearchVector   []float32                    `json:"searchVector"`
+		TargetVector   string                       `json:"targetVector"`
 		Limit          int                          `json:"limit"`
 		Filters        *filters.LocalFilter         `json:"filters"`
 		KeywordRanking *searchparams.KeywordRanking `json:"keywordRanking"`
@@ -356,16 +390,17 @@ func (p searchParamsPayload) Marshal(vector []float32, limit int,
 		Additional     additional.Properties        `json:"additional"`
 	}
 
-	par := params{vector, limit, filter, keywordRanking, sort, cursor, groupBy, addP}
+	par := params{vector, targetVector, limit, filter, keywordRanking, sort, cursor, groupBy, addP}
 	return json.Marshal(par)
 }
 
-func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
+func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, string, float32, int,
 	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
 	*filters.Cursor, *searchparams.GroupBy, additional.Properties, error,
 ) {
 	type searchParametersPayload struct {
 		SearchVector   []float32                    `json:"searchVector"`
+		TargetVector   string                       `json:"targetVector"`
 		Distance       float32                      `json:"distance"`
 		Limit          int                          `json:"limit"`
 		Filters        *filters.LocalFilter         `json:"filters"`
@@ -377,7 +412,7 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
 	}
 	var par searchParametersPayload
 	err := json.Unmarshal(in, &par)
-	return par.SearchVector, par.Distance, par.Limit,
+	return par.SearchVector, par.TargetVector, par.Distance, par.Limit,
 		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, err
 }
 
@@ -396,13 +431,13 @@ func (p searchParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
 
 type searchResultsPayload struct{}
 
-func (p searchResultsPayload) Unmarshal(in []byte) ([]*storobj.Object, []float32, error) {
+func (p searchResultsPayload) Unmarshal(in []byte, class *models.Class) ([]*storobj.Object, []float32, error) {
 	read := uint64(0)
 
 	objsLength := binary.LittleEndian.Uint64(in[read : read+8])
 	read += 8
 
-	objs, err := IndicesPayloads.ObjectList.Unmarshal(in[read : read+objsLength])
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(in[read:read+objsLength], class)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -709,6 +744,56 @@ func (p getShardStatusResultsPayload) CheckContentTypeHeader(r *http.Response) (
 	return ct, ct == p.MIME()
 }
 
+type tenantsLastUsedParamsPayload struct{}
+
+func (p tenantsLastUsedParamsPayload) Marshal(shardNames []string) ([]byte, error) {
+	return json.Marshal(shardNames)
+}
+
+func (p tenantsLastUsedParamsPayload) Unmarshal(in []byte) ([]string, error) {
+	var out []string
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p tenantsLastUsedParamsPayload) MIME() string {
+	return "application/vnd.weaviate.tenantslastusedparams+json"
+}
+
+func (p tenantsLastUsedParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p tenantsLastUsedParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+type tenantsLastUsedResultsPayload struct{}
+
+func (p tenantsLastUsedResultsPayload) Marshal(in map[string]time.Time) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p tenantsLastUsedResultsPayload) Unmarshal(in []byte) (map[string]time.Time, error) {
+	var out map[string]time.Time
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p tenantsLastUsedResultsPayload) MIME() string {
+	return "application/vnd.weaviate.tenantslastusedresults+json"
+}
+
+func (p tenantsLastUsedResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p tenantsLastUsedResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
 type updateShardStatusParamsPayload struct{}
 
 func (p updateShardStatusParamsPayload) Marshal(targetStatus string) ([]byte, error) {
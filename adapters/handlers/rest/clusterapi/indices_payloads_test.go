@@ -86,7 +86,7 @@ func Test_objectListPayload_Marshal(t *testing.T) {
 	b, err := payload.Marshal(objs)
 	require.Nil(t, err)
 
-	received, err := payload.Unmarshal(b)
+	received, err := payload.Unmarshal(b, nil)
 	require.Nil(t, err)
 	assert.Len(t, received, 2)
 	assert.EqualValues(t, objs[0].Object, received[0].Object)
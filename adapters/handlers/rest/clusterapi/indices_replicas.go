@@ -59,11 +59,14 @@ type replicator interface {
 type localScaler interface {
 	LocalScaleOut(ctx context.Context, className string,
 		dist scaler.ShardDist) error
+	LocalCopy(ctx context.Context, className string,
+		dist scaler.CopyDist) error
 }
 
 type replicatedIndices struct {
 	shards replicator
 	scaler localScaler
+	schema schemaGetter
 	auth   auth
 }
 
@@ -80,14 +83,19 @@ var (
 		`\/shards\/(` + sh + `)\/objects/references`)
 	regxIncreaseRepFactor = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/replication-factor:increase`)
+	regxCopyShards = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
+		`\/shards:copy`)
 	regxCommitPhase = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `):(commit|abort)`)
 )
 
-func NewReplicatedIndices(shards replicator, scaler localScaler, auth auth) *replicatedIndices {
+func NewReplicatedIndices(shards replicator, scaler localScaler,
+	schema schemaGetter, auth auth,
+) *replicatedIndices {
 	return &replicatedIndices{
 		shards: shards,
 		scaler: scaler,
+		schema: schema,
 		auth:   auth,
 	}
 }
@@ -170,6 +178,15 @@ func (i *replicatedIndices) indicesHandler() http.HandlerFunc {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case regxCopyShards.MatchString(path):
+			if r.Method == http.MethodPut {
+				i.copyShards().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case regxCommitPhase.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.executeCommitPhase().ServeHTTP(w, r)
@@ -259,6 +276,37 @@ func (i *replicatedIndices) increaseReplicationFactor() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) copyShards() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxCopyShards.FindStringSubmatch(r.URL.Path)
+		if len(args) != 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index := args[1]
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		dist, err := IndicesPayloads.CopyShards.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if err := i.scaler.LocalCopy(r.Context(), index, dist); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (i *replicatedIndices) postObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxObjects.FindStringSubmatch(r.URL.Path)
@@ -509,7 +557,7 @@ func (i *replicatedIndices) postObjectSingle(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes)
+	obj, err := IndicesPayloads.SingleObject.Unmarshal(bodyBytes, classOf(i.schema, index))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -540,7 +588,7 @@ func (i *replicatedIndices) postObjectBatch(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes)
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(bodyBytes, classOf(i.schema, index))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -26,18 +26,25 @@ type nodesManager interface {
 	GetNodeStatus(ctx context.Context, className string) (*models.NodeStatus, error)
 }
 
+// drainer marks this node as being drained
+type drainer interface {
+	SetDraining(draining bool) error
+}
+
 type nodes struct {
 	nodesManager nodesManager
+	drainer      drainer
 	auth         auth
 }
 
-func NewNodes(manager nodesManager, auth auth) *nodes {
-	return &nodes{nodesManager: manager, auth: auth}
+func NewNodes(manager nodesManager, drainer drainer, auth auth) *nodes {
+	return &nodes{nodesManager: manager, drainer: drainer, auth: auth}
 }
 
 var (
-	regxNodes      = regexp.MustCompile(`/status`)
-	regxNodesClass = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
+	regxNodes         = regexp.MustCompile(`/status`)
+	regxNodesClass    = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
+	regxNodesDraining = regexp.MustCompile(`/draining$`)
 )
 
 func (s *nodes) Nodes() http.Handler {
@@ -57,6 +64,15 @@ func (s *nodes) nodesHandler() http.HandlerFunc {
 
 			s.incomingNodeStatus().ServeHTTP(w, r)
 			return
+		case regxNodesDraining.MatchString(path):
+			if r.Method != http.MethodPut && r.Method != http.MethodDelete {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingSetDraining().ServeHTTP(w, r)
+			return
 		default:
 			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 			return
@@ -96,3 +112,17 @@ func (s *nodes) incomingNodeStatus() http.Handler {
 		w.Write(nodeStatusBytes)
 	})
 }
+
+func (s *nodes) incomingSetDraining() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		if err := s.drainer.SetDraining(r.Method == http.MethodPut); err != nil {
+			http.Error(w, "/nodes/draining: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -11,10 +11,12 @@
 
 package clusterapi
 
+import ucs "github.com/weaviate/weaviate/usecases/schema"
+
 type schema struct {
 	txHandler
 }
 
 func NewSchema(manager txManager, auth auth) *schema {
-	return &schema{txHandler{manager: manager, auth: auth}}
+	return &schema{txHandler{manager: manager, auth: auth, unmarshal: ucs.UnmarshalTransaction}}
 }
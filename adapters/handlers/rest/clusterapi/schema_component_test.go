@@ -187,6 +187,12 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 	return nil, nil
 }
 
+func (f *fakeScaleOutManager) Move(ctx context.Context,
+	className string, moves []sharding.ReplicaMove,
+) (*sharding.State, error) {
+	return nil, nil
+}
+
 func (f *fakeScaleOutManager) SetSchemaManager(sm scaler.SchemaManager) {
 }
 
@@ -28,11 +28,12 @@ func Serve(appState *state.State) {
 		Debugf("serving cluster api on port %d", port)
 
 	schema := NewSchema(appState.SchemaManager.TxManager(), auth)
-	indices := NewIndices(appState.RemoteIndexIncoming, appState.DB, auth)
-	replicatedIndices := NewReplicatedIndices(appState.RemoteReplicaIncoming, appState.Scaler, auth)
+	indices := NewIndices(appState.RemoteIndexIncoming, appState.DB, appState.SchemaManager, auth)
+	replicatedIndices := NewReplicatedIndices(appState.RemoteReplicaIncoming, appState.Scaler,
+		appState.SchemaManager, auth)
 	classifications := NewClassifications(appState.ClassificationRepo.TxManager(), auth)
-	nodes := NewNodes(appState.RemoteNodeIncoming, auth)
-	backups := NewBackups(appState.BackupManager, auth)
+	nodes := NewNodes(appState.RemoteNodeIncoming, appState.Cluster, auth)
+	backups := NewBackups(appState.BackupManager, appState.BackupScheduler, auth)
 
 	mux := http.NewServeMux()
 	mux.Handle("/schema/transactions/",
@@ -40,6 +41,17 @@ func Serve(appState *state.State) {
 	mux.Handle("/classifications/transactions/",
 		http.StripPrefix("/classifications/transactions/",
 			classifications.Transactions()))
+	if appState.AuthorizationRepo != nil {
+		authorization := NewAuthorization(appState.AuthorizationRepo.TxManager(), auth)
+		mux.Handle("/authorization/transactions/",
+			http.StripPrefix("/authorization/transactions/",
+				authorization.Transactions()))
+	}
+	if appState.APIKeysRepo != nil {
+		apiKeys := NewAPIKeys(appState.APIKeysRepo.TxManager(), auth)
+		mux.Handle("/apikeys/transactions/",
+			http.StripPrefix("/apikeys/transactions/", apiKeys.Transactions()))
+	}
 
 	mux.Handle("/nodes/", nodes.Nodes())
 	mux.Handle("/indices/", indices.Indices())
@@ -48,6 +60,7 @@ func Serve(appState *state.State) {
 	mux.Handle("/backups/can-commit", backups.CanCommit())
 	mux.Handle("/backups/commit", backups.Commit())
 	mux.Handle("/backups/abort", backups.Abort())
+	mux.Handle("/backups/cancel", backups.Cancel())
 	mux.Handle("/backups/status", backups.Status())
 
 	mux.Handle("/", index())
@@ -20,7 +20,6 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/usecases/cluster"
-	ucs "github.com/weaviate/weaviate/usecases/schema"
 )
 
 type txManager interface {
@@ -36,9 +35,13 @@ type txPayload struct {
 	DeadlineMilli int64                   `json:"deadlineMilli"`
 }
 
+// unmarshalFn parses the payload of the transactions of a single component
+type unmarshalFn func(txType cluster.TransactionType, payload json.RawMessage) (interface{}, error)
+
 type txHandler struct {
-	manager txManager
-	auth    auth
+	manager   txManager
+	auth      auth
+	unmarshal unmarshalFn
 }
 
 func (h *txHandler) Transactions() http.Handler {
@@ -104,7 +107,7 @@ func (h *txHandler) incomingTransaction() http.Handler {
 			return
 		}
 
-		txPayload, err := ucs.UnmarshalTransaction(payload.Type, payload.Payload)
+		txPayload, err := h.unmarshal(payload.Type, payload.Payload)
 		if err != nil {
 			http.Error(w, errors.Wrap(err, "decode tx payload").Error(),
 				http.StatusInternalServerError)
@@ -128,17 +131,10 @@ func (h *txHandler) incomingTransaction() http.Handler {
 			http.Error(w, errors.Wrap(err, "open transaction").Error(), status)
 			return
 		}
-		if txType != ucs.ReadSchema {
-			w.WriteHeader(http.StatusCreated)
-			return
-		}
-
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(err.Error()))
-		}
 		w.WriteHeader(http.StatusCreated)
-		w.Write(data)
+		if len(data) > 0 {
+			w.Write(data)
+		}
 	})
 }
 
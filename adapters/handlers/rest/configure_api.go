@@ -33,15 +33,17 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	apiKeysRepo "github.com/weaviate/weaviate/adapters/repos/apikeys"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
+	rbacRepo "github.com/weaviate/weaviate/adapters/repos/rbac"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	txstore "github.com/weaviate/weaviate/adapters/repos/transactions"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/replication"
-	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	modstgazure "github.com/weaviate/weaviate/modules/backup-azure"
 	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
 	modstggcs "github.com/weaviate/weaviate/modules/backup-gcs"
@@ -67,7 +69,9 @@ import (
 	modopenai "github.com/weaviate/weaviate/modules/text2vec-openai"
 	modtext2vecpalm "github.com/weaviate/weaviate/modules/text2vec-palm"
 	modtransformers "github.com/weaviate/weaviate/modules/text2vec-transformers"
+	"github.com/weaviate/weaviate/usecases/apikeys"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
@@ -76,6 +80,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/roles"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
@@ -180,6 +185,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
 		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
 		AvoidMMap:                 appState.ServerConfig.Config.AvoidMmap,
+		AsyncIndexing:             appState.ServerConfig.Config.AsyncIndexing,
+		MaximumActiveTenants:      appState.ServerConfig.Config.MaximumActiveTenants,
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -222,6 +229,63 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Cluster, localClassifierRepo, appState.Logger)
 	appState.ClassificationRepo = classifierRepo
 
+	var rolesManager *roles.Manager
+	if rbacAuthorizer, ok := appState.Authorizer.(*rbac.Authorizer); ok {
+		localAuthzRepo, err := rbacRepo.NewRepo(
+			appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize rbac repo")
+			os.Exit(1)
+		}
+
+		authzTxClient := clients.NewClusterAuthorization(clusterHttpClient)
+		authzRepo := rbacRepo.NewDistributedRepo(authzTxClient,
+			appState.Cluster, localAuthzRepo, appState.Logger)
+		appState.AuthorizationRepo = authzRepo
+
+		rolesManager, err = roles.NewManager(ctx, authzRepo, rbacAuthorizer)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize roles manager")
+			os.Exit(1)
+		}
+	}
+
+	var apiKeysManager *apikeys.Manager
+	if appState.ServerConfig.Config.Authentication.APIKey.Enabled {
+		localAPIKeysRepo, err := apiKeysRepo.NewRepo(
+			appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize api keys repo")
+			os.Exit(1)
+		}
+
+		apiKeysTxClient := clients.NewClusterAPIKeys(clusterHttpClient)
+		apiKeysDistributedRepo, err := apiKeysRepo.NewDistributedRepo(apiKeysTxClient,
+			appState.Cluster, localAPIKeysRepo, appState.APIKey, appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize api keys repo")
+			os.Exit(1)
+		}
+		appState.APIKeysRepo = apiKeysDistributedRepo
+
+		apiKeysManager, err = apikeys.NewManager(ctx, apiKeysDistributedRepo,
+			appState.Authorizer, appState.APIKey, rootUsers(appState.ServerConfig.Config))
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize api keys manager")
+			os.Exit(1)
+		}
+	}
+
 	scaler := scaler.New(appState.Cluster, vectorRepo,
 		remoteIndexClient, appState.Logger, appState.ServerConfig.Config.Persistence.DataPath)
 	appState.Scaler = scaler
@@ -241,7 +305,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
-		enthnsw.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
+		vectorindex.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
 		appState.Modules, appState.Cluster, schemaTxClient,
 		schemaTxPersistence, scaler,
 	)
@@ -268,10 +332,28 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	backupManager := backup.NewHandler(appState.Logger, appState.Authorizer,
 		schemaManager, repo, appState.Modules)
 	appState.BackupManager = backupManager
+	appState.BackupScheduler = backupScheduler
 
 	go clusterapi.Serve(appState)
 
+	if appState.AuthorizationRepo != nil {
+		if err := appState.AuthorizationRepo.StartupSync(ctx); err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Warn("could not sync rbac policy with the cluster, using local policy")
+		}
+	}
+	if appState.APIKeysRepo != nil {
+		if err := appState.APIKeysRepo.StartupSync(ctx); err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Warn("could not sync api keys with the cluster, using local api keys")
+		}
+	}
+
 	vectorRepo.SetSchemaGetter(schemaManager)
+	remoteIndexClient.SetSchemaGetter(schemaManager)
+	repo.SetTenantActivator(schemaManager)
 	explorer.SetSchemaGetter(schemaManager)
 	appState.Modules.SetSchemaGetter(schemaManager)
 
@@ -284,6 +366,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
+	go backupManager.ResumeWALArchive(context.Background())
+
 	if err := schemaManager.StartServing(ctx); err != nil {
 		appState.Logger.
 			WithError(err).
@@ -297,6 +381,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, vectorRepo, appState.Modules,
 		objects.NewMetrics(appState.Metrics))
+	appState.ObjectsManager = objectsManager
 	batchObjectsManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.Metrics)
@@ -323,7 +408,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Metrics, appState.Logger)
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
-	setupNodesHandlers(api, schemaManager, repo, appState)
+	setupNodesHandlers(api, schemaManager, repo, appState, remoteNodesClient)
+	if rolesManager != nil {
+		setupAuthzHandlers(api, rolesManager, appState.Metrics, appState.Logger)
+	}
+	if apiKeysManager != nil {
+		setupAPIKeysHandlers(api, apiKeysManager, appState.Metrics, appState.Logger)
+	}
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
 	if err != nil {
@@ -345,6 +436,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	if appState.ServerConfig.Config.IndexMissingTextFilterableAtStartup {
 		reindexTaskNames = append(reindexTaskNames, "ShardInvertedReindexTaskMissingTextFilterable")
 	}
+	if appState.ServerConfig.Config.IndexSearchablePositionsAtStartup {
+		reindexTaskNames = append(reindexTaskNames, "ShardInvertedReindexTaskSearchablePositions")
+	}
 	if len(reindexTaskNames) > 0 {
 		// start reindexing inverted indexes (if requested by user) in the background
 		// allowing db to complete api configuration and start handling requests
@@ -393,6 +487,16 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			Fatal("modules didn't initialize")
 	}
 
+	if name := appState.ServerConfig.Config.TenantOffloadBackend; name != "" {
+		backend, err := appState.Modules.BackupBackend(name)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("tenant offload backend not found")
+		}
+		repo.SetTenantOffloadBackend(backend)
+	}
+
 	// manually update schema once
 	schema := schemaManager.GetSchemaSkipAuth()
 	updateSchemaCallback(schema)
@@ -800,6 +904,19 @@ func reasonableHttpClient(authConfig cluster.AuthConfig) *http.Client {
 	return &http.Client{Transport: t}
 }
 
+// rootUsers are the users which are allowed everything by the configured
+// authorization
+func rootUsers(cfg config.Config) []string {
+	switch {
+	case cfg.Authorization.AdminList.Enabled:
+		return cfg.Authorization.AdminList.Users
+	case cfg.Authorization.RBAC.Enabled:
+		return cfg.Authorization.RBAC.RootUsers
+	default:
+		return nil
+	}
+}
+
 func setupGoProfiling(config config.Config) {
 	go func() {
 		fmt.Println(http.ListenAndServe(":6060", nil))
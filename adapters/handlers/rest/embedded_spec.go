@@ -147,35 +147,64 @@ func init() {
         }
       }
     },
-    "/backups/{backend}": {
+    "/apikeys": {
+      "get": {
+        "description": "list all api keys which were created at runtime, their secrets are never returned",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "apikeys.list",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ApiKey"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.apikeys.list"
+        ]
+      },
       "post": {
-        "description": "Starts a process of creating a backup for a set of classes",
+        "description": "create a new api key for a user, the secret is only returned in this response",
         "tags": [
-          "backups"
+          "apikeys"
         ],
-        "operationId": "backups.create",
+        "operationId": "apikeys.create",
         "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
+              "$ref": "#/definitions/ApiKey"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Backup create process successfully started.",
+          "201": {
+            "description": "Api key created successfully",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/ApiKey"
             }
           },
           "401": {
@@ -188,7 +217,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -201,38 +230,82 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.apikeys.create"
         ]
       }
     },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of classes",
+    "/apikeys/{id}": {
+      "delete": {
+        "description": "revoke an api key, it can't be used anymore",
         "tags": [
-          "backups"
+          "apikeys"
         ],
-        "operationId": "backups.create.status",
+        "operationId": "apikeys.revoke",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "description": "id of the api key",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Api key revoked successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no api key found"
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.apikeys.revoke"
+        ]
+      }
+    },
+    "/apikeys/{id}/expire": {
+      "post": {
+        "description": "set the expiration time of an api key, it expires immediately if no time is given",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "apikeys.expire",
+        "parameters": [
           {
             "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "description": "id of the api key",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/ApiKeyExpiration"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Api key expiration set successfully",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/ApiKey"
             }
           },
           "401": {
@@ -245,13 +318,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "no api key found"
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -264,28 +334,21 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.apikeys.expire"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
-      "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes",
+    "/apikeys/{id}/rotate": {
+      "post": {
+        "description": "replace the secret of an api key, the old secret can't be used anymore and the new secret is only returned in this response",
         "tags": [
-          "backups"
+          "apikeys"
         ],
-        "operationId": "backups.restore.status",
+        "operationId": "apikeys.rotate",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "description": "id of the api key",
             "name": "id",
             "in": "path",
             "required": true
@@ -293,9 +356,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "Api key rotated successfully",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/ApiKey"
             }
           },
           "401": {
@@ -308,10 +371,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "no api key found"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -321,26 +381,21 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.apikeys.rotate"
         ]
-      },
+      }
+    },
+    "/authz/groups/{id}/assign": {
       "post": {
-        "description": "Starts a process of restoring a backup for a set of classes",
+        "description": "assign roles to a group",
         "tags": [
-          "backups"
+          "authz"
         ],
-        "operationId": "backups.restore",
+        "operationId": "authz.assignRoleToGroup",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "description": "group name",
             "name": "id",
             "in": "path",
             "required": true
@@ -350,16 +405,22 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "names of the roles",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
-            }
+            "description": "Role assigned successfully"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -371,13 +432,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "no role found"
           },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -390,20 +448,25 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.authz.assignRoleToGroup"
         ]
       }
     },
-    "/batch/objects": {
+    "/authz/groups/{id}/revoke": {
       "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+        "description": "revoke roles from a group",
         "tags": [
-          "batch",
-          "objects"
+          "authz"
         ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
+        "operationId": "authz.revokeRoleFromGroup",
         "parameters": [
+          {
+            "type": "string",
+            "description": "group name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
@@ -411,49 +474,20 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
+                "roles": {
+                  "description": "names of the roles",
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/Object"
+                    "type": "string"
                   }
                 }
               }
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Role revoked successfully"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -464,8 +498,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "no role found"
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -477,47 +514,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.authz.revokeRoleFromGroup"
         ]
-      },
-      "delete": {
-        "description": "Delete Objects in bulk that match a certain filter.",
+      }
+    },
+    "/authz/groups/{id}/roles": {
+      "get": {
+        "description": "get the roles assigned to a group",
         "tags": [
-          "batch",
-          "objects"
+          "authz"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "operationId": "authz.getRolesForGroup",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "type": "string",
+            "description": "group name",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Role assigned to the group",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
             }
           },
           "401": {
@@ -529,12 +554,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -542,55 +561,28 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.authz.getRolesForGroup"
         ]
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+    "/authz/roles": {
+      "get": {
+        "description": "get all roles",
         "tags": [
-          "batch",
-          "references"
-        ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
-        "parameters": [
-          {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          }
+          "authz"
         ],
+        "operationId": "authz.getRoles",
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Successful response.",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
+                "$ref": "#/definitions/Role"
               }
             }
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -600,12 +592,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -613,50 +599,47 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.authz.getRoles"
         ]
-      }
-    },
-    "/classifications/": {
+      },
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "create a new role",
         "tags": [
-          "classifications"
+          "authz"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "operationId": "authz.createRole",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Role"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Successfully started classification.",
+            "description": "Role created successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "400": {
-            "description": "Incorrect request",
+          "409": {
+            "description": "Role already exists",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -669,22 +652,21 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.authz.createRole"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/authz/roles/{id}": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "description": "get a role",
         "tags": [
-          "classifications"
+          "authz"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "operationId": "authz.getRole",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
+            "description": "role name",
             "name": "id",
             "in": "path",
             "required": true
@@ -692,9 +674,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -707,7 +689,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "no role found"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -717,35 +699,35 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.authz.getRole"
         ]
-      }
-    },
-    "/graphql": {
-      "post": {
-        "description": "Get an object based on GraphQL",
+      },
+      "put": {
+        "description": "replace the permissions of a role",
         "tags": [
-          "graphql"
+          "authz"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "operationId": "authz.updateRole",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/Role"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
-            }
+            "description": "Role updated successfully"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -756,8 +738,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "no role found"
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -769,41 +754,28 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.authz.updateRole"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "delete": {
+        "description": "delete a role, it is revoked from all users and groups",
         "tags": [
-          "graphql"
+          "authz"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "operationId": "authz.deleteRole",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
-            }
+          "204": {
+            "description": "Role deleted successfully"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -814,8 +786,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "no role found"
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -827,30 +802,47 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.authz.deleteRole"
         ]
       }
     },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+    "/authz/users/{id}/assign": {
+      "post": {
+        "description": "assign roles to a user",
         "tags": [
-          "meta"
+          "authz"
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
-        "responses": {
-          "200": {
-            "description": "Successful response.",
+        "operationId": "authz.assignRoleToUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "names of the roles",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned successfully"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -861,6 +853,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -868,26 +869,47 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.authz.assignRoleToUser"
         ]
       }
     },
-    "/nodes": {
-      "get": {
-        "description": "Returns status of Weaviate DB.",
+    "/authz/users/{id}/revoke": {
+      "post": {
+        "description": "revoke roles from a user",
         "tags": [
-          "nodes"
+          "authz"
         ],
-        "operationId": "nodes.get",
-        "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
+        "operationId": "authz.revokeRoleFromUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "names of the roles",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role revoked successfully"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -899,13 +921,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "no role found"
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -918,30 +937,34 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.authz.revokeRoleFromUser"
         ]
       }
     },
-    "/nodes/{className}": {
+    "/authz/users/{id}/roles": {
       "get": {
-        "description": "Returns status of Weaviate DB.",
+        "description": "get the roles assigned to a user",
         "tags": [
-          "nodes"
+          "authz"
         ],
-        "operationId": "nodes.get.class",
+        "operationId": "authz.getRolesForUser",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "user name",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Role assigned to the user",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
             }
           },
           "401": {
@@ -953,18 +976,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -973,55 +984,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.authz.getRolesForUser"
         ]
       }
     },
-    "/objects": {
+    "/backups/{backend}": {
       "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "description": "Lists all backups stored on a backend",
         "tags": [
-          "objects"
+          "backups"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "operationId": "backups.list",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonAfterParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOffsetParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonSortParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOrderParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonClassParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Existing backups successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupListResponse"
             }
           },
           "401": {
@@ -1033,11 +1020,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup list attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1049,43 +1033,38 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.backup"
         ]
       },
       "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "description": "Starts a process of creating a backup for a set of classes",
         "tags": [
-          "objects"
+          "backups"
         ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
+        "operationId": "backups.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/BackupCreateRequest"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup create process successfully started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupCreateResponse"
             }
           },
           "401": {
@@ -1098,7 +1077,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup creation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1110,34 +1089,40 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of classes",
         "tags": [
-          "objects"
+          "backups"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "operationId": "backups.create.status",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Backup creation status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateStatusResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1148,8 +1133,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1161,61 +1152,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/objects/{className}/{id}": {
-      "get": {
-        "description": "Get a single data object",
+      },
+      "delete": {
+        "description": "Deletes a backup and all of its files from a backend. Backups which are in progress or which are the base of an incremental backup cannot be deleted.",
         "tags": [
-          "objects"
+          "backups"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "operationId": "backups.delete",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonNodeNameParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Backup successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1227,10 +1192,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Invalid backup deletion attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1242,52 +1210,37 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.backup"
         ]
-      },
-      "put": {
-        "description": "Update an individual data object based on its class and uuid.",
+      }
+    },
+    "/backups/{backend}/{id}/cancel": {
+      "post": {
+        "description": "Cancels the creation of a backup which is in progress. The operation is aborted on all participating nodes.",
         "tags": [
-          "objects"
+          "backups"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "operationId": "backups.cancel",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+          "204": {
+            "description": "Backup creation successfully cancelled."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1299,10 +1252,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup creation is not in progress",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup creation cancellation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1314,49 +1270,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
-      },
-      "delete": {
-        "description": "Delete a single data object.",
+      }
+    },
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes",
         "tags": [
-          "objects"
+          "backups"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "operationId": "backups.restore.status",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+          "200": {
+            "description": "Backup restoration status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
             }
           },
           "401": {
@@ -1369,10 +1315,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Not Found - Backup does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1384,45 +1327,46 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
       },
-      "head": {
-        "description": "Checks if a data object exists without retrieving it.",
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of classes",
         "tags": [
-          "objects"
+          "backups"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "operationId": "backups.restore",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Backup restoration process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1434,10 +1378,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Invalid backup restoration attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1449,56 +1396,37 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
-      },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/backups/{backend}/{id}/restore/cancel": {
+      "post": {
+        "description": "Cancels the restoration of a backup which is in progress. The operation is aborted on all participating nodes.",
         "tags": [
-          "objects"
+          "backups"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "operationId": "backups.restore.cancel",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Backup restoration successfully cancelled."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1510,10 +1438,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup restoration is not in progress",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid backup restoration cancellation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1525,62 +1456,65 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Update all references of a property of a data object.",
+    "/batch/objects": {
+      "post": {
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
         "tags": [
+          "batch",
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -1597,11 +1531,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1616,45 +1547,24 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add"
         ]
       },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
         "tags": [
+          "batch",
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/BatchDelete"
             }
           },
           {
@@ -1666,7 +1576,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -1683,11 +1596,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1704,55 +1614,43 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+      }
+    },
+    "/batch/references": {
+      "post": {
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
         "tags": [
-          "objects"
+          "batch",
+          "references"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -1769,14 +1667,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1791,41 +1683,38 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add"
         ]
       }
     },
-    "/objects/{id}": {
-      "get": {
-        "description": "Lists Objects.",
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
         "tags": [
-          "objects"
+          "classifications"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
+          "201": {
+            "description": "Successfully started classification.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Classification"
             }
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1839,9 +1728,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1849,46 +1735,83 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.classifications.post"
         ]
-      },
-      "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "objects"
+          "classifications"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "classification id",
             "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
+        "parameters": [
           {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/GraphQLQuery"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -1900,9 +1823,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -1919,36 +1839,38 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
+      }
+    },
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1959,8 +1881,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1969,33 +1894,30 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "head": {
-        "description": "Checks if an Object exists in the system.",
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
+          "meta"
         ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2006,9 +1928,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2016,48 +1935,27 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
-      },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
+        "operationId": "nodes.get",
+        "responses": {
+          "200": {
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -2068,10 +1966,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2083,53 +1984,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.status.get"
         ]
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/nodes/rebalance": {
+      "post": {
+        "description": "Moves shard replicas between nodes, so that all nodes hold a similar number of replicas and use a similar share of their disk. Nodes being drained are left out.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "operationId": "nodes.rebalance",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "type": "boolean",
+            "default": false,
+            "description": "If true, the moves are only planned and returned but not executed.",
+            "name": "dryRun",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Shard replicas successfully rebalanced",
+            "schema": {
+              "$ref": "#/definitions/NodesRebalanceResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2141,7 +2022,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid rebalance attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2153,51 +2034,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.rebalance"
         ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      }
+    },
+    "/nodes/{className}": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "operationId": "nodes.get.class",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Nodes status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodesStatusResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2208,8 +2070,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2221,51 +2089,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.status.get.class"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/nodes/{nodeName}/drain": {
+      "get": {
+        "description": "Returns whether the node is being drained or has been drained, in which case it is safe to remove.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
+        "operationId": "nodes.drain.status",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "description": "The name of the node.",
+            "name": "nodeName",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Drain status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodeDrainStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2277,7 +2127,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "Not Found - Node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid drain status request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2289,25 +2145,30 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.drain.status"
         ]
-      }
-    },
-    "/schema": {
-      "get": {
+      },
+      "post": {
+        "description": "Starts moving all shard replicas off the node, so that it can be removed from the cluster safely. No new replicas are placed on a node being drained. Replicas of multi-tenant classes are not moved, these classes are listed as skipped in the drain status.",
         "tags": [
-          "schema"
+          "nodes"
+        ],
+        "operationId": "nodes.drain",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the node.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Draining the node successfully started",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/NodeDrainStatus"
             }
           },
           "401": {
@@ -2319,6 +2180,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The node cannot be drained, e.g. since there is no other node to move its replicas to.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2327,30 +2200,29 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.nodes.drain"
         ]
       },
-      "post": {
+      "delete": {
+        "description": "Stops draining the node. Replicas moved already stay on their new nodes.",
         "tags": [
-          "schema"
+          "nodes"
         ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
+        "operationId": "nodes.drain.cancel",
         "parameters": [
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "string",
+            "description": "The name of the node.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Draining the node successfully stopped",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/NodeDrainStatus"
             }
           },
           "401": {
@@ -2362,8 +2234,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Invalid drain cancellation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2376,105 +2254,55 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.nodes.drain.cancel"
         ]
       }
     },
-    "/schema/cluster-status": {
+    "/objects": {
       "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "schema"
-        ],
-        "operationId": "schema.cluster.status",
-        "responses": {
-          "200": {
-            "description": "The schema in the cluster is in sync.",
-            "schema": {
-              "$ref": "#/definitions/SchemaClusterStatus"
-            }
-          },
-          "500": {
-            "description": "The schema is either out of sync (see response body) or the sync check could not be completed.",
-            "schema": {
-              "$ref": "#/definitions/SchemaClusterStatus"
-            }
-          }
-        }
-      }
-    },
-    "/schema/{className}": {
-      "get": {
-        "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Found the Class, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "$ref": "#/parameters/CommonAfterParameterQuery"
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
           },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
           },
-          "404": {
-            "description": "This class does not exist"
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
-      },
-      "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
-        "tags": [
-          "schema"
-        ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
-        "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonSortParameterQuery"
           },
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Class was updated successfully",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2487,13 +2315,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2505,30 +2330,41 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query"
         ]
       },
-      "delete": {
+      "post": {
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Object class from the schema."
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "400": {
-            "description": "Could not delete the Object class.",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2542,6 +2378,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2549,40 +2391,34 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.add"
         ]
       }
     },
-    "/schema/{className}/properties": {
+    "/objects/validate": {
       "post": {
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Object"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2594,7 +2430,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2606,31 +2442,60 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/schema/{className}/shards": {
+    "/objects/{className}/{id}": {
       "get": {
+        "description": "Get a single data object",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonNodeNameParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2643,7 +2508,10 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist",
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2655,18 +2523,19 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
+      },
       "put": {
-        "description": "Update shard status of an Object Class",
+        "description": "Update an individual data object based on its class and uuid.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "operationId": "schema.objects.shards.update",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
             "type": "string",
@@ -2676,7 +2545,9 @@ func init() {
           },
           {
             "type": "string",
-            "name": "shardName",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
             "in": "path",
             "required": true
           },
@@ -2685,15 +2556,18 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -2706,13 +2580,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2724,34 +2595,49 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}/tenants": {
-      "get": {
-        "description": "get all tenants from a specific class",
+      },
+      "delete": {
+        "description": "Delete a single data object.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "operationId": "tenants.get",
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "tenants from specified class.",
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2763,8 +2649,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2775,42 +2664,46 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       },
-      "put": {
-        "description": "Update tenant of a specific class",
+      "head": {
+        "description": "Checks if a data object exists without retrieving it.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "operationId": "tenants.update",
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Updated tenants of the specified class",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2821,8 +2714,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Object doesn't exist."
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2833,41 +2729,56 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       },
-      "post": {
-        "description": "Create a new tenant for a specific class",
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "operationId": "tenants.create",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added new tenants to the specified class",
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2879,8 +2790,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2891,36 +2805,69 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "delete": {
-        "description": "delete tenants from a specific class",
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Update all references of a property of a data object.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "operationId": "tenants.delete",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "tenants",
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/MultipleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Deleted tenants from specified class."
+            "description": "Successfully replaced all the references."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2931,8 +2878,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2943,1995 +2893,4578 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "calibrates term-weight scaling based on the document length",
-          "type": "number",
-          "format": "float"
-        },
-        "k1": {
-          "description": "calibrates term-weight scaling based on the term frequency within a document",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object"
         },
-        "exclude": {
-          "description": "List of classes to exclude from the backup creation process",
-          "type": "array",
-          "items": {
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}": {
+      "get": {
+        "description": "Lists Objects.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Object from the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if an Object exists in the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/schema": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/cluster-status": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.cluster.status",
+        "responses": {
+          "200": {
+            "description": "The schema in the cluster is in sync.",
+            "schema": {
+              "$ref": "#/definitions/SchemaClusterStatus"
+            }
+          },
+          "500": {
+            "description": "The schema is either out of sync (see response body) or the sync check could not be completed.",
+            "schema": {
+              "$ref": "#/definitions/SchemaClusterStatus"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the Class, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "put": {
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a new tenant for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "delete tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "ApiKey": {
+      "description": "api key which was created at runtime",
+      "type": "object",
+      "required": [
+        "user"
+      ],
+      "properties": {
+        "createdAt": {
+          "description": "time when the api key was created",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true,
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "description": {
+          "description": "description of the purpose of the api key",
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "time when the api key expires, the key doesn't expire if not set",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "id": {
+          "description": "id of the api key",
+          "type": "string",
+          "readOnly": true
+        },
+        "key": {
+          "description": "the api key, it is only returned when the key is created or rotated",
+          "type": "string",
+          "readOnly": true
+        },
+        "lastUsedAt": {
+          "description": "time when the api key was used last",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true,
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "user": {
+          "description": "name of the user which is authenticated by the api key",
+          "type": "string"
+        }
+      }
+    },
+    "ApiKeyExpiration": {
+      "description": "expiration time of an api key",
+      "type": "object",
+      "properties": {
+        "expiresAt": {
+          "description": "time when the api key expires",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
+      "properties": {
+        "b": {
+          "description": "calibrates term-weight scaling based on the document length",
+          "type": "number",
+          "format": "float"
+        },
+        "k1": {
+          "description": "calibrates term-weight scaling based on the term frequency within a document",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "archiveWal": {
+          "description": "Continuously archive the write-ahead logs of the backed up classes after the backup has been created. This allows restoring the classes at a later point in time. Archiving stops when the backup is deleted or when another backup archives its logs, it continues after a restart of the node.",
+          "type": "boolean"
+        },
+        "baseId": {
+          "description": "The ID of the base backup of an incremental backup. Segment files which didn't change since the base backup are referenced instead of uploaded again. The base backup must have been created successfully on the same backend.",
+          "type": "string"
+        },
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "bytesReused": {
+          "description": "number of bytes of files referenced from the base backup instead of being uploaded",
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytesTransferred": {
+          "description": "number of bytes of files uploaded by this backup",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupListResponse": {
+      "description": "The list of backups stored on a backend",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "baseId": {
+            "description": "The ID of the base backup if the backup is incremental.",
+            "type": "string"
+          },
+          "classes": {
+            "description": "The list of classes contained in the backup",
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          },
+          "completedAt": {
+            "description": "when the backup was completed",
+            "type": "string",
+            "format": "date-time",
+            "x-nullable": true
+          },
+          "id": {
+            "description": "The ID of the backup.",
+            "type": "string"
+          },
+          "startedAt": {
+            "description": "when the backup was started",
+            "type": "string",
+            "format": "date-time"
+          },
+          "status": {
+            "description": "status of the backup",
+            "type": "string",
+            "enum": [
+              "STARTED",
+              "TRANSFERRING",
+              "TRANSFERRED",
+              "SUCCESS",
+              "FAILED",
+              "CANCELED"
+            ]
+          }
+        }
+      }
+    },
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
+      "properties": {
+        "classMapping": {
+          "description": "Restore classes under a different name. Maps class names of the backup to the names they are restored as.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pointInTime": {
+          "description": "Restore the state of the classes at this point in time by replaying the write-ahead logs archived after the backup. The backup must have been created with archiveWal. Logs are archived every 10 seconds, which is the granularity of the restored state.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "tenant": {
+          "description": "Name of the reference tenant.",
+          "type": "string"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "BatchStats": {
+      "description": "The summary of a nodes batch queue congestion status.",
+      "properties": {
+        "queueLength": {
+          "description": "How many objects are currently in the batch queue.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        },
+        "ratePerSecond": {
+          "description": "How many objects are approximately processed from the batch queue per second.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "vectorConfig": {
+          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW, flat, dynamic)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
             "type": "string"
-          }
+          },
+          "example": [
+            "description"
+          ]
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
         },
-        "include": {
-          "description": "List of classes to include in the backup creation process",
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
           "type": "array",
           "items": {
             "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
           }
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
         }
       }
     },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Deprecation": {
+      "type": "object",
+      "properties": {
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
           "type": "string"
         },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+          "type": "string"
+        },
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "error": {
-          "description": "error message if creation failed",
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "msg": {
+          "description": "What this deprecation is about",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
           "type": "string"
         },
-        "status": {
-          "description": "phase of backup creation process",
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
           "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
+          "x-nullable": true
+        },
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
         }
       }
     },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
         "error": {
-          "description": "error message if creation failed",
-          "type": "string"
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "message": {
           "type": "string"
         },
         "path": {
-          "description": "destination path of backup files proper to selected backend",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
           "type": "string"
         },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
         }
       }
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
       "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
           }
         },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
+        "errors": {
+          "description": "Array with errors.",
           "type": "array",
           "items": {
-            "type": "string"
-          }
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
         }
       }
     },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
         },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
         },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
+        "indexNullState": {
+          "description": "Index each object with the null state",
+          "type": "boolean"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+        "indexPropertyLength": {
+          "description": "Index length of properties",
+          "type": "boolean"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps",
+          "type": "boolean"
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
         }
       }
     },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
           "type": "string"
         },
-        "error": {
-          "description": "error message if restoration failed",
+        "href": {
+          "description": "target of the link",
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "name": {
+          "description": "human readable name of the resource group",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend",
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
           "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
         }
       }
     },
-    "BatchDelete": {
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
       "type": "object",
       "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
-        },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+        "hostname": {
+          "description": "The url of the host.",
           "type": "string",
-          "default": "minimal"
-        }
-      }
-    },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+          "format": "url"
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
         },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
-              "type": "array",
-              "items": {
-                "description": "Results for this specific Object.",
-                "format": "object",
-                "properties": {
-                  "errors": {
-                    "$ref": "#/definitions/ErrorResponse"
-                  },
-                  "id": {
-                    "description": "ID of the Object.",
-                    "type": "string",
-                    "format": "uuid"
-                  },
-                  "status": {
-                    "type": "string",
-                    "default": "SUCCESS",
-                    "enum": [
-                      "SUCCESS",
-                      "DRYRUN",
-                      "FAILED"
-                    ]
-                  }
-                }
-              }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            }
-          }
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
         }
       }
     },
-    "BatchReference": {
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
       "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        "autoTenantActivation": {
+          "description": "Whether or not COLD tenants are activated when they are read or written",
+          "type": "boolean"
         },
-        "tenant": {
-          "description": "Name of the reference tenant.",
+        "autoTenantDeactivationTimeoutSeconds": {
+          "description": "Tenants which are neither read nor written for n seconds are deactivated. Requires autoTenantActivation, 0 disables the deactivation",
+          "type": "number",
+          "format": "int"
+        },
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
           "type": "string"
         },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+        "indexFilterable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
           "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
         }
       }
     },
-    "BatchReferenceResponse": {
+    "NodeDrainStatus": {
+      "description": "The status of draining a node",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
+      "properties": {
+        "error": {
+          "description": "The error which stopped draining the node.",
+          "type": "string"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
+        "node": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "replicas": {
+          "description": "The number of shard replicas still owned by the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "skippedClasses": {
+          "description": "Multi-tenant classes whose tenant replicas are not moved off the node, moving tenants is not supported. The node keeps draining until these tenants are deleted or removed from the node by other means.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "status": {
+          "description": "The drain status of the node. A drained node owns no shard replicas anymore and is safe to remove.",
+          "type": "string",
+          "enum": [
+            "ACTIVE",
+            "DRAINING",
+            "DRAINED",
+            "FAILED"
+          ]
         }
-      ]
+      }
     },
-    "BatchStats": {
-      "description": "The summary of a nodes batch queue congestion status.",
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
       "properties": {
-        "queueLength": {
-          "description": "How many objects are currently in the batch queue.",
+        "class": {
+          "description": "The name of shard's class.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects in shard.",
           "type": "number",
-          "format": "int",
+          "format": "int64",
           "x-omitempty": false
         },
-        "ratePerSecond": {
-          "description": "How many objects are approximately processed from the batch queue per second.",
+        "vectorQueueLength": {
+          "description": "The number of vectors waiting to be added to the vector index. Only set if asynchronous indexing is enabled.",
           "type": "number",
-          "format": "int",
+          "format": "int64",
           "x-omitempty": false
         }
       }
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
       "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
-          "type": "string"
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+        "shardCount": {
+          "description": "The count of Weaviate's shards.",
           "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
-          },
-          "word": {
-            "type": "string"
-          }
+          "format": "int",
+          "x-omitempty": false
         }
       }
     },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
-            }
-          },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
-            }
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
+      "properties": {
+        "batchStats": {
+          "description": "Weaviate batch statistics.",
+          "type": "object",
+          "$ref": "#/definitions/BatchStats"
+        },
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
           }
+        },
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
+          ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
         }
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
+    "NodesRebalanceResponse": {
+      "description": "The shard replica moves which even out the load of the nodes",
+      "type": "object",
       "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
-            }
-          }
+        "dryRun": {
+          "description": "Whether the moves were only planned but not executed.",
+          "type": "boolean"
         },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
+        "moves": {
+          "description": "The planned or executed shard replica moves.",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
-              }
-            }
+            "$ref": "#/definitions/ReplicaMove"
           }
         }
       }
     },
-    "Class": {
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
       "type": "object",
       "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
+          }
+        }
+      }
+    },
+    "Object": {
+      "type": "object",
+      "properties": {
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
         },
-        "description": {
-          "description": "Description of the class.",
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
           "type": "string"
         },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
         },
-        "multiTenancyConfig": {
-          "$ref": "#/definitions/MultiTenancyConfig"
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
         "properties": {
-          "description": "The properties of the class.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
-          }
-        },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
+          "$ref": "#/definitions/PropertySchema"
         },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
+        "tenant": {
+          "description": "Name of the Objects tenant.",
+          "type": "string"
         },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
+        "vector": {
+          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
+          "$ref": "#/definitions/C11yVector"
         },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
         },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
+        "vectors": {
+          "description": "This object's named vectors. Only used by classes that configure named vectors through ` + "`" + `vectorConfig` + "`" + `.",
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
+    "ObjectsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
       "type": "object",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
+        "deprecations": {
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
+            "$ref": "#/definitions/Deprecation"
+          }
         },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
+        "objects": {
+          "description": "The actual list of Objects.",
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCountry"
-          ]
+            "$ref": "#/definitions/Object"
+          }
         },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
         },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "merge": {
+          "$ref": "#/definitions/Object"
         },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
+        "op": {
+          "description": "The operation to be performed.",
           "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
         },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
         },
-        "settings": {
-          "description": "classification-type specific settings",
+        "value": {
+          "description": "The value to be used within the operations.",
           "type": "object"
+        }
+      }
+    },
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
         },
-        "status": {
-          "description": "status of this classification",
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
           "type": "string",
           "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
         },
-        "type": {
-          "description": "which algorithm to use for classifications",
+        "path": {
+          "description": "A JSON-Pointer.",
           "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
+        "id": {
+          "description": "The session ID of the peer.",
           "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
+          "format": "uuid"
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
         },
-        "started": {
-          "description": "time when this classification was started",
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
           "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "format": "uri"
         }
       }
     },
-    "Deprecation": {
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "Permission": {
+      "description": "permission to perform actions on the resources matching a pattern",
       "type": "object",
+      "required": [
+        "actions",
+        "resource"
+      ],
       "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
-        },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
+        "actions": {
+          "description": "actions which are allowed on the resources. Allowed values are ` + "`" + `create` + "`" + `, ` + "`" + `get` + "`" + `, ` + "`" + `list` + "`" + `, ` + "`" + `head` + "`" + `, ` + "`" + `update` + "`" + `, ` + "`" + `delete` + "`" + `, ` + "`" + `validate` + "`" + `, ` + "`" + `restore` + "`" + ` or ` + "`" + `*` + "`" + ` for all actions",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
+        "resource": {
+          "description": "pattern of the resources the actions are allowed on, ` + "`" + `*` + "`" + ` matches any sequence of characters. Resources are ` + "`" + `schema/collections/{class}` + "`" + `, ` + "`" + `schema/collections/{class}/shards/{shard}` + "`" + `, ` + "`" + `schema/collections/{class}/tenants/{tenant}` + "`" + `, ` + "`" + `data/collections/{class}/tenants/{tenant}/objects/{id}` + "`" + `, ` + "`" + `backups/{backend}/{id}` + "`" + `, ` + "`" + `classifications/{id}` + "`" + `, ` + "`" + `cluster/nodes` + "`" + `, ` + "`" + `graphql` + "`" + `, ` + "`" + `roles/{name}` + "`" + `, ` + "`" + `users/{name}` + "`" + ` and ` + "`" + `groups/{name}` + "`" + `",
           "type": "string"
+        }
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
         },
-        "msg": {
-          "description": "What this deprecation is about",
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
           "type": "string"
         },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
           "type": "string"
         },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
         },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
         },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
           "type": "string"
         },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
           "type": "string"
         }
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
+    "Property": {
       "type": "object",
       "properties": {
-        "error": {
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
-              }
-            }
+            "type": "string"
           }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "textAnalyzer": {
+          "$ref": "#/definitions/TextAnalyzerConfig"
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
         }
       }
     },
-    "GeoCoordinates": {
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
       "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
           "type": "number",
-          "format": "float",
+          "format": "float32",
           "x-nullable": true
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
           "type": "number",
-          "format": "float",
-          "x-nullable": true
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
         }
       }
     },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
+    "ReplicaMove": {
+      "description": "A shard replica moved from one node to another node",
+      "type": "object",
       "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
-            }
-          }
+        "class": {
+          "description": "The name of the class the shard belongs to.",
+          "type": "string"
         },
-        "message": {
+        "from": {
+          "description": "The node the replica is moved from.",
           "type": "string"
         },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The node the replica is moved to.",
+          "type": "string"
         }
       }
     },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
       "type": "object",
       "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
-        },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
-          "type": "string"
-        },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
         }
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+    "Role": {
+      "description": "named set of permissions which is assigned to users and groups",
+      "type": "object",
+      "required": [
+        "name",
+        "permissions"
+      ],
       "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
+        "name": {
+          "description": "name of the role",
+          "type": "string"
         },
-        "errors": {
-          "description": "Array with errors.",
+        "permissions": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/GraphQLError"
-          },
-          "x-omitempty": true
+            "$ref": "#/definitions/Permission"
+          }
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
       "type": "object",
       "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
-        },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
-        },
-        "indexNullState": {
-          "description": "Index each object with the null state",
-          "type": "boolean"
-        },
-        "indexPropertyLength": {
-          "description": "Index length of properties",
-          "type": "boolean"
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
         },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps",
-          "type": "boolean"
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
+    "SchemaClusterStatus": {
+      "description": "Indicates the health of the schema in a cluster.",
       "type": "object",
       "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
+        "error": {
+          "description": "Contains the sync check error if one occurred",
+          "type": "string",
+          "x-omitempty": true
         },
-        "href": {
-          "description": "target of the link",
-          "type": "string"
+        "healthy": {
+          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "name": {
-          "description": "human readable name of the resource group",
+        "hostname": {
+          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
           "type": "string"
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
-          "type": "string"
+        "ignoreSchemaSync": {
+          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "nodeCount": {
+          "description": "Number of nodes that participated in the sync check",
+          "type": "number",
+          "format": "int"
         }
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
       "properties": {
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
-        },
-        "modules": {
-          "description": "Module-specific meta information",
-          "type": "object"
-        },
-        "version": {
-          "description": "Version of weaviate you are currently running",
+        "status": {
+          "description": "Status of the shard",
           "type": "string"
         }
       }
     },
-    "MultiTenancyConfig": {
-      "description": "Configuration related to multi-tenancy within a class",
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
       "properties": {
-        "enabled": {
-          "description": "Whether or not multi-tenancy is enabled for this class",
-          "type": "boolean",
-          "x-omitempty": false
+        "name": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/SingleRef"
+        "$ref": "#/definitions/ShardStatusGetResponse"
       }
     },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
-        "class": {
-          "description": "The name of shard's class.",
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
           "type": "string",
-          "x-omitempty": false
+          "format": "uri"
         },
-        "name": {
-          "description": "The name of the shard.",
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
           "type": "string",
-          "x-omitempty": false
+          "format": "uri"
         },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        }
-      }
-    },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
-      "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
         },
-        "shardCount": {
-          "description": "The count of Weaviate's shards.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
         }
       }
     },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
       "properties": {
-        "batchStats": {
-          "description": "Weaviate batch statistics.",
-          "type": "object",
-          "$ref": "#/definitions/BatchStats"
-        },
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
-          "type": "string"
+        "additions": {
+          "description": "stopwords to be considered additionally",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "name": {
-          "description": "The name of the node.",
+        "preset": {
+          "description": "pre-existing list of common words by language",
           "type": "string"
         },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
+        "removals": {
+          "description": "stopwords to be removed from consideration",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NodeShardStatus"
+            "type": "string"
           }
-        },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
-        },
-        "status": {
-          "description": "Node's status.",
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on the configured tenant offload backend instead of locally. ` + "`" + `FREEZING` + "`" + ` and ` + "`" + `UNFREEZING` + "`" + ` are only returned when listing tenants, while the tenant's files are uploaded to or downloaded from the offload backend",
           "type": "string",
-          "default": "HEALTHY",
           "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE"
+            "HOT",
+            "WARM",
+            "COLD",
+            "FROZEN",
+            "FREEZING",
+            "UNFREEZING"
           ]
         },
-        "version": {
-          "description": "The version of Weaviate.",
+        "name": {
+          "description": "name of the tenant",
           "type": "string"
         }
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
+    "TextAnalyzerConfig": {
+      "description": "Language-specific analysis of the terms of a text property. It is applied when the property is indexed as well as to filter values and bm25 queries on the property.",
       "type": "object",
       "properties": {
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeStatus"
-          }
+        "asciiFold": {
+          "description": "Whether accents and other diacritics are removed from terms, e.g. ` + "`" + `café` + "`" + ` is indexed as ` + "`" + `cafe` + "`" + `.",
+          "type": "boolean"
+        },
+        "language": {
+          "description": "Language of the text. English terms (` + "`" + `en` + "`" + `) are reduced to their stem by the Snowball (Porter2) stemmer, e.g. ` + "`" + `generously` + "`" + ` is indexed as ` + "`" + `generous` + "`" + `. Stemming other languages is not supported, their terms can be folded with ` + "`" + `asciiFold` + "`" + ` and filtered with a ` + "`" + `stopwordPreset` + "`" + ` instead. Sequences of Chinese, Japanese or Korean characters (` + "`" + `zh` + "`" + `, ` + "`" + `ja` + "`" + `, ` + "`" + `ko` + "`" + `) are split into overlapping pairs of characters (bigrams), words are not segmented by a dictionary.",
+          "type": "string",
+          "enum": [
+            "en",
+            "zh",
+            "ja",
+            "ko"
+          ]
+        },
+        "stopwordPreset": {
+          "description": "Stopword preset applied to bm25 queries on the property instead of the stopwords of the class, e.g. ` + "`" + `de` + "`" + `.",
+          "type": "string"
         }
       }
     },
-    "Object": {
+    "VectorConfig": {
       "type": "object",
       "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
-        },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
         },
-        "tenant": {
-          "description": "Name of the Objects tenant.",
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW, flat, dynamic)",
           "type": "string"
         },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
-          "$ref": "#/definitions/C11yVector"
-        },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
+        "vectorizer": {
+          "description": "Configuration of a specific vectorizer used by this vector",
+          "type": "object"
         }
       }
     },
-    "ObjectsGetResponse": {
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "Vectors": {
+      "description": "A map of named vectors for multi-vector representations.",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
-        },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
-            }
-          }
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
-          }
-        }
-      ]
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
       "type": "object",
       "properties": {
-        "deprecations": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Deprecation"
+            "$ref": "#/definitions/WhereFilter"
           }
         },
-        "objects": {
-          "description": "The actual list of Objects.",
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Not",
+            "Equal",
+            "Like",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll",
+            "ContainsNone"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Object"
-          }
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
         },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueBooleanArray": {
+          "description": "value as boolean",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueDateArray": {
+          "description": "value as date (as string)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "TODO"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
         },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
         },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+        "valueIntArray": {
+          "description": "value as integer",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "[100, 200]"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
-      }
-    },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "valueNumberArray": {
+          "description": "value as number/float",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            3.14
+          ]
         },
-        "op": {
-          "description": "The operation to be performed.",
+        "valueString": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueStringArray": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
           ]
         },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+        "valueText": {
+          "description": "value as text",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "valueTextArray": {
+          "description": "value as text",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
       "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
-        },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
-          "type": "string"
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
         }
       }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "The starting ID of the result window.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "Class parameter specifies the class from which to query objects",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyLevelParameterQuery": {
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
+    },
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonNodeNameParameterQuery": {
+      "type": "string",
+      "description": "The target node which should fulfill the request",
+      "name": "node_name",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Default value is 0.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order parameter to tell how to order (asc or desc) data within given field",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Sort parameter to pass an information about the names of the sort fields",
+      "name": "sort",
+      "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
-      }
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
     },
-    "PhoneNumber": {
-      "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
-        },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
-        },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
-        },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
-        },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
-        }
-      }
+    {
+      "description": "These operations manage roles and their assignment to users and groups.",
+      "name": "authz"
     },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    {
+      "description": "These operations manage api keys which are created at runtime.",
+      "name": "apikeys"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/weaviate/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Cloud-native, modular vector database",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/weaviate",
+      "email": "hello@weaviate.io"
+    },
+    "version": "1.21.3"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Home. Discover the REST API",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
           }
-        },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
         }
       }
     },
-    "Property": {
-      "type": "object",
-      "properties": {
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
           }
-        },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
-        },
-        "indexFilterable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexInverted": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field"
-          ]
         }
       }
     },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
-      "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
-        },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
-          "format": "int64"
-        },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
-        }
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
-      "type": "object",
-      "properties": {
-        "factor": {
-          "description": "Number of times a class is replicated",
-          "type": "integer"
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
         }
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
-      "type": "object",
-      "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Class"
+    "/apikeys": {
+      "get": {
+        "description": "list all api keys which were created at runtime, their secrets are never returned",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "apikeys.list",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ApiKey"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
+        "x-serviceIds": [
+          "weaviate.apikeys.list"
+        ]
+      },
+      "post": {
+        "description": "create a new api key for a user, the secret is only returned in this response",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "apikeys.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ApiKey"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Api key created successfully",
+            "schema": {
+              "$ref": "#/definitions/ApiKey"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.apikeys.create"
+        ]
       }
     },
-    "SchemaClusterStatus": {
-      "description": "Indicates the health of the schema in a cluster.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "description": "Contains the sync check error if one occurred",
-          "type": "string",
-          "x-omitempty": true
-        },
-        "healthy": {
-          "description": "True if the cluster is in sync, false if there is an issue (see error).",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "hostname": {
-          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
-          "type": "string"
-        },
-        "ignoreSchemaSync": {
-          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
-          "type": "boolean",
-          "x-omitempty": false
+    "/apikeys/{id}": {
+      "delete": {
+        "description": "revoke an api key, it can't be used anymore",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "apikeys.revoke",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "id of the api key",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Api key revoked successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no api key found"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "nodeCount": {
-          "description": "Number of nodes that participated in the sync check",
-          "type": "number",
-          "format": "int"
-        }
+        "x-serviceIds": [
+          "weaviate.apikeys.revoke"
+        ]
       }
     },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
+    "/apikeys/{id}/expire": {
+      "post": {
+        "description": "set the expiration time of an api key, it expires immediately if no time is given",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "apikeys.expire",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "id of the api key",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/ApiKeyExpiration"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Api key expiration set successfully",
+            "schema": {
+              "$ref": "#/definitions/ApiKey"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no api key found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.apikeys.expire"
+        ]
+      }
     },
-    "ShardStatus": {
-      "description": "The status of a single shard",
-      "properties": {
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
-        }
+    "/apikeys/{id}/rotate": {
+      "post": {
+        "description": "replace the secret of an api key, the old secret can't be used anymore and the new secret is only returned in this response",
+        "tags": [
+          "apikeys"
+        ],
+        "operationId": "apikeys.rotate",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "id of the api key",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Api key rotated successfully",
+            "schema": {
+              "$ref": "#/definitions/ApiKey"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no api key found"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.apikeys.rotate"
+        ]
       }
     },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
-      "properties": {
-        "name": {
-          "description": "Name of the shard",
-          "type": "string"
+    "/authz/groups/{id}/assign": {
+      "post": {
+        "description": "assign roles to a group",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.assignRoleToGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "group name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "names of the roles",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.authz.assignRoleToGroup"
+        ]
       }
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
+    "/authz/groups/{id}/revoke": {
+      "post": {
+        "description": "revoke roles from a group",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.revokeRoleFromGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "group name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "names of the roles",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role revoked successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.revokeRoleFromGroup"
+        ]
       }
     },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
-      "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
-        },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
-        },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
+    "/authz/groups/{id}/roles": {
+      "get": {
+        "description": "get the roles assigned to a group",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.getRolesForGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "group name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned to the group",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
-        }
+        "x-serviceIds": [
+          "weaviate.authz.getRolesForGroup"
+        ]
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
-      "type": "object",
-      "properties": {
-        "additions": {
-          "description": "stopwords to be considered additionally",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/authz/roles": {
+      "get": {
+        "description": "get all roles",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.getRoles",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "preset": {
-          "description": "pre-existing list of common words by language",
-          "type": "string"
-        },
-        "removals": {
-          "description": "stopwords to be removed from consideration",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-serviceIds": [
+          "weaviate.authz.getRoles"
+        ]
+      },
+      "post": {
+        "description": "create a new role",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.createRole",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Role created successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Role already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "Tenant": {
-      "description": "attributes representing a single tenant within weaviate",
-      "type": "object",
-      "properties": {
-        "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
-          "type": "string",
-          "enum": [
-            "HOT",
-            "WARM",
-            "COLD",
-            "FROZEN"
-          ]
         },
-        "name": {
-          "description": "name of the tenant",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.authz.createRole"
+        ]
       }
     },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
-      "type": "object",
-      "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/WhereFilter"
+    "/authz/roles/{id}": {
+      "get": {
+        "description": "get a role",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.getRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
-        },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange",
-            "IsNull",
-            "ContainsAny",
-            "ContainsAll"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
           },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueBooleanArray": {
-          "description": "value as boolean",
-          "type": "array",
-          "items": {
-            "type": "boolean"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            true,
-            false
-          ]
-        },
-        "valueDate": {
-          "description": "value as date (as string)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
-        },
-        "valueDateArray": {
-          "description": "value as date (as string)",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "TODO"
-        },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
-        },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
-        },
-        "valueIntArray": {
-          "description": "value as integer",
-          "type": "array",
-          "items": {
-            "type": "integer",
-            "format": "int64"
+          "404": {
+            "description": "no role found"
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "[100, 200]"
-        },
-        "valueNumber": {
-          "description": "value as number/float",
-          "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueNumberArray": {
-          "description": "value as number/float",
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float64"
+        "x-serviceIds": [
+          "weaviate.authz.getRole"
+        ]
+      },
+      "put": {
+        "description": "replace the permissions of a role",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.updateRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            3.14
-          ]
-        },
-        "valueString": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role updated successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueStringArray": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-serviceIds": [
+          "weaviate.authz.updateRole"
+        ]
+      },
+      "delete": {
+        "description": "delete a role, it is revoked from all users and groups",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.deleteRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Role deleted successfully"
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
-        },
-        "valueText": {
-          "description": "value as text",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        },
-        "valueTextArray": {
-          "description": "value as text",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
-        }
-      }
-    },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-serviceIds": [
+          "weaviate.authz.deleteRole"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonAfterParameterQuery": {
-      "type": "string",
-      "description": "The starting ID of the result window.",
-      "name": "after",
-      "in": "query"
-    },
-    "CommonClassParameterQuery": {
-      "type": "string",
-      "description": "Class parameter specifies the class from which to query objects",
-      "name": "class",
-      "in": "query"
-    },
-    "CommonConsistencyLevelParameterQuery": {
-      "type": "string",
-      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-      "name": "consistency_level",
-      "in": "query"
-    },
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonNodeNameParameterQuery": {
-      "type": "string",
-      "description": "The target node which should fulfill the request",
-      "name": "node_name",
-      "in": "query"
-    },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Default value is 0.",
-      "name": "offset",
-      "in": "query"
-    },
-    "CommonOrderParameterQuery": {
-      "type": "string",
-      "description": "Order parameter to tell how to order (asc or desc) data within given field",
-      "name": "order",
-      "in": "query"
-    },
-    "CommonSortParameterQuery": {
-      "type": "string",
-      "description": "Sort parameter to pass an information about the names of the sort fields",
-      "name": "sort",
-      "in": "query"
-    },
-    "CommonTenantParameterQuery": {
-      "type": "string",
-      "description": "Specifies the tenant in a request targeting a multi-tenant class",
-      "name": "tenant",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/weaviate/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Cloud-native, modular vector database",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/weaviate",
-      "email": "hello@weaviate.io"
     },
-    "version": "1.21.3"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
-      "get": {
-        "description": "Home. Discover the REST API",
-        "operationId": "weaviate.root",
+    "/authz/users/{id}/assign": {
+      "post": {
+        "description": "assign roles to a user",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.assignRoleToUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "names of the roles",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Weaviate is alive and ready to serve content",
+            "description": "Role assigned successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.assignRoleToUser"
+        ]
+      }
+    },
+    "/authz/users/{id}/revoke": {
+      "post": {
+        "description": "revoke roles from a user",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.revokeRoleFromUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
               "properties": {
-                "links": {
+                "roles": {
+                  "description": "names of the roles",
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/Link"
+                    "type": "string"
                   }
                 }
               }
             }
           }
-        }
-      }
-    },
-    "/.well-known/live": {
-      "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "operationId": "weaviate.wellknown.liveness",
+        ],
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "Role revoked successfully"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.revokeRoleFromUser"
+        ]
       }
     },
-    "/.well-known/openid-configuration": {
+    "/authz/users/{id}/roles": {
       "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "description": "get the roles assigned to a user",
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "authz"
+        ],
+        "operationId": "authz.getRolesForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
           "200": {
-            "description": "Successful response, inspect body",
+            "description": "Role assigned to the user",
             "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
               }
             }
           },
-          "404": {
-            "description": "Not found, no oidc provider present"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4940,25 +7473,60 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
+        "x-serviceIds": [
+          "weaviate.authz.getRolesForUser"
+        ]
       }
     },
-    "/.well-known/ready": {
+    "/backups/{backend}": {
       "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
+        "description": "Lists all backups stored on a backend",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
+            "description": "Existing backups successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupListResponse"
+            }
           },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup list attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "/backups/{backend}": {
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
       "post": {
         "description": "Starts a process of creating a backup for a set of classes",
         "tags": [
@@ -5056,13 +7624,131 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "delete": {
+        "description": "Deletes a backup and all of its files from a backend. Backups which are in progress or which are the base of an incremental backup cannot be deleted.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup deletion attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}/cancel": {
+      "post": {
+        "description": "Cancels the creation of a backup which is in progress. The operation is aborted on all participating nodes.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.cancel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup creation successfully cancelled."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup creation is not in progress",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Invalid backup creation cancellation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5205,6 +7891,66 @@ func init() {
         ]
       }
     },
+    "/backups/{backend}/{id}/restore/cancel": {
+      "post": {
+        "description": "Cancels the restoration of a backup which is in progress. The operation is aborted on all participating nodes.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore.cancel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup restoration successfully cancelled."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup restoration is not in progress",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration cancellation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
     "/batch/objects": {
       "post": {
         "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
@@ -5745,6 +8491,56 @@ func init() {
         ]
       }
     },
+    "/nodes/rebalance": {
+      "post": {
+        "description": "Moves shard replicas between nodes, so that all nodes hold a similar number of replicas and use a similar share of their disk. Nodes being drained are left out.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.rebalance",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "If true, the moves are only planned and returned but not executed.",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard replicas successfully rebalanced",
+            "schema": {
+              "$ref": "#/definitions/NodesRebalanceResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid rebalance attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.rebalance"
+        ]
+      }
+    },
     "/nodes/{className}": {
       "get": {
         "description": "Returns status of Weaviate DB.",
@@ -5800,6 +8596,170 @@ func init() {
         ]
       }
     },
+    "/nodes/{nodeName}/drain": {
+      "get": {
+        "description": "Returns whether the node is being drained or has been drained, in which case it is safe to remove.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.drain.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the node.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Drain status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodeDrainStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid drain status request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.drain.status"
+        ]
+      },
+      "post": {
+        "description": "Starts moving all shard replicas off the node, so that it can be removed from the cluster safely. No new replicas are placed on a node being drained. Replicas of multi-tenant classes are not moved, these classes are listed as skipped in the drain status.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.drain",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the node.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Draining the node successfully started",
+            "schema": {
+              "$ref": "#/definitions/NodeDrainStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The node cannot be drained, e.g. since there is no other node to move its replicas to.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.drain"
+        ]
+      },
+      "delete": {
+        "description": "Stops draining the node. Replicas moved already stay on their new nodes.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.drain.cancel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the node.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Draining the node successfully stopped",
+            "schema": {
+              "$ref": "#/definitions/NodeDrainStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid drain cancellation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.drain.cancel"
+        ]
+      }
+    },
     "/objects": {
       "get": {
         "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
@@ -7870,14 +10830,76 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "ApiKey": {
+      "description": "api key which was created at runtime",
+      "type": "object",
+      "required": [
+        "user"
+      ],
+      "properties": {
+        "createdAt": {
+          "description": "time when the api key was created",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true,
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "description": {
+          "description": "description of the purpose of the api key",
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "time when the api key expires, the key doesn't expire if not set",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "id": {
+          "description": "id of the api key",
+          "type": "string",
+          "readOnly": true
+        },
+        "key": {
+          "description": "the api key, it is only returned when the key is created or rotated",
+          "type": "string",
+          "readOnly": true
+        },
+        "lastUsedAt": {
+          "description": "time when the api key was used last",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true,
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "user": {
+          "description": "name of the user which is authenticated by the api key",
+          "type": "string"
+        }
+      }
+    },
+    "ApiKeyExpiration": {
+      "description": "expiration time of an api key",
       "type": "object",
-      "additionalProperties": {
-        "type": "object"
+      "properties": {
+        "expiresAt": {
+          "description": "time when the api key expires",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "example": "2017-07-21T17:32:28Z"
+        }
       }
     },
     "BM25Config": {
@@ -7899,6 +10921,14 @@ func init() {
     "BackupCreateRequest": {
       "description": "Request body for creating a backup of a set of classes",
       "properties": {
+        "archiveWal": {
+          "description": "Continuously archive the write-ahead logs of the backed up classes after the backup has been created. This allows restoring the classes at a later point in time. Archiving stops when the backup is deleted or when another backup archives its logs, it continues after a restart of the node.",
+          "type": "boolean"
+        },
+        "baseId": {
+          "description": "The ID of the base backup of an incremental backup. Segment files which didn't change since the base backup are referenced instead of uploaded again. The base backup must have been created successfully on the same backend.",
+          "type": "string"
+        },
         "config": {
           "description": "Custom configuration for the backup creation process",
           "type": "object"
@@ -7958,7 +10988,8 @@ func init() {
             "TRANSFERRING",
             "TRANSFERRED",
             "SUCCESS",
-            "FAILED"
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
@@ -7970,6 +11001,16 @@ func init() {
           "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
+        "bytesReused": {
+          "description": "number of bytes of files referenced from the base backup instead of being uploaded",
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytesTransferred": {
+          "description": "number of bytes of files uploaded by this backup",
+          "type": "integer",
+          "format": "int64"
+        },
         "error": {
           "description": "error message if creation failed",
           "type": "string"
@@ -7991,7 +11032,58 @@ func init() {
             "TRANSFERRING",
             "TRANSFERRED",
             "SUCCESS",
-            "FAILED"
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupListResponse": {
+      "description": "The list of backups stored on a backend",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/BackupListResponseItems0"
+      }
+    },
+    "BackupListResponseItems0": {
+      "type": "object",
+      "properties": {
+        "baseId": {
+          "description": "The ID of the base backup if the backup is incremental.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes contained in the backup",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "completedAt": {
+          "description": "when the backup was completed",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "The ID of the backup.",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "when the backup was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "status of the backup",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
@@ -7999,6 +11091,13 @@ func init() {
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
+        "classMapping": {
+          "description": "Restore classes under a different name. Maps class names of the backup to the names they are restored as.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "config": {
           "description": "Custom configuration for the backup restoration process",
           "type": "object"
@@ -8016,6 +11115,11 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "pointInTime": {
+          "description": "Restore the state of the classes at this point in time by replaying the write-ahead logs archived after the backup. The backup must have been created with archiveWal. Logs are archived every 10 seconds, which is the granularity of the restored state.",
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
@@ -8054,7 +11158,8 @@ func init() {
             "TRANSFERRING",
             "TRANSFERRED",
             "SUCCESS",
-            "FAILED"
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
@@ -8087,7 +11192,8 @@ func init() {
             "TRANSFERRING",
             "TRANSFERRED",
             "SUCCESS",
-            "FAILED"
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
@@ -8582,12 +11688,19 @@ func init() {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "vectorConfig": {
+          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
         "vectorIndexConfig": {
           "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
         },
         "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
+          "description": "Name of the vector index to use, eg. (HNSW, flat, dynamic)",
           "type": "string"
         },
         "vectorizer": {
@@ -8988,6 +12101,15 @@ func init() {
     "MultiTenancyConfig": {
       "description": "Configuration related to multi-tenancy within a class",
       "properties": {
+        "autoTenantActivation": {
+          "description": "Whether or not COLD tenants are activated when they are read or written",
+          "type": "boolean"
+        },
+        "autoTenantDeactivationTimeoutSeconds": {
+          "description": "Tenants which are neither read nor written for n seconds are deactivated. Requires autoTenantActivation, 0 disables the deactivation",
+          "type": "number",
+          "format": "int"
+        },
         "enabled": {
           "description": "Whether or not multi-tenancy is enabled for this class",
           "type": "boolean",
@@ -9002,6 +12124,84 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "indexFilterable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
+        }
+      }
+    },
+    "NodeDrainStatus": {
+      "description": "The status of draining a node",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The error which stopped draining the node.",
+          "type": "string"
+        },
+        "node": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "replicas": {
+          "description": "The number of shard replicas still owned by the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "skippedClasses": {
+          "description": "Multi-tenant classes whose tenant replicas are not moved off the node, moving tenants is not supported. The node keeps draining until these tenants are deleted or removed from the node by other means.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "description": "The drain status of the node. A drained node owns no shard replicas anymore and is safe to remove.",
+          "type": "string",
+          "enum": [
+            "ACTIVE",
+            "DRAINING",
+            "DRAINED",
+            "FAILED"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -9020,6 +12220,12 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "vectorQueueLength": {
+          "description": "The number of vectors waiting to be added to the vector index. Only set if asynchronous indexing is enabled.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
@@ -9084,6 +12290,23 @@ func init() {
         }
       }
     },
+    "NodesRebalanceResponse": {
+      "description": "The shard replica moves which even out the load of the nodes",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "Whether the moves were only planned but not executed.",
+          "type": "boolean"
+        },
+        "moves": {
+          "description": "The planned or executed shard replica moves.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicaMove"
+          }
+        }
+      }
+    },
     "NodesStatusResponse": {
       "description": "The status of all of the Weaviate nodes",
       "type": "object",
@@ -9134,6 +12357,10 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "description": "This object's named vectors. Only used by classes that configure named vectors through ` + "`" + `vectorConfig` + "`" + `.",
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
@@ -9321,6 +12548,27 @@ func init() {
         "$ref": "#/definitions/PeerUpdate"
       }
     },
+    "Permission": {
+      "description": "permission to perform actions on the resources matching a pattern",
+      "type": "object",
+      "required": [
+        "actions",
+        "resource"
+      ],
+      "properties": {
+        "actions": {
+          "description": "actions which are allowed on the resources. Allowed values are ` + "`" + `create` + "`" + `, ` + "`" + `get` + "`" + `, ` + "`" + `list` + "`" + `, ` + "`" + `head` + "`" + `, ` + "`" + `update` + "`" + `, ` + "`" + `delete` + "`" + `, ` + "`" + `validate` + "`" + `, ` + "`" + `restore` + "`" + ` or ` + "`" + `*` + "`" + ` for all actions",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "resource": {
+          "description": "pattern of the resources the actions are allowed on, ` + "`" + `*` + "`" + ` matches any sequence of characters. Resources are ` + "`" + `schema/collections/{class}` + "`" + `, ` + "`" + `schema/collections/{class}/shards/{shard}` + "`" + `, ` + "`" + `schema/collections/{class}/tenants/{tenant}` + "`" + `, ` + "`" + `data/collections/{class}/tenants/{tenant}/objects/{id}` + "`" + `, ` + "`" + `backups/{backend}/{id}` + "`" + `, ` + "`" + `classifications/{id}` + "`" + `, ` + "`" + `cluster/nodes` + "`" + `, ` + "`" + `graphql` + "`" + `, ` + "`" + `roles/{name}` + "`" + `, ` + "`" + `users/{name}` + "`" + ` and ` + "`" + `groups/{name}` + "`" + `",
+          "type": "string"
+        }
+      }
+    },
     "PhoneNumber": {
       "properties": {
         "countryCode": {
@@ -9407,6 +12655,17 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "textAnalyzer": {
+          "$ref": "#/definitions/TextAnalyzerConfig"
+        },
         "tokenization": {
           "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
           "type": "string",
@@ -9481,6 +12740,28 @@ func init() {
         }
       }
     },
+    "ReplicaMove": {
+      "description": "A shard replica moved from one node to another node",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The name of the class the shard belongs to.",
+          "type": "string"
+        },
+        "from": {
+          "description": "The node the replica is moved from.",
+          "type": "string"
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The node the replica is moved to.",
+          "type": "string"
+        }
+      }
+    },
     "ReplicationConfig": {
       "description": "Configure how replication is executed in a cluster",
       "type": "object",
@@ -9491,6 +12772,26 @@ func init() {
         }
       }
     },
+    "Role": {
+      "description": "named set of permissions which is assigned to users and groups",
+      "type": "object",
+      "required": [
+        "name",
+        "permissions"
+      ],
+      "properties": {
+        "name": {
+          "description": "name of the role",
+          "type": "string"
+        },
+        "permissions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Permission"
+          }
+        }
+      }
+    },
     "Schema": {
       "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
       "type": "object",
@@ -9633,13 +12934,15 @@ func init() {
       "type": "object",
       "properties": {
         "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on the configured tenant offload backend instead of locally. ` + "`" + `FREEZING` + "`" + ` and ` + "`" + `UNFREEZING` + "`" + ` are only returned when listing tenants, while the tenant's files are uploaded to or downloaded from the offload backend",
           "type": "string",
           "enum": [
             "HOT",
             "WARM",
             "COLD",
-            "FROZEN"
+            "FROZEN",
+            "FREEZING",
+            "UNFREEZING"
           ]
         },
         "name": {
@@ -9648,10 +12951,58 @@ func init() {
         }
       }
     },
+    "TextAnalyzerConfig": {
+      "description": "Language-specific analysis of the terms of a text property. It is applied when the property is indexed as well as to filter values and bm25 queries on the property.",
+      "type": "object",
+      "properties": {
+        "asciiFold": {
+          "description": "Whether accents and other diacritics are removed from terms, e.g. ` + "`" + `café` + "`" + ` is indexed as ` + "`" + `cafe` + "`" + `.",
+          "type": "boolean"
+        },
+        "language": {
+          "description": "Language of the text. English terms (` + "`" + `en` + "`" + `) are reduced to their stem by the Snowball (Porter2) stemmer, e.g. ` + "`" + `generously` + "`" + ` is indexed as ` + "`" + `generous` + "`" + `. Stemming other languages is not supported, their terms can be folded with ` + "`" + `asciiFold` + "`" + ` and filtered with a ` + "`" + `stopwordPreset` + "`" + ` instead. Sequences of Chinese, Japanese or Korean characters (` + "`" + `zh` + "`" + `, ` + "`" + `ja` + "`" + `, ` + "`" + `ko` + "`" + `) are split into overlapping pairs of characters (bigrams), words are not segmented by a dictionary.",
+          "type": "string",
+          "enum": [
+            "en",
+            "zh",
+            "ja",
+            "ko"
+          ]
+        },
+        "stopwordPreset": {
+          "description": "Stopword preset applied to bm25 queries on the property instead of the stopwords of the class, e.g. ` + "`" + `de` + "`" + `.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorConfig": {
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW, flat, dynamic)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of a specific vectorizer used by this vector",
+          "type": "object"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors for multi-vector representations.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -9669,6 +13020,7 @@ func init() {
           "enum": [
             "And",
             "Or",
+            "Not",
             "Equal",
             "Like",
             "NotEqual",
@@ -9679,7 +13031,8 @@ func init() {
             "WithinGeoRange",
             "IsNull",
             "ContainsAny",
-            "ContainsAll"
+            "ContainsAll",
+            "ContainsNone"
           ],
           "example": "GreaterThanEqual"
         },
@@ -9944,6 +13297,14 @@ func init() {
     {
       "description": "These operations enable manipulation of the schema in Weaviate schema.",
       "name": "schema"
+    },
+    {
+      "description": "These operations manage roles and their assignment to users and groups.",
+      "name": "authz"
+    },
+    {
+      "description": "These operations manage api keys which are created at runtime.",
+      "name": "apikeys"
     }
   ],
   "externalDocs": {
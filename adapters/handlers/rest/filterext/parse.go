@@ -139,12 +139,16 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorAnd, nil
 	case models.WhereFilterOperatorOr:
 		return filters.OperatorOr, nil
+	case models.WhereFilterOperatorNot:
+		return filters.OperatorNot, nil
 	case models.WhereFilterOperatorIsNull:
 		return filters.OperatorIsNull, nil
 	case models.WhereFilterOperatorContainsAny:
 		return filters.ContainsAny, nil
 	case models.WhereFilterOperatorContainsAll:
 		return filters.ContainsAll, nil
+	case models.WhereFilterOperatorContainsNone:
+		return filters.ContainsNone, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
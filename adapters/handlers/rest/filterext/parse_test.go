@@ -330,6 +330,11 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("LessThanEqual"),
 				expectedFilter: intFilterWithOp(filters.OperatorLessThanEqual),
 			},
+			{
+				name:           "contains none",
+				input:          inputIntFilterWithOp("ContainsNone"),
+				expectedFilter: intFilterWithOp(filters.ContainsNone),
+			},
 		}
 
 		for _, test := range tests {
@@ -432,6 +437,33 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				},
 			},
+			{
+				name: "negated using not",
+				input: &models.WhereFilter{
+					Operator: "Not",
+					Operands: []*models.WhereFilter{
+						inputIntFilterWithValue(42),
+					},
+				},
+				expectedFilter: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorNot,
+						Operands: []filters.Clause{
+							{
+								Operator: filters.OperatorEqual,
+								On: &filters.Path{
+									Class:    schema.AssertValidClassName("Todo"),
+									Property: schema.AssertValidPropertyName("intField"),
+								},
+								Value: &filters.Value{
+									Value: 42,
+									Type:  schema.DataTypeInt,
+								},
+							},
+						},
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/entities/models"
+	apikeysUC "github.com/weaviate/weaviate/usecases/apikeys"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type apiKeysHandlers struct {
+	manager             *apikeysUC.Manager
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *apiKeysHandlers) list(params apikeys.ApikeysListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := h.manager.List(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return apikeys.NewApikeysListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return apikeys.NewApikeysListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysListOK().WithPayload(res)
+}
+
+func (h *apiKeysHandlers) create(params apikeys.ApikeysCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := h.manager.Create(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return apikeys.NewApikeysCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case apikeysUC.ErrUnprocessable:
+			return apikeys.NewApikeysCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return apikeys.NewApikeysCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysCreateCreated().WithPayload(res)
+}
+
+func (h *apiKeysHandlers) rotate(params apikeys.ApikeysRotateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := h.manager.Rotate(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return apikeys.NewApikeysRotateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case apikeysUC.ErrNotFound:
+			return apikeys.NewApikeysRotateNotFound()
+		default:
+			return apikeys.NewApikeysRotateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysRotateOK().WithPayload(res)
+}
+
+func (h *apiKeysHandlers) expire(params apikeys.ApikeysExpireParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var expiresAt time.Time
+	if params.Body != nil && params.Body.ExpiresAt != nil {
+		expiresAt = time.Time(*params.Body.ExpiresAt)
+	}
+
+	res, err := h.manager.Expire(params.HTTPRequest.Context(), principal, params.ID, expiresAt)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return apikeys.NewApikeysExpireForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case apikeysUC.ErrNotFound:
+			return apikeys.NewApikeysExpireNotFound()
+		case apikeysUC.ErrUnprocessable:
+			return apikeys.NewApikeysExpireUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return apikeys.NewApikeysExpireInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysExpireOK().WithPayload(res)
+}
+
+func (h *apiKeysHandlers) revoke(params apikeys.ApikeysRevokeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.Revoke(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return apikeys.NewApikeysRevokeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case apikeysUC.ErrNotFound:
+			return apikeys.NewApikeysRevokeNotFound()
+		default:
+			return apikeys.NewApikeysRevokeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysRevokeNoContent()
+}
+
+func setupAPIKeysHandlers(api *operations.WeaviateAPI, manager *apikeysUC.Manager,
+	metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &apiKeysHandlers{manager, newAPIKeysRequestsTotal(metrics, logger)}
+	api.ApikeysApikeysListHandler = apikeys.ApikeysListHandlerFunc(h.list)
+	api.ApikeysApikeysCreateHandler = apikeys.ApikeysCreateHandlerFunc(h.create)
+	api.ApikeysApikeysRotateHandler = apikeys.ApikeysRotateHandlerFunc(h.rotate)
+	api.ApikeysApikeysExpireHandler = apikeys.ApikeysExpireHandlerFunc(h.expire)
+	api.ApikeysApikeysRevokeHandler = apikeys.ApikeysRevokeHandlerFunc(h.revoke)
+}
+
+type apiKeysRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newAPIKeysRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &apiKeysRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "apikeys", logger},
+	}
+}
+
+func (e *apiKeysRequestsTotal) logError(className string, err error) {
+	switch err.(type) {
+	case errors.Forbidden:
+		e.logUserError(className)
+	case apikeysUC.ErrUnprocessable, apikeysUC.ErrNotFound:
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/roles"
+)
+
+type authzHandlers struct {
+	manager             *roles.Manager
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *authzHandlers) getRoles(params authz.AuthzGetRolesParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := h.manager.GetRoles(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzGetRolesForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzGetRolesInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzGetRolesOK().WithPayload(res)
+}
+
+func (h *authzHandlers) getRole(params authz.AuthzGetRoleParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := h.manager.GetRole(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzGetRoleForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrNotFound:
+			return authz.NewAuthzGetRoleNotFound()
+		default:
+			return authz.NewAuthzGetRoleInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzGetRoleOK().WithPayload(res)
+}
+
+func (h *authzHandlers) createRole(params authz.AuthzCreateRoleParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.CreateRole(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzCreateRoleForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrConflict:
+			return authz.NewAuthzCreateRoleConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrUnprocessable:
+			return authz.NewAuthzCreateRoleUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzCreateRoleInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzCreateRoleCreated()
+}
+
+func (h *authzHandlers) updateRole(params authz.AuthzUpdateRoleParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.UpdateRole(params.HTTPRequest.Context(), principal, params.ID, params.Body)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzUpdateRoleForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrNotFound:
+			return authz.NewAuthzUpdateRoleNotFound()
+		case roles.ErrUnprocessable:
+			return authz.NewAuthzUpdateRoleUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzUpdateRoleInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzUpdateRoleOK()
+}
+
+func (h *authzHandlers) deleteRole(params authz.AuthzDeleteRoleParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.DeleteRole(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzDeleteRoleForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrNotFound:
+			return authz.NewAuthzDeleteRoleNotFound()
+		case roles.ErrUnprocessable:
+			return authz.NewAuthzDeleteRoleUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzDeleteRoleInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzDeleteRoleNoContent()
+}
+
+func (h *authzHandlers) getRolesForUser(params authz.AuthzGetRolesForUserParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := h.manager.GetRolesForUser(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzGetRolesForUserForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzGetRolesForUserInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzGetRolesForUserOK().WithPayload(res)
+}
+
+func (h *authzHandlers) assignRoleToUser(params authz.AuthzAssignRoleToUserParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.AssignRolesToUser(params.HTTPRequest.Context(), principal, params.ID, params.Body.Roles)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzAssignRoleToUserForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrNotFound:
+			return authz.NewAuthzAssignRoleToUserNotFound()
+		case roles.ErrUnprocessable:
+			return authz.NewAuthzAssignRoleToUserUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzAssignRoleToUserInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzAssignRoleToUserOK()
+}
+
+func (h *authzHandlers) revokeRoleFromUser(params authz.AuthzRevokeRoleFromUserParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.RevokeRolesFromUser(params.HTTPRequest.Context(), principal, params.ID, params.Body.Roles)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzRevokeRoleFromUserForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrNotFound:
+			return authz.NewAuthzRevokeRoleFromUserNotFound()
+		case roles.ErrUnprocessable:
+			return authz.NewAuthzRevokeRoleFromUserUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRevokeRoleFromUserInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzRevokeRoleFromUserOK()
+}
+
+func (h *authzHandlers) getRolesForGroup(params authz.AuthzGetRolesForGroupParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := h.manager.GetRolesForGroup(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzGetRolesForGroupForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzGetRolesForGroupInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzGetRolesForGroupOK().WithPayload(res)
+}
+
+func (h *authzHandlers) assignRoleToGroup(params authz.AuthzAssignRoleToGroupParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.AssignRolesToGroup(params.HTTPRequest.Context(), principal, params.ID, params.Body.Roles)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzAssignRoleToGroupForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrNotFound:
+			return authz.NewAuthzAssignRoleToGroupNotFound()
+		case roles.ErrUnprocessable:
+			return authz.NewAuthzAssignRoleToGroupUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzAssignRoleToGroupInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzAssignRoleToGroupOK()
+}
+
+func (h *authzHandlers) revokeRoleFromGroup(params authz.AuthzRevokeRoleFromGroupParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.RevokeRolesFromGroup(params.HTTPRequest.Context(), principal, params.ID, params.Body.Roles)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzRevokeRoleFromGroupForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case roles.ErrNotFound:
+			return authz.NewAuthzRevokeRoleFromGroupNotFound()
+		case roles.ErrUnprocessable:
+			return authz.NewAuthzRevokeRoleFromGroupUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRevokeRoleFromGroupInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzRevokeRoleFromGroupOK()
+}
+
+func setupAuthzHandlers(api *operations.WeaviateAPI, manager *roles.Manager,
+	metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &authzHandlers{manager, newAuthzRequestsTotal(metrics, logger)}
+	api.AuthzAuthzGetRolesHandler = authz.AuthzGetRolesHandlerFunc(h.getRoles)
+	api.AuthzAuthzGetRoleHandler = authz.AuthzGetRoleHandlerFunc(h.getRole)
+	api.AuthzAuthzCreateRoleHandler = authz.AuthzCreateRoleHandlerFunc(h.createRole)
+	api.AuthzAuthzUpdateRoleHandler = authz.AuthzUpdateRoleHandlerFunc(h.updateRole)
+	api.AuthzAuthzDeleteRoleHandler = authz.AuthzDeleteRoleHandlerFunc(h.deleteRole)
+	api.AuthzAuthzGetRolesForUserHandler = authz.AuthzGetRolesForUserHandlerFunc(h.getRolesForUser)
+	api.AuthzAuthzAssignRoleToUserHandler = authz.AuthzAssignRoleToUserHandlerFunc(h.assignRoleToUser)
+	api.AuthzAuthzRevokeRoleFromUserHandler = authz.AuthzRevokeRoleFromUserHandlerFunc(h.revokeRoleFromUser)
+	api.AuthzAuthzGetRolesForGroupHandler = authz.AuthzGetRolesForGroupHandlerFunc(h.getRolesForGroup)
+	api.AuthzAuthzAssignRoleToGroupHandler = authz.AuthzAssignRoleToGroupHandlerFunc(h.assignRoleToGroup)
+	api.AuthzAuthzRevokeRoleFromGroupHandler = authz.AuthzRevokeRoleFromGroupHandlerFunc(h.revokeRoleFromGroup)
+}
+
+type authzRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newAuthzRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &authzRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "authz", logger},
+	}
+}
+
+func (e *authzRequestsTotal) logError(className string, err error) {
+	switch err.(type) {
+	case errors.Forbidden:
+		e.logUserError(className)
+	case roles.ErrUnprocessable, roles.ErrNotFound, roles.ErrConflict:
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
@@ -12,6 +12,8 @@
 package rest
 
 import (
+	"time"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
@@ -32,10 +34,12 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	req := ubak.BackupRequest{
-		ID:      params.Body.ID,
-		Backend: params.Backend,
-		Include: params.Body.Include,
-		Exclude: params.Body.Exclude,
+		ID:         params.Body.ID,
+		Backend:    params.Backend,
+		Include:    params.Body.Include,
+		Exclude:    params.Body.Exclude,
+		BaseID:     params.Body.BaseID,
+		ArchiveWAL: params.Body.ArchiveWal,
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -86,6 +90,9 @@ func (s *backupHandlers) createBackupStatus(params backups.BackupsCreateStatusPa
 		Path:    status.Path,
 		Backend: params.Backend,
 		Error:   status.Err,
+
+		BytesTransferred: status.BytesTransferred,
+		BytesReused:      status.BytesReused,
 	}
 	s.metricRequestsTotal.logOk("")
 	return backups.NewBackupsCreateStatusOK().WithPayload(&payload)
@@ -95,10 +102,12 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	req := ubak.BackupRequest{
-		ID:      params.ID,
-		Backend: params.Backend,
-		Include: params.Body.Include,
-		Exclude: params.Body.Exclude,
+		ID:           params.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		ClassMapping: params.Body.ClassMapping,
+		PointInTime:  time.Time(params.Body.PointInTime),
 	}
 	meta, err := s.manager.Restore(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -157,6 +166,107 @@ func (s *backupHandlers) restoreBackupStatus(params backups.BackupsRestoreStatus
 	return backups.NewBackupsRestoreStatusOK().WithPayload(&payload)
 }
 
+func (s *backupHandlers) listBackups(params backups.BackupsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	list, err := s.manager.List(params.HTTPRequest.Context(), principal, params.Backend)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsListOK().WithPayload(list)
+}
+
+func (s *backupHandlers) deleteBackup(params backups.BackupsDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.Delete(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsDeleteNoContent()
+}
+
+func (s *backupHandlers) cancelBackup(params backups.BackupsCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.Cancel(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsCancelUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsCancelNoContent()
+}
+
+func (s *backupHandlers) cancelRestore(params backups.BackupsRestoreCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.CancelRestore(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsRestoreCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsRestoreCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsRestoreCancelUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsRestoreCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsRestoreCancelNoContent()
+}
+
 func setupBackupHandlers(api *operations.WeaviateAPI,
 	scheduler *ubak.Scheduler, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
 ) {
@@ -169,6 +279,14 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 		BackupsRestoreHandlerFunc(h.restoreBackup)
 	api.BackupsBackupsRestoreStatusHandler = backups.
 		BackupsRestoreStatusHandlerFunc(h.restoreBackupStatus)
+	api.BackupsBackupsListHandler = backups.
+		BackupsListHandlerFunc(h.listBackups)
+	api.BackupsBackupsDeleteHandler = backups.
+		BackupsDeleteHandlerFunc(h.deleteBackup)
+	api.BackupsBackupsCancelHandler = backups.
+		BackupsCancelHandlerFunc(h.cancelBackup)
+	api.BackupsBackupsRestoreCancelHandler = backups.
+		BackupsRestoreCancelHandlerFunc(h.cancelRestore)
 }
 
 type backupRequestsTotal struct {
@@ -32,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 const error422 string = "The request is well-formed but was unable to be followed due to semantic errors."
@@ -55,10 +56,10 @@ func setupGraphQLHandlers(
 ) {
 	metricRequestsTotal := newGraphqlRequestsTotal(metrics, logger)
 	api.GraphqlGraphqlPostHandler = graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
-		// All requests to the graphQL API need at least permissions to read the schema. Request might have further
-		// authorization requirements.
+		// All requests to the graphQL API need permissions to use it. The resolvers authorize the individual
+		// queries.
 
-		err := m.Authorizer.Authorize(principal, "list", "schema/*")
+		err := m.Authorizer.Authorize(principal, "get", authorization.GraphQL)
 		if err != nil {
 			metricRequestsTotal.logUserError()
 			switch err.(type) {
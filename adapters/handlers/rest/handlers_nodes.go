@@ -16,6 +16,7 @@ import (
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/clients"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
@@ -79,17 +80,105 @@ func (s *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 		WithPayload(errPayloadFromSingleErr(err))
 }
 
+func (s *nodesHandlers) drainNode(params nodes.NodesDrainParams, principal *models.Principal) middleware.Responder {
+	status, err := s.manager.DrainNode(params.HTTPRequest.Context(), principal, params.NodeName)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return nodes.NewNodesDrainNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesDrainForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesDrainUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesDrainInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return nodes.NewNodesDrainOK().WithPayload(status)
+}
+
+func (s *nodesHandlers) drainStatus(params nodes.NodesDrainStatusParams, principal *models.Principal) middleware.Responder {
+	status, err := s.manager.DrainStatus(params.HTTPRequest.Context(), principal, params.NodeName)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return nodes.NewNodesDrainStatusNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesDrainStatusForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesDrainStatusUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesDrainStatusInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return nodes.NewNodesDrainStatusOK().WithPayload(status)
+}
+
+func (s *nodesHandlers) cancelDrain(params nodes.NodesDrainCancelParams, principal *models.Principal) middleware.Responder {
+	status, err := s.manager.CancelDrain(params.HTTPRequest.Context(), principal, params.NodeName)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return nodes.NewNodesDrainCancelNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesDrainCancelForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesDrainCancelUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesDrainCancelInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return nodes.NewNodesDrainCancelOK().WithPayload(status)
+}
+
+func (s *nodesHandlers) rebalance(params nodes.NodesRebalanceParams, principal *models.Principal) middleware.Responder {
+	dryRun := params.DryRun != nil && *params.DryRun
+	res, err := s.manager.Rebalance(params.HTTPRequest.Context(), principal, dryRun)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesRebalanceForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesRebalanceUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesRebalanceInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return nodes.NewNodesRebalanceOK().WithPayload(res)
+}
+
 func setupNodesHandlers(api *operations.WeaviateAPI,
 	schemaManger *schemaUC.Manager, repo *db.DB, appState *state.State,
+	nodesClient *clients.RemoteNode,
 ) {
 	nodesManager := nodesUC.NewManager(appState.Logger, appState.Authorizer,
-		repo, schemaManger)
+		repo, schemaManger, appState.Cluster, nodesClient)
 
 	h := &nodesHandlers{nodesManager, newNodesRequestsTotal(appState.Metrics, appState.Logger)}
 	api.NodesNodesGetHandler = nodes.
 		NodesGetHandlerFunc(h.getNodesStatus)
 	api.NodesNodesGetClassHandler = nodes.
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
+	api.NodesNodesDrainHandler = nodes.
+		NodesDrainHandlerFunc(h.drainNode)
+	api.NodesNodesDrainStatusHandler = nodes.
+		NodesDrainStatusHandlerFunc(h.drainStatus)
+	api.NodesNodesDrainCancelHandler = nodes.
+		NodesDrainCancelHandlerFunc(h.cancelDrain)
+	api.NodesNodesRebalanceHandler = nodes.
+		NodesRebalanceHandlerFunc(h.rebalance)
 }
 
 type nodesRequestsTotal struct {
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysCreateHandlerFunc turns a function with the right signature into a apikeys create handler
+type ApikeysCreateHandlerFunc func(ApikeysCreateParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ApikeysCreateHandlerFunc) Handle(params ApikeysCreateParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ApikeysCreateHandler interface for that can handle valid apikeys create params
+type ApikeysCreateHandler interface {
+	Handle(ApikeysCreateParams, *models.Principal) middleware.Responder
+}
+
+// NewApikeysCreate creates a new http.Handler for the apikeys create operation
+func NewApikeysCreate(ctx *middleware.Context, handler ApikeysCreateHandler) *ApikeysCreate {
+	return &ApikeysCreate{Context: ctx, Handler: handler}
+}
+
+/*
+	ApikeysCreate swagger:route POST /apikeys apikeys apikeysCreate
+
+create a new api key for a user, the secret is only returned in this response
+*/
+type ApikeysCreate struct {
+	Context *middleware.Context
+	Handler ApikeysCreateHandler
+}
+
+func (o *ApikeysCreate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewApikeysCreateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysCreateCreatedCode is the HTTP code returned for type ApikeysCreateCreated
+const ApikeysCreateCreatedCode int = 201
+
+/*
+ApikeysCreateCreated Api key created successfully
+
+swagger:response apikeysCreateCreated
+*/
+type ApikeysCreateCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIKey `json:"body,omitempty"`
+}
+
+// NewApikeysCreateCreated creates ApikeysCreateCreated with default headers values
+func NewApikeysCreateCreated() *ApikeysCreateCreated {
+
+	return &ApikeysCreateCreated{}
+}
+
+// WithPayload adds the payload to the apikeys create created response
+func (o *ApikeysCreateCreated) WithPayload(payload *models.APIKey) *ApikeysCreateCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys create created response
+func (o *ApikeysCreateCreated) SetPayload(payload *models.APIKey) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysCreateCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysCreateUnauthorizedCode is the HTTP code returned for type ApikeysCreateUnauthorized
+const ApikeysCreateUnauthorizedCode int = 401
+
+/*
+ApikeysCreateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response apikeysCreateUnauthorized
+*/
+type ApikeysCreateUnauthorized struct {
+}
+
+// NewApikeysCreateUnauthorized creates ApikeysCreateUnauthorized with default headers values
+func NewApikeysCreateUnauthorized() *ApikeysCreateUnauthorized {
+
+	return &ApikeysCreateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysCreateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ApikeysCreateForbiddenCode is the HTTP code returned for type ApikeysCreateForbidden
+const ApikeysCreateForbiddenCode int = 403
+
+/*
+ApikeysCreateForbidden Forbidden
+
+swagger:response apikeysCreateForbidden
+*/
+type ApikeysCreateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysCreateForbidden creates ApikeysCreateForbidden with default headers values
+func NewApikeysCreateForbidden() *ApikeysCreateForbidden {
+
+	return &ApikeysCreateForbidden{}
+}
+
+// WithPayload adds the payload to the apikeys create forbidden response
+func (o *ApikeysCreateForbidden) WithPayload(payload *models.ErrorResponse) *ApikeysCreateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys create forbidden response
+func (o *ApikeysCreateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysCreateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysCreateUnprocessableEntityCode is the HTTP code returned for type ApikeysCreateUnprocessableEntity
+const ApikeysCreateUnprocessableEntityCode int = 422
+
+/*
+ApikeysCreateUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response apikeysCreateUnprocessableEntity
+*/
+type ApikeysCreateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysCreateUnprocessableEntity creates ApikeysCreateUnprocessableEntity with default headers values
+func NewApikeysCreateUnprocessableEntity() *ApikeysCreateUnprocessableEntity {
+
+	return &ApikeysCreateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the apikeys create unprocessable entity response
+func (o *ApikeysCreateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ApikeysCreateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys create unprocessable entity response
+func (o *ApikeysCreateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysCreateInternalServerErrorCode is the HTTP code returned for type ApikeysCreateInternalServerError
+const ApikeysCreateInternalServerErrorCode int = 500
+
+/*
+ApikeysCreateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response apikeysCreateInternalServerError
+*/
+type ApikeysCreateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysCreateInternalServerError creates ApikeysCreateInternalServerError with default headers values
+func NewApikeysCreateInternalServerError() *ApikeysCreateInternalServerError {
+
+	return &ApikeysCreateInternalServerError{}
+}
+
+// WithPayload adds the payload to the apikeys create internal server error response
+func (o *ApikeysCreateInternalServerError) WithPayload(payload *models.ErrorResponse) *ApikeysCreateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys create internal server error response
+func (o *ApikeysCreateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysCreateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
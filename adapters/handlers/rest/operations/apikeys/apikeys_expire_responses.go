@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysExpireOKCode is the HTTP code returned for type ApikeysExpireOK
+const ApikeysExpireOKCode int = 200
+
+/*
+ApikeysExpireOK Api key expiration set successfully
+
+swagger:response apikeysExpireOK
+*/
+type ApikeysExpireOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIKey `json:"body,omitempty"`
+}
+
+// NewApikeysExpireOK creates ApikeysExpireOK with default headers values
+func NewApikeysExpireOK() *ApikeysExpireOK {
+
+	return &ApikeysExpireOK{}
+}
+
+// WithPayload adds the payload to the apikeys expire o k response
+func (o *ApikeysExpireOK) WithPayload(payload *models.APIKey) *ApikeysExpireOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys expire o k response
+func (o *ApikeysExpireOK) SetPayload(payload *models.APIKey) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysExpireOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysExpireUnauthorizedCode is the HTTP code returned for type ApikeysExpireUnauthorized
+const ApikeysExpireUnauthorizedCode int = 401
+
+/*
+ApikeysExpireUnauthorized Unauthorized or invalid credentials.
+
+swagger:response apikeysExpireUnauthorized
+*/
+type ApikeysExpireUnauthorized struct {
+}
+
+// NewApikeysExpireUnauthorized creates ApikeysExpireUnauthorized with default headers values
+func NewApikeysExpireUnauthorized() *ApikeysExpireUnauthorized {
+
+	return &ApikeysExpireUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysExpireUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ApikeysExpireForbiddenCode is the HTTP code returned for type ApikeysExpireForbidden
+const ApikeysExpireForbiddenCode int = 403
+
+/*
+ApikeysExpireForbidden Forbidden
+
+swagger:response apikeysExpireForbidden
+*/
+type ApikeysExpireForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysExpireForbidden creates ApikeysExpireForbidden with default headers values
+func NewApikeysExpireForbidden() *ApikeysExpireForbidden {
+
+	return &ApikeysExpireForbidden{}
+}
+
+// WithPayload adds the payload to the apikeys expire forbidden response
+func (o *ApikeysExpireForbidden) WithPayload(payload *models.ErrorResponse) *ApikeysExpireForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys expire forbidden response
+func (o *ApikeysExpireForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysExpireForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysExpireNotFoundCode is the HTTP code returned for type ApikeysExpireNotFound
+const ApikeysExpireNotFoundCode int = 404
+
+/*
+ApikeysExpireNotFound no api key found
+
+swagger:response apikeysExpireNotFound
+*/
+type ApikeysExpireNotFound struct {
+}
+
+// NewApikeysExpireNotFound creates ApikeysExpireNotFound with default headers values
+func NewApikeysExpireNotFound() *ApikeysExpireNotFound {
+
+	return &ApikeysExpireNotFound{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysExpireNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// ApikeysExpireUnprocessableEntityCode is the HTTP code returned for type ApikeysExpireUnprocessableEntity
+const ApikeysExpireUnprocessableEntityCode int = 422
+
+/*
+ApikeysExpireUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response apikeysExpireUnprocessableEntity
+*/
+type ApikeysExpireUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysExpireUnprocessableEntity creates ApikeysExpireUnprocessableEntity with default headers values
+func NewApikeysExpireUnprocessableEntity() *ApikeysExpireUnprocessableEntity {
+
+	return &ApikeysExpireUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the apikeys expire unprocessable entity response
+func (o *ApikeysExpireUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ApikeysExpireUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys expire unprocessable entity response
+func (o *ApikeysExpireUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysExpireUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysExpireInternalServerErrorCode is the HTTP code returned for type ApikeysExpireInternalServerError
+const ApikeysExpireInternalServerErrorCode int = 500
+
+/*
+ApikeysExpireInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response apikeysExpireInternalServerError
+*/
+type ApikeysExpireInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysExpireInternalServerError creates ApikeysExpireInternalServerError with default headers values
+func NewApikeysExpireInternalServerError() *ApikeysExpireInternalServerError {
+
+	return &ApikeysExpireInternalServerError{}
+}
+
+// WithPayload adds the payload to the apikeys expire internal server error response
+func (o *ApikeysExpireInternalServerError) WithPayload(payload *models.ErrorResponse) *ApikeysExpireInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys expire internal server error response
+func (o *ApikeysExpireInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysExpireInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysListHandlerFunc turns a function with the right signature into a apikeys list handler
+type ApikeysListHandlerFunc func(ApikeysListParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ApikeysListHandlerFunc) Handle(params ApikeysListParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ApikeysListHandler interface for that can handle valid apikeys list params
+type ApikeysListHandler interface {
+	Handle(ApikeysListParams, *models.Principal) middleware.Responder
+}
+
+// NewApikeysList creates a new http.Handler for the apikeys list operation
+func NewApikeysList(ctx *middleware.Context, handler ApikeysListHandler) *ApikeysList {
+	return &ApikeysList{Context: ctx, Handler: handler}
+}
+
+/*
+	ApikeysList swagger:route GET /apikeys apikeys apikeysList
+
+list all api keys which were created at runtime, their secrets are never returned
+*/
+type ApikeysList struct {
+	Context *middleware.Context
+	Handler ApikeysListHandler
+}
+
+func (o *ApikeysList) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewApikeysListParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysListOKCode is the HTTP code returned for type ApikeysListOK
+const ApikeysListOKCode int = 200
+
+/*
+ApikeysListOK Successful response.
+
+swagger:response apikeysListOK
+*/
+type ApikeysListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.APIKey `json:"body,omitempty"`
+}
+
+// NewApikeysListOK creates ApikeysListOK with default headers values
+func NewApikeysListOK() *ApikeysListOK {
+
+	return &ApikeysListOK{}
+}
+
+// WithPayload adds the payload to the apikeys list o k response
+func (o *ApikeysListOK) WithPayload(payload []*models.APIKey) *ApikeysListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys list o k response
+func (o *ApikeysListOK) SetPayload(payload []*models.APIKey) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.APIKey, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ApikeysListUnauthorizedCode is the HTTP code returned for type ApikeysListUnauthorized
+const ApikeysListUnauthorizedCode int = 401
+
+/*
+ApikeysListUnauthorized Unauthorized or invalid credentials.
+
+swagger:response apikeysListUnauthorized
+*/
+type ApikeysListUnauthorized struct {
+}
+
+// NewApikeysListUnauthorized creates ApikeysListUnauthorized with default headers values
+func NewApikeysListUnauthorized() *ApikeysListUnauthorized {
+
+	return &ApikeysListUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysListUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ApikeysListForbiddenCode is the HTTP code returned for type ApikeysListForbidden
+const ApikeysListForbiddenCode int = 403
+
+/*
+ApikeysListForbidden Forbidden
+
+swagger:response apikeysListForbidden
+*/
+type ApikeysListForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysListForbidden creates ApikeysListForbidden with default headers values
+func NewApikeysListForbidden() *ApikeysListForbidden {
+
+	return &ApikeysListForbidden{}
+}
+
+// WithPayload adds the payload to the apikeys list forbidden response
+func (o *ApikeysListForbidden) WithPayload(payload *models.ErrorResponse) *ApikeysListForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys list forbidden response
+func (o *ApikeysListForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysListForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysListInternalServerErrorCode is the HTTP code returned for type ApikeysListInternalServerError
+const ApikeysListInternalServerErrorCode int = 500
+
+/*
+ApikeysListInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response apikeysListInternalServerError
+*/
+type ApikeysListInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysListInternalServerError creates ApikeysListInternalServerError with default headers values
+func NewApikeysListInternalServerError() *ApikeysListInternalServerError {
+
+	return &ApikeysListInternalServerError{}
+}
+
+// WithPayload adds the payload to the apikeys list internal server error response
+func (o *ApikeysListInternalServerError) WithPayload(payload *models.ErrorResponse) *ApikeysListInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys list internal server error response
+func (o *ApikeysListInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysListInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysRevokeHandlerFunc turns a function with the right signature into a apikeys revoke handler
+type ApikeysRevokeHandlerFunc func(ApikeysRevokeParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ApikeysRevokeHandlerFunc) Handle(params ApikeysRevokeParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ApikeysRevokeHandler interface for that can handle valid apikeys revoke params
+type ApikeysRevokeHandler interface {
+	Handle(ApikeysRevokeParams, *models.Principal) middleware.Responder
+}
+
+// NewApikeysRevoke creates a new http.Handler for the apikeys revoke operation
+func NewApikeysRevoke(ctx *middleware.Context, handler ApikeysRevokeHandler) *ApikeysRevoke {
+	return &ApikeysRevoke{Context: ctx, Handler: handler}
+}
+
+/*
+	ApikeysRevoke swagger:route DELETE /apikeys/{id} apikeys apikeysRevoke
+
+revoke an api key, it can't be used anymore
+*/
+type ApikeysRevoke struct {
+	Context *middleware.Context
+	Handler ApikeysRevokeHandler
+}
+
+func (o *ApikeysRevoke) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewApikeysRevokeParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysRevokeNoContentCode is the HTTP code returned for type ApikeysRevokeNoContent
+const ApikeysRevokeNoContentCode int = 204
+
+/*
+ApikeysRevokeNoContent Api key revoked successfully
+
+swagger:response apikeysRevokeNoContent
+*/
+type ApikeysRevokeNoContent struct {
+}
+
+// NewApikeysRevokeNoContent creates ApikeysRevokeNoContent with default headers values
+func NewApikeysRevokeNoContent() *ApikeysRevokeNoContent {
+
+	return &ApikeysRevokeNoContent{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysRevokeNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// ApikeysRevokeUnauthorizedCode is the HTTP code returned for type ApikeysRevokeUnauthorized
+const ApikeysRevokeUnauthorizedCode int = 401
+
+/*
+ApikeysRevokeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response apikeysRevokeUnauthorized
+*/
+type ApikeysRevokeUnauthorized struct {
+}
+
+// NewApikeysRevokeUnauthorized creates ApikeysRevokeUnauthorized with default headers values
+func NewApikeysRevokeUnauthorized() *ApikeysRevokeUnauthorized {
+
+	return &ApikeysRevokeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysRevokeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ApikeysRevokeForbiddenCode is the HTTP code returned for type ApikeysRevokeForbidden
+const ApikeysRevokeForbiddenCode int = 403
+
+/*
+ApikeysRevokeForbidden Forbidden
+
+swagger:response apikeysRevokeForbidden
+*/
+type ApikeysRevokeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysRevokeForbidden creates ApikeysRevokeForbidden with default headers values
+func NewApikeysRevokeForbidden() *ApikeysRevokeForbidden {
+
+	return &ApikeysRevokeForbidden{}
+}
+
+// WithPayload adds the payload to the apikeys revoke forbidden response
+func (o *ApikeysRevokeForbidden) WithPayload(payload *models.ErrorResponse) *ApikeysRevokeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys revoke forbidden response
+func (o *ApikeysRevokeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysRevokeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysRevokeNotFoundCode is the HTTP code returned for type ApikeysRevokeNotFound
+const ApikeysRevokeNotFoundCode int = 404
+
+/*
+ApikeysRevokeNotFound no api key found
+
+swagger:response apikeysRevokeNotFound
+*/
+type ApikeysRevokeNotFound struct {
+}
+
+// NewApikeysRevokeNotFound creates ApikeysRevokeNotFound with default headers values
+func NewApikeysRevokeNotFound() *ApikeysRevokeNotFound {
+
+	return &ApikeysRevokeNotFound{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysRevokeNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// ApikeysRevokeInternalServerErrorCode is the HTTP code returned for type ApikeysRevokeInternalServerError
+const ApikeysRevokeInternalServerErrorCode int = 500
+
+/*
+ApikeysRevokeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response apikeysRevokeInternalServerError
+*/
+type ApikeysRevokeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysRevokeInternalServerError creates ApikeysRevokeInternalServerError with default headers values
+func NewApikeysRevokeInternalServerError() *ApikeysRevokeInternalServerError {
+
+	return &ApikeysRevokeInternalServerError{}
+}
+
+// WithPayload adds the payload to the apikeys revoke internal server error response
+func (o *ApikeysRevokeInternalServerError) WithPayload(payload *models.ErrorResponse) *ApikeysRevokeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys revoke internal server error response
+func (o *ApikeysRevokeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysRevokeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
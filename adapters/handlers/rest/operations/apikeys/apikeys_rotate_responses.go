@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysRotateOKCode is the HTTP code returned for type ApikeysRotateOK
+const ApikeysRotateOKCode int = 200
+
+/*
+ApikeysRotateOK Api key rotated successfully
+
+swagger:response apikeysRotateOK
+*/
+type ApikeysRotateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIKey `json:"body,omitempty"`
+}
+
+// NewApikeysRotateOK creates ApikeysRotateOK with default headers values
+func NewApikeysRotateOK() *ApikeysRotateOK {
+
+	return &ApikeysRotateOK{}
+}
+
+// WithPayload adds the payload to the apikeys rotate o k response
+func (o *ApikeysRotateOK) WithPayload(payload *models.APIKey) *ApikeysRotateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys rotate o k response
+func (o *ApikeysRotateOK) SetPayload(payload *models.APIKey) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysRotateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysRotateUnauthorizedCode is the HTTP code returned for type ApikeysRotateUnauthorized
+const ApikeysRotateUnauthorizedCode int = 401
+
+/*
+ApikeysRotateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response apikeysRotateUnauthorized
+*/
+type ApikeysRotateUnauthorized struct {
+}
+
+// NewApikeysRotateUnauthorized creates ApikeysRotateUnauthorized with default headers values
+func NewApikeysRotateUnauthorized() *ApikeysRotateUnauthorized {
+
+	return &ApikeysRotateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysRotateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ApikeysRotateForbiddenCode is the HTTP code returned for type ApikeysRotateForbidden
+const ApikeysRotateForbiddenCode int = 403
+
+/*
+ApikeysRotateForbidden Forbidden
+
+swagger:response apikeysRotateForbidden
+*/
+type ApikeysRotateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysRotateForbidden creates ApikeysRotateForbidden with default headers values
+func NewApikeysRotateForbidden() *ApikeysRotateForbidden {
+
+	return &ApikeysRotateForbidden{}
+}
+
+// WithPayload adds the payload to the apikeys rotate forbidden response
+func (o *ApikeysRotateForbidden) WithPayload(payload *models.ErrorResponse) *ApikeysRotateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys rotate forbidden response
+func (o *ApikeysRotateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysRotateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ApikeysRotateNotFoundCode is the HTTP code returned for type ApikeysRotateNotFound
+const ApikeysRotateNotFoundCode int = 404
+
+/*
+ApikeysRotateNotFound no api key found
+
+swagger:response apikeysRotateNotFound
+*/
+type ApikeysRotateNotFound struct {
+}
+
+// NewApikeysRotateNotFound creates ApikeysRotateNotFound with default headers values
+func NewApikeysRotateNotFound() *ApikeysRotateNotFound {
+
+	return &ApikeysRotateNotFound{}
+}
+
+// WriteResponse to the client
+func (o *ApikeysRotateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// ApikeysRotateInternalServerErrorCode is the HTTP code returned for type ApikeysRotateInternalServerError
+const ApikeysRotateInternalServerErrorCode int = 500
+
+/*
+ApikeysRotateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response apikeysRotateInternalServerError
+*/
+type ApikeysRotateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewApikeysRotateInternalServerError creates ApikeysRotateInternalServerError with default headers values
+func NewApikeysRotateInternalServerError() *ApikeysRotateInternalServerError {
+
+	return &ApikeysRotateInternalServerError{}
+}
+
+// WithPayload adds the payload to the apikeys rotate internal server error response
+func (o *ApikeysRotateInternalServerError) WithPayload(payload *models.ErrorResponse) *ApikeysRotateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the apikeys rotate internal server error response
+func (o *ApikeysRotateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApikeysRotateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
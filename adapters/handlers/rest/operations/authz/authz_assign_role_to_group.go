@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzAssignRoleToGroupHandlerFunc turns a function with the right signature into a authz assign role to group handler
+type AuthzAssignRoleToGroupHandlerFunc func(AuthzAssignRoleToGroupParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AuthzAssignRoleToGroupHandlerFunc) Handle(params AuthzAssignRoleToGroupParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AuthzAssignRoleToGroupHandler interface for that can handle valid authz assign role to group params
+type AuthzAssignRoleToGroupHandler interface {
+	Handle(AuthzAssignRoleToGroupParams, *models.Principal) middleware.Responder
+}
+
+// NewAuthzAssignRoleToGroup creates a new http.Handler for the authz assign role to group operation
+func NewAuthzAssignRoleToGroup(ctx *middleware.Context, handler AuthzAssignRoleToGroupHandler) *AuthzAssignRoleToGroup {
+	return &AuthzAssignRoleToGroup{Context: ctx, Handler: handler}
+}
+
+/*
+	AuthzAssignRoleToGroup swagger:route POST /authz/groups/{id}/assign authz authzAssignRoleToGroup
+
+assign roles to a group
+*/
+type AuthzAssignRoleToGroup struct {
+	Context *middleware.Context
+	Handler AuthzAssignRoleToGroupHandler
+}
+
+func (o *AuthzAssignRoleToGroup) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAuthzAssignRoleToGroupParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// AuthzAssignRoleToGroupBody authz assign role to group body
+//
+// swagger:model AuthzAssignRoleToGroupBody
+type AuthzAssignRoleToGroupBody struct {
+
+	// names of the roles
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this authz assign role to group body
+func (o *AuthzAssignRoleToGroupBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this authz assign role to group body based on context it is used
+func (o *AuthzAssignRoleToGroupBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AuthzAssignRoleToGroupBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AuthzAssignRoleToGroupBody) UnmarshalBinary(b []byte) error {
+	var res AuthzAssignRoleToGroupBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzAssignRoleToGroupOKCode is the HTTP code returned for type AuthzAssignRoleToGroupOK
+const AuthzAssignRoleToGroupOKCode int = 200
+
+/*
+AuthzAssignRoleToGroupOK Role assigned successfully
+
+swagger:response authzAssignRoleToGroupOK
+*/
+type AuthzAssignRoleToGroupOK struct {
+}
+
+// NewAuthzAssignRoleToGroupOK creates AuthzAssignRoleToGroupOK with default headers values
+func NewAuthzAssignRoleToGroupOK() *AuthzAssignRoleToGroupOK {
+
+	return &AuthzAssignRoleToGroupOK{}
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToGroupOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// AuthzAssignRoleToGroupUnauthorizedCode is the HTTP code returned for type AuthzAssignRoleToGroupUnauthorized
+const AuthzAssignRoleToGroupUnauthorizedCode int = 401
+
+/*
+AuthzAssignRoleToGroupUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzAssignRoleToGroupUnauthorized
+*/
+type AuthzAssignRoleToGroupUnauthorized struct {
+}
+
+// NewAuthzAssignRoleToGroupUnauthorized creates AuthzAssignRoleToGroupUnauthorized with default headers values
+func NewAuthzAssignRoleToGroupUnauthorized() *AuthzAssignRoleToGroupUnauthorized {
+
+	return &AuthzAssignRoleToGroupUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToGroupUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzAssignRoleToGroupForbiddenCode is the HTTP code returned for type AuthzAssignRoleToGroupForbidden
+const AuthzAssignRoleToGroupForbiddenCode int = 403
+
+/*
+AuthzAssignRoleToGroupForbidden Forbidden
+
+swagger:response authzAssignRoleToGroupForbidden
+*/
+type AuthzAssignRoleToGroupForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzAssignRoleToGroupForbidden creates AuthzAssignRoleToGroupForbidden with default headers values
+func NewAuthzAssignRoleToGroupForbidden() *AuthzAssignRoleToGroupForbidden {
+
+	return &AuthzAssignRoleToGroupForbidden{}
+}
+
+// WithPayload adds the payload to the authz assign role to group forbidden response
+func (o *AuthzAssignRoleToGroupForbidden) WithPayload(payload *models.ErrorResponse) *AuthzAssignRoleToGroupForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz assign role to group forbidden response
+func (o *AuthzAssignRoleToGroupForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToGroupForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzAssignRoleToGroupNotFoundCode is the HTTP code returned for type AuthzAssignRoleToGroupNotFound
+const AuthzAssignRoleToGroupNotFoundCode int = 404
+
+/*
+AuthzAssignRoleToGroupNotFound no role found
+
+swagger:response authzAssignRoleToGroupNotFound
+*/
+type AuthzAssignRoleToGroupNotFound struct {
+}
+
+// NewAuthzAssignRoleToGroupNotFound creates AuthzAssignRoleToGroupNotFound with default headers values
+func NewAuthzAssignRoleToGroupNotFound() *AuthzAssignRoleToGroupNotFound {
+
+	return &AuthzAssignRoleToGroupNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToGroupNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// AuthzAssignRoleToGroupUnprocessableEntityCode is the HTTP code returned for type AuthzAssignRoleToGroupUnprocessableEntity
+const AuthzAssignRoleToGroupUnprocessableEntityCode int = 422
+
+/*
+AuthzAssignRoleToGroupUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response authzAssignRoleToGroupUnprocessableEntity
+*/
+type AuthzAssignRoleToGroupUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzAssignRoleToGroupUnprocessableEntity creates AuthzAssignRoleToGroupUnprocessableEntity with default headers values
+func NewAuthzAssignRoleToGroupUnprocessableEntity() *AuthzAssignRoleToGroupUnprocessableEntity {
+
+	return &AuthzAssignRoleToGroupUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the authz assign role to group unprocessable entity response
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *AuthzAssignRoleToGroupUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz assign role to group unprocessable entity response
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzAssignRoleToGroupInternalServerErrorCode is the HTTP code returned for type AuthzAssignRoleToGroupInternalServerError
+const AuthzAssignRoleToGroupInternalServerErrorCode int = 500
+
+/*
+AuthzAssignRoleToGroupInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzAssignRoleToGroupInternalServerError
+*/
+type AuthzAssignRoleToGroupInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzAssignRoleToGroupInternalServerError creates AuthzAssignRoleToGroupInternalServerError with default headers values
+func NewAuthzAssignRoleToGroupInternalServerError() *AuthzAssignRoleToGroupInternalServerError {
+
+	return &AuthzAssignRoleToGroupInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz assign role to group internal server error response
+func (o *AuthzAssignRoleToGroupInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzAssignRoleToGroupInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz assign role to group internal server error response
+func (o *AuthzAssignRoleToGroupInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToGroupInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
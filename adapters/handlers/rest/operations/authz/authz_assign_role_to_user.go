@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzAssignRoleToUserHandlerFunc turns a function with the right signature into a authz assign role to user handler
+type AuthzAssignRoleToUserHandlerFunc func(AuthzAssignRoleToUserParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AuthzAssignRoleToUserHandlerFunc) Handle(params AuthzAssignRoleToUserParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AuthzAssignRoleToUserHandler interface for that can handle valid authz assign role to user params
+type AuthzAssignRoleToUserHandler interface {
+	Handle(AuthzAssignRoleToUserParams, *models.Principal) middleware.Responder
+}
+
+// NewAuthzAssignRoleToUser creates a new http.Handler for the authz assign role to user operation
+func NewAuthzAssignRoleToUser(ctx *middleware.Context, handler AuthzAssignRoleToUserHandler) *AuthzAssignRoleToUser {
+	return &AuthzAssignRoleToUser{Context: ctx, Handler: handler}
+}
+
+/*
+	AuthzAssignRoleToUser swagger:route POST /authz/users/{id}/assign authz authzAssignRoleToUser
+
+assign roles to a user
+*/
+type AuthzAssignRoleToUser struct {
+	Context *middleware.Context
+	Handler AuthzAssignRoleToUserHandler
+}
+
+func (o *AuthzAssignRoleToUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAuthzAssignRoleToUserParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// AuthzAssignRoleToUserBody authz assign role to user body
+//
+// swagger:model AuthzAssignRoleToUserBody
+type AuthzAssignRoleToUserBody struct {
+
+	// names of the roles
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this authz assign role to user body
+func (o *AuthzAssignRoleToUserBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this authz assign role to user body based on context it is used
+func (o *AuthzAssignRoleToUserBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AuthzAssignRoleToUserBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AuthzAssignRoleToUserBody) UnmarshalBinary(b []byte) error {
+	var res AuthzAssignRoleToUserBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzAssignRoleToUserOKCode is the HTTP code returned for type AuthzAssignRoleToUserOK
+const AuthzAssignRoleToUserOKCode int = 200
+
+/*
+AuthzAssignRoleToUserOK Role assigned successfully
+
+swagger:response authzAssignRoleToUserOK
+*/
+type AuthzAssignRoleToUserOK struct {
+}
+
+// NewAuthzAssignRoleToUserOK creates AuthzAssignRoleToUserOK with default headers values
+func NewAuthzAssignRoleToUserOK() *AuthzAssignRoleToUserOK {
+
+	return &AuthzAssignRoleToUserOK{}
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// AuthzAssignRoleToUserUnauthorizedCode is the HTTP code returned for type AuthzAssignRoleToUserUnauthorized
+const AuthzAssignRoleToUserUnauthorizedCode int = 401
+
+/*
+AuthzAssignRoleToUserUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzAssignRoleToUserUnauthorized
+*/
+type AuthzAssignRoleToUserUnauthorized struct {
+}
+
+// NewAuthzAssignRoleToUserUnauthorized creates AuthzAssignRoleToUserUnauthorized with default headers values
+func NewAuthzAssignRoleToUserUnauthorized() *AuthzAssignRoleToUserUnauthorized {
+
+	return &AuthzAssignRoleToUserUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToUserUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzAssignRoleToUserForbiddenCode is the HTTP code returned for type AuthzAssignRoleToUserForbidden
+const AuthzAssignRoleToUserForbiddenCode int = 403
+
+/*
+AuthzAssignRoleToUserForbidden Forbidden
+
+swagger:response authzAssignRoleToUserForbidden
+*/
+type AuthzAssignRoleToUserForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzAssignRoleToUserForbidden creates AuthzAssignRoleToUserForbidden with default headers values
+func NewAuthzAssignRoleToUserForbidden() *AuthzAssignRoleToUserForbidden {
+
+	return &AuthzAssignRoleToUserForbidden{}
+}
+
+// WithPayload adds the payload to the authz assign role to user forbidden response
+func (o *AuthzAssignRoleToUserForbidden) WithPayload(payload *models.ErrorResponse) *AuthzAssignRoleToUserForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz assign role to user forbidden response
+func (o *AuthzAssignRoleToUserForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToUserForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzAssignRoleToUserNotFoundCode is the HTTP code returned for type AuthzAssignRoleToUserNotFound
+const AuthzAssignRoleToUserNotFoundCode int = 404
+
+/*
+AuthzAssignRoleToUserNotFound no role found
+
+swagger:response authzAssignRoleToUserNotFound
+*/
+type AuthzAssignRoleToUserNotFound struct {
+}
+
+// NewAuthzAssignRoleToUserNotFound creates AuthzAssignRoleToUserNotFound with default headers values
+func NewAuthzAssignRoleToUserNotFound() *AuthzAssignRoleToUserNotFound {
+
+	return &AuthzAssignRoleToUserNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// AuthzAssignRoleToUserUnprocessableEntityCode is the HTTP code returned for type AuthzAssignRoleToUserUnprocessableEntity
+const AuthzAssignRoleToUserUnprocessableEntityCode int = 422
+
+/*
+AuthzAssignRoleToUserUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response authzAssignRoleToUserUnprocessableEntity
+*/
+type AuthzAssignRoleToUserUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzAssignRoleToUserUnprocessableEntity creates AuthzAssignRoleToUserUnprocessableEntity with default headers values
+func NewAuthzAssignRoleToUserUnprocessableEntity() *AuthzAssignRoleToUserUnprocessableEntity {
+
+	return &AuthzAssignRoleToUserUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the authz assign role to user unprocessable entity response
+func (o *AuthzAssignRoleToUserUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *AuthzAssignRoleToUserUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz assign role to user unprocessable entity response
+func (o *AuthzAssignRoleToUserUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToUserUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzAssignRoleToUserInternalServerErrorCode is the HTTP code returned for type AuthzAssignRoleToUserInternalServerError
+const AuthzAssignRoleToUserInternalServerErrorCode int = 500
+
+/*
+AuthzAssignRoleToUserInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzAssignRoleToUserInternalServerError
+*/
+type AuthzAssignRoleToUserInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzAssignRoleToUserInternalServerError creates AuthzAssignRoleToUserInternalServerError with default headers values
+func NewAuthzAssignRoleToUserInternalServerError() *AuthzAssignRoleToUserInternalServerError {
+
+	return &AuthzAssignRoleToUserInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz assign role to user internal server error response
+func (o *AuthzAssignRoleToUserInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzAssignRoleToUserInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz assign role to user internal server error response
+func (o *AuthzAssignRoleToUserInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzAssignRoleToUserInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
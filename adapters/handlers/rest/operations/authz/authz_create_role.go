@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzCreateRoleHandlerFunc turns a function with the right signature into a authz create role handler
+type AuthzCreateRoleHandlerFunc func(AuthzCreateRoleParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AuthzCreateRoleHandlerFunc) Handle(params AuthzCreateRoleParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AuthzCreateRoleHandler interface for that can handle valid authz create role params
+type AuthzCreateRoleHandler interface {
+	Handle(AuthzCreateRoleParams, *models.Principal) middleware.Responder
+}
+
+// NewAuthzCreateRole creates a new http.Handler for the authz create role operation
+func NewAuthzCreateRole(ctx *middleware.Context, handler AuthzCreateRoleHandler) *AuthzCreateRole {
+	return &AuthzCreateRole{Context: ctx, Handler: handler}
+}
+
+/*
+	AuthzCreateRole swagger:route POST /authz/roles authz authzCreateRole
+
+create a new role
+*/
+type AuthzCreateRole struct {
+	Context *middleware.Context
+	Handler AuthzCreateRoleHandler
+}
+
+func (o *AuthzCreateRole) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAuthzCreateRoleParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzCreateRoleCreatedCode is the HTTP code returned for type AuthzCreateRoleCreated
+const AuthzCreateRoleCreatedCode int = 201
+
+/*
+AuthzCreateRoleCreated Role created successfully
+
+swagger:response authzCreateRoleCreated
+*/
+type AuthzCreateRoleCreated struct {
+}
+
+// NewAuthzCreateRoleCreated creates AuthzCreateRoleCreated with default headers values
+func NewAuthzCreateRoleCreated() *AuthzCreateRoleCreated {
+
+	return &AuthzCreateRoleCreated{}
+}
+
+// WriteResponse to the client
+func (o *AuthzCreateRoleCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(201)
+}
+
+// AuthzCreateRoleUnauthorizedCode is the HTTP code returned for type AuthzCreateRoleUnauthorized
+const AuthzCreateRoleUnauthorizedCode int = 401
+
+/*
+AuthzCreateRoleUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzCreateRoleUnauthorized
+*/
+type AuthzCreateRoleUnauthorized struct {
+}
+
+// NewAuthzCreateRoleUnauthorized creates AuthzCreateRoleUnauthorized with default headers values
+func NewAuthzCreateRoleUnauthorized() *AuthzCreateRoleUnauthorized {
+
+	return &AuthzCreateRoleUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzCreateRoleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzCreateRoleForbiddenCode is the HTTP code returned for type AuthzCreateRoleForbidden
+const AuthzCreateRoleForbiddenCode int = 403
+
+/*
+AuthzCreateRoleForbidden Forbidden
+
+swagger:response authzCreateRoleForbidden
+*/
+type AuthzCreateRoleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzCreateRoleForbidden creates AuthzCreateRoleForbidden with default headers values
+func NewAuthzCreateRoleForbidden() *AuthzCreateRoleForbidden {
+
+	return &AuthzCreateRoleForbidden{}
+}
+
+// WithPayload adds the payload to the authz create role forbidden response
+func (o *AuthzCreateRoleForbidden) WithPayload(payload *models.ErrorResponse) *AuthzCreateRoleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz create role forbidden response
+func (o *AuthzCreateRoleForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzCreateRoleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzCreateRoleConflictCode is the HTTP code returned for type AuthzCreateRoleConflict
+const AuthzCreateRoleConflictCode int = 409
+
+/*
+AuthzCreateRoleConflict Role already exists
+
+swagger:response authzCreateRoleConflict
+*/
+type AuthzCreateRoleConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzCreateRoleConflict creates AuthzCreateRoleConflict with default headers values
+func NewAuthzCreateRoleConflict() *AuthzCreateRoleConflict {
+
+	return &AuthzCreateRoleConflict{}
+}
+
+// WithPayload adds the payload to the authz create role conflict response
+func (o *AuthzCreateRoleConflict) WithPayload(payload *models.ErrorResponse) *AuthzCreateRoleConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz create role conflict response
+func (o *AuthzCreateRoleConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzCreateRoleConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzCreateRoleUnprocessableEntityCode is the HTTP code returned for type AuthzCreateRoleUnprocessableEntity
+const AuthzCreateRoleUnprocessableEntityCode int = 422
+
+/*
+AuthzCreateRoleUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response authzCreateRoleUnprocessableEntity
+*/
+type AuthzCreateRoleUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzCreateRoleUnprocessableEntity creates AuthzCreateRoleUnprocessableEntity with default headers values
+func NewAuthzCreateRoleUnprocessableEntity() *AuthzCreateRoleUnprocessableEntity {
+
+	return &AuthzCreateRoleUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the authz create role unprocessable entity response
+func (o *AuthzCreateRoleUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *AuthzCreateRoleUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz create role unprocessable entity response
+func (o *AuthzCreateRoleUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzCreateRoleUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzCreateRoleInternalServerErrorCode is the HTTP code returned for type AuthzCreateRoleInternalServerError
+const AuthzCreateRoleInternalServerErrorCode int = 500
+
+/*
+AuthzCreateRoleInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzCreateRoleInternalServerError
+*/
+type AuthzCreateRoleInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzCreateRoleInternalServerError creates AuthzCreateRoleInternalServerError with default headers values
+func NewAuthzCreateRoleInternalServerError() *AuthzCreateRoleInternalServerError {
+
+	return &AuthzCreateRoleInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz create role internal server error response
+func (o *AuthzCreateRoleInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzCreateRoleInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz create role internal server error response
+func (o *AuthzCreateRoleInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzCreateRoleInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
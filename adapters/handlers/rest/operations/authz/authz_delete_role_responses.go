@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzDeleteRoleNoContentCode is the HTTP code returned for type AuthzDeleteRoleNoContent
+const AuthzDeleteRoleNoContentCode int = 204
+
+/*
+AuthzDeleteRoleNoContent Role deleted successfully
+
+swagger:response authzDeleteRoleNoContent
+*/
+type AuthzDeleteRoleNoContent struct {
+}
+
+// NewAuthzDeleteRoleNoContent creates AuthzDeleteRoleNoContent with default headers values
+func NewAuthzDeleteRoleNoContent() *AuthzDeleteRoleNoContent {
+
+	return &AuthzDeleteRoleNoContent{}
+}
+
+// WriteResponse to the client
+func (o *AuthzDeleteRoleNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// AuthzDeleteRoleUnauthorizedCode is the HTTP code returned for type AuthzDeleteRoleUnauthorized
+const AuthzDeleteRoleUnauthorizedCode int = 401
+
+/*
+AuthzDeleteRoleUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzDeleteRoleUnauthorized
+*/
+type AuthzDeleteRoleUnauthorized struct {
+}
+
+// NewAuthzDeleteRoleUnauthorized creates AuthzDeleteRoleUnauthorized with default headers values
+func NewAuthzDeleteRoleUnauthorized() *AuthzDeleteRoleUnauthorized {
+
+	return &AuthzDeleteRoleUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzDeleteRoleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzDeleteRoleForbiddenCode is the HTTP code returned for type AuthzDeleteRoleForbidden
+const AuthzDeleteRoleForbiddenCode int = 403
+
+/*
+AuthzDeleteRoleForbidden Forbidden
+
+swagger:response authzDeleteRoleForbidden
+*/
+type AuthzDeleteRoleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzDeleteRoleForbidden creates AuthzDeleteRoleForbidden with default headers values
+func NewAuthzDeleteRoleForbidden() *AuthzDeleteRoleForbidden {
+
+	return &AuthzDeleteRoleForbidden{}
+}
+
+// WithPayload adds the payload to the authz delete role forbidden response
+func (o *AuthzDeleteRoleForbidden) WithPayload(payload *models.ErrorResponse) *AuthzDeleteRoleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz delete role forbidden response
+func (o *AuthzDeleteRoleForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzDeleteRoleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzDeleteRoleNotFoundCode is the HTTP code returned for type AuthzDeleteRoleNotFound
+const AuthzDeleteRoleNotFoundCode int = 404
+
+/*
+AuthzDeleteRoleNotFound no role found
+
+swagger:response authzDeleteRoleNotFound
+*/
+type AuthzDeleteRoleNotFound struct {
+}
+
+// NewAuthzDeleteRoleNotFound creates AuthzDeleteRoleNotFound with default headers values
+func NewAuthzDeleteRoleNotFound() *AuthzDeleteRoleNotFound {
+
+	return &AuthzDeleteRoleNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AuthzDeleteRoleNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// AuthzDeleteRoleUnprocessableEntityCode is the HTTP code returned for type AuthzDeleteRoleUnprocessableEntity
+const AuthzDeleteRoleUnprocessableEntityCode int = 422
+
+/*
+AuthzDeleteRoleUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response authzDeleteRoleUnprocessableEntity
+*/
+type AuthzDeleteRoleUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzDeleteRoleUnprocessableEntity creates AuthzDeleteRoleUnprocessableEntity with default headers values
+func NewAuthzDeleteRoleUnprocessableEntity() *AuthzDeleteRoleUnprocessableEntity {
+
+	return &AuthzDeleteRoleUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the authz delete role unprocessable entity response
+func (o *AuthzDeleteRoleUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *AuthzDeleteRoleUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz delete role unprocessable entity response
+func (o *AuthzDeleteRoleUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzDeleteRoleUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzDeleteRoleInternalServerErrorCode is the HTTP code returned for type AuthzDeleteRoleInternalServerError
+const AuthzDeleteRoleInternalServerErrorCode int = 500
+
+/*
+AuthzDeleteRoleInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzDeleteRoleInternalServerError
+*/
+type AuthzDeleteRoleInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzDeleteRoleInternalServerError creates AuthzDeleteRoleInternalServerError with default headers values
+func NewAuthzDeleteRoleInternalServerError() *AuthzDeleteRoleInternalServerError {
+
+	return &AuthzDeleteRoleInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz delete role internal server error response
+func (o *AuthzDeleteRoleInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzDeleteRoleInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz delete role internal server error response
+func (o *AuthzDeleteRoleInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzDeleteRoleInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRoleOKCode is the HTTP code returned for type AuthzGetRoleOK
+const AuthzGetRoleOKCode int = 200
+
+/*
+AuthzGetRoleOK Successful response.
+
+swagger:response authzGetRoleOK
+*/
+type AuthzGetRoleOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Role `json:"body,omitempty"`
+}
+
+// NewAuthzGetRoleOK creates AuthzGetRoleOK with default headers values
+func NewAuthzGetRoleOK() *AuthzGetRoleOK {
+
+	return &AuthzGetRoleOK{}
+}
+
+// WithPayload adds the payload to the authz get role o k response
+func (o *AuthzGetRoleOK) WithPayload(payload *models.Role) *AuthzGetRoleOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get role o k response
+func (o *AuthzGetRoleOK) SetPayload(payload *models.Role) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRoleOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzGetRoleUnauthorizedCode is the HTTP code returned for type AuthzGetRoleUnauthorized
+const AuthzGetRoleUnauthorizedCode int = 401
+
+/*
+AuthzGetRoleUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzGetRoleUnauthorized
+*/
+type AuthzGetRoleUnauthorized struct {
+}
+
+// NewAuthzGetRoleUnauthorized creates AuthzGetRoleUnauthorized with default headers values
+func NewAuthzGetRoleUnauthorized() *AuthzGetRoleUnauthorized {
+
+	return &AuthzGetRoleUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRoleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzGetRoleForbiddenCode is the HTTP code returned for type AuthzGetRoleForbidden
+const AuthzGetRoleForbiddenCode int = 403
+
+/*
+AuthzGetRoleForbidden Forbidden
+
+swagger:response authzGetRoleForbidden
+*/
+type AuthzGetRoleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzGetRoleForbidden creates AuthzGetRoleForbidden with default headers values
+func NewAuthzGetRoleForbidden() *AuthzGetRoleForbidden {
+
+	return &AuthzGetRoleForbidden{}
+}
+
+// WithPayload adds the payload to the authz get role forbidden response
+func (o *AuthzGetRoleForbidden) WithPayload(payload *models.ErrorResponse) *AuthzGetRoleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get role forbidden response
+func (o *AuthzGetRoleForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRoleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzGetRoleNotFoundCode is the HTTP code returned for type AuthzGetRoleNotFound
+const AuthzGetRoleNotFoundCode int = 404
+
+/*
+AuthzGetRoleNotFound no role found
+
+swagger:response authzGetRoleNotFound
+*/
+type AuthzGetRoleNotFound struct {
+}
+
+// NewAuthzGetRoleNotFound creates AuthzGetRoleNotFound with default headers values
+func NewAuthzGetRoleNotFound() *AuthzGetRoleNotFound {
+
+	return &AuthzGetRoleNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRoleNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// AuthzGetRoleInternalServerErrorCode is the HTTP code returned for type AuthzGetRoleInternalServerError
+const AuthzGetRoleInternalServerErrorCode int = 500
+
+/*
+AuthzGetRoleInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzGetRoleInternalServerError
+*/
+type AuthzGetRoleInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzGetRoleInternalServerError creates AuthzGetRoleInternalServerError with default headers values
+func NewAuthzGetRoleInternalServerError() *AuthzGetRoleInternalServerError {
+
+	return &AuthzGetRoleInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz get role internal server error response
+func (o *AuthzGetRoleInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzGetRoleInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get role internal server error response
+func (o *AuthzGetRoleInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRoleInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRolesForGroupOKCode is the HTTP code returned for type AuthzGetRolesForGroupOK
+const AuthzGetRolesForGroupOKCode int = 200
+
+/*
+AuthzGetRolesForGroupOK Role assigned to the group
+
+swagger:response authzGetRolesForGroupOK
+*/
+type AuthzGetRolesForGroupOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Role `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesForGroupOK creates AuthzGetRolesForGroupOK with default headers values
+func NewAuthzGetRolesForGroupOK() *AuthzGetRolesForGroupOK {
+
+	return &AuthzGetRolesForGroupOK{}
+}
+
+// WithPayload adds the payload to the authz get roles for group o k response
+func (o *AuthzGetRolesForGroupOK) WithPayload(payload []*models.Role) *AuthzGetRolesForGroupOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles for group o k response
+func (o *AuthzGetRolesForGroupOK) SetPayload(payload []*models.Role) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForGroupOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Role, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// AuthzGetRolesForGroupUnauthorizedCode is the HTTP code returned for type AuthzGetRolesForGroupUnauthorized
+const AuthzGetRolesForGroupUnauthorizedCode int = 401
+
+/*
+AuthzGetRolesForGroupUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzGetRolesForGroupUnauthorized
+*/
+type AuthzGetRolesForGroupUnauthorized struct {
+}
+
+// NewAuthzGetRolesForGroupUnauthorized creates AuthzGetRolesForGroupUnauthorized with default headers values
+func NewAuthzGetRolesForGroupUnauthorized() *AuthzGetRolesForGroupUnauthorized {
+
+	return &AuthzGetRolesForGroupUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForGroupUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzGetRolesForGroupForbiddenCode is the HTTP code returned for type AuthzGetRolesForGroupForbidden
+const AuthzGetRolesForGroupForbiddenCode int = 403
+
+/*
+AuthzGetRolesForGroupForbidden Forbidden
+
+swagger:response authzGetRolesForGroupForbidden
+*/
+type AuthzGetRolesForGroupForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesForGroupForbidden creates AuthzGetRolesForGroupForbidden with default headers values
+func NewAuthzGetRolesForGroupForbidden() *AuthzGetRolesForGroupForbidden {
+
+	return &AuthzGetRolesForGroupForbidden{}
+}
+
+// WithPayload adds the payload to the authz get roles for group forbidden response
+func (o *AuthzGetRolesForGroupForbidden) WithPayload(payload *models.ErrorResponse) *AuthzGetRolesForGroupForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles for group forbidden response
+func (o *AuthzGetRolesForGroupForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForGroupForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzGetRolesForGroupInternalServerErrorCode is the HTTP code returned for type AuthzGetRolesForGroupInternalServerError
+const AuthzGetRolesForGroupInternalServerErrorCode int = 500
+
+/*
+AuthzGetRolesForGroupInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzGetRolesForGroupInternalServerError
+*/
+type AuthzGetRolesForGroupInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesForGroupInternalServerError creates AuthzGetRolesForGroupInternalServerError with default headers values
+func NewAuthzGetRolesForGroupInternalServerError() *AuthzGetRolesForGroupInternalServerError {
+
+	return &AuthzGetRolesForGroupInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz get roles for group internal server error response
+func (o *AuthzGetRolesForGroupInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzGetRolesForGroupInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles for group internal server error response
+func (o *AuthzGetRolesForGroupInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForGroupInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
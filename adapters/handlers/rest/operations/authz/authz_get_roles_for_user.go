@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRolesForUserHandlerFunc turns a function with the right signature into a authz get roles for user handler
+type AuthzGetRolesForUserHandlerFunc func(AuthzGetRolesForUserParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AuthzGetRolesForUserHandlerFunc) Handle(params AuthzGetRolesForUserParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AuthzGetRolesForUserHandler interface for that can handle valid authz get roles for user params
+type AuthzGetRolesForUserHandler interface {
+	Handle(AuthzGetRolesForUserParams, *models.Principal) middleware.Responder
+}
+
+// NewAuthzGetRolesForUser creates a new http.Handler for the authz get roles for user operation
+func NewAuthzGetRolesForUser(ctx *middleware.Context, handler AuthzGetRolesForUserHandler) *AuthzGetRolesForUser {
+	return &AuthzGetRolesForUser{Context: ctx, Handler: handler}
+}
+
+/*
+	AuthzGetRolesForUser swagger:route GET /authz/users/{id}/roles authz authzGetRolesForUser
+
+get the roles assigned to a user
+*/
+type AuthzGetRolesForUser struct {
+	Context *middleware.Context
+	Handler AuthzGetRolesForUserHandler
+}
+
+func (o *AuthzGetRolesForUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAuthzGetRolesForUserParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRolesForUserOKCode is the HTTP code returned for type AuthzGetRolesForUserOK
+const AuthzGetRolesForUserOKCode int = 200
+
+/*
+AuthzGetRolesForUserOK Role assigned to the user
+
+swagger:response authzGetRolesForUserOK
+*/
+type AuthzGetRolesForUserOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Role `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesForUserOK creates AuthzGetRolesForUserOK with default headers values
+func NewAuthzGetRolesForUserOK() *AuthzGetRolesForUserOK {
+
+	return &AuthzGetRolesForUserOK{}
+}
+
+// WithPayload adds the payload to the authz get roles for user o k response
+func (o *AuthzGetRolesForUserOK) WithPayload(payload []*models.Role) *AuthzGetRolesForUserOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles for user o k response
+func (o *AuthzGetRolesForUserOK) SetPayload(payload []*models.Role) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Role, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// AuthzGetRolesForUserUnauthorizedCode is the HTTP code returned for type AuthzGetRolesForUserUnauthorized
+const AuthzGetRolesForUserUnauthorizedCode int = 401
+
+/*
+AuthzGetRolesForUserUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzGetRolesForUserUnauthorized
+*/
+type AuthzGetRolesForUserUnauthorized struct {
+}
+
+// NewAuthzGetRolesForUserUnauthorized creates AuthzGetRolesForUserUnauthorized with default headers values
+func NewAuthzGetRolesForUserUnauthorized() *AuthzGetRolesForUserUnauthorized {
+
+	return &AuthzGetRolesForUserUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForUserUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzGetRolesForUserForbiddenCode is the HTTP code returned for type AuthzGetRolesForUserForbidden
+const AuthzGetRolesForUserForbiddenCode int = 403
+
+/*
+AuthzGetRolesForUserForbidden Forbidden
+
+swagger:response authzGetRolesForUserForbidden
+*/
+type AuthzGetRolesForUserForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesForUserForbidden creates AuthzGetRolesForUserForbidden with default headers values
+func NewAuthzGetRolesForUserForbidden() *AuthzGetRolesForUserForbidden {
+
+	return &AuthzGetRolesForUserForbidden{}
+}
+
+// WithPayload adds the payload to the authz get roles for user forbidden response
+func (o *AuthzGetRolesForUserForbidden) WithPayload(payload *models.ErrorResponse) *AuthzGetRolesForUserForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles for user forbidden response
+func (o *AuthzGetRolesForUserForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForUserForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzGetRolesForUserInternalServerErrorCode is the HTTP code returned for type AuthzGetRolesForUserInternalServerError
+const AuthzGetRolesForUserInternalServerErrorCode int = 500
+
+/*
+AuthzGetRolesForUserInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzGetRolesForUserInternalServerError
+*/
+type AuthzGetRolesForUserInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesForUserInternalServerError creates AuthzGetRolesForUserInternalServerError with default headers values
+func NewAuthzGetRolesForUserInternalServerError() *AuthzGetRolesForUserInternalServerError {
+
+	return &AuthzGetRolesForUserInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz get roles for user internal server error response
+func (o *AuthzGetRolesForUserInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzGetRolesForUserInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles for user internal server error response
+func (o *AuthzGetRolesForUserInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForUserInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
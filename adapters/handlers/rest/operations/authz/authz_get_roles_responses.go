@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRolesOKCode is the HTTP code returned for type AuthzGetRolesOK
+const AuthzGetRolesOKCode int = 200
+
+/*
+AuthzGetRolesOK Successful response.
+
+swagger:response authzGetRolesOK
+*/
+type AuthzGetRolesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Role `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesOK creates AuthzGetRolesOK with default headers values
+func NewAuthzGetRolesOK() *AuthzGetRolesOK {
+
+	return &AuthzGetRolesOK{}
+}
+
+// WithPayload adds the payload to the authz get roles o k response
+func (o *AuthzGetRolesOK) WithPayload(payload []*models.Role) *AuthzGetRolesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles o k response
+func (o *AuthzGetRolesOK) SetPayload(payload []*models.Role) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Role, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// AuthzGetRolesUnauthorizedCode is the HTTP code returned for type AuthzGetRolesUnauthorized
+const AuthzGetRolesUnauthorizedCode int = 401
+
+/*
+AuthzGetRolesUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzGetRolesUnauthorized
+*/
+type AuthzGetRolesUnauthorized struct {
+}
+
+// NewAuthzGetRolesUnauthorized creates AuthzGetRolesUnauthorized with default headers values
+func NewAuthzGetRolesUnauthorized() *AuthzGetRolesUnauthorized {
+
+	return &AuthzGetRolesUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzGetRolesForbiddenCode is the HTTP code returned for type AuthzGetRolesForbidden
+const AuthzGetRolesForbiddenCode int = 403
+
+/*
+AuthzGetRolesForbidden Forbidden
+
+swagger:response authzGetRolesForbidden
+*/
+type AuthzGetRolesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesForbidden creates AuthzGetRolesForbidden with default headers values
+func NewAuthzGetRolesForbidden() *AuthzGetRolesForbidden {
+
+	return &AuthzGetRolesForbidden{}
+}
+
+// WithPayload adds the payload to the authz get roles forbidden response
+func (o *AuthzGetRolesForbidden) WithPayload(payload *models.ErrorResponse) *AuthzGetRolesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles forbidden response
+func (o *AuthzGetRolesForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzGetRolesInternalServerErrorCode is the HTTP code returned for type AuthzGetRolesInternalServerError
+const AuthzGetRolesInternalServerErrorCode int = 500
+
+/*
+AuthzGetRolesInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzGetRolesInternalServerError
+*/
+type AuthzGetRolesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzGetRolesInternalServerError creates AuthzGetRolesInternalServerError with default headers values
+func NewAuthzGetRolesInternalServerError() *AuthzGetRolesInternalServerError {
+
+	return &AuthzGetRolesInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz get roles internal server error response
+func (o *AuthzGetRolesInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzGetRolesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz get roles internal server error response
+func (o *AuthzGetRolesInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzGetRolesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
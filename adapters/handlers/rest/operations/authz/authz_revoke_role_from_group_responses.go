@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzRevokeRoleFromGroupOKCode is the HTTP code returned for type AuthzRevokeRoleFromGroupOK
+const AuthzRevokeRoleFromGroupOKCode int = 200
+
+/*
+AuthzRevokeRoleFromGroupOK Role revoked successfully
+
+swagger:response authzRevokeRoleFromGroupOK
+*/
+type AuthzRevokeRoleFromGroupOK struct {
+}
+
+// NewAuthzRevokeRoleFromGroupOK creates AuthzRevokeRoleFromGroupOK with default headers values
+func NewAuthzRevokeRoleFromGroupOK() *AuthzRevokeRoleFromGroupOK {
+
+	return &AuthzRevokeRoleFromGroupOK{}
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromGroupOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// AuthzRevokeRoleFromGroupUnauthorizedCode is the HTTP code returned for type AuthzRevokeRoleFromGroupUnauthorized
+const AuthzRevokeRoleFromGroupUnauthorizedCode int = 401
+
+/*
+AuthzRevokeRoleFromGroupUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzRevokeRoleFromGroupUnauthorized
+*/
+type AuthzRevokeRoleFromGroupUnauthorized struct {
+}
+
+// NewAuthzRevokeRoleFromGroupUnauthorized creates AuthzRevokeRoleFromGroupUnauthorized with default headers values
+func NewAuthzRevokeRoleFromGroupUnauthorized() *AuthzRevokeRoleFromGroupUnauthorized {
+
+	return &AuthzRevokeRoleFromGroupUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromGroupUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzRevokeRoleFromGroupForbiddenCode is the HTTP code returned for type AuthzRevokeRoleFromGroupForbidden
+const AuthzRevokeRoleFromGroupForbiddenCode int = 403
+
+/*
+AuthzRevokeRoleFromGroupForbidden Forbidden
+
+swagger:response authzRevokeRoleFromGroupForbidden
+*/
+type AuthzRevokeRoleFromGroupForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzRevokeRoleFromGroupForbidden creates AuthzRevokeRoleFromGroupForbidden with default headers values
+func NewAuthzRevokeRoleFromGroupForbidden() *AuthzRevokeRoleFromGroupForbidden {
+
+	return &AuthzRevokeRoleFromGroupForbidden{}
+}
+
+// WithPayload adds the payload to the authz revoke role from group forbidden response
+func (o *AuthzRevokeRoleFromGroupForbidden) WithPayload(payload *models.ErrorResponse) *AuthzRevokeRoleFromGroupForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz revoke role from group forbidden response
+func (o *AuthzRevokeRoleFromGroupForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromGroupForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzRevokeRoleFromGroupNotFoundCode is the HTTP code returned for type AuthzRevokeRoleFromGroupNotFound
+const AuthzRevokeRoleFromGroupNotFoundCode int = 404
+
+/*
+AuthzRevokeRoleFromGroupNotFound no role found
+
+swagger:response authzRevokeRoleFromGroupNotFound
+*/
+type AuthzRevokeRoleFromGroupNotFound struct {
+}
+
+// NewAuthzRevokeRoleFromGroupNotFound creates AuthzRevokeRoleFromGroupNotFound with default headers values
+func NewAuthzRevokeRoleFromGroupNotFound() *AuthzRevokeRoleFromGroupNotFound {
+
+	return &AuthzRevokeRoleFromGroupNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromGroupNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// AuthzRevokeRoleFromGroupUnprocessableEntityCode is the HTTP code returned for type AuthzRevokeRoleFromGroupUnprocessableEntity
+const AuthzRevokeRoleFromGroupUnprocessableEntityCode int = 422
+
+/*
+AuthzRevokeRoleFromGroupUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response authzRevokeRoleFromGroupUnprocessableEntity
+*/
+type AuthzRevokeRoleFromGroupUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzRevokeRoleFromGroupUnprocessableEntity creates AuthzRevokeRoleFromGroupUnprocessableEntity with default headers values
+func NewAuthzRevokeRoleFromGroupUnprocessableEntity() *AuthzRevokeRoleFromGroupUnprocessableEntity {
+
+	return &AuthzRevokeRoleFromGroupUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the authz revoke role from group unprocessable entity response
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *AuthzRevokeRoleFromGroupUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz revoke role from group unprocessable entity response
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzRevokeRoleFromGroupInternalServerErrorCode is the HTTP code returned for type AuthzRevokeRoleFromGroupInternalServerError
+const AuthzRevokeRoleFromGroupInternalServerErrorCode int = 500
+
+/*
+AuthzRevokeRoleFromGroupInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzRevokeRoleFromGroupInternalServerError
+*/
+type AuthzRevokeRoleFromGroupInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzRevokeRoleFromGroupInternalServerError creates AuthzRevokeRoleFromGroupInternalServerError with default headers values
+func NewAuthzRevokeRoleFromGroupInternalServerError() *AuthzRevokeRoleFromGroupInternalServerError {
+
+	return &AuthzRevokeRoleFromGroupInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz revoke role from group internal server error response
+func (o *AuthzRevokeRoleFromGroupInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzRevokeRoleFromGroupInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz revoke role from group internal server error response
+func (o *AuthzRevokeRoleFromGroupInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromGroupInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
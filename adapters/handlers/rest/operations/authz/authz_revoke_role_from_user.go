@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzRevokeRoleFromUserHandlerFunc turns a function with the right signature into a authz revoke role from user handler
+type AuthzRevokeRoleFromUserHandlerFunc func(AuthzRevokeRoleFromUserParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AuthzRevokeRoleFromUserHandlerFunc) Handle(params AuthzRevokeRoleFromUserParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AuthzRevokeRoleFromUserHandler interface for that can handle valid authz revoke role from user params
+type AuthzRevokeRoleFromUserHandler interface {
+	Handle(AuthzRevokeRoleFromUserParams, *models.Principal) middleware.Responder
+}
+
+// NewAuthzRevokeRoleFromUser creates a new http.Handler for the authz revoke role from user operation
+func NewAuthzRevokeRoleFromUser(ctx *middleware.Context, handler AuthzRevokeRoleFromUserHandler) *AuthzRevokeRoleFromUser {
+	return &AuthzRevokeRoleFromUser{Context: ctx, Handler: handler}
+}
+
+/*
+	AuthzRevokeRoleFromUser swagger:route POST /authz/users/{id}/revoke authz authzRevokeRoleFromUser
+
+revoke roles from a user
+*/
+type AuthzRevokeRoleFromUser struct {
+	Context *middleware.Context
+	Handler AuthzRevokeRoleFromUserHandler
+}
+
+func (o *AuthzRevokeRoleFromUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAuthzRevokeRoleFromUserParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// AuthzRevokeRoleFromUserBody authz revoke role from user body
+//
+// swagger:model AuthzRevokeRoleFromUserBody
+type AuthzRevokeRoleFromUserBody struct {
+
+	// names of the roles
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this authz revoke role from user body
+func (o *AuthzRevokeRoleFromUserBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this authz revoke role from user body based on context it is used
+func (o *AuthzRevokeRoleFromUserBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AuthzRevokeRoleFromUserBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AuthzRevokeRoleFromUserBody) UnmarshalBinary(b []byte) error {
+	var res AuthzRevokeRoleFromUserBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzRevokeRoleFromUserOKCode is the HTTP code returned for type AuthzRevokeRoleFromUserOK
+const AuthzRevokeRoleFromUserOKCode int = 200
+
+/*
+AuthzRevokeRoleFromUserOK Role revoked successfully
+
+swagger:response authzRevokeRoleFromUserOK
+*/
+type AuthzRevokeRoleFromUserOK struct {
+}
+
+// NewAuthzRevokeRoleFromUserOK creates AuthzRevokeRoleFromUserOK with default headers values
+func NewAuthzRevokeRoleFromUserOK() *AuthzRevokeRoleFromUserOK {
+
+	return &AuthzRevokeRoleFromUserOK{}
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// AuthzRevokeRoleFromUserUnauthorizedCode is the HTTP code returned for type AuthzRevokeRoleFromUserUnauthorized
+const AuthzRevokeRoleFromUserUnauthorizedCode int = 401
+
+/*
+AuthzRevokeRoleFromUserUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzRevokeRoleFromUserUnauthorized
+*/
+type AuthzRevokeRoleFromUserUnauthorized struct {
+}
+
+// NewAuthzRevokeRoleFromUserUnauthorized creates AuthzRevokeRoleFromUserUnauthorized with default headers values
+func NewAuthzRevokeRoleFromUserUnauthorized() *AuthzRevokeRoleFromUserUnauthorized {
+
+	return &AuthzRevokeRoleFromUserUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromUserUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzRevokeRoleFromUserForbiddenCode is the HTTP code returned for type AuthzRevokeRoleFromUserForbidden
+const AuthzRevokeRoleFromUserForbiddenCode int = 403
+
+/*
+AuthzRevokeRoleFromUserForbidden Forbidden
+
+swagger:response authzRevokeRoleFromUserForbidden
+*/
+type AuthzRevokeRoleFromUserForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzRevokeRoleFromUserForbidden creates AuthzRevokeRoleFromUserForbidden with default headers values
+func NewAuthzRevokeRoleFromUserForbidden() *AuthzRevokeRoleFromUserForbidden {
+
+	return &AuthzRevokeRoleFromUserForbidden{}
+}
+
+// WithPayload adds the payload to the authz revoke role from user forbidden response
+func (o *AuthzRevokeRoleFromUserForbidden) WithPayload(payload *models.ErrorResponse) *AuthzRevokeRoleFromUserForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz revoke role from user forbidden response
+func (o *AuthzRevokeRoleFromUserForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromUserForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzRevokeRoleFromUserNotFoundCode is the HTTP code returned for type AuthzRevokeRoleFromUserNotFound
+const AuthzRevokeRoleFromUserNotFoundCode int = 404
+
+/*
+AuthzRevokeRoleFromUserNotFound no role found
+
+swagger:response authzRevokeRoleFromUserNotFound
+*/
+type AuthzRevokeRoleFromUserNotFound struct {
+}
+
+// NewAuthzRevokeRoleFromUserNotFound creates AuthzRevokeRoleFromUserNotFound with default headers values
+func NewAuthzRevokeRoleFromUserNotFound() *AuthzRevokeRoleFromUserNotFound {
+
+	return &AuthzRevokeRoleFromUserNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// AuthzRevokeRoleFromUserUnprocessableEntityCode is the HTTP code returned for type AuthzRevokeRoleFromUserUnprocessableEntity
+const AuthzRevokeRoleFromUserUnprocessableEntityCode int = 422
+
+/*
+AuthzRevokeRoleFromUserUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response authzRevokeRoleFromUserUnprocessableEntity
+*/
+type AuthzRevokeRoleFromUserUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzRevokeRoleFromUserUnprocessableEntity creates AuthzRevokeRoleFromUserUnprocessableEntity with default headers values
+func NewAuthzRevokeRoleFromUserUnprocessableEntity() *AuthzRevokeRoleFromUserUnprocessableEntity {
+
+	return &AuthzRevokeRoleFromUserUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the authz revoke role from user unprocessable entity response
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *AuthzRevokeRoleFromUserUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz revoke role from user unprocessable entity response
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzRevokeRoleFromUserInternalServerErrorCode is the HTTP code returned for type AuthzRevokeRoleFromUserInternalServerError
+const AuthzRevokeRoleFromUserInternalServerErrorCode int = 500
+
+/*
+AuthzRevokeRoleFromUserInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzRevokeRoleFromUserInternalServerError
+*/
+type AuthzRevokeRoleFromUserInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzRevokeRoleFromUserInternalServerError creates AuthzRevokeRoleFromUserInternalServerError with default headers values
+func NewAuthzRevokeRoleFromUserInternalServerError() *AuthzRevokeRoleFromUserInternalServerError {
+
+	return &AuthzRevokeRoleFromUserInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz revoke role from user internal server error response
+func (o *AuthzRevokeRoleFromUserInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzRevokeRoleFromUserInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz revoke role from user internal server error response
+func (o *AuthzRevokeRoleFromUserInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzRevokeRoleFromUserInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzUpdateRoleHandlerFunc turns a function with the right signature into a authz update role handler
+type AuthzUpdateRoleHandlerFunc func(AuthzUpdateRoleParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AuthzUpdateRoleHandlerFunc) Handle(params AuthzUpdateRoleParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AuthzUpdateRoleHandler interface for that can handle valid authz update role params
+type AuthzUpdateRoleHandler interface {
+	Handle(AuthzUpdateRoleParams, *models.Principal) middleware.Responder
+}
+
+// NewAuthzUpdateRole creates a new http.Handler for the authz update role operation
+func NewAuthzUpdateRole(ctx *middleware.Context, handler AuthzUpdateRoleHandler) *AuthzUpdateRole {
+	return &AuthzUpdateRole{Context: ctx, Handler: handler}
+}
+
+/*
+	AuthzUpdateRole swagger:route PUT /authz/roles/{id} authz authzUpdateRole
+
+replace the permissions of a role
+*/
+type AuthzUpdateRole struct {
+	Context *middleware.Context
+	Handler AuthzUpdateRoleHandler
+}
+
+func (o *AuthzUpdateRole) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAuthzUpdateRoleParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzUpdateRoleOKCode is the HTTP code returned for type AuthzUpdateRoleOK
+const AuthzUpdateRoleOKCode int = 200
+
+/*
+AuthzUpdateRoleOK Role updated successfully
+
+swagger:response authzUpdateRoleOK
+*/
+type AuthzUpdateRoleOK struct {
+}
+
+// NewAuthzUpdateRoleOK creates AuthzUpdateRoleOK with default headers values
+func NewAuthzUpdateRoleOK() *AuthzUpdateRoleOK {
+
+	return &AuthzUpdateRoleOK{}
+}
+
+// WriteResponse to the client
+func (o *AuthzUpdateRoleOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// AuthzUpdateRoleUnauthorizedCode is the HTTP code returned for type AuthzUpdateRoleUnauthorized
+const AuthzUpdateRoleUnauthorizedCode int = 401
+
+/*
+AuthzUpdateRoleUnauthorized Unauthorized or invalid credentials.
+
+swagger:response authzUpdateRoleUnauthorized
+*/
+type AuthzUpdateRoleUnauthorized struct {
+}
+
+// NewAuthzUpdateRoleUnauthorized creates AuthzUpdateRoleUnauthorized with default headers values
+func NewAuthzUpdateRoleUnauthorized() *AuthzUpdateRoleUnauthorized {
+
+	return &AuthzUpdateRoleUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *AuthzUpdateRoleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// AuthzUpdateRoleForbiddenCode is the HTTP code returned for type AuthzUpdateRoleForbidden
+const AuthzUpdateRoleForbiddenCode int = 403
+
+/*
+AuthzUpdateRoleForbidden Forbidden
+
+swagger:response authzUpdateRoleForbidden
+*/
+type AuthzUpdateRoleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzUpdateRoleForbidden creates AuthzUpdateRoleForbidden with default headers values
+func NewAuthzUpdateRoleForbidden() *AuthzUpdateRoleForbidden {
+
+	return &AuthzUpdateRoleForbidden{}
+}
+
+// WithPayload adds the payload to the authz update role forbidden response
+func (o *AuthzUpdateRoleForbidden) WithPayload(payload *models.ErrorResponse) *AuthzUpdateRoleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz update role forbidden response
+func (o *AuthzUpdateRoleForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzUpdateRoleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzUpdateRoleNotFoundCode is the HTTP code returned for type AuthzUpdateRoleNotFound
+const AuthzUpdateRoleNotFoundCode int = 404
+
+/*
+AuthzUpdateRoleNotFound no role found
+
+swagger:response authzUpdateRoleNotFound
+*/
+type AuthzUpdateRoleNotFound struct {
+}
+
+// NewAuthzUpdateRoleNotFound creates AuthzUpdateRoleNotFound with default headers values
+func NewAuthzUpdateRoleNotFound() *AuthzUpdateRoleNotFound {
+
+	return &AuthzUpdateRoleNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AuthzUpdateRoleNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// AuthzUpdateRoleUnprocessableEntityCode is the HTTP code returned for type AuthzUpdateRoleUnprocessableEntity
+const AuthzUpdateRoleUnprocessableEntityCode int = 422
+
+/*
+AuthzUpdateRoleUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response authzUpdateRoleUnprocessableEntity
+*/
+type AuthzUpdateRoleUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzUpdateRoleUnprocessableEntity creates AuthzUpdateRoleUnprocessableEntity with default headers values
+func NewAuthzUpdateRoleUnprocessableEntity() *AuthzUpdateRoleUnprocessableEntity {
+
+	return &AuthzUpdateRoleUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the authz update role unprocessable entity response
+func (o *AuthzUpdateRoleUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *AuthzUpdateRoleUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz update role unprocessable entity response
+func (o *AuthzUpdateRoleUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzUpdateRoleUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AuthzUpdateRoleInternalServerErrorCode is the HTTP code returned for type AuthzUpdateRoleInternalServerError
+const AuthzUpdateRoleInternalServerErrorCode int = 500
+
+/*
+AuthzUpdateRoleInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response authzUpdateRoleInternalServerError
+*/
+type AuthzUpdateRoleInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAuthzUpdateRoleInternalServerError creates AuthzUpdateRoleInternalServerError with default headers values
+func NewAuthzUpdateRoleInternalServerError() *AuthzUpdateRoleInternalServerError {
+
+	return &AuthzUpdateRoleInternalServerError{}
+}
+
+// WithPayload adds the payload to the authz update role internal server error response
+func (o *AuthzUpdateRoleInternalServerError) WithPayload(payload *models.ErrorResponse) *AuthzUpdateRoleInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authz update role internal server error response
+func (o *AuthzUpdateRoleInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthzUpdateRoleInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
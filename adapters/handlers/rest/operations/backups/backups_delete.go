@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsDeleteHandlerFunc turns a function with the right signature into a backups delete handler
+type BackupsDeleteHandlerFunc func(BackupsDeleteParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BackupsDeleteHandlerFunc) Handle(params BackupsDeleteParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BackupsDeleteHandler interface for that can handle valid backups delete params
+type BackupsDeleteHandler interface {
+	Handle(BackupsDeleteParams, *models.Principal) middleware.Responder
+}
+
+// NewBackupsDelete creates a new http.Handler for the backups delete operation
+func NewBackupsDelete(ctx *middleware.Context, handler BackupsDeleteHandler) *BackupsDelete {
+	return &BackupsDelete{Context: ctx, Handler: handler}
+}
+
+/*
+	BackupsDelete swagger:route DELETE /backups/{backend}/{id} backups backupsDelete
+
+Deletes a backup and all of its files from a backend. Backups which are in progress or which are the base of an incremental backup cannot be deleted.
+*/
+type BackupsDelete struct {
+	Context *middleware.Context
+	Handler BackupsDeleteHandler
+}
+
+func (o *BackupsDelete) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewBackupsDeleteParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsDeleteNoContentCode is the HTTP code returned for type BackupsDeleteNoContent
+const BackupsDeleteNoContentCode int = 204
+
+/*
+BackupsDeleteNoContent Backup successfully deleted.
+
+swagger:response backupsDeleteNoContent
+*/
+type BackupsDeleteNoContent struct {
+}
+
+// NewBackupsDeleteNoContent creates BackupsDeleteNoContent with default headers values
+func NewBackupsDeleteNoContent() *BackupsDeleteNoContent {
+
+	return &BackupsDeleteNoContent{}
+}
+
+// WriteResponse to the client
+func (o *BackupsDeleteNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// BackupsDeleteUnauthorizedCode is the HTTP code returned for type BackupsDeleteUnauthorized
+const BackupsDeleteUnauthorizedCode int = 401
+
+/*
+BackupsDeleteUnauthorized Unauthorized or invalid credentials.
+
+swagger:response backupsDeleteUnauthorized
+*/
+type BackupsDeleteUnauthorized struct {
+}
+
+// NewBackupsDeleteUnauthorized creates BackupsDeleteUnauthorized with default headers values
+func NewBackupsDeleteUnauthorized() *BackupsDeleteUnauthorized {
+
+	return &BackupsDeleteUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BackupsDeleteUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BackupsDeleteForbiddenCode is the HTTP code returned for type BackupsDeleteForbidden
+const BackupsDeleteForbiddenCode int = 403
+
+/*
+BackupsDeleteForbidden Forbidden
+
+swagger:response backupsDeleteForbidden
+*/
+type BackupsDeleteForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsDeleteForbidden creates BackupsDeleteForbidden with default headers values
+func NewBackupsDeleteForbidden() *BackupsDeleteForbidden {
+
+	return &BackupsDeleteForbidden{}
+}
+
+// WithPayload adds the payload to the backups delete forbidden response
+func (o *BackupsDeleteForbidden) WithPayload(payload *models.ErrorResponse) *BackupsDeleteForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups delete forbidden response
+func (o *BackupsDeleteForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsDeleteForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsDeleteNotFoundCode is the HTTP code returned for type BackupsDeleteNotFound
+const BackupsDeleteNotFoundCode int = 404
+
+/*
+BackupsDeleteNotFound Not Found - Backup does not exist
+
+swagger:response backupsDeleteNotFound
+*/
+type BackupsDeleteNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsDeleteNotFound creates BackupsDeleteNotFound with default headers values
+func NewBackupsDeleteNotFound() *BackupsDeleteNotFound {
+
+	return &BackupsDeleteNotFound{}
+}
+
+// WithPayload adds the payload to the backups delete not found response
+func (o *BackupsDeleteNotFound) WithPayload(payload *models.ErrorResponse) *BackupsDeleteNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups delete not found response
+func (o *BackupsDeleteNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsDeleteNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsDeleteUnprocessableEntityCode is the HTTP code returned for type BackupsDeleteUnprocessableEntity
+const BackupsDeleteUnprocessableEntityCode int = 422
+
+/*
+BackupsDeleteUnprocessableEntity Invalid backup deletion attempt.
+
+swagger:response backupsDeleteUnprocessableEntity
+*/
+type BackupsDeleteUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsDeleteUnprocessableEntity creates BackupsDeleteUnprocessableEntity with default headers values
+func NewBackupsDeleteUnprocessableEntity() *BackupsDeleteUnprocessableEntity {
+
+	return &BackupsDeleteUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the backups delete unprocessable entity response
+func (o *BackupsDeleteUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BackupsDeleteUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups delete unprocessable entity response
+func (o *BackupsDeleteUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsDeleteUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsDeleteInternalServerErrorCode is the HTTP code returned for type BackupsDeleteInternalServerError
+const BackupsDeleteInternalServerErrorCode int = 500
+
+/*
+BackupsDeleteInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response backupsDeleteInternalServerError
+*/
+type BackupsDeleteInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsDeleteInternalServerError creates BackupsDeleteInternalServerError with default headers values
+func NewBackupsDeleteInternalServerError() *BackupsDeleteInternalServerError {
+
+	return &BackupsDeleteInternalServerError{}
+}
+
+// WithPayload adds the payload to the backups delete internal server error response
+func (o *BackupsDeleteInternalServerError) WithPayload(payload *models.ErrorResponse) *BackupsDeleteInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups delete internal server error response
+func (o *BackupsDeleteInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsDeleteInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
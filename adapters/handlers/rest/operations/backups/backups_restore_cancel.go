@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsRestoreCancelHandlerFunc turns a function with the right signature into a backups restore cancel handler
+type BackupsRestoreCancelHandlerFunc func(BackupsRestoreCancelParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BackupsRestoreCancelHandlerFunc) Handle(params BackupsRestoreCancelParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BackupsRestoreCancelHandler interface for that can handle valid backups restore cancel params
+type BackupsRestoreCancelHandler interface {
+	Handle(BackupsRestoreCancelParams, *models.Principal) middleware.Responder
+}
+
+// NewBackupsRestoreCancel creates a new http.Handler for the backups restore cancel operation
+func NewBackupsRestoreCancel(ctx *middleware.Context, handler BackupsRestoreCancelHandler) *BackupsRestoreCancel {
+	return &BackupsRestoreCancel{Context: ctx, Handler: handler}
+}
+
+/*
+	BackupsRestoreCancel swagger:route POST /backups/{backend}/{id}/restore/cancel backups backupsRestoreCancel
+
+Cancels the restoration of a backup which is in progress. The operation is aborted on all participating nodes.
+*/
+type BackupsRestoreCancel struct {
+	Context *middleware.Context
+	Handler BackupsRestoreCancelHandler
+}
+
+func (o *BackupsRestoreCancel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewBackupsRestoreCancelParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsRestoreCancelNoContentCode is the HTTP code returned for type BackupsRestoreCancelNoContent
+const BackupsRestoreCancelNoContentCode int = 204
+
+/*
+BackupsRestoreCancelNoContent Backup restoration successfully cancelled.
+
+swagger:response backupsRestoreCancelNoContent
+*/
+type BackupsRestoreCancelNoContent struct {
+}
+
+// NewBackupsRestoreCancelNoContent creates BackupsRestoreCancelNoContent with default headers values
+func NewBackupsRestoreCancelNoContent() *BackupsRestoreCancelNoContent {
+
+	return &BackupsRestoreCancelNoContent{}
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreCancelNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// BackupsRestoreCancelUnauthorizedCode is the HTTP code returned for type BackupsRestoreCancelUnauthorized
+const BackupsRestoreCancelUnauthorizedCode int = 401
+
+/*
+BackupsRestoreCancelUnauthorized Unauthorized or invalid credentials.
+
+swagger:response backupsRestoreCancelUnauthorized
+*/
+type BackupsRestoreCancelUnauthorized struct {
+}
+
+// NewBackupsRestoreCancelUnauthorized creates BackupsRestoreCancelUnauthorized with default headers values
+func NewBackupsRestoreCancelUnauthorized() *BackupsRestoreCancelUnauthorized {
+
+	return &BackupsRestoreCancelUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreCancelUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BackupsRestoreCancelForbiddenCode is the HTTP code returned for type BackupsRestoreCancelForbidden
+const BackupsRestoreCancelForbiddenCode int = 403
+
+/*
+BackupsRestoreCancelForbidden Forbidden
+
+swagger:response backupsRestoreCancelForbidden
+*/
+type BackupsRestoreCancelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsRestoreCancelForbidden creates BackupsRestoreCancelForbidden with default headers values
+func NewBackupsRestoreCancelForbidden() *BackupsRestoreCancelForbidden {
+
+	return &BackupsRestoreCancelForbidden{}
+}
+
+// WithPayload adds the payload to the backups restore cancel forbidden response
+func (o *BackupsRestoreCancelForbidden) WithPayload(payload *models.ErrorResponse) *BackupsRestoreCancelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups restore cancel forbidden response
+func (o *BackupsRestoreCancelForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreCancelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsRestoreCancelNotFoundCode is the HTTP code returned for type BackupsRestoreCancelNotFound
+const BackupsRestoreCancelNotFoundCode int = 404
+
+/*
+BackupsRestoreCancelNotFound Not Found - Backup restoration is not in progress
+
+swagger:response backupsRestoreCancelNotFound
+*/
+type BackupsRestoreCancelNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsRestoreCancelNotFound creates BackupsRestoreCancelNotFound with default headers values
+func NewBackupsRestoreCancelNotFound() *BackupsRestoreCancelNotFound {
+
+	return &BackupsRestoreCancelNotFound{}
+}
+
+// WithPayload adds the payload to the backups restore cancel not found response
+func (o *BackupsRestoreCancelNotFound) WithPayload(payload *models.ErrorResponse) *BackupsRestoreCancelNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups restore cancel not found response
+func (o *BackupsRestoreCancelNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreCancelNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsRestoreCancelUnprocessableEntityCode is the HTTP code returned for type BackupsRestoreCancelUnprocessableEntity
+const BackupsRestoreCancelUnprocessableEntityCode int = 422
+
+/*
+BackupsRestoreCancelUnprocessableEntity Invalid backup restoration cancellation attempt.
+
+swagger:response backupsRestoreCancelUnprocessableEntity
+*/
+type BackupsRestoreCancelUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsRestoreCancelUnprocessableEntity creates BackupsRestoreCancelUnprocessableEntity with default headers values
+func NewBackupsRestoreCancelUnprocessableEntity() *BackupsRestoreCancelUnprocessableEntity {
+
+	return &BackupsRestoreCancelUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the backups restore cancel unprocessable entity response
+func (o *BackupsRestoreCancelUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BackupsRestoreCancelUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups restore cancel unprocessable entity response
+func (o *BackupsRestoreCancelUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreCancelUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsRestoreCancelInternalServerErrorCode is the HTTP code returned for type BackupsRestoreCancelInternalServerError
+const BackupsRestoreCancelInternalServerErrorCode int = 500
+
+/*
+BackupsRestoreCancelInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response backupsRestoreCancelInternalServerError
+*/
+type BackupsRestoreCancelInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsRestoreCancelInternalServerError creates BackupsRestoreCancelInternalServerError with default headers values
+func NewBackupsRestoreCancelInternalServerError() *BackupsRestoreCancelInternalServerError {
+
+	return &BackupsRestoreCancelInternalServerError{}
+}
+
+// WithPayload adds the payload to the backups restore cancel internal server error response
+func (o *BackupsRestoreCancelInternalServerError) WithPayload(payload *models.ErrorResponse) *BackupsRestoreCancelInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups restore cancel internal server error response
+func (o *BackupsRestoreCancelInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreCancelInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
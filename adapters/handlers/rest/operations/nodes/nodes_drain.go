@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainHandlerFunc turns a function with the right signature into a nodes drain handler
+type NodesDrainHandlerFunc func(NodesDrainParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn NodesDrainHandlerFunc) Handle(params NodesDrainParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// NodesDrainHandler interface for that can handle valid nodes drain params
+type NodesDrainHandler interface {
+	Handle(NodesDrainParams, *models.Principal) middleware.Responder
+}
+
+// NewNodesDrain creates a new http.Handler for the nodes drain operation
+func NewNodesDrain(ctx *middleware.Context, handler NodesDrainHandler) *NodesDrain {
+	return &NodesDrain{Context: ctx, Handler: handler}
+}
+
+/*
+	NodesDrain swagger:route POST /nodes/{nodeName}/drain nodes nodesDrain
+
+Starts moving all shard replicas off the node, so that it can be removed from the cluster safely. No new replicas are placed on a node being drained. Replicas of multi-tenant classes are not moved, these classes are listed as skipped in the drain status.
+*/
+type NodesDrain struct {
+	Context *middleware.Context
+	Handler NodesDrainHandler
+}
+
+func (o *NodesDrain) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewNodesDrainParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
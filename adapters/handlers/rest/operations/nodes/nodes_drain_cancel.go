@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainCancelHandlerFunc turns a function with the right signature into a nodes drain cancel handler
+type NodesDrainCancelHandlerFunc func(NodesDrainCancelParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn NodesDrainCancelHandlerFunc) Handle(params NodesDrainCancelParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// NodesDrainCancelHandler interface for that can handle valid nodes drain cancel params
+type NodesDrainCancelHandler interface {
+	Handle(NodesDrainCancelParams, *models.Principal) middleware.Responder
+}
+
+// NewNodesDrainCancel creates a new http.Handler for the nodes drain cancel operation
+func NewNodesDrainCancel(ctx *middleware.Context, handler NodesDrainCancelHandler) *NodesDrainCancel {
+	return &NodesDrainCancel{Context: ctx, Handler: handler}
+}
+
+/*
+	NodesDrainCancel swagger:route DELETE /nodes/{nodeName}/drain nodes nodesDrainCancel
+
+Stops draining the node. Replicas moved already stay on their new nodes.
+*/
+type NodesDrainCancel struct {
+	Context *middleware.Context
+	Handler NodesDrainCancelHandler
+}
+
+func (o *NodesDrainCancel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewNodesDrainCancelParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
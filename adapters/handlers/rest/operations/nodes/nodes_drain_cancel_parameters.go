@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewNodesDrainCancelParams creates a new NodesDrainCancelParams object
+//
+// There are no default values defined in the spec.
+func NewNodesDrainCancelParams() NodesDrainCancelParams {
+
+	return NodesDrainCancelParams{}
+}
+
+// NodesDrainCancelParams contains all the bound params for the nodes drain cancel operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters nodes.drain.cancel
+type NodesDrainCancelParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The name of the node.
+	  Required: true
+	  In: path
+	*/
+	NodeName string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewNodesDrainCancelParams() beforehand.
+func (o *NodesDrainCancelParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rNodeName, rhkNodeName, _ := route.Params.GetOK("nodeName")
+	if err := o.bindNodeName(rNodeName, rhkNodeName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindNodeName binds and validates parameter NodeName from path.
+func (o *NodesDrainCancelParams) bindNodeName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.NodeName = raw
+
+	return nil
+}
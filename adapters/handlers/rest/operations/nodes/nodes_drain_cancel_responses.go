@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainCancelOKCode is the HTTP code returned for type NodesDrainCancelOK
+const NodesDrainCancelOKCode int = 200
+
+/*
+NodesDrainCancelOK Draining the node successfully stopped
+
+swagger:response nodesDrainCancelOK
+*/
+type NodesDrainCancelOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NodeDrainStatus `json:"body,omitempty"`
+}
+
+// NewNodesDrainCancelOK creates NodesDrainCancelOK with default headers values
+func NewNodesDrainCancelOK() *NodesDrainCancelOK {
+
+	return &NodesDrainCancelOK{}
+}
+
+// WithPayload adds the payload to the nodes drain cancel o k response
+func (o *NodesDrainCancelOK) WithPayload(payload *models.NodeDrainStatus) *NodesDrainCancelOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain cancel o k response
+func (o *NodesDrainCancelOK) SetPayload(payload *models.NodeDrainStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainCancelOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainCancelUnauthorizedCode is the HTTP code returned for type NodesDrainCancelUnauthorized
+const NodesDrainCancelUnauthorizedCode int = 401
+
+/*
+NodesDrainCancelUnauthorized Unauthorized or invalid credentials.
+
+swagger:response nodesDrainCancelUnauthorized
+*/
+type NodesDrainCancelUnauthorized struct {
+}
+
+// NewNodesDrainCancelUnauthorized creates NodesDrainCancelUnauthorized with default headers values
+func NewNodesDrainCancelUnauthorized() *NodesDrainCancelUnauthorized {
+
+	return &NodesDrainCancelUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *NodesDrainCancelUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// NodesDrainCancelForbiddenCode is the HTTP code returned for type NodesDrainCancelForbidden
+const NodesDrainCancelForbiddenCode int = 403
+
+/*
+NodesDrainCancelForbidden Forbidden
+
+swagger:response nodesDrainCancelForbidden
+*/
+type NodesDrainCancelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainCancelForbidden creates NodesDrainCancelForbidden with default headers values
+func NewNodesDrainCancelForbidden() *NodesDrainCancelForbidden {
+
+	return &NodesDrainCancelForbidden{}
+}
+
+// WithPayload adds the payload to the nodes drain cancel forbidden response
+func (o *NodesDrainCancelForbidden) WithPayload(payload *models.ErrorResponse) *NodesDrainCancelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain cancel forbidden response
+func (o *NodesDrainCancelForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainCancelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainCancelNotFoundCode is the HTTP code returned for type NodesDrainCancelNotFound
+const NodesDrainCancelNotFoundCode int = 404
+
+/*
+NodesDrainCancelNotFound Not Found - Node does not exist
+
+swagger:response nodesDrainCancelNotFound
+*/
+type NodesDrainCancelNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainCancelNotFound creates NodesDrainCancelNotFound with default headers values
+func NewNodesDrainCancelNotFound() *NodesDrainCancelNotFound {
+
+	return &NodesDrainCancelNotFound{}
+}
+
+// WithPayload adds the payload to the nodes drain cancel not found response
+func (o *NodesDrainCancelNotFound) WithPayload(payload *models.ErrorResponse) *NodesDrainCancelNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain cancel not found response
+func (o *NodesDrainCancelNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainCancelNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainCancelUnprocessableEntityCode is the HTTP code returned for type NodesDrainCancelUnprocessableEntity
+const NodesDrainCancelUnprocessableEntityCode int = 422
+
+/*
+NodesDrainCancelUnprocessableEntity Invalid drain cancellation attempt.
+
+swagger:response nodesDrainCancelUnprocessableEntity
+*/
+type NodesDrainCancelUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainCancelUnprocessableEntity creates NodesDrainCancelUnprocessableEntity with default headers values
+func NewNodesDrainCancelUnprocessableEntity() *NodesDrainCancelUnprocessableEntity {
+
+	return &NodesDrainCancelUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the nodes drain cancel unprocessable entity response
+func (o *NodesDrainCancelUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *NodesDrainCancelUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain cancel unprocessable entity response
+func (o *NodesDrainCancelUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainCancelUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainCancelInternalServerErrorCode is the HTTP code returned for type NodesDrainCancelInternalServerError
+const NodesDrainCancelInternalServerErrorCode int = 500
+
+/*
+NodesDrainCancelInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response nodesDrainCancelInternalServerError
+*/
+type NodesDrainCancelInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainCancelInternalServerError creates NodesDrainCancelInternalServerError with default headers values
+func NewNodesDrainCancelInternalServerError() *NodesDrainCancelInternalServerError {
+
+	return &NodesDrainCancelInternalServerError{}
+}
+
+// WithPayload adds the payload to the nodes drain cancel internal server error response
+func (o *NodesDrainCancelInternalServerError) WithPayload(payload *models.ErrorResponse) *NodesDrainCancelInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain cancel internal server error response
+func (o *NodesDrainCancelInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainCancelInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
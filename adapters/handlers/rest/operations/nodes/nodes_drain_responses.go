@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainOKCode is the HTTP code returned for type NodesDrainOK
+const NodesDrainOKCode int = 200
+
+/*
+NodesDrainOK Draining the node successfully started
+
+swagger:response nodesDrainOK
+*/
+type NodesDrainOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NodeDrainStatus `json:"body,omitempty"`
+}
+
+// NewNodesDrainOK creates NodesDrainOK with default headers values
+func NewNodesDrainOK() *NodesDrainOK {
+
+	return &NodesDrainOK{}
+}
+
+// WithPayload adds the payload to the nodes drain o k response
+func (o *NodesDrainOK) WithPayload(payload *models.NodeDrainStatus) *NodesDrainOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain o k response
+func (o *NodesDrainOK) SetPayload(payload *models.NodeDrainStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainUnauthorizedCode is the HTTP code returned for type NodesDrainUnauthorized
+const NodesDrainUnauthorizedCode int = 401
+
+/*
+NodesDrainUnauthorized Unauthorized or invalid credentials.
+
+swagger:response nodesDrainUnauthorized
+*/
+type NodesDrainUnauthorized struct {
+}
+
+// NewNodesDrainUnauthorized creates NodesDrainUnauthorized with default headers values
+func NewNodesDrainUnauthorized() *NodesDrainUnauthorized {
+
+	return &NodesDrainUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *NodesDrainUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// NodesDrainForbiddenCode is the HTTP code returned for type NodesDrainForbidden
+const NodesDrainForbiddenCode int = 403
+
+/*
+NodesDrainForbidden Forbidden
+
+swagger:response nodesDrainForbidden
+*/
+type NodesDrainForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainForbidden creates NodesDrainForbidden with default headers values
+func NewNodesDrainForbidden() *NodesDrainForbidden {
+
+	return &NodesDrainForbidden{}
+}
+
+// WithPayload adds the payload to the nodes drain forbidden response
+func (o *NodesDrainForbidden) WithPayload(payload *models.ErrorResponse) *NodesDrainForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain forbidden response
+func (o *NodesDrainForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainNotFoundCode is the HTTP code returned for type NodesDrainNotFound
+const NodesDrainNotFoundCode int = 404
+
+/*
+NodesDrainNotFound Not Found - Node does not exist
+
+swagger:response nodesDrainNotFound
+*/
+type NodesDrainNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainNotFound creates NodesDrainNotFound with default headers values
+func NewNodesDrainNotFound() *NodesDrainNotFound {
+
+	return &NodesDrainNotFound{}
+}
+
+// WithPayload adds the payload to the nodes drain not found response
+func (o *NodesDrainNotFound) WithPayload(payload *models.ErrorResponse) *NodesDrainNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain not found response
+func (o *NodesDrainNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainUnprocessableEntityCode is the HTTP code returned for type NodesDrainUnprocessableEntity
+const NodesDrainUnprocessableEntityCode int = 422
+
+/*
+NodesDrainUnprocessableEntity The node cannot be drained, e.g. since there is no other node to move its replicas to.
+
+swagger:response nodesDrainUnprocessableEntity
+*/
+type NodesDrainUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainUnprocessableEntity creates NodesDrainUnprocessableEntity with default headers values
+func NewNodesDrainUnprocessableEntity() *NodesDrainUnprocessableEntity {
+
+	return &NodesDrainUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the nodes drain unprocessable entity response
+func (o *NodesDrainUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *NodesDrainUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain unprocessable entity response
+func (o *NodesDrainUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainInternalServerErrorCode is the HTTP code returned for type NodesDrainInternalServerError
+const NodesDrainInternalServerErrorCode int = 500
+
+/*
+NodesDrainInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response nodesDrainInternalServerError
+*/
+type NodesDrainInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainInternalServerError creates NodesDrainInternalServerError with default headers values
+func NewNodesDrainInternalServerError() *NodesDrainInternalServerError {
+
+	return &NodesDrainInternalServerError{}
+}
+
+// WithPayload adds the payload to the nodes drain internal server error response
+func (o *NodesDrainInternalServerError) WithPayload(payload *models.ErrorResponse) *NodesDrainInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain internal server error response
+func (o *NodesDrainInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainStatusHandlerFunc turns a function with the right signature into a nodes drain status handler
+type NodesDrainStatusHandlerFunc func(NodesDrainStatusParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn NodesDrainStatusHandlerFunc) Handle(params NodesDrainStatusParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// NodesDrainStatusHandler interface for that can handle valid nodes drain status params
+type NodesDrainStatusHandler interface {
+	Handle(NodesDrainStatusParams, *models.Principal) middleware.Responder
+}
+
+// NewNodesDrainStatus creates a new http.Handler for the nodes drain status operation
+func NewNodesDrainStatus(ctx *middleware.Context, handler NodesDrainStatusHandler) *NodesDrainStatus {
+	return &NodesDrainStatus{Context: ctx, Handler: handler}
+}
+
+/*
+	NodesDrainStatus swagger:route GET /nodes/{nodeName}/drain nodes nodesDrainStatus
+
+Returns whether the node is being drained or has been drained, in which case it is safe to remove.
+*/
+type NodesDrainStatus struct {
+	Context *middleware.Context
+	Handler NodesDrainStatusHandler
+}
+
+func (o *NodesDrainStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewNodesDrainStatusParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainStatusOKCode is the HTTP code returned for type NodesDrainStatusOK
+const NodesDrainStatusOKCode int = 200
+
+/*
+NodesDrainStatusOK Drain status successfully returned
+
+swagger:response nodesDrainStatusOK
+*/
+type NodesDrainStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NodeDrainStatus `json:"body,omitempty"`
+}
+
+// NewNodesDrainStatusOK creates NodesDrainStatusOK with default headers values
+func NewNodesDrainStatusOK() *NodesDrainStatusOK {
+
+	return &NodesDrainStatusOK{}
+}
+
+// WithPayload adds the payload to the nodes drain status o k response
+func (o *NodesDrainStatusOK) WithPayload(payload *models.NodeDrainStatus) *NodesDrainStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain status o k response
+func (o *NodesDrainStatusOK) SetPayload(payload *models.NodeDrainStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainStatusUnauthorizedCode is the HTTP code returned for type NodesDrainStatusUnauthorized
+const NodesDrainStatusUnauthorizedCode int = 401
+
+/*
+NodesDrainStatusUnauthorized Unauthorized or invalid credentials.
+
+swagger:response nodesDrainStatusUnauthorized
+*/
+type NodesDrainStatusUnauthorized struct {
+}
+
+// NewNodesDrainStatusUnauthorized creates NodesDrainStatusUnauthorized with default headers values
+func NewNodesDrainStatusUnauthorized() *NodesDrainStatusUnauthorized {
+
+	return &NodesDrainStatusUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *NodesDrainStatusUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// NodesDrainStatusForbiddenCode is the HTTP code returned for type NodesDrainStatusForbidden
+const NodesDrainStatusForbiddenCode int = 403
+
+/*
+NodesDrainStatusForbidden Forbidden
+
+swagger:response nodesDrainStatusForbidden
+*/
+type NodesDrainStatusForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainStatusForbidden creates NodesDrainStatusForbidden with default headers values
+func NewNodesDrainStatusForbidden() *NodesDrainStatusForbidden {
+
+	return &NodesDrainStatusForbidden{}
+}
+
+// WithPayload adds the payload to the nodes drain status forbidden response
+func (o *NodesDrainStatusForbidden) WithPayload(payload *models.ErrorResponse) *NodesDrainStatusForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain status forbidden response
+func (o *NodesDrainStatusForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainStatusForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainStatusNotFoundCode is the HTTP code returned for type NodesDrainStatusNotFound
+const NodesDrainStatusNotFoundCode int = 404
+
+/*
+NodesDrainStatusNotFound Not Found - Node does not exist
+
+swagger:response nodesDrainStatusNotFound
+*/
+type NodesDrainStatusNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainStatusNotFound creates NodesDrainStatusNotFound with default headers values
+func NewNodesDrainStatusNotFound() *NodesDrainStatusNotFound {
+
+	return &NodesDrainStatusNotFound{}
+}
+
+// WithPayload adds the payload to the nodes drain status not found response
+func (o *NodesDrainStatusNotFound) WithPayload(payload *models.ErrorResponse) *NodesDrainStatusNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain status not found response
+func (o *NodesDrainStatusNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainStatusNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainStatusUnprocessableEntityCode is the HTTP code returned for type NodesDrainStatusUnprocessableEntity
+const NodesDrainStatusUnprocessableEntityCode int = 422
+
+/*
+NodesDrainStatusUnprocessableEntity Invalid drain status request.
+
+swagger:response nodesDrainStatusUnprocessableEntity
+*/
+type NodesDrainStatusUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainStatusUnprocessableEntity creates NodesDrainStatusUnprocessableEntity with default headers values
+func NewNodesDrainStatusUnprocessableEntity() *NodesDrainStatusUnprocessableEntity {
+
+	return &NodesDrainStatusUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the nodes drain status unprocessable entity response
+func (o *NodesDrainStatusUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *NodesDrainStatusUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain status unprocessable entity response
+func (o *NodesDrainStatusUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainStatusUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDrainStatusInternalServerErrorCode is the HTTP code returned for type NodesDrainStatusInternalServerError
+const NodesDrainStatusInternalServerErrorCode int = 500
+
+/*
+NodesDrainStatusInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response nodesDrainStatusInternalServerError
+*/
+type NodesDrainStatusInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDrainStatusInternalServerError creates NodesDrainStatusInternalServerError with default headers values
+func NewNodesDrainStatusInternalServerError() *NodesDrainStatusInternalServerError {
+
+	return &NodesDrainStatusInternalServerError{}
+}
+
+// WithPayload adds the payload to the nodes drain status internal server error response
+func (o *NodesDrainStatusInternalServerError) WithPayload(payload *models.ErrorResponse) *NodesDrainStatusInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes drain status internal server error response
+func (o *NodesDrainStatusInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDrainStatusInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
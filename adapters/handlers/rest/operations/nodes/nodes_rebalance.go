@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesRebalanceHandlerFunc turns a function with the right signature into a nodes rebalance handler
+type NodesRebalanceHandlerFunc func(NodesRebalanceParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn NodesRebalanceHandlerFunc) Handle(params NodesRebalanceParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// NodesRebalanceHandler interface for that can handle valid nodes rebalance params
+type NodesRebalanceHandler interface {
+	Handle(NodesRebalanceParams, *models.Principal) middleware.Responder
+}
+
+// NewNodesRebalance creates a new http.Handler for the nodes rebalance operation
+func NewNodesRebalance(ctx *middleware.Context, handler NodesRebalanceHandler) *NodesRebalance {
+	return &NodesRebalance{Context: ctx, Handler: handler}
+}
+
+/*
+	NodesRebalance swagger:route POST /nodes/rebalance nodes nodesRebalance
+
+Moves shard replicas between nodes, so that all nodes hold a similar number of replicas and use a similar share of their disk. Nodes being drained are left out.
+*/
+type NodesRebalance struct {
+	Context *middleware.Context
+	Handler NodesRebalanceHandler
+}
+
+func (o *NodesRebalance) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewNodesRebalanceParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
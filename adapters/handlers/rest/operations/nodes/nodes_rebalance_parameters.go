@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewNodesRebalanceParams creates a new NodesRebalanceParams object
+// with the default values initialized.
+func NewNodesRebalanceParams() NodesRebalanceParams {
+
+	var (
+		// initialize parameters with default values
+
+		dryRunDefault = bool(false)
+	)
+
+	return NodesRebalanceParams{
+		DryRun: &dryRunDefault,
+	}
+}
+
+// NodesRebalanceParams contains all the bound params for the nodes rebalance operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters nodes.rebalance
+type NodesRebalanceParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*If true, the moves are only planned and returned but not executed.
+	  In: query
+	  Default: false
+	*/
+	DryRun *bool
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewNodesRebalanceParams() beforehand.
+func (o *NodesRebalanceParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qDryRun, qhkDryRun, _ := qs.GetOK("dryRun")
+	if err := o.bindDryRun(qDryRun, qhkDryRun, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDryRun binds and validates parameter DryRun from query.
+func (o *NodesRebalanceParams) bindDryRun(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewNodesRebalanceParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("dryRun", "query", "bool", raw)
+	}
+	o.DryRun = &value
+
+	return nil
+}
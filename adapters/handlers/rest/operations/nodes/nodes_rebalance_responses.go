@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesRebalanceOKCode is the HTTP code returned for type NodesRebalanceOK
+const NodesRebalanceOKCode int = 200
+
+/*
+NodesRebalanceOK Shard replicas successfully rebalanced
+
+swagger:response nodesRebalanceOK
+*/
+type NodesRebalanceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NodesRebalanceResponse `json:"body,omitempty"`
+}
+
+// NewNodesRebalanceOK creates NodesRebalanceOK with default headers values
+func NewNodesRebalanceOK() *NodesRebalanceOK {
+
+	return &NodesRebalanceOK{}
+}
+
+// WithPayload adds the payload to the nodes rebalance o k response
+func (o *NodesRebalanceOK) WithPayload(payload *models.NodesRebalanceResponse) *NodesRebalanceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes rebalance o k response
+func (o *NodesRebalanceOK) SetPayload(payload *models.NodesRebalanceResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesRebalanceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesRebalanceUnauthorizedCode is the HTTP code returned for type NodesRebalanceUnauthorized
+const NodesRebalanceUnauthorizedCode int = 401
+
+/*
+NodesRebalanceUnauthorized Unauthorized or invalid credentials.
+
+swagger:response nodesRebalanceUnauthorized
+*/
+type NodesRebalanceUnauthorized struct {
+}
+
+// NewNodesRebalanceUnauthorized creates NodesRebalanceUnauthorized with default headers values
+func NewNodesRebalanceUnauthorized() *NodesRebalanceUnauthorized {
+
+	return &NodesRebalanceUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *NodesRebalanceUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// NodesRebalanceForbiddenCode is the HTTP code returned for type NodesRebalanceForbidden
+const NodesRebalanceForbiddenCode int = 403
+
+/*
+NodesRebalanceForbidden Forbidden
+
+swagger:response nodesRebalanceForbidden
+*/
+type NodesRebalanceForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesRebalanceForbidden creates NodesRebalanceForbidden with default headers values
+func NewNodesRebalanceForbidden() *NodesRebalanceForbidden {
+
+	return &NodesRebalanceForbidden{}
+}
+
+// WithPayload adds the payload to the nodes rebalance forbidden response
+func (o *NodesRebalanceForbidden) WithPayload(payload *models.ErrorResponse) *NodesRebalanceForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes rebalance forbidden response
+func (o *NodesRebalanceForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesRebalanceForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesRebalanceUnprocessableEntityCode is the HTTP code returned for type NodesRebalanceUnprocessableEntity
+const NodesRebalanceUnprocessableEntityCode int = 422
+
+/*
+NodesRebalanceUnprocessableEntity Invalid rebalance attempt.
+
+swagger:response nodesRebalanceUnprocessableEntity
+*/
+type NodesRebalanceUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesRebalanceUnprocessableEntity creates NodesRebalanceUnprocessableEntity with default headers values
+func NewNodesRebalanceUnprocessableEntity() *NodesRebalanceUnprocessableEntity {
+
+	return &NodesRebalanceUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the nodes rebalance unprocessable entity response
+func (o *NodesRebalanceUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *NodesRebalanceUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes rebalance unprocessable entity response
+func (o *NodesRebalanceUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesRebalanceUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesRebalanceInternalServerErrorCode is the HTTP code returned for type NodesRebalanceInternalServerError
+const NodesRebalanceInternalServerErrorCode int = 500
+
+/*
+NodesRebalanceInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response nodesRebalanceInternalServerError
+*/
+type NodesRebalanceInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesRebalanceInternalServerError creates NodesRebalanceInternalServerError with default headers values
+func NewNodesRebalanceInternalServerError() *NodesRebalanceInternalServerError {
+
+	return &NodesRebalanceInternalServerError{}
+}
+
+// WithPayload adds the payload to the nodes rebalance internal server error response
+func (o *NodesRebalanceInternalServerError) WithPayload(payload *models.ErrorResponse) *NodesRebalanceInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes rebalance internal server error response
+func (o *NodesRebalanceInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesRebalanceInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
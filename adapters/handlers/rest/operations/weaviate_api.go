@@ -31,6 +31,8 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
@@ -69,15 +71,75 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
 		}),
+		ApikeysApikeysCreateHandler: apikeys.ApikeysCreateHandlerFunc(func(params apikeys.ApikeysCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysCreate has not yet been implemented")
+		}),
+		ApikeysApikeysExpireHandler: apikeys.ApikeysExpireHandlerFunc(func(params apikeys.ApikeysExpireParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysExpire has not yet been implemented")
+		}),
+		ApikeysApikeysListHandler: apikeys.ApikeysListHandlerFunc(func(params apikeys.ApikeysListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysList has not yet been implemented")
+		}),
+		ApikeysApikeysRevokeHandler: apikeys.ApikeysRevokeHandlerFunc(func(params apikeys.ApikeysRevokeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysRevoke has not yet been implemented")
+		}),
+		ApikeysApikeysRotateHandler: apikeys.ApikeysRotateHandlerFunc(func(params apikeys.ApikeysRotateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysRotate has not yet been implemented")
+		}),
+		AuthzAuthzAssignRoleToGroupHandler: authz.AuthzAssignRoleToGroupHandlerFunc(func(params authz.AuthzAssignRoleToGroupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzAssignRoleToGroup has not yet been implemented")
+		}),
+		AuthzAuthzAssignRoleToUserHandler: authz.AuthzAssignRoleToUserHandlerFunc(func(params authz.AuthzAssignRoleToUserParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzAssignRoleToUser has not yet been implemented")
+		}),
+		AuthzAuthzCreateRoleHandler: authz.AuthzCreateRoleHandlerFunc(func(params authz.AuthzCreateRoleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzCreateRole has not yet been implemented")
+		}),
+		AuthzAuthzDeleteRoleHandler: authz.AuthzDeleteRoleHandlerFunc(func(params authz.AuthzDeleteRoleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzDeleteRole has not yet been implemented")
+		}),
+		AuthzAuthzGetRoleHandler: authz.AuthzGetRoleHandlerFunc(func(params authz.AuthzGetRoleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzGetRole has not yet been implemented")
+		}),
+		AuthzAuthzGetRolesHandler: authz.AuthzGetRolesHandlerFunc(func(params authz.AuthzGetRolesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzGetRoles has not yet been implemented")
+		}),
+		AuthzAuthzGetRolesForGroupHandler: authz.AuthzGetRolesForGroupHandlerFunc(func(params authz.AuthzGetRolesForGroupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzGetRolesForGroup has not yet been implemented")
+		}),
+		AuthzAuthzGetRolesForUserHandler: authz.AuthzGetRolesForUserHandlerFunc(func(params authz.AuthzGetRolesForUserParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzGetRolesForUser has not yet been implemented")
+		}),
+		AuthzAuthzRevokeRoleFromGroupHandler: authz.AuthzRevokeRoleFromGroupHandlerFunc(func(params authz.AuthzRevokeRoleFromGroupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRevokeRoleFromGroup has not yet been implemented")
+		}),
+		AuthzAuthzRevokeRoleFromUserHandler: authz.AuthzRevokeRoleFromUserHandlerFunc(func(params authz.AuthzRevokeRoleFromUserParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRevokeRoleFromUser has not yet been implemented")
+		}),
+		AuthzAuthzUpdateRoleHandler: authz.AuthzUpdateRoleHandlerFunc(func(params authz.AuthzUpdateRoleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzUpdateRole has not yet been implemented")
+		}),
+		BackupsBackupsCancelHandler: backups.BackupsCancelHandlerFunc(func(params backups.BackupsCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsCancel has not yet been implemented")
+		}),
 		BackupsBackupsCreateHandler: backups.BackupsCreateHandlerFunc(func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsCreate has not yet been implemented")
 		}),
 		BackupsBackupsCreateStatusHandler: backups.BackupsCreateStatusHandlerFunc(func(params backups.BackupsCreateStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsCreateStatus has not yet been implemented")
 		}),
+		BackupsBackupsDeleteHandler: backups.BackupsDeleteHandlerFunc(func(params backups.BackupsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsDelete has not yet been implemented")
+		}),
+		BackupsBackupsListHandler: backups.BackupsListHandlerFunc(func(params backups.BackupsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsList has not yet been implemented")
+		}),
 		BackupsBackupsRestoreHandler: backups.BackupsRestoreHandlerFunc(func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestore has not yet been implemented")
 		}),
+		BackupsBackupsRestoreCancelHandler: backups.BackupsRestoreCancelHandlerFunc(func(params backups.BackupsRestoreCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsRestoreCancel has not yet been implemented")
+		}),
 		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
 		}),
@@ -105,12 +167,24 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
+		NodesNodesDrainHandler: nodes.NodesDrainHandlerFunc(func(params nodes.NodesDrainParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDrain has not yet been implemented")
+		}),
+		NodesNodesDrainCancelHandler: nodes.NodesDrainCancelHandlerFunc(func(params nodes.NodesDrainCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDrainCancel has not yet been implemented")
+		}),
+		NodesNodesDrainStatusHandler: nodes.NodesDrainStatusHandlerFunc(func(params nodes.NodesDrainStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDrainStatus has not yet been implemented")
+		}),
 		NodesNodesGetHandler: nodes.NodesGetHandlerFunc(func(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGet has not yet been implemented")
 		}),
 		NodesNodesGetClassHandler: nodes.NodesGetClassHandlerFunc(func(params nodes.NodesGetClassParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGetClass has not yet been implemented")
 		}),
+		NodesNodesRebalanceHandler: nodes.NodesRebalanceHandlerFunc(func(params nodes.NodesRebalanceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesRebalance has not yet been implemented")
+		}),
 		ObjectsObjectsClassDeleteHandler: objects.ObjectsClassDeleteHandlerFunc(func(params objects.ObjectsClassDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassDelete has not yet been implemented")
 		}),
@@ -270,12 +344,52 @@ type WeaviateAPI struct {
 
 	// WellKnownGetWellKnownOpenidConfigurationHandler sets the operation handler for the get well known openid configuration operation
 	WellKnownGetWellKnownOpenidConfigurationHandler well_known.GetWellKnownOpenidConfigurationHandler
+	// ApikeysApikeysCreateHandler sets the operation handler for the apikeys create operation
+	ApikeysApikeysCreateHandler apikeys.ApikeysCreateHandler
+	// ApikeysApikeysExpireHandler sets the operation handler for the apikeys expire operation
+	ApikeysApikeysExpireHandler apikeys.ApikeysExpireHandler
+	// ApikeysApikeysListHandler sets the operation handler for the apikeys list operation
+	ApikeysApikeysListHandler apikeys.ApikeysListHandler
+	// ApikeysApikeysRevokeHandler sets the operation handler for the apikeys revoke operation
+	ApikeysApikeysRevokeHandler apikeys.ApikeysRevokeHandler
+	// ApikeysApikeysRotateHandler sets the operation handler for the apikeys rotate operation
+	ApikeysApikeysRotateHandler apikeys.ApikeysRotateHandler
+	// AuthzAuthzAssignRoleToGroupHandler sets the operation handler for the authz assign role to group operation
+	AuthzAuthzAssignRoleToGroupHandler authz.AuthzAssignRoleToGroupHandler
+	// AuthzAuthzAssignRoleToUserHandler sets the operation handler for the authz assign role to user operation
+	AuthzAuthzAssignRoleToUserHandler authz.AuthzAssignRoleToUserHandler
+	// AuthzAuthzCreateRoleHandler sets the operation handler for the authz create role operation
+	AuthzAuthzCreateRoleHandler authz.AuthzCreateRoleHandler
+	// AuthzAuthzDeleteRoleHandler sets the operation handler for the authz delete role operation
+	AuthzAuthzDeleteRoleHandler authz.AuthzDeleteRoleHandler
+	// AuthzAuthzGetRoleHandler sets the operation handler for the authz get role operation
+	AuthzAuthzGetRoleHandler authz.AuthzGetRoleHandler
+	// AuthzAuthzGetRolesHandler sets the operation handler for the authz get roles operation
+	AuthzAuthzGetRolesHandler authz.AuthzGetRolesHandler
+	// AuthzAuthzGetRolesForGroupHandler sets the operation handler for the authz get roles for group operation
+	AuthzAuthzGetRolesForGroupHandler authz.AuthzGetRolesForGroupHandler
+	// AuthzAuthzGetRolesForUserHandler sets the operation handler for the authz get roles for user operation
+	AuthzAuthzGetRolesForUserHandler authz.AuthzGetRolesForUserHandler
+	// AuthzAuthzRevokeRoleFromGroupHandler sets the operation handler for the authz revoke role from group operation
+	AuthzAuthzRevokeRoleFromGroupHandler authz.AuthzRevokeRoleFromGroupHandler
+	// AuthzAuthzRevokeRoleFromUserHandler sets the operation handler for the authz revoke role from user operation
+	AuthzAuthzRevokeRoleFromUserHandler authz.AuthzRevokeRoleFromUserHandler
+	// AuthzAuthzUpdateRoleHandler sets the operation handler for the authz update role operation
+	AuthzAuthzUpdateRoleHandler authz.AuthzUpdateRoleHandler
+	// BackupsBackupsCancelHandler sets the operation handler for the backups cancel operation
+	BackupsBackupsCancelHandler backups.BackupsCancelHandler
 	// BackupsBackupsCreateHandler sets the operation handler for the backups create operation
 	BackupsBackupsCreateHandler backups.BackupsCreateHandler
 	// BackupsBackupsCreateStatusHandler sets the operation handler for the backups create status operation
 	BackupsBackupsCreateStatusHandler backups.BackupsCreateStatusHandler
+	// BackupsBackupsDeleteHandler sets the operation handler for the backups delete operation
+	BackupsBackupsDeleteHandler backups.BackupsDeleteHandler
+	// BackupsBackupsListHandler sets the operation handler for the backups list operation
+	BackupsBackupsListHandler backups.BackupsListHandler
 	// BackupsBackupsRestoreHandler sets the operation handler for the backups restore operation
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
+	// BackupsBackupsRestoreCancelHandler sets the operation handler for the backups restore cancel operation
+	BackupsBackupsRestoreCancelHandler backups.BackupsRestoreCancelHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
 	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
@@ -294,10 +408,18 @@ type WeaviateAPI struct {
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
+	// NodesNodesDrainHandler sets the operation handler for the nodes drain operation
+	NodesNodesDrainHandler nodes.NodesDrainHandler
+	// NodesNodesDrainCancelHandler sets the operation handler for the nodes drain cancel operation
+	NodesNodesDrainCancelHandler nodes.NodesDrainCancelHandler
+	// NodesNodesDrainStatusHandler sets the operation handler for the nodes drain status operation
+	NodesNodesDrainStatusHandler nodes.NodesDrainStatusHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// NodesNodesGetClassHandler sets the operation handler for the nodes get class operation
 	NodesNodesGetClassHandler nodes.NodesGetClassHandler
+	// NodesNodesRebalanceHandler sets the operation handler for the nodes rebalance operation
+	NodesNodesRebalanceHandler nodes.NodesRebalanceHandler
 	// ObjectsObjectsClassDeleteHandler sets the operation handler for the objects class delete operation
 	ObjectsObjectsClassDeleteHandler objects.ObjectsClassDeleteHandler
 	// ObjectsObjectsClassGetHandler sets the operation handler for the objects class get operation
@@ -455,15 +577,75 @@ func (o *WeaviateAPI) Validate() error {
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
+	if o.ApikeysApikeysCreateHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysCreateHandler")
+	}
+	if o.ApikeysApikeysExpireHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysExpireHandler")
+	}
+	if o.ApikeysApikeysListHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysListHandler")
+	}
+	if o.ApikeysApikeysRevokeHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysRevokeHandler")
+	}
+	if o.ApikeysApikeysRotateHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysRotateHandler")
+	}
+	if o.AuthzAuthzAssignRoleToGroupHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzAssignRoleToGroupHandler")
+	}
+	if o.AuthzAuthzAssignRoleToUserHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzAssignRoleToUserHandler")
+	}
+	if o.AuthzAuthzCreateRoleHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzCreateRoleHandler")
+	}
+	if o.AuthzAuthzDeleteRoleHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzDeleteRoleHandler")
+	}
+	if o.AuthzAuthzGetRoleHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzGetRoleHandler")
+	}
+	if o.AuthzAuthzGetRolesHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzGetRolesHandler")
+	}
+	if o.AuthzAuthzGetRolesForGroupHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzGetRolesForGroupHandler")
+	}
+	if o.AuthzAuthzGetRolesForUserHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzGetRolesForUserHandler")
+	}
+	if o.AuthzAuthzRevokeRoleFromGroupHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRevokeRoleFromGroupHandler")
+	}
+	if o.AuthzAuthzRevokeRoleFromUserHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRevokeRoleFromUserHandler")
+	}
+	if o.AuthzAuthzUpdateRoleHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzUpdateRoleHandler")
+	}
+	if o.BackupsBackupsCancelHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsCancelHandler")
+	}
 	if o.BackupsBackupsCreateHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsCreateHandler")
 	}
 	if o.BackupsBackupsCreateStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsCreateStatusHandler")
 	}
+	if o.BackupsBackupsDeleteHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsDeleteHandler")
+	}
+	if o.BackupsBackupsListHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsListHandler")
+	}
 	if o.BackupsBackupsRestoreHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreHandler")
 	}
+	if o.BackupsBackupsRestoreCancelHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsRestoreCancelHandler")
+	}
 	if o.BackupsBackupsRestoreStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
 	}
@@ -491,12 +673,24 @@ func (o *WeaviateAPI) Validate() error {
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
+	if o.NodesNodesDrainHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDrainHandler")
+	}
+	if o.NodesNodesDrainCancelHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDrainCancelHandler")
+	}
+	if o.NodesNodesDrainStatusHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDrainStatusHandler")
+	}
 	if o.NodesNodesGetHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetHandler")
 	}
 	if o.NodesNodesGetClassHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetClassHandler")
 	}
+	if o.NodesNodesRebalanceHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesRebalanceHandler")
+	}
 	if o.ObjectsObjectsClassDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassDeleteHandler")
 	}
@@ -709,15 +903,95 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/apikeys"] = apikeys.NewApikeysCreate(o.context, o.ApikeysApikeysCreateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/apikeys/{id}/expire"] = apikeys.NewApikeysExpire(o.context, o.ApikeysApikeysExpireHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/apikeys"] = apikeys.NewApikeysList(o.context, o.ApikeysApikeysListHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/apikeys/{id}"] = apikeys.NewApikeysRevoke(o.context, o.ApikeysApikeysRevokeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/apikeys/{id}/rotate"] = apikeys.NewApikeysRotate(o.context, o.ApikeysApikeysRotateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/groups/{id}/assign"] = authz.NewAuthzAssignRoleToGroup(o.context, o.AuthzAuthzAssignRoleToGroupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/users/{id}/assign"] = authz.NewAuthzAssignRoleToUser(o.context, o.AuthzAuthzAssignRoleToUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/roles"] = authz.NewAuthzCreateRole(o.context, o.AuthzAuthzCreateRoleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/authz/roles/{id}"] = authz.NewAuthzDeleteRole(o.context, o.AuthzAuthzDeleteRoleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles/{id}"] = authz.NewAuthzGetRole(o.context, o.AuthzAuthzGetRoleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles"] = authz.NewAuthzGetRoles(o.context, o.AuthzAuthzGetRolesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/groups/{id}/roles"] = authz.NewAuthzGetRolesForGroup(o.context, o.AuthzAuthzGetRolesForGroupHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/users/{id}/roles"] = authz.NewAuthzGetRolesForUser(o.context, o.AuthzAuthzGetRolesForUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/groups/{id}/revoke"] = authz.NewAuthzRevokeRoleFromGroup(o.context, o.AuthzAuthzRevokeRoleFromGroupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/users/{id}/revoke"] = authz.NewAuthzRevokeRoleFromUser(o.context, o.AuthzAuthzRevokeRoleFromUserHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/authz/roles/{id}"] = authz.NewAuthzUpdateRole(o.context, o.AuthzAuthzUpdateRoleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/backups/{backend}/{id}/cancel"] = backups.NewBackupsCancel(o.context, o.BackupsBackupsCancelHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/backups/{backend}"] = backups.NewBackupsCreate(o.context, o.BackupsBackupsCreateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/backups/{backend}/{id}"] = backups.NewBackupsCreateStatus(o.context, o.BackupsBackupsCreateStatusHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/backups/{backend}/{id}"] = backups.NewBackupsDelete(o.context, o.BackupsBackupsDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/{backend}"] = backups.NewBackupsList(o.context, o.BackupsBackupsListHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/backups/{backend}/{id}/restore"] = backups.NewBackupsRestore(o.context, o.BackupsBackupsRestoreHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/backups/{backend}/{id}/restore/cancel"] = backups.NewBackupsRestoreCancel(o.context, o.BackupsBackupsRestoreCancelHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -754,6 +1028,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/meta"] = meta.NewMetaGet(o.context, o.MetaMetaGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/{nodeName}/drain"] = nodes.NewNodesDrain(o.context, o.NodesNodesDrainHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/nodes/{nodeName}/drain"] = nodes.NewNodesDrainCancel(o.context, o.NodesNodesDrainCancelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/nodes/{nodeName}/drain"] = nodes.NewNodesDrainStatus(o.context, o.NodesNodesDrainStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -762,6 +1048,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/nodes/{className}"] = nodes.NewNodesGetClass(o.context, o.NodesNodesGetClassHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/rebalance"] = nodes.NewNodesRebalance(o.context, o.NodesNodesRebalanceHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
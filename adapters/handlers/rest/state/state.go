@@ -14,8 +14,10 @@ package state
 import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql"
+	"github.com/weaviate/weaviate/adapters/repos/apikeys"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/adapters/repos/rbac"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
@@ -56,10 +58,16 @@ type State struct {
 	Traverser             *traverser.Traverser
 
 	ClassificationRepo *classifications.DistributedRepo
-	Metrics            *monitoring.PrometheusMetrics
-	BackupManager      *backup.Handler
-	DB                 *db.DB
-	BatchManager       *objects.BatchManager
+	// AuthorizationRepo is only set if role based access control is enabled
+	AuthorizationRepo *rbac.DistributedRepo
+	// APIKeysRepo is only set if api key authentication is enabled
+	APIKeysRepo     *apikeys.DistributedRepo
+	Metrics         *monitoring.PrometheusMetrics
+	BackupManager   *backup.Handler
+	BackupScheduler *backup.Scheduler
+	DB              *db.DB
+	BatchManager    *objects.BatchManager
+	ObjectsManager  *objects.Manager
 }
 
 // GetGraphQL is the safe way to retrieve GraphQL from the state as it can be
@@ -0,0 +1,365 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikeys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	DefaultTxTTL = 60 * time.Second
+	// usageFlushInterval is how often the usage of the keys on this node is
+	// persisted
+	usageFlushInterval = time.Minute
+)
+
+// DistributedRepo replicates the api keys to all nodes of the cluster. When
+// a key was used last is only tracked by the node which authenticated the
+// request, it is collected from all nodes when the keys are listed.
+type DistributedRepo struct {
+	sync.Mutex
+	txRemote  *cluster.TxManager
+	localRepo localRepo
+	usage     usageTracker
+	callbacks []func(keys *apikey.KeySet)
+	logger    logrus.FieldLogger
+}
+
+type localRepo interface {
+	Load(ctx context.Context) (*apikey.KeySet, error)
+	Save(ctx context.Context, keys *apikey.KeySet) error
+	LoadLastUsed(ctx context.Context) (map[string]time.Time, error)
+	SaveLastUsed(ctx context.Context, lastUsed map[string]time.Time) error
+}
+
+type usageTracker interface {
+	LastUsed() map[string]time.Time
+	RestoreLastUsed(lastUsed map[string]time.Time)
+}
+
+func NewDistributedRepo(remoteClient cluster.Client,
+	memberLister cluster.MemberLister, localRepo localRepo,
+	usage usageTracker, logger logrus.FieldLogger,
+) (*DistributedRepo, error) {
+	repo := &DistributedRepo{
+		localRepo: localRepo,
+		usage:     usage,
+		logger:    logger,
+	}
+
+	lastUsed, err := localRepo.LoadLastUsed(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "load usage of api keys")
+	}
+	usage.RestoreLastUsed(lastUsed)
+
+	broadcaster := cluster.NewTxBroadcaster(memberLister, remoteClient)
+	broadcaster.SetConsensusFunction(repo.readConsensus)
+	repo.txRemote = cluster.NewTxManager(broadcaster, &dummyTxPersistence{}, logger)
+	repo.txRemote.SetCommitFn(repo.incomingCommit)
+	repo.txRemote.SetResponseFn(repo.incomingBegin)
+	repo.txRemote.StartAcceptIncoming()
+
+	go repo.flushUsage()
+
+	return repo, nil
+}
+
+func (r *DistributedRepo) Load(ctx context.Context) (*apikey.KeySet, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.localRepo.Load(ctx)
+}
+
+// Save replaces the keys on all nodes. Other nodes refuse the keys if they
+// already have keys of the same or a higher version, i.e. if the keys were
+// changed concurrently.
+func (r *DistributedRepo) Save(ctx context.Context, keys *apikey.KeySet) error {
+	r.Lock()
+	defer r.Unlock()
+
+	tx, err := r.txRemote.BeginTransaction(ctx, apikey.PutKeys,
+		apikey.PutKeysPayload{Keys: keys}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	err = r.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return r.localRepo.Save(ctx, keys)
+}
+
+func (r *DistributedRepo) RegisterUpdateCallback(callback func(keys *apikey.KeySet)) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.callbacks = append(r.callbacks, callback)
+}
+
+// LastUsed returns when the keys were used last on any node. If the other
+// nodes can't be asked, e.g. because another transaction is ongoing, only
+// the usage on this node is returned.
+func (r *DistributedRepo) LastUsed(ctx context.Context) (map[string]time.Time, error) {
+	lastUsed := r.usage.LastUsed()
+
+	tx, err := r.txRemote.BeginTransaction(ctx, apikey.ReadUsage, nil, DefaultTxTTL)
+	if err != nil {
+		r.logger.WithField("action", "read_apikey_usage").WithError(err).
+			Warn("cannot read usage of api keys from other nodes")
+		return lastUsed, nil
+	}
+
+	// this tx is read-only, so we don't have to worry about aborting it
+	defer r.txRemote.CloseReadTransaction(ctx, tx)
+
+	if pl, ok := tx.Payload.(apikey.ReadUsagePayload); ok {
+		mergeLastUsed(lastUsed, pl.LastUsed)
+	}
+	return lastUsed, nil
+}
+
+// StartupSync adopts the keys of the other nodes if they are more recent
+// than the local ones, e.g. because keys were changed while this node was
+// down
+func (r *DistributedRepo) StartupSync(ctx context.Context) error {
+	tx, err := r.txRemote.BeginTransaction(ctx, apikey.ReadKeys, nil, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "read api keys: open transaction")
+	}
+
+	// this tx is read-only, so we don't have to worry about aborting it
+	defer r.txRemote.CloseReadTransaction(ctx, tx)
+
+	pl, ok := tx.Payload.(apikey.ReadKeysPayload)
+	if !ok || pl.Keys == nil {
+		// single node cluster, nothing to sync
+		return nil
+	}
+
+	return r.adopt(ctx, pl.Keys)
+}
+
+// adopt stores keys unless the local keys are at least as recent. The
+// callbacks are called without holding the lock, so that they can't
+// deadlock with a concurrent Save.
+func (r *DistributedRepo) adopt(ctx context.Context, keys *apikey.KeySet) error {
+	r.Lock()
+	local, err := r.localRepo.Load(ctx)
+	if err != nil {
+		r.Unlock()
+		return err
+	}
+	if keys.Version <= local.Version {
+		r.Unlock()
+		return nil
+	}
+	if err := r.localRepo.Save(ctx, keys); err != nil {
+		r.Unlock()
+		return err
+	}
+	callbacks := r.callbacks
+	r.Unlock()
+
+	for _, callback := range callbacks {
+		callback(keys)
+	}
+	return nil
+}
+
+func (r *DistributedRepo) flushUsage() {
+	t := time.NewTicker(usageFlushInterval)
+	defer t.Stop()
+
+	var flushed map[string]time.Time
+	for range t.C {
+		lastUsed := r.usage.LastUsed()
+		if sameLastUsed(lastUsed, flushed) {
+			continue
+		}
+		if err := r.localRepo.SaveLastUsed(context.Background(), lastUsed); err != nil {
+			r.logger.WithField("action", "flush_apikey_usage").WithError(err).
+				Error("cannot persist usage of api keys")
+			continue
+		}
+		flushed = lastUsed
+	}
+}
+
+func (r *DistributedRepo) incomingBegin(ctx context.Context,
+	tx *cluster.Transaction,
+) ([]byte, error) {
+	switch tx.Type {
+	case apikey.PutKeys:
+		local, err := r.localRepo.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys := tx.Payload.(apikey.PutKeysPayload).Keys
+		if keys == nil || keys.Version <= local.Version {
+			return nil, fmt.Errorf("api keys were changed concurrently, " +
+				"local version is more recent than the new keys")
+		}
+		return nil, nil
+
+	case apikey.ReadKeys:
+		local, err := r.localRepo.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tx.Payload = apikey.ReadKeysPayload{Keys: local}
+		data, err := json.Marshal(tx)
+		tx.Payload = apikey.ReadKeysPayload{}
+		return data, err
+
+	case apikey.ReadUsage:
+		tx.Payload = apikey.ReadUsagePayload{LastUsed: r.usage.LastUsed()}
+		data, err := json.Marshal(tx)
+		tx.Payload = apikey.ReadUsagePayload{}
+		return data, err
+
+	default:
+		return nil, nil
+	}
+}
+
+func (r *DistributedRepo) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	switch tx.Type {
+	case apikey.PutKeys:
+		return r.adopt(ctx, tx.Payload.(apikey.PutKeysPayload).Keys)
+
+	case apikey.ReadKeys, apikey.ReadUsage:
+		return nil
+
+	default:
+		return errors.Errorf("unrecognized tx type: %s", tx.Type)
+	}
+}
+
+// readConsensus picks the most recent keys of all nodes, or merges the usage
+// of the keys on all nodes
+func (r *DistributedRepo) readConsensus(ctx context.Context,
+	in []*cluster.Transaction,
+) (*cluster.Transaction, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	switch in[0].Type {
+	case apikey.ReadKeys:
+		var consensus *cluster.Transaction
+		var latest *apikey.KeySet
+		for _, tx := range in {
+			typed, ok, err := unmarshalPayload(tx)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			keys := typed.(apikey.ReadKeysPayload).Keys
+			if keys == nil || (latest != nil && keys.Version <= latest.Version) {
+				continue
+			}
+			latest = keys
+			consensus = tx
+			consensus.Payload = typed
+		}
+		return consensus, nil
+
+	case apikey.ReadUsage:
+		lastUsed := map[string]time.Time{}
+		for _, tx := range in {
+			typed, ok, err := unmarshalPayload(tx)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				mergeLastUsed(lastUsed, typed.(apikey.ReadUsagePayload).LastUsed)
+			}
+		}
+		consensus := in[0]
+		consensus.Payload = apikey.ReadUsagePayload{LastUsed: lastUsed}
+		return consensus, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func unmarshalPayload(tx *cluster.Transaction) (interface{}, bool, error) {
+	raw, ok := tx.Payload.(json.RawMessage)
+	if !ok {
+		return nil, false, nil
+	}
+	typed, err := apikey.UnmarshalTransaction(tx.Type, raw)
+	if err != nil {
+		return nil, false, fmt.Errorf("unmarshal tx: %w", err)
+	}
+	return typed, true, nil
+}
+
+// mergeLastUsed adds the usage of other to lastUsed, keeping the most recent
+// usage of each key
+func mergeLastUsed(lastUsed, other map[string]time.Time) {
+	for id, used := range other {
+		if prev, ok := lastUsed[id]; !ok || prev.Before(used) {
+			lastUsed[id] = used
+		}
+	}
+}
+
+func sameLastUsed(a, b map[string]time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, used := range a {
+		if !used.Equal(b[id]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *DistributedRepo) TxManager() *cluster.TxManager {
+	return r.txRemote
+}
+
+// The keys are stored by the local repo on commit, a tx which was not
+// committed yet does not need to survive a crash.
+type dummyTxPersistence struct{}
+
+func (d *dummyTxPersistence) StoreTx(ctx context.Context, tx *cluster.Transaction) error {
+	return nil
+}
+
+func (d *dummyTxPersistence) DeleteTx(ctx context.Context, txID string) error {
+	return nil
+}
+
+func (d *dummyTxPersistence) IterateAll(ctx context.Context, cb func(tx *cluster.Transaction)) error {
+	return nil
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikeys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	keysBucket  = []byte("apikeys")
+	keysKey     = []byte("keys")
+	usageBucket = []byte("usage")
+	usageKey    = []byte("last_used")
+)
+
+// Repo stores the api keys which were created at runtime and when they were
+// used last on the local node
+type Repo struct {
+	logger  logrus.FieldLogger
+	baseDir string
+	db      *bolt.DB
+}
+
+func NewRepo(baseDir string, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir: baseDir,
+		logger:  logger,
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/apikeys.db", r.baseDir)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{keysBucket, usageBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return errors.Wrapf(err, "create bucket '%s'", string(bucket))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return nil
+}
+
+// Save replaces the stored keys
+func (r *Repo) Save(ctx context.Context, keys *apikey.KeySet) error {
+	return r.put(keysBucket, keysKey, keys)
+}
+
+// Load returns the stored keys, or an empty key set if none were stored yet
+func (r *Repo) Load(ctx context.Context) (*apikey.KeySet, error) {
+	keys := apikey.NewKeySet()
+	ok, err := r.get(keysBucket, keysKey, keys)
+	if err != nil || !ok {
+		return keys, err
+	}
+	if keys.Keys == nil {
+		keys.Keys = map[string]*apikey.Key{}
+	}
+	return keys, nil
+}
+
+// SaveLastUsed replaces when the keys were used last on this node
+func (r *Repo) SaveLastUsed(ctx context.Context, lastUsed map[string]time.Time) error {
+	return r.put(usageBucket, usageKey, lastUsed)
+}
+
+// LoadLastUsed returns when the keys were used last on this node
+func (r *Repo) LoadLastUsed(ctx context.Context) (map[string]time.Time, error) {
+	lastUsed := map[string]time.Time{}
+	if _, err := r.get(usageBucket, usageKey, &lastUsed); err != nil {
+		return nil, err
+	}
+	return lastUsed, nil
+}
+
+func (r *Repo) put(bucket, key []byte, value interface{}) error {
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return errors.Wrap(err, "marshal to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put(key, valueJSON)
+	})
+}
+
+func (r *Repo) get(bucket, key []byte, value interface{}) (bool, error) {
+	var valueJSON []byte
+	r.db.View(func(tx *bolt.Tx) error {
+		valueJSON = append(valueJSON, tx.Bucket(bucket).Get(key)...)
+		return nil
+	})
+
+	if len(valueJSON) == 0 {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(valueJSON, value); err != nil {
+		return false, errors.Wrapf(err, "parse from JSON")
+	}
+	return true, nil
+}
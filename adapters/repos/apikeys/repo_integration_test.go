@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package apikeys
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+)
+
+func Test_APIKeysRepo(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	r, err := NewRepo(dirName, logger)
+	require.Nil(t, err)
+
+	t.Run("loading without stored keys", func(t *testing.T) {
+		keys, err := r.Load(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, apikey.NewKeySet(), keys)
+
+		lastUsed, err := r.LoadLastUsed(context.Background())
+		require.Nil(t, err)
+		assert.Empty(t, lastUsed)
+	})
+
+	key, _, err := apikey.NewKey("jane", "ci", time.Time{})
+	require.Nil(t, err)
+	expected := apikey.NewKeySet()
+	expected.Version = 2
+	expected.Keys[key.ID] = key
+	usedAt := time.Now().UTC().Truncate(time.Millisecond)
+
+	t.Run("storing keys and usage", func(t *testing.T) {
+		require.Nil(t, r.Save(context.Background(), expected))
+		require.Nil(t, r.SaveLastUsed(context.Background(),
+			map[string]time.Time{key.ID: usedAt}))
+	})
+
+	t.Run("loading the stored keys and usage", func(t *testing.T) {
+		keys, err := r.Load(context.Background())
+		require.Nil(t, err)
+		require.Len(t, keys.Keys, 1)
+		assert.Equal(t, expected.Version, keys.Version)
+		assert.Equal(t, key.Hash, keys.Keys[key.ID].Hash)
+		assert.True(t, key.CreatedAt.Equal(keys.Keys[key.ID].CreatedAt))
+
+		lastUsed, err := r.LoadLastUsed(context.Background())
+		require.Nil(t, err)
+		assert.True(t, usedAt.Equal(lastUsed[key.ID]))
+	})
+}
@@ -112,6 +112,8 @@ func (a *Aggregator) aggTypeOfProperty(
 		return "", "", fmt.Errorf("dataType geoCoordinates can't be aggregated")
 	case schema.DataTypePhoneNumber:
 		return "", "", fmt.Errorf("dataType phoneNumber can't be aggregated")
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		return "", "", fmt.Errorf("dataType %s can't be aggregated", dt)
 	default:
 		return "", "", fmt.Errorf("unrecoginzed dataType %v", schemaProp.DataType[0])
 	}
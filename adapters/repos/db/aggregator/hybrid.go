@@ -24,8 +24,10 @@ import (
 
 func (a *Aggregator) buildHybridKeywordRanking() (*searchparams.KeywordRanking, error) {
 	kw := &searchparams.KeywordRanking{
-		Type:  "bm25",
-		Query: a.params.Hybrid.Query,
+		Type:      "bm25",
+		Query:     a.params.Hybrid.Query,
+		Fuzziness: a.params.Hybrid.Fuzziness,
+		Prefix:    a.params.Hybrid.Prefix,
 	}
 
 	cl, err := schema.GetClassByName(
@@ -53,7 +55,7 @@ func (a *Aggregator) bm25Objects(ctx context.Context, kw *searchparams.KeywordRa
 
 	objs, dists, err := inverted.NewBM25Searcher(cfg.BM25, a.store, s,
 		propertyspecific.Indices{}, a.classSearcher,
-		nil, a.propLengths, a.logger, a.shardVersion,
+		nil, a.propLengths, nil, a.logger, a.shardVersion,
 	).BM25F(ctx, nil, a.params.ClassName, *a.params.ObjectLimit, *kw)
 	if err != nil {
 		return nil, nil, fmt.Errorf("bm25 objects: %w", err)
@@ -75,7 +75,9 @@ func (a *Aggregator) objectVectorSearch(searchVector []float32,
 	}
 
 	bucket := a.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional.Properties{})
+	s := a.getSchema.GetSchemaSkipAuth()
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional.Properties{},
+		s.GetClass(a.params.ClassName))
 	if err != nil {
 		return nil, nil, fmt.Errorf("get objects by doc id: %w", err)
 	}
@@ -0,0 +1,332 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// RetainWAL makes the local shards of classes keep their commit logs, which
+// would otherwise be deleted once their content has been flushed, until they
+// are released. The backup archiving the logs is persisted with the class,
+// such that the logs are still retained after a restart. Retained logs are
+// deleted if archive is nil.
+func (db *DB) RetainWAL(ctx context.Context, classes []string, archive *backup.WALArchiveRef) error {
+	for _, class := range classes {
+		idx := db.GetIndex(schema.ClassName(class))
+		if idx == nil {
+			if archive != nil {
+				return fmt.Errorf("class %v doesn't exist", class)
+			}
+			continue
+		}
+		if err := idx.setWALArchive(archive); err != nil {
+			return fmt.Errorf("class %v: %w", class, err)
+		}
+		if archive != nil {
+			continue
+		}
+		if err := idx.ForEachShard(func(name string, s *Shard) error {
+			return s.walRetainer.clear()
+		}); err != nil {
+			return fmt.Errorf("class %v: clear retained commit logs: %w", class, err)
+		}
+	}
+	return nil
+}
+
+// RetainedWAL returns the classes whose commit logs are retained along with
+// the backup archiving them
+func (db *DB) RetainedWAL() map[string]backup.WALArchiveRef {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	retained := map[string]backup.WALArchiveRef{}
+	for _, idx := range db.indices {
+		archive, err := idx.walArchive()
+		if err != nil {
+			db.logger.WithField("action", "archive_wal").
+				WithField("class", idx.Config.ClassName).Error(err)
+			continue
+		}
+		if archive != nil {
+			retained[idx.Config.ClassName.String()] = *archive
+		}
+	}
+	return retained
+}
+
+// walArchivePath is the file persisting the backup the commit logs of the
+// index are retained for
+func (i *Index) walArchivePath() string {
+	return filepath.Join(i.Config.RootPath, i.ID()+".wal_archive.json")
+}
+
+// setWALArchive persists the backup the commit logs are retained for and
+// enables retention, a nil archive disables it
+func (i *Index) setWALArchive(archive *backup.WALArchiveRef) error {
+	path := i.walArchivePath()
+	if archive == nil {
+		i.retainWAL.Store(false)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove commit log archive state: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(archive)
+	if err != nil {
+		return fmt.Errorf("marshal commit log archive state: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o666); err != nil {
+		return fmt.Errorf("write commit log archive state: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write commit log archive state: %w", err)
+	}
+	i.retainWAL.Store(true)
+	return nil
+}
+
+// walArchive returns the backup the commit logs are retained for or nil if
+// they are not retained
+func (i *Index) walArchive() (*backup.WALArchiveRef, error) {
+	data, err := os.ReadFile(i.walArchivePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read commit log archive state: %w", err)
+	}
+	var archive backup.WALArchiveRef
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return nil, fmt.Errorf("unmarshal commit log archive state: %w", err)
+	}
+	return &archive, nil
+}
+
+// WALFiles returns the commit logs of all local shards of class. The size
+// of the logs is determined before the shard metadata is read, such that
+// the metadata covers all mutations contained in the logs.
+func (db *DB) WALFiles(ctx context.Context, class string) ([]backup.ShardWAL, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, fmt.Errorf("class %v doesn't exist", class)
+	}
+	var shards []backup.ShardWAL
+	err := idx.ForEachShard(func(name string, s *Shard) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		sw, err := s.walFiles()
+		if err != nil {
+			return fmt.Errorf("shard %s: %w", name, err)
+		}
+		shards = append(shards, sw)
+		return nil
+	})
+	return shards, err
+}
+
+// ReleaseWAL deletes retained commit logs of class which have been archived
+func (db *DB) ReleaseWAL(ctx context.Context, class string, paths []string) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil
+	}
+	for _, p := range paths {
+		dir, _, _ := strings.Cut(p, "/")
+		if !strings.HasPrefix(dir, idx.ID()+"_") || !strings.HasSuffix(dir, walRetainerSuffix) {
+			return fmt.Errorf("%s is not a retained commit log of class %v", p, class)
+		}
+		if err := os.Remove(filepath.Join(idx.Config.RootPath, p)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("release commit log: %w", err)
+		}
+	}
+	return nil
+}
+
+// walFiles flushes the buffered commit logs of the shard and lists them
+// along with the metadata of the shard
+func (s *Shard) walFiles() (sw backup.ShardWAL, err error) {
+	if err := s.store.WriteWALs(); err != nil {
+		return sw, fmt.Errorf("flush commit logs: %w", err)
+	}
+	if err := s.flushVectorIndexes(); err != nil {
+		return sw, fmt.Errorf("flush vector commit logs: %w", err)
+	}
+
+	if sw.Logs, err = s.walRetainer.list(); err != nil {
+		return sw, err
+	}
+	dirs := []string{s.DBPathLSM()}
+	s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		id := s.ID()
+		if targetVector != "" {
+			id = s.vectorIndexID(targetVector)
+		}
+		dirs = append(dirs, filepath.Join(s.index.Config.RootPath, id+".hnsw.commitlog.d"))
+		return nil
+	})
+	for _, dir := range dirs {
+		logs, err := listCommitLogs(s.index.Config.RootPath, dir)
+		if err != nil {
+			return sw, err
+		}
+		sw.Logs = append(sw.Logs, logs...)
+	}
+
+	if err := s.readBackupMetadata(&sw.Meta); err != nil {
+		return sw, err
+	}
+	return sw, nil
+}
+
+// listCommitLogs lists the commit logs found in dir. These are the write-ahead
+// logs of LSM buckets and the raw commit logs of vector indexes.
+func listCommitLogs(rootPath, dir string) ([]backup.WALFile, error) {
+	var logs []backup.WALFile
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil // e.g. vector indexes without commit logs
+			}
+			return err
+		}
+		if d.IsDir() || !isCommitLog(path) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(rootPath, path)
+		if err != nil {
+			return err
+		}
+		logs = append(logs, backup.WALFile{Path: rel, Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list commit logs in %s: %w", dir, err)
+	}
+	return logs, nil
+}
+
+// isCommitLog returns true for write-ahead logs of LSM buckets and raw,
+// i.e. not condensed, commit logs of vector indexes
+func isCommitLog(path string) bool {
+	if filepath.Ext(path) == ".wal" {
+		return true
+	}
+	if !strings.HasSuffix(filepath.Dir(path), ".hnsw.commitlog.d") {
+		return false
+	}
+	name := filepath.Base(path)
+	return name != "" && strings.Trim(name, "0123456789") == ""
+}
+
+const walRetainerSuffix = ".wal_archive"
+
+// walRetainer keeps the commit logs of a shard while they are archived for
+// point-in-time recovery. Logs which would be deleted, because their content
+// has been flushed, are moved to the archive directory of the shard instead.
+// There, they keep their path relative to the root path.
+type walRetainer struct {
+	rootPath string
+	dir      string
+	enabled  *atomic.Bool // shared by all shards of an index
+}
+
+func newWALRetainer(rootPath, shardID string, enabled *atomic.Bool) *walRetainer {
+	return &walRetainer{
+		rootPath: rootPath,
+		dir:      filepath.Join(rootPath, shardID+walRetainerSuffix),
+		enabled:  enabled,
+	}
+}
+
+// Retain moves the commit log at path to the archive directory if retention
+// is enabled
+func (r *walRetainer) Retain(path string) (bool, error) {
+	if r == nil || !r.enabled.Load() {
+		return false, nil
+	}
+	rel, err := filepath.Rel(r.rootPath, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return false, nil
+	}
+	dst := filepath.Join(r.dir, rel)
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return false, fmt.Errorf("retain commit log %s: %w", rel, err)
+	}
+	if err := os.Rename(path, dst); err != nil {
+		return false, fmt.Errorf("retain commit log %s: %w", rel, err)
+	}
+	return true, nil
+}
+
+// list returns the retained commit logs
+func (r *walRetainer) list() ([]backup.WALFile, error) {
+	if r == nil {
+		return nil, nil
+	}
+	var logs []backup.WALFile
+	err := filepath.WalkDir(r.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(r.dir, path)
+		if err != nil {
+			return err
+		}
+		retained, err := filepath.Rel(r.rootPath, path)
+		if err != nil {
+			return err
+		}
+		logs = append(logs, backup.WALFile{Path: rel, Retained: retained, Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list retained commit logs: %w", err)
+	}
+	return logs, nil
+}
+
+// clear deletes all retained commit logs
+func (r *walRetainer) clear() error {
+	if r == nil {
+		return nil
+	}
+	return os.RemoveAll(r.dir)
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestWALRetainer(t *testing.T) {
+	root := t.TempDir()
+	var enabled atomic.Bool
+	r := newWALRetainer(root, "class_shard", &enabled)
+
+	write := func(rel string) string {
+		path := filepath.Join(root, rel)
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.Nil(t, os.WriteFile(path, []byte("log"), 0o644))
+		return path
+	}
+	wal := filepath.Join("class_shard_lsm", "objects", "segment-1.wal")
+
+	t.Run("disabled", func(t *testing.T) {
+		retained, err := r.Retain(write(wal))
+		require.Nil(t, err)
+		assert.False(t, retained)
+		logs, err := r.list()
+		require.Nil(t, err)
+		assert.Empty(t, logs)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		enabled.Store(true)
+		path := write(wal)
+		retained, err := r.Retain(path)
+		require.Nil(t, err)
+		assert.True(t, retained)
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+
+		logs, err := r.list()
+		require.Nil(t, err)
+		assert.Equal(t, []backup.WALFile{{
+			Path:     wal,
+			Retained: filepath.Join("class_shard.wal_archive", wal),
+			Size:     3,
+		}}, logs)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		require.Nil(t, r.clear())
+		logs, err := r.list()
+		require.Nil(t, err)
+		assert.Empty(t, logs)
+	})
+}
+
+func TestWALArchiveState(t *testing.T) {
+	idx := &Index{Config: IndexConfig{RootPath: t.TempDir(), ClassName: "Article"}}
+	archive := &backup.WALArchiveRef{Backend: "s3", ID: "base"}
+
+	got, err := idx.walArchive()
+	require.Nil(t, err)
+	assert.Nil(t, got)
+
+	require.Nil(t, idx.setWALArchive(archive))
+	assert.True(t, idx.retainWAL.Load())
+	// a restarted index finds the persisted state
+	got, err = (&Index{Config: idx.Config}).walArchive()
+	require.Nil(t, err)
+	assert.Equal(t, archive, got)
+
+	require.Nil(t, idx.setWALArchive(nil))
+	assert.False(t, idx.retainWAL.Load())
+	got, err = idx.walArchive()
+	require.Nil(t, err)
+	assert.Nil(t, got)
+}
+
+func TestIsCommitLog(t *testing.T) {
+	for path, expected := range map[string]bool{
+		"class_shard_lsm/objects/segment-1.wal":             true,
+		"class_shard_lsm/objects/segment-1.db":              false,
+		"class_shard.hnsw.commitlog.d/1681234567":           true,
+		"class_shard.hnsw.commitlog.d/1681234567.condensed": false,
+		"class_shard.hnsw.commitlog.d/1.combined.tmp":       false,
+	} {
+		assert.Equal(t, expected, isCommitLog(path), path)
+	}
+}
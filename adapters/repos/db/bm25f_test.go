@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -602,6 +603,306 @@ func TestBM25FWithFilters_ScoreIsIdenticalWithOrWithoutFilter(t *testing.T) {
 	assert.Equal(t, filtered[0].Score(), unfiltered[0].Score())
 }
 
+func TestBM25FPhrases(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	SetupClass(t, repo, schemaGetter, logger, 0.5, 100)
+
+	idx := repo.GetIndex("MyClass")
+	require.NotNil(t, idx)
+
+	search := func(properties []string, query string) ([]uint64, error) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0)
+		if err != nil {
+			return nil, err
+		}
+		docIDs := make([]uint64, len(res))
+		for i := range res {
+			docIDs[i] = res[i].DocID()
+		}
+		return docIDs, nil
+	}
+
+	type testCase struct {
+		name       string
+		properties []string
+		query      string
+		expected   []uint64
+	}
+
+	testCases := []testCase{
+		{
+			name:       "exact phrase",
+			properties: []string{"description"},
+			query:      `"we get to"`,
+			expected:   []uint64{0},
+		},
+		{
+			name:       "terms in different order",
+			properties: []string{"description"},
+			query:      `"get we"~5`,
+			expected:   []uint64{},
+		},
+		{
+			name:       "phrase with a gap",
+			properties: []string{"description"},
+			query:      `"how get"`,
+			expected:   []uint64{},
+		},
+		{
+			name:       "phrase with a gap within slop",
+			properties: []string{"description"},
+			query:      `"how get"~1`,
+			expected:   []uint64{0, 1},
+		},
+		{
+			name:       "phrase across elements of a text array",
+			properties: []string{"multiTitles"},
+			query:      `"breakfast dinner"~10`,
+			expected:   []uint64{},
+		},
+		{
+			name:       "phrase combined with terms",
+			properties: []string{"title"},
+			query:      `"journey to bm25f" unrelated`,
+			expected:   []uint64{0, 3, 7},
+		},
+		{
+			name:       "single term phrase",
+			properties: []string{"textField"},
+			query:      `"YELLING IS FUN"`,
+			expected:   []uint64{8},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			docIDs, err := search(tc.properties, tc.query)
+			require.Nil(t, err)
+			assert.ElementsMatch(t, tc.expected, docIDs)
+		})
+	}
+
+	t.Run("positions of existing properties are indexed by reindex task", func(t *testing.T) {
+		idx.ForEachShard(func(name string, shard *Shard) error {
+			return shard.store.DropBucket(context.Background(),
+				helpers.BucketSearchablePositionsFromPropNameLSM("description"))
+		})
+
+		_, err := search([]string{"description"}, `"we get to"`)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "token positions are not indexed")
+
+		migrator := NewMigrator(repo, logger)
+		require.Nil(t, migrator.InvertedReindex(context.Background(), "ShardInvertedReindexTaskSearchablePositions"))
+
+		docIDs, err := search([]string{"description"}, `"we get to"`)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0}, docIDs)
+	})
+}
+
+func TestBM25FFuzzyAndPrefix(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	SetupClass(t, repo, schemaGetter, logger, 0.5, 100)
+
+	idx := repo.GetIndex("MyClass")
+	require.NotNil(t, idx)
+
+	search := func(properties []string, query string, fuzziness int, prefix bool) ([]uint64, error) {
+		kwr := &searchparams.KeywordRanking{
+			Type: "bm25", Properties: properties, Query: query,
+			Fuzziness: fuzziness, Prefix: prefix,
+		}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0)
+		if err != nil {
+			return nil, err
+		}
+		docIDs := make([]uint64, len(res))
+		for i := range res {
+			docIDs[i] = res[i].DocID()
+		}
+		return docIDs, nil
+	}
+
+	type testCase struct {
+		name       string
+		properties []string
+		query      string
+		fuzziness  int
+		prefix     bool
+		expected   []uint64
+	}
+
+	testCases := []testCase{
+		{
+			name:       "typo without fuzziness",
+			properties: []string{"title"},
+			query:      "jurney",
+			expected:   []uint64{},
+		},
+		{
+			name:       "typo with fuzziness",
+			properties: []string{"title"},
+			query:      "jurney",
+			fuzziness:  1,
+			expected:   []uint64{0, 1, 2, 4, 5, 6},
+		},
+		{
+			name:       "transposition with fuzziness",
+			properties: []string{"multiTitles"},
+			query:      "sandwihces",
+			fuzziness:  1,
+			expected:   []uint64{1},
+		},
+		{
+			name:       "too many edits",
+			properties: []string{"multiTitles"},
+			query:      "sandwhiche",
+			fuzziness:  1,
+			expected:   []uint64{},
+		},
+		{
+			name:       "prefix of last term",
+			properties: []string{"title"},
+			query:      "unrelated journ",
+			prefix:     true,
+			expected:   []uint64{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			name:       "prefix of completed term",
+			properties: []string{"title"},
+			query:      "journ ",
+			prefix:     true,
+			expected:   []uint64{},
+		},
+		{
+			name:       "fuzzy terms and prefix of last term",
+			properties: []string{"title", "description"},
+			query:      "abut absolut",
+			fuzziness:  1,
+			prefix:     true,
+			expected:   []uint64{1, 2, 3, 7},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			docIDs, err := search(tc.properties, tc.query, tc.fuzziness, tc.prefix)
+			require.Nil(t, err)
+			assert.ElementsMatch(t, tc.expected, docIDs)
+		})
+	}
+
+	t.Run("fuzziness out of range", func(t *testing.T) {
+		_, err := search([]string{"title"}, "jurney", 3, false)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "fuzziness must be between 0 and 2")
+	})
+
+	t.Run("terms written after lookups", func(t *testing.T) {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", 10)).String())
+		obj := &models.Object{Class: "MyClass", ID: id, Properties: map[string]interface{}{"title": "Journeyman"}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+
+		docIDs, err := search([]string{"title"}, "journeym", 0, true)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{10}, docIDs)
+	})
+}
+
+func TestBM25FHighlight(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	SetupClass(t, repo, schemaGetter, logger, 0.5, 100)
+
+	idx := repo.GetIndex("MyClass")
+	require.NotNil(t, idx)
+
+	search := func(kwr *searchparams.KeywordRanking) map[uint64]interface{} {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+		highlights := map[uint64]interface{}{}
+		for i := range res {
+			highlights[res[i].DocID()] = res[i].AdditionalProperties()["highlight"]
+		}
+		return highlights
+	}
+
+	t.Run("with highlight", func(t *testing.T) {
+		highlights := search(&searchparams.KeywordRanking{
+			Type: "bm25", Properties: []string{"title", "description"}, Query: "journey", Highlight: true,
+		})
+		assert.Equal(t, []*additional.Highlight{
+			{Property: "title", Fragments: []string{"My journeys in <em>Journey</em>"}},
+			{Property: "description", Fragments: []string{"A <em>journey</em> story about journeying"}},
+		}, highlights[2])
+		assert.Equal(t, []*additional.Highlight{
+			{Property: "description", Fragments: []string{"Actually all about <em>journey</em>"}},
+		}, highlights[3])
+	})
+
+	t.Run("with fuzziness", func(t *testing.T) {
+		highlights := search(&searchparams.KeywordRanking{
+			Type: "bm25", Properties: []string{"title"}, Query: "journy", Fuzziness: 1, Highlight: true,
+		})
+		assert.Equal(t, []*additional.Highlight{
+			{Property: "title", Fragments: []string{"Why I dont like <em>journey</em>"}},
+		}, highlights[1])
+	})
+
+	t.Run("without highlight", func(t *testing.T) {
+		highlights := search(&searchparams.KeywordRanking{
+			Type: "bm25", Properties: []string{"title"}, Query: "journey",
+		})
+		require.NotEmpty(t, highlights)
+		for _, highlight := range highlights {
+			assert.Nil(t, highlight)
+		}
+	})
+}
+
 func TestBM25FDifferentParamsJourney(t *testing.T) {
 	dirName := t.TempDir()
 
@@ -84,6 +84,7 @@ func (n *node) init(dirName string, shardStateRaw []byte,
 	}
 
 	n.repo.SetSchemaGetter(n.schemaManager)
+	client.SetSchemaGetter(n.schemaManager)
 	err = n.repo.WaitForStartup(context.Background())
 	if err != nil {
 		panic(err)
@@ -99,14 +100,16 @@ func (n *node) init(dirName string, shardStateRaw []byte,
 
 	n.migrator = db.NewMigrator(n.repo, logger)
 
-	indices := clusterapi.NewIndices(sharding.NewRemoteIndexIncoming(n.repo), n.repo, clusterapi.NewNoopAuthHandler())
+	indices := clusterapi.NewIndices(sharding.NewRemoteIndexIncoming(n.repo), n.repo,
+		n.schemaManager, clusterapi.NewNoopAuthHandler())
 	mux := http.NewServeMux()
 	mux.Handle("/indices/", indices.Indices())
 
-	backups := clusterapi.NewBackups(n.backupManager, clusterapi.NewNoopAuthHandler())
+	backups := clusterapi.NewBackups(n.backupManager, n.scheduler, clusterapi.NewNoopAuthHandler())
 	mux.Handle("/backups/can-commit", backups.CanCommit())
 	mux.Handle("/backups/commit", backups.Commit())
 	mux.Handle("/backups/abort", backups.Abort())
+	mux.Handle("/backups/cancel", backups.Cancel())
 	mux.Handle("/backups/status", backups.Status())
 
 	srv := httptest.NewServer(mux)
@@ -200,7 +203,11 @@ type nodeResolver struct {
 }
 
 func (r nodeResolver) AllNames() []string {
-	panic("node resolving not implemented yet")
+	names := make([]string, len(*r.nodes))
+	for i, node := range *r.nodes {
+		names[i] = node.name
+	}
+	return names
 }
 
 func (r nodeResolver) Candidates() []string {
@@ -303,6 +310,24 @@ func (f *fakeBackupBackend) SourceDataPath() string {
 	return f.backupsPath
 }
 
+func (f *fakeBackupBackend) ListBackups(ctx context.Context) ([]string, error) {
+	f.Lock()
+	defer f.Unlock()
+	return nil, nil
+}
+
+func (f *fakeBackupBackend) DeleteBackup(ctx context.Context, backupID string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
+func (f *fakeBackupBackend) DeleteObject(ctx context.Context, backupID, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
 func (f *fakeBackupBackend) setLocal(v bool) {
 	f.Lock()
 	defer f.Unlock()
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCRUD_AsyncIndexing(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "AsyncIndexingClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+		AsyncIndexing:             true,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	objects := []struct {
+		id     strfmt.UUID
+		vector []float32
+	}{
+		{id: "8d5a3aa2-3c8d-4589-9ae1-3f638f506003", vector: []float32{1, 1, 1, 1}},
+		{id: "8d5a3aa2-3c8d-4589-9ae1-3f638f506004", vector: []float32{-1, 1, 1, 1}},
+		{id: "8d5a3aa2-3c8d-4589-9ae1-3f638f506005", vector: []float32{-1, -1, -1, -1}},
+	}
+
+	search := func(t *testing.T, limit int) []strfmt.UUID {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			SearchVector: []float32{1, 1, 1, 0.9},
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: limit},
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	vectorQueueLength := func(t *testing.T) int64 {
+		nodeStatuses, err := repo.GetNodeStatus(context.Background(), class.Class)
+		require.Nil(t, err)
+		require.Len(t, nodeStatuses, 1)
+
+		var length int64
+		for _, shard := range nodeStatuses[0].Shards {
+			length += shard.VectorQueueLength
+		}
+		return length
+	}
+
+	t.Run("adding objects", func(t *testing.T) {
+		for _, obj := range objects {
+			err := repo.PutObject(context.Background(), &models.Object{
+				ID:         obj.id,
+				Class:      class.Class,
+				Properties: map[string]interface{}{"name": "some name"},
+			}, obj.vector, nil)
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("searching by vector while indexing", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{objects[0].id, objects[1].id}, search(t, 2))
+	})
+
+	t.Run("waiting for the queue to be indexed", func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			return vectorQueueLength(t) == 0
+		}, 10*time.Second, 50*time.Millisecond)
+
+		assert.Equal(t, []strfmt.UUID{objects[0].id, objects[1].id}, search(t, 2))
+	})
+
+	t.Run("deleting an object", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class,
+			objects[0].id, nil, ""))
+
+		assert.Equal(t, []strfmt.UUID{objects[1].id}, search(t, 1))
+	})
+}
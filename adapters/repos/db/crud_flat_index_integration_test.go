@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestCRUD_FlatIndex(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	vectorIndexConfig := flatent.NewDefaultUserConfig()
+	vectorIndexConfig.BQ.Enabled = true
+	class := &models.Class{
+		Class:               "FlatIndexClass",
+		VectorIndexType:     "flat",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	objects := []struct {
+		id     strfmt.UUID
+		vector []float32
+	}{
+		{id: "8d5a3aa2-3c8d-4589-9ae1-3f638f506003", vector: []float32{1, 1, 1, 1}},
+		{id: "8d5a3aa2-3c8d-4589-9ae1-3f638f506004", vector: []float32{-1, 1, 1, 1}},
+		{id: "8d5a3aa2-3c8d-4589-9ae1-3f638f506005", vector: []float32{-1, -1, -1, -1}},
+	}
+
+	t.Run("adding objects", func(t *testing.T) {
+		for _, obj := range objects {
+			err := repo.PutObject(context.Background(), &models.Object{
+				ID:         obj.id,
+				Class:      class.Class,
+				Properties: map[string]interface{}{"name": "some name"},
+			}, obj.vector, nil)
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("rejecting a vector with a different length", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    "8d5a3aa2-3c8d-4589-9ae1-3f638f506006",
+			Class: class.Class,
+		}, []float32{1, 2}, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("searching by vector", func(t *testing.T) {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			SearchVector: []float32{1, 1, 1, 0.9},
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 2},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, objects[0].id, res[0].ID)
+		assert.Equal(t, objects[1].id, res[1].ID)
+	})
+
+	t.Run("deleting an object", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class,
+			objects[0].id, nil, ""))
+
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			SearchVector: []float32{1, 1, 1, 0.9},
+			ClassName:    class.Class,
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, objects[1].id, res[0].ID)
+	})
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// ExportShards returns the shards of a class, or the shard of a single tenant
+// if multi-tenancy is enabled. The shards are sorted by name, so that
+// exports can be resumed in the same order.
+func (db *DB) ExportShards(ctx context.Context, class, tenant string) ([]string, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, objects.NewErrNotFound("class %s not found", class)
+	}
+	if err := idx.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+
+	shards, err := idx.targetShardNames(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(shards)
+	return shards, nil
+}
+
+// ExportObjects returns up to limit objects of a single shard including their
+// vectors. The objects are read in the order of the objects bucket, starting
+// after the given id, so that consecutive calls walk the whole shard.
+func (db *DB) ExportObjects(ctx context.Context, class, shard string,
+	after strfmt.UUID, limit int, tenant string,
+) (search.Results, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, objects.NewErrNotFound("class %s not found", class)
+	}
+
+	addl := additional.Properties{Vector: true}
+	cursor := &filters.Cursor{After: after.String(), Limit: limit}
+
+	var objs []*storobj.Object
+	var err error
+	if local := idx.localShard(shard); local != nil {
+		objs, _, err = local.objectSearch(ctx, limit, nil, nil, nil, cursor, addl)
+		if err != nil {
+			return nil, fmt.Errorf("local shard %s: %w", shard, err)
+		}
+	} else {
+		objs, _, err = idx.remote.SearchShard(ctx, shard, nil, "", limit, nil, nil,
+			nil, cursor, nil, addl, idx.replicationEnabled())
+		if err != nil {
+			return nil, fmt.Errorf("remote shard %s: %w", shard, err)
+		}
+	}
+
+	return storobj.SearchResults(objs, addl, tenant), nil
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -69,6 +70,11 @@ func (f *fakeSchemaGetter) ShardReplicas(class, shard string) ([]string, error)
 }
 
 func (f *fakeSchemaGetter) TenantShard(class, tenant string) (string, string) {
+	if f.shardState != nil {
+		if status := f.shardState.Physical[tenant].Status; status != "" {
+			return tenant, status
+		}
+	}
 	return tenant, models.TenantActivityStatusHOT
 }
 
@@ -196,7 +202,7 @@ func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexN
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int,
+	shardName string, vector []float32, targetVector string, limit int,
 	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -233,6 +239,12 @@ func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	return "", nil
 }
 
+func (f *fakeRemoteClient) TenantsLastUsed(ctx context.Context,
+	hostName, indexName string, shardNames []string,
+) (map[string]time.Time, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string,
 ) error {
@@ -92,6 +92,8 @@ var (
 	and  = filters.OperatorAnd
 	null = filters.OperatorIsNull
 
+	containsNone = filters.ContainsNone
+
 	// datatypes
 	dtInt            = schema.DataTypeInt
 	dtBool           = schema.DataTypeBoolean
@@ -651,22 +653,45 @@ func testChainedPrimitiveProps(repo *DB,
 				),
 				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
 			},
-			// test{
-			// 	name: "NOT modelName == sprinter, modelName == e63s",
-			// 	filter: filterNot(
-			// 		buildFilter("modelName", "sprinter", eq, dtText),
-			// 		buildFilter("modelName", "e63s", eq, dtText),
-			// 	),
-			// 	expectedIDs: []strfmt.UUID{carPoloID},
-			// },
-			// test{
-			// 	name: "NOT horsepower < 200 , weight > 3000",
-			// 	filter: filterNot(
-			// 		buildFilter("horsepower", 200, lt, dtNumber),
-			// 		buildFilter("weight", 3000, gt, dtNumber),
-			// 	),
-			// 	expectedIDs: []strfmt.UUID{carE63sID},
-			// },
+			{
+				name: "NOT (modelName == sprinter OR modelName == e63s)",
+				filter: filterNot(
+					filterOr(
+						buildFilter("modelName", "sprinter", eq, dtText),
+						buildFilter("modelName", "e63s", eq, dtText),
+					),
+				),
+				expectedIDs: []strfmt.UUID{carPoloID, carNilID, carEmpty},
+			},
+			{
+				name: "weight > 1500 AND NOT horsepower > 200",
+				filter: filterAnd(
+					buildFilter("weight", float64(1500), gt, dtNumber),
+					filterNot(buildFilter("horsepower", 200, gt, dtInt)),
+				),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name:        "NOT NOT modelName == polo",
+				filter:      filterNot(filterNot(buildFilter("modelName", "polo", eq, dtText))),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				name:        "colorArrayField ContainsNone [grey, light grey]",
+				filter:      buildFilter("colorArrayField", []string{"grey", "light grey"}, containsNone, dtText),
+				expectedIDs: []strfmt.UUID{carNilID, carEmpty},
+			},
+			{
+				name:        "colorArrayField ContainsNone [dark]",
+				filter:      buildFilter("colorArrayField", []string{"dark"}, containsNone, dtText),
+				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID, carNilID, carEmpty},
+			},
+			{
+				name: "availableAtDealerships ContainsNone [south]",
+				filter: buildFilter("availableAtDealerships",
+					[]string{dealershipSouth.String()}, containsNone, dtText),
+				expectedIDs: []strfmt.UUID{carE63sID, carNilID, carEmpty},
+			},
 			{
 				name: "(heavy AND powerful) OR light",
 				filter: filterOr(
@@ -757,6 +782,10 @@ func filterOr(operands ...*filters.LocalFilter) *filters.LocalFilter {
 	return compoundFilter(filters.OperatorOr, operands...)
 }
 
+func filterNot(operand *filters.LocalFilter) *filters.LocalFilter {
+	return compoundFilter(filters.OperatorNot, operand)
+}
+
 // test data
 var carClass = &models.Class{
 	Class:               "FilterTestCar",
@@ -1614,6 +1643,17 @@ func TestFilteringAfterDeletion(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(resLen))
 		assert.Equal(t, UUID2, resLen[0].ID)
+
+		filterNotName := filterNot(buildFilter("name", "otherthing", eq, dtText))
+		paramsNot := dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 2},
+			Filters:    filterNotName,
+		}
+		resNot, err := repo.Search(context.Background(), paramsNot)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(resNot))
+		assert.Equal(t, UUID2, resNot[0].ID)
 	})
 
 	t.Run("Delete object and filter again", func(t *testing.T) {
@@ -1638,5 +1678,15 @@ func TestFilteringAfterDeletion(t *testing.T) {
 		resLen, err := repo.Search(context.Background(), paramsLen)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(resLen))
+
+		filterNotName := filterNot(buildFilter("name", "otherthing", eq, dtText))
+		paramsNot := dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 2},
+			Filters:    filterNotName,
+		}
+		resNot, err := repo.Search(context.Background(), paramsNot)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(resNot))
 	})
 }
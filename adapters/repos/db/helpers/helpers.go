@@ -22,6 +22,10 @@ var (
 	ObjectsBucketLSM           = "objects"
 	CompressedObjectsBucketLSM = "compressed_objects"
 	DimensionsBucketLSM        = "dimensions"
+	VectorsBucketLSM           = "vectors"
+	VectorsCompressedBucketLSM = "vectors_compressed"
+	VectorIndexQueueBucketLSM  = "vector_index_queue"
+	DynamicIndexStateBucketLSM = "dynamic_index_state"
 	DocIDBucket                = []byte("doc_ids")
 )
 
@@ -71,3 +75,7 @@ func TempBucketFromBucketName(bucketName string) string {
 func BucketSearchableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_searchable")
 }
+
+func BucketSearchablePositionsFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_searchable_positions")
+}
@@ -0,0 +1,308 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// stemmers reduce terms of a language to their stem. Only the languages
+// listed here are stemmed. Changing a stemmer changes the indexed terms,
+// existing properties have to be reindexed then.
+var stemmers = map[string]func([]rune) []rune{
+	models.TextAnalyzerConfigLanguageEn: stemEnglish,
+}
+
+// englishExceptions are stemmed irregularly or not at all
+var englishExceptions = map[string]string{
+	"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie", "tying": "tie",
+	"idly": "idl", "gently": "gentl", "ugly": "ugli", "early": "earli", "only": "onli",
+	"singly": "singl", "sky": "sky", "news": "news", "howe": "howe", "atlas": "atlas",
+	"cosmos": "cosmos", "bias": "bias", "andes": "andes",
+}
+
+// englishInvariants are not stemmed any further once their plural is removed
+var englishInvariants = map[string]bool{
+	"inning": true, "outing": true, "canning": true, "herring": true,
+	"earring": true, "proceed": true, "exceed": true, "succeed": true,
+}
+
+// englishSuffix is replaced by repl if it is found in the region of a step
+type englishSuffix struct {
+	suffix, repl string
+}
+
+// the suffixes of a step are ordered by length, the longest one is removed
+var (
+	englishStep2 = []englishSuffix{
+		{"ization", "ize"}, {"ational", "ate"}, {"fulness", "ful"}, {"ousness", "ous"},
+		{"iveness", "ive"}, {"tional", "tion"}, {"biliti", "ble"}, {"lessli", "less"},
+		{"entli", "ent"}, {"ation", "ate"}, {"alism", "al"}, {"aliti", "al"},
+		{"ousli", "ous"}, {"iviti", "ive"}, {"fulli", "ful"}, {"enci", "ence"},
+		{"anci", "ance"}, {"abli", "able"}, {"izer", "ize"}, {"ator", "ate"},
+		{"alli", "al"}, {"bli", "ble"}, {"ogi", "og"}, {"li", ""},
+	}
+	englishStep3 = []englishSuffix{
+		{"ational", "ate"}, {"tional", "tion"}, {"alize", "al"}, {"icate", "ic"},
+		{"iciti", "ic"}, {"ative", ""}, {"ical", "ic"}, {"ness", ""}, {"ful", ""},
+	}
+	englishStep4 = []englishSuffix{
+		{"ement", ""}, {"ance", ""}, {"ence", ""}, {"able", ""}, {"ible", ""},
+		{"ment", ""}, {"ant", ""}, {"ent", ""}, {"ism", ""}, {"ate", ""}, {"iti", ""},
+		{"ous", ""}, {"ive", ""}, {"ize", ""}, {"ion", ""}, {"al", ""}, {"er", ""},
+		{"ic", ""},
+	}
+)
+
+// stemEnglish is the English (Porter2) stemmer of the Snowball project, see
+// https://snowballstem.org/algorithms/english/stemmer.html, e.g. "generously"
+// becomes "generous" and "queries" becomes "queri"
+func stemEnglish(s []rune) []rune {
+	if len(s) > 0 && s[0] == '\'' {
+		s = s[1:]
+	}
+	if len(s) <= 2 {
+		return s
+	}
+	if stem, ok := englishExceptions[string(s)]; ok {
+		return []rune(stem)
+	}
+
+	// a y which is used as a consonant is marked as Y
+	for i, r := range s {
+		if r == 'y' && (i == 0 || isEnglishVowel(s[i-1])) {
+			s[i] = 'Y'
+		}
+	}
+	r1 := englishRegion(s, 0)
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if hasPrefix(s, prefix) {
+			r1 = len(prefix)
+			break
+		}
+	}
+	r2 := englishRegion(s, r1)
+
+	s = stemEnglishStep1a(trimLongestSuffix(s, "'s'", "'s", "'"))
+	if englishInvariants[string(s)] {
+		return s
+	}
+	s = stemEnglishStep1b(s, r1)
+	if n := len(s); n > 2 && (s[n-1] == 'y' || s[n-1] == 'Y') && !isEnglishVowel(s[n-2]) {
+		s[n-1] = 'i'
+	}
+	s = replaceEnglishSuffix(s, englishStep2, r1, func(s []rune, suffix string) bool {
+		switch suffix {
+		case "ogi":
+			return len(s) > 3 && s[len(s)-4] == 'l'
+		case "li":
+			return len(s) > 2 && isEnglishLiEnding(s[len(s)-3])
+		}
+		return true
+	})
+	s = replaceEnglishSuffix(s, englishStep3, r1, func(s []rune, suffix string) bool {
+		return suffix != "ative" || len(s)-len(suffix) >= r2
+	})
+	s = replaceEnglishSuffix(s, englishStep4, r2, func(s []rune, suffix string) bool {
+		return suffix != "ion" || len(s) > 3 && (s[len(s)-4] == 's' || s[len(s)-4] == 't')
+	})
+	s = stemEnglishStep5(s, r1, r2)
+
+	for i, r := range s {
+		if r == 'Y' {
+			s[i] = 'y'
+		}
+	}
+	return s
+}
+
+// stemEnglishStep1a removes plural endings
+func stemEnglishStep1a(s []rune) []rune {
+	n := len(s)
+	switch {
+	case hasSuffix(s, "sses"):
+		return s[:n-2]
+	case hasSuffix(s, "ied"), hasSuffix(s, "ies"):
+		if n > 4 {
+			return s[:n-2]
+		}
+		return s[:n-1]
+	case hasSuffix(s, "us"), hasSuffix(s, "ss"):
+		return s
+	case hasSuffix(s, "s") && n > 2 && containsEnglishVowel(s[:n-2]):
+		return s[:n-1]
+	}
+	return s
+}
+
+// stemEnglishStep1b removes the endings of past tense and progressive forms
+func stemEnglishStep1b(s []rune, r1 int) []rune {
+	switch suffix := longestSuffix(s, "eedly", "ingly", "edly", "eed", "ing", "ed"); suffix {
+	case "":
+	case "eedly", "eed":
+		if len(s)-len(suffix) >= r1 {
+			s = append(s[:len(s)-len(suffix)], 'e', 'e')
+		}
+	default:
+		stem := s[:len(s)-len(suffix)]
+		if !containsEnglishVowel(stem) {
+			return s
+		}
+		switch n := len(stem); {
+		case hasSuffix(stem, "at"), hasSuffix(stem, "bl"), hasSuffix(stem, "iz"):
+			return append(stem, 'e')
+		case n > 1 && stem[n-1] == stem[n-2] && isEnglishDouble(stem[n-1]):
+			return stem[:n-1]
+		case r1 >= n && endsWithShortSyllable(stem):
+			return append(stem, 'e')
+		}
+		return stem
+	}
+	return s
+}
+
+// stemEnglishStep5 removes a final e or the second l of a final double l
+func stemEnglishStep5(s []rune, r1, r2 int) []rune {
+	n := len(s)
+	if n == 0 {
+		return s
+	}
+	switch s[n-1] {
+	case 'e':
+		if n-1 >= r2 || n-1 >= r1 && !endsWithShortSyllable(s[:n-1]) {
+			return s[:n-1]
+		}
+	case 'l':
+		if n-1 >= r2 && n > 1 && s[n-2] == 'l' {
+			return s[:n-1]
+		}
+	}
+	return s
+}
+
+// replaceEnglishSuffix replaces the longest suffix of s found in suffixes if
+// it starts in the region at pos and the condition cond holds
+func replaceEnglishSuffix(s []rune, suffixes []englishSuffix, pos int,
+	cond func(s []rune, suffix string) bool,
+) []rune {
+	for _, suf := range suffixes {
+		if !hasSuffix(s, suf.suffix) {
+			continue
+		}
+		n := len(s) - len([]rune(suf.suffix))
+		if n < pos || !cond(s, suf.suffix) {
+			return s
+		}
+		return append(s[:n], []rune(suf.repl)...)
+	}
+	return s
+}
+
+// englishRegion returns the position after the first non-vowel following a
+// vowel in s[start:], or len(s) if there is none
+func englishRegion(s []rune, start int) int {
+	for i := start + 1; i < len(s); i++ {
+		if !isEnglishVowel(s[i]) && isEnglishVowel(s[i-1]) {
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// endsWithShortSyllable returns true if s ends with a vowel which follows a
+// non-vowel and is followed by a non-vowel other than w, x and Y, or if s
+// consists of a vowel followed by a non-vowel
+func endsWithShortSyllable(s []rune) bool {
+	switch n := len(s); {
+	case n == 2:
+		return isEnglishVowel(s[0]) && !isEnglishVowel(s[1])
+	case n > 2:
+		last := s[n-1]
+		return !isEnglishVowel(s[n-3]) && isEnglishVowel(s[n-2]) && !isEnglishVowel(last) &&
+			last != 'w' && last != 'x' && last != 'Y'
+	}
+	return false
+}
+
+func isEnglishVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+	return false
+}
+
+func containsEnglishVowel(s []rune) bool {
+	for _, r := range s {
+		if isEnglishVowel(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEnglishDouble(r rune) bool {
+	switch r {
+	case 'b', 'd', 'f', 'g', 'm', 'n', 'p', 'r', 't':
+		return true
+	}
+	return false
+}
+
+func isEnglishLiEnding(r rune) bool {
+	switch r {
+	case 'c', 'd', 'e', 'g', 'h', 'k', 'm', 'n', 'r', 't':
+		return true
+	}
+	return false
+}
+
+// trimLongestSuffix removes the longest of suffixes from s
+func trimLongestSuffix(s []rune, suffixes ...string) []rune {
+	suffix := longestSuffix(s, suffixes...)
+	return s[:len(s)-len([]rune(suffix))]
+}
+
+// longestSuffix returns the longest of suffixes s ends with
+func longestSuffix(s []rune, suffixes ...string) string {
+	longest := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && hasSuffix(s, suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
+
+func hasPrefix(s []rune, prefix string) bool {
+	pre := []rune(prefix)
+	if len(s) < len(pre) {
+		return false
+	}
+	for i, r := range pre {
+		if s[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+func hasSuffix(s []rune, suffix string) bool {
+	suf := []rune(suffix)
+	if len(s) < len(suf) {
+		return false
+	}
+	for i, r := range suf {
+		if s[len(s)-len(suf)+i] != r {
+			return false
+		}
+	}
+	return true
+}
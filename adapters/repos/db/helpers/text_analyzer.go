@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"golang.org/x/text/unicode/norm"
+)
+
+// TextAnalyzer tokenizes the values of a text property according to its
+// tokenization and text analyzer config. The same analyzer must be used when
+// indexing a property and when querying it, since otherwise terms won't
+// match.
+//
+// Analyzing text happens in two steps: Split tokenizes the text and splits
+// CJK characters into bigrams, Normalize folds accents and stems a single
+// term. Both are separate, so that terms can be checked against stopwords
+// before they are normalized.
+type TextAnalyzer struct {
+	tokenization string
+	bigrams      bool
+	fold         bool
+	stem         func([]rune) []rune
+}
+
+// NewTextAnalyzer returns the analyzer for a text property. A nil config
+// analyzes text by its tokenization only. Values of properties with field
+// tokenization are never analyzed, they are matched as a whole.
+func NewTextAnalyzer(tokenization string, config *models.TextAnalyzerConfig) *TextAnalyzer {
+	a := &TextAnalyzer{tokenization: tokenization}
+	if config == nil || tokenization == models.PropertyTokenizationField {
+		return a
+	}
+	a.fold = config.ASCIIFold
+	switch config.Language {
+	case models.TextAnalyzerConfigLanguageZh, models.TextAnalyzerConfigLanguageJa,
+		models.TextAnalyzerConfigLanguageKo:
+		a.bigrams = true
+	default:
+		a.stem = stemmers[config.Language]
+	}
+	return a
+}
+
+// Tokenize splits in into normalized terms
+func (a *TextAnalyzer) Tokenize(in string) []string {
+	terms := a.Split(in)
+	for i := range terms {
+		terms[i] = a.Normalize(terms[i])
+	}
+	return terms
+}
+
+// TokenizeWithWildcards splits in into normalized terms keeping wildcard
+// symbols. Terms containing wildcards are only folded, since stemming or
+// splitting them would change the pattern.
+func (a *TextAnalyzer) TokenizeWithWildcards(in string) []string {
+	var terms []string
+	for _, term := range TokenizeWithWildcards(a.tokenization, in) {
+		if !strings.ContainsAny(term, "?*") {
+			for _, t := range a.splitBigrams(term) {
+				terms = append(terms, a.Normalize(t))
+			}
+			continue
+		}
+		if a.fold {
+			term = foldAccents(term)
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// Split tokenizes in. Sequences of CJK characters are split into overlapping
+// bigrams if the language of the text is Chinese, Japanese or Korean. Bigrams
+// stand in for word segmentation, which would need a dictionary per language.
+func (a *TextAnalyzer) Split(in string) []string {
+	terms := Tokenize(a.tokenization, in)
+	if !a.bigrams {
+		return terms
+	}
+	var out []string
+	for _, term := range terms {
+		out = append(out, a.splitBigrams(term)...)
+	}
+	return out
+}
+
+// SplitTokens tokenizes in like Split, but keeps the offsets of the terms in
+// in. The terms are the same as those returned by Split.
+func (a *TextAnalyzer) SplitTokens(in string) []Token {
+	tokens := TokenizeWithOffsets(a.tokenization, in)
+	if !a.bigrams {
+		return tokens
+	}
+	var out []Token
+	for _, token := range tokens {
+		out = append(out, a.splitBigramTokens(in, token)...)
+	}
+	return out
+}
+
+// Normalize folds accents of and stems a single term
+func (a *TextAnalyzer) Normalize(term string) string {
+	if a.fold {
+		term = foldAccents(term)
+	}
+	if a.stem != nil {
+		term = string(a.stem([]rune(term)))
+	}
+	return term
+}
+
+// splitBigrams splits the sequences of CJK characters in term into
+// overlapping bigrams. A single CJK character is kept as is, other characters
+// are kept together.
+func (a *TextAnalyzer) splitBigrams(term string) []string {
+	if !a.bigrams {
+		return []string{term}
+	}
+	tokens := a.splitBigramTokens(term, Token{Term: term, End: len(term)})
+	out := make([]string, len(tokens))
+	for i := range tokens {
+		out[i] = tokens[i].Term
+	}
+	return out
+}
+
+// splitBigramTokens splits token of text in like splitBigrams. The terms of
+// the sequences are taken from token, since they may be lowercased, their
+// offsets from the text.
+func (a *TextAnalyzer) splitBigramTokens(in string, token Token) []Token {
+	termRunes := []rune(token.Term)
+	offsets := make([]int, 0, len(termRunes)+1)
+	for i := range in[token.Start:token.End] {
+		offsets = append(offsets, token.Start+i)
+	}
+	offsets = append(offsets, token.End)
+	if len(offsets) != len(termRunes)+1 {
+		// lowercasing changed the number of characters, the offsets of the
+		// sequences are unknown
+		return []Token{token}
+	}
+
+	var out []Token
+	for start := 0; start < len(termRunes); {
+		end := start + 1
+		cjk := isCJK(termRunes[start])
+		for end < len(termRunes) && isCJK(termRunes[end]) == cjk {
+			end++
+		}
+		switch {
+		case !cjk, end-start == 1:
+			out = append(out, Token{
+				Term: string(termRunes[start:end]), Start: offsets[start], End: offsets[end],
+			})
+		default:
+			for i := start; i < end-1; i++ {
+				out = append(out, Token{
+					Term: string(termRunes[i : i+2]), Start: offsets[i], End: offsets[i+2],
+				})
+			}
+		}
+		start = end
+	}
+	return out
+}
+
+func isCJK(r rune) bool {
+	// the prolonged sound mark and the iteration mark belong to no script
+	return r == 'ー' || r == '々' ||
+		unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// foldings are letters which are not decomposed into a base letter and
+// diacritics by unicode normalization
+var foldings = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ø': "o", 'Ø': "O",
+	'ł': "l", 'Ł': "L", 'đ': "d", 'Đ': "D", 'ð': "d", 'Ð': "D", 'þ': "th", 'Þ': "TH",
+}
+
+// foldAccents removes accents and other diacritics from letters, e.g. é
+// becomes e. CJK characters are kept as they are, since their marks are
+// significant.
+func foldAccents(term string) string {
+	ascii := true
+	for _, r := range term {
+		if r >= unicode.MaxASCII {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return term
+	}
+
+	var b strings.Builder
+	for _, r := range term {
+		if f, ok := foldings[r]; ok {
+			b.WriteString(f)
+			continue
+		}
+		if r < unicode.MaxASCII || isCJK(r) {
+			b.WriteRune(r)
+			continue
+		}
+		for _, d := range norm.NFD.String(string(r)) {
+			if !unicode.Is(unicode.Mn, d) {
+				b.WriteRune(d)
+			}
+		}
+	}
+	return norm.NFC.String(b.String())
+}
@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTextAnalyzer(t *testing.T) {
+	type testCase struct {
+		name         string
+		tokenization string
+		config       *models.TextAnalyzerConfig
+		input        string
+		expected     []string
+	}
+
+	t.Run("tokenize", func(t *testing.T) {
+		testCases := []testCase{
+			{
+				name:         "without config",
+				tokenization: models.PropertyTokenizationWord,
+				input:        "Die Häuser am Fluss",
+				expected:     []string{"die", "häuser", "am", "fluss"},
+			},
+			{
+				name:         "english stemming",
+				tokenization: models.PropertyTokenizationLowercase,
+				config:       &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageEn},
+				input:        "Queries books glass generously",
+				expected:     []string{"queri", "book", "glass", "generous"},
+			},
+			{
+				name:         "accent folding",
+				tokenization: models.PropertyTokenizationWord,
+				config:       &models.TextAnalyzerConfig{ASCIIFold: true},
+				input:        "Café crème Straße Øre",
+				expected:     []string{"cafe", "creme", "strasse", "ore"},
+			},
+			{
+				name:         "accent folding keeps case",
+				tokenization: models.PropertyTokenizationWhitespace,
+				config:       &models.TextAnalyzerConfig{ASCIIFold: true},
+				input:        "Ésprit ÀLA",
+				expected:     []string{"Esprit", "ALA"},
+			},
+			{
+				name:         "stemming and folding",
+				tokenization: models.PropertyTokenizationWord,
+				config: &models.TextAnalyzerConfig{
+					Language:  models.TextAnalyzerConfigLanguageEn,
+					ASCIIFold: true,
+				},
+				input:    "cafés naïvely",
+				expected: []string{"cafe", "naiv"},
+			},
+			{
+				name:         "cjk bigrams",
+				tokenization: models.PropertyTokenizationWord,
+				config:       &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageJa},
+				input:        "東京タワー iPhone15を買う 木",
+				expected:     []string{"東京", "京タ", "タワ", "ワー", "iphone15", "を買", "買う", "木"},
+			},
+			{
+				name:         "cjk without language",
+				tokenization: models.PropertyTokenizationWord,
+				input:        "東京タワー",
+				expected:     []string{"東京タワー"},
+			},
+			{
+				name:         "folding keeps cjk marks",
+				tokenization: models.PropertyTokenizationWord,
+				config: &models.TextAnalyzerConfig{
+					Language:  models.TextAnalyzerConfigLanguageJa,
+					ASCIIFold: true,
+				},
+				input:    "がぎ",
+				expected: []string{"がぎ"},
+			},
+			{
+				name:         "field is not analyzed",
+				tokenization: models.PropertyTokenizationField,
+				config: &models.TextAnalyzerConfig{
+					Language:  models.TextAnalyzerConfigLanguageEn,
+					ASCIIFold: true,
+				},
+				input:    " Cafés ",
+				expected: []string{"Cafés"},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				terms := NewTextAnalyzer(tc.tokenization, tc.config).Tokenize(tc.input)
+				assert.Equal(t, tc.expected, terms)
+			})
+		}
+	})
+
+	t.Run("tokenize with wildcards", func(t *testing.T) {
+		testCases := []testCase{
+			{
+				name:         "stems terms without wildcards only",
+				tokenization: models.PropertyTokenizationWord,
+				config: &models.TextAnalyzerConfig{
+					Language:  models.TextAnalyzerConfigLanguageEn,
+					ASCIIFold: true,
+				},
+				input:    "Cafés Café*",
+				expected: []string{"cafe", "cafe*"},
+			},
+			{
+				name:         "keeps cjk terms with wildcards",
+				tokenization: models.PropertyTokenizationWord,
+				config:       &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageZh},
+				input:        "北京大学 北京*",
+				expected:     []string{"北京", "京大", "大学", "北京*"},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				terms := NewTextAnalyzer(tc.tokenization, tc.config).TokenizeWithWildcards(tc.input)
+				assert.Equal(t, tc.expected, terms)
+			})
+		}
+	})
+
+	t.Run("split tokens", func(t *testing.T) {
+		inputs := []string{
+			"Die Häuser, am Fluss!",
+			"  東京タワー iPhone15を買う 木 ",
+			"ΣΊΣΥΦΟΣ straße",
+		}
+		configs := []*models.TextAnalyzerConfig{
+			nil,
+			{Language: models.TextAnalyzerConfigLanguageJa},
+		}
+
+		for _, tokenization := range Tokenizations {
+			for _, config := range configs {
+				for _, input := range inputs {
+					analyzer := NewTextAnalyzer(tokenization, config)
+					tokens := analyzer.SplitTokens(input)
+					terms := make([]string, len(tokens))
+					for i, token := range tokens {
+						terms[i] = token.Term
+						assert.True(t, strings.EqualFold(token.Term, input[token.Start:token.End]),
+							"term %q at %d-%d", token.Term, token.Start, token.End)
+					}
+					assert.Equal(t, analyzer.Split(input), terms, "input %q", input)
+				}
+			}
+		}
+	})
+
+	t.Run("split tokens keeps offsets of bigrams", func(t *testing.T) {
+		analyzer := NewTextAnalyzer(models.PropertyTokenizationWord,
+			&models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageZh})
+		assert.Equal(t, []Token{
+			{Term: "北京", Start: 0, End: 6},
+			{Term: "京大", Start: 3, End: 9},
+			{Term: "大学", Start: 6, End: 12},
+			{Term: "pku", Start: 13, End: 16},
+		}, analyzer.SplitTokens("北京大学 PKU"))
+	})
+}
+
+func TestStemEnglish(t *testing.T) {
+	// taken from the vocabulary of the Snowball English stemmer
+	stems := map[string]string{
+		"consign": "consign", "consigned": "consign", "consigning": "consign",
+		"consignment": "consign", "consistency": "consist", "consistently": "consist",
+		"knack": "knack", "knackered": "knacker", "knaves": "knave", "kneeling": "kneel",
+		"knees": "knee", "knightly": "knight", "generously": "generous",
+		"generate": "generat", "national": "nation", "caresses": "caress",
+		"ponies": "poni", "ties": "tie", "cats": "cat", "gas": "gas", "this": "this",
+		"running": "run", "hoped": "hope", "hopping": "hop", "agreed": "agre",
+		"queries": "queri", "query": "queri", "cry": "cri", "by": "by", "say": "say",
+		"happily": "happili", "skies": "sky", "dying": "die", "news": "news",
+		"succeeding": "succeed", "inning": "inning", "innings": "inning",
+		"communication": "communic", "relational": "relat", "conditional": "condit",
+		"analogi": "analog", "hopefulness": "hope", "electrical": "electr",
+		"adjustment": "adjust", "adoption": "adopt", "controll": "control",
+		"rate": "rate", "cease": "ceas", "yelling": "yell", "boy's": "boy",
+	}
+	for word, stem := range stems {
+		assert.Equal(t, stem, string(stemEnglish([]rune(word))), word)
+	}
+}
+
+func TestStemmers(t *testing.T) {
+	// only english is stemmed, other languages are not supported
+	assert.Len(t, stemmers, 1)
+	assert.NotNil(t, stemmers[models.TextAnalyzerConfigLanguageEn])
+}
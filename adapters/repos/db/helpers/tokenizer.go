@@ -55,6 +55,67 @@ func TokenizeWithWildcards(tokenization string, in string) []string {
 	}
 }
 
+// Token is a term of a text along with the byte offsets of the text it was
+// taken from
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// TokenizeWithOffsets tokenizes in like Tokenize, but keeps the offsets of
+// the terms in in, e.g. to mark them in the original text
+func TokenizeWithOffsets(tokenization string, in string) []Token {
+	switch tokenization {
+	case models.PropertyTokenizationWord:
+		return lowercaseTokens(fieldsWithOffsets(in, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		}))
+	case models.PropertyTokenizationLowercase:
+		return lowercaseTokens(fieldsWithOffsets(in, unicode.IsSpace))
+	case models.PropertyTokenizationWhitespace:
+		return fieldsWithOffsets(in, unicode.IsSpace)
+	case models.PropertyTokenizationField:
+		start := len(in) - len(strings.TrimLeftFunc(in, unicode.IsSpace))
+		end := len(strings.TrimRightFunc(in, unicode.IsSpace))
+		if end < start {
+			end = start
+		}
+		return []Token{{Term: in[start:end], Start: start, End: end}}
+	default:
+		return []Token{}
+	}
+}
+
+// fieldsWithOffsets splits in like strings.FieldsFunc
+func fieldsWithOffsets(in string, isSeparator func(rune) bool) []Token {
+	var tokens []Token
+	start := -1
+	for i, r := range in {
+		if isSeparator(r) {
+			if start >= 0 {
+				tokens = append(tokens, Token{Term: in[start:i], Start: start, End: i})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, Token{Term: in[start:], Start: start, End: len(in)})
+	}
+	return tokens
+}
+
+func lowercaseTokens(tokens []Token) []Token {
+	for i := range tokens {
+		tokens[i].Term = strings.ToLower(tokens[i].Term)
+	}
+	return tokens
+}
+
 // tokenizeField trims white spaces
 // (former DataTypeString/Field)
 func tokenizeField(in string) []string {
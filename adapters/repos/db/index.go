@@ -119,11 +119,14 @@ type Index struct {
 	shards                shardMap
 	Config                IndexConfig
 	vectorIndexUserConfig schema.VectorIndexConfig
-	getSchema             schemaUC.SchemaGetter
-	logger                logrus.FieldLogger
-	remote                *sharding.RemoteIndex
-	stopwords             *stopwords.Detector
-	replicator            *replica.Replicator
+	// vectorIndexUserConfigs holds the index configs of named vectors, keyed
+	// by target vector. It is empty for classes without named vectors.
+	vectorIndexUserConfigs map[string]schema.VectorIndexConfig
+	getSchema              schemaUC.SchemaGetter
+	logger                 logrus.FieldLogger
+	remote                 *sharding.RemoteIndex
+	stopwords              *stopwords.Detector
+	replicator             *replica.Replicator
 
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
@@ -151,6 +154,26 @@ type Index struct {
 
 	backupMutex backupMutex
 	lastBackup  atomic.Pointer[BackupState]
+	// retainWAL makes the shards keep their commit logs for archiving
+	retainWAL atomic.Bool
+	// reshardLock makes sharding state updates apply one at a time
+	reshardLock sync.Mutex
+	// reshardMarkerLock guards the pending reshard marker and the number of
+	// reshards which have not been applied yet
+	reshardMarkerLock sync.Mutex
+	reshardsPending   int
+	// merging maps shards to the local shards being merged into them, which
+	// are read together with them until their objects are moved
+	merging     map[string][]string
+	mergingLock sync.RWMutex
+
+	// offloads holds the shards that are being frozen or unfrozen together
+	// with their transitional activity status
+	offloads sync.Map
+
+	tenantActivity *tenantActivity
+	// tenantsUsage holds the time the tenant shards were used last
+	tenantsUsage sync.Map
 }
 
 func (i *Index) ID() string {
@@ -165,7 +188,8 @@ type nodeResolver interface {
 // the shards that are local to a node
 func NewIndex(ctx context.Context, cfg IndexConfig,
 	shardState *sharding.State, invertedIndexConfig schema.InvertedIndexConfig,
-	vectorIndexUserConfig schema.VectorIndexConfig, sg schemaUC.SchemaGetter,
+	vectorIndexUserConfig schema.VectorIndexConfig,
+	vectorIndexUserConfigs map[string]schema.VectorIndexConfig, sg schemaUC.SchemaGetter,
 	cs inverted.ClassSearcher, logger logrus.FieldLogger,
 	nodeResolver nodeResolver, remoteClient sharding.RemoteIndexClient,
 	replicaClient replica.Client,
@@ -184,14 +208,15 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	}
 
 	index := &Index{
-		Config:                cfg,
-		getSchema:             sg,
-		logger:                logger,
-		classSearcher:         cs,
-		vectorIndexUserConfig: vectorIndexUserConfig,
-		invertedIndexConfig:   invertedIndexConfig,
-		stopwords:             sd,
-		replicator:            repl,
+		Config:                 cfg,
+		getSchema:              sg,
+		logger:                 logger,
+		classSearcher:          cs,
+		vectorIndexUserConfig:  vectorIndexUserConfig,
+		vectorIndexUserConfigs: vectorIndexUserConfigs,
+		invertedIndexConfig:    invertedIndexConfig,
+		stopwords:              sd,
+		replicator:             repl,
 		remote: sharding.NewRemoteIndex(cfg.ClassName.String(), sg,
 			nodeResolver, remoteClient),
 		metrics:             NewMetrics(logger, promMetrics, cfg.ClassName.String(), "n/a"),
@@ -205,6 +230,14 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		return nil, errors.Wrap(err, "migrating sharding state from previous version")
 	}
 
+	// shards must keep retaining their commit logs after a restart until
+	// archiving resumes
+	archive, err := index.walArchive()
+	if err != nil {
+		return nil, errors.Wrapf(err, "init index %s", index.ID())
+	}
+	index.retainWAL.Store(archive != nil)
+
 	for _, shardName := range shardState.AllPhysicalShards() {
 		if !shardState.IsLocalShard(shardName) {
 			// do not create non-local shards
@@ -224,6 +257,10 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		index.shards.Store(shardName, shard)
 	}
 
+	if err := index.resumeReshard(ctx, shardState, promMetrics, class, jobQueueCh); err != nil {
+		return nil, errors.Wrapf(err, "resume resharding of index %s", index.ID())
+	}
+
 	index.cycleCallbacks.compactionCycle.Start()
 	index.cycleCallbacks.flushCycle.Start()
 
@@ -232,12 +269,13 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 
 // Iterate over all objects in the index, applying the callback function to each one.  Adding or removing objects during iteration is not supported.
 func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard *Shard, object *storobj.Object) error) (err error) {
+	class := i.class()
 	return i.ForEachShard(func(_ string, shard *Shard) error {
 		wrapper := func(object *storobj.Object) error {
 			return cb(i, shard, object)
 		}
 		bucket := shard.store.Bucket(helpers.ObjectsBucketLSM)
-		return bucket.IterateObjects(ctx, wrapper)
+		return bucket.IterateObjects(ctx, class, wrapper)
 	})
 }
 
@@ -310,6 +348,17 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	})
 }
 
+func (i *Index) updateVectorIndexConfigs(ctx context.Context,
+	updated map[string]schema.VectorIndexConfig,
+) error {
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.updateVectorIndexConfigs(ctx, updated); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+		return nil
+	})
+}
+
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -328,6 +377,22 @@ func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	return nil
 }
 
+// convertToVectorIndexConfigs extracts the parsed index configs of the named
+// vectors of a class
+func convertToVectorIndexConfigs(configs map[string]models.VectorConfig) map[string]schema.VectorIndexConfig {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	out := make(map[string]schema.VectorIndexConfig, len(configs))
+	for targetVector, cfg := range configs {
+		if vectorIndexConfig, ok := cfg.VectorIndexConfig.(schema.VectorIndexConfig); ok {
+			out[targetVector] = vectorIndexConfig
+		}
+	}
+	return out
+}
+
 type IndexConfig struct {
 	RootPath                  string
 	ClassName                 schema.ClassName
@@ -341,6 +406,7 @@ type IndexConfig struct {
 	MemtablesMaxActiveSeconds int
 	ReplicationFactor         int64
 	AvoidMMap                 bool
+	AsyncIndexing             bool
 
 	TrackVectorDimensions bool
 }
@@ -349,16 +415,10 @@ func indexID(class schema.ClassName) string {
 	return strings.ToLower(string(class))
 }
 
-func (i *Index) determineObjectShard(id strfmt.UUID, tenant string) (string, error) {
+func (i *Index) determineObjectShard(ctx context.Context, id strfmt.UUID, tenant string) (string, error) {
 	className := i.Config.ClassName.String()
 	if tenant != "" {
-		if shard, status := i.getSchema.TenantShard(className, tenant); shard != "" {
-			if status == models.TenantActivityStatusHOT {
-				return shard, nil
-			}
-			return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: '%s'", errTenantNotActive, tenant))
-		}
-		return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotFound, tenant))
+		return i.tenantShard(ctx, tenant)
 	}
 
 	uuid, err := uuid.Parse(id.String())
@@ -386,7 +446,7 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 			object.Class(), i.Config.ClassName)
 	}
 
-	shardName, err := i.determineObjectShard(object.ID(), object.Object.Tenant)
+	shardName, err := i.determineObjectShard(ctx, object.ID(), object.Object.Tenant)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
@@ -555,7 +615,7 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 			out[pos] = err
 			continue
 		}
-		shardName, err := i.determineObjectShard(obj.ID(), obj.Object.Tenant)
+		shardName, err := i.determineObjectShard(ctx, obj.ID(), obj.Object.Tenant)
 		if err != nil {
 			out[pos] = err
 			continue
@@ -670,7 +730,7 @@ func (i *Index) addReferencesBatch(ctx context.Context, refs objects.BatchRefere
 			out[pos] = err
 			continue
 		}
-		shardName, err := i.determineObjectShard(ref.From.TargetID, ref.Tenant)
+		shardName, err := i.determineObjectShard(ctx, ref.From.TargetID, ref.Tenant)
 		if err != nil {
 			out[pos] = err
 			continue
@@ -732,7 +792,7 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, err
 	}
 
-	shardName, err := i.determineObjectShard(id, tenant)
+	shardName, err := i.determineObjectShard(ctx, id, tenant)
 	if err != nil {
 		switch err.(type) {
 		case objects.ErrMultiTenancy:
@@ -809,7 +869,7 @@ func (i *Index) multiObjectByID(ctx context.Context,
 
 	byShard := map[string]idsAndPos{}
 	for pos, id := range query {
-		shardName, err := i.determineObjectShard(strfmt.UUID(id.ID), tenant)
+		shardName, err := i.determineObjectShard(ctx, strfmt.UUID(id.ID), tenant)
 		if err != nil {
 			return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
 		}
@@ -875,7 +935,7 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 		return false, err
 	}
 
-	shardName, err := i.determineObjectShard(id, tenant)
+	shardName, err := i.determineObjectShard(ctx, id, tenant)
 	if err != nil {
 		switch err.(type) {
 		case objects.ErrMultiTenancy:
@@ -919,6 +979,45 @@ func (i *Index) IncomingExists(ctx context.Context, shardName string,
 	return shard.exists(ctx, id)
 }
 
+// class returns the class of the index, nil if it is not part of the schema
+func (i *Index) class() *models.Class {
+	s := i.getSchema.GetSchemaSkipAuth()
+	return s.GetClass(i.Config.ClassName)
+}
+
+// highlight marks the terms of a keyword query in the text properties of the
+// objects found. It runs once for the objects of all shards, after their
+// number has been limited.
+func (i *Index) highlight(objects []*storobj.Object,
+	keywordRanking searchparams.KeywordRanking,
+) error {
+	class, err := schema.GetClassByName(i.getSchema.GetSchemaSkipAuth().Objects,
+		i.Config.ClassName.String())
+	if err != nil {
+		return err
+	}
+	highlighter, err := inverted.NewHighlighter(class, keywordRanking)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		props, ok := obj.Properties().(map[string]interface{})
+		if !ok {
+			continue
+		}
+		highlights := highlighter.Highlight(props)
+		if len(highlights) == 0 {
+			continue
+		}
+		if obj.AdditionalProperties() == nil {
+			obj.Object.Additional = make(map[string]interface{})
+		}
+		obj.Object.Additional["highlight"] = highlights
+	}
+	return nil
+}
+
 func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	addlProps additional.Properties, replProps *additional.ReplicationProperties, tenant string, autoCut int,
@@ -927,7 +1026,7 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		return nil, nil, err
 	}
 
-	shardNames, err := i.targetShardNames(tenant)
+	shardNames, err := i.targetShardNames(ctx, tenant)
 	if err != nil || len(shardNames) == 0 {
 		return nil, nil, err
 	}
@@ -1030,6 +1129,12 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		outObjects = outObjects[:limit]
 	}
 
+	if keywordRanking != nil && keywordRanking.Highlight {
+		if err := i.highlight(outObjects, *keywordRanking); err != nil {
+			return nil, nil, errors.Wrap(err, "highlight")
+		}
+	}
+
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency(replica.One)
@@ -1063,7 +1168,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 			var err error
 
 			if shard := i.localShard(shardName); shard != nil {
-				objs, scores, err = shard.objectSearch(ctx, limit, filters, keywordRanking, sort, cursor, addlProps)
+				objs, scores, err = i.shardObjectSearch(ctx, shard, limit, filters, keywordRanking, sort, cursor, addlProps)
 				if err != nil {
 					return fmt.Errorf(
 						"local shard object search %s: %w", shard.ID(), err)
@@ -1073,7 +1178,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				}
 			} else {
 				objs, scores, err = i.remote.SearchShard(
-					ctx, shardName, nil, limit, filters, keywordRanking,
+					ctx, shardName, nil, "", limit, filters, keywordRanking,
 					sort, cursor, nil, addlProps, i.replicationEnabled())
 				if err != nil {
 					return fmt.Errorf(
@@ -1158,13 +1263,13 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 }
 
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
 	shardName string,
 ) ([]*storobj.Object, []float32, error) {
 	shard := i.localShard(shardName)
-	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+	res, resDists, err := i.shardObjectVectorSearch(
+		ctx, shard, searchVector, targetVector, dist, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1173,39 +1278,38 @@ func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVe
 }
 
 // to be called after validating multi-tenancy
-func (i *Index) targetShardNames(tenant string) ([]string, error) {
+func (i *Index) targetShardNames(ctx context.Context, tenant string) ([]string, error) {
 	className := i.Config.ClassName.String()
 	if !i.partitioningEnabled {
 		shardingState := i.getSchema.CopyShardingState(className)
 		return shardingState.AllPhysicalShards(), nil
 	}
-	if tenant != "" {
-		if shard, status := i.getSchema.TenantShard(className, tenant); shard != "" {
-			if status == models.TenantActivityStatusHOT {
-				return []string{shard}, nil
-			}
-			return nil, objects.NewErrMultiTenancy(fmt.Errorf("%w: '%s'", errTenantNotActive, tenant))
-		}
+	if tenant == "" {
+		return nil, objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotFound, tenant))
+	}
+	shard, err := i.tenantShard(ctx, tenant)
+	if err != nil {
+		return nil, err
 	}
-	return nil, objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotFound, tenant))
+	return []string{shard}, nil
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
 	groupBy *searchparams.GroupBy, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
 ) ([]*storobj.Object, []float32, error) {
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
-	shardNames, err := i.targetShardNames(tenant)
+	shardNames, err := i.targetShardNames(ctx, tenant)
 	if err != nil || len(shardNames) == 0 {
 		return nil, nil, err
 	}
 
 	if len(shardNames) == 1 {
 		if i.localShard(shardNames[0]) != nil {
-			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, dist, limit, filters,
+			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, targetVector, dist, limit, filters,
 				sort, groupBy, additional, shardNames[0])
 		}
 	}
@@ -1233,8 +1337,8 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 			var err error
 
 			if shard := i.localShard(shardName); shard != nil {
-				res, resDists, err = shard.objectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+				res, resDists, err = i.shardObjectVectorSearch(
+					ctx, shard, searchVector, targetVector, dist, limit, filters, sort, groupBy, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
@@ -1243,7 +1347,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 				}
 			} else {
 				res, resDists, err = i.remote.SearchShard(ctx,
-					shardName, searchVector, limit, filters,
+					shardName, searchVector, targetVector, limit, filters,
 					nil, sort, nil, groupBy, additional, i.replicationEnabled())
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
@@ -1297,8 +1401,8 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 }
 
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, distance float32, limit int, filters *filters.LocalFilter,
-	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
+	searchVector []float32, targetVector string, distance float32, limit int,
+	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -1308,7 +1412,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	if searchVector == nil {
-		res, scores, err := shard.objectSearch(ctx, limit, filters, keywordRanking, sort, cursor, additional)
+		res, scores, err := i.shardObjectSearch(ctx, shard, limit, filters, keywordRanking, sort, cursor, additional)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1316,8 +1420,8 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 		return res, scores, nil
 	}
 
-	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, distance, limit, filters, sort, groupBy, additional)
+	res, resDists, err := i.shardObjectVectorSearch(
+		ctx, shard, searchVector, targetVector, distance, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1332,7 +1436,7 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 		return err
 	}
 
-	shardName, err := i.determineObjectShard(id, tenant)
+	shardName, err := i.determineObjectShard(ctx, id, tenant)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
@@ -1382,7 +1486,11 @@ func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
 }
 
 func (i *Index) localShard(name string) *Shard {
-	return i.shards.Load(name)
+	shard := i.shards.Load(name)
+	if shard != nil {
+		i.touchShard(name)
+	}
+	return shard
 }
 
 func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
@@ -1392,7 +1500,7 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 		return err
 	}
 
-	shardName, err := i.determineObjectShard(merge.ID, tenant)
+	shardName, err := i.determineObjectShard(ctx, merge.ID, tenant)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
@@ -1450,7 +1558,7 @@ func (i *Index) aggregate(ctx context.Context,
 		return nil, err
 	}
 
-	shardNames, err := i.targetShardNames(params.Tenant)
+	shardNames, err := i.targetShardNames(ctx, params.Tenant)
 	if err != nil || len(shardNames) == 0 {
 		return nil, err
 	}
@@ -1506,7 +1614,16 @@ func (i *Index) drop() error {
 	defer i.backupMutex.RUnlock()
 
 	i.shards.Range(dropShard)
-	return eg.Wait()
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	if err := os.Remove(i.reshardMarkerPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove reshard marker")
+	}
+	if err := os.Remove(i.walArchivePath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove commit log archive state")
+	}
+	return nil
 }
 
 // dropShards deletes shards in a transactional manner.
@@ -1636,6 +1753,18 @@ func (i *Index) IncomingGetShardStatus(ctx context.Context, shardName string) (s
 	return shard.getStatus().String(), nil
 }
 
+// IncomingTenantsLastUsed returns when the loaded tenant shards among
+// shardNames were used last on this node
+func (i *Index) IncomingTenantsLastUsed(ctx context.Context, shardNames []string) map[string]time.Time {
+	lastUsed := make(map[string]time.Time, len(shardNames))
+	for _, name := range shardNames {
+		if used, ok := i.tenantsUsage.Load(name); ok {
+			lastUsed[name] = used.(time.Time)
+		}
+	}
+	return lastUsed
+}
+
 func (i *Index) updateShardStatus(ctx context.Context, shardName, targetStatus string) error {
 	if shard := i.localShard(shardName); shard != nil {
 		return shard.updateStatus(targetStatus)
@@ -1668,7 +1797,7 @@ func (i *Index) findDocIDs(ctx context.Context,
 		return nil, err
 	}
 
-	shardNames, err := i.targetShardNames(tenant)
+	shardNames, err := i.targetShardNames(ctx, tenant)
 	if err != nil {
 		return nil, err
 	}
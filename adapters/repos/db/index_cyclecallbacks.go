@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -40,8 +41,11 @@ type indexCycleCallbacks struct {
 
 func (index *Index) initCycleCallbacks() {
 	vectorTombstoneCleanupIntervalSeconds := hnsw.DefaultCleanupIntervalSeconds
-	if hnswUserConfig, ok := index.vectorIndexUserConfig.(hnsw.UserConfig); ok {
-		vectorTombstoneCleanupIntervalSeconds = hnswUserConfig.CleanupIntervalSeconds
+	switch userConfig := index.vectorIndexUserConfig.(type) {
+	case hnsw.UserConfig:
+		vectorTombstoneCleanupIntervalSeconds = userConfig.CleanupIntervalSeconds
+	case dynamic.UserConfig:
+		vectorTombstoneCleanupIntervalSeconds = userConfig.HnswUC.CleanupIntervalSeconds
 	}
 
 	id := func(elems ...string) string {
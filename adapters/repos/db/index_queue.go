@@ -0,0 +1,595 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultIndexQueueBatchSize = 1000
+	defaultIndexQueueInterval  = time.Second
+	maxIndexQueueRetryBackoff  = 5 * time.Minute
+)
+
+// IndexQueue makes indexing into the wrapped vector index asynchronous. Vectors
+// are appended to a persistent queue in the shard's LSM store and the write is
+// acknowledged right away. A background worker drains the queue into the
+// vector index. Searches brute-force the vectors that are still queued, so
+// the results are complete at any time.
+//
+// The queue is only emptied after a batch has been added to the vector index
+// and the index has been flushed. If the process stops in between, the batch
+// is indexed again on the next startup. Vectors which can't be added stay
+// queued and are retried with a backoff, they count towards the vector queue
+// length of the shard status until they are indexed.
+type IndexQueue struct {
+	VectorIndex
+
+	targetVector      string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	bucket            *lsmkv.Bucket
+	batchSize         int
+	interval          time.Duration
+
+	// indexLock makes sure a vector cannot be deleted while it is being moved
+	// from the queue to the vector index. Otherwise the delete could miss both
+	// the queue and the index.
+	indexLock sync.Mutex
+
+	// lock protects vectors, order and seq
+	lock    sync.RWMutex
+	vectors map[uint64]queuedVector
+	order   []uint64
+	seq     uint64
+
+	notify    chan struct{}
+	stop      chan struct{}
+	done      chan struct{}
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+type queuedVector struct {
+	vector []float32
+	// seq tells apart two vectors which were queued for the same doc id
+	seq uint64
+	// attempts and retryAt are set once adding the vector to the index failed
+	attempts int
+	retryAt  time.Time
+}
+
+func NewIndexQueue(ctx context.Context, index VectorIndex, store *lsmkv.Store,
+	targetVector string, distancerProvider distancer.Provider,
+	logger logrus.FieldLogger, avoidMMap bool,
+) (*IndexQueue, error) {
+	q := &IndexQueue{
+		VectorIndex:       index,
+		targetVector:      targetVector,
+		logger:            logger,
+		distancerProvider: distancerProvider,
+		batchSize:         defaultIndexQueueBatchSize,
+		interval:          defaultIndexQueueInterval,
+		vectors:           map[uint64]queuedVector{},
+		notify:            make(chan struct{}, 1),
+		stop:              make(chan struct{}),
+		done:              make(chan struct{}),
+	}
+
+	bucketName := q.bucketName()
+	if err := store.CreateOrLoadBucket(ctx, bucketName,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(avoidMMap),
+	); err != nil {
+		return nil, errors.Wrapf(err, "create or load bucket %q", bucketName)
+	}
+	q.bucket = store.Bucket(bucketName)
+
+	if err := q.restore(); err != nil {
+		return nil, errors.Wrap(err, "restore index queue")
+	}
+
+	return q, nil
+}
+
+func (q *IndexQueue) bucketName() string {
+	if q.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorIndexQueueBucketLSM, q.targetVector)
+	}
+	return helpers.VectorIndexQueueBucketLSM
+}
+
+// restore loads the vectors which were not indexed before the last shutdown.
+// The keys are big endian, so the cursor returns them in insertion order.
+func (q *IndexQueue) restore() error {
+	cursor := q.bucket.Cursor()
+	defer cursor.Close()
+
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		id := binary.BigEndian.Uint64(key)
+		q.seq++
+		q.vectors[id] = queuedVector{vector: indexQueueBytesToVector(value), seq: q.seq}
+		q.order = append(q.order, id)
+	}
+
+	return nil
+}
+
+// Size returns the number of vectors which are not indexed yet
+func (q *IndexQueue) Size() int64 {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	return int64(len(q.vectors))
+}
+
+func (q *IndexQueue) ValidateBeforeInsert(vector []float32) error {
+	if err := q.VectorIndex.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+
+	// the vector index only knows about the vectors that are indexed already
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	for _, queued := range q.vectors {
+		if len(queued.vector) != len(vector) {
+			return fmt.Errorf("new node has a vector with length %v. "+
+				"Existing nodes have vectors with length %v", len(vector), len(queued.vector))
+		}
+		break
+	}
+
+	return nil
+}
+
+func (q *IndexQueue) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	vector = q.normalized(vector)
+	if err := q.bucket.Put(indexQueueKey(id), indexQueueVectorToBytes(vector)); err != nil {
+		return errors.Wrapf(err, "queue vector %d", id)
+	}
+
+	q.lock.Lock()
+	if _, ok := q.vectors[id]; !ok {
+		q.order = append(q.order, id)
+	}
+	q.seq++
+	q.vectors[id] = queuedVector{vector: vector, seq: q.seq}
+	q.lock.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Delete removes vectors which are still queued from the queue, all others are
+// deleted from the vector index
+func (q *IndexQueue) Delete(ids ...uint64) error {
+	q.indexLock.Lock()
+	defer q.indexLock.Unlock()
+
+	indexed := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		q.lock.Lock()
+		_, queued := q.vectors[id]
+		delete(q.vectors, id)
+		q.lock.Unlock()
+
+		if !queued {
+			indexed = append(indexed, id)
+			continue
+		}
+
+		if err := q.bucket.Delete(indexQueueKey(id)); err != nil {
+			return errors.Wrapf(err, "delete queued vector %d", id)
+		}
+	}
+
+	if len(indexed) == 0 {
+		return nil
+	}
+
+	return q.VectorIndex.Delete(indexed...)
+}
+
+func (q *IndexQueue) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	// the queue has to be searched before the index. A vector is only removed
+	// from the queue once it is indexed, so this way no vector can be missed.
+	queuedIDs, queuedDists, err := q.bruteForce(vector, k, allow)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "search index queue")
+	}
+
+	ids, dists, err := q.VectorIndex.SearchByVector(vector, k, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists = mergeSearchResults(ids, dists, queuedIDs, queuedDists)
+	if len(ids) > k {
+		ids, dists = ids[:k], dists[:k]
+	}
+
+	return ids, dists, nil
+}
+
+func (q *IndexQueue) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	queuedIDs, queuedDists, err := q.bruteForceByDistance(vector, targetDistance, allow)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "search index queue")
+	}
+
+	ids, dists, err := q.VectorIndex.SearchByVectorDistance(vector, targetDistance,
+		maxLimit, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists = mergeSearchResults(ids, dists, queuedIDs, queuedDists)
+	if maxLimit >= 0 && int64(len(ids)) > maxLimit {
+		ids, dists = ids[:maxLimit], dists[:maxLimit]
+	}
+
+	return ids, dists, nil
+}
+
+func (q *IndexQueue) bruteForce(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	heap := priorityqueue.NewMax(k)
+	err := q.forEachQueued(vector, allow, func(id uint64, dist float32) {
+		if heap.Len() < k {
+			heap.Insert(id, dist)
+		} else if heap.Top().Dist > dist {
+			heap.Pop()
+			heap.Insert(id, dist)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+
+	return ids, dists, nil
+}
+
+func (q *IndexQueue) bruteForceByDistance(vector []float32, targetDistance float32,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		ids   []uint64
+		dists []float32
+	)
+	err := q.forEachQueued(vector, allow, func(id uint64, dist float32) {
+		if dist <= targetDistance {
+			ids = append(ids, id)
+			dists = append(dists, dist)
+		}
+	})
+
+	return ids, dists, err
+}
+
+func (q *IndexQueue) forEachQueued(vector []float32, allow helpers.AllowList,
+	f func(id uint64, dist float32),
+) error {
+	vector = q.normalized(vector)
+
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	for id, queued := range q.vectors {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+
+		dist, _, err := q.distancerProvider.SingleDist(vector, queued.vector)
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance for %d", id)
+		}
+		f(id, dist)
+	}
+
+	return nil
+}
+
+// PostStartup starts the worker once the vector index is ready
+func (q *IndexQueue) PostStartup() {
+	q.VectorIndex.PostStartup()
+
+	q.startOnce.Do(func() {
+		go q.run()
+	})
+}
+
+func (q *IndexQueue) Shutdown(ctx context.Context) error {
+	q.stopWorker()
+	return q.VectorIndex.Shutdown(ctx)
+}
+
+func (q *IndexQueue) Drop(ctx context.Context) error {
+	q.stopWorker()
+	return q.VectorIndex.Drop(ctx)
+}
+
+// stopWorker stops the worker and waits for the current batch to complete.
+// It must be called before the shard's store is shut down.
+func (q *IndexQueue) stopWorker() {
+	q.stopOnce.Do(func() {
+		close(q.stop)
+	})
+
+	// if the worker was never started, there is nothing to wait for
+	q.startOnce.Do(func() {
+		close(q.done)
+	})
+	<-q.done
+}
+
+func (q *IndexQueue) run() {
+	defer close(q.done)
+
+	ticker := time.NewTicker(q.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-q.stop:
+			return
+		case <-q.notify:
+		case <-ticker.C:
+		}
+
+		for {
+			select {
+			case <-q.stop:
+				return
+			default:
+			}
+
+			if q.indexBatch() == 0 {
+				break
+			}
+		}
+	}
+}
+
+// indexBatch moves the oldest queued vectors to the vector index and returns
+// how many were processed
+func (q *IndexQueue) indexBatch() int {
+	q.indexLock.Lock()
+	defer q.indexLock.Unlock()
+
+	ids, batch := q.nextBatch()
+	if len(ids) == 0 {
+		return 0
+	}
+
+	failed := make([]bool, len(ids))
+	eg := &errgroup.Group{}
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range ids {
+		i, id, queued := i, ids[i], batch[i]
+		eg.Go(func() error {
+			if err := q.VectorIndex.Add(id, queued.vector); err != nil {
+				q.logger.WithField("action", "index_queue_add").
+					WithField("target_vector", q.targetVector).
+					WithError(err).Errorf("add doc id %d to vector index, retrying later", id)
+				failed[i] = true
+			}
+			return nil
+		})
+	}
+	eg.Wait()
+
+	// the vectors may only be removed from the queue once they are persisted
+	// in the commit log of the vector index
+	if err := q.VectorIndex.Flush(); err != nil {
+		q.logger.WithField("action", "index_queue_flush").
+			WithField("target_vector", q.targetVector).
+			WithError(err).Error("flush vector index, retrying batch later")
+		for i := range failed {
+			failed[i] = true
+		}
+	}
+
+	now := time.Now()
+	q.lock.Lock()
+	indexed := make([]uint64, 0, len(ids))
+	for i, id := range ids {
+		current, ok := q.vectors[id]
+		if !ok {
+			continue
+		}
+		if current.seq != batch[i].seq {
+			// the vector was replaced while it was being indexed, the newer one
+			// still needs to be indexed
+			q.order = append(q.order, id)
+			continue
+		}
+		if failed[i] {
+			current.attempts++
+			current.retryAt = now.Add(indexQueueRetryBackoff(q.interval, current.attempts))
+			q.vectors[id] = current
+			q.order = append(q.order, id)
+			continue
+		}
+		delete(q.vectors, id)
+		indexed = append(indexed, id)
+	}
+	q.lock.Unlock()
+
+	for _, id := range indexed {
+		if err := q.bucket.Delete(indexQueueKey(id)); err != nil {
+			q.logger.WithField("action", "index_queue_delete").
+				WithField("target_vector", q.targetVector).
+				WithError(err).Errorf("remove doc id %d from index queue", id)
+		}
+	}
+
+	return len(ids)
+}
+
+func (q *IndexQueue) nextBatch() ([]uint64, []queuedVector) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	now := time.Now()
+	ids := make([]uint64, 0, q.batchSize)
+	batch := make([]queuedVector, 0, q.batchSize)
+	var waiting []uint64
+	pos := 0
+	for ; pos < len(q.order) && len(ids) < q.batchSize; pos++ {
+		id := q.order[pos]
+		queued, ok := q.vectors[id]
+		if !ok {
+			// deleted in the meantime
+			continue
+		}
+		if now.Before(queued.retryAt) {
+			waiting = append(waiting, id)
+			continue
+		}
+		ids = append(ids, id)
+		batch = append(batch, queued)
+	}
+	q.order = append(waiting, q.order[pos:]...)
+
+	return ids, batch
+}
+
+// indexQueueRetryBackoff doubles the wait for every failed attempt to add a
+// vector, up to maxIndexQueueRetryBackoff
+func indexQueueRetryBackoff(interval time.Duration, attempts int) time.Duration {
+	backoff := interval
+	for i := 1; i < attempts && backoff < maxIndexQueueRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxIndexQueueRetryBackoff {
+		return maxIndexQueueRetryBackoff
+	}
+	return backoff
+}
+
+func (q *IndexQueue) normalized(vector []float32) []float32 {
+	if q.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+
+	out := make([]float32, len(vector))
+	copy(out, vector)
+	return out
+}
+
+// mergeSearchResults combines the results of the vector index with those of the
+// queue. A vector can show up in both if it was indexed during the search.
+func mergeSearchResults(ids []uint64, dists []float32, queuedIDs []uint64,
+	queuedDists []float32,
+) ([]uint64, []float32) {
+	if len(queuedIDs) == 0 {
+		return ids, dists
+	}
+
+	seen := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+	for i, id := range queuedIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		ids = append(ids, id)
+		dists = append(dists, queuedDists[i])
+	}
+
+	sort.Sort(searchResultsByDistance{ids: ids, dists: dists})
+	return ids, dists
+}
+
+type searchResultsByDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (r searchResultsByDistance) Len() int {
+	return len(r.ids)
+}
+
+func (r searchResultsByDistance) Less(i, j int) bool {
+	return r.dists[i] < r.dists[j]
+}
+
+func (r searchResultsByDistance) Swap(i, j int) {
+	r.ids[i], r.ids[j] = r.ids[j], r.ids[i]
+	r.dists[i], r.dists[j] = r.dists[j], r.dists[i]
+}
+
+func indexQueueKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func indexQueueVectorToBytes(vector []float32) []byte {
+	out := make([]byte, len(vector)*4)
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+func indexQueueBytesToVector(in []byte) []float32 {
+	out := make([]float32, len(in)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+	}
+	return out
+}
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestIndexQueue(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+	distProv := distancer.NewL2SquaredProvider()
+
+	vectors := [][]float32{
+		{1, 1, 1},
+		{-1, 1, 1},
+		{-1, -1, 1},
+		{0.9, 1, 0.8},
+		{-1, -1, -1},
+	}
+
+	openQueue := func(t *testing.T) (*IndexQueue, *lsmkv.Store) {
+		store, err := lsmkv.New(dirName, dirName, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+
+		index, err := flat.New(flat.Config{
+			ID:               "flat",
+			Logger:           logger,
+			DistanceProvider: distProv,
+		}, flatent.NewDefaultUserConfig(), store)
+		require.Nil(t, err)
+
+		q, err := NewIndexQueue(ctx, index, store, "", distProv, logger, false)
+		require.Nil(t, err)
+		q.interval = 10 * time.Millisecond
+		return q, store
+	}
+
+	q, store := openQueue(t)
+
+	t.Run("add without indexing", func(t *testing.T) {
+		for i, vec := range vectors {
+			require.Nil(t, q.Add(uint64(i), vec))
+		}
+		assert.Equal(t, int64(len(vectors)), q.Size())
+	})
+
+	t.Run("search covers the queued vectors", func(t *testing.T) {
+		ids, dists, err := q.SearchByVector([]float32{1, 1, 1}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 3}, ids)
+		assert.Equal(t, float32(0), dists[0])
+
+		ids, _, err = q.SearchByVector([]float32{1, 1, 1}, 2, helpers.NewAllowList(1, 2, 4))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2}, ids)
+
+		ids, _, err = q.SearchByVectorDistance([]float32{1, 1, 1}, 0.1, -1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 3}, ids)
+	})
+
+	t.Run("delete a queued vector", func(t *testing.T) {
+		require.Nil(t, q.Delete(4))
+		assert.Equal(t, int64(len(vectors)-1), q.Size())
+
+		ids, _, err := q.SearchByVector([]float32{-1, -1, -1}, 1, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, ids, uint64(4))
+	})
+
+	t.Run("validate against the queued vectors", func(t *testing.T) {
+		assert.NotNil(t, q.ValidateBeforeInsert([]float32{1, 1}))
+		assert.Nil(t, q.ValidateBeforeInsert([]float32{1, 1, 0}))
+	})
+
+	t.Run("the queue is restored after a restart", func(t *testing.T) {
+		require.Nil(t, q.Shutdown(ctx))
+		require.Nil(t, store.Shutdown(ctx))
+
+		q, store = openQueue(t)
+		assert.Equal(t, int64(len(vectors)-1), q.Size())
+	})
+
+	t.Run("the worker empties the queue", func(t *testing.T) {
+		q.PostStartup()
+		assert.Eventually(t, func() bool {
+			return q.Size() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		ids, _, err := q.SearchByVector([]float32{1, 1, 1}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 3}, ids)
+	})
+
+	t.Run("delete an indexed vector", func(t *testing.T) {
+		require.Nil(t, q.Delete(0))
+
+		ids, _, err := q.SearchByVector([]float32{1, 1, 1}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3}, ids)
+	})
+
+	require.Nil(t, q.Shutdown(ctx))
+	require.Nil(t, store.Shutdown(ctx))
+}
+
+// failingVectorIndex rejects vectors until it is told to accept them
+type failingVectorIndex struct {
+	VectorIndex
+
+	sync.Mutex
+	reject  bool
+	flushes int
+}
+
+func (f *failingVectorIndex) Add(id uint64, vector []float32) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.reject {
+		return errors.New("rejected")
+	}
+	return f.VectorIndex.Add(id, vector)
+}
+
+func (f *failingVectorIndex) Flush() error {
+	f.Lock()
+	defer f.Unlock()
+	f.flushes++
+	return f.VectorIndex.Flush()
+}
+
+func TestIndexQueueRetry(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+	distProv := distancer.NewL2SquaredProvider()
+
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	flatIndex, err := flat.New(flat.Config{
+		ID:               "flat",
+		Logger:           logger,
+		DistanceProvider: distProv,
+	}, flatent.NewDefaultUserConfig(), store)
+	require.Nil(t, err)
+	index := &failingVectorIndex{VectorIndex: flatIndex, reject: true}
+
+	q, err := NewIndexQueue(ctx, index, store, "", distProv, logger, false)
+	require.Nil(t, err)
+	q.interval = 10 * time.Millisecond
+	defer q.Shutdown(ctx)
+
+	require.Nil(t, q.Add(1, []float32{1, 1, 1}))
+
+	t.Run("a rejected vector stays queued", func(t *testing.T) {
+		assert.Equal(t, 1, q.indexBatch())
+		assert.Equal(t, int64(1), q.Size())
+
+		q.lock.RLock()
+		queued := q.vectors[1]
+		q.lock.RUnlock()
+		assert.Equal(t, 1, queued.attempts)
+		assert.True(t, queued.retryAt.After(time.Now()))
+
+		// not due yet
+		assert.Equal(t, 0, q.indexBatch())
+
+		ids, _, err := q.SearchByVector([]float32{1, 1, 1}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+	})
+
+	t.Run("the vector is indexed once it is accepted", func(t *testing.T) {
+		index.Lock()
+		index.reject = false
+		index.flushes = 0
+		index.Unlock()
+
+		q.PostStartup()
+		assert.Eventually(t, func() bool {
+			return q.Size() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		index.Lock()
+		assert.Positive(t, index.flushes, "the index is flushed before the queue is emptied")
+		index.Unlock()
+
+		value, err := store.Bucket(q.bucketName()).Get(indexQueueKey(1))
+		require.Nil(t, err)
+		assert.Nil(t, value)
+	})
+}
+
+func TestIndexQueueRetryBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, indexQueueRetryBackoff(time.Second, 1))
+	assert.Equal(t, 4*time.Second, indexQueueRetryBackoff(time.Second, 3))
+	assert.Equal(t, maxIndexQueueRetryBackoff, indexQueueRetryBackoff(time.Second, 100))
+}
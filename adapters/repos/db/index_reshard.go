@@ -0,0 +1,497 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// reshard brings the local shards in line with a replaced sharding state:
+//
+//   - Objects stored in a shard they don't belong to anymore are moved to
+//     the local shard they belong to now. This is the case for shards split
+//     off another shard, which start as a copy of it, and for shards merged
+//     into another shard.
+//   - Shards which are not owned by this node anymore are dropped. Their
+//     objects have been moved already or are held by other replicas.
+//
+// Until an object is moved, it may be found in both shards. Shards merged
+// into another shard are read together with it until their objects are
+// moved, see trackMerges. The reshard must have been marked as pending with
+// markReshard before.
+func (i *Index) reshard(ctx context.Context, state *sharding.State) (err error) {
+	i.reshardLock.Lock()
+	defer i.reshardLock.Unlock()
+	defer func() { i.unmarkReshard(err == nil) }()
+
+	owners := map[string]*Shard{}
+	var misplaced, stale []string
+	i.ForEachShard(func(name string, shard *Shard) error {
+		_, exists := state.Physical[name]
+		switch {
+		case state.IsLocalShard(name):
+			owners[name] = shard
+			misplaced = append(misplaced, name)
+		case !exists: // merged into another shard
+			misplaced = append(misplaced, name)
+			stale = append(stale, name)
+		default: // replica moved to another node
+			stale = append(stale, name)
+		}
+		return nil
+	})
+
+	for _, name := range misplaced {
+		shard := i.localShard(name)
+		if shard == nil {
+			continue
+		}
+		_, keep := owners[name]
+		n, err := i.moveMisplacedObjects(ctx, shard, state, owners, !keep)
+		if err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		if !keep {
+			i.untrackMerge(name)
+		}
+		if n > 0 {
+			i.logger.WithField("action", "reshard").WithField("shard", name).
+				Infof("moved %d objects to their new shards", n)
+		}
+	}
+
+	if len(stale) == 0 {
+		return nil
+	}
+	commit, err := i.dropShards(stale)
+	if err != nil {
+		return fmt.Errorf("drop shards %v: %w", stale, err)
+	}
+	commit(true)
+	i.logger.WithField("action", "reshard").WithField("shards", stale).
+		Info("dropped shards which are no longer owned by this node")
+	return nil
+}
+
+// moveMisplacedObjects moves the objects of shard which belong to another
+// shard according to state into that shard, if it is one of the local
+// owners. An object already present in the target shard is only overwritten
+// by a more recent version and an object deleted in the target shard is not
+// brought back. Moved objects are deleted from shard unless it is dropped
+// afterwards anyway.
+func (i *Index) moveMisplacedObjects(ctx context.Context, shard *Shard,
+	state *sharding.State, owners map[string]*Shard, dropped bool,
+) (moved int, err error) {
+	// objects must not be changed while iterating the bucket
+	type misplacedObject struct {
+		id    []byte
+		owner string
+	}
+	var objects []misplacedObject
+	bucket := shard.store.Bucket(helpers.ObjectsBucketLSM)
+	cursor := bucket.Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		if owner := state.PhysicalShard(k); owner != shard.name {
+			objects = append(objects, misplacedObject{append([]byte{}, k...), owner})
+		}
+	}
+	cursor.Close()
+
+	class := i.class()
+	for _, o := range objects {
+		if err := ctx.Err(); err != nil {
+			return moved, err
+		}
+		target := owners[o.owner]
+		if target == nil {
+			// the owner is not local which should not happen, since split
+			// and merged shards are placed on the nodes of their targets
+			i.logger.WithField("action", "reshard").WithField("shard", shard.name).
+				Warnf("shard %q of object is not local, keeping object", o.owner)
+			continue
+		}
+		data, err := bucket.Get(o.id)
+		if err != nil {
+			return moved, fmt.Errorf("get object: %w", err)
+		}
+		if data == nil { // deleted in the meantime
+			continue
+		}
+		obj, err := storobj.FromBinaryWithClass(data, class)
+		if err != nil {
+			return moved, fmt.Errorf("unmarshal object: %w", err)
+		}
+		targetBucket := target.store.Bucket(helpers.ObjectsBucketLSM)
+		existing, err := targetBucket.Get(o.id)
+		if err != nil {
+			return moved, fmt.Errorf("get object from shard %q: %w", o.owner, err)
+		}
+		newer := existing == nil
+		if newer {
+			// the object was deleted in its new shard after the state was
+			// replaced, so the delete is more recent than this copy
+			deleted, err := targetBucket.WasDeleted(o.id)
+			if err != nil {
+				return moved, fmt.Errorf("check deletion in shard %q: %w", o.owner, err)
+			}
+			newer = !deleted
+		} else {
+			prev, err := storobj.FromBinary(existing)
+			if err != nil {
+				return moved, fmt.Errorf("unmarshal object of shard %q: %w", o.owner, err)
+			}
+			newer = obj.LastUpdateTimeUnix() > prev.LastUpdateTimeUnix()
+		}
+		if newer {
+			if err := target.putObject(ctx, obj); err != nil {
+				return moved, fmt.Errorf("put object %s into shard %q: %w", obj.ID(), o.owner, err)
+			}
+		}
+		if !dropped {
+			if err := shard.deleteObject(ctx, obj.ID()); err != nil {
+				return moved, fmt.Errorf("delete object %s: %w", obj.ID(), err)
+			}
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// trackMerges registers the local shards which are merged into another
+// local shard by state. Their objects belong to the other shard already, so
+// it reads them from there until they are moved. It must be called before
+// state is applied in the background.
+func (i *Index) trackMerges(state *sharding.State) {
+	merging := map[string][]string{}
+	i.ForEachShard(func(name string, shard *Shard) error {
+		if _, ok := state.Physical[name]; ok {
+			return nil
+		}
+		// all virtual shards of a merged shard are taken over by the same
+		// shard, so any object tells where it is merged into
+		cursor := shard.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+		k, _ := cursor.First()
+		var target string
+		if k != nil {
+			target = state.PhysicalShard(k)
+		}
+		cursor.Close()
+		if target != "" && state.IsLocalShard(target) {
+			merging[target] = append(merging[target], name)
+		}
+		return nil
+	})
+
+	i.mergingLock.Lock()
+	defer i.mergingLock.Unlock()
+	i.merging = merging
+}
+
+// untrackMerge stops reading the merged shard name once its objects have
+// been moved
+func (i *Index) untrackMerge(name string) {
+	i.mergingLock.Lock()
+	defer i.mergingLock.Unlock()
+	for target, sources := range i.merging {
+		for j, source := range sources {
+			if source == name {
+				sources = append(sources[:j:j], sources[j+1:]...)
+				break
+			}
+		}
+		if len(sources) == 0 {
+			delete(i.merging, target)
+		} else {
+			i.merging[target] = sources
+		}
+	}
+}
+
+// mergeSources returns the local shards being merged into shard name
+func (i *Index) mergeSources(name string) []*Shard {
+	i.mergingLock.RLock()
+	names := i.merging[name]
+	i.mergingLock.RUnlock()
+
+	var sources []*Shard
+	for _, source := range names {
+		if shard := i.localShard(source); shard != nil {
+			sources = append(sources, shard)
+		}
+	}
+	return sources
+}
+
+// mergedObjectByID looks up an object which is not part of shard in the
+// shards being merged into it. Objects deleted from shard are not looked up,
+// the delete is more recent than the copy being merged.
+func (s *Shard) mergedObjectByID(ctx context.Context, id strfmt.UUID,
+	props search.SelectProperties, addl additional.Properties,
+) (*storobj.Object, error) {
+	sources := s.index.mergeSources(s.name)
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	if deleted, err := s.wasDeleted(ctx, id); err != nil || deleted {
+		return nil, err
+	}
+	for _, source := range sources {
+		obj, err := source.objectByID(ctx, id, props, addl)
+		if err != nil || obj != nil {
+			return obj, err
+		}
+	}
+	return nil, nil
+}
+
+// supersedes returns true if the object id was written to or deleted from
+// shard, its version in a shard being merged into shard is outdated then
+func (s *Shard) supersedes(id strfmt.UUID) (bool, error) {
+	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	data, err := bucket.Get(idBytes)
+	if err != nil || data != nil {
+		return data != nil, err
+	}
+	return bucket.WasDeleted(idBytes)
+}
+
+// mergedResults appends the objects found in a shard being merged into shard
+// to the results of shard, unless they are superseded by shard
+func (s *Shard) mergedResults(objs []*storobj.Object, scores []float32,
+	sourceObjs []*storobj.Object, sourceScores []float32,
+) ([]*storobj.Object, []float32, error) {
+	for j, obj := range sourceObjs {
+		superseded, err := s.supersedes(obj.ID())
+		if err != nil {
+			return nil, nil, err
+		}
+		if superseded {
+			continue
+		}
+		objs = append(objs, obj)
+		if len(sourceScores) == len(sourceObjs) {
+			scores = append(scores, sourceScores[j])
+		}
+	}
+	return objs, scores, nil
+}
+
+// shardObjectSearch searches shard and the shards being merged into it
+func (i *Index) shardObjectSearch(ctx context.Context, shard *Shard, limit int,
+	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
+	sort []filters.Sort, cursor *filters.Cursor, addl additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	objs, scores, err := shard.objectSearch(ctx, limit, filters, keywordRanking, sort, cursor, addl)
+	sources := i.mergeSources(shard.name)
+	if err != nil || len(sources) == 0 {
+		return objs, scores, err
+	}
+
+	for _, source := range sources {
+		sourceObjs, sourceScores, err := source.objectSearch(ctx, limit, filters,
+			keywordRanking, sort, cursor, addl)
+		if err != nil {
+			return nil, nil, fmt.Errorf("merged shard %s: %w", source.name, err)
+		}
+		objs, scores, err = shard.mergedResults(objs, scores, sourceObjs, sourceScores)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	switch {
+	case keywordRanking != nil:
+		objs, scores = i.sortKeywordRanking(objs, scores)
+	case len(sort) > 0:
+		return i.sort(objs, scores, sort, limit)
+	default:
+		objs, scores = i.sortByID(objs, scores)
+	}
+	if limit > 0 && len(objs) > limit && !addl.ReferenceQuery {
+		if len(objs) == len(scores) {
+			scores = scores[:limit]
+		}
+		objs = objs[:limit]
+	}
+	return objs, scores, nil
+}
+
+// shardObjectVectorSearch searches shard and the shards being merged into it
+func (i *Index) shardObjectVectorSearch(ctx context.Context, shard *Shard,
+	searchVector []float32, targetVector string, dist float32, limit int,
+	filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy,
+	addl additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	objs, dists, err := shard.objectVectorSearch(ctx, searchVector, targetVector,
+		dist, limit, filters, sort, groupBy, addl)
+	sources := i.mergeSources(shard.name)
+	if err != nil || len(sources) == 0 {
+		return objs, dists, err
+	}
+
+	for _, source := range sources {
+		sourceObjs, sourceDists, err := source.objectVectorSearch(ctx, searchVector,
+			targetVector, dist, limit, filters, sort, groupBy, addl)
+		if err != nil {
+			return nil, nil, fmt.Errorf("merged shard %s: %w", source.name, err)
+		}
+		objs, dists, err = shard.mergedResults(objs, dists, sourceObjs, sourceDists)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	switch {
+	case groupBy != nil:
+		return i.mergeGroups(objs, dists, groupBy, limit, len(sources)+1)
+	case len(sort) > 0:
+		return i.sort(objs, dists, sort, limit)
+	}
+	objs, dists = newDistancesSorter().sort(objs, dists)
+	if limit > 0 && len(objs) > limit {
+		objs, dists = objs[:limit], dists[:limit]
+	}
+	return objs, dists, nil
+}
+
+// reshardMarkerPath is the file listing the shards of a pending reshard.
+// Shards merged into another shard or moved to another node are not part of
+// the sharding state anymore, so they would not be loaded after a restart
+// without it.
+func (i *Index) reshardMarkerPath() string {
+	return filepath.Join(i.Config.RootPath, i.ID()+".reshard")
+}
+
+// markReshard persists that the local shards have to be resharded. The
+// marker is removed once all pending reshards have been applied.
+func (i *Index) markReshard() error {
+	i.reshardMarkerLock.Lock()
+	defer i.reshardMarkerLock.Unlock()
+
+	pending, err := i.readReshardMarker()
+	if err != nil {
+		return err
+	}
+	names := map[string]struct{}{}
+	for _, name := range pending {
+		names[name] = struct{}{}
+	}
+	i.ForEachShard(func(name string, _ *Shard) error {
+		names[name] = struct{}{}
+		return nil
+	})
+	shards := make([]string, 0, len(names))
+	for name := range names {
+		shards = append(shards, name)
+	}
+	sort.Strings(shards)
+
+	path := i.reshardMarkerPath()
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strings.Join(shards, "\n")), 0o666); err != nil {
+		return fmt.Errorf("write reshard marker: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write reshard marker: %w", err)
+	}
+	i.reshardsPending++
+	return nil
+}
+
+// unmarkReshard removes the marker once the last pending reshard was
+// applied. A failed reshard keeps the marker, so it is retried on restart.
+func (i *Index) unmarkReshard(success bool) {
+	i.reshardMarkerLock.Lock()
+	defer i.reshardMarkerLock.Unlock()
+
+	i.reshardsPending--
+	if !success || i.reshardsPending > 0 {
+		return
+	}
+	if err := os.Remove(i.reshardMarkerPath()); err != nil && !os.IsNotExist(err) {
+		i.logger.WithField("action", "reshard").WithError(err).
+			Error("remove reshard marker")
+	}
+}
+
+func (i *Index) readReshardMarker() ([]string, error) {
+	data, err := os.ReadFile(i.reshardMarkerPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read reshard marker: %w", err)
+	}
+	if len(data) == 0 {
+		return []string{}, nil
+	}
+	return strings.Split(string(data), "\n"), nil
+}
+
+// resumeReshard loads the shards of a reshard which was interrupted by a
+// restart and applies state to them in the background
+func (i *Index) resumeReshard(ctx context.Context, state *sharding.State,
+	promMetrics *monitoring.PrometheusMetrics, class *models.Class, jobQueueCh chan job,
+) error {
+	i.reshardMarkerLock.Lock()
+	defer i.reshardMarkerLock.Unlock()
+
+	pending, err := i.readReshardMarker()
+	if err != nil || pending == nil {
+		return err
+	}
+	for _, name := range pending {
+		if i.shards.Load(name) != nil {
+			continue
+		}
+		lsmPath := filepath.Join(i.Config.RootPath, fmt.Sprintf("%s_%s_lsm", i.ID(), name))
+		if _, err := os.Stat(lsmPath); os.IsNotExist(err) {
+			continue // dropped already
+		}
+		shard, err := NewShard(ctx, promMetrics, name, i, class, jobQueueCh)
+		if err != nil {
+			return fmt.Errorf("init shard %s: %w", name, err)
+		}
+		i.shards.Store(name, shard)
+	}
+
+	i.reshardsPending++
+	i.trackMerges(state)
+	go func() {
+		if err := i.reshard(context.Background(), state); err != nil {
+			i.logger.WithField("action", "reshard").WithField("class", i.Config.ClassName).
+				Errorf("resume applying sharding state: %v", err)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestIndexReshard(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	p1 := shd.name
+	for _, name := range []string{"P2", "M"} {
+		require.Nil(t, idx.addNewShard(ctx, &models.Class{Class: className}, name))
+	}
+	p2, merged := idx.localShard("P2"), idx.localShard("M")
+
+	// P2 is a copy of P1 after a split, M is merged into P1 and P2
+	var objects []*storobj.Object
+	for i := 0; i < 20; i++ {
+		obj := testObject(className)
+		obj.Object.LastUpdateTimeUnix = 1
+		objects = append(objects, obj)
+		require.Nil(t, shd.putObject(ctx, obj))
+		require.Nil(t, p2.putObject(ctx, obj))
+	}
+	mergedObj := testObject(className)
+	require.Nil(t, merged.putObject(ctx, mergedObj))
+	updated := testObject(className)
+	updated.Object.ID = objects[0].ID()
+	updated.Object.LastUpdateTimeUnix = 2
+	updated.Object.Properties = map[string]interface{}{}
+	require.Nil(t, merged.putObject(ctx, updated))
+
+	state := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			p1:   {Name: p1, OwnsVirtual: []string{"v1"}, BelongsToNodes: []string{"node1"}},
+			"P2": {Name: "P2", OwnsVirtual: []string{"v2"}, BelongsToNodes: []string{"node1"}},
+		},
+		Virtual: []sharding.Virtual{
+			{Name: "v1", Upper: math.MaxUint64 / 2, AssignedToPhysical: p1},
+			{Name: "v2", Upper: math.MaxUint64, AssignedToPhysical: "P2"},
+		},
+	}
+	state.SetLocalName("node1")
+	require.Nil(t, idx.markReshard())
+	require.Nil(t, idx.reshard(ctx, state))
+
+	assert.NoFileExists(t, idx.reshardMarkerPath())
+	assert.Nil(t, idx.localShard("M"), "merged shard is dropped")
+	for _, obj := range append(objects, mergedObj) {
+		id, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+		require.Nil(t, err)
+		owner := state.PhysicalShard(id)
+		for _, name := range []string{p1, "P2"} {
+			found, err := idx.localShard(name).objectByID(ctx, obj.ID(), nil, additional.Properties{})
+			require.Nil(t, err)
+			if name != owner {
+				assert.Nil(t, found, "object %s is removed from shard %s", obj.ID(), name)
+				continue
+			}
+			require.NotNil(t, found, "object %s is in shard %s", obj.ID(), name)
+			if obj.ID() == updated.ID() {
+				assert.Equal(t, int64(2), found.LastUpdateTimeUnix(), "newer version is kept")
+			}
+		}
+	}
+}
+
+func TestIndexReshardResume(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	class := &models.Class{Class: className}
+	require.Nil(t, idx.addNewShard(ctx, class, "M"))
+	merged := idx.localShard("M")
+
+	kept := testObject(className)
+	require.Nil(t, merged.putObject(ctx, kept))
+	// deleted in the new shard after the state was replaced
+	deleted := testObject(className)
+	require.Nil(t, merged.putObject(ctx, deleted))
+	require.Nil(t, shd.putObject(ctx, deleted))
+	require.Nil(t, shd.deleteObject(ctx, deleted.ID()))
+
+	state := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			shd.name: {Name: shd.name, OwnsVirtual: []string{"v1"}, BelongsToNodes: []string{"node1"}},
+		},
+		Virtual: []sharding.Virtual{
+			{Name: "v1", Upper: math.MaxUint64, AssignedToPhysical: shd.name},
+		},
+	}
+	state.SetLocalName("node1")
+	require.Nil(t, idx.markReshard())
+
+	// restart before the reshard was applied, the merged shard is not part
+	// of the state anymore and is only loaded because of the marker
+	require.Nil(t, merged.shutdown(ctx))
+	idx.shards.LoadAndDelete("M")
+	idx.reshardsPending = 0
+	require.Nil(t, idx.resumeReshard(ctx, state, nil, class, idx.centralJobQueue))
+
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(idx.reshardMarkerPath())
+		return os.IsNotExist(err)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Nil(t, idx.localShard("M"), "merged shard is dropped")
+
+	found, err := shd.objectByID(ctx, kept.ID(), nil, additional.Properties{})
+	require.Nil(t, err)
+	assert.NotNil(t, found, "object of merged shard is moved")
+	found, err = shd.objectByID(ctx, deleted.ID(), nil, additional.Properties{})
+	require.Nil(t, err)
+	assert.Nil(t, found, "object deleted in its new shard is not brought back")
+}
+
+func TestIndexReshardMergeReadable(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, func(i *Index) {
+		i.vectorIndexUserConfig = enthnsw.NewDefaultUserConfig()
+	})
+	require.Nil(t, idx.addNewShard(ctx, &models.Class{Class: className}, "M"))
+	merged := idx.localShard("M")
+
+	var objects []*storobj.Object
+	for i := 0; i < 10; i++ {
+		obj := testObject(className)
+		objects = append(objects, obj)
+		require.Nil(t, merged.putObject(ctx, obj))
+	}
+	state := &sharding.State{
+		Physical: map[string]sharding.Physical{
+			shd.name: {Name: shd.name, OwnsVirtual: []string{"v1"}, BelongsToNodes: []string{"node1"}},
+		},
+		Virtual: []sharding.Virtual{
+			{Name: "v1", Upper: math.MaxUint64, AssignedToPhysical: shd.name},
+		},
+	}
+	state.SetLocalName("node1")
+	require.Nil(t, idx.markReshard())
+	idx.trackMerges(state)
+
+	// written and deleted in the new shard after the state was replaced
+	updated := testObject(className)
+	updated.Object.ID = objects[0].ID()
+	updated.Object.LastUpdateTimeUnix = 2
+	require.Nil(t, shd.putObject(ctx, updated))
+	deleted := objects[1]
+	require.Nil(t, shd.putObject(ctx, deleted))
+	require.Nil(t, shd.deleteObject(ctx, deleted.ID()))
+
+	assertReadable := func(t *testing.T) {
+		for _, obj := range objects[1:] {
+			found, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{})
+			require.Nil(t, err)
+			exists, err := shd.exists(ctx, obj.ID())
+			require.Nil(t, err)
+			if obj == deleted {
+				assert.Nil(t, found, "deleted object is not read from the merged shard")
+				assert.False(t, exists)
+				continue
+			}
+			require.NotNil(t, found, "object %s is readable", obj.ID())
+			assert.True(t, exists)
+		}
+		found, err := shd.objectByID(ctx, updated.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, int64(2), found.LastUpdateTimeUnix(), "newer version is read")
+
+		objs, _, err := idx.shardObjectSearch(ctx, shd, 100, nil, nil, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Len(t, objs, len(objects)-1, "every object is listed once")
+		objs, _, err = idx.shardObjectVectorSearch(ctx, shd, []float32{1, 2, 3}, "", 0, 100,
+			nil, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Len(t, objs, len(objects)-1, "every object is found once")
+	}
+
+	t.Run("before the objects are moved", assertReadable)
+
+	require.Nil(t, idx.reshard(ctx, state))
+	assert.Nil(t, idx.localShard("M"), "merged shard is dropped")
+	assert.Empty(t, idx.mergeSources(shd.name))
+
+	t.Run("after the objects are moved", assertReadable)
+}
@@ -67,15 +67,18 @@ func (db *DB) init(ctx context.Context) error {
 				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
 				TrackVectorDimensions:     db.config.TrackVectorDimensions,
 				AvoidMMap:                 db.config.AvoidMMap,
+				AsyncIndexing:             db.config.AsyncIndexing,
 				ReplicationFactor:         class.ReplicationConfig.Factor,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				class.VectorIndexConfig.(schema.VectorIndexConfig),
+				convertToVectorIndexConfigs(class.VectorConfig),
 				db.schemaGetter, db, db.logger, db.nodeResolver, db.remoteIndex,
 				db.replicaClient, db.promMetrics, class, db.jobQueueCh)
 			if err != nil {
 				return errors.Wrap(err, "create index")
 			}
+			idx.tenantActivity = db.tenantActivity
 
 			db.indexLock.Lock()
 			db.indices[idx.ID()] = idx
@@ -25,8 +25,13 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	Positions     []uint32 // token positions of text terms, ascending
 }
 
+// TextArrayPositionGap separates the token positions of two elements of a
+// text array, so that phrases do not match across elements
+const TextArrayPositionGap = 100
+
 type Property struct {
 	Name               string
 	Items              []Countable
@@ -48,24 +53,37 @@ func (a *Analyzer) Text(tokenization, in string) []Countable {
 // TextArray tokenizes given input according to selected tokenization,
 // then aggregates duplicates
 func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
-	var terms []string
-	for _, in := range inArr {
-		terms = append(terms, helpers.Tokenize(tokenization, in)...)
-	}
+	return a.AnalyzedTextArray(helpers.NewTextAnalyzer(tokenization, nil), inArr)
+}
 
-	counts := map[string]uint64{}
-	for _, term := range terms {
-		counts[term]++
+// AnalyzedTextArray tokenizes and normalizes given input using the text
+// analyzer of a property, then aggregates duplicates
+func (a *Analyzer) AnalyzedTextArray(analyzer *helpers.TextAnalyzer, inArr []string) []Countable {
+	var terms []string
+	var positions []uint32
+	position := uint32(0)
+	for i, in := range inArr {
+		if i > 0 {
+			position += TextArrayPositionGap
+		}
+		for _, term := range analyzer.Tokenize(in) {
+			terms = append(terms, term)
+			positions = append(positions, position)
+			position++
+		}
 	}
 
-	countable := make([]Countable, len(counts))
-	i := 0
-	for term, count := range counts {
-		countable[i] = Countable{
-			Data:          []byte(term),
-			TermFrequency: float32(count),
+	indices := map[string]int{}
+	var countable []Countable
+	for i, term := range terms {
+		index, ok := indices[term]
+		if !ok {
+			index = len(countable)
+			indices[term] = index
+			countable = append(countable, Countable{Data: []byte(term)})
 		}
-		i++
+		countable[index].TermFrequency++
+		countable[index].Positions = append(countable[index].Positions, positions[i])
 	}
 	return countable
 }
@@ -25,12 +25,13 @@ import (
 func TestAnalyzer(t *testing.T) {
 	a := NewAnalyzer(nil)
 
-	countable := func(data []string, freq []int) []Countable {
+	countable := func(data []string, freq []int, positions [][]uint32) []Countable {
 		countable := make([]Countable, len(data))
 		for i := range data {
 			countable[i] = Countable{
 				Data:          []byte(data[i]),
 				TermFrequency: float32(freq[i]),
+				Positions:     positions[i],
 			}
 		}
 		return countable
@@ -52,6 +53,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"hello", "my", "name", "is", "john", "doe"},
 					[]int{1, 1, 1, 1, 1, 1},
+					[][]uint32{{0}, {1}, {2}, {3}, {4}, {5}},
 				),
 			},
 			{
@@ -61,6 +63,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"du", "hast", "mich", "gefragt"},
 					[]int{4, 3, 1, 1},
+					[][]uint32{{0, 1, 3, 5}, {2, 4, 6}, {7}, {8}},
 				),
 			},
 			{
@@ -70,6 +73,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"my", "email", "is", "john-thats-jay.ohh.age.n+alloneword@doe.com"},
 					[]int{1, 1, 1, 1},
+					[][]uint32{{0}, {1}, {2}, {3}},
 				),
 			},
 			{
@@ -79,6 +83,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"du.", "du", "hast.", "hast", "mich", "gefragt."},
 					[]int{1, 3, 2, 1, 1, 1},
+					[][]uint32{{0}, {1, 3, 5}, {2, 4}, {6}, {7}, {8}},
 				),
 			},
 			{
@@ -88,6 +93,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"My", "email", "is", "john-thats-jay.ohh.age.n+alloneword@doe.com"},
 					[]int{1, 1, 1, 1},
+					[][]uint32{{0}, {1}, {2}, {3}},
 				),
 			},
 			{
@@ -97,6 +103,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"Du.", "Du", "hast.", "hast", "mich", "gefragt."},
 					[]int{1, 3, 2, 1, 1, 1},
+					[][]uint32{{0}, {1, 3, 5}, {2, 4}, {6}, {7}, {8}},
 				),
 			},
 			{
@@ -106,6 +113,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"Du. Du hast. Du hast. Du hast mich gefragt."},
 					[]int{1},
+					[][]uint32{{0}},
 				),
 			},
 			{
@@ -140,6 +148,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"hello", "my", "name", "is", "john", "doe"},
 					[]int{1, 1, 1, 1, 1, 1},
+					[][]uint32{{0}, {101}, {102}, {103}, {104}, {105}},
 				),
 			},
 			{
@@ -149,6 +158,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"du", "hast", "mich", "gefragt"},
 					[]int{4, 3, 1, 1},
+					[][]uint32{{0, 1, 3, 105}, {2, 4, 106}, {107}, {108}},
 				),
 			},
 			{
@@ -158,6 +168,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"my", "email", "is", "john-thats-jay.ohh.age.n+alloneword@doe.com"},
 					[]int{1, 1, 1, 1},
+					[][]uint32{{0}, {1}, {102}, {103}},
 				),
 			},
 			{
@@ -167,6 +178,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"du.", "du", "hast.", "hast", "mich", "gefragt."},
 					[]int{1, 3, 2, 1, 1, 1},
+					[][]uint32{{0}, {1, 3, 105}, {2, 4}, {106}, {107}, {108}},
 				),
 			},
 			{
@@ -176,6 +188,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"My", "email", "is", "john-thats-jay.ohh.age.n+alloneword@doe.com"},
 					[]int{1, 1, 1, 1},
+					[][]uint32{{0}, {1}, {102}, {103}},
 				),
 			},
 			{
@@ -185,6 +198,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"Du.", "Du", "hast.", "hast", "mich", "gefragt."},
 					[]int{1, 3, 2, 1, 1, 1},
+					[][]uint32{{0}, {1, 3, 105}, {2, 4}, {106}, {107}, {108}},
 				),
 			},
 			{
@@ -194,6 +208,7 @@ func TestAnalyzer(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"Du. Du hast. Du hast.", "Du hast mich gefragt."},
 					[]int{1, 1},
+					[][]uint32{{0}, {101}},
 				),
 			},
 			{
@@ -397,12 +412,13 @@ func TestAnalyzer(t *testing.T) {
 }
 
 func TestAnalyzer_DefaultEngPreset(t *testing.T) {
-	countable := func(data []string, freq []int) []Countable {
+	countable := func(data []string, freq []int, positions [][]uint32) []Countable {
 		countable := make([]Countable, len(data))
 		for i := range data {
 			countable[i] = Countable{
 				Data:          []byte(data[i]),
 				TermFrequency: float32(freq[i]),
+				Positions:     positions[i],
 			}
 		}
 		return countable
@@ -427,6 +443,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"hello", "you", "beautiful", "world"},
 					[]int{1, 1, 1, 1},
+					[][]uint32{{0}, {1}, {2}, {3}},
 				),
 			},
 			{
@@ -436,6 +453,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"hello", "you-beautiful_world"},
 					[]int{1, 1},
+					[][]uint32{{0}, {1}},
 				),
 			},
 			{
@@ -445,6 +463,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"Hello", "you-beautiful_World"},
 					[]int{1, 1},
+					[][]uint32{{0}, {1}},
 				),
 			},
 			{
@@ -454,6 +473,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"Hello you-beautiful_World"},
 					[]int{1},
+					[][]uint32{{0}},
 				),
 			},
 			{
@@ -486,6 +506,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"hello", "you", "beautiful", "world"},
 					[]int{2, 2, 2, 2},
+					[][]uint32{{0, 104}, {1, 105}, {2, 106}, {3, 107}},
 				),
 			},
 			{
@@ -495,6 +516,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"hello", "you-beautiful_world"},
 					[]int{2, 2},
+					[][]uint32{{0, 102}, {1, 103}},
 				),
 			},
 			{
@@ -504,6 +526,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"Hello", "you-beautiful_World"},
 					[]int{2, 2},
+					[][]uint32{{0, 102}, {1, 103}},
 				),
 			},
 			{
@@ -513,6 +536,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 				expectedCountable: countable(
 					[]string{"Hello you-beautiful_World"},
 					[]int{2},
+					[][]uint32{{0, 101}},
 				),
 			},
 			{
@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// MaxFuzziness is the maximum edit distance of fuzzy query terms. Larger
+// distances match too many unrelated terms to be useful.
+const MaxFuzziness = 2
+
+// maxTermExpansions limits the number of terms of the index a single query
+// term is expanded to
+const maxTermExpansions = 50
+
+// termExpansion is a term of the index matching a query term. Its frequencies
+// are weighted by how close it is to the query term.
+type termExpansion struct {
+	term   string
+	weight float32
+}
+
+// prefixQueryTerm returns the term a query ends with as it is analyzed for
+// prop. It is the one which is still being typed in search-as-you-type. A
+// query ending with whitespace, a phrase or a stopword has no such term.
+func prefixQueryTerm(prop *models.Property, query string,
+	classStopwords *stopwords.Detector,
+) (string, error) {
+	last, _ := utf8.DecodeLastRuneInString(query)
+	if last == utf8.RuneError || unicode.IsSpace(last) {
+		return "", nil
+	}
+
+	analyzer := TextAnalyzer(prop)
+	terms := analyzer.Split(query)
+	if len(terms) == 0 {
+		return "", nil
+	}
+	term := terms[len(terms)-1]
+
+	detector, err := queryStopwords(prop, classStopwords)
+	if err != nil {
+		return "", err
+	}
+	if detector != nil && detector.IsStopword(term) {
+		return "", nil
+	}
+	return analyzer.Normalize(term), nil
+}
+
+// expandTerm looks up the terms of property propName matching query, either
+// by being within fuzziness edits of it or, if prefix is set, by starting
+// with it.
+func (b *BM25Searcher) expandTerm(propName, query string, fuzziness int, prefix bool,
+) ([]termExpansion, error) {
+	dict, err := b.termDictionaries.Get(propName)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := map[string]float32{query: 1}
+	if fuzziness > 0 {
+		queryLen := utf8.RuneCountInString(query)
+		for _, match := range dict.Fuzzy(query, fuzziness, maxTermExpansions) {
+			// same as in Lucene, the closer the terms relative to their
+			// length, the higher the weight
+			minLen := queryLen
+			if l := utf8.RuneCountInString(match.Term); l < minLen {
+				minLen = l
+			}
+			if minLen <= match.Distance {
+				continue
+			}
+			weight := 1 - float32(match.Distance)/float32(minLen)
+			if weight > weights[match.Term] {
+				weights[match.Term] = weight
+			}
+		}
+	}
+	if prefix {
+		for _, match := range dict.Prefix(query, maxTermExpansions) {
+			weights[match.Term] = 1
+		}
+	}
+
+	expansions := make([]termExpansion, 0, len(weights))
+	for term, weight := range weights {
+		expansions = append(expansions, termExpansion{term: term, weight: weight})
+	}
+	sort.Slice(expansions, func(i, j int) bool {
+		if expansions[i].weight != expansions[j].weight {
+			return expansions[i].weight > expansions[j].weight
+		}
+		return expansions[i].term < expansions[j].term
+	})
+	if len(expansions) > maxTermExpansions {
+		expansions = expansions[:maxTermExpansions]
+	}
+	return expansions, nil
+}
+
+// expandedTermLabel names a fuzzy or prefix query term in explanations
+func expandedTermLabel(query string, fuzziness int, prefix bool) string {
+	label := query
+	if prefix {
+		label += "*"
+	}
+	if fuzziness > 0 {
+		label += "~" + strconv.Itoa(fuzziness)
+	}
+	return label
+}
+
+// createExpandedTerm scores a query term matching terms of the index other
+// than itself like a single term. Per object, the frequencies of the terms it
+// is expanded to are summed up, weighted by how close they are to the query.
+func (b *BM25Searcher) createExpandedTerm(N float64, filterDocIds helpers.AllowList, query string,
+	fuzziness int, prefix bool, propertyNames []string, propertyBoosts map[string]float32,
+	duplicateTextBoost int, additionalExplanations bool,
+) (term, map[uint64]int, error) {
+	termResult := term{queryTerm: expandedTermLabel(query, fuzziness, prefix)}
+	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
+
+	var docMapPairs []docPointerWithScore
+	docMapPairsIndices := map[uint64]int{}
+	for _, propName := range propertyNames {
+		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+		if bucket == nil {
+			return termResult, nil, fmt.Errorf("could not find bucket for property %v", propName)
+		}
+		expansions, err := b.expandTerm(propName, query, fuzziness, prefix)
+		if err != nil {
+			return termResult, nil, err
+		}
+
+		// the property length of an object is the same for all terms, it is
+		// added once per property
+		frequencies := map[uint64]float32{}
+		propLengths := map[uint64]float32{}
+		for _, expansion := range expansions {
+			m, err := bucket.MapList([]byte(expansion.term))
+			if err != nil {
+				return termResult, nil, err
+			}
+
+			for _, val := range m {
+				if len(val.Value) < 8 {
+					b.logger.Warnf("Skipping pair in BM25: MapPair.Value should be 8 bytes long, but is %d.", len(val.Value))
+					continue
+				}
+				docID := binary.BigEndian.Uint64(val.Key)
+				if filterDocIds != nil && !filterDocIds.Contains(docID) {
+					filteredDocIDs.Set(docID)
+					continue
+				}
+				freqBits := binary.LittleEndian.Uint32(val.Value[0:4])
+				propLenBits := binary.LittleEndian.Uint32(val.Value[4:8])
+				frequencies[docID] += math.Float32frombits(freqBits) * expansion.weight
+				propLengths[docID] = math.Float32frombits(propLenBits)
+			}
+		}
+
+		for docID, frequency := range frequencies {
+			if ind, ok := docMapPairsIndices[docID]; ok {
+				docMapPairs[ind].frequency += frequency * propertyBoosts[propName]
+				docMapPairs[ind].propLength += propLengths[docID]
+			} else {
+				docMapPairs = append(docMapPairs, docPointerWithScore{
+					id:         docID,
+					frequency:  frequency * propertyBoosts[propName],
+					propLength: propLengths[docID],
+				})
+				docMapPairsIndices[docID] = len(docMapPairs) - 1
+			}
+		}
+	}
+	if len(docMapPairs) == 0 {
+		termResult.exhausted = true
+		return termResult, docMapPairsIndices, nil
+	}
+
+	sort.Slice(docMapPairs, func(i, j int) bool { return docMapPairs[i].id < docMapPairs[j].id })
+	for i := range docMapPairs {
+		docMapPairsIndices[docMapPairs[i].id] = i
+	}
+	termResult.data = docMapPairs
+
+	n := float64(len(docMapPairs))
+	if filterDocIds != nil {
+		n += float64(filteredDocIDs.GetCardinality())
+	}
+	termResult.idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * float64(duplicateTextBoost)
+
+	termResult.posPointer = 0
+	termResult.idPointer = termResult.data[0].id
+	return termResult, docMapPairsIndices, nil
+}
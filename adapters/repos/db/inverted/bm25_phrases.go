@@ -0,0 +1,307 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// queryPhrase is a quoted part of a BM25 query, e.g. "red running shoes"~2
+type queryPhrase struct {
+	text string
+	slop int
+}
+
+// bm25Phrase is an analyzed query phrase. Its terms need to appear in the
+// same order as in the query, with at most slop additional tokens in between
+type bm25Phrase struct {
+	terms []string
+	// offsets of the terms relative to the first one, removed stopwords leave
+	// a gap
+	offsets []int
+	slop    int
+}
+
+func (p bm25Phrase) String() string {
+	out := `"` + strings.Join(p.terms, " ") + `"`
+	if p.slop > 0 {
+		out += "~" + strconv.Itoa(p.slop)
+	}
+	return out
+}
+
+// key identifies phrases which match the same way
+func (p bm25Phrase) key() string {
+	return fmt.Sprintf("%v%v%d", p.terms, p.offsets, p.slop)
+}
+
+// splitQueryPhrases separates the quoted phrases of a query from its remaining
+// bag of words. A phrase can be followed by ~N to allow up to N additional
+// tokens between its terms. A quote without a closing counterpart is left as
+// is.
+func splitQueryPhrases(query string) (string, []queryPhrase) {
+	var rest strings.Builder
+	var phrases []queryPhrase
+
+	for {
+		start := strings.IndexByte(query, '"')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(query[start+1:], '"')
+		if end < 0 {
+			break
+		}
+		end += start + 1
+
+		phrase := queryPhrase{text: query[start+1 : end]}
+		next := end + 1
+		if next < len(query) && query[next] == '~' {
+			digits := next + 1
+			for digits < len(query) && query[digits] >= '0' && query[digits] <= '9' {
+				digits++
+			}
+			if slop, err := strconv.Atoi(query[next+1 : digits]); err == nil {
+				phrase.slop = slop
+				next = digits
+			}
+		}
+
+		phrases = append(phrases, phrase)
+		rest.WriteString(query[:start])
+		rest.WriteByte(' ')
+		query = query[next:]
+	}
+	rest.WriteString(query)
+
+	return rest.String(), phrases
+}
+
+// analyzePhrases analyzes query phrases the way the text of prop is analyzed.
+// Stopwords are removed, but keep their offset within the phrase. Duplicate
+// phrases are counted.
+func analyzePhrases(prop *models.Property, phrases []queryPhrase,
+	classStopwords *stopwords.Detector,
+) ([]bm25Phrase, []int, error) {
+	analyzer := TextAnalyzer(prop)
+	detector, err := queryStopwords(prop, classStopwords)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := map[string]int{}
+	var unique []bm25Phrase
+	for _, queryPhrase := range phrases {
+		phrase := bm25Phrase{slop: queryPhrase.slop}
+		for offset, token := range analyzer.Split(queryPhrase.text) {
+			if detector != nil && detector.IsStopword(token) {
+				continue
+			}
+			phrase.terms = append(phrase.terms, analyzer.Normalize(token))
+			phrase.offsets = append(phrase.offsets, offset)
+		}
+		if len(phrase.terms) == 0 {
+			continue
+		}
+		for i := len(phrase.offsets) - 1; i >= 0; i-- {
+			phrase.offsets[i] -= phrase.offsets[0]
+		}
+
+		key := phrase.key()
+		if counts[key] == 0 {
+			unique = append(unique, phrase)
+		}
+		counts[key]++
+	}
+	boosts := make([]int, len(unique))
+	for i, phrase := range unique {
+		boosts[i] = counts[phrase.key()]
+	}
+	return unique, boosts, nil
+}
+
+// createPhraseTerm scores a phrase like a single term. Its frequency in a
+// document is the number of times the phrase matches. A phrase of a single
+// term does not need positions, so it is searched as a regular term.
+func (b *BM25Searcher) createPhraseTerm(N float64, filterDocIds helpers.AllowList, phrase bm25Phrase,
+	propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool,
+) (term, map[uint64]int, error) {
+	if len(phrase.terms) == 1 {
+		return b.createTerm(N, filterDocIds, phrase.terms[0], propertyNames, propertyBoosts,
+			duplicateTextBoost, additionalExplanations)
+	}
+
+	termResult := term{queryTerm: phrase.String()}
+	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
+
+	var docMapPairs []docPointerWithScore
+	docMapPairsIndices := map[uint64]int{}
+	for _, propName := range propertyNames {
+		frequencies, propLengths, err := b.matchPhrase(propName, phrase)
+		if err != nil {
+			return termResult, nil, err
+		}
+
+		for docID, frequency := range frequencies {
+			if filterDocIds != nil && !filterDocIds.Contains(docID) {
+				filteredDocIDs.Set(docID)
+				continue
+			}
+
+			if ind, ok := docMapPairsIndices[docID]; ok {
+				docMapPairs[ind].frequency += float32(frequency) * propertyBoosts[propName]
+				docMapPairs[ind].propLength += propLengths[docID]
+			} else {
+				docMapPairs = append(docMapPairs, docPointerWithScore{
+					id:         docID,
+					frequency:  float32(frequency) * propertyBoosts[propName],
+					propLength: propLengths[docID],
+				})
+				docMapPairsIndices[docID] = len(docMapPairs) - 1
+			}
+		}
+	}
+	if len(docMapPairs) == 0 {
+		termResult.exhausted = true
+		return termResult, docMapPairsIndices, nil
+	}
+
+	sort.Slice(docMapPairs, func(i, j int) bool { return docMapPairs[i].id < docMapPairs[j].id })
+	for i := range docMapPairs {
+		docMapPairsIndices[docMapPairs[i].id] = i
+	}
+	termResult.data = docMapPairs
+
+	n := float64(len(docMapPairs))
+	if filterDocIds != nil {
+		n += float64(filteredDocIDs.GetCardinality())
+	}
+	termResult.idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * float64(duplicateTextBoost)
+
+	termResult.posPointer = 0
+	termResult.idPointer = termResult.data[0].id
+	return termResult, docMapPairsIndices, nil
+}
+
+// matchPhrase returns how often the phrase matches in each document of the
+// given property, as well as the length of the property in those documents
+func (b *BM25Searcher) matchPhrase(propName string, phrase bm25Phrase,
+) (map[uint64]int, map[uint64]float32, error) {
+	bucketPositions := b.store.Bucket(helpers.BucketSearchablePositionsFromPropNameLSM(propName))
+	if bucketPositions == nil {
+		return nil, nil, fmt.Errorf("phrase queries are not supported for property %v: "+
+			"token positions are not indexed yet, they can be indexed by restarting with "+
+			"INDEX_SEARCHABLE_POSITIONS_AT_STARTUP=true", propName)
+	}
+
+	termPositions := make(map[string]map[uint64][]uint32, len(phrase.terms))
+	for _, queryTerm := range phrase.terms {
+		if _, ok := termPositions[queryTerm]; ok {
+			continue
+		}
+		pairs, err := bucketPositions.MapList([]byte(queryTerm))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(pairs) == 0 {
+			return nil, nil, nil
+		}
+
+		docPositions := make(map[uint64][]uint32, len(pairs))
+		for _, pair := range pairs {
+			positions, err := ParseTermPositions(pair.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			docPositions[binary.BigEndian.Uint64(pair.Key)] = positions
+		}
+		termPositions[queryTerm] = docPositions
+	}
+
+	frequencies := map[uint64]int{}
+	positions := make([][]uint32, len(phrase.terms))
+	for docID := range termPositions[phrase.terms[0]] {
+		found := true
+		for i, queryTerm := range phrase.terms {
+			if positions[i], found = termPositions[queryTerm][docID]; !found {
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		if frequency := countPhraseMatches(positions, phrase.offsets, phrase.slop); frequency > 0 {
+			frequencies[docID] = frequency
+		}
+	}
+	if len(frequencies) == 0 {
+		return nil, nil, nil
+	}
+
+	// the property length is stored alongside the frequency in the searchable
+	// bucket, every matching document contains the first term of the phrase
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return nil, nil, fmt.Errorf("could not find bucket for property %v", propName)
+	}
+	pairs, err := bucket.MapList([]byte(phrase.terms[0]))
+	if err != nil {
+		return nil, nil, err
+	}
+	propLengths := make(map[uint64]float32, len(frequencies))
+	for _, pair := range pairs {
+		if len(pair.Value) < 8 {
+			b.logger.Warnf("Skipping pair in BM25: MapPair.Value should be 8 bytes long, but is %d.", len(pair.Value))
+			continue
+		}
+		docID := binary.BigEndian.Uint64(pair.Key)
+		if _, ok := frequencies[docID]; ok {
+			propLengths[docID] = math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[4:8]))
+		}
+	}
+	return frequencies, propLengths, nil
+}
+
+// countPhraseMatches counts the positions at which a phrase starts. Each term
+// is matched at its earliest position after the previous term, which keeps
+// the number of additional tokens in between as small as possible.
+func countPhraseMatches(positions [][]uint32, offsets []int, slop int) int {
+	count := 0
+	for _, start := range positions[0] {
+		previous := start
+		additional := 0
+		for i := 1; i < len(positions) && additional <= slop; i++ {
+			expected := previous + uint32(offsets[i]-offsets[i-1])
+			j := sort.Search(len(positions[i]), func(k int) bool { return positions[i][k] >= expected })
+			if j == len(positions[i]) {
+				// later starts cannot match either
+				return count
+			}
+			additional += int(positions[i][j] - expected)
+			previous = positions[i][j]
+		}
+		if additional <= slop {
+			count++
+		}
+	}
+	return count
+}
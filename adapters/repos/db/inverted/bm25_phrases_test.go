@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestBM25SplitQueryPhrases(t *testing.T) {
+	type testCase struct {
+		name            string
+		query           string
+		expectedRest    string
+		expectedPhrases []queryPhrase
+	}
+
+	testCases := []testCase{
+		{
+			name:         "without phrases",
+			query:        "red running shoes",
+			expectedRest: "red running shoes",
+		},
+		{
+			name:            "phrase only",
+			query:           `"red running shoes"`,
+			expectedRest:    " ",
+			expectedPhrases: []queryPhrase{{text: "red running shoes"}},
+		},
+		{
+			name:            "phrase with slop",
+			query:           `cheap "red shoes"~2 sale`,
+			expectedRest:    "cheap   sale",
+			expectedPhrases: []queryPhrase{{text: "red shoes", slop: 2}},
+		},
+		{
+			name:         "multiple phrases",
+			query:        `"red shoes"~ "blue socks"~10x`,
+			expectedRest: " ~  x",
+			expectedPhrases: []queryPhrase{
+				{text: "red shoes"},
+				{text: "blue socks", slop: 10},
+			},
+		},
+		{
+			name:            "unclosed quote",
+			query:           `"red shoes" "blue socks`,
+			expectedRest:    `  "blue socks`,
+			expectedPhrases: []queryPhrase{{text: "red shoes"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rest, phrases := splitQueryPhrases(tc.query)
+			assert.Equal(t, tc.expectedRest, rest)
+			assert.Equal(t, tc.expectedPhrases, phrases)
+		})
+	}
+}
+
+func TestBM25AnalyzePhrases(t *testing.T) {
+	classStopwords, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	require.Nil(t, err)
+
+	prop := &models.Property{
+		Tokenization: models.PropertyTokenizationWord,
+		TextAnalyzer: &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageEn},
+	}
+	phrases, boosts, err := analyzePhrases(prop, []queryPhrase{
+		{text: "The Houses of the Holy", slop: 1},
+		{text: "the and"},
+		{text: "houses of the holy", slop: 1},
+		{text: "house holy", slop: 1},
+		{text: "holy"},
+	}, classStopwords)
+	require.Nil(t, err)
+
+	assert.Equal(t, []bm25Phrase{
+		{terms: []string{"hous", "holi"}, offsets: []int{0, 3}, slop: 1},
+		{terms: []string{"hous", "holi"}, offsets: []int{0, 1}, slop: 1},
+		{terms: []string{"holi"}, offsets: []int{0}},
+	}, phrases)
+	assert.Equal(t, []int{2, 1, 1}, boosts)
+	assert.Equal(t, `"hous holi"~1`, phrases[0].String())
+}
+
+func TestBM25CountPhraseMatches(t *testing.T) {
+	type testCase struct {
+		name      string
+		positions [][]uint32
+		offsets   []int
+		slop      int
+		expected  int
+	}
+
+	testCases := []testCase{
+		{
+			name:      "adjacent terms",
+			positions: [][]uint32{{0, 5, 9}, {1, 7, 10}},
+			offsets:   []int{0, 1},
+			expected:  2,
+		},
+		{
+			name:      "terms in wrong order",
+			positions: [][]uint32{{3}, {2}},
+			offsets:   []int{0, 1},
+			slop:      5,
+			expected:  0,
+		},
+		{
+			name:      "gap within slop",
+			positions: [][]uint32{{0, 5}, {3, 7}},
+			offsets:   []int{0, 1},
+			slop:      1,
+			expected:  1,
+		},
+		{
+			name:      "slop is shared by all terms",
+			positions: [][]uint32{{0}, {2}, {4}},
+			offsets:   []int{0, 1, 2},
+			slop:      1,
+			expected:  0,
+		},
+		{
+			name:      "gap of removed stopword",
+			positions: [][]uint32{{0, 4}, {1, 6}},
+			offsets:   []int{0, 2},
+			expected:  1,
+		},
+		{
+			name:      "repeated term",
+			positions: [][]uint32{{0, 1, 2}, {0, 1, 2}},
+			offsets:   []int{0, 1},
+			expected:  2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, countPhraseMatches(tc.positions, tc.offsets, tc.slop))
+		})
+	}
+}
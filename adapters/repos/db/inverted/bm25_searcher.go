@@ -41,15 +41,16 @@ import (
 )
 
 type BM25Searcher struct {
-	config        schema.BM25Config
-	store         *lsmkv.Store
-	schema        schema.Schema
-	classSearcher ClassSearcher // to allow recursive searches on ref-props
-	propIndices   propertyspecific.Indices
-	deletedDocIDs DeletedDocIDChecker
-	propLengths   propLengthRetriever
-	logger        logrus.FieldLogger
-	shardVersion  uint16
+	config           schema.BM25Config
+	store            *lsmkv.Store
+	schema           schema.Schema
+	classSearcher    ClassSearcher // to allow recursive searches on ref-props
+	propIndices      propertyspecific.Indices
+	deletedDocIDs    DeletedDocIDChecker
+	propLengths      propLengthRetriever
+	termDictionaries *TermDictionaries
+	logger           logrus.FieldLogger
+	shardVersion     uint16
 }
 
 type propLengthRetriever interface {
@@ -59,19 +60,24 @@ type propLengthRetriever interface {
 func NewBM25Searcher(config schema.BM25Config, store *lsmkv.Store,
 	schema schema.Schema, propIndices propertyspecific.Indices,
 	classSearcher ClassSearcher, deletedDocIDs DeletedDocIDChecker,
-	propLengths propLengthRetriever, logger logrus.FieldLogger,
-	shardVersion uint16,
+	propLengths propLengthRetriever, termDictionaries *TermDictionaries,
+	logger logrus.FieldLogger, shardVersion uint16,
 ) *BM25Searcher {
+	if termDictionaries == nil {
+		// without dictionaries kept by the caller, they are built per search
+		termDictionaries = NewTermDictionaries(store)
+	}
 	return &BM25Searcher{
-		config:        config,
-		store:         store,
-		schema:        schema,
-		propIndices:   propIndices,
-		classSearcher: classSearcher,
-		deletedDocIDs: deletedDocIDs,
-		propLengths:   propLengths,
-		logger:        logger.WithField("action", "bm25_search"),
-		shardVersion:  shardVersion,
+		config:           config,
+		store:            store,
+		schema:           schema,
+		propIndices:      propIndices,
+		classSearcher:    classSearcher,
+		deletedDocIDs:    deletedDocIDs,
+		propLengths:      propLengths,
+		termDictionaries: termDictionaries,
+		logger:           logger.WithField("action", "bm25_search"),
+		shardVersion:     shardVersion,
 	}
 }
 
@@ -82,6 +88,10 @@ func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList
 			return nil, nil, inverted.NewMissingSearchableIndexError(property)
 		}
 	}
+	if keywordRanking.Fuzziness < 0 || keywordRanking.Fuzziness > MaxFuzziness {
+		return nil, nil, fmt.Errorf("fuzziness must be between 0 and %d, got %d",
+			MaxFuzziness, keywordRanking.Fuzziness)
+	}
 	class, err := schema.GetClassByName(b.schema.Objects, string(className))
 	if err != nil {
 		return nil, nil, err
@@ -109,33 +119,18 @@ func (b *BM25Searcher) wand(
 		}
 	}
 
-	// There are currently cases, for different tokenization:
-	// word, lowercase, whitespace and field.
-	// Query is tokenized and respective properties are then searched for the search terms,
-	// results at the end are combined using WAND
-	tokenizationsOrdered := []string{
-		models.PropertyTokenizationWord,
-		models.PropertyTokenizationLowercase,
-		models.PropertyTokenizationWhitespace,
-		models.PropertyTokenizationField,
-	}
+	// Quoted phrases of the query are matched using the token positions of
+	// the properties and scored like single terms
+	query, phrases := splitQueryPhrases(params.Query)
 
-	queryTermsByTokenization := map[string][]string{}
-	duplicateBoostsByTokenization := map[string][]int{}
-	propNamesByTokenization := map[string][]string{}
+	// Properties are grouped by the way their text is analyzed, i.e. their
+	// tokenization (word, lowercase, whitespace or field) and text analyzer.
+	// Query is analyzed once per group and respective properties are then
+	// searched for the search terms, results at the end are combined using WAND
+	var groups []*bm25PropGroup
+	groupsByAnalysis := map[string]*bm25PropGroup{}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
-	for _, tokenization := range tokenizationsOrdered {
-		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
-
-		// stopword filtering for word tokenization
-		if tokenization == models.PropertyTokenizationWord {
-			queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = b.removeStopwordsFromQueryTerms(queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization], stopWordDetector)
-		}
-
-		propNamesByTokenization[tokenization] = make([]string, 0)
-	}
-
 	averagePropLength := 0.
 	for _, propertyWithBoost := range params.Properties {
 		property := propertyWithBoost
@@ -160,11 +155,32 @@ func (b *BM25Searcher) wand(
 
 		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
 		case schema.DataTypeText, schema.DataTypeTextArray:
-			if _, exists := propNamesByTokenization[prop.Tokenization]; !exists {
+			if !isKnownTokenization(prop.Tokenization) {
 				return nil, nil, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
 			}
-			propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], property)
+			key := analysisKey(prop)
+			group, ok := groupsByAnalysis[key]
+			if !ok {
+				group = &bm25PropGroup{}
+				group.queryTerms, group.duplicateBoosts, err = analyzeQuery(prop, query, stopWordDetector)
+				if err != nil {
+					return nil, nil, err
+				}
+				group.phrases, group.phraseBoosts, err = analyzePhrases(prop, phrases, stopWordDetector)
+				if err != nil {
+					return nil, nil, err
+				}
+				if params.Prefix {
+					group.prefixTerm, err = prefixQueryTerm(prop, query, stopWordDetector)
+					if err != nil {
+						return nil, nil, err
+					}
+				}
+				groupsByAnalysis[key] = group
+				groups = append(groups, group)
+			}
+			group.propNames = append(group.propNames, property)
 		default:
 			return nil, nil, fmt.Errorf("cannot handle datatype '%v' of property '%s'", dt, prop.Name)
 		}
@@ -174,10 +190,8 @@ func (b *BM25Searcher) wand(
 
 	// preallocate the results
 	lengthAllResults := 0
-	for tokenization, propNames := range propNamesByTokenization {
-		if len(propNames) > 0 {
-			lengthAllResults += len(queryTermsByTokenization[tokenization])
-		}
+	for _, group := range groups {
+		lengthAllResults += len(group.queryTerms) + len(group.phrases)
 	}
 	results := make(terms, lengthAllResults)
 	indices := make([]map[uint64]int, lengthAllResults)
@@ -186,29 +200,57 @@ func (b *BM25Searcher) wand(
 	eg.SetLimit(_NUMCPU)
 	offset := 0
 
-	for _, tokenization := range tokenizationsOrdered {
-		propNames := propNamesByTokenization[tokenization]
-		if len(propNames) > 0 {
-			queryTerms := queryTermsByTokenization[tokenization]
-			duplicateBoosts := duplicateBoostsByTokenization[tokenization]
+	for _, group := range groups {
+		propNames := group.propNames
+		queryTerms := group.queryTerms
+		duplicateBoosts := group.duplicateBoosts
+
+		for i := range queryTerms {
+			j := i
+			k := i + offset
+
+			prefix := group.prefixTerm != "" && queryTerms[j] == group.prefixTerm
+			eg.Go(func() error {
+				var termResult term
+				var docIndices map[uint64]int
+				var err error
+				if params.Fuzziness > 0 || prefix {
+					termResult, docIndices, err = b.createExpandedTerm(N, filterDocIds, queryTerms[j],
+						params.Fuzziness, prefix, propNames, propertyBoosts, duplicateBoosts[j],
+						params.AdditionalExplanations)
+				} else {
+					termResult, docIndices, err = b.createTerm(N, filterDocIds, queryTerms[j], propNames,
+						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
+				}
+				if err != nil {
+					return err
+				}
+				results[k] = termResult
+				indices[k] = docIndices
+				return nil
+			})
+		}
+		offset += len(queryTerms)
 
-			for i := range queryTerms {
-				j := i
-				k := i + offset
+		phrases := group.phrases
+		phraseBoosts := group.phraseBoosts
 
-				eg.Go(func() error {
-					termResult, docIndices, err := b.createTerm(N, filterDocIds, queryTerms[j], propNames,
-						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
-					if err != nil {
-						return err
-					}
-					results[k] = termResult
-					indices[k] = docIndices
-					return nil
-				})
-			}
-			offset += len(queryTerms)
+		for i := range phrases {
+			j := i
+			k := i + offset
+
+			eg.Go(func() error {
+				termResult, docIndices, err := b.createPhraseTerm(N, filterDocIds, phrases[j], propNames,
+					propertyBoosts, phraseBoosts[j], params.AdditionalExplanations)
+				if err != nil {
+					return err
+				}
+				results[k] = termResult
+				indices[k] = docIndices
+				return nil
+			})
 		}
+		offset += len(phrases)
 	}
 
 	if err := eg.Wait(); err != nil {
@@ -226,35 +268,86 @@ func (b *BM25Searcher) wand(
 	copy(resultsOriginalOrder, results)
 
 	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
-	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations, class)
+}
+
+// bm25PropGroup are properties whose text is analyzed the same way, which
+// is why they are searched for the same query terms and phrases
+type bm25PropGroup struct {
+	propNames       []string
+	queryTerms      []string
+	duplicateBoosts []int
+	phrases         []bm25Phrase
+	phraseBoosts    []int
+	// prefixTerm is matched as the prefix of terms in search-as-you-type
+	prefixTerm string
 }
 
-func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
-	if detector == nil || len(queryTerms) == 0 {
-		return queryTerms, duplicateBoost
+// analysisKey identifies the way the text of a property is analyzed
+func analysisKey(prop *models.Property) string {
+	key := prop.Tokenization
+	if cfg := prop.TextAnalyzer; cfg != nil && prop.Tokenization != models.PropertyTokenizationField {
+		key += fmt.Sprintf("/%s/%t/%s", cfg.Language, cfg.ASCIIFold, cfg.StopwordPreset)
 	}
+	return key
+}
 
-	i := 0
-WordLoop:
-	for {
-		if i == len(queryTerms) {
-			return queryTerms, duplicateBoost
+func isKnownTokenization(tokenization string) bool {
+	for _, t := range helpers.Tokenizations {
+		if t == tokenization {
+			return true
+		}
+	}
+	return false
+}
+
+// analyzeQuery tokenizes the query the way the text of prop is analyzed.
+// Stopwords are removed before the terms are normalized. They are those of
+// the class for word tokenization, unless the property has a stopword preset
+// of its own. Duplicate terms are counted.
+func analyzeQuery(prop *models.Property, query string,
+	classStopwords *stopwords.Detector,
+) ([]string, []int, error) {
+	analyzer := TextAnalyzer(prop)
+	terms := analyzer.Split(query)
+
+	detector, err := queryStopwords(prop, classStopwords)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := map[string]int{}
+	var unique []string
+	for _, term := range terms {
+		if detector != nil && detector.IsStopword(term) {
+			continue
 		}
-		queryTerm := queryTerms[i]
-		if detector.IsStopword(queryTerm) {
-			queryTerms[i] = queryTerms[len(queryTerms)-1]
-			queryTerms = queryTerms[:len(queryTerms)-1]
-			duplicateBoost[i] = duplicateBoost[len(duplicateBoost)-1]
-			duplicateBoost = duplicateBoost[:len(duplicateBoost)-1]
-
-			continue WordLoop
+		term = analyzer.Normalize(term)
+		if counts[term] == 0 {
+			unique = append(unique, term)
 		}
+		counts[term]++
+	}
+	boosts := make([]int, len(unique))
+	for i, term := range unique {
+		boosts[i] = counts[term]
+	}
+	return unique, boosts, nil
+}
 
-		i++
+// queryStopwords returns the stopwords to remove from a query searching prop
+func queryStopwords(prop *models.Property, classStopwords *stopwords.Detector,
+) (stopwords.StopwordDetector, error) {
+	if prop.TextAnalyzer != nil && prop.TextAnalyzer.StopwordPreset != "" {
+		return PropertyStopwords(prop, nil)
+	}
+	if prop.Tokenization == models.PropertyTokenizationWord && classStopwords != nil {
+		return classStopwords, nil
 	}
+	return nil, nil
 }
 
-func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool) ([]*storobj.Object, []float32, error) {
+func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool, class *models.Class) ([]*storobj.Object, []float32, error) {
 	objectsBucket := b.store.Bucket(helpers.ObjectsBucketLSM)
 	if objectsBucket == nil {
 		return nil, nil, errors.Errorf("objects bucket not found")
@@ -277,7 +370,7 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results ter
 			continue
 		}
 
-		obj, err := storobj.FromBinary(objectByte)
+		obj, err := storobj.FromBinaryWithClass(objectByte, class)
 		if err != nil {
 			return nil, nil, err
 		}
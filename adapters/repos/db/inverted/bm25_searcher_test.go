@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestBM25AnalyzeQuery(t *testing.T) {
+	classStopwords, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	require.Nil(t, err)
+
+	type testCase struct {
+		name           string
+		prop           *models.Property
+		query          string
+		expectedTerms  []string
+		expectedBoosts []int
+	}
+
+	testCases := []testCase{
+		{
+			name:           "word tokenization removes class stopwords",
+			prop:           &models.Property{Tokenization: models.PropertyTokenizationWord},
+			query:          "the house and the houses",
+			expectedTerms:  []string{"house", "houses"},
+			expectedBoosts: []int{1, 1},
+		},
+		{
+			name:           "whitespace tokenization keeps stopwords",
+			prop:           &models.Property{Tokenization: models.PropertyTokenizationWhitespace},
+			query:          "the house",
+			expectedTerms:  []string{"the", "house"},
+			expectedBoosts: []int{1, 1},
+		},
+		{
+			name: "stemmed terms are merged",
+			prop: &models.Property{
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageEn},
+			},
+			query:          "the house and the houses",
+			expectedTerms:  []string{"hous"},
+			expectedBoosts: []int{2},
+		},
+		{
+			name: "stopword preset of property replaces class stopwords",
+			prop: &models.Property{
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{
+					Language:       models.TextAnalyzerConfigLanguageEn,
+					StopwordPreset: stopwords.GermanPreset,
+				},
+			},
+			query:          "die houses the house",
+			expectedTerms:  []string{"hous", "the"},
+			expectedBoosts: []int{2, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			terms, boosts, err := analyzeQuery(tc.prop, tc.query, classStopwords)
+			require.Nil(t, err)
+			assert.Equal(t, tc.expectedTerms, terms)
+			assert.Equal(t, tc.expectedBoosts, boosts)
+		})
+	}
+}
+
+func TestBM25AnalysisKey(t *testing.T) {
+	word := &models.Property{Tokenization: models.PropertyTokenizationWord}
+	stemmed := &models.Property{
+		Tokenization: models.PropertyTokenizationWord,
+		TextAnalyzer: &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageEn},
+	}
+	field := &models.Property{
+		Tokenization: models.PropertyTokenizationField,
+		TextAnalyzer: &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageEn},
+	}
+
+	assert.Equal(t, analysisKey(word), analysisKey(&models.Property{Tokenization: models.PropertyTokenizationWord}))
+	assert.NotEqual(t, analysisKey(word), analysisKey(stemmed))
+	assert.Equal(t, models.PropertyTokenizationField, analysisKey(field))
+}
@@ -0,0 +1,290 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+const (
+	// HighlightPreTag and HighlightPostTag enclose the query terms marked in
+	// fragments
+	HighlightPreTag  = "<em>"
+	HighlightPostTag = "</em>"
+
+	// highlightFragmentSize is the length of a fragment in bytes that query
+	// terms are grouped into and which is filled up with the text around them
+	highlightFragmentSize = 100
+	// highlightMaxFragments is the maximum number of fragments returned per
+	// property, those with most query terms are kept
+	highlightMaxFragments = 3
+)
+
+// Highlighter marks the terms of a keyword query in the text properties of
+// search results. The text of a property is analyzed by the analyzer of the
+// property, so the terms marked are exactly those which were scored.
+type Highlighter struct {
+	props     []*highlightProp
+	fuzziness int
+}
+
+type highlightProp struct {
+	name     string
+	analyzer *helpers.TextAnalyzer
+	// terms are the terms of query and its phrases as they are analyzed for
+	// the property
+	terms map[string]struct{}
+	// queryTerms are the terms outside of phrases, which are matched fuzzy
+	queryTerms [][]rune
+	prefixTerm string
+}
+
+type highlightFragment struct {
+	text    string
+	matches int
+	// position orders fragments by the value of the property they were taken
+	// from and their offset within it
+	position int
+}
+
+// NewHighlighter analyzes the query of params for all text properties
+// searched
+func NewHighlighter(class *models.Class, params searchparams.KeywordRanking,
+) (*Highlighter, error) {
+	var classStopwords *stopwords.Detector
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
+		var err error
+		classStopwords, err = stopwords.NewDetectorFromConfig(*(class.InvertedIndexConfig.Stopwords))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query, phrases := splitQueryPhrases(params.Query)
+	h := &Highlighter{fuzziness: params.Fuzziness}
+	for _, propertyWithBoost := range params.Properties {
+		property := strings.Split(propertyWithBoost, "^")[0]
+		prop, err := schema.GetPropertyByName(class, property)
+		if err != nil {
+			return nil, err
+		}
+		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+		default:
+			continue
+		}
+
+		queryTerms, _, err := analyzeQuery(prop, query, classStopwords)
+		if err != nil {
+			return nil, err
+		}
+		analyzedPhrases, _, err := analyzePhrases(prop, phrases, classStopwords)
+		if err != nil {
+			return nil, err
+		}
+
+		hp := &highlightProp{
+			name:     prop.Name,
+			analyzer: TextAnalyzer(prop),
+			terms:    map[string]struct{}{},
+		}
+		for _, term := range queryTerms {
+			hp.terms[term] = struct{}{}
+			hp.queryTerms = append(hp.queryTerms, []rune(term))
+		}
+		for _, phrase := range analyzedPhrases {
+			for _, term := range phrase.terms {
+				hp.terms[term] = struct{}{}
+			}
+		}
+		if params.Prefix {
+			hp.prefixTerm, err = prefixQueryTerm(prop, query, classStopwords)
+			if err != nil {
+				return nil, err
+			}
+		}
+		h.props = append(h.props, hp)
+	}
+	return h, nil
+}
+
+// Highlight returns the fragments of the text properties in props which
+// contain query terms. Properties without any are omitted.
+func (h *Highlighter) Highlight(props map[string]interface{}) []*additional.Highlight {
+	var out []*additional.Highlight
+	for _, prop := range h.props {
+		var values []string
+		switch v := props[prop.name].(type) {
+		case string:
+			values = []string{v}
+		case []string:
+			values = v
+		case []interface{}:
+			for _, elem := range v {
+				if s, ok := elem.(string); ok {
+					values = append(values, s)
+				}
+			}
+		}
+
+		var fragments []highlightFragment
+		position := 0
+		for _, value := range values {
+			for _, fragment := range h.fragments(prop, value) {
+				fragment.position = position
+				fragments = append(fragments, fragment)
+				position++
+			}
+		}
+		if len(fragments) == 0 {
+			continue
+		}
+
+		if len(fragments) > highlightMaxFragments {
+			sort.SliceStable(fragments, func(i, j int) bool {
+				return fragments[i].matches > fragments[j].matches
+			})
+			fragments = fragments[:highlightMaxFragments]
+			sort.Slice(fragments, func(i, j int) bool {
+				return fragments[i].position < fragments[j].position
+			})
+		}
+
+		highlight := &additional.Highlight{
+			Property:  prop.name,
+			Fragments: make([]string, len(fragments)),
+		}
+		for i := range fragments {
+			highlight.Fragments[i] = fragments[i].text
+		}
+		out = append(out, highlight)
+	}
+	return out
+}
+
+// fragments groups the query terms in text which are close to each other
+// into fragments and marks them
+func (h *Highlighter) fragments(prop *highlightProp, text string) []highlightFragment {
+	tokens := prop.analyzer.SplitTokens(text)
+	var matched []helpers.Token
+	for _, token := range tokens {
+		if h.matches(prop, prop.analyzer.Normalize(token.Term)) {
+			matched = append(matched, token)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	var groups [][]helpers.Token
+	for i := 0; i < len(matched); {
+		j := i + 1
+		for j < len(matched) && matched[j].End-matched[i].Start <= highlightFragmentSize {
+			j++
+		}
+		groups = append(groups, matched[i:j])
+		i = j
+	}
+
+	fragments := make([]highlightFragment, len(groups))
+	prevEnd := 0
+	for i, group := range groups {
+		nextStart := len(text)
+		if i+1 < len(groups) {
+			nextStart = groups[i+1][0].Start
+		}
+		start, end := fillFragment(tokens, group[0].Start, group[len(group)-1].End,
+			prevEnd, nextStart)
+		fragments[i] = highlightFragment{
+			text:    markTerms(text, start, end, group),
+			matches: len(group),
+		}
+		prevEnd = end
+	}
+	return fragments
+}
+
+// matches checks whether the normalized term of a text matches a query term
+// the way the term would have been scored
+func (h *Highlighter) matches(prop *highlightProp, term string) bool {
+	if _, ok := prop.terms[term]; ok {
+		return true
+	}
+	if prop.prefixTerm != "" && strings.HasPrefix(term, prop.prefixTerm) {
+		return true
+	}
+	if h.fuzziness > 0 {
+		runes := []rune(term)
+		for _, queryTerm := range prop.queryTerms {
+			distance, ok := editDistance(queryTerm, runes, h.fuzziness)
+			// terms no longer than their distance to the query term do not
+			// match, see expandTerm
+			if ok && minInt(len(queryTerm), len(runes)) > distance {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fillFragment extends the range of text from start to end by the tokens
+// around it, until it has the size of a fragment. It does not extend beyond
+// lower and upper.
+func fillFragment(tokens []helpers.Token, start, end, lower, upper int) (int, int) {
+	pad := (highlightFragmentSize - (end - start)) / 2
+	if pad <= 0 {
+		return start, end
+	}
+
+	from, to := start, end
+	for _, token := range tokens {
+		if token.Start >= lower && token.Start >= start-pad && token.Start < from {
+			from = token.Start
+		}
+		if token.End <= upper && token.End <= end+pad && token.End > to {
+			to = token.End
+		}
+	}
+	return from, to
+}
+
+// markTerms returns text from start to end with the matched tokens enclosed
+// in highlight tags. Overlapping tokens, e.g. CJK bigrams, are marked as one.
+func markTerms(text string, start, end int, matched []helpers.Token) string {
+	var b strings.Builder
+	pos := start
+	for i := 0; i < len(matched); {
+		markStart, markEnd := matched[i].Start, matched[i].End
+		i++
+		for i < len(matched) && matched[i].Start <= markEnd {
+			if matched[i].End > markEnd {
+				markEnd = matched[i].End
+			}
+			i++
+		}
+		b.WriteString(text[pos:markStart])
+		b.WriteString(HighlightPreTag)
+		b.WriteString(text[markStart:markEnd])
+		b.WriteString(HighlightPostTag)
+		pos = markEnd
+	}
+	b.WriteString(text[pos:end])
+	return b.String()
+}
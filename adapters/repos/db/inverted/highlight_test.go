@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestHighlighter(t *testing.T) {
+	class := &models.Class{
+		Class: "Song",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en"},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "lyrics",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageEn},
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:         "titleZh",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageZh},
+			},
+			{
+				Name:     "year",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+
+	type testCase struct {
+		name     string
+		params   searchparams.KeywordRanking
+		props    map[string]interface{}
+		expected []*additional.Highlight
+	}
+
+	testCases := []testCase{
+		{
+			name: "marks terms, but not stopwords",
+			params: searchparams.KeywordRanking{
+				Query:      "the stairway",
+				Properties: []string{"title^2", "year"},
+			},
+			props: map[string]interface{}{"title": "Stairway to the Heaven", "year": 1971},
+			expected: []*additional.Highlight{
+				{Property: "title", Fragments: []string{"<em>Stairway</em> to the Heaven"}},
+			},
+		},
+		{
+			name: "marks stemmed terms",
+			params: searchparams.KeywordRanking{
+				Query:      "queries",
+				Properties: []string{"lyrics"},
+			},
+			props: map[string]interface{}{"lyrics": "One query, two books and more queries"},
+			expected: []*additional.Highlight{
+				{Property: "lyrics", Fragments: []string{"One <em>query</em>, two books and more <em>queries</em>"}},
+			},
+		},
+		{
+			name: "omits properties without terms",
+			params: searchparams.KeywordRanking{
+				Query:      "heaven",
+				Properties: []string{"title", "lyrics"},
+			},
+			props: map[string]interface{}{"title": "Stairway to Heaven", "lyrics": "There's a lady"},
+			expected: []*additional.Highlight{
+				{Property: "title", Fragments: []string{"Stairway to <em>Heaven</em>"}},
+			},
+		},
+		{
+			name: "marks phrases, fuzzy and prefix terms",
+			params: searchparams.KeywordRanking{
+				Query:      `"whole lotta" lov stai`,
+				Properties: []string{"title"},
+				Fuzziness:  1,
+				Prefix:     true,
+			},
+			props: map[string]interface{}{"title": "Whole Lotta Love on the Stairs"},
+			expected: []*additional.Highlight{
+				{Property: "title", Fragments: []string{"<em>Whole</em> <em>Lotta</em> <em>Love</em> on the <em>Stairs</em>"}},
+			},
+		},
+		{
+			name: "marks whole values of field tokenization",
+			params: searchparams.KeywordRanking{
+				Query:      "hard rock",
+				Properties: []string{"tags"},
+			},
+			props: map[string]interface{}{"tags": []interface{}{"folk", "hard rock"}},
+			expected: []*additional.Highlight{
+				{Property: "tags", Fragments: []string{"<em>hard rock</em>"}},
+			},
+		},
+		{
+			name: "marks overlapping bigrams once",
+			params: searchparams.KeywordRanking{
+				Query:      "天堂阶梯",
+				Properties: []string{"titleZh"},
+			},
+			props: map[string]interface{}{"titleZh": "通往天堂的阶梯"},
+			expected: []*additional.Highlight{
+				{Property: "titleZh", Fragments: []string{"通往<em>天堂</em>的<em>阶梯</em>"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			highlighter, err := NewHighlighter(class, tc.params)
+			require.Nil(t, err)
+			assert.Equal(t, tc.expected, highlighter.Highlight(tc.props))
+		})
+	}
+
+	t.Run("splits long text into fragments", func(t *testing.T) {
+		text := "Led Zeppelin" + strings.Repeat(" and so on", 30) + " a Zeppelin again" +
+			strings.Repeat(" and so on", 30)
+		highlighter, err := NewHighlighter(class, searchparams.KeywordRanking{
+			Query:      "zeppelin",
+			Properties: []string{"title"},
+		})
+		require.Nil(t, err)
+
+		highlights := highlighter.Highlight(map[string]interface{}{"title": text})
+		require.Len(t, highlights, 1)
+		require.Len(t, highlights[0].Fragments, 2)
+		for _, fragment := range highlights[0].Fragments {
+			assert.Contains(t, fragment, "<em>Zeppelin</em>")
+			assert.LessOrEqual(t, len(fragment), highlightFragmentSize+
+				len(HighlightPreTag)+len(HighlightPostTag))
+		}
+		assert.True(t, strings.HasPrefix(highlights[0].Fragments[0], "Led <em>Zeppelin</em> and so on"))
+	})
+}
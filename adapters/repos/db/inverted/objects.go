@@ -21,6 +21,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -76,6 +77,11 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 			continue
 		}
 
+		if _, isNested := schema.AsNested(prop.DataType); isNested {
+			// nested properties are not indexed yet
+			continue
+		}
+
 		if schema.IsRefDataType(prop.DataType) {
 			if err := a.extendPropertiesWithReference(&out, prop, input, key); err != nil {
 				return nil, err
@@ -215,7 +221,7 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		items = a.AnalyzedTextArray(TextAnalyzer(prop), in)
 	case schema.DataTypeIntArray:
 		in := make([]int64, len(values))
 		for i, value := range values {
@@ -355,7 +361,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		items = a.AnalyzedTextArray(TextAnalyzer(prop), []string{asString})
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		if asFloat, ok := value.(float64); ok {
@@ -597,6 +603,23 @@ func HasInvertedIndex(prop *models.Property) bool {
 	return HasFilterableIndex(prop) || HasSearchableIndex(prop)
 }
 
+// TextAnalyzer returns the analyzer for the values of a text property. It
+// must be used both for indexing the property and for querying it.
+func TextAnalyzer(prop *models.Property) *helpers.TextAnalyzer {
+	return helpers.NewTextAnalyzer(prop.Tokenization, prop.TextAnalyzer)
+}
+
+// PropertyStopwords returns the stopwords of a text property. These are the
+// stopword preset of its text analyzer if set, otherwise classStopwords.
+func PropertyStopwords(prop *models.Property,
+	classStopwords stopwords.StopwordDetector,
+) (stopwords.StopwordDetector, error) {
+	if prop.TextAnalyzer == nil || prop.TextAnalyzer.StopwordPreset == "" {
+		return classStopwords, nil
+	}
+	return stopwords.NewDetectorFromPreset(prop.TextAnalyzer.StopwordPreset)
+}
+
 const (
 	// always
 	HasFilterableIndexIdProp = true
@@ -89,14 +89,17 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Data:          []byte("i"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{0},
 			},
 			{
 				Data:          []byte("am"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{1},
 			},
 			{
 				Data:          []byte("great"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{2},
 			},
 		}
 
@@ -104,6 +107,7 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Data:          []byte("john@doe.com"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{0},
 			},
 		}
 
@@ -111,22 +115,27 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Data:          []byte("i"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{0},
 			},
 			{
 				Data:          []byte("like"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{1},
 			},
 			{
 				Data:          []byte("reading"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{2},
 			},
 			{
 				Data:          []byte("sci-fi"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{3},
 			},
 			{
 				Data:          []byte("books"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{4},
 			},
 		}
 
@@ -134,6 +143,7 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Data:          []byte("Mechanical Engineer"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{0},
 			},
 		}
 
@@ -280,18 +290,22 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Data:          []byte("i"),
 				TermFrequency: float32(2),
+				Positions:     []uint32{0, 103},
 			},
 			{
 				Data:          []byte("am"),
 				TermFrequency: float32(2),
+				Positions:     []uint32{1, 104},
 			},
 			{
 				Data:          []byte("great"),
 				TermFrequency: float32(2),
+				Positions:     []uint32{2, 106},
 			},
 			{
 				Data:          []byte("also"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{105},
 			},
 		}
 
@@ -299,10 +313,12 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Data:          []byte("john@doe.com"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{0},
 			},
 			{
 				Data:          []byte("john2@doe.com"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{101},
 			},
 		}
 
@@ -310,30 +326,37 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Data:          []byte("i"),
 				TermFrequency: float32(2),
+				Positions:     []uint32{0, 105},
 			},
 			{
 				Data:          []byte("like"),
 				TermFrequency: float32(2),
+				Positions:     []uint32{1, 106},
 			},
 			{
 				Data:          []byte("reading"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{2},
 			},
 			{
 				Data:          []byte("sci-fi"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{3},
 			},
 			{
 				Data:          []byte("books"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{4},
 			},
 			{
 				Data:          []byte("playing"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{107},
 			},
 			{
 				Data:          []byte("piano"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{108},
 			},
 		}
 
@@ -341,10 +364,12 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Data:          []byte("Mechanical Engineer"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{0},
 			},
 			{
 				Data:          []byte("Marketing Analyst"),
 				TermFrequency: float32(1),
+				Positions:     []uint32{101},
 			},
 		}
 
@@ -631,6 +656,7 @@ func TestAnalyzeObject(t *testing.T) {
 				{
 					Data:          []byte(name),
 					TermFrequency: 1,
+					Positions:     []uint32{0},
 				},
 			}
 
@@ -676,12 +702,12 @@ func TestAnalyzeObject(t *testing.T) {
 			{
 				Name: "description",
 				Items: []Countable{
-					{Data: []byte("pretty"), TermFrequency: 1},
-					{Data: []byte("ok"), TermFrequency: 1},
-					{Data: []byte("if"), TermFrequency: 1},
-					{Data: []byte("you"), TermFrequency: 1},
-					{Data: []byte("ask"), TermFrequency: 1},
-					{Data: []byte("me"), TermFrequency: 1},
+					{Data: []byte("pretty"), TermFrequency: 1, Positions: []uint32{0}},
+					{Data: []byte("ok"), TermFrequency: 1, Positions: []uint32{1}},
+					{Data: []byte("if"), TermFrequency: 1, Positions: []uint32{2}},
+					{Data: []byte("you"), TermFrequency: 1, Positions: []uint32{3}},
+					{Data: []byte("ask"), TermFrequency: 1, Positions: []uint32{4}},
+					{Data: []byte("me"), TermFrequency: 1, Positions: []uint32{5}},
 				},
 				HasFilterableIndex: true,
 				HasSearchableIndex: true,
@@ -713,6 +739,51 @@ func TestAnalyzeObject(t *testing.T) {
 			assert.ElementsMatch(t, expected[i].Items, res[i].Items)
 		}
 	})
+
+	t.Run("with text analyzers", func(t *testing.T) {
+		sch := map[string]interface{}{
+			"titel": "The houses by the house",
+			"tags":  []interface{}{"Café", "café crème"},
+		}
+
+		uuid := strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501")
+		props := []*models.Property{
+			{
+				Name:         "titel",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{Language: models.TextAnalyzerConfigLanguageEn},
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{ASCIIFold: true},
+			},
+		}
+
+		res, err := a.Object(sch, props, uuid)
+		require.Nil(t, err)
+
+		expected := map[string][]Countable{
+			"titel": {
+				{Data: []byte("the"), TermFrequency: 2, Positions: []uint32{0, 3}},
+				{Data: []byte("hous"), TermFrequency: 2, Positions: []uint32{1, 4}},
+				{Data: []byte("by"), TermFrequency: 1, Positions: []uint32{2}},
+			},
+			"tags": {
+				{Data: []byte("cafe"), TermFrequency: 2, Positions: []uint32{0, 101}},
+				{Data: []byte("creme"), TermFrequency: 1, Positions: []uint32{102}},
+			},
+		}
+		for _, prop := range res {
+			if items, ok := expected[prop.Name]; ok {
+				assert.ElementsMatch(t, items, prop.Items, prop.Name)
+				delete(expected, prop.Name)
+			}
+		}
+		assert.Empty(t, expected)
+	})
 }
 
 func TestConvertSliceToUntyped(t *testing.T) {
@@ -49,7 +49,7 @@ func newPropValuePair(class *models.Class) (*propValuePair, error) {
 	return &propValuePair{docIDs: newDocBitmap(), Class: class}, nil
 }
 
-func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
+func (pv *propValuePair) fetchDocIDs(ctx context.Context, s *Searcher, limit int) error {
 	if pv.operator.OnValue() {
 
 		// TODO text_rbm_inverted_index find better way check whether prop len
@@ -87,7 +87,6 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
 		}
 
-		ctx := context.TODO() // TODO: pass through instead of spawning new
 		dbm, err := s.docBitmap(ctx, b, limit, pv)
 		if err != nil {
 			return err
@@ -105,7 +104,7 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 				// otherwise we run into situations where each subfilter on their own
 				// runs into the limit, possibly yielding in "less than limit" results
 				// after merging.
-				err := child.fetchDocIDs(s, 0)
+				err := child.fetchDocIDs(ctx, s, 0)
 				if err != nil {
 					return errors.Wrapf(err, "nested child %d", i)
 				}
@@ -116,6 +115,16 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 		if err := eg.Wait(); err != nil {
 			return fmt.Errorf("nested query: %w", err)
 		}
+
+		if pv.operator == filters.OperatorNot {
+			// the child's ids are complemented against all ids of the shard when
+			// merging, so those need to be present as well
+			dbm, err := s.docBitmapAll(ctx)
+			if err != nil {
+				return errors.Wrap(err, "all doc ids for operator Not")
+			}
+			pv.docIDs = dbm
+		}
 	}
 
 	return nil
@@ -126,6 +135,10 @@ func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 		return &pv.docIDs, nil
 	}
 
+	if pv.operator == filters.OperatorNot {
+		return pv.mergeDocIDsNot()
+	}
+
 	if pv.operator != filters.OperatorAnd && pv.operator != filters.OperatorOr {
 		return nil, fmt.Errorf("unsupported operator: %s", pv.operator.Name())
 	}
@@ -156,3 +169,26 @@ func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 		docIDs: roaringset.Condense(mergeRes),
 	}, nil
 }
+
+// mergeDocIDsNot complements the ids of the single child against the ids of
+// all objects in the shard, which were fetched alongside the child
+func (pv *propValuePair) mergeDocIDsNot() (*docBitmap, error) {
+	if len(pv.children) != 1 {
+		return nil, fmt.Errorf("operator %s requires exactly one child, got %d",
+			pv.operator.Name(), len(pv.children))
+	}
+
+	dbm, err := pv.children[0].mergeDocIDs()
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieve doc bitmap of child 0")
+	}
+
+	mergeRes := pv.docIDs.docIDs.Clone()
+	if dbm.docIDs != nil {
+		mergeRes.AndNot(dbm.docIDs)
+	}
+
+	return &docBitmap{
+		docIDs: roaringset.Condense(mergeRes),
+	}, nil
+}
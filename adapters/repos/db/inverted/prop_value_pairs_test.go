@@ -108,4 +108,38 @@ func TestPropValuePairs_Merging(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("NOT complements child against all ids", func(t *testing.T) {
+		all := roaringset.NewBitmap(1, 2, 3, 4, 5, 6, 7, 8)
+		child := roaringset.NewBitmap(2, 4, 6, 9)
+		pv := &propValuePair{
+			operator: filters.OperatorNot,
+			docIDs:   docBitmap{docIDs: all},
+			children: []*propValuePair{{
+				operator: filters.OperatorEqual,
+				docIDs:   docBitmap{docIDs: child},
+			}},
+		}
+
+		dbm, err := pv.mergeDocIDs()
+
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{1, 3, 5, 7, 8}, dbm.IDs())
+		assert.False(t, all == dbm.docIDs)
+		assert.Equal(t, 8, all.GetCardinality())
+	})
+
+	t.Run("NOT requires exactly one child", func(t *testing.T) {
+		pv := &propValuePair{
+			operator: filters.OperatorNot,
+			docIDs:   newDocBitmap(),
+			children: []*propValuePair{
+				{operator: filters.OperatorEqual, docIDs: newDocBitmap()},
+				{operator: filters.OperatorEqual, docIDs: newDocBitmap()},
+			},
+		}
+
+		_, err := pv.mergeDocIDs()
+		require.NotNil(t, err)
+	})
 }
@@ -98,7 +98,7 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 		it = allowList.LimitedIterator(limit)
 	}
 
-	return s.objectsByDocID(it, additional)
+	return s.objectsByDocID(it, additional, s.schema.GetClass(className))
 }
 
 func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, docIDs helpers.AllowList,
@@ -112,7 +112,7 @@ func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, doc
 }
 
 func (s *Searcher) objectsByDocID(it docIDsIterator,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*storobj.Object, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	if bucket == nil {
@@ -138,7 +138,7 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 		if additional.ReferenceQuery {
 			unmarshalled, err = storobj.FromBinaryUUIDOnly(res)
 		} else {
-			unmarshalled, err = storobj.FromBinaryOptional(res, additional)
+			unmarshalled, err = storobj.FromBinaryOptional(res, additional, class)
 		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
@@ -176,7 +176,7 @@ func (s *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 		return nil, err
 	}
 
-	if err := pv.fetchDocIDs(s, limit); err != nil {
+	if err := pv.fetchDocIDs(ctx, s, limit); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
 
@@ -210,7 +210,8 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return out, nil
 	}
 
-	if filter.Operator == filters.ContainsAny || filter.Operator == filters.ContainsAll {
+	if filter.Operator == filters.ContainsAny || filter.Operator == filters.ContainsAll ||
+		filter.Operator == filters.ContainsNone {
 		return s.extractContains(filter.On, filter.Value.Type, filter.Value.Value, filter.Operator, class)
 	}
 
@@ -518,14 +519,29 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 		return nil, fmt.Errorf("expected value to be string, got '%T'", value)
 	}
 
+	detector, err := PropertyStopwords(prop, s.stopwords)
+	if err != nil {
+		return nil, err
+	}
+
 	switch propType {
 	case schema.DataTypeText:
+		analyzer := TextAnalyzer(prop)
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
 		if operator == filters.OperatorLike {
-			terms = helpers.TokenizeWithWildcards(prop.Tokenization, valueString)
+			for _, term := range analyzer.TokenizeWithWildcards(valueString) {
+				if !detector.IsStopword(term) {
+					terms = append(terms, term)
+				}
+			}
 		} else {
-			terms = helpers.Tokenize(prop.Tokenization, valueString)
+			// stopwords are matched before terms are normalized
+			for _, term := range analyzer.Split(valueString) {
+				if !detector.IsStopword(term) {
+					terms = append(terms, analyzer.Normalize(term))
+				}
+			}
 		}
 	default:
 		return nil, fmt.Errorf("expected value type to be text, got %v", propType)
@@ -540,9 +556,6 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 
 	propValuePairs := make([]*propValuePair, 0, len(terms))
 	for _, term := range terms {
-		if s.stopwords.IsStopword(term) {
-			continue
-		}
 		propValuePairs = append(propValuePairs, &propValuePair{
 			value:              []byte(term),
 			prop:               prop.Name,
@@ -670,6 +683,17 @@ func (s *Searcher) extractContains(path *filters.Path, propType schema.DataType,
 		out.operator = filters.OperatorAnd
 	}
 	out.Class = class
+
+	if operator == filters.ContainsNone {
+		// ContainsNone is the complement of ContainsAny
+		not, err := newPropValuePair(class)
+		if err != nil {
+			return nil, errors.Wrap(err, "new prop value pair")
+		}
+		not.children = []*propValuePair{out}
+		not.operator = filters.OperatorNot
+		return not, nil
+	}
 	return out, nil
 }
 
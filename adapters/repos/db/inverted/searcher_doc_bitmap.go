@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/filters"
 )
@@ -53,6 +54,27 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	return docBitmap{}, fmt.Errorf("property '%s' is neither filterable nor searchable", pv.prop)
 }
 
+// docBitmapAll serves the ids of all objects currently present in the shard.
+// Every object is indexed by its uuid, so reading all rows of the filterable
+// id bucket yields the complete set. It is used as the universe when
+// complementing a bitmap, such as for the Not and ContainsNone operators.
+func (s *Searcher) docBitmapAll(ctx context.Context) (docBitmap, error) {
+	b := s.store.Bucket(helpers.BucketFromPropNameLSM(filters.InternalPropID))
+	if b == nil {
+		return docBitmap{}, errors.Errorf("bucket for prop %s not found", filters.InternalPropID)
+	}
+
+	// an empty value with a GreaterThanEqual operator starts at the very first
+	// row and reads through to the end of the bucket
+	pv := &propValuePair{
+		prop:               filters.InternalPropID,
+		operator:           filters.OperatorGreaterThanEqual,
+		value:              []byte{},
+		hasFilterableIndex: HasFilterableIndexIdProp,
+	}
+	return s.docBitmap(ctx, b, 0, pv)
+}
+
 func (s *Searcher) docBitmapInvertedRoaringSet(ctx context.Context, b *lsmkv.Bucket,
 	limit int, pv *propValuePair,
 ) (docBitmap, error) {
@@ -153,3 +153,26 @@ func ParseLexicographicallySortableUint64(in []byte) (uint64, error) {
 
 	return value, nil
 }
+
+// TermPositions serializes the token positions of a term within a property,
+// each as 4 bytes little endian
+func TermPositions(positions []uint32) []byte {
+	out := make([]byte, 4*len(positions))
+	for i, position := range positions {
+		binary.LittleEndian.PutUint32(out[4*i:], position)
+	}
+	return out
+}
+
+// ParseTermPositions reverses the changes in TermPositions
+func ParseTermPositions(in []byte) ([]uint32, error) {
+	if len(in)%4 != 0 {
+		return nil, fmt.Errorf("term positions must be a multiple of 4 bytes long, got: %d", len(in))
+	}
+
+	positions := make([]uint32, len(in)/4)
+	for i := range positions {
+		positions[i] = binary.LittleEndian.Uint32(in[4*i:])
+	}
+	return positions, nil
+}
@@ -93,4 +93,25 @@ func TestSerialization(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("term positions", func(t *testing.T) {
+		subjects := [][]uint32{
+			{},
+			{0},
+			{3, 17, 101},
+			{math.MaxUint32},
+		}
+
+		for _, sub := range subjects {
+			t.Run(fmt.Sprintf("with %v", sub), func(t *testing.T) {
+				parsed, err := ParseTermPositions(TermPositions(sub))
+				require.Nil(t, err)
+
+				assert.Equal(t, sub, parsed, "before and after must match")
+			})
+		}
+
+		_, err := ParseTermPositions([]byte{1, 2, 3})
+		assert.NotNil(t, err)
+	})
 }
@@ -156,4 +156,31 @@ func TestStopwordDetector(t *testing.T) {
 
 		runTest(t, tests)
 	})
+
+	t.Run("with language presets", func(t *testing.T) {
+		tests := []testcase{
+			{
+				cfg:               models.StopwordConfig{Preset: "de"},
+				input:             []string{"die", "häuser", "und", "der", "garten"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "fr"},
+				input:             []string{"la", "maison", "et", "le", "jardin"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "es"},
+				input:             []string{"la", "casa", "y", "el", "jardín"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "it"},
+				input:             []string{"la", "casa", "e", "il", "giardino"},
+				expectedCountable: 2,
+			},
+		}
+
+		runTest(t, tests)
+	})
 }
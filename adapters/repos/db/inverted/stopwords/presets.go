@@ -13,6 +13,10 @@ package stopwords
 
 const (
 	EnglishPreset = "en"
+	GermanPreset  = "de"
+	FrenchPreset  = "fr"
+	SpanishPreset = "es"
+	ItalianPreset = "it"
 	NoPreset      = "none"
 )
 
@@ -23,5 +27,59 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "als", "am", "an", "auch", "auf", "aus", "bei", "bin", "bis", "bist",
+		"da", "dadurch", "daher", "darum", "das", "dass", "daß", "dein", "deine", "dem",
+		"den", "der", "des", "dessen", "die", "dies", "dieser", "dieses", "doch", "dort",
+		"du", "durch", "ein", "eine", "einem", "einen", "einer", "eines", "er", "es",
+		"euer", "eure", "für", "hatte", "hatten", "hattest", "hattet", "hier", "hinter",
+		"ich", "ihr", "ihre", "im", "in", "ist", "ja", "jede", "jedem", "jeden", "jeder",
+		"jedes", "jener", "jenes", "jetzt", "kann", "kannst", "können", "könnt", "machen",
+		"mein", "meine", "mit", "muß", "mußt", "musst", "müssen", "müßt", "nach",
+		"nachdem", "nein", "nicht", "nun", "oder", "seid", "sein", "seine", "sich", "sie",
+		"sind", "soll", "sollen", "sollst", "sollt", "sonst", "soweit", "sowie", "und",
+		"unser", "unsere", "unter", "vom", "von", "vor", "wann", "warum", "was", "weiter",
+		"weitere", "wenn", "wer", "werde", "werden", "werdet", "weshalb", "wie", "wieder",
+		"wieso", "wir", "wird", "wirst", "wo", "woher", "wohin", "zu", "zum", "zur",
+		"über",
+	},
+	FrenchPreset: {
+		"a", "au", "aux", "avec", "ce", "ces", "dans", "de", "des", "du", "elle", "en",
+		"et", "eux", "il", "je", "la", "le", "les", "leur", "lui", "ma", "mais", "me",
+		"même", "mes", "moi", "mon", "ne", "nos", "notre", "nous", "on", "ou", "par",
+		"pas", "pour", "qu", "que", "qui", "sa", "se", "ses", "son", "sur", "ta", "te",
+		"tes", "toi", "ton", "tu", "un", "une", "vos", "votre", "vous", "c", "d", "j",
+		"l", "à", "m", "n", "s", "t", "y", "été", "étée", "étées", "étés", "étant",
+		"suis", "es", "est", "sommes", "êtes", "sont", "serai", "sera", "serons",
+		"seront", "étais", "était", "étions", "étaient", "ai", "as", "avons", "avez",
+		"ont", "avait", "eu", "ceci", "cela", "celà", "cet", "cette", "ici", "ils",
+		"leurs", "quel", "quels", "quelle", "quelles", "sans", "soi",
+	},
+	SpanishPreset: {
+		"de", "la", "que", "el", "en", "y", "a", "los", "del", "se", "las", "por", "un",
+		"para", "con", "no", "una", "su", "al", "lo", "como", "más", "pero", "sus", "le",
+		"ya", "o", "este", "sí", "porque", "esta", "entre", "cuando", "muy", "sin",
+		"sobre", "también", "me", "hasta", "hay", "donde", "quien", "desde", "todo",
+		"nos", "durante", "todos", "uno", "les", "ni", "contra", "otros", "ese", "eso",
+		"ante", "ellos", "e", "esto", "mí", "antes", "algunos", "qué", "unos", "yo",
+		"otro", "otras", "otra", "él", "tanto", "esa", "estos", "mucho", "quienes",
+		"nada", "muchos", "cual", "poco", "ella", "estar", "estas", "algunas", "algo",
+		"nosotros", "es", "son", "fue", "ha", "han", "ser", "era",
+	},
+	ItalianPreset: {
+		"a", "ad", "al", "allo", "ai", "agli", "all", "agl", "alla", "alle", "con",
+		"col", "coi", "da", "dal", "dallo", "dai", "dagli", "dall", "dagl", "dalla",
+		"dalle", "di", "del", "dello", "dei", "degli", "dell", "degl", "della", "delle",
+		"in", "nel", "nello", "nei", "negli", "nell", "negl", "nella", "nelle", "su",
+		"sul", "sullo", "sui", "sugli", "sull", "sugl", "sulla", "sulle", "per", "tra",
+		"contro", "io", "tu", "lui", "lei", "noi", "voi", "loro", "mio", "mia", "miei",
+		"mie", "tuo", "tua", "tuoi", "tue", "suo", "sua", "suoi", "sue", "nostro",
+		"nostra", "nostri", "nostre", "vostro", "vostra", "vostri", "vostre", "mi", "ti",
+		"ci", "vi", "lo", "la", "li", "le", "gli", "ne", "il", "un", "uno", "una", "ma",
+		"ed", "se", "perché", "anche", "come", "dov", "dove", "che", "chi", "cui", "non",
+		"più", "quale", "quanto", "quanti", "quanta", "quante", "quello", "quelli",
+		"quella", "quelle", "questo", "questi", "questa", "queste", "si", "tutto",
+		"tutti", "e", "è", "o", "sono", "ha", "hanno", "era", "fu",
+	},
 	NoPreset: {},
 }
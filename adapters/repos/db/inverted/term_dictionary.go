@@ -0,0 +1,458 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// trigramPadding marks the start and end of a term, so that its first and
+// last characters are part of as many trigrams as the ones in the middle
+const trigramPadding = "\x00\x00"
+
+// defaultMaxDictionaryTerms limits the number of terms a term dictionary holds
+// in memory. Properties with more distinct terms are looked up in their
+// searchable bucket instead.
+const defaultMaxDictionaryTerms = 500_000
+
+// TermLookup finds the terms of a property matching a query term
+type TermLookup interface {
+	Prefix(prefix string, limit int) []TermMatch
+	Fuzzy(term string, maxDistance, limit int) []TermMatch
+}
+
+// TermDictionary holds the distinct terms of a searchable property. It is
+// used to expand query terms to the terms of the index, which start with them
+// (prefix search) or are within an edit distance of them (fuzzy search).
+//
+// Terms are kept sorted for prefix lookups and indexed by their trigrams to
+// find candidates of fuzzy lookups without comparing all terms. Terms of
+// deleted objects are not removed, they do not match any object anymore and
+// thus do not contribute to scores.
+//
+// A dictionary with a limit on its terms stops holding any terms once the
+// limit is exceeded, see Full.
+type TermDictionary struct {
+	sync.RWMutex
+	ids      map[string]uint32
+	terms    []string // by id
+	sorted   []string
+	unsorted []string // added since terms were last sorted
+	trigrams map[string][]uint32
+	maxTerms int // 0 means no limit
+	full     bool
+}
+
+func NewTermDictionary() *TermDictionary {
+	return &TermDictionary{
+		ids:      map[string]uint32{},
+		trigrams: map[string][]uint32{},
+	}
+}
+
+// TermMatch is a term of the dictionary matching a lookup
+type TermMatch struct {
+	Term     string
+	Distance int
+}
+
+func (d *TermDictionary) Add(term string) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.add(term)
+}
+
+func (d *TermDictionary) add(term string) {
+	if d.full {
+		return
+	}
+	if _, ok := d.ids[term]; ok {
+		return
+	}
+	if d.maxTerms > 0 && len(d.terms) >= d.maxTerms {
+		d.release()
+		return
+	}
+
+	id := uint32(len(d.terms))
+	d.ids[term] = id
+	d.terms = append(d.terms, term)
+	d.unsorted = append(d.unsorted, term)
+	for _, trigram := range distinctTrigrams(term) {
+		d.trigrams[trigram] = append(d.trigrams[trigram], id)
+	}
+}
+
+// release drops all terms of a dictionary which exceeded its limit
+func (d *TermDictionary) release() {
+	d.full = true
+	d.ids, d.terms, d.sorted, d.unsorted, d.trigrams = nil, nil, nil, nil, nil
+}
+
+// Full tells if the dictionary exceeded its limit of terms. It doesn't hold
+// any terms then.
+func (d *TermDictionary) Full() bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.full
+}
+
+func (d *TermDictionary) Len() int {
+	d.RLock()
+	defer d.RUnlock()
+
+	return len(d.terms)
+}
+
+// Prefix returns the terms starting with prefix, at most limit of them.
+// If there are more, the shortest ones are returned.
+func (d *TermDictionary) Prefix(prefix string, limit int) []TermMatch {
+	d.sortTerms()
+
+	d.RLock()
+	defer d.RUnlock()
+
+	var matches []TermMatch
+	for i := sort.SearchStrings(d.sorted, prefix); i < len(d.sorted); i++ {
+		if !strings.HasPrefix(d.sorted[i], prefix) {
+			break
+		}
+		matches = append(matches, TermMatch{Term: d.sorted[i]})
+	}
+	return shortestMatches(matches, limit)
+}
+
+// shortestMatches returns the limit shortest of the prefix matches, which are
+// sorted by term
+func shortestMatches(matches []TermMatch, limit int) []TermMatch {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return utf8.RuneCountInString(matches[i].Term) < utf8.RuneCountInString(matches[j].Term)
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// Fuzzy returns the terms within maxDistance edits (insertions, deletions,
+// substitutions and transpositions of adjacent characters) of term, at most
+// limit of them. If there are more, the closest ones are returned.
+func (d *TermDictionary) Fuzzy(term string, maxDistance, limit int) []TermMatch {
+	d.RLock()
+	defer d.RUnlock()
+
+	query := []rune(term)
+
+	// A single edit changes at most 4 trigrams of a term (a transposition
+	// changes the ones of two characters). Terms sharing fewer trigrams with
+	// the query than the remaining ones can not be within maxDistance.
+	queryTrigrams := distinctTrigrams(term)
+	minShared := len(queryTrigrams) - 4*maxDistance
+
+	var candidates []uint32
+	if minShared > 0 {
+		shared := map[uint32]int{}
+		for _, trigram := range queryTrigrams {
+			for _, id := range d.trigrams[trigram] {
+				shared[id]++
+			}
+		}
+		candidates = make([]uint32, 0, len(shared))
+		for id, count := range shared {
+			if count >= minShared {
+				candidates = append(candidates, id)
+			}
+		}
+	} else {
+		// term is too short for its trigrams to rule out any terms
+		candidates = make([]uint32, len(d.terms))
+		for i := range d.terms {
+			candidates[i] = uint32(i)
+		}
+	}
+
+	var matches []TermMatch
+	for _, id := range candidates {
+		candidate := []rune(d.terms[id])
+		if distance, ok := editDistance(query, candidate, maxDistance); ok {
+			matches = append(matches, TermMatch{Term: d.terms[id], Distance: distance})
+		}
+	}
+	return closestMatches(matches, limit)
+}
+
+// closestMatches returns the limit closest of the fuzzy matches
+func closestMatches(matches []TermMatch, limit int) []TermMatch {
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Distance != matches[j].Distance {
+			return matches[i].Distance < matches[j].Distance
+		}
+		return matches[i].Term < matches[j].Term
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// sortTerms merges the terms added since the last lookup into the sorted ones
+func (d *TermDictionary) sortTerms() {
+	d.RLock()
+	pending := len(d.unsorted)
+	d.RUnlock()
+	if pending == 0 {
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	sort.Strings(d.unsorted)
+	merged := make([]string, 0, len(d.sorted)+len(d.unsorted))
+	i, j := 0, 0
+	for i < len(d.sorted) && j < len(d.unsorted) {
+		if d.sorted[i] < d.unsorted[j] {
+			merged = append(merged, d.sorted[i])
+			i++
+		} else {
+			merged = append(merged, d.unsorted[j])
+			j++
+		}
+	}
+	merged = append(merged, d.sorted[i:]...)
+	merged = append(merged, d.unsorted[j:]...)
+
+	d.sorted = merged
+	d.unsorted = nil
+}
+
+func distinctTrigrams(term string) []string {
+	padded := []rune(trigramPadding + term + trigramPadding)
+
+	seen := map[string]struct{}{}
+	trigrams := make([]string, 0, len(padded)-2)
+	for i := 0; i+3 <= len(padded); i++ {
+		trigram := string(padded[i : i+3])
+		if _, ok := seen[trigram]; ok {
+			continue
+		}
+		seen[trigram] = struct{}{}
+		trigrams = append(trigrams, trigram)
+	}
+	return trigrams
+}
+
+// editDistance is the optimal string alignment distance of a and b, i.e. the
+// number of insertions, deletions, substitutions and transpositions of
+// adjacent characters turning a into b. It returns false as soon as the
+// distance is known to exceed max.
+func editDistance(a, b []rune, max int) (int, bool) {
+	if diff := len(a) - len(b); diff > max || -diff > max {
+		return 0, false
+	}
+
+	prevPrev := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = minInt(curr[j], prevPrev[j-2]+1)
+			}
+			rowMin = minInt(rowMin, curr[j])
+		}
+		if rowMin > max {
+			return 0, false
+		}
+		prevPrev, prev, curr = prev, curr, prevPrev
+	}
+
+	if prev[len(b)] > max {
+		return 0, false
+	}
+	return prev[len(b)], true
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
+
+// bucketTerms looks up the terms of a property by scanning its searchable
+// bucket. It is used for properties with too many distinct terms to hold
+// them in a dictionary.
+type bucketTerms struct {
+	bucket *lsmkv.Bucket
+}
+
+func (b bucketTerms) Prefix(prefix string, limit int) []TermMatch {
+	c := b.bucket.MapCursorKeyOnly()
+	defer c.Close()
+
+	var matches []TermMatch
+	for k, _ := c.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, _ = c.Next() {
+		matches = append(matches, TermMatch{Term: string(k)})
+	}
+	return shortestMatches(matches, limit)
+}
+
+func (b bucketTerms) Fuzzy(term string, maxDistance, limit int) []TermMatch {
+	c := b.bucket.MapCursorKeyOnly()
+	defer c.Close()
+
+	query := []rune(term)
+	var matches []TermMatch
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		candidate := string(k)
+		if distance, ok := editDistance(query, []rune(candidate), maxDistance); ok {
+			matches = append(matches, TermMatch{Term: candidate, Distance: distance})
+		}
+	}
+	return closestMatches(matches, limit)
+}
+
+// TermDictionaries are the term dictionaries of the searchable properties of
+// a shard. A dictionary is built from the searchable bucket of its property
+// the first time it is needed and kept up to date with the terms written to
+// the bucket afterwards. It is built again if the bucket has been replaced
+// since, e.g. by reindexing the property.
+//
+// Properties with more distinct terms than maxTerms have no dictionary, their
+// terms are looked up in the bucket.
+type TermDictionaries struct {
+	sync.Mutex
+	store    *lsmkv.Store
+	dicts    map[string]*lazyTermDictionary
+	maxTerms int
+}
+
+type lazyTermDictionary struct {
+	dict   *TermDictionary
+	bucket *lsmkv.Bucket // the bucket the dictionary is built from
+	once   sync.Once
+	err    error
+}
+
+func NewTermDictionaries(store *lsmkv.Store) *TermDictionaries {
+	return &TermDictionaries{
+		store:    store,
+		dicts:    map[string]*lazyTermDictionary{},
+		maxTerms: defaultMaxDictionaryTerms,
+	}
+}
+
+// Get returns the term lookup of property propName, building its dictionary
+// first if needed
+func (d *TermDictionaries) Get(propName string) (TermLookup, error) {
+	bucket := d.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return nil, fmt.Errorf("could not find bucket for property %v", propName)
+	}
+
+	d.Lock()
+	lazy, ok := d.dicts[propName]
+	if !ok || lazy.bucket != bucket {
+		// registered before it is built, so that terms written in the
+		// meantime are not missed
+		dict := NewTermDictionary()
+		dict.maxTerms = d.maxTerms
+		lazy = &lazyTermDictionary{dict: dict, bucket: bucket}
+		d.dicts[propName] = lazy
+	}
+	d.Unlock()
+
+	lazy.once.Do(func() {
+		lazy.err = d.load(lazy)
+	})
+	if lazy.err != nil {
+		d.Lock()
+		if d.dicts[propName] == lazy {
+			delete(d.dicts, propName)
+		}
+		d.Unlock()
+		return nil, lazy.err
+	}
+	if lazy.dict.Full() {
+		return bucketTerms{bucket: bucket}, nil
+	}
+	return lazy.dict, nil
+}
+
+// Add adds terms written to the searchable bucket of property propName to its
+// dictionary. Dictionaries not built yet are skipped, they will read the
+// terms from the bucket.
+func (d *TermDictionaries) Add(propName string, terms ...string) {
+	d.Lock()
+	lazy, ok := d.dicts[propName]
+	d.Unlock()
+	if !ok {
+		return
+	}
+
+	lazy.dict.Lock()
+	defer lazy.dict.Unlock()
+	for _, term := range terms {
+		lazy.dict.add(term)
+	}
+}
+
+func (d *TermDictionaries) load(lazy *lazyTermDictionary) error {
+	// terms are only read up to the limit, a larger dictionary is not built
+	var terms []string
+	full := false
+	c := lazy.bucket.MapCursorKeyOnly()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if d.maxTerms > 0 && len(terms) >= d.maxTerms {
+			full = true
+			break
+		}
+		terms = append(terms, string(k))
+	}
+	c.Close()
+
+	lazy.dict.Lock()
+	defer lazy.dict.Unlock()
+	if full {
+		lazy.dict.release()
+		return nil
+	}
+	for _, term := range terms {
+		lazy.dict.add(term)
+	}
+	return nil
+}
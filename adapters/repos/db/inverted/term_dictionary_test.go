@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTermDictionary(t *testing.T) {
+	dict := NewTermDictionary()
+	for _, term := range []string{
+		"shoe", "shoes", "shop", "shopping", "show", "short", "sock",
+		"socks", "a", "at", "car", "cart", "carts", "café", "shoe",
+	} {
+		dict.Add(term)
+	}
+	assert.Equal(t, 14, dict.Len())
+
+	t.Run("prefix", func(t *testing.T) {
+		assert.Equal(t, []TermMatch{
+			{Term: "shoe"}, {Term: "shop"}, {Term: "show"},
+			{Term: "shoes"}, {Term: "short"}, {Term: "shopping"},
+		}, dict.Prefix("sho", 10))
+		assert.Equal(t, []TermMatch{{Term: "shoe"}, {Term: "shop"}}, dict.Prefix("sho", 2))
+		assert.Equal(t, []TermMatch{{Term: "car"}, {Term: "café"}, {Term: "cart"}, {Term: "carts"}},
+			dict.Prefix("ca", 10))
+		assert.Empty(t, dict.Prefix("x", 10))
+	})
+
+	t.Run("prefix with terms added after lookups", func(t *testing.T) {
+		dict.Add("shoelace")
+		assert.Equal(t, []TermMatch{{Term: "shoe"}, {Term: "shoes"}, {Term: "shoelace"}},
+			dict.Prefix("shoe", 10))
+	})
+
+	t.Run("fuzzy", func(t *testing.T) {
+		assert.Equal(t, []TermMatch{
+			{Term: "shoes", Distance: 0},
+			{Term: "shoe", Distance: 1},
+		}, dict.Fuzzy("shoes", 1, 10))
+		assert.Equal(t, []TermMatch{
+			{Term: "shoe", Distance: 1},
+			{Term: "shop", Distance: 1},
+			{Term: "show", Distance: 1},
+		}, dict.Fuzzy("shoo", 1, 10))
+		assert.Equal(t, []TermMatch{{Term: "shoe", Distance: 1}}, dict.Fuzzy("shoo", 1, 1))
+		assert.Equal(t, []TermMatch{{Term: "shopping", Distance: 1}}, dict.Fuzzy("shoppnig", 1, 10))
+		assert.Equal(t, []TermMatch{{Term: "café", Distance: 1}}, dict.Fuzzy("cafe", 1, 10))
+		assert.Equal(t, []TermMatch{
+			{Term: "socks", Distance: 1},
+			{Term: "shoes", Distance: 2},
+			{Term: "sock", Distance: 2},
+		}, dict.Fuzzy("scoks", 2, 10))
+		assert.Equal(t, []TermMatch{
+			{Term: "a", Distance: 1},
+			{Term: "at", Distance: 1},
+		}, dict.Fuzzy("t", 1, 10))
+	})
+}
+
+func TestTermDictionaryLimit(t *testing.T) {
+	dict := NewTermDictionary()
+	dict.maxTerms = 2
+	dict.Add("shoe")
+	dict.Add("shop")
+	dict.Add("shoe")
+	assert.False(t, dict.Full())
+
+	dict.Add("show")
+	assert.True(t, dict.Full())
+	assert.Equal(t, 0, dict.Len())
+}
+
+func TestTermDictionaries(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	createBucket := func(t *testing.T, name string, terms ...string) {
+		require.Nil(t, store.CreateOrLoadBucket(ctx, name,
+			lsmkv.WithStrategy(lsmkv.StrategyMapCollection)))
+		for i, term := range terms {
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, uint64(i))
+			require.Nil(t, store.Bucket(name).MapSet([]byte(term),
+				lsmkv.MapPair{Key: key, Value: make([]byte, 8)}))
+		}
+	}
+	bucketName := helpers.BucketSearchableFromPropNameLSM("text")
+	createBucket(t, bucketName, "shoe", "shop", "show")
+
+	dicts := NewTermDictionaries(store)
+
+	t.Run("built from the bucket", func(t *testing.T) {
+		lookup, err := dicts.Get("text")
+		require.Nil(t, err)
+		require.IsType(t, &TermDictionary{}, lookup)
+		assert.Len(t, lookup.Prefix("sho", 10), 3)
+
+		dicts.Add("text", "shore")
+		assert.Len(t, lookup.Prefix("sho", 10), 4)
+	})
+
+	t.Run("rebuilt once the bucket is replaced", func(t *testing.T) {
+		createBucket(t, bucketName+"_reindex", "sock", "socks")
+		require.Nil(t, store.ReplaceBuckets(ctx, bucketName, bucketName+"_reindex"))
+
+		lookup, err := dicts.Get("text")
+		require.Nil(t, err)
+		assert.Empty(t, lookup.Prefix("sho", 10))
+		assert.Equal(t, []TermMatch{{Term: "sock"}, {Term: "socks"}}, lookup.Prefix("so", 10))
+	})
+
+	t.Run("too many terms are looked up in the bucket", func(t *testing.T) {
+		dicts := NewTermDictionaries(store)
+		dicts.maxTerms = 1
+
+		lookup, err := dicts.Get("text")
+		require.Nil(t, err)
+		require.IsType(t, bucketTerms{}, lookup)
+		assert.Equal(t, []TermMatch{{Term: "sock"}, {Term: "socks"}}, lookup.Prefix("so", 10))
+		assert.Equal(t, []TermMatch{{Term: "sock"}}, lookup.Prefix("so", 1))
+		assert.Equal(t, []TermMatch{{Term: "socks", Distance: 1}}, lookup.Fuzzy("sacks", 1, 10))
+	})
+
+	t.Run("missing bucket", func(t *testing.T) {
+		_, err := dicts.Get("missing")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		max      int
+		distance int
+		ok       bool
+	}{
+		{a: "", b: "", max: 0, distance: 0, ok: true},
+		{a: "", b: "ab", max: 2, distance: 2, ok: true},
+		{a: "shoe", b: "shoe", max: 0, distance: 0, ok: true},
+		{a: "shoe", b: "shoes", max: 1, distance: 1, ok: true},
+		{a: "shoe", b: "shoo", max: 1, distance: 1, ok: true},
+		{a: "shoe", b: "hsoe", max: 1, distance: 1, ok: true},
+		{a: "shoe", b: "hose", max: 2, distance: 2, ok: true},
+		{a: "shoe", b: "hose", max: 1, ok: false},
+		{a: "shoe", b: "shoelace", max: 2, ok: false},
+		{a: "ca", b: "abc", max: 3, distance: 3, ok: true},
+		{a: "café", b: "cafe", max: 1, distance: 1, ok: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+"/"+tc.b, func(t *testing.T) {
+			distance, ok := editDistance([]rune(tc.a), []rune(tc.b), tc.max)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.distance, distance)
+		})
+	}
+}
+
+func TestBM25PrefixQueryTerm(t *testing.T) {
+	classStopwords, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	require.Nil(t, err)
+
+	prop := &models.Property{
+		Tokenization: models.PropertyTokenizationWord,
+		TextAnalyzer: &models.TextAnalyzerConfig{ASCIIFold: true},
+	}
+
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{query: "red Runn", expected: "runn"},
+		{query: "red café", expected: "cafe"},
+		{query: "red shoes ", expected: ""},
+		{query: `red "running shoes"`, expected: ""},
+		{query: "shoes for th", expected: "th"},
+		{query: "shoes for the", expected: ""},
+		{query: "", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			rest, _ := splitQueryPhrases(tc.query)
+			term, err := prefixQueryTerm(prop, rest, classStopwords)
+			require.Nil(t, err)
+			assert.Equal(t, tc.expected, term)
+		})
+	}
+}
@@ -242,7 +242,7 @@ func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexa
 		Debug("starting populating indexes")
 
 	i := 0
-	if err := objectsBucket.IterateObjects(ctx, func(object *storobj.Object) error {
+	if err := objectsBucket.IterateObjects(ctx, r.class, func(object *storobj.Object) error {
 		// check context expired every 100k objects
 		if i%100_000 == 0 && i != 0 {
 			if err := r.checkContextExpired(ctx, "iterating through objects stopped due to context canceled"); err != nil {
@@ -289,11 +289,12 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 ) error {
 	reindexablePropValue := checker.isReindexable(property.Name, IndexTypePropValue)
 	reindexablePropSearchableValue := checker.isReindexable(property.Name, IndexTypePropSearchableValue)
+	reindexablePropSearchablePositions := checker.isReindexable(property.Name, IndexTypePropSearchablePositions)
 
-	if reindexablePropValue || reindexablePropSearchableValue {
+	if reindexablePropValue || reindexablePropSearchableValue || reindexablePropSearchablePositions {
 		schemaProp := checker.getSchemaProp(property.Name)
 
-		var bucketValue, bucketSearchableValue *lsmkv.Bucket
+		var bucketValue, bucketSearchableValue, bucketSearchablePositions *lsmkv.Bucket
 
 		if reindexablePropValue {
 			bucketValue = r.tempBucket(property.Name, IndexTypePropValue)
@@ -307,6 +308,12 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 				return fmt.Errorf("no bucket searchable for prop '%s' value found", property.Name)
 			}
 		}
+		if reindexablePropSearchablePositions {
+			bucketSearchablePositions = r.tempBucket(property.Name, IndexTypePropSearchablePositions)
+			if bucketSearchablePositions == nil {
+				return fmt.Errorf("no bucket searchable positions for prop '%s' found", property.Name)
+			}
+		}
 
 		propLen := float32(len(property.Items))
 		for _, item := range property.Items {
@@ -317,6 +324,12 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 				}
 			}
+			if reindexablePropSearchablePositions && inverted.HasSearchableIndex(schemaProp) {
+				pair := r.shard.pairPropertyWithPositions(docID, item.Positions)
+				if err := r.shard.addToPropertyMapBucket(bucketSearchablePositions, pair, key); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", property.Name)
+				}
+			}
 			if reindexablePropValue && inverted.HasFilterableIndex(schemaProp) {
 				if err := r.shard.addToPropertySetBucket(bucketValue, docID, key); err != nil {
 					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
@@ -413,6 +426,8 @@ func (r *ShardInvertedReindexer) bucketName(propName string, indexType PropertyI
 		return helpers.BucketFromPropNameLSM(propName)
 	case IndexTypePropSearchableValue:
 		return helpers.BucketSearchableFromPropNameLSM(propName)
+	case IndexTypePropSearchablePositions:
+		return helpers.BucketSearchablePositionsFromPropNameLSM(propName)
 	case IndexTypePropLength:
 		return helpers.BucketFromPropNameLengthLSM(propName)
 	case IndexTypePropNull:
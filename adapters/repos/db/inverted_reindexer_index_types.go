@@ -20,6 +20,7 @@ const (
 	IndexTypePropLength
 	IndexTypePropNull
 	IndexTypePropSearchableValue
+	IndexTypePropSearchablePositions
 )
 
 func isSupportedPropertyIndexType(indexType PropertyIndexType) bool {
@@ -27,7 +28,8 @@ func isSupportedPropertyIndexType(indexType PropertyIndexType) bool {
 	case IndexTypePropValue,
 		IndexTypePropLength,
 		IndexTypePropNull,
-		IndexTypePropSearchableValue:
+		IndexTypePropSearchableValue,
+		IndexTypePropSearchablePositions:
 		return true
 	default:
 		return false
@@ -48,7 +50,8 @@ func isIndexTypeSupportedByStrategy(indexType PropertyIndexType, strategy string
 		IndexTypePropNull,
 		IndexTypePropValue:
 		return lsmkv.IsExpectedStrategy(strategy, lsmkv.StrategySetCollection, lsmkv.StrategyRoaringSet)
-	case IndexTypePropSearchableValue:
+	case IndexTypePropSearchableValue,
+		IndexTypePropSearchablePositions:
 		return lsmkv.IsExpectedStrategy(strategy, lsmkv.StrategyMapCollection)
 	}
 	return false
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// ShardInvertedReindexTaskSearchablePositions indexes token positions of
+// searchable properties, which were created before positions were stored.
+// Phrase and proximity queries are not possible on those properties until
+// their positions are indexed.
+type ShardInvertedReindexTaskSearchablePositions struct{}
+
+func (t *ShardInvertedReindexTaskSearchablePositions) GetPropertiesToReindex(ctx context.Context,
+	shard *Shard,
+) ([]ReindexableProperty, error) {
+	reindexableProperties := []ReindexableProperty{}
+
+	bucketOptions := []lsmkv.BucketOption{
+		lsmkv.WithIdleThreshold(time.Duration(shard.index.Config.MemtablesFlushIdleAfter) * time.Second),
+	}
+	if shard.versioner.Version() < 2 {
+		bucketOptions = append(bucketOptions, lsmkv.WithLegacyMapSorting())
+	}
+
+	for name, bucket := range shard.store.GetBucketsByName() {
+		if bucket.Strategy() != lsmkv.StrategyMapCollection {
+			continue
+		}
+
+		propName, indexType := GetPropNameAndIndexTypeFromBucketName(name)
+		if indexType != IndexTypePropSearchableValue {
+			continue
+		}
+		if shard.store.Bucket(helpers.BucketSearchablePositionsFromPropNameLSM(propName)) != nil {
+			continue
+		}
+
+		reindexableProperties = append(reindexableProperties,
+			ReindexableProperty{
+				PropertyName:    propName,
+				IndexType:       IndexTypePropSearchablePositions,
+				DesiredStrategy: lsmkv.StrategyMapCollection,
+				NewIndex:        true,
+				BucketOptions:   bucketOptions,
+			},
+		)
+	}
+
+	return reindexableProperties, nil
+}
+
+func (t *ShardInvertedReindexTaskSearchablePositions) OnPostResumeStore(ctx context.Context, shard *Shard) error {
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -76,6 +77,9 @@ type Bucket struct {
 	monitorCount bool
 
 	pauseTimer *prometheus.Timer // Times the pause
+
+	// commitLogRetainer takes over the commit logs of flushed memtables
+	commitLogRetainer CommitLogRetainer
 }
 
 // NewBucket initializes a new bucket. It either loads the state from disk if
@@ -169,13 +173,18 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 	return b, nil
 }
 
-func (b *Bucket) IterateObjects(ctx context.Context, f func(object *storobj.Object) error) error {
+// IterateObjects calls f with every object of an objects bucket. The data
+// types of the properties are taken from the class, see
+// storobj.FromBinaryWithClass.
+func (b *Bucket) IterateObjects(ctx context.Context, class *models.Class,
+	f func(object *storobj.Object) error,
+) error {
 	i := 0
 	cursor := b.Cursor()
 	defer cursor.Close()
 
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
-		obj, err := storobj.FromBinary(v)
+		obj, err := storobj.FromBinaryWithClass(v, class)
 		if err != nil {
 			return fmt.Errorf("cannot unmarshal object %d, %v", i, err)
 		}
@@ -655,6 +664,7 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return err
 	}
+	mt.commitlog.retainer = b.commitLogRetainer
 
 	b.active = mt
 	return nil
@@ -75,6 +75,15 @@ func WithPread(with bool) BucketOption {
 	}
 }
 
+// WithCommitLogRetainer hands over the commit logs of flushed memtables to r
+// instead of deleting them
+func WithCommitLogRetainer(r CommitLogRetainer) BucketOption {
+	return func(b *Bucket) error {
+		b.commitLogRetainer = r
+		return nil
+	}
+}
+
 func WithDynamicMemtableSizing(
 	initialMB, maxMB, minActiveSeconds, maxActiveSeconds int,
 ) BucketOption {
@@ -14,6 +14,7 @@ package lsmkv
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
@@ -58,6 +59,10 @@ func TestBucket(t *testing.T) {
 				WithSecondaryIndices(1),
 			},
 		},
+		{
+			name: "bucketRetainsCommitLogs",
+			f:    bucketRetainsCommitLogs,
+		},
 	}
 	tests.run(ctx, t)
 }
@@ -144,6 +149,45 @@ func bucketReadsIntoMemory(ctx context.Context, t *testing.T, opts []BucketOptio
 	assert.Equal(t, []byte("world"), valueSecondary)
 }
 
+type fakeCommitLogRetainer struct {
+	dir      string
+	retained []string
+}
+
+func (r *fakeCommitLogRetainer) Retain(path string) (bool, error) {
+	dst := filepath.Join(r.dir, filepath.Base(path))
+	r.retained = append(r.retained, dst)
+	return true, os.Rename(path, dst)
+}
+
+func bucketRetainsCommitLogs(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	retainer := &fakeCommitLogRetainer{dir: t.TempDir()}
+
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		append(opts, WithCommitLogRetainer(retainer))...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	require.Nil(t, b.Put([]byte("hello"), []byte("world")))
+	walPath := b.active.commitlog.path
+	require.Nil(t, b.FlushAndSwitch())
+
+	_, err = os.Stat(walPath)
+	assert.True(t, os.IsNotExist(err))
+	require.Len(t, retainer.retained, 1)
+	info, err := os.Stat(retainer.retained[0])
+	require.Nil(t, err)
+	assert.Greater(t, info.Size(), int64(0))
+
+	value, err := b.Get([]byte("hello"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("world"), value)
+}
+
 func TestBucket_MemtableCountWithFlushing(t *testing.T) {
 	b := Bucket{
 		// by using an empty segment group for the disk portion, we can test the
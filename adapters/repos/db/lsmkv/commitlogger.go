@@ -30,6 +30,18 @@ type commitLogger struct {
 	// e.g. when recovering from an existing log, we do not want to write into a
 	// new log again
 	paused bool
+
+	// retainer takes over the log instead of it being deleted, if set
+	retainer CommitLogRetainer
+}
+
+// CommitLogRetainer takes over commit logs which are no longer needed, because
+// their content has been flushed to a disk segment. This allows archiving the
+// logs, e.g. for point-in-time recovery.
+type CommitLogRetainer interface {
+	// Retain takes over the commit log at path. It returns false if the log
+	// is not retained, in which case it is deleted.
+	Retain(path string) (bool, error)
 }
 
 type CommitType uint16
@@ -169,6 +181,11 @@ func (cl *commitLogger) unpause() {
 }
 
 func (cl *commitLogger) delete() error {
+	if cl.retainer != nil {
+		if retained, err := cl.retainer.Retain(cl.path); err != nil || retained {
+			return err
+		}
+	}
 	return os.Remove(cl.path)
 }
 
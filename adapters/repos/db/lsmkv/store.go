@@ -37,6 +37,9 @@ type Store struct {
 
 	cycleCallbacks *storeCycleCallbacks
 
+	// commitLogRetainer is passed on to all buckets created by the store
+	commitLogRetainer CommitLogRetainer
+
 	// Prevent concurrent manipulations to the bucketsByNameMap, most notably
 	// when initializing buckets in parallel
 	bucketAccessLock sync.RWMutex
@@ -96,6 +99,20 @@ func (s *Store) init() error {
 	return nil
 }
 
+// RetainCommitLogs makes all buckets which are created from now on hand over
+// the commit logs of flushed memtables to r instead of deleting them.
+func (s *Store) RetainCommitLogs(r CommitLogRetainer) {
+	s.commitLogRetainer = r
+}
+
+// bucketOptions adds the store wide options to the options of a bucket
+func (s *Store) bucketOptions(opts []BucketOption) []BucketOption {
+	if s.commitLogRetainer == nil {
+		return opts
+	}
+	return append(opts[:len(opts):len(opts)], WithCommitLogRetainer(s.commitLogRetainer))
+}
+
 func (s *Store) bucketDir(bucketName string) string {
 	return path.Join(s.dir, bucketName)
 }
@@ -119,7 +136,7 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -282,7 +299,7 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -338,6 +355,28 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 	return nil
 }
 
+// DropBucket shuts down the bucket with the given name and removes its files.
+// The bucket must not be used anymore once it is dropped.
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	bucket := s.bucketsByName[bucketName]
+	if bucket == nil {
+		return fmt.Errorf("bucket '%s' not found", bucketName)
+	}
+	delete(s.bucketsByName, bucketName)
+
+	if err := bucket.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+	}
+	if err := os.RemoveAll(bucket.dir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucket.dir)
+	}
+
+	return nil
+}
+
 func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName string) error {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
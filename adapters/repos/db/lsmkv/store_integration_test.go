@@ -93,6 +93,27 @@ func testStoreLifecycle(ctx context.Context, t *testing.T, opts []BucketOption)
 		require.Nil(t, err)
 		assert.Equal(t, []byte("bar"), res)
 
+		err = store.DropBucket(testCtx(), "bucket2")
+		require.Nil(t, err)
+		assert.Nil(t, store.Bucket("bucket2"))
+
+		err = store.Shutdown(context.Background())
+		require.Nil(t, err)
+	})
+
+	t.Run("cycle 3", func(t *testing.T) {
+		store, err := New(dirName, dirName, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+
+		err = store.CreateOrLoadBucket(testCtx(), "bucket2", opts...)
+		require.Nil(t, err)
+
+		// the dropped bucket starts out empty
+		res, err := store.Bucket("bucket2").Get([]byte("foo"))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
 		err = store.Shutdown(context.Background())
 		require.Nil(t, err)
 	})
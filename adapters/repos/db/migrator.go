@@ -19,11 +19,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -56,6 +59,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
 			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
 			AvoidMMap:                 m.db.config.AvoidMMap,
+			AsyncIndexing:             m.db.config.AsyncIndexing,
 			ReplicationFactor:         class.ReplicationConfig.Factor,
 		},
 		shardState,
@@ -63,11 +67,13 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 		// always have the field set
 		inverted.ConfigFromModel(class.InvertedIndexConfig),
 		class.VectorIndexConfig.(schema.VectorIndexConfig),
+		convertToVectorIndexConfigs(class.VectorConfig),
 		m.db.schemaGetter, m.db, m.logger, m.db.nodeResolver, m.db.remoteIndex,
 		m.db.replicaClient, m.db.promMetrics, class, m.db.jobQueueCh)
 	if err != nil {
 		return errors.Wrap(err, "create index")
 	}
+	idx.tenantActivity = m.db.tenantActivity
 
 	err = idx.addUUIDProperty(ctx)
 	if err != nil {
@@ -96,7 +102,23 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 }
 
 func (m *Migrator) DropClass(ctx context.Context, className string) error {
-	return m.db.DeleteIndex(schema.ClassName(className))
+	idx := m.db.GetIndex(schema.ClassName(className))
+	var frozen []string
+	if idx != nil && m.db.offloadBackend != nil {
+		if state := idx.getSchema.CopyShardingState(className); state != nil {
+			for _, name := range state.AllLocalPhysicalShards() {
+				if state.Physical[name].Status == models.TenantActivityStatusFROZEN {
+					frozen = append(frozen, name)
+				}
+			}
+		}
+	}
+
+	if err := m.db.DeleteIndex(schema.ClassName(className)); err != nil {
+		return err
+	}
+	m.deleteOffloadedShards(ctx, idx, frozen)
+	return nil
 }
 
 func (m *Migrator) UpdateClass(ctx context.Context, className string, newClassName *string) error {
@@ -200,7 +222,12 @@ func (m *Migrator) NewTenants(ctx context.Context, class *models.Class, creates
 }
 
 // UpdateTenans activates or deactivates tenant partitions and returns a commit func
-// that can be used to either commit or rollback the changes
+// that can be used to either commit or rollback the changes.
+//
+// Frozen partitions are uploaded to the tenant offload backend in the
+// background once the changes are committed. Partitions leaving FROZEN are
+// usually downloaded by PrepareUpdateTenants already, the ones that are not
+// are downloaded before the changes are applied.
 func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) (commit func(success bool), err error) {
 	idx := m.db.GetIndex(schema.ClassName(class.Class))
 	if idx == nil {
@@ -209,8 +236,12 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 
 	shardsToHot := make([]string, 0, len(updates))
 	shardsToCold := make([]string, 0, len(updates))
+	shardsToFreeze := make([]string, 0, len(updates))
+	shardsToUnfreeze := make([]string, 0, len(updates))
+	shardsUnfrozen := make([]string, 0, len(updates))
 	shardsHotted := make(map[string]*Shard)
 	shardsColded := make(map[string]*Shard)
+	shardsFrozen := make(map[string]*Shard) // nil for shards that were cold
 
 	rollbackHotted := func() {
 		eg := new(errgroup.Group)
@@ -233,9 +264,17 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 			idx.shards.CompareAndSwap(name, nil, shard)
 		}
 	}
+	rollbackFrozen := func() {
+		for name, shard := range shardsFrozen {
+			if shard != nil {
+				idx.shards.CompareAndSwap(name, nil, shard)
+			}
+		}
+	}
 	rollback := func() {
 		rollbackHotted()
 		rollbackColded()
+		rollbackFrozen()
 	}
 
 	commitHotted := func() {
@@ -263,6 +302,15 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 		}
 		eg.Wait()
 	}
+	commitFrozen := func() {
+		for name, shard := range shardsFrozen {
+			if shard != nil {
+				idx.shards.LoadAndDelete(name)
+			}
+			idx.offloads.Store(name, models.TenantActivityStatusFREEZING)
+			go m.freezeShard(idx, class, name, shard)
+		}
+	}
 	commit = func(success bool) {
 		if !success {
 			rollback()
@@ -270,6 +318,10 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 		}
 		commitHotted()
 		commitColded()
+		commitFrozen()
+		// the files of unfrozen shards are local again, their archives would
+		// only be left behind
+		m.deleteOffloadedShards(ctx, idx, shardsUnfrozen)
 	}
 
 	applyHot := func() error {
@@ -303,12 +355,47 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 		return nil
 	}
 
+	applyFreeze := func() error {
+		if len(shardsToFreeze) == 0 {
+			return nil
+		}
+		if m.db.offloadBackend == nil {
+			return fmt.Errorf("cannot freeze tenants: no tenant offload backend configured")
+		}
+
+		idx.backupMutex.RLock()
+		defer idx.backupMutex.RUnlock()
+
+		for _, name := range shardsToFreeze {
+			shard, ok := idx.shards.Swap(name, nil) // mark as deactivated
+			if !ok {                                // shard is cold
+				idx.shards.LoadAndDelete(name) // rollback nil value created by swap()
+			}
+			shardsFrozen[name] = shard
+		}
+		return nil
+	}
+
 	for _, tu := range updates {
+		if status, ok := idx.offloadStatus(tu.Name); ok {
+			return nil, fmt.Errorf("cannot update tenant '%s' while it is %s", tu.Name, status)
+		}
+
 		switch tu.Status {
 		case models.TenantActivityStatusHOT:
 			shardsToHot = append(shardsToHot, tu.Name)
 		case models.TenantActivityStatusCOLD:
 			shardsToCold = append(shardsToCold, tu.Name)
+		case models.TenantActivityStatusFROZEN:
+			shardsToFreeze = append(shardsToFreeze, tu.Name)
+			continue
+		}
+
+		if _, status := idx.getSchema.TenantShard(class.Class, tu.Name); status == models.TenantActivityStatusFROZEN {
+			shardsUnfrozen = append(shardsUnfrozen, tu.Name)
+			if idx.shardOffloaded(tu.Name) {
+				shardsToUnfreeze = append(shardsToUnfreeze, tu.Name)
+			}
 		}
 	}
 
@@ -318,16 +405,123 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 		}
 	}()
 
+	if err := m.unfreezeShards(ctx, idx, shardsToUnfreeze); err != nil {
+		return nil, err
+	}
 	if err := applyHot(); err != nil {
 		return nil, err
 	}
 	if err := applyCold(); err != nil {
 		return nil, err
 	}
+	if err := applyFreeze(); err != nil {
+		return nil, err
+	}
 
 	return commit, nil
 }
 
+// PrepareUpdateTenants downloads the local partitions leaving FROZEN. It runs
+// before the update is committed anywhere in the cluster, so that a failed
+// download aborts the update on all nodes instead of leaving the tenant
+// FROZEN on this node only. The downloaded partitions stay FROZEN until the
+// update is committed.
+func (m *Migrator) PrepareUpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) error {
+	idx := m.db.GetIndex(schema.ClassName(class.Class))
+	if idx == nil {
+		return fmt.Errorf("cannot find index for %q", class.Class)
+	}
+
+	shardsToUnfreeze := make([]string, 0, len(updates))
+	for _, tu := range updates {
+		if tu.Status == models.TenantActivityStatusFROZEN {
+			continue
+		}
+		if status, ok := idx.offloadStatus(tu.Name); ok {
+			return fmt.Errorf("cannot update tenant '%s' while it is %s", tu.Name, status)
+		}
+		if m.shardToUnfreeze(idx, class, tu.Name) {
+			shardsToUnfreeze = append(shardsToUnfreeze, tu.Name)
+		}
+	}
+	return m.unfreezeShards(ctx, idx, shardsToUnfreeze)
+}
+
+// shardToUnfreeze returns whether a shard is FROZEN and its files need to be
+// downloaded. The files of a frozen shard are missing locally if it was
+// uploaded successfully, otherwise they are still there and used as they are.
+func (m *Migrator) shardToUnfreeze(idx *Index, class *models.Class, name string) bool {
+	_, status := idx.getSchema.TenantShard(class.Class, name)
+	return status == models.TenantActivityStatusFROZEN && idx.shardOffloaded(name)
+}
+
+// unfreezeShards downloads the files of frozen shards from the tenant offload
+// backend. The shards are listed as UNFREEZING until they are downloaded.
+func (m *Migrator) unfreezeShards(ctx context.Context, idx *Index, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	if m.db.offloadBackend == nil {
+		return fmt.Errorf("cannot unfreeze tenants: no tenant offload backend configured")
+	}
+
+	eg := new(errgroup.Group)
+	eg.SetLimit(_NUMCPU)
+	for _, name := range names {
+		name := name
+		eg.Go(func() error {
+			idx.offloads.Store(name, models.TenantActivityStatusUNFREEZING)
+			defer idx.offloads.Delete(name)
+
+			if err := idx.downloadShard(ctx, m.db.offloadBackend, name); err != nil {
+				return fmt.Errorf("cannot unfreeze shard '%s': %w", name, err)
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// freezeShard uploads a frozen shard to the tenant offload backend, a cold
+// shard is loaded for it first. The shard is listed as FREEZING until it is
+// done.
+func (m *Migrator) freezeShard(idx *Index, class *models.Class, name string, shard *Shard) {
+	defer idx.offloads.Delete(name)
+
+	ctx := context.Background()
+	if shard == nil {
+		var err error
+		if shard, err = NewShard(ctx, m.db.promMetrics, name, idx, class, idx.centralJobQueue); err != nil {
+			idx.logger.WithField("action", "freeze_shard").
+				WithField("shard", name).
+				Errorf("cannot load shard %q: %s", name, err)
+			return
+		}
+	}
+
+	if err := idx.freezeShard(ctx, m.db.offloadBackend, shard); err != nil {
+		idx.logger.WithField("action", "freeze_shard").
+			WithField("shard", shard.ID()).
+			Errorf("cannot freeze shard %q, its files are kept locally: %s", name, err)
+	}
+}
+
+// TenantTransitions returns the tenants of a class that are being frozen or
+// unfrozen on this node together with their transitional activity status
+func (m *Migrator) TenantTransitions(className string) map[string]string {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil
+	}
+
+	transitions := make(map[string]string)
+	idx.offloads.Range(func(name, status interface{}) bool {
+		transitions[name.(string)] = status.(string)
+		return true
+	})
+	return transitions
+}
+
 // DeleteTenants deletes tenants and returns a commit func
 // that can be used to either commit or rollback deletion
 func (m *Migrator) DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error) {
@@ -335,7 +529,69 @@ func (m *Migrator) DeleteTenants(ctx context.Context, class *models.Class, tenan
 	if idx == nil {
 		return func(bool) {}, nil
 	}
-	return idx.dropShards(tenants)
+
+	var frozen []string
+	if m.db.offloadBackend != nil {
+		for _, name := range tenants {
+			if _, status := idx.getSchema.TenantShard(class.Class, name); status == models.TenantActivityStatusFROZEN {
+				frozen = append(frozen, name)
+			}
+		}
+	}
+
+	drop, err := idx.dropShards(tenants)
+	return func(success bool) {
+		drop(success)
+		if success {
+			m.deleteOffloadedShards(ctx, idx, frozen)
+		}
+	}, err
+}
+
+// deleteOffloadedShards removes the archives of deleted frozen shards from the
+// tenant offload backend. Failures are only logged, the shards are gone
+// already.
+func (m *Migrator) deleteOffloadedShards(ctx context.Context, idx *Index, names []string) {
+	if m.db.offloadBackend == nil {
+		return
+	}
+	for _, name := range names {
+		if err := idx.deleteOffloadedShard(ctx, m.db.offloadBackend, name); err != nil {
+			idx.logger.WithField("action", "delete_offloaded_shard").
+				WithField("shard", name).
+				Errorf("cannot delete archive of frozen shard %q: %s", name, err)
+		}
+	}
+}
+
+// UpdateShardingState applies a replaced sharding state of a class to the
+// local shards in the background: objects are moved to the shards they
+// belong to and shards no longer owned by this node are dropped. A reshard
+// interrupted by a restart is resumed when the index is loaded again.
+func (m *Migrator) UpdateShardingState(ctx context.Context, className string,
+	state *sharding.State,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update sharding state of non-existing index for %s", className)
+	}
+	if state.PartitioningEnabled {
+		return nil
+	}
+
+	// the marker makes sure the shards are resharded after a restart, even
+	// if they are not part of the state anymore
+	if err := idx.markReshard(); err != nil {
+		return errors.Wrapf(err, "mark reshard of %s", className)
+	}
+	idx.trackMerges(state)
+	go func() {
+		if err := idx.reshard(context.Background(), state); err != nil {
+			m.logger.WithField("action", "reshard").WithField("class", className).
+				Errorf("apply sharding state: %v", err)
+		}
+	}()
+	return nil
 }
 
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
@@ -353,13 +609,39 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 	return idx.updateVectorIndexConfig(ctx, updated)
 }
 
+func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
+	className string, updated map[string]schema.VectorIndexConfig,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update vector config of non-existing index for %s", className)
+	}
+
+	return idx.updateVectorIndexConfigs(ctx, updated)
+}
+
 func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	old, updated schema.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
-	return hnsw.ValidateUserConfigUpdate(old, updated)
+	return validateVectorIndexConfigUpdate(old, updated)
+}
+
+func validateVectorIndexConfigUpdate(old, updated schema.VectorIndexConfig) error {
+	if old.IndexType() != updated.IndexType() {
+		return errors.Errorf("vector index type is immutable: attempted change from %q to %q",
+			old.IndexType(), updated.IndexType())
+	}
+
+	switch old.IndexType() {
+	case vectorindex.VectorIndexTypeHNSW:
+		return hnsw.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeFLAT:
+		return flat.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeDYNAMIC:
+		return dynamic.ValidateUserConfigUpdate(old, updated)
+	default:
+		return errors.Errorf("unsupported vector index type %q", old.IndexType())
+	}
 }
 
 func (m *Migrator) ValidateInvertedIndexConfigUpdate(ctx context.Context,
@@ -479,6 +761,9 @@ func (m *Migrator) doInvertedReindex(ctx context.Context, taskNames ...string) e
 		"ShardInvertedReindexTaskSetToRoaringSet": func() ShardInvertedReindexTask {
 			return &ShardInvertedReindexTaskSetToRoaringSet{}
 		},
+		"ShardInvertedReindexTaskSearchablePositions": func() ShardInvertedReindexTask {
+			return &ShardInvertedReindexTaskSearchablePositions{}
+		},
 	}
 
 	tasks := map[string]ShardInvertedReindexTask{}
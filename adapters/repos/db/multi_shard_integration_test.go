@@ -77,6 +77,8 @@ func Test_MultiShardJourneys_IndividualImports(t *testing.T) {
 
 	t.Run("verify refs", makeTestRetrieveRefClass(repo, data, refData))
 
+	t.Run("export", makeTestExportClass(repo, data))
+
 	t.Run("batch delete", makeTestBatchDeleteAllObjects(repo))
 }
 
@@ -149,6 +151,8 @@ func Test_MultiShardJourneys_BatchedImports(t *testing.T) {
 
 	t.Run("verify refs", makeTestRetrieveRefClass(repo, data, refData))
 
+	t.Run("export", makeTestExportClass(repo, data))
+
 	t.Run("batch delete", makeTestBatchDeleteAllObjects(repo))
 }
 
@@ -673,6 +677,41 @@ func testNodesAPI(repo *DB) func(t *testing.T) {
 	}
 }
 
+func makeTestExportClass(repo *DB, data []*models.Object) func(t *testing.T) {
+	return func(t *testing.T) {
+		shards, err := repo.ExportShards(context.Background(), "TestClass", "")
+		require.Nil(t, err)
+		require.Len(t, shards, 3)
+		assert.True(t, sort.StringsAreSorted(shards))
+
+		exported := map[strfmt.UUID][]float32{}
+		for _, shard := range shards {
+			var after strfmt.UUID
+			for {
+				res, err := repo.ExportObjects(context.Background(), "TestClass", shard,
+					after, 3, "")
+				require.Nil(t, err)
+				for _, obj := range res {
+					require.NotContains(t, exported, obj.ID, "object exported twice")
+					exported[obj.ID] = obj.Vector
+				}
+				if len(res) < 3 {
+					break
+				}
+				after = res[len(res)-1].ID
+			}
+		}
+
+		require.Len(t, exported, len(data))
+		for _, obj := range data {
+			assert.Equal(t, []float32(obj.Vector), exported[obj.ID])
+		}
+
+		_, err = repo.ExportShards(context.Background(), "NoSuchClass", "")
+		assert.NotNil(t, err)
+	}
+}
+
 func makeTestBatchDeleteAllObjects(repo *DB) func(t *testing.T) {
 	return func(t *testing.T) {
 		performDelete := func(t *testing.T, className string) {
@@ -136,9 +136,10 @@ func (i *Index) getShardsNodeStatus(status *[]*models.NodeShardStatus) (totalCou
 	i.ForEachShard(func(name string, shard *Shard) error {
 		objectCount := int64(shard.objectCount())
 		shardStatus := &models.NodeShardStatus{
-			Name:        name,
-			Class:       shard.index.Config.ClassName.String(),
-			ObjectCount: objectCount,
+			Name:              name,
+			Class:             shard.index.Config.ClassName.String(),
+			ObjectCount:       objectCount,
+			VectorQueueLength: shard.vectorQueueLength(),
 		}
 		totalCount += objectCount
 		*status = append(*status, shardStatus)
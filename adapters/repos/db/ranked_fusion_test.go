@@ -872,7 +872,7 @@ func (f *fakeObjectSearcher) SparseObjectSearch(ctx context.Context, params dto.
 	return out[:lim], []float32{0.008, 0.001}[:lim], nil
 }
 
-func (f *fakeObjectSearcher) DenseObjectSearch(ctx context.Context, class string, vector []float32, offset int, limit int, filters *filters.LocalFilter, additinal additional.Properties, tenant string) ([]*storobj.Object, []float32, error) {
+func (f *fakeObjectSearcher) DenseObjectSearch(ctx context.Context, class string, vector []float32, targetVector string, offset int, limit int, filters *filters.LocalFilter, additinal additional.Properties, tenant string) ([]*storobj.Object, []float32, error) {
 	out := []*storobj.Object{
 		{
 			Object: models.Object{
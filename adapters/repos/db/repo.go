@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -74,6 +75,12 @@ type DB struct {
 	maxNumberGoroutines int
 	batchMonitorLock    sync.Mutex
 	ratePerSecond       int
+
+	// offloadBackend stores the shards of frozen tenants, tenants can't be
+	// frozen if it is not set
+	offloadBackend modulecapabilities.BackupBackend
+
+	tenantActivity *tenantActivity
 }
 
 func (db *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
@@ -88,6 +95,7 @@ func (db *DB) WaitForStartup(ctx context.Context) error {
 
 	db.startupComplete.Store(true)
 	db.scanResourceUsage()
+	db.observeTenantActivity()
 
 	return nil
 }
@@ -113,6 +121,7 @@ func New(logger logrus.FieldLogger, config Config,
 		maxNumberGoroutines: int(math.Round(config.MaxImportGoroutinesFactor * float64(runtime.GOMAXPROCS(0)))),
 		resourceScanState:   newResourceScanState(),
 	}
+	db.tenantActivity = &tenantActivity{db: db, maxActive: config.MaximumActiveTenants}
 	if db.maxNumberGoroutines == 0 {
 		return db, errors.New("no workers to add batch-jobs configured.")
 	}
@@ -140,7 +149,11 @@ type Config struct {
 	ServerVersion             string
 	GitHash                   string
 	AvoidMMap                 bool
+	AsyncIndexing             bool
 	Replication               replication.GlobalConfig
+	// MaximumActiveTenants limits the tenants with automatic activation that
+	// are active on this node, 0 means no limit
+	MaximumActiveTenants int
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
@@ -205,6 +218,10 @@ func (db *DB) DeleteIndex(className schema.ClassName) error {
 
 func (db *DB) Shutdown(ctx context.Context) error {
 	db.shutdown <- struct{}{}
+	if db.tenantActivity.stop != nil {
+		close(db.tenantActivity.stop)
+		db.tenantActivity.stop = nil
+	}
 
 	// shut down the workers that add objects to
 	for i := 0; i < db.maxNumberGoroutines; i++ {
@@ -203,3 +203,26 @@ func (d *DB) setShardsReadOnly() {
 	d.indexLock.Unlock()
 	d.resourceScanState.isReadOnly = true
 }
+
+// ResourceUsageExceeded returns an error if the memory or disk usage is above
+// the readonly thresholds. Unlike the periodic scan it does not set the shards
+// to READONLY, it only allows imports to back off.
+func (d *DB) ResourceUsageExceeded() error {
+	if diskROPercent := d.config.ResourceUsage.DiskUse.ReadOnlyPercentage; diskROPercent > 0 {
+		du := d.getDiskUse(d.config.RootPath)
+		if pu := du.percentUsed(); pu > float64(diskROPercent) {
+			return fmt.Errorf("disk usage currently at %.2f%%, readonly threshold set to %.2f%%",
+				pu, float64(diskROPercent))
+		}
+	}
+
+	if memROPercent := d.config.ResourceUsage.MemUse.ReadOnlyPercentage; memROPercent > 0 {
+		mon := memwatch.NewMonitor(runtime.MemProfile, debug.SetMemoryLimit, runtime.MemProfileRate)
+		if pu := mon.Ratio() * 100; pu > float64(memROPercent) {
+			return fmt.Errorf("memory usage currently at %.2f%%, readonly threshold set to %.2f%%",
+				pu, float64(memROPercent))
+		}
+	}
+
+	return nil
+}
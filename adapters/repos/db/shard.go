@@ -27,6 +27,8 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
@@ -35,6 +37,10 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/sync/errgroup"
@@ -51,12 +57,15 @@ type Shard struct {
 	store           *lsmkv.Store
 	counter         *indexcounter.Counter
 	vectorIndex     VectorIndex
+	vectorIndexes   map[string]VectorIndex // named vectors, keyed by target vector
 	metrics         *Metrics
 	promMetrics     *monitoring.PrometheusMetrics
 	propertyIndices propertyspecific.Indices
 	deletedDocIDs   *docid.InMemDeletedTracker
 	propLengths     *inverted.JsonPropertyLengthTracker
 	versioner       *shardVersioner
+	// termDictionaries expand fuzzy and prefix terms of bm25 queries
+	termDictionaries *inverted.TermDictionaries
 
 	status              storagestate.Status
 	statusLock          sync.Mutex
@@ -81,6 +90,9 @@ type Shard struct {
 	fallbackToSearchable bool
 
 	cycleCallbacks *shardCycleCallbacks
+
+	// walRetainer keeps commit logs while they are archived
+	walRetainer *walRetainer
 }
 
 func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
@@ -100,53 +112,226 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		centralJobQueue: jobQueueCh,
 	}
 	s.initCycleCallbacks()
+	s.walRetainer = newWALRetainer(index.Config.RootPath, s.ID(), &index.retainWAL)
+	if !index.retainWAL.Load() {
+		// logs retained for an archive which has stopped are of no use
+		if err := s.walRetainer.clear(); err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: clear retained commit logs", s.ID())
+		}
+	}
 
 	s.docIdLock = make([]sync.Mutex, IdLockPoolSize)
 
 	defer s.metrics.ShardStartup(before)
 
-	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			index.vectorIndexUserConfig)
+	// the LSM store is initialized first, as vector indexes such as the flat
+	// index persist their vectors in buckets of the shard's store
+	if err := s.initNonVector(ctx, class); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
-			return nil, fmt.Errorf("init vector index: %w", err)
+	if err := s.initVectorIndexes(ctx); err != nil {
+		return nil, fmt.Errorf("init vector index: %w", err)
+	}
+	defer s.postStartupVectorIndexes()
+
+	return s, nil
+}
+
+// initVectorIndexes sets up the vector indexes of the shard. Classes with
+// named vectors get one index per target vector, the class-level index is
+// not used for those classes and set to a noop index.
+func (s *Shard) initVectorIndexes(ctx context.Context) error {
+	if len(s.index.vectorIndexUserConfigs) == 0 {
+		vi, err := s.initVectorIndex(ctx, s.ID(), "", s.index.vectorIndexUserConfig,
+			s.vectorByIndexID, s.readVectorByIndexIDIntoSlice)
+		if err != nil {
+			return err
+		}
+		s.vectorIndex = vi
+		return nil
+	}
+
+	s.vectorIndex = noop.NewIndex()
+	s.vectorIndexes = make(map[string]VectorIndex, len(s.index.vectorIndexUserConfigs))
+	for targetVector, cfg := range s.index.vectorIndexUserConfigs {
+		targetVector := targetVector
+		vectorForID := func(ctx context.Context, indexID uint64) ([]float32, error) {
+			return s.readTargetVectorByIndexIDIntoSlice(ctx, indexID,
+				&hnsw.VectorSlice{Buff8: make([]byte, 8)}, targetVector)
+		}
+		tempVectorForID := func(ctx context.Context, indexID uint64,
+			container *hnsw.VectorSlice,
+		) ([]float32, error) {
+			return s.readTargetVectorByIndexIDIntoSlice(ctx, indexID, container, targetVector)
+		}
+
+		vi, err := s.initVectorIndex(ctx, s.vectorIndexID(targetVector), targetVector, cfg,
+			vectorForID, tempVectorForID)
+		if err != nil {
+			return errors.Wrapf(err, "target vector %q", targetVector)
 		}
+		s.vectorIndexes[targetVector] = vi
+	}
+
+	return nil
+}
 
-		defer s.vectorIndex.PostStartup()
+// vectorIndexID is the id of the vector index of the given target vector. It
+// is used to name the index files on disk.
+func (s *Shard) vectorIndexID(targetVector string) string {
+	return s.ID() + "_vectors_" + targetVector
+}
+
+func (s *Shard) postStartupVectorIndexes() {
+	s.vectorIndex.PostStartup()
+	for _, vi := range s.vectorIndexes {
+		vi.PostStartup()
 	}
+}
 
-	if err := s.initNonVector(ctx, class); err != nil {
-		return nil, errors.Wrapf(err, "init shard %q", s.ID())
+// getVectorIndex returns the index serving the given target vector. An empty
+// target vector refers to the class-level vector index.
+func (s *Shard) getVectorIndex(targetVector string) (VectorIndex, error) {
+	if targetVector == "" {
+		return s.vectorIndex, nil
 	}
 
-	return s, nil
+	vi, ok := s.vectorIndexes[targetVector]
+	if !ok {
+		return nil, errors.Errorf("vector index for target vector %q not found", targetVector)
+	}
+	return vi, nil
 }
 
-func (s *Shard) initVectorIndex(
-	ctx context.Context, hnswUserConfig hnswent.UserConfig,
-) error {
-	var distProv distancer.Provider
-
-	switch hnswUserConfig.Distance {
-	case "", hnswent.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
-	case hnswent.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
-	case hnswent.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
-	case hnswent.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
-	case hnswent.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
+// forEachVectorIndex calls f for the class-level vector index as well as for
+// the index of every named vector. For the class-level index the target
+// vector is empty.
+func (s *Shard) forEachVectorIndex(f func(targetVector string, index VectorIndex) error) error {
+	if err := f("", s.vectorIndex); err != nil {
+		return err
+	}
+	for targetVector, vi := range s.vectorIndexes {
+		if err := f(targetVector, vi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushVectorIndexes flushes the buffered commit logs of all vector indexes
+func (s *Shard) flushVectorIndexes() error {
+	return s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return index.Flush()
+	})
+}
+
+// deleteFromVectorIndexes removes the doc ids from all vector indexes
+func (s *Shard) deleteFromVectorIndexes(docIDs ...uint64) error {
+	return s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return index.Delete(docIDs...)
+	})
+}
+
+func (s *Shard) initVectorIndex(ctx context.Context, id, targetVector string,
+	userConfig schema.VectorIndexConfig, vectorForID hnsw.VectorForID,
+	tempVectorForID hnsw.TempVectorForID,
+) (VectorIndex, error) {
+	var (
+		vi  VectorIndex
+		err error
+	)
+	switch userConfig.IndexType() {
+	case vectorindex.VectorIndexTypeHNSW:
+		vi, err = s.initHnswIndex(ctx, id, targetVector, userConfig, vectorForID, tempVectorForID)
+	case vectorindex.VectorIndexTypeFLAT:
+		vi, err = s.initFlatIndex(ctx, id, targetVector, userConfig)
+	case vectorindex.VectorIndexTypeDYNAMIC:
+		vi, err = s.initDynamicIndex(ctx, id, targetVector, userConfig, vectorForID, tempVectorForID)
 	default:
-		return errors.Errorf("unrecognized distance metric %q,"+
-			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", hnswUserConfig.Distance)
+		return nil, errors.Errorf("unsupported vector index type %q", userConfig.IndexType())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.index.Config.AsyncIndexing {
+		return vi, nil
+	}
+	if _, ok := vi.(*noop.Index); ok {
+		return vi, nil
+	}
+
+	distProv, err := distancerProvider(userConfig.DistanceName())
+	if err != nil {
+		return nil, err
+	}
+	q, err := NewIndexQueue(ctx, vi, s.store, targetVector, distProv,
+		s.index.logger, s.index.Config.AvoidMMap)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: index queue", s.ID())
+	}
+
+	return q, nil
+}
+
+// vectorQueueLength is the number of vectors which are not indexed yet
+func (s *Shard) vectorQueueLength() int64 {
+	var length int64
+	s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		if q, ok := index.(*IndexQueue); ok {
+			length += q.Size()
+		}
+		return nil
+	})
+	return length
+}
+
+func (s *Shard) initFlatIndex(ctx context.Context, id, targetVector string,
+	userConfig schema.VectorIndexConfig,
+) (VectorIndex, error) {
+	flatUserConfig, ok := userConfig.(flatent.UserConfig)
+	if !ok {
+		return nil, errors.Errorf("flat vector index: config is not flat.UserConfig: %T",
+			userConfig)
+	}
+
+	distProv, err := distancerProvider(flatUserConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	vi, err := flat.New(flat.Config{
+		ID:               id,
+		TargetVector:     targetVector,
+		Logger:           s.index.logger,
+		DistanceProvider: distProv,
+		AvoidMMap:        s.index.Config.AvoidMMap,
+	}, flatUserConfig, s.store)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: flat index", s.ID())
+	}
+
+	return vi, nil
+}
+
+func (s *Shard) initHnswIndex(ctx context.Context, id, targetVector string,
+	userConfig schema.VectorIndexConfig, vectorForID hnsw.VectorForID,
+	tempVectorForID hnsw.TempVectorForID,
+) (VectorIndex, error) {
+	hnswUserConfig, ok := userConfig.(hnswent.UserConfig)
+	if !ok {
+		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
+			userConfig)
+	}
+
+	if hnswUserConfig.Skip {
+		return noop.NewIndex(), nil
+	}
+
+	distProv, err := distancerProvider(hnswUserConfig.Distance)
+	if err != nil {
+		return nil, err
 	}
 
 	// starts vector cycles if vector is configured
@@ -156,25 +341,92 @@ func (s *Shard) initVectorIndex(
 	vi, err := hnsw.New(hnsw.Config{
 		Logger:               s.index.logger,
 		RootPath:             s.index.Config.RootPath,
-		ID:                   s.ID(),
+		ID:                   id,
+		TargetVector:         targetVector,
 		ShardName:            s.name,
 		ClassName:            s.index.Config.ClassName.String(),
 		PrometheusMetrics:    s.promMetrics,
-		VectorForIDThunk:     s.vectorByIndexID,
-		TempVectorForIDThunk: s.readVectorByIndexIDIntoSlice,
+		VectorForIDThunk:     vectorForID,
+		TempVectorForIDThunk: tempVectorForID,
 		DistanceProvider:     distProv,
 		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(),
-				s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id,
+				s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+				hnsw.WithCommitlogRetainer(s.walRetainer))
 		},
 	}, hnswUserConfig,
 		s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks)
 	if err != nil {
-		return errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 	}
-	s.vectorIndex = vi
 
-	return nil
+	return vi, nil
+}
+
+func (s *Shard) initDynamicIndex(ctx context.Context, id, targetVector string,
+	userConfig schema.VectorIndexConfig, vectorForID hnsw.VectorForID,
+	tempVectorForID hnsw.TempVectorForID,
+) (VectorIndex, error) {
+	dynamicUserConfig, ok := userConfig.(dynamicent.UserConfig)
+	if !ok {
+		return nil, errors.Errorf("dynamic vector index: config is not dynamic.UserConfig: %T",
+			userConfig)
+	}
+
+	distProv, err := distancerProvider(dynamicUserConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	// the index may be upgraded to hnsw at any time, so the cycles need to run
+	s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
+	s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+	vi, err := dynamic.New(dynamic.Config{
+		ID:                   id,
+		TargetVector:         targetVector,
+		RootPath:             s.index.Config.RootPath,
+		ShardName:            s.name,
+		ClassName:            s.index.Config.ClassName.String(),
+		Logger:               s.index.logger,
+		DistanceProvider:     distProv,
+		PrometheusMetrics:    s.promMetrics,
+		VectorForIDThunk:     vectorForID,
+		TempVectorForIDThunk: tempVectorForID,
+		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id,
+				s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+				hnsw.WithCommitlogRetainer(s.walRetainer))
+		},
+		AvoidMMap:                s.index.Config.AvoidMMap,
+		TombstoneCallbacks:       s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		ShardCompactionCallbacks: s.cycleCallbacks.compactionCallbacks,
+		ShardFlushCallbacks:      s.cycleCallbacks.flushCallbacks,
+		SharedDB:                 s.store,
+	}, dynamicUserConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
+	}
+
+	return vi, nil
+}
+
+func distancerProvider(distance string) (distancer.Provider, error) {
+	switch distance {
+	case "", vectorIndexCommon.DistanceCosine:
+		return distancer.NewCosineDistanceProvider(), nil
+	case vectorIndexCommon.DistanceDot:
+		return distancer.NewDotProductProvider(), nil
+	case vectorIndexCommon.DistanceL2Squared:
+		return distancer.NewL2SquaredProvider(), nil
+	case vectorIndexCommon.DistanceManhattan:
+		return distancer.NewManhattanProvider(), nil
+	case vectorIndexCommon.DistanceHamming:
+		return distancer.NewHammingProvider(), nil
+	default:
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", distance)
+	}
 }
 
 func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
@@ -203,6 +455,7 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return errors.Wrapf(err, "init shard %q: prop length tracker", s.ID())
 	}
 	s.propLengths = propLengths
+	s.termDictionaries = inverted.NewTermDictionaries(s.store)
 
 	if err := s.initProperties(class); err != nil {
 		return errors.Wrapf(err, "init shard %q: init per property indices", s.ID())
@@ -243,6 +496,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "init lsmkv store at %s", s.DBPathLSM())
 	}
+	store.RetainCommitLogs(s.walRetainer)
 
 	err = store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
@@ -287,6 +541,15 @@ func (s *Shard) drop() error {
 		return err
 	}
 
+	// the vector indexes are dropped before the store is shut down, as some
+	// of them run background work against buckets of the store
+	err := s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return index.Drop(ctx)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
+	}
+
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
@@ -297,8 +560,12 @@ func (s *Shard) drop() error {
 			return errors.Wrapf(err, "remove lsm store at %s", s.DBPathLSM())
 		}
 	}
+	if err := s.walRetainer.clear(); err != nil {
+		return errors.Wrapf(err, "remove retained commit logs at %s", s.walRetainer.dir)
+	}
+
 	// delete indexcount
-	err := s.counter.Drop()
+	err = s.counter.Drop()
 	if err != nil {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
@@ -308,12 +575,6 @@ func (s *Shard) drop() error {
 	if err != nil {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
-	// remove vector index
-	err = s.vectorIndex.Drop(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
-	}
-
 	// delete indexcount
 	err = s.propLengths.Drop()
 	if err != nil {
@@ -479,17 +740,38 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 			searchableBucketOpts = append(searchableBucketOpts, lsmkv.WithLegacyMapSorting())
 		}
 
+		// token positions are indexed for properties created since phrase
+		// queries are supported. Existing searchable indexes get their positions
+		// through the ShardInvertedReindexTaskSearchablePositions reindex task
+		positionsBucketName := helpers.BucketSearchablePositionsFromPropNameLSM(prop.Name)
+		createPositions := !s.bucketExistsOnDisk(helpers.BucketSearchableFromPropNameLSM(prop.Name)) ||
+			s.bucketExistsOnDisk(positionsBucketName)
+
 		if err := s.store.CreateOrLoadBucket(ctx,
 			helpers.BucketSearchableFromPropNameLSM(prop.Name),
 			searchableBucketOpts...,
 		); err != nil {
 			return err
 		}
+
+		if createPositions {
+			if err := s.store.CreateOrLoadBucket(ctx,
+				positionsBucketName,
+				searchableBucketOpts...,
+			); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+func (s *Shard) bucketExistsOnDisk(bucketName string) bool {
+	_, err := os.Stat(path.Join(s.DBPathLSM(), bucketName))
+	return err == nil
+}
+
 func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Property) error {
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
@@ -536,6 +818,55 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 	})
 }
 
+func (s *Shard) updateVectorIndexConfigs(ctx context.Context,
+	updated map[string]schema.VectorIndexConfig,
+) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	// all configs are validated first, so that an invalid one does not leave
+	// the other indexes updated
+	for targetVector, cfg := range updated {
+		if _, ok := s.vectorIndexes[targetVector]; !ok {
+			return errors.Errorf("vector index for target vector %q not found", targetVector)
+		}
+		if old, ok := s.index.vectorIndexUserConfigs[targetVector]; ok {
+			if err := validateVectorIndexConfigUpdate(old, cfg); err != nil {
+				return errors.Wrapf(err, "target vector %s", targetVector)
+			}
+		}
+	}
+
+	err := s.updateStatus(storagestate.StatusReadOnly.String())
+	if err != nil {
+		return fmt.Errorf("attempt to mark read-only: %w", err)
+	}
+
+	// the shard is only marked ready again, once all indexes have applied
+	// their updated config. If an index fails to apply it, the remaining ones
+	// are not updated and the shard is marked ready once the updates started
+	// so far are done.
+	wg := &sync.WaitGroup{}
+	for targetVector, cfg := range updated {
+		wg.Add(1)
+		done := &sync.Once{}
+		callback := func() { done.Do(wg.Done) }
+		if err = s.vectorIndexes[targetVector].UpdateUserConfig(cfg, callback); err != nil {
+			callback()
+			err = errors.Wrapf(err, "target vector %s", targetVector)
+			break
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		s.updateStatus(storagestate.StatusReady.String())
+	}()
+
+	return err
+}
+
 func (s *Shard) shutdown(ctx context.Context) error {
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
@@ -552,12 +883,17 @@ func (s *Shard) shutdown(ctx context.Context) error {
 	// 'RemoveTombstone' entry is not picked up on restarts
 	// resulting in perpetually attempting to remove a tombstone
 	// which doesn't actually exist anymore
-	if err := s.vectorIndex.Flush(); err != nil {
-		return errors.Wrap(err, "flush vector index commitlog")
-	}
-
-	if err := s.vectorIndex.Shutdown(ctx); err != nil {
-		return errors.Wrap(err, "shut down vector index")
+	err := s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		if err := index.Flush(); err != nil {
+			return errors.Wrap(err, "flush vector index commitlog")
+		}
+		if err := index.Shutdown(ctx); err != nil {
+			return errors.Wrap(err, "shut down vector index")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// unregister all callbacks at once, in parallel
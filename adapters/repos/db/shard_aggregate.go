@@ -21,9 +21,14 @@ import (
 func (s *Shard) aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
+	vectorIndex, err := s.getVectorIndex(params.TargetVector)
+	if err != nil {
+		return nil, err
+	}
+
 	return aggregator.New(s.store, params, s.index.getSchema,
 		s.index.classSearcher, s.deletedDocIDs, s.index.stopwords, s.versioner.Version(),
-		s.vectorIndex, s.index.logger, s.propLengths, s.isFallbackToSearchable, s.tenant(),
+		vectorIndex, s.index.logger, s.propLengths, s.isFallbackToSearchable, s.tenant(),
 		s.index.Config.QueryNestedRefLimit).
 		Do(ctx)
 }
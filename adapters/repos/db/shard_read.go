@@ -48,10 +48,10 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 	}
 
 	if bytes == nil {
-		return nil, nil
+		return s.mergedObjectByID(ctx, id, props, additional)
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinaryWithClass(bytes, s.index.class())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
@@ -75,6 +75,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 	}
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	class := s.index.class()
 	for i, id := range ids {
 		bytes, err := bucket.Get(id)
 		if err != nil {
@@ -82,10 +83,15 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 		}
 
 		if bytes == nil {
+			obj, err := s.mergedObjectByID(ctx, strfmt.UUID(query[i].ID), nil, additional.Properties{})
+			if err != nil {
+				return nil, err
+			}
+			objects[i] = obj
 			continue
 		}
 
-		obj, err := storobj.FromBinary(bytes)
+		obj, err := storobj.FromBinaryWithClass(bytes, class)
 		if err != nil {
 			return nil, errors.Wrap(err, "unmarshal kind object")
 		}
@@ -112,7 +118,8 @@ func (s *Shard) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 	}
 
 	if bytes == nil {
-		return false, nil
+		obj, err := s.mergedObjectByID(ctx, id, nil, additional.Properties{})
+		return obj != nil, err
 	}
 
 	return true, nil
@@ -135,7 +142,7 @@ func (s *Shard) objectByIndexID(ctx context.Context,
 			"uuid found for docID, but object is nil")
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinaryWithClass(bytes, s.index.class())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal kind object")
 	}
@@ -149,6 +156,26 @@ func (s *Shard) vectorByIndexID(ctx context.Context, indexID uint64) ([]float32,
 }
 
 func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64, container *hnsw.VectorSlice) ([]float32, error) {
+	bytes, err := s.readObjectByIndexIDIntoSlice(indexID, container)
+	if err != nil {
+		return nil, err
+	}
+
+	return storobj.VectorFromBinary(bytes, container.Slice)
+}
+
+func (s *Shard) readTargetVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64,
+	container *hnsw.VectorSlice, targetVector string,
+) ([]float32, error) {
+	bytes, err := s.readObjectByIndexIDIntoSlice(indexID, container)
+	if err != nil {
+		return nil, err
+	}
+
+	return storobj.TargetVectorFromBinary(bytes, container.Slice, targetVector)
+}
+
+func (s *Shard) readObjectByIndexIDIntoSlice(indexID uint64, container *hnsw.VectorSlice) ([]byte, error) {
 	binary.LittleEndian.PutUint64(container.Buff8, indexID)
 
 	bytes, newBuff, err := s.store.Bucket(helpers.ObjectsBucketLSM).
@@ -163,7 +190,7 @@ func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64
 	}
 
 	container.Buff = newBuff
-	return storobj.VectorFromBinary(bytes, container.Slice)
+	return bytes, nil
 }
 
 func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties) ([]*storobj.Object, []float32, error) {
@@ -196,7 +223,7 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 
 		className := s.index.Config.ClassName
 		bm25Config := s.index.getInvertedIndexConfig().BM25
-		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store, s.index.getSchema.GetSchemaSkipAuth(), s.propertyIndices, s.index.classSearcher, s.deletedDocIDs, s.propLengths, s.index.logger, s.versioner.Version())
+		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store, s.index.getSchema.GetSchemaSkipAuth(), s.propertyIndices, s.index.classSearcher, s.deletedDocIDs, s.propLengths, s.termDictionaries, s.index.logger, s.versioner.Version())
 		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking)
 		if err != nil {
 			return nil, nil, err
@@ -220,8 +247,9 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 }
 
 func (s *Shard) objectVectorSearch(ctx context.Context,
-	searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter,
-	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
+	searchVector []float32, targetVector string, targetDist float32, limit int,
+	filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy,
+	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	var (
 		ids       []uint64
@@ -230,6 +258,11 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		allowList helpers.AllowList
 	)
 
+	vectorIndex, err := s.getVectorIndex(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if filters != nil {
 		beforeFilter := time.Now()
 		list, err := s.buildAllowList(ctx, filters, additional)
@@ -242,13 +275,13 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 
 	beforeVector := time.Now()
 	if limit < 0 {
-		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
+		ids, dists, err = vectorIndex.SearchByVectorDistance(
 			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
 	} else {
-		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+		ids, dists, err = vectorIndex.SearchByVector(searchVector, limit, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search")
 		}
@@ -280,7 +313,7 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	beforeObjects := time.Now()
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional, s.index.class())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -302,7 +335,7 @@ func (s *Shard) objectList(ctx context.Context, limit int,
 			return nil, err
 		}
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-		return storobj.ObjectsByDocID(bucket, docIDs, additional)
+		return storobj.ObjectsByDocID(bucket, docIDs, additional, s.index.class())
 	}
 
 	if cursor == nil {
@@ -335,9 +368,10 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 
 	i := 0
 	out := make([]*storobj.Object, c.Limit)
+	class := s.index.class()
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
-		obj, err := storobj.FromBinary(val)
+		obj, err := storobj.FromBinaryWithClass(val, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
 		}
@@ -454,7 +488,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
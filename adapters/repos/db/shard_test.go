@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -29,8 +30,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func TestShard_UpdateStatus(t *testing.T) {
@@ -80,6 +84,65 @@ func TestShard_UpdateStatus(t *testing.T) {
 	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
 }
 
+func TestShard_UpdateVectorIndexConfigs(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	withTargetVectors := func(i *Index) {
+		i.vectorIndexUserConfigs = map[string]schema.VectorIndexConfig{
+			"hnsw": enthnsw.NewDefaultUserConfig(),
+			"flat": flatent.NewDefaultUserConfig(),
+		}
+	}
+
+	t.Run("invalid config of one target vector", func(t *testing.T) {
+		shd, _ := testShard(t, ctx, className, withTargetVectors)
+		hnswIndex := &fakeConfigVectorIndex{VectorIndex: shd.vectorIndexes["hnsw"]}
+		shd.vectorIndexes["hnsw"] = hnswIndex
+
+		err := shd.updateVectorIndexConfigs(ctx, map[string]schema.VectorIndexConfig{
+			"hnsw": enthnsw.NewDefaultUserConfig(),
+			"flat": enthnsw.NewDefaultUserConfig(),
+		})
+		require.ErrorContains(t, err, "target vector flat")
+		assert.False(t, shd.isReadOnly())
+		assert.False(t, hnswIndex.updated, "valid config is not applied")
+	})
+
+	t.Run("one target vector fails to apply its config", func(t *testing.T) {
+		shd, _ := testShard(t, ctx, className, withTargetVectors)
+		shd.vectorIndexes["flat"] = &fakeConfigVectorIndex{
+			VectorIndex: shd.vectorIndexes["flat"],
+			err:         errors.New("update failed"),
+		}
+
+		err := shd.updateVectorIndexConfigs(ctx, map[string]schema.VectorIndexConfig{
+			"hnsw": enthnsw.NewDefaultUserConfig(),
+			"flat": flatent.NewDefaultUserConfig(),
+		})
+		require.ErrorContains(t, err, "target vector flat")
+		assert.Eventually(t, func() bool {
+			return shd.getStatus() == storagestate.StatusReady
+		}, 5*time.Second, 10*time.Millisecond, "shard is marked ready again")
+	})
+}
+
+// fakeConfigVectorIndex records config updates. If err is set, the update
+// fails without calling back.
+type fakeConfigVectorIndex struct {
+	VectorIndex
+	err     error
+	updated bool
+}
+
+func (f *fakeConfigVectorIndex) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	f.updated = true
+	if f.err != nil {
+		return f.err
+	}
+	callback()
+	return nil
+}
+
 func TestShard_ReadOnly_HaltCompaction(t *testing.T) {
 	amount := 10000
 	sizePerValue := 8
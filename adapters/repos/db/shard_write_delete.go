@@ -66,7 +66,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err = s.vectorIndex.Delete(docID); err != nil {
+	if err = s.deleteFromVectorIndexes(docID); err != nil {
 		return fmt.Errorf("delete from vector index: %w", err)
 	}
 
@@ -74,7 +74,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return fmt.Errorf("flush all buffered WALs: %w", err)
 	}
 
-	if err = s.vectorIndex.Flush(); err != nil {
+	if err = s.flushVectorIndexes(); err != nil {
 		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
 	}
 
@@ -123,7 +123,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err = s.vectorIndex.Delete(docID); err != nil {
+	if err = s.deleteFromVectorIndexes(docID); err != nil {
 		return fmt.Errorf("delete from vector index: %w", err)
 	}
 
@@ -131,7 +131,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 		return fmt.Errorf("flush all buffered WALs: %w", err)
 	}
 
-	if err = s.vectorIndex.Flush(); err != nil {
+	if err = s.flushVectorIndexes(); err != nil {
 		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
 	}
 
@@ -139,7 +139,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 }
 
 func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) error {
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := storobj.FromBinaryWithClass(previous, s.index.class())
 	if err != nil {
 		return fmt.Errorf("unmarshal previous object: %w", err)
 	}
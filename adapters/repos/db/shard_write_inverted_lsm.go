@@ -90,12 +90,28 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 		}
 
 		propLen := float32(len(property.Items))
-		for _, item := range property.Items {
+		terms := make([]string, len(property.Items))
+		for i, item := range property.Items {
 			key := item.Data
 			pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
 			if err := s.addToPropertyMapBucket(bucketValue, pair, key); err != nil {
 				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 			}
+			terms[i] = string(key)
+		}
+		s.termDictionaries.Add(property.Name, terms...)
+
+		// positions bucket is missing for searchable indexes created before
+		// phrase queries were supported, until they are reindexed
+		bucketPositions := s.store.Bucket(helpers.BucketSearchablePositionsFromPropNameLSM(property.Name))
+		if bucketPositions != nil {
+			for _, item := range property.Items {
+				key := item.Data
+				pair := s.pairPropertyWithPositions(docID, item.Positions)
+				if err := s.addToPropertyMapBucket(bucketPositions, pair, key); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", property.Name)
+				}
+			}
 		}
 	}
 
@@ -154,6 +170,22 @@ func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32) l
 	}
 }
 
+func (s *Shard) pairPropertyWithPositions(docID uint64, positions []uint32) lsmkv.MapPair {
+	buf := make([]byte, 8)
+
+	// same key layout as the frequency pairs of the searchable bucket
+	if s.versioner.Version() < 2 {
+		binary.LittleEndian.PutUint64(buf, docID)
+	} else {
+		binary.BigEndian.PutUint64(buf, docID)
+	}
+
+	return lsmkv.MapPair{
+		Key:   buf,
+		Value: inverted.TermPositions(positions),
+	}
+}
+
 func (s *Shard) keyPropertyLength(length int) ([]byte, error) {
 	return inverted.LexicographicallySortableInt64(int64(length))
 }
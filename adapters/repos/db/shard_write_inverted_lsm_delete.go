@@ -53,6 +53,17 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 						string(item.Data))
 				}
 			}
+
+			bucketPositions := s.store.Bucket(helpers.BucketSearchablePositionsFromPropNameLSM(prop.Name))
+			if bucketPositions != nil {
+				for _, item := range prop.Items {
+					if err := s.deleteInvertedIndexItemWithFrequencyLSM(bucketPositions, item,
+						docID); err != nil {
+						return errors.Wrapf(err, "delete item '%s' positions from index",
+							string(item.Data))
+					}
+				}
+			}
 		}
 	}
 
@@ -35,6 +35,15 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 			return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
 		}
 	}
+	for targetVector, vector := range merge.Vectors {
+		vectorIndex, err := s.getVectorIndex(targetVector)
+		if err != nil {
+			return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
+		}
+		if err := vectorIndex.ValidateBeforeInsert(vector); err != nil {
+			return errors.Wrapf(err, "Validate vector index %s for update of %v", targetVector, merge.ID)
+		}
+	}
 
 	idBytes, err := uuid.MustParse(merge.ID.String()).MarshalBinary()
 	if err != nil {
@@ -54,6 +63,10 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateVectorIndexes(next.Vectors, status); err != nil {
+		return errors.Wrap(err, "update vector indexes")
+	}
+
 	if err := s.updatePropertySpecificIndices(next, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -62,7 +75,7 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -197,7 +210,7 @@ func (s *Shard) mergeObjectData(previous []byte,
 		previousObj.SetClass(merge.Class)
 		previousObj.SetID(merge.ID)
 	} else {
-		p, err := storobj.FromBinary(previous)
+		p, err := storobj.FromBinaryWithClass(previous, s.index.class())
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "unmarshal previous")
 		}
@@ -244,6 +257,13 @@ func mergeProps(previous *storobj.Object,
 		next.Vector = merge.Vector
 	}
 
+	if len(merge.Vectors) > 0 {
+		next.Vectors = make(map[string][]float32, len(merge.Vectors))
+		for targetVector, vector := range merge.Vectors {
+			next.Vectors[targetVector] = vector
+		}
+	}
+
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
@@ -47,6 +47,15 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 			return errors.Wrapf(err, "Validate vector index for %v", uuid)
 		}
 	}
+	for targetVector, vector := range object.Vectors {
+		vectorIndex, err := s.getVectorIndex(targetVector)
+		if err != nil {
+			return errors.Wrapf(err, "Validate vector index for %v", uuid)
+		}
+		if err := vectorIndex.ValidateBeforeInsert(vector); err != nil {
+			return errors.Wrapf(err, "Validate vector index %s for %v", targetVector, uuid)
+		}
+	}
 
 	status, err := s.putObjectLSM(object, uuid)
 	if err != nil {
@@ -57,6 +66,10 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateVectorIndexes(object.Vectors, status); err != nil {
+		return errors.Wrap(err, "update vector indexes")
+	}
+
 	if err := s.updatePropertySpecificIndices(object, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -69,7 +82,7 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "flush prop length tracker to disk")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -82,14 +95,22 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 func (s *Shard) updateVectorIndexIgnoreDelete(vector []float32,
 	status objectInsertStatus,
 ) error {
-	// vector is now optional as of
-	// https://github.com/weaviate/weaviate/issues/1800
-	if len(vector) == 0 {
-		return nil
-	}
+	return addToVectorIndex(s.vectorIndex, vector, status)
+}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
-		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
+// updateVectorIndexesIgnoreDelete is the named vectors equivalent of
+// updateVectorIndexIgnoreDelete
+func (s *Shard) updateVectorIndexesIgnoreDelete(vectors map[string][]float32,
+	status objectInsertStatus,
+) error {
+	for targetVector, vector := range vectors {
+		vectorIndex, err := s.getVectorIndex(targetVector)
+		if err != nil {
+			return err
+		}
+		if err := addToVectorIndex(vectorIndex, vector, status); err != nil {
+			return errors.Wrapf(err, "target vector %s", targetVector)
+		}
 	}
 
 	return nil
@@ -97,24 +118,57 @@ func (s *Shard) updateVectorIndexIgnoreDelete(vector []float32,
 
 func (s *Shard) updateVectorIndex(vector []float32,
 	status objectInsertStatus,
+) error {
+	return updateVectorIndex(s.vectorIndex, vector, status)
+}
+
+// updateVectorIndexes updates the index of every named vector. Indexes for
+// which the object has no vector still need to be updated, since the
+// previous doc id needs to be removed from them.
+func (s *Shard) updateVectorIndexes(vectors map[string][]float32,
+	status objectInsertStatus,
+) error {
+	for targetVector := range vectors {
+		if _, err := s.getVectorIndex(targetVector); err != nil {
+			return err
+		}
+	}
+
+	for targetVector, vectorIndex := range s.vectorIndexes {
+		if err := updateVectorIndex(vectorIndex, vectors[targetVector], status); err != nil {
+			return errors.Wrapf(err, "target vector %s", targetVector)
+		}
+	}
+
+	return nil
+}
+
+func updateVectorIndex(vectorIndex VectorIndex, vector []float32,
+	status objectInsertStatus,
 ) error {
 	// even if no vector is provided in an update, we still need
 	// to delete the previous vector from the index, if it
 	// exists. otherwise, the associated doc id is left dangling,
 	// resulting in failed attempts to merge an object on restarts.
 	if status.docIDChanged {
-		if err := s.vectorIndex.Delete(status.oldDocID); err != nil {
+		if err := vectorIndex.Delete(status.oldDocID); err != nil {
 			return errors.Wrapf(err, "delete doc id %d from vector index", status.oldDocID)
 		}
 	}
 
+	return addToVectorIndex(vectorIndex, vector, status)
+}
+
+func addToVectorIndex(vectorIndex VectorIndex, vector []float32,
+	status objectInsertStatus,
+) error {
 	// vector is now optional as of
 	// https://github.com/weaviate/weaviate/issues/1800
 	if len(vector) == 0 {
 		return nil
 	}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
+	if err := vectorIndex.Add(status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
 
@@ -260,7 +314,7 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 
 	if status.docIDChanged {
-		oldObject, err := storobj.FromBinary(previous)
+		oldObject, err := storobj.FromBinaryWithClass(previous, s.index.class())
 		if err == nil {
 
 			oldProps, _, err := s.analyzeObject(oldObject)
@@ -352,7 +406,7 @@ func (s *Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 	// NOTE: Since Doc IDs are immutable, there is no need to use a
 	// DeltaAnalyzer. docIDChanged==true, therefore the old docID is
 	// "worthless" and can be cleaned up in the inverted index fully.
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := storobj.FromBinaryWithClass(previous, s.index.class())
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
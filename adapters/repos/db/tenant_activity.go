@@ -0,0 +1,331 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"golang.org/x/sync/singleflight"
+)
+
+// tenantActivityInterval is how often idle tenants are deactivated
+const tenantActivityInterval = 10 * time.Second
+
+// TenantActivator changes the activity status of tenants of classes with
+// automatic tenant activation enabled
+type TenantActivator interface {
+	ActivateTenant(ctx context.Context, class, tenant string) error
+	DeactivateTenants(ctx context.Context, class string, tenants []string) error
+}
+
+// tenantActivity activates COLD tenants on access and deactivates tenants
+// which are idle or exceed the maximum number of active tenants.
+//
+// The usage of a tenant is tracked on the nodes holding its shard, thus
+// tenants are deactivated by the nodes they are active on. As a tenant may
+// be read from a single replica only, the last usage is merged across all
+// replicas before the tenant is deactivated. The limit of active tenants is
+// enforced before a local tenant is activated and periodically for tenants
+// activated by requests coordinated by other nodes.
+type tenantActivity struct {
+	db        *DB
+	activator TenantActivator
+	maxActive int
+	// activations makes sure that a tenant is activated only once if it is
+	// accessed by concurrent requests
+	activations singleflight.Group
+	// stop ends the periodic deactivation, it is recreated on every startup
+	stop chan struct{}
+}
+
+// SetTenantActivator sets the activator used for classes with automatic
+// tenant activation, it must be set before the db is started
+func (db *DB) SetTenantActivator(activator TenantActivator) {
+	db.tenantActivity.activator = activator
+}
+
+func (db *DB) observeTenantActivity() {
+	stop := make(chan struct{})
+	db.tenantActivity.stop = stop
+	go func() {
+		t := time.NewTicker(tenantActivityInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-t.C:
+				db.tenantActivity.deactivateTenants(context.Background())
+			}
+		}
+	}()
+}
+
+// autoActivationConfig returns the multi-tenancy config of the class if
+// tenants are activated automatically
+func (a *tenantActivity) autoActivationConfig(idx *Index) (*models.MultiTenancyConfig, bool) {
+	if a == nil || a.activator == nil || !idx.partitioningEnabled {
+		return nil, false
+	}
+	sch := idx.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(idx.Config.ClassName)
+	if class == nil || class.MultiTenancyConfig == nil || !class.MultiTenancyConfig.AutoTenantActivation {
+		return nil, false
+	}
+	return class.MultiTenancyConfig, true
+}
+
+// activate activates a COLD tenant, local tenants are only activated if the
+// maximum number of active tenants allows it
+func (a *tenantActivity) activate(ctx context.Context, idx *Index, tenant string) error {
+	className := idx.Config.ClassName.String()
+	_, err, _ := a.activations.Do(className+"/"+tenant, func() (interface{}, error) {
+		if idx.isLocalShard(tenant) {
+			a.evict(ctx, 1)
+		}
+		return nil, a.activator.ActivateTenant(ctx, className, tenant)
+	})
+	return err
+}
+
+// deactivateTenants deactivates idle tenants and the least recently used
+// tenants which exceed the maximum number of active tenants
+func (a *tenantActivity) deactivateTenants(ctx context.Context) {
+	if a.activator == nil {
+		return
+	}
+	now := time.Now()
+	for _, idx := range a.db.partitionedIndices() {
+		cfg, ok := a.autoActivationConfig(idx)
+		if !ok {
+			continue
+		}
+		timeout := time.Duration(cfg.AutoTenantDeactivationTimeoutSeconds) * time.Second
+		if timeout <= 0 {
+			continue
+		}
+		idle := map[string]time.Time{}
+		for name, lastUsed := range idx.tenantsLastUsed(now) {
+			if now.Sub(lastUsed) > timeout {
+				idle[name] = lastUsed
+			}
+		}
+		// the tenant may still be used on another replica
+		a.mergeReplicasLastUsed(ctx, idx, idle)
+		var names []string
+		for name, lastUsed := range idle {
+			if now.Sub(lastUsed) > timeout {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		a.deactivate(ctx, idx, names)
+	}
+	a.evict(ctx, 0)
+}
+
+// evict deactivates the least recently used tenants until room for n more
+// active tenants is left
+func (a *tenantActivity) evict(ctx context.Context, n int) {
+	if a.maxActive <= 0 {
+		return
+	}
+
+	type activeTenant struct {
+		idx      *Index
+		name     string
+		lastUsed time.Time
+	}
+	var active []activeTenant
+	lastUsed := map[*Index]map[string]time.Time{}
+	now := time.Now()
+	for _, idx := range a.db.partitionedIndices() {
+		if _, ok := a.autoActivationConfig(idx); !ok {
+			continue
+		}
+		lastUsed[idx] = idx.tenantsLastUsed(now)
+		for name := range lastUsed[idx] {
+			active = append(active, activeTenant{idx: idx, name: name})
+		}
+	}
+
+	excess := len(active) + n - a.maxActive
+	if excess <= 0 {
+		return
+	}
+	// evict the tenants which are least recently used on any replica
+	for idx, used := range lastUsed {
+		a.mergeReplicasLastUsed(ctx, idx, used)
+	}
+	for i := range active {
+		active[i].lastUsed = lastUsed[active[i].idx][active[i].name]
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].lastUsed.Before(active[j].lastUsed)
+	})
+	if excess > len(active) {
+		excess = len(active)
+	}
+
+	evicted := map[*Index][]string{}
+	for _, t := range active[:excess] {
+		evicted[t.idx] = append(evicted[t.idx], t.name)
+	}
+	for idx, names := range evicted {
+		a.deactivate(ctx, idx, names)
+	}
+}
+
+// mergeReplicasLastUsed updates lastUsed with the more recent usage of the
+// tenants on their other replicas. If a replica can't be asked, its tenants
+// are considered used now, so they are not deactivated while they might be
+// in use.
+func (a *tenantActivity) mergeReplicasLastUsed(ctx context.Context, idx *Index,
+	lastUsed map[string]time.Time,
+) {
+	if len(lastUsed) == 0 {
+		return
+	}
+	local := idx.getSchema.NodeName()
+	byNode := map[string][]string{}
+	for name := range lastUsed {
+		nodes, err := idx.getSchema.ShardReplicas(idx.Config.ClassName.String(), name)
+		if err != nil {
+			lastUsed[name] = time.Now()
+			continue
+		}
+		for _, node := range nodes {
+			if node != local {
+				byNode[node] = append(byNode[node], name)
+			}
+		}
+	}
+
+	for node, names := range byNode {
+		remote, err := idx.remote.TenantsLastUsed(ctx, node, names)
+		if err != nil {
+			a.db.logger.WithField("action", "deactivate_tenants").
+				WithField("class", idx.Config.ClassName).
+				WithField("node", node).
+				Warnf("cannot get usage of tenants, keeping them active: %v", err)
+			remote = map[string]time.Time{}
+			now := time.Now()
+			for _, name := range names {
+				remote[name] = now
+			}
+		}
+		for _, name := range names {
+			if used, ok := remote[name]; ok && used.After(lastUsed[name]) {
+				lastUsed[name] = used
+				idx.tenantsUsage.Store(name, used)
+			}
+		}
+	}
+}
+
+func (a *tenantActivity) deactivate(ctx context.Context, idx *Index, tenants []string) {
+	if len(tenants) == 0 {
+		return
+	}
+	className := idx.Config.ClassName.String()
+	if err := a.activator.DeactivateTenants(ctx, className, tenants); err != nil {
+		a.db.logger.WithField("action", "deactivate_tenants").
+			WithField("class", className).
+			WithField("tenants", tenants).
+			Errorf("cannot deactivate tenants: %v", err)
+		return
+	}
+	for _, name := range tenants {
+		idx.tenantsUsage.Delete(name)
+	}
+}
+
+func (db *DB) partitionedIndices() []*Index {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	indices := make([]*Index, 0, len(db.indices))
+	for _, idx := range db.indices {
+		if idx.partitioningEnabled {
+			indices = append(indices, idx)
+		}
+	}
+	return indices
+}
+
+// tenantShard returns the shard of an active tenant. A COLD tenant is
+// activated first if the class allows it.
+func (i *Index) tenantShard(ctx context.Context, tenant string) (string, error) {
+	className := i.Config.ClassName.String()
+	shard, status := i.getSchema.TenantShard(className, tenant)
+	if shard == "" {
+		return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotFound, tenant))
+	}
+	if status == models.TenantActivityStatusCOLD {
+		if _, ok := i.tenantActivity.autoActivationConfig(i); ok {
+			if err := i.tenantActivity.activate(ctx, i, tenant); err != nil {
+				return "", objects.NewErrMultiTenancy(
+					fmt.Errorf("%w: '%s': activate: %v", errTenantNotActive, tenant, err))
+			}
+			shard, status = i.getSchema.TenantShard(className, tenant)
+		}
+	}
+	if status != models.TenantActivityStatusHOT {
+		return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: '%s'", errTenantNotActive, tenant))
+	}
+	return shard, nil
+}
+
+func (i *Index) isLocalShard(name string) bool {
+	nodes, err := i.getSchema.ShardReplicas(i.Config.ClassName.String(), name)
+	if err != nil {
+		return false
+	}
+	local := i.getSchema.NodeName()
+	for _, node := range nodes {
+		if node == local {
+			return true
+		}
+	}
+	return false
+}
+
+// touchShard records the usage of a tenant shard
+func (i *Index) touchShard(name string) {
+	if i.partitioningEnabled {
+		i.tenantsUsage.Store(name, time.Now())
+	}
+}
+
+// tenantsLastUsed returns when the loaded tenant shards were used last.
+// Shards which weren't used yet are considered used now.
+func (i *Index) tenantsLastUsed(now time.Time) map[string]time.Time {
+	lastUsed := map[string]time.Time{}
+	i.shards.Range(func(name string, _ *Shard) error {
+		used, _ := i.tenantsUsage.LoadOrStore(name, now)
+		lastUsed[name] = used.(time.Time)
+		return nil
+	})
+	// forget shards which are not loaded anymore
+	i.tenantsUsage.Range(func(key, _ any) bool {
+		if _, ok := lastUsed[key.(string)]; !ok {
+			i.tenantsUsage.Delete(key)
+		}
+		return true
+	})
+	return lastUsed
+}
@@ -0,0 +1,257 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// fakeTenantSchema keeps the activity status of the tenants of a single
+// class and changes it like the schema manager does
+type fakeTenantSchema struct {
+	schemaUC.SchemaGetter
+
+	sync.Mutex
+	class       *models.Class
+	statuses    map[string]string
+	replicas    []string
+	activated   []string
+	deactivated []string
+}
+
+func (f *fakeTenantSchema) GetSchemaSkipAuth() schema.Schema {
+	return schema.Schema{Objects: &models.Schema{Classes: []*models.Class{f.class}}}
+}
+
+func (f *fakeTenantSchema) TenantShard(class, tenant string) (string, string) {
+	f.Lock()
+	defer f.Unlock()
+	if status, ok := f.statuses[tenant]; ok {
+		return tenant, status
+	}
+	return "", ""
+}
+
+func (f *fakeTenantSchema) ShardReplicas(class, shard string) ([]string, error) {
+	if f.replicas != nil {
+		return f.replicas, nil
+	}
+	return []string{"node1"}, nil
+}
+
+func (f *fakeTenantSchema) NodeName() string {
+	return "node1"
+}
+
+func (f *fakeTenantSchema) ActivateTenant(ctx context.Context, class, tenant string) error {
+	f.Lock()
+	defer f.Unlock()
+	if _, ok := f.statuses[tenant]; !ok {
+		return errors.New("tenant not found")
+	}
+	f.statuses[tenant] = models.TenantActivityStatusHOT
+	f.activated = append(f.activated, tenant)
+	return nil
+}
+
+func (f *fakeTenantSchema) DeactivateTenants(ctx context.Context, class string, tenants []string) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, tenant := range tenants {
+		f.statuses[tenant] = models.TenantActivityStatusCOLD
+	}
+	f.deactivated = append(f.deactivated, tenants...)
+	sort.Strings(f.deactivated)
+	return nil
+}
+
+// fakeReplicaUsage serves the usage of tenants on other nodes
+type fakeReplicaUsage struct {
+	sharding.RemoteIndexClient
+	lastUsed map[string]map[string]time.Time
+	err      error
+}
+
+func (f *fakeReplicaUsage) NodeHostname(nodeName string) (string, bool) {
+	return nodeName, true
+}
+
+func (f *fakeReplicaUsage) TenantsLastUsed(ctx context.Context,
+	hostName, indexName string, shardNames []string,
+) (map[string]time.Time, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := map[string]time.Time{}
+	for _, name := range shardNames {
+		if used, ok := f.lastUsed[hostName][name]; ok {
+			out[name] = used
+		}
+	}
+	return out, nil
+}
+
+func newTenantActivityTest(t *testing.T, cfg *models.MultiTenancyConfig,
+	maxActive int, statuses map[string]string,
+) (*fakeTenantSchema, *Index) {
+	logger, _ := test.NewNullLogger()
+	sg := &fakeTenantSchema{
+		class:    &models.Class{Class: "Auto", MultiTenancyConfig: cfg},
+		statuses: statuses,
+	}
+	db := &DB{logger: logger, indices: map[string]*Index{}}
+	db.tenantActivity = &tenantActivity{db: db, activator: sg, maxActive: maxActive}
+
+	idx := &Index{
+		Config:              IndexConfig{ClassName: "Auto"},
+		getSchema:           sg,
+		partitioningEnabled: true,
+		tenantActivity:      db.tenantActivity,
+	}
+	for name, status := range statuses {
+		if status == models.TenantActivityStatusHOT {
+			idx.shards.Store(name, nil)
+		}
+	}
+	db.indices[idx.ID()] = idx
+	return sg, idx
+}
+
+func TestTenantActivity_Activate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("activation enabled", func(t *testing.T) {
+		sg, idx := newTenantActivityTest(t,
+			&models.MultiTenancyConfig{Enabled: true, AutoTenantActivation: true}, 0,
+			map[string]string{"cold": models.TenantActivityStatusCOLD})
+
+		shard, err := idx.tenantShard(ctx, "cold")
+		require.Nil(t, err)
+		assert.Equal(t, "cold", shard)
+		assert.Equal(t, []string{"cold"}, sg.activated)
+
+		_, err = idx.tenantShard(ctx, "missing")
+		assert.ErrorContains(t, err, errTenantNotFound.Error())
+	})
+
+	t.Run("activation disabled", func(t *testing.T) {
+		sg, idx := newTenantActivityTest(t,
+			&models.MultiTenancyConfig{Enabled: true}, 0,
+			map[string]string{"cold": models.TenantActivityStatusCOLD})
+
+		_, err := idx.tenantShard(ctx, "cold")
+		assert.ErrorContains(t, err, errTenantNotActive.Error())
+		assert.Empty(t, sg.activated)
+	})
+
+	t.Run("evict least recently used", func(t *testing.T) {
+		sg, idx := newTenantActivityTest(t,
+			&models.MultiTenancyConfig{Enabled: true, AutoTenantActivation: true}, 2,
+			map[string]string{
+				"hot1": models.TenantActivityStatusHOT,
+				"hot2": models.TenantActivityStatusHOT,
+				"cold": models.TenantActivityStatusCOLD,
+			})
+		idx.tenantsUsage.Store("hot1", time.Now().Add(-time.Minute))
+		idx.tenantsUsage.Store("hot2", time.Now())
+
+		_, err := idx.tenantShard(ctx, "cold")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"hot1"}, sg.deactivated)
+	})
+}
+
+func TestTenantActivity_DeactivateIdle(t *testing.T) {
+	ctx := context.Background()
+	sg, idx := newTenantActivityTest(t,
+		&models.MultiTenancyConfig{
+			Enabled: true, AutoTenantActivation: true, AutoTenantDeactivationTimeoutSeconds: 60,
+		}, 0,
+		map[string]string{
+			"idle":   models.TenantActivityStatusHOT,
+			"used":   models.TenantActivityStatusHOT,
+			"unseen": models.TenantActivityStatusHOT,
+		})
+	idx.tenantsUsage.Store("idle", time.Now().Add(-2*time.Minute))
+	idx.touchShard("used")
+	// usage of shards which are not loaded anymore is forgotten
+	idx.tenantsUsage.Store("dropped", time.Now().Add(-2*time.Minute))
+
+	idx.tenantActivity.deactivateTenants(ctx)
+	assert.Equal(t, []string{"idle"}, sg.deactivated)
+
+	_, ok := idx.tenantsUsage.Load("dropped")
+	assert.False(t, ok)
+	_, ok = idx.tenantsUsage.Load("unseen")
+	assert.True(t, ok)
+}
+
+func TestTenantActivity_DeactivateIdleOnAllReplicas(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	newTest := func(remote *fakeReplicaUsage, maxActive int) (*fakeTenantSchema, *Index) {
+		sg, idx := newTenantActivityTest(t,
+			&models.MultiTenancyConfig{
+				Enabled: true, AutoTenantActivation: true, AutoTenantDeactivationTimeoutSeconds: 60,
+			}, maxActive,
+			map[string]string{
+				"idle":          models.TenantActivityStatusHOT,
+				"used-remotely": models.TenantActivityStatusHOT,
+			})
+		sg.replicas = []string{"node1", "node2"}
+		idx.remote = sharding.NewRemoteIndex("Auto", sg, remote, remote)
+		idx.tenantsUsage.Store("idle", now.Add(-3*time.Minute))
+		idx.tenantsUsage.Store("used-remotely", now.Add(-2*time.Minute))
+		return sg, idx
+	}
+
+	t.Run("tenant used on another replica is kept", func(t *testing.T) {
+		sg, idx := newTest(&fakeReplicaUsage{lastUsed: map[string]map[string]time.Time{
+			"node2": {"used-remotely": now},
+		}}, 0)
+
+		idx.tenantActivity.deactivateTenants(ctx)
+		assert.Equal(t, []string{"idle"}, sg.deactivated)
+	})
+
+	t.Run("tenants are kept if a replica can't be asked", func(t *testing.T) {
+		sg, idx := newTest(&fakeReplicaUsage{err: errors.New("unreachable")}, 0)
+
+		idx.tenantActivity.deactivateTenants(ctx)
+		assert.Empty(t, sg.deactivated)
+	})
+
+	t.Run("evict tenant least recently used on any replica", func(t *testing.T) {
+		sg, idx := newTest(&fakeReplicaUsage{lastUsed: map[string]map[string]time.Time{
+			"node2": {"idle": now},
+		}}, 1)
+		// not idle yet, only the limit applies
+		idx.tenantsUsage.Store("idle", now.Add(-time.Second))
+		idx.tenantsUsage.Store("used-remotely", now.Add(-2*time.Second))
+
+		idx.tenantActivity.evict(ctx, 0)
+		assert.Equal(t, []string{"used-remotely"}, sg.deactivated)
+	})
+}
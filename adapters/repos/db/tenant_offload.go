@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	ubak "github.com/weaviate/weaviate/usecases/backup"
+	"golang.org/x/sync/errgroup"
+)
+
+// tenantOffloadBackupID is the backup id the shards of frozen tenants are
+// stored under. Backup ids can't contain dots, so it can't collide with a
+// backup created by a user.
+const tenantOffloadBackupID = ".frozen-tenants"
+
+// SetTenantOffloadBackend sets the backend the shards of frozen tenants are
+// stored in
+func (db *DB) SetTenantOffloadBackend(backend modulecapabilities.BackupBackend) {
+	db.offloadBackend = backend
+}
+
+// tenantOffloadKey is the key of the archive holding all files of the local
+// replica of a shard. Every replica has its own archive, so that replicas
+// frozen at the same time don't overwrite each other's archive.
+func (i *Index) tenantOffloadKey(shardName string) string {
+	return fmt.Sprintf("%s/%s/%s.tar.gz", i.ID(), shardName, i.getSchema.NodeName())
+}
+
+// deleteOffloadedShard removes the archive of a frozen shard from the
+// backend. A missing archive is not an error.
+func (i *Index) deleteOffloadedShard(ctx context.Context,
+	backend modulecapabilities.BackupBackend, shardName string,
+) error {
+	key := i.tenantOffloadKey(shardName)
+	if err := backend.DeleteObject(ctx, tenantOffloadBackupID, key); err != nil {
+		return fmt.Errorf("delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// shardOffloaded tells if the files of a shard are missing locally
+func (i *Index) shardOffloaded(shardName string) bool {
+	lsmPath := path.Join(i.Config.RootPath, fmt.Sprintf("%s_%s_lsm", i.ID(), shardName))
+	_, err := os.Stat(lsmPath)
+	return os.IsNotExist(err)
+}
+
+// offloadStatus returns the transitional activity status of a shard that is
+// being frozen or unfrozen
+func (i *Index) offloadStatus(shardName string) (string, bool) {
+	status, ok := i.offloads.Load(shardName)
+	if !ok {
+		return "", false
+	}
+	return status.(string), true
+}
+
+// freezeShard uploads all files of an inactive shard to the backend and
+// drops the shard afterwards. If the upload fails the shard is shut down
+// instead, its files are kept so that it can be activated again.
+func (i *Index) freezeShard(ctx context.Context,
+	backend modulecapabilities.BackupBackend, shard *Shard,
+) error {
+	if err := i.uploadShard(ctx, backend, shard); err != nil {
+		if err2 := shard.shutdown(ctx); err2 != nil {
+			err = fmt.Errorf("%w: shutdown: %v", err, err2)
+		}
+		return err
+	}
+	return shard.drop()
+}
+
+func (i *Index) uploadShard(ctx context.Context,
+	backend modulecapabilities.BackupBackend, shard *Shard,
+) (err error) {
+	if err := shard.beginBackup(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err2 := shard.resumeMaintenanceCycles(ctx); err2 != nil && err == nil {
+			err = err2
+		}
+	}()
+
+	sd := &backup.ShardDescriptor{}
+	if err := shard.listBackupFiles(ctx, sd); err != nil {
+		return fmt.Errorf("list files: %w", err)
+	}
+
+	key := i.tenantOffloadKey(shard.name)
+	zip, reader := ubak.NewZip(i.Config.RootPath, int(ubak.DefaultCompression))
+	var eg errgroup.Group
+	eg.Go(func() error {
+		_, err := backend.Write(ctx, tenantOffloadBackupID, key, reader)
+		return err
+	})
+
+	_, err = zip.WriteShard(ctx, sd)
+	if err2 := zip.Close(); err == nil {
+		err = err2
+	}
+	if err2 := eg.Wait(); err == nil && err2 != nil {
+		err = fmt.Errorf("upload %s: %w", key, err2)
+	}
+	return err
+}
+
+// downloadShard restores the files of a frozen shard. They are written to a
+// temporary directory first, so that a failed download leaves no partial
+// shard behind.
+func (i *Index) downloadShard(ctx context.Context,
+	backend modulecapabilities.BackupBackend, shardName string,
+) error {
+	tempDir := path.Join(i.Config.RootPath, fmt.Sprintf(".unfreeze_%s_%s", i.ID(), shardName))
+	// remove leftovers of an interrupted download
+	if err := os.RemoveAll(tempDir); err != nil {
+		return fmt.Errorf("remove %s: %w", tempDir, err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	key := i.tenantOffloadKey(shardName)
+	uz, w := ubak.NewUnzip(tempDir)
+	var eg errgroup.Group
+	eg.Go(func() error {
+		_, err := backend.Read(ctx, tenantOffloadBackupID, key, w)
+		return err
+	})
+
+	_, err := uz.ReadChunk()
+	uz.Close()
+	// the archive is complete once it has been read without an error, the
+	// reader is closed before the end of the stream though, so the error of
+	// the backend only matters if reading the archive failed
+	if err2 := eg.Wait(); err != nil {
+		if err2 != nil {
+			err = err2
+		}
+		return fmt.Errorf("download %s: %w", key, err)
+	}
+
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", tempDir, err)
+	}
+	for _, entry := range entries {
+		from := path.Join(tempDir, entry.Name())
+		to := path.Join(i.Config.RootPath, entry.Name())
+		if err := os.RemoveAll(to); err != nil {
+			return fmt.Errorf("remove %s: %w", to, err)
+		}
+		if err := os.Rename(from, to); err != nil {
+			return fmt.Errorf("move %s %s: %w", from, to, err)
+		}
+	}
+	return nil
+}
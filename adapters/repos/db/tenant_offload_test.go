@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
+)
+
+// fakeOffloadBackend keeps the written objects in memory, only Write and
+// Read are used to offload tenants
+type fakeOffloadBackend struct {
+	modulecapabilities.BackupBackend
+
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeOffloadBackend) Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error) {
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.objects[path.Join(backupID, key)] = data
+	return int64(len(data)), nil
+}
+
+func (f *fakeOffloadBackend) DeleteObject(ctx context.Context, backupID, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, path.Join(backupID, key))
+	return nil
+}
+
+func (f *fakeOffloadBackend) Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error) {
+	defer w.Close()
+
+	f.Lock()
+	data, ok := f.objects[path.Join(backupID, key)]
+	f.Unlock()
+	if !ok {
+		return 0, fmt.Errorf("object %s/%s not found", backupID, key)
+	}
+	return io.Copy(w, bytes.NewReader(data))
+}
+
+func TestIndex_FreezeShard(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	name := shd.name
+	backend := &fakeOffloadBackend{objects: map[string][]byte{}}
+
+	amount := 10
+	for i := 0; i < amount; i++ {
+		require.Nil(t, shd.putObject(ctx, testObject(className)))
+	}
+
+	t.Run("freeze shard", func(t *testing.T) {
+		idx.shards.LoadAndDelete(name)
+		require.Nil(t, idx.freezeShard(ctx, backend, shd))
+
+		assert.True(t, idx.shardOffloaded(name))
+		assert.Contains(t, backend.objects, path.Join(tenantOffloadBackupID, idx.tenantOffloadKey(name)))
+		assert.Contains(t, idx.tenantOffloadKey(name), idx.getSchema.NodeName(),
+			"every replica has its own archive")
+	})
+
+	t.Run("unfreeze shard", func(t *testing.T) {
+		require.Nil(t, idx.downloadShard(ctx, backend, name))
+		assert.False(t, idx.shardOffloaded(name))
+
+		class := &models.Class{Class: className}
+		shd, err := NewShard(ctx, nil, name, idx, class, idx.centralJobQueue)
+		require.Nil(t, err)
+		idx.shards.Store(name, shd)
+
+		objs, err := shd.objectList(ctx, 2*amount, nil, nil, additional.Properties{}, idx.Config.ClassName)
+		require.Nil(t, err)
+		assert.Len(t, objs, amount)
+	})
+
+	t.Run("unfreeze missing shard", func(t *testing.T) {
+		err := idx.downloadShard(ctx, backend, "missing")
+		assert.ErrorContains(t, err, "not found")
+
+		entries, err := os.ReadDir(idx.Config.RootPath)
+		require.Nil(t, err)
+		for _, entry := range entries {
+			assert.NotContains(t, entry.Name(), "missing")
+		}
+	})
+
+	require.Nil(t, idx.drop())
+}
+
+func TestMigrator_DeleteFrozenShards(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	class := &models.Class{Class: className}
+	backend := &fakeOffloadBackend{objects: map[string][]byte{}}
+	logger, _ := test.NewNullLogger()
+	db := &DB{
+		logger:         logger,
+		indices:        map[string]*Index{idx.ID(): idx},
+		offloadBackend: backend,
+	}
+	m := NewMigrator(db, logger)
+	state := idx.getSchema.CopyShardingState(className)
+
+	freeze := func(t *testing.T, shard *Shard) {
+		idx.shards.LoadAndDelete(shard.name)
+		require.Nil(t, idx.freezeShard(ctx, backend, shard))
+		require.Contains(t, backend.objects, path.Join(tenantOffloadBackupID, idx.tenantOffloadKey(shard.name)))
+		physical := state.Physical[shard.name]
+		physical.Name = shard.name
+		physical.BelongsToNodes = []string{"node1"}
+		physical.Status = models.TenantActivityStatusFROZEN
+		state.Physical[shard.name] = physical
+	}
+
+	t.Run("delete frozen tenant", func(t *testing.T) {
+		freeze(t, shd)
+
+		commit, err := m.DeleteTenants(ctx, class, []string{shd.name})
+		require.Nil(t, err)
+		commit(true)
+		assert.NotContains(t, backend.objects, path.Join(tenantOffloadBackupID, idx.tenantOffloadKey(shd.name)))
+	})
+
+	t.Run("drop class with frozen tenant", func(t *testing.T) {
+		require.Nil(t, idx.addNewShard(ctx, class, "frozen"))
+		freeze(t, idx.localShard("frozen"))
+
+		require.Nil(t, m.DropClass(ctx, className))
+		assert.Empty(t, backend.objects)
+	})
+}
+
+func TestMigrator_PrepareUpdateTenants(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	name := shd.name
+	class := &models.Class{Class: className}
+	backend := &fakeOffloadBackend{objects: map[string][]byte{}}
+	logger, _ := test.NewNullLogger()
+	db := &DB{
+		logger:         logger,
+		indices:        map[string]*Index{idx.ID(): idx},
+		offloadBackend: backend,
+	}
+	m := NewMigrator(db, logger)
+	for i := 0; i < 10; i++ {
+		require.Nil(t, shd.putObject(ctx, testObject(className)))
+	}
+
+	idx.shards.LoadAndDelete(name)
+	require.Nil(t, idx.freezeShard(ctx, backend, shd))
+	state := idx.getSchema.CopyShardingState(className)
+	physical := state.Physical[name]
+	physical.Name = name
+	physical.BelongsToNodes = []string{"node1"}
+	physical.Status = models.TenantActivityStatusFROZEN
+	state.Physical[name] = physical
+	updates := []*migrate.UpdateTenantPayload{{Name: name, Status: models.TenantActivityStatusHOT}}
+
+	t.Run("failed download", func(t *testing.T) {
+		key := path.Join(tenantOffloadBackupID, idx.tenantOffloadKey(name))
+		archive := backend.objects[key]
+		delete(backend.objects, key)
+		defer func() { backend.objects[key] = archive }()
+
+		err := m.PrepareUpdateTenants(ctx, class, updates)
+		assert.ErrorContains(t, err, "not found")
+		assert.True(t, idx.shardOffloaded(name))
+		_, ok := idx.offloadStatus(name)
+		assert.False(t, ok)
+	})
+
+	t.Run("download before commit", func(t *testing.T) {
+		require.Nil(t, m.PrepareUpdateTenants(ctx, class, updates))
+		assert.False(t, idx.shardOffloaded(name))
+
+		commit, err := m.UpdateTenants(ctx, class, updates)
+		require.Nil(t, err)
+		commit(true)
+		require.NotNil(t, idx.localShard(name))
+		assert.NotContains(t, backend.objects, path.Join(tenantOffloadBackupID, idx.tenantOffloadKey(name)))
+	})
+
+	require.Nil(t, idx.drop())
+}
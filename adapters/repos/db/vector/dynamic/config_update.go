@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/schema"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+// ValidateUserConfigUpdate validates the shared settings of the dynamic index
+// and delegates the nested configs to the validation of the respective
+// index, as either of them can be in use.
+func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
+	initialParsed, ok := initial.(dynament.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(dynament.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%v\" to \"%v\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	// the threshold is mutable, but an index which was upgraded already is
+	// never downgraded again
+
+	if err := flat.ValidateUserConfigUpdate(initialParsed.FlatUC,
+		updatedParsed.FlatUC); err != nil {
+		return errors.Wrap(err, "flat")
+	}
+
+	if err := hnsw.ValidateUserConfigUpdate(initialParsed.HnswUC,
+		updatedParsed.HnswUC); err != nil {
+		return errors.Wrap(err, "hnsw")
+	}
+
+	return nil
+}
@@ -0,0 +1,737 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/schema"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"golang.org/x/sync/errgroup"
+)
+
+// The state of a dynamic index is persisted, so that an upgrade which was
+// interrupted by a crash or shutdown can be cleaned up on the next startup.
+const (
+	// the flat index serves all requests
+	stateFlat = "flat"
+	// the hnsw index is being built, it may be incomplete
+	stateUpgrading = "upgrading"
+	// the hnsw index serves all requests, the flat index still needs to be
+	// removed
+	stateUpgraded = "upgraded"
+	// the hnsw index serves all requests
+	stateHnsw = "hnsw"
+)
+
+const (
+	stateKey         = "state"
+	upgradeBatchSize = 1000
+	migrationLocks   = 128
+)
+
+// Config for a new dynamic index, this contains information that is derived
+// internally, e.g. by the shard. All User-settable config is specified in
+// dynament.UserConfig
+type Config struct {
+	ID                    string
+	TargetVector          string
+	RootPath              string
+	ShardName             string
+	ClassName             string
+	Logger                logrus.FieldLogger
+	DistanceProvider      distancer.Provider
+	PrometheusMetrics     *monitoring.PrometheusMetrics
+	VectorForIDThunk      hnsw.VectorForID
+	TempVectorForIDThunk  hnsw.TempVectorForID
+	MakeCommitLoggerThunk hnsw.MakeCommitLogger
+	AvoidMMap             bool
+
+	TombstoneCallbacks       cyclemanager.CycleCallbackGroup
+	ShardCompactionCallbacks cyclemanager.CycleCallbackGroup
+	ShardFlushCallbacks      cyclemanager.CycleCallbackGroup
+
+	// SharedDB is the shard's LSM store, it holds the vectors of the flat index
+	// as well as the state of the dynamic index
+	SharedDB *lsmkv.Store
+}
+
+func (c Config) Validate() error {
+	ec := &errorcompounder.ErrorCompounder{}
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	if c.RootPath == "" {
+		ec.Addf("rootPath cannot be empty")
+	}
+
+	if c.MakeCommitLoggerThunk == nil {
+		ec.Addf("makeCommitLoggerThunk cannot be nil")
+	}
+
+	if c.VectorForIDThunk == nil {
+		ec.Addf("vectorForIDThunk cannot be nil")
+	}
+
+	if c.DistanceProvider == nil {
+		ec.Addf("distancerProvider cannot be nil")
+	}
+
+	if c.SharedDB == nil {
+		ec.Addf("sharedDB cannot be nil")
+	}
+
+	return ec.ToError()
+}
+
+type vectorIndex interface {
+	Dump(labels ...string)
+	Add(id uint64, vector []float32) error
+	Delete(id ...uint64) error
+	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistance(vector []float32, dist float32,
+		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error
+	Drop(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+	Flush() error
+	SwitchCommitLogs(ctx context.Context) error
+	ListFiles(ctx context.Context) ([]string, error)
+	PostStartup()
+	ValidateBeforeInsert(vector []float32) error
+}
+
+type flatIndex interface {
+	vectorIndex
+	Count() int
+	Iterate(batchSize int, f func(id uint64, vector []float32) error) error
+	DropBuckets(ctx context.Context) error
+}
+
+// dynamic starts out as a flat index and upgrades itself to an hnsw index
+// once it holds more vectors than the configured threshold. This way the many
+// small shards of a multi-tenant class do not pay for an hnsw graph, while
+// the few large ones still get fast searches.
+//
+// The upgrade runs in the background. While the hnsw index is built, the flat
+// index keeps serving all searches and writes are applied to both indexes.
+// Once the hnsw index has caught up, it replaces the flat index.
+type dynamic struct {
+	// lock protects the fields below. Searches and writes hold a read lock,
+	// an exclusive lock is only needed to swap the indexes.
+	sync.RWMutex
+	uc        dynament.UserConfig
+	index     vectorIndex
+	flat      flatIndex // nil once the index is upgraded
+	migration *migration
+	upgraded  bool
+	dropping  bool
+
+	cfg         Config
+	logger      logrus.FieldLogger
+	stateBucket *lsmkv.Bucket
+
+	// estimated number of vectors in the flat index, updates and deletes are
+	// not taken into account. The exact count is only looked up once the
+	// estimate passes the threshold.
+	count     int64
+	upgrading int32
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a dynamic index. Depending on the persisted state, this is
+// backed by a flat or an hnsw index.
+func New(cfg Config, uc dynament.UserConfig) (*dynamic, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	index := &dynamic{
+		uc:     uc,
+		cfg:    cfg,
+		logger: cfg.Logger.WithField("action", "dynamic_index").WithField("id", cfg.ID),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	if err := index.init(); err != nil {
+		cancel()
+		return nil, errors.Wrapf(err, "init dynamic index %q", cfg.ID)
+	}
+
+	return index, nil
+}
+
+func (d *dynamic) init() error {
+	bucketName := d.stateBucketName()
+	if err := d.cfg.SharedDB.CreateOrLoadBucket(context.Background(), bucketName,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(d.cfg.AvoidMMap),
+	); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", bucketName)
+	}
+	d.stateBucket = d.cfg.SharedDB.Bucket(bucketName)
+
+	state, err := d.state()
+	if err != nil {
+		return err
+	}
+
+	switch state {
+	case stateFlat:
+		return d.initFlat()
+
+	case stateUpgrading:
+		// the upgrade was interrupted, the hnsw index is incomplete. It is
+		// dropped and the upgrade starts over once the index is ready.
+		target, err := d.newHnsw(d.uc)
+		if err != nil {
+			return err
+		}
+		if err := target.Drop(context.Background()); err != nil {
+			return errors.Wrap(err, "drop incomplete hnsw index")
+		}
+		if err := d.setState(stateFlat); err != nil {
+			return err
+		}
+		return d.initFlat()
+
+	case stateUpgraded:
+		// the upgrade completed, but the flat index was not removed yet
+		flatIndex, err := d.newFlat(d.uc)
+		if err != nil {
+			return err
+		}
+		if err := flatIndex.DropBuckets(context.Background()); err != nil {
+			return errors.Wrap(err, "drop flat index")
+		}
+		if err := d.setState(stateHnsw); err != nil {
+			return err
+		}
+		return d.initHnsw()
+
+	case stateHnsw:
+		return d.initHnsw()
+
+	default:
+		return errors.Errorf("unknown state %q", state)
+	}
+}
+
+func (d *dynamic) initFlat() error {
+	flatIndex, err := d.newFlat(d.uc)
+	if err != nil {
+		return err
+	}
+	d.index = flatIndex
+	d.flat = flatIndex
+	return nil
+}
+
+func (d *dynamic) initHnsw() error {
+	hnswIndex, err := d.newHnsw(d.uc)
+	if err != nil {
+		return err
+	}
+	d.index = hnswIndex
+	d.upgraded = true
+	return nil
+}
+
+func (d *dynamic) newFlat(uc dynament.UserConfig) (flatIndex, error) {
+	return flat.New(flat.Config{
+		ID:               d.cfg.ID,
+		TargetVector:     d.cfg.TargetVector,
+		Logger:           d.cfg.Logger,
+		DistanceProvider: d.cfg.DistanceProvider,
+		AvoidMMap:        d.cfg.AvoidMMap,
+	}, uc.FlatUC, d.cfg.SharedDB)
+}
+
+func (d *dynamic) newHnsw(uc dynament.UserConfig) (vectorIndex, error) {
+	return hnsw.New(hnsw.Config{
+		Logger:                d.cfg.Logger,
+		RootPath:              d.cfg.RootPath,
+		ID:                    d.cfg.ID,
+		TargetVector:          d.cfg.TargetVector,
+		ShardName:             d.cfg.ShardName,
+		ClassName:             d.cfg.ClassName,
+		PrometheusMetrics:     d.cfg.PrometheusMetrics,
+		VectorForIDThunk:      d.cfg.VectorForIDThunk,
+		TempVectorForIDThunk:  d.cfg.TempVectorForIDThunk,
+		DistanceProvider:      d.cfg.DistanceProvider,
+		MakeCommitLoggerThunk: d.cfg.MakeCommitLoggerThunk,
+	}, uc.HnswUC, d.callbackGroup(d.cfg.TombstoneCallbacks),
+		d.callbackGroup(d.cfg.ShardCompactionCallbacks),
+		d.callbackGroup(d.cfg.ShardFlushCallbacks))
+}
+
+func (d *dynamic) callbackGroup(group cyclemanager.CycleCallbackGroup) cyclemanager.CycleCallbackGroup {
+	if group == nil {
+		return cyclemanager.NewCallbackGroupNoop()
+	}
+	return group
+}
+
+func (d *dynamic) stateBucketName() string {
+	if d.cfg.TargetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.DynamicIndexStateBucketLSM, d.cfg.TargetVector)
+	}
+	return helpers.DynamicIndexStateBucketLSM
+}
+
+func (d *dynamic) state() (string, error) {
+	state, err := d.stateBucket.Get([]byte(stateKey))
+	if err != nil {
+		return "", errors.Wrap(err, "read state")
+	}
+	if state == nil {
+		return stateFlat, nil
+	}
+	return string(state), nil
+}
+
+func (d *dynamic) setState(state string) error {
+	if err := d.stateBucket.Put([]byte(stateKey), []byte(state)); err != nil {
+		return errors.Wrapf(err, "set state %q", state)
+	}
+	// the state has to survive a crash, so it cannot wait for the next
+	// memtable flush
+	if err := d.stateBucket.WriteWAL(); err != nil {
+		return errors.Wrapf(err, "persist state %q", state)
+	}
+	return nil
+}
+
+// Upgraded returns whether the index is backed by hnsw
+func (d *dynamic) Upgraded() bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.upgraded
+}
+
+func (d *dynamic) Add(id uint64, vector []float32) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if err := d.index.Add(id, vector); err != nil {
+		return err
+	}
+
+	if d.migration != nil {
+		if err := d.migration.add(id, vector); err != nil {
+			return errors.Wrap(err, "add to hnsw index during upgrade")
+		}
+		return nil
+	}
+
+	if !d.upgraded {
+		d.maybeUpgrade(atomic.AddInt64(&d.count, 1))
+	}
+	return nil
+}
+
+func (d *dynamic) Delete(ids ...uint64) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if err := d.index.Delete(ids...); err != nil {
+		return err
+	}
+
+	if d.migration != nil {
+		if err := d.migration.delete(ids...); err != nil {
+			return errors.Wrap(err, "delete from hnsw index during upgrade")
+		}
+	}
+	return nil
+}
+
+func (d *dynamic) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SearchByVector(vector, k, allow)
+}
+
+func (d *dynamic) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SearchByVectorDistance(vector, targetDistance, maxLimit, allow)
+}
+
+func (d *dynamic) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(dynament.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.uc = parsed
+	if d.upgraded {
+		return d.index.UpdateUserConfig(parsed.HnswUC, callback)
+	}
+
+	if d.migration != nil {
+		if err := d.migration.target.UpdateUserConfig(parsed.HnswUC, func() {}); err != nil {
+			callback()
+			return err
+		}
+	}
+	return d.index.UpdateUserConfig(parsed.FlatUC, callback)
+}
+
+func (d *dynamic) Drop(ctx context.Context) error {
+	d.Lock()
+	d.dropping = true
+	d.Unlock()
+
+	d.stopUpgrade()
+
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.Drop(ctx)
+}
+
+func (d *dynamic) Shutdown(ctx context.Context) error {
+	d.stopUpgrade()
+
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.Shutdown(ctx)
+}
+
+func (d *dynamic) Flush() error {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.Flush()
+}
+
+func (d *dynamic) SwitchCommitLogs(ctx context.Context) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SwitchCommitLogs(ctx)
+}
+
+// ListFiles only lists the files of the index which serves the requests. An
+// hnsw index which is still being built is left out, it would be dropped on
+// restore anyway.
+func (d *dynamic) ListFiles(ctx context.Context) ([]string, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.ListFiles(ctx)
+}
+
+func (d *dynamic) ValidateBeforeInsert(vector []float32) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.ValidateBeforeInsert(vector)
+}
+
+// PostStartup also resumes an upgrade which was interrupted or not started
+// before the last shutdown
+func (d *dynamic) PostStartup() {
+	d.RLock()
+	defer d.RUnlock()
+
+	d.index.PostStartup()
+
+	if !d.upgraded {
+		count := int64(d.flat.Count())
+		atomic.StoreInt64(&d.count, count)
+		d.maybeUpgrade(count)
+	}
+}
+
+func (d *dynamic) Dump(labels ...string) {
+	d.RLock()
+	defer d.RUnlock()
+
+	d.index.Dump(labels...)
+}
+
+// maybeUpgrade starts the upgrade in the background if the flat index holds
+// more vectors than the threshold. Must be called with the read lock held.
+func (d *dynamic) maybeUpgrade(estimated int64) {
+	if estimated < int64(d.uc.Threshold) || d.ctx.Err() != nil {
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&d.upgrading, 0, 1) {
+		return
+	}
+
+	// the estimate also counts updates, so check the actual number first
+	count := int64(d.flat.Count())
+	atomic.StoreInt64(&d.count, count)
+	if count < int64(d.uc.Threshold) {
+		atomic.StoreInt32(&d.upgrading, 0)
+		return
+	}
+
+	d.wg.Add(1)
+	go d.upgrade()
+}
+
+func (d *dynamic) upgrade() {
+	defer d.wg.Done()
+	defer atomic.StoreInt32(&d.upgrading, 0)
+
+	before := time.Now()
+	if err := d.doUpgrade(); err != nil {
+		if errors.Is(err, context.Canceled) {
+			d.logger.Info("upgrade to hnsw interrupted, it is resumed on the next startup")
+			return
+		}
+
+		// try again once another threshold of vectors was added
+		atomic.StoreInt64(&d.count, 0)
+		d.logger.WithError(err).Error("upgrade to hnsw failed")
+		return
+	}
+
+	d.logger.WithField("took", time.Since(before)).Info("upgraded flat index to hnsw")
+}
+
+func (d *dynamic) doUpgrade() error {
+	if err := d.setState(stateUpgrading); err != nil {
+		return err
+	}
+
+	d.RLock()
+	uc := d.uc
+	d.RUnlock()
+
+	target, err := d.newHnsw(uc)
+	if err != nil {
+		return errors.Wrap(err, "create hnsw index")
+	}
+	target.PostStartup()
+
+	// from now on every write is applied to both indexes
+	m := newMigration(target)
+	d.Lock()
+	d.migration = m
+	d.Unlock()
+
+	if err := d.copyToHnsw(m); err != nil {
+		d.Lock()
+		d.migration = nil
+		dropping := d.dropping
+		d.Unlock()
+
+		return d.abortUpgrade(target, dropping, err)
+	}
+
+	d.Lock()
+	if err := d.setState(stateUpgraded); err != nil {
+		d.migration = nil
+		d.Unlock()
+		return d.abortUpgrade(target, false, err)
+	}
+	flatIndex := d.flat
+	d.index = target
+	d.flat = nil
+	d.migration = nil
+	d.upgraded = true
+	d.Unlock()
+
+	// no search or write can reach the flat index anymore. If removing it
+	// fails, it is retried on the next startup.
+	if err := flatIndex.DropBuckets(context.Background()); err != nil {
+		d.logger.WithError(err).Warn("remove flat index after upgrade")
+		return nil
+	}
+
+	if err := d.setState(stateHnsw); err != nil {
+		d.logger.WithError(err).Warn("set state after upgrade")
+	}
+	return nil
+}
+
+// copyToHnsw adds all vectors of the flat index to the hnsw index
+func (d *dynamic) copyToHnsw(m *migration) error {
+	eg := &errgroup.Group{}
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+
+	err := d.flat.Iterate(upgradeBatchSize, func(id uint64, vector []float32) error {
+		if err := d.ctx.Err(); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			return m.copy(id, vector)
+		})
+		return nil
+	})
+	if egErr := eg.Wait(); err == nil {
+		err = egErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return m.target.Flush()
+}
+
+// abortUpgrade leaves the state at "upgrading" if the upgrade was
+// interrupted by a shutdown, so that the incomplete hnsw index is cleaned up on
+// the next startup
+func (d *dynamic) abortUpgrade(target vectorIndex, dropping bool, cause error) error {
+	if errors.Is(cause, context.Canceled) && !dropping {
+		if err := target.Shutdown(context.Background()); err != nil {
+			d.logger.WithError(err).Warn("shut down incomplete hnsw index")
+		}
+		return cause
+	}
+
+	if err := target.Drop(context.Background()); err != nil {
+		d.logger.WithError(err).Warn("drop incomplete hnsw index")
+		return cause
+	}
+
+	if !dropping {
+		if err := d.setState(stateFlat); err != nil {
+			d.logger.WithError(err).Warn("reset state after failed upgrade")
+		}
+	}
+	return cause
+}
+
+// stopUpgrade interrupts a running upgrade and waits for it to stop. No new
+// upgrade is started afterwards.
+func (d *dynamic) stopUpgrade() {
+	d.Lock()
+	d.cancel()
+	d.Unlock()
+
+	d.wg.Wait()
+}
+
+// migration applies the writes which happen during an upgrade to the hnsw
+// index. Vectors which were written during the upgrade are marked as dirty,
+// so that the copy of the flat index does not overwrite them with an older
+// version.
+type migration struct {
+	target vectorIndex
+
+	locks     []sync.Mutex
+	dirtyLock sync.Mutex
+	dirty     map[uint64]struct{}
+}
+
+func newMigration(target vectorIndex) *migration {
+	return &migration{
+		target: target,
+		locks:  make([]sync.Mutex, migrationLocks),
+		dirty:  map[uint64]struct{}{},
+	}
+}
+
+func (m *migration) lockFor(id uint64) *sync.Mutex {
+	return &m.locks[id%uint64(len(m.locks))]
+}
+
+func (m *migration) markDirty(id uint64) {
+	m.dirtyLock.Lock()
+	defer m.dirtyLock.Unlock()
+
+	m.dirty[id] = struct{}{}
+}
+
+func (m *migration) isDirty(id uint64) bool {
+	m.dirtyLock.Lock()
+	defer m.dirtyLock.Unlock()
+
+	_, ok := m.dirty[id]
+	return ok
+}
+
+func (m *migration) add(id uint64, vector []float32) error {
+	lock := m.lockFor(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	m.markDirty(id)
+	return m.target.Add(id, vector)
+}
+
+func (m *migration) delete(ids ...uint64) error {
+	for _, id := range ids {
+		if err := m.deleteOne(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *migration) deleteOne(id uint64) error {
+	lock := m.lockFor(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	m.markDirty(id)
+	return m.target.Delete(id)
+}
+
+func (m *migration) copy(id uint64, vector []float32) error {
+	lock := m.lockFor(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if m.isDirty(id) {
+		return nil
+	}
+	return m.target.Add(id, vector)
+}
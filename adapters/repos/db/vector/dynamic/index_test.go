@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+// testVectors stands in for the objects bucket of the shard, which the hnsw
+// index reads the vectors from
+type testVectors struct {
+	sync.RWMutex
+	vectors map[uint64][]float32
+}
+
+func (v *testVectors) put(id uint64, vector []float32) {
+	v.Lock()
+	defer v.Unlock()
+	v.vectors[id] = vector
+}
+
+func (v *testVectors) get(ctx context.Context, id uint64) ([]float32, error) {
+	v.RLock()
+	defer v.RUnlock()
+
+	vector, ok := v.vectors[id]
+	if !ok {
+		return nil, fmt.Errorf("vector %d not found", id)
+	}
+	return vector, nil
+}
+
+type testIndex struct {
+	rootPath string
+	vectors  *testVectors
+	store    *lsmkv.Store
+}
+
+func newTestIndex(t *testing.T) *testIndex {
+	return &testIndex{
+		rootPath: t.TempDir(),
+		vectors:  &testVectors{vectors: map[uint64][]float32{}},
+	}
+}
+
+func (ti *testIndex) open(t *testing.T, uc dynament.UserConfig) *dynamic {
+	logger, _ := test.NewNullLogger()
+
+	store, err := lsmkv.New(ti.rootPath, ti.rootPath, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	ti.store = store
+
+	index, err := New(Config{
+		ID:               "dynamic",
+		RootPath:         ti.rootPath,
+		Logger:           logger,
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: ti.vectors.get,
+		TempVectorForIDThunk: func(ctx context.Context, id uint64,
+			container *hnsw.VectorSlice,
+		) ([]float32, error) {
+			return ti.vectors.get(ctx, id)
+		},
+		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+			return hnsw.NewCommitLogger(ti.rootPath, "dynamic", logger,
+				cyclemanager.NewCallbackGroupNoop())
+		},
+		SharedDB: store,
+	}, uc)
+	require.Nil(t, err)
+	index.PostStartup()
+
+	return index
+}
+
+func (ti *testIndex) close(t *testing.T, index *dynamic) {
+	require.Nil(t, index.Flush())
+	require.Nil(t, index.Shutdown(context.Background()))
+	require.Nil(t, ti.store.Shutdown(context.Background()))
+}
+
+func (ti *testIndex) add(t *testing.T, index *dynamic, id uint64, vector []float32) {
+	ti.vectors.put(id, vector)
+	require.Nil(t, index.Add(id, vector))
+}
+
+func testUserConfig(threshold int) dynament.UserConfig {
+	uc := dynament.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	uc.HnswUC.Distance = "l2-squared"
+	uc.FlatUC.Distance = "l2-squared"
+	uc.Threshold = threshold
+	return uc
+}
+
+func randomVector(r *rand.Rand, dims int) []float32 {
+	vector := make([]float32, dims)
+	for i := range vector {
+		vector[i] = r.Float32()
+	}
+	return vector
+}
+
+func waitForUpgrade(t *testing.T, index *dynamic) {
+	assert.Eventually(t, index.Upgraded, 10*time.Second, 10*time.Millisecond)
+	// the upgrading flag is cleared right after the indexes were swapped
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&index.upgrading) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestDynamicIndex(t *testing.T) {
+	ti := newTestIndex(t)
+	r := rand.New(rand.NewSource(7))
+	threshold := 200
+	index := ti.open(t, testUserConfig(threshold))
+
+	t.Run("stays flat below the threshold", func(t *testing.T) {
+		for i := 0; i < threshold-1; i++ {
+			ti.add(t, index, uint64(i), randomVector(r, 8))
+		}
+		assert.False(t, index.Upgraded())
+
+		ids, _, err := index.SearchByVector(ti.vectors.vectors[5], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{5}, ids)
+	})
+
+	t.Run("upgrades once the threshold is reached", func(t *testing.T) {
+		// keep writing and searching while the upgrade runs in the background
+		for i := threshold - 1; i < 2*threshold; i++ {
+			ti.add(t, index, uint64(i), randomVector(r, 8))
+
+			ids, _, err := index.SearchByVector(ti.vectors.vectors[uint64(i)], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{uint64(i)}, ids)
+		}
+		require.Nil(t, index.Delete(10))
+
+		waitForUpgrade(t, index)
+		assert.Nil(t, ti.store.Bucket(helpers.VectorsBucketLSM))
+	})
+
+	t.Run("the hnsw index holds all vectors", func(t *testing.T) {
+		for i := 0; i < 2*threshold; i++ {
+			if i == 10 {
+				continue
+			}
+			ids, _, err := index.SearchByVector(ti.vectors.vectors[uint64(i)], 1, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, 1)
+			assert.Equal(t, uint64(i), ids[0])
+		}
+
+		ids, _, err := index.SearchByVector(ti.vectors.vectors[10], 1, nil)
+		require.Nil(t, err)
+		assert.NotEqual(t, []uint64{10}, ids)
+	})
+
+	t.Run("the upgrade survives a restart", func(t *testing.T) {
+		ti.close(t, index)
+		index = ti.open(t, testUserConfig(threshold))
+		defer ti.close(t, index)
+
+		assert.True(t, index.Upgraded())
+		ids, _, err := index.SearchByVector(ti.vectors.vectors[42], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{42}, ids)
+	})
+}
+
+func TestDynamicIndexInterruptedUpgrade(t *testing.T) {
+	ti := newTestIndex(t)
+	r := rand.New(rand.NewSource(7))
+	threshold := 100
+
+	// a threshold which is never reached, so the upgrade can be set up by hand
+	index := ti.open(t, testUserConfig(1_000_000))
+	for i := 0; i < threshold; i++ {
+		ti.add(t, index, uint64(i), randomVector(r, 8))
+	}
+
+	t.Run("crash while building the hnsw index", func(t *testing.T) {
+		require.Nil(t, index.setState(stateUpgrading))
+		target, err := index.newHnsw(testUserConfig(threshold))
+		require.Nil(t, err)
+		for i := 0; i < threshold/2; i++ {
+			require.Nil(t, target.Add(uint64(i), ti.vectors.vectors[uint64(i)]))
+		}
+		require.Nil(t, target.Flush())
+		require.Nil(t, target.Shutdown(context.Background()))
+
+		ti.close(t, index)
+	})
+
+	t.Run("restart drops the incomplete index and upgrades again", func(t *testing.T) {
+		index = ti.open(t, testUserConfig(threshold))
+		defer ti.close(t, index)
+
+		waitForUpgrade(t, index)
+		for i := 0; i < threshold; i++ {
+			ids, _, err := index.SearchByVector(ti.vectors.vectors[uint64(i)], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{uint64(i)}, ids)
+		}
+	})
+}
+
+func TestValidateUserConfigUpdate(t *testing.T) {
+	initial := dynament.NewDefaultUserConfig()
+
+	t.Run("threshold is mutable", func(t *testing.T) {
+		updated := dynament.NewDefaultUserConfig()
+		updated.Threshold = 5
+		assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+
+	t.Run("distance is immutable", func(t *testing.T) {
+		updated := dynament.NewDefaultUserConfig()
+		updated.Distance = "dot"
+		assert.NotNil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+
+	t.Run("nested configs are validated", func(t *testing.T) {
+		updated := dynament.NewDefaultUserConfig()
+		updated.HnswUC.MaxConnections = 10
+		assert.NotNil(t, ValidateUserConfigUpdate(initial, updated))
+
+		updated = dynament.NewDefaultUserConfig()
+		updated.FlatUC.BQ.Enabled = true
+		assert.NotNil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+}
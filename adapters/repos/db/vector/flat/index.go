@@ -0,0 +1,553 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+// Config for a new flat index, this contains information that is derived
+// internally, e.g. by the shard. All User-settable config is specified in
+// flatent.UserConfig
+type Config struct {
+	ID               string
+	TargetVector     string
+	Logger           logrus.FieldLogger
+	DistanceProvider distancer.Provider
+	AvoidMMap        bool
+}
+
+func (c Config) Validate() error {
+	ec := &errorcompounder.ErrorCompounder{}
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	if c.DistanceProvider == nil {
+		ec.Addf("distancerProvider cannot be nil")
+	}
+
+	return ec.ToError()
+}
+
+// flat is a brute-force vector index. It does not hold any graph or vectors
+// in memory, but keeps the vectors in buckets of the shard's LSM store and
+// compares the query against every one of them. This makes it a good fit for
+// many small shards, such as tenants, where the cost of building and holding
+// an HNSW graph outweighs its benefits.
+type flat struct {
+	id                string
+	targetVector      string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+
+	dims int32
+
+	bqEnabled    bool
+	bq           ssdhelpers.BinaryQuantizer
+	rescoreLimit int64
+}
+
+// New creates a flat index which persists its vectors in the given store
+func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if store == nil {
+		return nil, errors.New("store cannot be nil")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	index := &flat{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		logger:            cfg.Logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		bqEnabled:         uc.BQ.Enabled,
+		bq:                ssdhelpers.NewBinaryQuantizer(),
+		rescoreLimit:      int64(uc.BQ.RescoreLimit),
+	}
+
+	if err := index.initBuckets(context.Background(), cfg.AvoidMMap); err != nil {
+		return nil, errors.Wrapf(err, "init flat index %q", cfg.ID)
+	}
+
+	return index, nil
+}
+
+func (index *flat) initBuckets(ctx context.Context, avoidMMap bool) error {
+	if err := index.store.CreateOrLoadBucket(ctx, index.bucketName(),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(avoidMMap),
+	); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", index.bucketName())
+	}
+
+	if !index.bqEnabled {
+		return nil
+	}
+
+	if err := index.store.CreateOrLoadBucket(ctx, index.compressedBucketName(),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(avoidMMap),
+	); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", index.compressedBucketName())
+	}
+
+	return nil
+}
+
+func (index *flat) bucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsBucketLSM
+}
+
+func (index *flat) compressedBucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsCompressedBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsCompressedBucketLSM
+}
+
+func (index *flat) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	atomic.CompareAndSwapInt32(&index.dims, 0, int32(len(vector)))
+
+	vector = index.normalized(vector)
+
+	key := idToKey(id)
+	if err := index.store.Bucket(index.bucketName()).Put(key,
+		float32SliceToBytes(vector)); err != nil {
+		return errors.Wrapf(err, "put vector %d", id)
+	}
+
+	if index.bqEnabled {
+		if err := index.store.Bucket(index.compressedBucketName()).Put(key,
+			uint64SliceToBytes(index.bq.Encode(vector))); err != nil {
+			return errors.Wrapf(err, "put compressed vector %d", id)
+		}
+	}
+
+	return nil
+}
+
+func (index *flat) Delete(ids ...uint64) error {
+	for _, id := range ids {
+		key := idToKey(id)
+		if err := index.store.Bucket(index.bucketName()).Delete(key); err != nil {
+			return errors.Wrapf(err, "delete vector %d", id)
+		}
+
+		if index.bqEnabled {
+			if err := index.store.Bucket(index.compressedBucketName()).Delete(key); err != nil {
+				return errors.Wrapf(err, "delete compressed vector %d", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (index *flat) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	vector = index.normalized(vector)
+	if index.bqEnabled {
+		return index.searchByVectorBQ(vector, k, allow)
+	}
+
+	heap := priorityqueue.NewMax(k)
+	if err := index.forEachCandidate(index.bucketName(), allow, func(id uint64, value []byte) error {
+		dist, _, err := index.distancerProvider.SingleDist(vector, bytesToFloat32Slice(value))
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance for %d", id)
+		}
+		addToHeap(heap, k, id, dist)
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := heapToResults(heap)
+	return ids, dists, nil
+}
+
+// searchByVectorBQ compares the query to all compressed vectors and then
+// rescores the best candidates with their uncompressed vectors
+func (index *flat) searchByVectorBQ(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	candidates := int(atomic.LoadInt64(&index.rescoreLimit))
+	if candidates < k {
+		candidates = k
+	}
+
+	query := index.bq.Encode(vector)
+	heap := priorityqueue.NewMax(candidates)
+	if err := index.forEachCandidate(index.compressedBucketName(), allow, func(id uint64, value []byte) error {
+		dist, err := index.bq.DistanceBetweenCompressedVectors(query, bytesToUint64Slice(value))
+		if err != nil {
+			return errors.Wrapf(err, "calculate compressed distance for %d", id)
+		}
+		addToHeap(heap, candidates, id, dist)
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	bucket := index.store.Bucket(index.bucketName())
+	rescored := priorityqueue.NewMax(k)
+	for heap.Len() > 0 {
+		id := heap.Pop().ID
+		value, err := bucket.Get(idToKey(id))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "get vector %d", id)
+		}
+		if value == nil {
+			// deleted in the meantime
+			continue
+		}
+
+		dist, _, err := index.distancerProvider.SingleDist(vector, bytesToFloat32Slice(value))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "calculate distance for %d", id)
+		}
+		addToHeap(rescored, k, id, dist)
+	}
+
+	ids, dists := heapToResults(rescored)
+	return ids, dists, nil
+}
+
+// SearchByVectorDistance returns all vectors within the target distance. As
+// every vector is looked at anyway, no iterative search as in HNSW is
+// required.
+func (index *flat) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	vector = index.normalized(vector)
+
+	var (
+		ids   []uint64
+		dists []float32
+	)
+	if err := index.forEachCandidate(index.bucketName(), allow, func(id uint64, value []byte) error {
+		dist, _, err := index.distancerProvider.SingleDist(vector, bytesToFloat32Slice(value))
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance for %d", id)
+		}
+		if dist <= targetDistance {
+			ids = append(ids, id)
+			dists = append(dists, dist)
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	sort.Sort(byDistance{ids: ids, dists: dists})
+
+	if maxLimit >= 0 && int64(len(ids)) > maxLimit {
+		index.logger.
+			WithField("action", "unlimited_vector_search").
+			Warnf("maximum search limit of %d results has been reached", maxLimit)
+		ids, dists = ids[:maxLimit], dists[:maxLimit]
+	}
+
+	return ids, dists, nil
+}
+
+// forEachCandidate calls f with every vector in the bucket. If an allow list
+// is set, only the vectors on the allow list are read. The value passed to f
+// is only valid until f returns.
+func (index *flat) forEachCandidate(bucketName string, allow helpers.AllowList,
+	f func(id uint64, value []byte) error,
+) error {
+	bucket := index.store.Bucket(bucketName)
+	if bucket == nil {
+		return errors.Errorf("bucket %q not found", bucketName)
+	}
+
+	if allow != nil {
+		it := allow.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			value, err := bucket.Get(idToKey(id))
+			if err != nil {
+				return errors.Wrapf(err, "get vector %d", id)
+			}
+			if value == nil {
+				continue
+			}
+			if err := f(id, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		if err := f(binary.LittleEndian.Uint64(key), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Drop is a no-op, the vectors are part of the shard's LSM store and are
+// removed together with it
+func (index *flat) Drop(ctx context.Context) error {
+	return nil
+}
+
+// Flush is a no-op, the writes are persisted through the WAL of the shard's
+// LSM store
+func (index *flat) Flush() error {
+	return nil
+}
+
+// Shutdown is a no-op, the buckets are shut down with the shard's LSM store
+func (index *flat) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+// SwitchCommitLogs is a no-op, the flat index does not write commit logs
+func (index *flat) SwitchCommitLogs(ctx context.Context) error {
+	return nil
+}
+
+// ListFiles returns no files, the buckets are backed up as part of the
+// shard's LSM store
+func (index *flat) ListFiles(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (index *flat) ValidateBeforeInsert(vector []float32) error {
+	dims := int(atomic.LoadInt32(&index.dims))
+	if dims == 0 {
+		return nil
+	}
+
+	if dims != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+
+	return nil
+}
+
+// PostStartup picks up the vector dimensions of an existing index, so that
+// ValidateBeforeInsert can reject vectors of a different length
+func (index *flat) PostStartup() {
+	cursor := index.store.Bucket(index.bucketName()).Cursor()
+	defer cursor.Close()
+
+	if key, value := cursor.First(); key != nil {
+		atomic.CompareAndSwapInt32(&index.dims, 0, int32(len(value)/4))
+	}
+}
+
+// Count returns the number of vectors in the index
+func (index *flat) Count() int {
+	return index.store.Bucket(index.bucketName()).Count()
+}
+
+// Iterate calls f for every vector in the index. The vectors are read in
+// batches and the cursor is closed while f runs, so a slow f does not hold up
+// writes or memtable flushes. Vectors written during the iteration may or may
+// not be passed to f.
+func (index *flat) Iterate(batchSize int, f func(id uint64, vector []float32) error) error {
+	bucket := index.store.Bucket(index.bucketName())
+
+	var (
+		ids     = make([]uint64, 0, batchSize)
+		vectors = make([][]float32, 0, batchSize)
+		lastKey []byte
+	)
+	for {
+		ids, vectors = ids[:0], vectors[:0]
+
+		cursor := bucket.Cursor()
+		var key, value []byte
+		if lastKey == nil {
+			key, value = cursor.First()
+		} else {
+			key, value = cursor.Seek(lastKey)
+			if key != nil && bytes.Equal(key, lastKey) {
+				key, value = cursor.Next()
+			}
+		}
+		for ; key != nil && len(ids) < batchSize; key, value = cursor.Next() {
+			ids = append(ids, binary.LittleEndian.Uint64(key))
+			vectors = append(vectors, bytesToFloat32Slice(value))
+			lastKey = append(lastKey[:0], key...)
+		}
+		cursor.Close()
+
+		for i := range ids {
+			if err := f(ids[i], vectors[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(ids) < batchSize {
+			return nil
+		}
+	}
+}
+
+// DropBuckets removes the buckets of the index from the shard's store. Unlike
+// Drop, it is meant for an index which is replaced while the shard keeps
+// running.
+func (index *flat) DropBuckets(ctx context.Context) error {
+	if err := index.store.DropBucket(ctx, index.bucketName()); err != nil {
+		return errors.Wrapf(err, "drop bucket %q", index.bucketName())
+	}
+
+	if !index.bqEnabled {
+		return nil
+	}
+
+	if err := index.store.DropBucket(ctx, index.compressedBucketName()); err != nil {
+		return errors.Wrapf(err, "drop bucket %q", index.compressedBucketName())
+	}
+
+	return nil
+}
+
+func (index *flat) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", labels[0])
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", index.id)
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (index *flat) normalized(vector []float32) []float32 {
+	if index.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func addToHeap(heap *priorityqueue.Queue, k int, id uint64, dist float32) {
+	if heap.Len() < k {
+		heap.Insert(id, dist)
+	} else if heap.Top().Dist > dist {
+		heap.Pop()
+		heap.Insert(id, dist)
+	}
+}
+
+// heapToResults drains the max heap, the results are ordered by ascending
+// distance
+func heapToResults(heap *priorityqueue.Queue) ([]uint64, []float32) {
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+
+	i := len(ids) - 1
+	for heap.Len() > 0 {
+		res := heap.Pop()
+		ids[i] = res.ID
+		dists[i] = res.Dist
+		i--
+	}
+
+	return ids, dists
+}
+
+type byDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (s byDistance) Len() int           { return len(s.ids) }
+func (s byDistance) Less(i, j int) bool { return s.dists[i] < s.dists[j] }
+func (s byDistance) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
+
+func idToKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, id)
+	return key
+}
+
+func float32SliceToBytes(slice []float32) []byte {
+	out := make([]byte, len(slice)*4)
+	for i, v := range slice {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+func bytesToFloat32Slice(data []byte) []float32 {
+	out := make([]float32, len(data)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+	}
+	return out
+}
+
+func uint64SliceToBytes(slice []uint64) []byte {
+	out := make([]byte, len(slice)*8)
+	for i, v := range slice {
+		binary.LittleEndian.PutUint64(out[i*8:], v)
+	}
+	return out
+}
+
+func bytesToUint64Slice(data []byte) []uint64 {
+	out := make([]uint64, len(data)/8)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	return out
+}
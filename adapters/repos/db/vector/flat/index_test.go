@@ -0,0 +1,200 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func testStore(t *testing.T) *lsmkv.Store {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	t.Cleanup(func() { store.Shutdown(context.Background()) })
+	return store
+}
+
+func testVectors() [][]float32 {
+	return [][]float32{
+		{1, 1, 1},
+		{-1, 1, 1},
+		{-1, -1, 1},
+		{0.9, 1, 0.8},
+		{-1, -1, -1},
+	}
+}
+
+func TestFlatIndex(t *testing.T) {
+	for _, bq := range []bool{false, true} {
+		uc := flatent.NewDefaultUserConfig()
+		uc.BQ.Enabled = bq
+		uc.BQ.RescoreLimit = 3
+
+		t.Run(fmt.Sprintf("bq enabled %t", bq), func(t *testing.T) {
+			store := testStore(t)
+			index, err := New(Config{
+				ID:               "flat",
+				DistanceProvider: distancer.NewL2SquaredProvider(),
+			}, uc, store)
+			require.Nil(t, err)
+
+			for i, vec := range testVectors() {
+				require.Nil(t, index.Add(uint64(i), vec))
+			}
+
+			t.Run("search", func(t *testing.T) {
+				ids, dists, err := index.SearchByVector([]float32{1, 1, 1}, 2, nil)
+				require.Nil(t, err)
+				assert.Equal(t, []uint64{0, 3}, ids)
+				assert.Len(t, dists, 2)
+				assert.Equal(t, float32(0), dists[0])
+			})
+
+			t.Run("search with allow list", func(t *testing.T) {
+				ids, _, err := index.SearchByVector([]float32{1, 1, 1}, 2,
+					helpers.NewAllowList(1, 2, 4))
+				require.Nil(t, err)
+				assert.Len(t, ids, 2)
+				assert.ElementsMatch(t, []uint64{1, 2}, ids)
+			})
+
+			t.Run("search by distance", func(t *testing.T) {
+				ids, _, err := index.SearchByVectorDistance([]float32{1, 1, 1}, 0.1, -1, nil)
+				require.Nil(t, err)
+				assert.Equal(t, []uint64{0, 3}, ids)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				require.Nil(t, index.Delete(0))
+				ids, _, err := index.SearchByVector([]float32{1, 1, 1}, 1, nil)
+				require.Nil(t, err)
+				assert.Equal(t, []uint64{3}, ids)
+			})
+
+			t.Run("validate dimensions", func(t *testing.T) {
+				assert.Nil(t, index.ValidateBeforeInsert([]float32{1, 2, 3}))
+				assert.NotNil(t, index.ValidateBeforeInsert([]float32{1, 2}))
+			})
+
+			t.Run("dimensions are restored after restart", func(t *testing.T) {
+				restarted, err := New(Config{
+					ID:               "flat",
+					DistanceProvider: distancer.NewL2SquaredProvider(),
+				}, uc, store)
+				require.Nil(t, err)
+				restarted.PostStartup()
+				assert.NotNil(t, restarted.ValidateBeforeInsert([]float32{1, 2}))
+			})
+		})
+	}
+}
+
+func TestFlatIndexTargetVectorBuckets(t *testing.T) {
+	store := testStore(t)
+	uc := flatent.NewDefaultUserConfig()
+
+	first, err := New(Config{
+		ID:               "first",
+		TargetVector:     "first",
+		DistanceProvider: distancer.NewCosineDistanceProvider(),
+	}, uc, store)
+	require.Nil(t, err)
+	second, err := New(Config{
+		ID:               "second",
+		TargetVector:     "second",
+		DistanceProvider: distancer.NewCosineDistanceProvider(),
+	}, uc, store)
+	require.Nil(t, err)
+
+	require.Nil(t, first.Add(1, []float32{1, 0}))
+	require.Nil(t, second.Add(2, []float32{0, 1}))
+
+	ids, _, err := first.SearchByVector([]float32{0, 1}, 10, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1}, ids)
+
+	ids, _, err = second.SearchByVector([]float32{0, 1}, 10, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{2}, ids)
+}
+
+func TestFlatIndexIterate(t *testing.T) {
+	store := testStore(t)
+	uc := flatent.NewDefaultUserConfig()
+	uc.BQ.Enabled = true
+
+	index, err := New(Config{
+		ID:               "flat",
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}, uc, store)
+	require.Nil(t, err)
+
+	for i, vec := range testVectors() {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+	assert.Equal(t, len(testVectors()), index.Count())
+
+	t.Run("iterate in batches", func(t *testing.T) {
+		found := map[uint64][]float32{}
+		err := index.Iterate(2, func(id uint64, vector []float32) error {
+			found[id] = vector
+			return nil
+		})
+		require.Nil(t, err)
+
+		require.Len(t, found, len(testVectors()))
+		for i, vec := range testVectors() {
+			assert.Equal(t, vec, found[uint64(i)])
+		}
+	})
+
+	t.Run("drop buckets", func(t *testing.T) {
+		require.Nil(t, index.DropBuckets(context.Background()))
+		assert.Nil(t, store.Bucket(index.bucketName()))
+		assert.Nil(t, store.Bucket(index.compressedBucketName()))
+	})
+}
+
+func TestValidateUserConfigUpdate(t *testing.T) {
+	initial := flatent.NewDefaultUserConfig()
+
+	t.Run("rescore limit is mutable", func(t *testing.T) {
+		updated := flatent.NewDefaultUserConfig()
+		updated.BQ.RescoreLimit = 500
+		assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+
+	t.Run("distance is immutable", func(t *testing.T) {
+		updated := flatent.NewDefaultUserConfig()
+		updated.Distance = "dot"
+		assert.NotNil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+
+	t.Run("bq is immutable", func(t *testing.T) {
+		updated := flatent.NewDefaultUserConfig()
+		updated.BQ.Enabled = true
+		assert.NotNil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+}
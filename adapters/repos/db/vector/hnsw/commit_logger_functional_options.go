@@ -26,3 +26,12 @@ func WithCommitlogThresholdForCombining(size int64) CommitlogOption {
 		return nil
 	}
 }
+
+// WithCommitlogRetainer hands over commit logs to r once they have been
+// condensed, instead of deleting them
+func WithCommitlogRetainer(r CommitLogRetainer) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.condensor = &MemoryCondensor{logger: l.logger, retainer: r}
+		return nil
+	}
+}
@@ -26,7 +26,7 @@ import (
 )
 
 func (h *hnsw) initCompressedStore() error {
-	store, err := lsmkv.New(fmt.Sprintf("%s/%s/%s", h.rootPath, h.className, h.shardName), "", h.logger, nil,
+	store, err := lsmkv.New(h.compressedStorePath(), "", h.logger, nil,
 		h.shardCompactionCallbacks, h.shardFlushCallbacks)
 	if err != nil {
 		return errors.Wrap(err, "Init lsmkv (compressed vectors store)")
@@ -39,6 +39,32 @@ func (h *hnsw) initCompressedStore() error {
 	return nil
 }
 
+func (h *hnsw) compressedStorePath() string {
+	path := fmt.Sprintf("%s/%s/%s", h.rootPath, h.className, h.shardName)
+	if h.targetVector != "" {
+		// every target vector of a shard has its own index, so they cannot
+		// share a store for their compressed vectors
+		path = fmt.Sprintf("%s/vectors_%s", path, h.targetVector)
+	}
+	return path
+}
+
+// initBQ turns on binary quantization. As opposed to PQ there is nothing to
+// train, so this happens right when the index is created and the commit log
+// does not need to know about it. The compressed vectors are encoded on the
+// fly whenever they are missing from the cache.
+func (h *hnsw) initBQ() error {
+	if err := h.initCompressedStore(); err != nil {
+		return errors.Wrap(err, "Initializing compressed vector store")
+	}
+
+	h.compressor = newBQCompressor(h.distancerProvider)
+	h.compressedVectorsCache.grow(uint64(len(h.nodes)))
+	h.compressed.Store(true)
+	h.cache.drop()
+	return nil
+}
+
 func (h *hnsw) Compress(cfg ent.PQConfig) error {
 	if h.nodes[0] == nil {
 		return errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
@@ -74,6 +100,7 @@ func (h *hnsw) Compress(cfg ent.PQConfig) error {
 	}
 	h.compressedVectorsCache.grow(uint64(len(data)))
 	h.pq.Fit(cleanData)
+	h.compressor = newPQCompressor(h.pq)
 
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
@@ -83,7 +110,7 @@ func (h *hnsw) Compress(cfg ent.PQConfig) error {
 				return
 			}
 
-			encoded := h.pq.Encode(data[index])
+			encoded := h.compressor.Encode(data[index])
 			h.storeCompressedVector(index, encoded)
 			h.compressedVectorsCache.preload(index, encoded)
 		})
@@ -108,7 +135,9 @@ func (h *hnsw) storeCompressedVector(index uint64, vector []byte) {
 }
 
 func (h *hnsw) getCompressedVectorForID(ctx context.Context, id uint64) ([]byte, error) {
-	vec, err := h.vectorForID(ctx, id)
+	// read the vector straight from disk, the cache of the full vectors is no
+	// longer used once the index is compressed
+	vec, err := h.VectorForIDThunk(ctx, id)
 	if err != nil {
 		return nil, errors.Wrap(err, "Getting vector for id")
 	}
@@ -118,5 +147,5 @@ func (h *hnsw) getCompressedVectorForID(ctx context.Context, id uint64) ([]byte,
 		vec = distancer.Normalize(vec)
 	}
 
-	return h.pq.Encode(vec), nil
+	return h.compressor.Encode(vec), nil
 }
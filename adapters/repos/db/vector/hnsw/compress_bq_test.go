@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package hnsw_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestHnswBQ(t *testing.T) {
+	dimensions := 128
+	vectorsSize := 2000
+	queriesSize := 20
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+	distancer := distancer.NewCosineDistanceProvider()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 32
+	uc.EFConstruction = 64
+	uc.EF = 128
+	uc.BQ = ent.BQConfig{Enabled: true}
+
+	index, err := hnsw.New(hnsw.Config{
+		RootPath:              t.TempDir(),
+		ID:                    "bq",
+		ClassName:             "BQ",
+		ShardName:             "shard",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: hnsw.TempVectorForIDThunk(vectors),
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	t.Run("importing without any training", func(t *testing.T) {
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+	})
+
+	t.Run("searching rescores with the full vectors", func(t *testing.T) {
+		var relevant uint64
+		for _, query := range queries {
+			truth := testinghelpers.BruteForce(vectors, query, k, distanceWrapper(distancer))
+			ids, dists, err := index.SearchByVector(query, k, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, k)
+			relevant += testinghelpers.MatchesInLists(truth, ids)
+
+			for i, id := range ids {
+				expected, _, err := distancer.SingleDist(query, vectors[id])
+				require.Nil(t, err)
+				assert.InDelta(t, expected, dists[i], 1e-4)
+			}
+		}
+
+		// uniformly distributed random vectors are the worst case for BQ, real
+		// embeddings reach a much higher recall
+		recall := float32(relevant) / float32(k*len(queries))
+		assert.Greater(t, recall, float32(0.7))
+	})
+
+	t.Run("searching with an allow list", func(t *testing.T) {
+		allowList := helpers.NewAllowList(1, 3, 5, 7, 9)
+		ids, dists, err := index.SearchByVector(queries[0], 3, allowList)
+		require.Nil(t, err)
+		require.Len(t, ids, 3)
+
+		allowed := [][]float32{vectors[1], vectors[3], vectors[5], vectors[7], vectors[9]}
+		truth := testinghelpers.BruteForce(allowed, queries[0], 3, distanceWrapper(distancer))
+		for i, id := range ids {
+			assert.Equal(t, []uint64{1, 3, 5, 7, 9}[truth[i]], id)
+			expected, _, err := distancer.SingleDist(queries[0], vectors[id])
+			require.Nil(t, err)
+			assert.InDelta(t, expected, dists[i], 1e-4)
+		}
+	})
+
+	t.Run("deleting and cleaning up tombstones", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			require.Nil(t, index.Delete(uint64(i)))
+		}
+		require.Nil(t, index.CleanUpTombstonedNodes(func() bool { return false }))
+
+		ids, _, err := index.SearchByVector(queries[0], k, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, k)
+		for _, id := range ids {
+			assert.GreaterOrEqual(t, id, uint64(100))
+		}
+	})
+}
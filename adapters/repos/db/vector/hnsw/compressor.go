@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+// compressor is implemented by the quantizers which can replace the full
+// vectors in memory once the index is compressed. The full vectors are still
+// present on disk, so the results of a search can be rescored.
+type compressor interface {
+	Encode(vec []float32) []byte
+	DistanceBetweenCompressedVectors(x, y []byte) (float32, error)
+	DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) (float32, error)
+	NewDistancer(vec []float32) compressorDistancer
+	ReturnDistancer(d compressorDistancer)
+}
+
+type compressorDistancer interface {
+	Distance(x []byte) (float32, bool, error)
+	DistanceToFloat(x []float32) (float32, bool, error)
+}
+
+type pqCompressor struct {
+	pq *ssdhelpers.ProductQuantizer
+}
+
+func newPQCompressor(pq *ssdhelpers.ProductQuantizer) *pqCompressor {
+	return &pqCompressor{pq: pq}
+}
+
+func (c *pqCompressor) Encode(vec []float32) []byte {
+	return c.pq.Encode(vec)
+}
+
+func (c *pqCompressor) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	return c.pq.DistanceBetweenCompressedVectors(x, y), nil
+}
+
+func (c *pqCompressor) DistanceBetweenCompressedAndUncompressedVectors(x []float32,
+	encoded []byte,
+) (float32, error) {
+	return c.pq.DistanceBetweenCompressedAndUncompressedVectors(x, encoded), nil
+}
+
+func (c *pqCompressor) NewDistancer(vec []float32) compressorDistancer {
+	return c.pq.NewDistancer(vec)
+}
+
+func (c *pqCompressor) ReturnDistancer(d compressorDistancer) {
+	c.pq.ReturnDistancer(d.(*ssdhelpers.PQDistancer))
+}
+
+// bqCompressor keeps a single bit per dimension and compares the codes through
+// their Hamming distance. The distances are only meaningful relative to each
+// other, so the results always need to be rescored with the full vectors.
+type bqCompressor struct {
+	bq                ssdhelpers.BinaryQuantizer
+	distancerProvider distancer.Provider
+}
+
+func newBQCompressor(distancerProvider distancer.Provider) *bqCompressor {
+	return &bqCompressor{
+		bq:                ssdhelpers.NewBinaryQuantizer(),
+		distancerProvider: distancerProvider,
+	}
+}
+
+func (c *bqCompressor) Encode(vec []float32) []byte {
+	return c.bq.EncodeBytes(vec)
+}
+
+func (c *bqCompressor) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	return c.bq.DistanceBetweenCompressedBytes(x, y)
+}
+
+func (c *bqCompressor) DistanceBetweenCompressedAndUncompressedVectors(x []float32,
+	encoded []byte,
+) (float32, error) {
+	return c.bq.DistanceBetweenCompressedBytes(c.bq.EncodeBytes(x), encoded)
+}
+
+func (c *bqCompressor) NewDistancer(vec []float32) compressorDistancer {
+	return &bqDistancer{
+		x:          vec,
+		code:       c.bq.EncodeBytes(vec),
+		compressor: c,
+	}
+}
+
+func (c *bqCompressor) ReturnDistancer(d compressorDistancer) {}
+
+type bqDistancer struct {
+	x          []float32
+	code       []byte
+	compressor *bqCompressor
+}
+
+func (d *bqDistancer) Distance(x []byte) (float32, bool, error) {
+	dist, err := d.compressor.bq.DistanceBetweenCompressedBytes(d.code, x)
+	return dist, err == nil, err
+}
+
+func (d *bqDistancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	return d.compressor.distancerProvider.SingleDist(x, d.x)
+}
@@ -28,6 +28,17 @@ type MemoryCondensor struct {
 	newLogFile *os.File
 	newLog     *bufWriter
 	logger     logrus.FieldLogger
+	// retainer takes over condensed logs instead of them being deleted, if set
+	retainer CommitLogRetainer
+}
+
+// CommitLogRetainer takes over commit logs which are no longer needed, because
+// they have been condensed. This allows archiving the logs, e.g. for
+// point-in-time recovery.
+type CommitLogRetainer interface {
+	// Retain takes over the commit log at path. It returns false if the log
+	// is not retained, in which case it is deleted.
+	Retain(path string) (bool, error)
 }
 
 func (c *MemoryCondensor) Do(fileName string) error {
@@ -111,13 +122,22 @@ func (c *MemoryCondensor) Do(fileName string) error {
 		return errors.Wrap(err, "close new commit log")
 	}
 
-	if err := os.Remove(fileName); err != nil {
+	if err := c.remove(fileName); err != nil {
 		return errors.Wrap(err, "cleanup old (uncondensed) commit log")
 	}
 
 	return nil
 }
 
+func (c *MemoryCondensor) remove(fileName string) error {
+	if c.retainer != nil {
+		if retained, err := c.retainer.Retain(fileName); err != nil || retained {
+			return err
+		}
+	}
+	return os.Remove(fileName)
+}
+
 func (c *MemoryCondensor) writeUint64(w *bufWriter, in uint64) error {
 	toWrite := make([]byte, 8)
 	binary.LittleEndian.PutUint64(toWrite[0:8], in)
@@ -14,11 +14,50 @@ package hnsw
 import (
 	_ "fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+type fakeCommitLogRetainer struct {
+	retained []string
+}
+
+func (r *fakeCommitLogRetainer) Retain(path string) (bool, error) {
+	r.retained = append(r.retained, path)
+	return true, nil
+}
+
+func TestCondensorRemove(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	t.Run("without retainer", func(t *testing.T) {
+		fileName := filepath.Join(dir, "1000")
+		require.Nil(t, os.WriteFile(fileName, []byte{1, 2, 3}, 0o666))
+
+		require.Nil(t, NewMemoryCondensor(logger).remove(fileName))
+		_, err := os.Stat(fileName)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("with retainer", func(t *testing.T) {
+		fileName := filepath.Join(dir, "2000")
+		require.Nil(t, os.WriteFile(fileName, []byte{1, 2, 3}, 0o666))
+
+		retainer := &fakeCommitLogRetainer{}
+		c := &MemoryCondensor{logger: logger, retainer: retainer}
+		require.Nil(t, c.remove(fileName))
+		assert.Equal(t, []string{fileName}, retainer.retained)
+		_, err := os.Stat(fileName)
+		assert.Nil(t, err)
+	})
+}
+
 func BenchmarkCondensor2NewUint64Write(b *testing.B) {
 	b.StopTimer()
 	logger, _ := test.NewNullLogger()
@@ -25,6 +25,7 @@ type Config struct {
 	// internal
 	RootPath              string
 	ID                    string
+	TargetVector          string
 	MakeCommitLoggerThunk MakeCommitLogger
 	VectorForIDThunk      VectorForID
 	TempVectorForIDThunk  TempVectorForID
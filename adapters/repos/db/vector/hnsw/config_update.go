@@ -51,6 +51,12 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			// BQ is turned on when the index is created, there is no way to
+			// compress or decompress an existing index yet
+			name:     "bq.enabled",
+			accessor: func(c ent.UserConfig) interface{} { return c.BQ.Enabled },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -95,6 +101,12 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
 
+	if parsed.BQ.Enabled {
+		h.compressedVectorsCache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
+		callback()
+		return nil
+	}
+
 	if !parsed.PQ.Enabled {
 		callback()
 		return nil
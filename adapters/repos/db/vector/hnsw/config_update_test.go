@@ -63,6 +63,14 @@ func TestUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name:    "attempting to enable bq",
+				initial: ent.UserConfig{BQ: ent.BQConfig{Enabled: false}},
+				update:  ent.UserConfig{BQ: ent.BQConfig{Enabled: true}},
+				expectedError: errors.Errorf(
+					"bq.enabled is immutable: " +
+						"attempted change from \"false\" to \"true\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
@@ -20,6 +20,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -297,7 +298,14 @@ func (h *hnsw) reassignNeighbor(neighbor uint64, deleteList helpers.AllowList, b
 	}
 
 	var neighborVec []float32
-	if h.compressed.Load() {
+	if h.compressed.Load() && h.bqConfig.Enabled {
+		// binary quantized vectors cannot be decoded, read the full vector from
+		// disk instead
+		neighborVec, err = h.VectorForIDThunk(context.Background(), neighbor)
+		if err == nil && h.distancerProvider.Type() == "cosine-dot" {
+			neighborVec = distancer.Normalize(neighborVec)
+		}
+	} else if h.compressed.Load() {
 		var vec []byte
 		vec, err = h.compressedVectorsCache.get(context.Background(), neighbor)
 		if err == nil {
@@ -12,6 +12,8 @@
 package distancer
 
 import (
+	"math/bits"
+
 	"github.com/pkg/errors"
 )
 
@@ -78,3 +80,20 @@ func (l HammingProvider) Step(x, y []float32) float32 {
 func (l HammingProvider) Wrap(x float32) float32 {
 	return x
 }
+
+// HammingBitwise returns the number of differing bits between two bit sets,
+// such as binary quantized vectors. It relies on popcount, so it is a lot
+// cheaper than comparing the vectors element by element.
+func HammingBitwise(x, y []uint64) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x), len(y))
+	}
+
+	total := 0
+	for i := range x {
+		total += bits.OnesCount64(x[i] ^ y[i])
+	}
+
+	return float32(total), nil
+}
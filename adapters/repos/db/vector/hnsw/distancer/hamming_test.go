@@ -99,3 +99,22 @@ func TestHammingDistancerStepbyStep(t *testing.T) {
 		assert.Equal(t, control, expectedDistance)
 	})
 }
+
+func TestHammingBitwise(t *testing.T) {
+	t.Run("identical bit sets", func(t *testing.T) {
+		dist, err := HammingBitwise([]uint64{0b1011, 7}, []uint64{0b1011, 7})
+		require.Nil(t, err)
+		assert.Equal(t, float32(0), dist)
+	})
+
+	t.Run("differing bits across blocks", func(t *testing.T) {
+		dist, err := HammingBitwise([]uint64{0b1011, 1 << 63}, []uint64{0b0010, 0})
+		require.Nil(t, err)
+		assert.Equal(t, float32(3), dist)
+	})
+
+	t.Run("different lengths", func(t *testing.T) {
+		_, err := HammingBitwise([]uint64{0}, []uint64{0, 0})
+		assert.NotNil(t, err)
+	})
+}
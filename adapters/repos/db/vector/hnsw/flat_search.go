@@ -19,7 +19,19 @@ import (
 func (h *hnsw) flatSearch(queryVector []float32, limit int,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
-	results := priorityqueue.NewMax(limit)
+	// if the index is compressed, the compressed distances are only used to
+	// select the candidates, which are then rescored with the full vectors
+	candidateLimit := limit
+	var byteDistancer compressorDistancer
+	if h.compressed.Load() {
+		byteDistancer = h.compressor.NewDistancer(queryVector)
+		defer h.compressor.ReturnDistancer(byteDistancer)
+		if h.shouldRescore() {
+			candidateLimit = h.searchTimeEF(limit)
+		}
+	}
+
+	results := priorityqueue.NewMax(candidateLimit)
 
 	it := allowList.Iterator()
 	for candidate, ok := it.Next(); ok; candidate, ok = it.Next() {
@@ -45,7 +57,14 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 			continue
 		}
 		h.RUnlock()
-		dist, ok, err := h.distBetweenNodeAndVec(candidate, queryVector)
+		var dist float32
+		var ok bool
+		var err error
+		if byteDistancer != nil {
+			dist, ok, err = h.distanceToByteNode(byteDistancer, candidate)
+		} else {
+			dist, ok, err = h.distBetweenNodeAndVec(candidate, queryVector)
+		}
 		if err != nil {
 			return nil, nil, err
 		}
@@ -55,7 +74,7 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 			continue
 		}
 
-		if results.Len() < limit {
+		if results.Len() < candidateLimit {
 			results.Insert(candidate, dist)
 		} else if results.Top().Dist > dist {
 			results.Pop()
@@ -63,6 +82,12 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 		}
 	}
 
+	if h.shouldRescore() {
+		if err := h.rescore(results, limit, byteDistancer); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	ids := make([]uint64, results.Len())
 	dists := make([]float32, results.Len())
 
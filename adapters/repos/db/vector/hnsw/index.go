@@ -17,6 +17,7 @@ import (
 	"io"
 	"math"
 	"math/rand"
+	"os"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -154,11 +155,14 @@ type hnsw struct {
 	doNotRescore           bool
 	pq                     *ssdhelpers.ProductQuantizer
 	pqConfig               ent.PQConfig
+	bqConfig               ent.BQConfig
+	compressor             compressor
 	compressedVectorsCache cache[byte]
 	compressedStore        *lsmkv.Store
 	compressActionLock     *sync.RWMutex
 	className              string
 	shardName              string
+	targetVector           string
 	VectorForIDThunk       VectorForID
 	shardedNodeLocks       []sync.RWMutex
 }
@@ -266,16 +270,18 @@ func New(cfg Config, uc ent.UserConfig,
 		randFunc:             rand.Float64,
 		compressActionLock:   &sync.RWMutex{},
 		className:            cfg.ClassName,
+		targetVector:         cfg.TargetVector,
 		VectorForIDThunk:     cfg.VectorForIDThunk,
 		TempVectorForIDThunk: cfg.TempVectorForIDThunk,
 		pqConfig:             uc.PQ,
+		bqConfig:             uc.BQ,
 		shardedNodeLocks:     make([]sync.RWMutex, NodeLockStripe),
 
 		shardCompactionCallbacks: shardCompactionCallbacks,
 		shardFlushCallbacks:      shardFlushCallbacks,
 	}
 
-	if uc.PQ.Enabled {
+	if uc.PQ.Enabled || uc.BQ.Enabled {
 		index.compressedVectorsCache = newCompressedShardedLockCache(index.getCompressedVectorForID, uc.VectorCacheMaxObjects, cfg.Logger)
 	}
 
@@ -477,7 +483,12 @@ func (h *hnsw) distBetweenNodes(a, b uint64) (float32, bool, error) {
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", b)
 		}
 
-		return h.pq.DistanceBetweenCompressedVectors(v1, v2), true, nil
+		dist, err := h.compressor.DistanceBetweenCompressedVectors(v1, v2)
+		if err != nil {
+			return 0, false, errors.Wrapf(err,
+				"could not calculate distance between docIDs %d and %d", a, b)
+		}
+		return dist, true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -536,7 +547,12 @@ func (h *hnsw) distBetweenNodeAndVec(node uint64, vecB []float32) (float32, bool
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", node)
 		}
 
-		return h.pq.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
+		dist, err := h.compressor.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1)
+		if err != nil {
+			return 0, false, errors.Wrapf(err,
+				"could not calculate distance to docID %d", node)
+		}
+		return dist, true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -629,6 +645,15 @@ func (h *hnsw) Drop(ctx context.Context) error {
 
 	if h.compressed.Load() {
 		h.compressedVectorsCache.drop()
+		// the compressed vectors are removed right after, so failing to flush
+		// them is not a reason to abort the drop
+		if err := h.compressedStore.Shutdown(ctx); err != nil {
+			h.logger.WithField("action", "hnsw_drop").WithError(err).
+				Warn("shutdown compressed vectors store")
+		}
+		if err := os.RemoveAll(h.compressedStorePath()); err != nil {
+			return errors.Wrap(err, "hnsw drop compressed vectors")
+		}
 	} else {
 		// cancel vector cache goroutine
 		h.cache.drop()
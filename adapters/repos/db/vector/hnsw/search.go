@@ -22,7 +22,6 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
-	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
@@ -165,17 +164,17 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error,
 ) {
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer compressorDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewDistancer(queryVector)
+		defer h.compressor.ReturnDistancer(byteDistancer)
 	}
 	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, byteDistancer)
 }
 
 func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
-	allowList helpers.AllowList, byteDistancer *ssdhelpers.PQDistancer) (*priorityqueue.Queue, error,
+	allowList helpers.AllowList, byteDistancer compressorDistancer) (*priorityqueue.Queue, error,
 ) {
 	h.pools.visitedListsLock.Lock()
 	visited := h.pools.visitedLists.Borrow()
@@ -185,8 +184,8 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	results := h.pools.pqResults.GetMax(ef)
 	var floatDistancer distancer.Distancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewDistancer(queryVector)
+		defer h.compressor.ReturnDistancer(byteDistancer)
 	} else {
 		floatDistancer = h.distancerProvider.New(queryVector)
 	}
@@ -378,7 +377,7 @@ func (h *hnsw) currentWorstResultDistanceToFloat(results *priorityqueue.Queue,
 }
 
 func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
-	distancer *ssdhelpers.PQDistancer,
+	distancer compressorDistancer,
 ) (float32, error) {
 	if results.Len() > 0 {
 		item := results.Top()
@@ -405,7 +404,7 @@ func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
 	}
 }
 
-func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
+func (h *hnsw) distanceToByteNode(distancer compressorDistancer,
 	nodeID uint64,
 ) (float32, bool, error) {
 	vec, err := h.compressedVectorsCache.get(context.Background(), nodeID)
@@ -422,7 +421,7 @@ func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
 	return distancer.Distance(vec)
 }
 
-func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDistancer, nodeID uint64) (float32, bool, error) {
+func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer compressorDistancer, nodeID uint64) (float32, bool, error) {
 	slice := h.pools.tempVectors.Get(int(h.dims))
 	defer h.pools.tempVectors.Put(slice)
 	vec, err := h.TempVectorForIDThunk(context.Background(), nodeID, slice)
@@ -442,6 +441,38 @@ func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDist
 	return concreteDistancer.DistanceToFloat(vec)
 }
 
+// rescore replaces the compressed distances of the results with the
+// distances to the full vectors which are read from disk. Only the closest
+// limit results are kept.
+func (h *hnsw) rescore(res *priorityqueue.Queue, limit int,
+	byteDistancer compressorDistancer,
+) error {
+	ids := make([]uint64, res.Len())
+	i := len(ids) - 1
+	for res.Len() > 0 {
+		res := res.Pop()
+		ids[i] = res.ID
+		i--
+	}
+	res.Reset()
+	for _, id := range ids {
+		dist, ok, err := h.distanceFromBytesToFloatNode(byteDistancer, id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// node was deleted in the underlying object store
+			continue
+		}
+		res.Insert(id, dist)
+		if res.Len() > limit {
+			res.Pop()
+		}
+	}
+
+	return nil
+}
+
 func (h *hnsw) distanceToFloatNode(distancer distancer.Distancer,
 	nodeID uint64,
 ) (float32, bool, error) {
@@ -508,10 +539,10 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 			"it has been flagged for cleanup and should be fixed in the next cleanup cycle")
 	}
 
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer compressorDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(searchVec)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewDistancer(searchVec)
+		defer h.compressor.ReturnDistancer(byteDistancer)
 	}
 	// stop at layer 1, not 0!
 	for level := maxLayer; level >= 1; level-- {
@@ -567,22 +598,9 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	}
 
 	if h.shouldRescore() {
-		ids := make([]uint64, res.Len())
-		i := len(ids) - 1
-		for res.Len() > 0 {
-			res := res.Pop()
-			ids[i] = res.ID
-			i--
+		if err := h.rescore(res, ef, byteDistancer); err != nil {
+			return nil, nil, errors.Wrap(err, "knn search: rescore")
 		}
-		res.Reset()
-		for _, id := range ids {
-			dist, _, _ := h.distanceFromBytesToFloatNode(byteDistancer, id)
-			res.Insert(id, dist)
-			if res.Len() > ef {
-				res.Pop()
-			}
-		}
-
 	}
 
 	for res.Len() > k {
@@ -34,6 +34,12 @@ func (h *hnsw) init(cfg Config) error {
 		return errors.Wrapf(err, "restore hnsw index %q", cfg.ID)
 	}
 
+	if h.bqConfig.Enabled {
+		if err := h.initBQ(); err != nil {
+			return errors.Wrapf(err, "init bq of hnsw index %q", cfg.ID)
+		}
+	}
+
 	// init commit logger for future writes
 	cl, err := cfg.MakeCommitLoggerThunk()
 	if err != nil {
@@ -145,6 +151,7 @@ func (h *hnsw) restoreFromDisk() error {
 		if err != nil {
 			return errors.Wrap(err, "Restoring PQ data.")
 		}
+		h.compressor = newPQCompressor(h.pq)
 
 		// make sure the compressed cache fits the current size
 		h.compressedVectorsCache.grow(uint64(len(h.nodes)))
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// BinaryQuantizer compresses a vector to a single bit per dimension. The bit
+// is set if the value is negative. Unlike product quantization it does not
+// require any training, so vectors can be encoded from the first insert on.
+// Distances between encoded vectors are estimated through the Hamming
+// distance of their bits.
+type BinaryQuantizer struct{}
+
+func NewBinaryQuantizer() BinaryQuantizer {
+	return BinaryQuantizer{}
+}
+
+// Encode packs the sign bits of the vector into 64 bit blocks
+func (bq BinaryQuantizer) Encode(vec []float32) []uint64 {
+	total := len(vec) / 64
+	if len(vec)%64 != 0 {
+		total++
+	}
+
+	code := make([]uint64, total)
+	for i, v := range vec {
+		if v < 0 {
+			code[i/64] |= 1 << (i % 64)
+		}
+	}
+	return code
+}
+
+// EncodeBytes is like Encode, but returns the 64 bit blocks in little endian
+// byte order, so the codes can be kept in byte-based caches and stores
+func (bq BinaryQuantizer) EncodeBytes(vec []float32) []byte {
+	code := bq.Encode(vec)
+	out := make([]byte, len(code)*8)
+	for i, block := range code {
+		binary.LittleEndian.PutUint64(out[i*8:], block)
+	}
+	return out
+}
+
+// DistanceBetweenCompressedVectors returns the number of differing bits
+func (bq BinaryQuantizer) DistanceBetweenCompressedVectors(x, y []uint64) (float32, error) {
+	return distancer.HammingBitwise(x, y)
+}
+
+// DistanceBetweenCompressedBytes returns the number of differing bits of two
+// codes produced by EncodeBytes
+func (bq BinaryQuantizer) DistanceBetweenCompressedBytes(x, y []byte) (float32, error) {
+	if len(x) != len(y) || len(x)%8 != 0 {
+		return 0, errors.New("both codes should have the same len, " +
+			"which must be a multiple of 8")
+	}
+
+	total := 0
+	for i := 0; i < len(x); i += 8 {
+		total += bits.OnesCount64(binary.LittleEndian.Uint64(x[i:]) ^
+			binary.LittleEndian.Uint64(y[i:]))
+	}
+	return float32(total), nil
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+func TestBinaryQuantizer(t *testing.T) {
+	bq := ssdhelpers.NewBinaryQuantizer()
+
+	t.Run("encode sign bits", func(t *testing.T) {
+		code := bq.Encode([]float32{-1, 2, -3, 4})
+		assert.Equal(t, []uint64{0b0101}, code)
+	})
+
+	t.Run("encode more than 64 dimensions", func(t *testing.T) {
+		vec := make([]float32, 65)
+		vec[64] = -1
+		code := bq.Encode(vec)
+		assert.Equal(t, []uint64{0, 1}, code)
+	})
+
+	t.Run("distance between codes", func(t *testing.T) {
+		x := bq.Encode([]float32{-1, 2, -3, 4})
+		y := bq.Encode([]float32{1, 2, 3, -4})
+		dist, err := bq.DistanceBetweenCompressedVectors(x, y)
+		require.Nil(t, err)
+		assert.Equal(t, float32(3), dist)
+	})
+
+	t.Run("codes of different lengths", func(t *testing.T) {
+		_, err := bq.DistanceBetweenCompressedVectors([]uint64{0}, []uint64{0, 0})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestBinaryQuantizerBytes(t *testing.T) {
+	bq := ssdhelpers.NewBinaryQuantizer()
+
+	t.Run("encode into little endian blocks", func(t *testing.T) {
+		vec := make([]float32, 65)
+		vec[0] = -1
+		vec[64] = -1
+		code := bq.EncodeBytes(vec)
+		assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}, code)
+	})
+
+	t.Run("distance matches the uint64 codes", func(t *testing.T) {
+		x := []float32{-1, 2, -3, 4, 0.5, -0.5}
+		y := []float32{1, 2, 3, -4, -0.5, -0.5}
+		expected, err := bq.DistanceBetweenCompressedVectors(bq.Encode(x), bq.Encode(y))
+		require.Nil(t, err)
+
+		dist, err := bq.DistanceBetweenCompressedBytes(bq.EncodeBytes(x), bq.EncodeBytes(y))
+		require.Nil(t, err)
+		assert.Equal(t, expected, dist)
+		assert.Equal(t, float32(4), dist)
+	})
+
+	t.Run("codes of different lengths", func(t *testing.T) {
+		_, err := bq.DistanceBetweenCompressedBytes(make([]byte, 8), make([]byte, 16))
+		assert.NotNil(t, err)
+	})
+}
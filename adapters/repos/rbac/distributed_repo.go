@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	rbacUC "github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const DefaultTxTTL = 60 * time.Second
+
+// DistributedRepo replicates the rbac policy to all nodes of the cluster
+type DistributedRepo struct {
+	sync.Mutex
+	txRemote  *cluster.TxManager
+	localRepo localRepo
+	callbacks []func(policy *rbacUC.Policy)
+	logger    logrus.FieldLogger
+}
+
+type localRepo interface {
+	Load(ctx context.Context) (*rbacUC.Policy, error)
+	Save(ctx context.Context, policy *rbacUC.Policy) error
+}
+
+func NewDistributedRepo(remoteClient cluster.Client,
+	memberLister cluster.MemberLister, localRepo localRepo,
+	logger logrus.FieldLogger,
+) *DistributedRepo {
+	repo := &DistributedRepo{
+		localRepo: localRepo,
+		logger:    logger,
+	}
+
+	broadcaster := cluster.NewTxBroadcaster(memberLister, remoteClient)
+	broadcaster.SetConsensusFunction(repo.readConsensus)
+	repo.txRemote = cluster.NewTxManager(broadcaster, &dummyTxPersistence{}, logger)
+	repo.txRemote.SetCommitFn(repo.incomingCommit)
+	repo.txRemote.SetResponseFn(repo.incomingBegin)
+	repo.txRemote.StartAcceptIncoming()
+
+	return repo
+}
+
+func (r *DistributedRepo) Load(ctx context.Context) (*rbacUC.Policy, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.localRepo.Load(ctx)
+}
+
+// Save replaces the policy on all nodes. Other nodes refuse the policy if
+// they already have a policy of the same or a higher version, i.e. if the
+// policy was changed concurrently.
+func (r *DistributedRepo) Save(ctx context.Context, policy *rbacUC.Policy) error {
+	r.Lock()
+	defer r.Unlock()
+
+	tx, err := r.txRemote.BeginTransaction(ctx, rbacUC.PutPolicy,
+		rbacUC.PutPolicyPayload{Policy: policy}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	err = r.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return r.localRepo.Save(ctx, policy)
+}
+
+func (r *DistributedRepo) RegisterUpdateCallback(callback func(policy *rbacUC.Policy)) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.callbacks = append(r.callbacks, callback)
+}
+
+// StartupSync adopts the policy of the other nodes if it is more recent than
+// the local one, e.g. because roles were changed while this node was down
+func (r *DistributedRepo) StartupSync(ctx context.Context) error {
+	tx, err := r.txRemote.BeginTransaction(ctx, rbacUC.ReadPolicy, nil, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "read policy: open transaction")
+	}
+
+	// this tx is read-only, so we don't have to worry about aborting it
+	defer r.txRemote.CloseReadTransaction(ctx, tx)
+
+	pl, ok := tx.Payload.(rbacUC.ReadPolicyPayload)
+	if !ok || pl.Policy == nil {
+		// single node cluster, nothing to sync
+		return nil
+	}
+
+	return r.adopt(ctx, pl.Policy)
+}
+
+// adopt stores a policy unless the local policy is at least as recent. The
+// callbacks are called without holding the lock, so that they can't deadlock
+// with a concurrent Save.
+func (r *DistributedRepo) adopt(ctx context.Context, policy *rbacUC.Policy) error {
+	r.Lock()
+	local, err := r.localRepo.Load(ctx)
+	if err != nil {
+		r.Unlock()
+		return err
+	}
+	if policy.Version <= local.Version {
+		r.Unlock()
+		return nil
+	}
+	if err := r.localRepo.Save(ctx, policy); err != nil {
+		r.Unlock()
+		return err
+	}
+	callbacks := r.callbacks
+	r.Unlock()
+
+	for _, callback := range callbacks {
+		callback(policy)
+	}
+	return nil
+}
+
+func (r *DistributedRepo) incomingBegin(ctx context.Context,
+	tx *cluster.Transaction,
+) ([]byte, error) {
+	switch tx.Type {
+	case rbacUC.PutPolicy:
+		local, err := r.localRepo.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policy := tx.Payload.(rbacUC.PutPolicyPayload).Policy
+		if policy == nil || policy.Version <= local.Version {
+			return nil, fmt.Errorf("policy was changed concurrently, " +
+				"local version is more recent than the new policy")
+		}
+		return nil, nil
+
+	case rbacUC.ReadPolicy:
+		local, err := r.localRepo.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tx.Payload = rbacUC.ReadPolicyPayload{Policy: local}
+		data, err := json.Marshal(tx)
+		tx.Payload = rbacUC.ReadPolicyPayload{}
+		return data, err
+
+	default:
+		return nil, nil
+	}
+}
+
+func (r *DistributedRepo) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	switch tx.Type {
+	case rbacUC.PutPolicy:
+		return r.adopt(ctx, tx.Payload.(rbacUC.PutPolicyPayload).Policy)
+
+	case rbacUC.ReadPolicy:
+		return nil
+
+	default:
+		return errors.Errorf("unrecognized tx type: %s", tx.Type)
+	}
+}
+
+// readConsensus picks the most recent policy of all nodes
+func (r *DistributedRepo) readConsensus(ctx context.Context,
+	in []*cluster.Transaction,
+) (*cluster.Transaction, error) {
+	if len(in) == 0 || in[0].Type != rbacUC.ReadPolicy {
+		return nil, nil
+	}
+
+	var consensus *cluster.Transaction
+	var latest *rbacUC.Policy
+	for _, tx := range in {
+		raw, ok := tx.Payload.(json.RawMessage)
+		if !ok {
+			continue
+		}
+		typed, err := rbacUC.UnmarshalTransaction(tx.Type, raw)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal tx: %w", err)
+		}
+		policy := typed.(rbacUC.ReadPolicyPayload).Policy
+		if policy == nil || (latest != nil && policy.Version <= latest.Version) {
+			continue
+		}
+		latest = policy
+		consensus = tx
+		consensus.Payload = typed
+	}
+
+	return consensus, nil
+}
+
+func (r *DistributedRepo) TxManager() *cluster.TxManager {
+	return r.txRemote
+}
+
+// The policy is stored by the local repo on commit, a tx which was not
+// committed yet does not need to survive a crash.
+type dummyTxPersistence struct{}
+
+func (d *dummyTxPersistence) StoreTx(ctx context.Context, tx *cluster.Transaction) error {
+	return nil
+}
+
+func (d *dummyTxPersistence) DeleteTx(ctx context.Context, txID string) error {
+	return nil
+}
+
+func (d *dummyTxPersistence) IterateAll(ctx context.Context, cb func(tx *cluster.Transaction)) error {
+	return nil
+}
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	rbacUC "github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	policyBucket = []byte("rbac")
+	policyKey    = []byte("policy")
+)
+
+// Repo stores the rbac policy of the local node
+type Repo struct {
+	logger  logrus.FieldLogger
+	baseDir string
+	db      *bolt.DB
+}
+
+func NewRepo(baseDir string, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir: baseDir,
+		logger:  logger,
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/rbac.db", r.baseDir)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(policyBucket); err != nil {
+			return errors.Wrapf(err, "create rbac bucket '%s'", string(policyBucket))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return nil
+}
+
+// Save replaces the stored policy
+func (r *Repo) Save(ctx context.Context, policy *rbacUC.Policy) error {
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return errors.Wrap(err, "marshal policy to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(policyBucket)
+		return b.Put(policyKey, policyJSON)
+	})
+}
+
+// Load returns the stored policy, or an empty policy if none was stored yet
+func (r *Repo) Load(ctx context.Context) (*rbacUC.Policy, error) {
+	var policyJSON []byte
+	r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(policyBucket)
+		policyJSON = append(policyJSON, b.Get(policyKey)...)
+		return nil
+	})
+
+	policy := rbacUC.NewPolicy()
+	if len(policyJSON) == 0 {
+		return policy, nil
+	}
+
+	if err := json.Unmarshal(policyJSON, policy); err != nil {
+		return nil, errors.Wrapf(err, "parse policy from JSON")
+	}
+
+	return policy, nil
+}
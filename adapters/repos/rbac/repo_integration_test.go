@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	rbacUC "github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+func Test_RBACRepo(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	r, err := NewRepo(dirName, logger)
+	require.Nil(t, err)
+
+	t.Run("loading without a stored policy", func(t *testing.T) {
+		policy, err := r.Load(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, rbacUC.NewPolicy(), policy)
+	})
+
+	name, resource := "reader", "data/collections/Article/*"
+	expected := rbacUC.NewPolicy()
+	expected.Version = 3
+	expected.Roles[name] = &models.Role{
+		Name: &name,
+		Permissions: []*models.Permission{
+			{Actions: []string{"get"}, Resource: &resource},
+		},
+	}
+	expected.Users["alice"] = []string{name}
+
+	t.Run("storing a policy", func(t *testing.T) {
+		err := r.Save(context.Background(), expected)
+		require.Nil(t, err)
+	})
+
+	t.Run("loading the stored policy", func(t *testing.T) {
+		policy, err := r.Load(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, expected, policy)
+	})
+}
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new apikeys API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for apikeys API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	ApikeysCreate(params *ApikeysCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysCreateCreated, error)
+
+	ApikeysExpire(params *ApikeysExpireParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysExpireOK, error)
+
+	ApikeysList(params *ApikeysListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysListOK, error)
+
+	ApikeysRevoke(params *ApikeysRevokeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysRevokeNoContent, error)
+
+	ApikeysRotate(params *ApikeysRotateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysRotateOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+ApikeysCreate create a new api key for a user, the secret is only returned in this response
+*/
+func (a *Client) ApikeysCreate(params *ApikeysCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysCreateCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewApikeysCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "apikeys.create",
+		Method:             "POST",
+		PathPattern:        "/apikeys",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ApikeysCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ApikeysCreateCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for apikeys.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ApikeysExpire set the expiration time of an api key, it expires immediately if no time is given
+*/
+func (a *Client) ApikeysExpire(params *ApikeysExpireParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysExpireOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewApikeysExpireParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "apikeys.expire",
+		Method:             "POST",
+		PathPattern:        "/apikeys/{id}/expire",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ApikeysExpireReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ApikeysExpireOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for apikeys.expire: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ApikeysList list all api keys which were created at runtime, their secrets are never returned
+*/
+func (a *Client) ApikeysList(params *ApikeysListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewApikeysListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "apikeys.list",
+		Method:             "GET",
+		PathPattern:        "/apikeys",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ApikeysListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ApikeysListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for apikeys.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ApikeysRevoke revoke an api key, it can't be used anymore
+*/
+func (a *Client) ApikeysRevoke(params *ApikeysRevokeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysRevokeNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewApikeysRevokeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "apikeys.revoke",
+		Method:             "DELETE",
+		PathPattern:        "/apikeys/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ApikeysRevokeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ApikeysRevokeNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for apikeys.revoke: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ApikeysRotate replace the secret of an api key, the old secret can't be used anymore and the new secret is only returned in this response
+*/
+func (a *Client) ApikeysRotate(params *ApikeysRotateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApikeysRotateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewApikeysRotateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "apikeys.rotate",
+		Method:             "POST",
+		PathPattern:        "/apikeys/{id}/rotate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ApikeysRotateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ApikeysRotateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for apikeys.rotate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewApikeysCreateParams creates a new ApikeysCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewApikeysCreateParams() *ApikeysCreateParams {
+	return &ApikeysCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewApikeysCreateParamsWithTimeout creates a new ApikeysCreateParams object
+// with the ability to set a timeout on a request.
+func NewApikeysCreateParamsWithTimeout(timeout time.Duration) *ApikeysCreateParams {
+	return &ApikeysCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewApikeysCreateParamsWithContext creates a new ApikeysCreateParams object
+// with the ability to set a context for a request.
+func NewApikeysCreateParamsWithContext(ctx context.Context) *ApikeysCreateParams {
+	return &ApikeysCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewApikeysCreateParamsWithHTTPClient creates a new ApikeysCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewApikeysCreateParamsWithHTTPClient(client *http.Client) *ApikeysCreateParams {
+	return &ApikeysCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ApikeysCreateParams contains all the parameters to send to the API endpoint
+
+	for the apikeys create operation.
+
+	Typically these are written to a http.Request.
+*/
+type ApikeysCreateParams struct {
+
+	// Body.
+	Body *models.APIKey
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the apikeys create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysCreateParams) WithDefaults() *ApikeysCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the apikeys create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the apikeys create params
+func (o *ApikeysCreateParams) WithTimeout(timeout time.Duration) *ApikeysCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the apikeys create params
+func (o *ApikeysCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the apikeys create params
+func (o *ApikeysCreateParams) WithContext(ctx context.Context) *ApikeysCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the apikeys create params
+func (o *ApikeysCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the apikeys create params
+func (o *ApikeysCreateParams) WithHTTPClient(client *http.Client) *ApikeysCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the apikeys create params
+func (o *ApikeysCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the apikeys create params
+func (o *ApikeysCreateParams) WithBody(body *models.APIKey) *ApikeysCreateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the apikeys create params
+func (o *ApikeysCreateParams) SetBody(body *models.APIKey) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ApikeysCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
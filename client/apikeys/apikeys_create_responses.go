@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysCreateReader is a Reader for the ApikeysCreate structure.
+type ApikeysCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApikeysCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewApikeysCreateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewApikeysCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewApikeysCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewApikeysCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewApikeysCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewApikeysCreateCreated creates a ApikeysCreateCreated with default headers values
+func NewApikeysCreateCreated() *ApikeysCreateCreated {
+	return &ApikeysCreateCreated{}
+}
+
+/*
+ApikeysCreateCreated describes a response with status code 201, with default header values.
+
+Api key created successfully
+*/
+type ApikeysCreateCreated struct {
+	Payload *models.APIKey
+}
+
+// IsSuccess returns true when this apikeys create created response has a 2xx status code
+func (o *ApikeysCreateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this apikeys create created response has a 3xx status code
+func (o *ApikeysCreateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys create created response has a 4xx status code
+func (o *ApikeysCreateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys create created response has a 5xx status code
+func (o *ApikeysCreateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys create created response a status code equal to that given
+func (o *ApikeysCreateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the apikeys create created response
+func (o *ApikeysCreateCreated) Code() int {
+	return 201
+}
+
+func (o *ApikeysCreateCreated) Error() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateCreated  %+v", 201, o.Payload)
+}
+
+func (o *ApikeysCreateCreated) String() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateCreated  %+v", 201, o.Payload)
+}
+
+func (o *ApikeysCreateCreated) GetPayload() *models.APIKey {
+	return o.Payload
+}
+
+func (o *ApikeysCreateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIKey)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysCreateUnauthorized creates a ApikeysCreateUnauthorized with default headers values
+func NewApikeysCreateUnauthorized() *ApikeysCreateUnauthorized {
+	return &ApikeysCreateUnauthorized{}
+}
+
+/*
+ApikeysCreateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ApikeysCreateUnauthorized struct {
+}
+
+// IsSuccess returns true when this apikeys create unauthorized response has a 2xx status code
+func (o *ApikeysCreateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys create unauthorized response has a 3xx status code
+func (o *ApikeysCreateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys create unauthorized response has a 4xx status code
+func (o *ApikeysCreateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys create unauthorized response has a 5xx status code
+func (o *ApikeysCreateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys create unauthorized response a status code equal to that given
+func (o *ApikeysCreateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the apikeys create unauthorized response
+func (o *ApikeysCreateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ApikeysCreateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateUnauthorized ", 401)
+}
+
+func (o *ApikeysCreateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateUnauthorized ", 401)
+}
+
+func (o *ApikeysCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysCreateForbidden creates a ApikeysCreateForbidden with default headers values
+func NewApikeysCreateForbidden() *ApikeysCreateForbidden {
+	return &ApikeysCreateForbidden{}
+}
+
+/*
+ApikeysCreateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ApikeysCreateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys create forbidden response has a 2xx status code
+func (o *ApikeysCreateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys create forbidden response has a 3xx status code
+func (o *ApikeysCreateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys create forbidden response has a 4xx status code
+func (o *ApikeysCreateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys create forbidden response has a 5xx status code
+func (o *ApikeysCreateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys create forbidden response a status code equal to that given
+func (o *ApikeysCreateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the apikeys create forbidden response
+func (o *ApikeysCreateForbidden) Code() int {
+	return 403
+}
+
+func (o *ApikeysCreateForbidden) Error() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysCreateForbidden) String() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysCreateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysCreateUnprocessableEntity creates a ApikeysCreateUnprocessableEntity with default headers values
+func NewApikeysCreateUnprocessableEntity() *ApikeysCreateUnprocessableEntity {
+	return &ApikeysCreateUnprocessableEntity{}
+}
+
+/*
+ApikeysCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type ApikeysCreateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys create unprocessable entity response has a 2xx status code
+func (o *ApikeysCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys create unprocessable entity response has a 3xx status code
+func (o *ApikeysCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys create unprocessable entity response has a 4xx status code
+func (o *ApikeysCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys create unprocessable entity response has a 5xx status code
+func (o *ApikeysCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys create unprocessable entity response a status code equal to that given
+func (o *ApikeysCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the apikeys create unprocessable entity response
+func (o *ApikeysCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ApikeysCreateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ApikeysCreateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ApikeysCreateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysCreateInternalServerError creates a ApikeysCreateInternalServerError with default headers values
+func NewApikeysCreateInternalServerError() *ApikeysCreateInternalServerError {
+	return &ApikeysCreateInternalServerError{}
+}
+
+/*
+ApikeysCreateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ApikeysCreateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys create internal server error response has a 2xx status code
+func (o *ApikeysCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys create internal server error response has a 3xx status code
+func (o *ApikeysCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys create internal server error response has a 4xx status code
+func (o *ApikeysCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys create internal server error response has a 5xx status code
+func (o *ApikeysCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this apikeys create internal server error response a status code equal to that given
+func (o *ApikeysCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the apikeys create internal server error response
+func (o *ApikeysCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ApikeysCreateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysCreateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /apikeys][%d] apikeysCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysCreateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
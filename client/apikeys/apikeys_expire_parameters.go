@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewApikeysExpireParams creates a new ApikeysExpireParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewApikeysExpireParams() *ApikeysExpireParams {
+	return &ApikeysExpireParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewApikeysExpireParamsWithTimeout creates a new ApikeysExpireParams object
+// with the ability to set a timeout on a request.
+func NewApikeysExpireParamsWithTimeout(timeout time.Duration) *ApikeysExpireParams {
+	return &ApikeysExpireParams{
+		timeout: timeout,
+	}
+}
+
+// NewApikeysExpireParamsWithContext creates a new ApikeysExpireParams object
+// with the ability to set a context for a request.
+func NewApikeysExpireParamsWithContext(ctx context.Context) *ApikeysExpireParams {
+	return &ApikeysExpireParams{
+		Context: ctx,
+	}
+}
+
+// NewApikeysExpireParamsWithHTTPClient creates a new ApikeysExpireParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewApikeysExpireParamsWithHTTPClient(client *http.Client) *ApikeysExpireParams {
+	return &ApikeysExpireParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ApikeysExpireParams contains all the parameters to send to the API endpoint
+
+	for the apikeys expire operation.
+
+	Typically these are written to a http.Request.
+*/
+type ApikeysExpireParams struct {
+
+	// Body.
+	Body *models.APIKeyExpiration
+
+	/* ID.
+
+	   id of the api key
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the apikeys expire params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysExpireParams) WithDefaults() *ApikeysExpireParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the apikeys expire params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysExpireParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the apikeys expire params
+func (o *ApikeysExpireParams) WithTimeout(timeout time.Duration) *ApikeysExpireParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the apikeys expire params
+func (o *ApikeysExpireParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the apikeys expire params
+func (o *ApikeysExpireParams) WithContext(ctx context.Context) *ApikeysExpireParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the apikeys expire params
+func (o *ApikeysExpireParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the apikeys expire params
+func (o *ApikeysExpireParams) WithHTTPClient(client *http.Client) *ApikeysExpireParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the apikeys expire params
+func (o *ApikeysExpireParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the apikeys expire params
+func (o *ApikeysExpireParams) WithBody(body *models.APIKeyExpiration) *ApikeysExpireParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the apikeys expire params
+func (o *ApikeysExpireParams) SetBody(body *models.APIKeyExpiration) {
+	o.Body = body
+}
+
+// WithID adds the id to the apikeys expire params
+func (o *ApikeysExpireParams) WithID(id string) *ApikeysExpireParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the apikeys expire params
+func (o *ApikeysExpireParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ApikeysExpireParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysExpireReader is a Reader for the ApikeysExpire structure.
+type ApikeysExpireReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApikeysExpireReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewApikeysExpireOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewApikeysExpireUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewApikeysExpireForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewApikeysExpireNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewApikeysExpireUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewApikeysExpireInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewApikeysExpireOK creates a ApikeysExpireOK with default headers values
+func NewApikeysExpireOK() *ApikeysExpireOK {
+	return &ApikeysExpireOK{}
+}
+
+/*
+ApikeysExpireOK describes a response with status code 200, with default header values.
+
+Api key expiration set successfully
+*/
+type ApikeysExpireOK struct {
+	Payload *models.APIKey
+}
+
+// IsSuccess returns true when this apikeys expire o k response has a 2xx status code
+func (o *ApikeysExpireOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this apikeys expire o k response has a 3xx status code
+func (o *ApikeysExpireOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys expire o k response has a 4xx status code
+func (o *ApikeysExpireOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys expire o k response has a 5xx status code
+func (o *ApikeysExpireOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys expire o k response a status code equal to that given
+func (o *ApikeysExpireOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the apikeys expire o k response
+func (o *ApikeysExpireOK) Code() int {
+	return 200
+}
+
+func (o *ApikeysExpireOK) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireOK  %+v", 200, o.Payload)
+}
+
+func (o *ApikeysExpireOK) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireOK  %+v", 200, o.Payload)
+}
+
+func (o *ApikeysExpireOK) GetPayload() *models.APIKey {
+	return o.Payload
+}
+
+func (o *ApikeysExpireOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIKey)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysExpireUnauthorized creates a ApikeysExpireUnauthorized with default headers values
+func NewApikeysExpireUnauthorized() *ApikeysExpireUnauthorized {
+	return &ApikeysExpireUnauthorized{}
+}
+
+/*
+ApikeysExpireUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ApikeysExpireUnauthorized struct {
+}
+
+// IsSuccess returns true when this apikeys expire unauthorized response has a 2xx status code
+func (o *ApikeysExpireUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys expire unauthorized response has a 3xx status code
+func (o *ApikeysExpireUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys expire unauthorized response has a 4xx status code
+func (o *ApikeysExpireUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys expire unauthorized response has a 5xx status code
+func (o *ApikeysExpireUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys expire unauthorized response a status code equal to that given
+func (o *ApikeysExpireUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the apikeys expire unauthorized response
+func (o *ApikeysExpireUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ApikeysExpireUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireUnauthorized ", 401)
+}
+
+func (o *ApikeysExpireUnauthorized) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireUnauthorized ", 401)
+}
+
+func (o *ApikeysExpireUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysExpireForbidden creates a ApikeysExpireForbidden with default headers values
+func NewApikeysExpireForbidden() *ApikeysExpireForbidden {
+	return &ApikeysExpireForbidden{}
+}
+
+/*
+ApikeysExpireForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ApikeysExpireForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys expire forbidden response has a 2xx status code
+func (o *ApikeysExpireForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys expire forbidden response has a 3xx status code
+func (o *ApikeysExpireForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys expire forbidden response has a 4xx status code
+func (o *ApikeysExpireForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys expire forbidden response has a 5xx status code
+func (o *ApikeysExpireForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys expire forbidden response a status code equal to that given
+func (o *ApikeysExpireForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the apikeys expire forbidden response
+func (o *ApikeysExpireForbidden) Code() int {
+	return 403
+}
+
+func (o *ApikeysExpireForbidden) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysExpireForbidden) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysExpireForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysExpireForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysExpireNotFound creates a ApikeysExpireNotFound with default headers values
+func NewApikeysExpireNotFound() *ApikeysExpireNotFound {
+	return &ApikeysExpireNotFound{}
+}
+
+/*
+ApikeysExpireNotFound describes a response with status code 404, with default header values.
+
+no api key found
+*/
+type ApikeysExpireNotFound struct {
+}
+
+// IsSuccess returns true when this apikeys expire not found response has a 2xx status code
+func (o *ApikeysExpireNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys expire not found response has a 3xx status code
+func (o *ApikeysExpireNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys expire not found response has a 4xx status code
+func (o *ApikeysExpireNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys expire not found response has a 5xx status code
+func (o *ApikeysExpireNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys expire not found response a status code equal to that given
+func (o *ApikeysExpireNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the apikeys expire not found response
+func (o *ApikeysExpireNotFound) Code() int {
+	return 404
+}
+
+func (o *ApikeysExpireNotFound) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireNotFound ", 404)
+}
+
+func (o *ApikeysExpireNotFound) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireNotFound ", 404)
+}
+
+func (o *ApikeysExpireNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysExpireUnprocessableEntity creates a ApikeysExpireUnprocessableEntity with default headers values
+func NewApikeysExpireUnprocessableEntity() *ApikeysExpireUnprocessableEntity {
+	return &ApikeysExpireUnprocessableEntity{}
+}
+
+/*
+ApikeysExpireUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type ApikeysExpireUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys expire unprocessable entity response has a 2xx status code
+func (o *ApikeysExpireUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys expire unprocessable entity response has a 3xx status code
+func (o *ApikeysExpireUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys expire unprocessable entity response has a 4xx status code
+func (o *ApikeysExpireUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys expire unprocessable entity response has a 5xx status code
+func (o *ApikeysExpireUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys expire unprocessable entity response a status code equal to that given
+func (o *ApikeysExpireUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the apikeys expire unprocessable entity response
+func (o *ApikeysExpireUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ApikeysExpireUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ApikeysExpireUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ApikeysExpireUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysExpireUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysExpireInternalServerError creates a ApikeysExpireInternalServerError with default headers values
+func NewApikeysExpireInternalServerError() *ApikeysExpireInternalServerError {
+	return &ApikeysExpireInternalServerError{}
+}
+
+/*
+ApikeysExpireInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ApikeysExpireInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys expire internal server error response has a 2xx status code
+func (o *ApikeysExpireInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys expire internal server error response has a 3xx status code
+func (o *ApikeysExpireInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys expire internal server error response has a 4xx status code
+func (o *ApikeysExpireInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys expire internal server error response has a 5xx status code
+func (o *ApikeysExpireInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this apikeys expire internal server error response a status code equal to that given
+func (o *ApikeysExpireInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the apikeys expire internal server error response
+func (o *ApikeysExpireInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ApikeysExpireInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysExpireInternalServerError) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/expire][%d] apikeysExpireInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysExpireInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysExpireInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
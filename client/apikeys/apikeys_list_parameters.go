@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewApikeysListParams creates a new ApikeysListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewApikeysListParams() *ApikeysListParams {
+	return &ApikeysListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewApikeysListParamsWithTimeout creates a new ApikeysListParams object
+// with the ability to set a timeout on a request.
+func NewApikeysListParamsWithTimeout(timeout time.Duration) *ApikeysListParams {
+	return &ApikeysListParams{
+		timeout: timeout,
+	}
+}
+
+// NewApikeysListParamsWithContext creates a new ApikeysListParams object
+// with the ability to set a context for a request.
+func NewApikeysListParamsWithContext(ctx context.Context) *ApikeysListParams {
+	return &ApikeysListParams{
+		Context: ctx,
+	}
+}
+
+// NewApikeysListParamsWithHTTPClient creates a new ApikeysListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewApikeysListParamsWithHTTPClient(client *http.Client) *ApikeysListParams {
+	return &ApikeysListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ApikeysListParams contains all the parameters to send to the API endpoint
+
+	for the apikeys list operation.
+
+	Typically these are written to a http.Request.
+*/
+type ApikeysListParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the apikeys list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysListParams) WithDefaults() *ApikeysListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the apikeys list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysListParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the apikeys list params
+func (o *ApikeysListParams) WithTimeout(timeout time.Duration) *ApikeysListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the apikeys list params
+func (o *ApikeysListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the apikeys list params
+func (o *ApikeysListParams) WithContext(ctx context.Context) *ApikeysListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the apikeys list params
+func (o *ApikeysListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the apikeys list params
+func (o *ApikeysListParams) WithHTTPClient(client *http.Client) *ApikeysListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the apikeys list params
+func (o *ApikeysListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ApikeysListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
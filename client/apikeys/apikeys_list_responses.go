@@ -0,0 +1,322 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysListReader is a Reader for the ApikeysList structure.
+type ApikeysListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApikeysListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewApikeysListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewApikeysListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewApikeysListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewApikeysListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewApikeysListOK creates a ApikeysListOK with default headers values
+func NewApikeysListOK() *ApikeysListOK {
+	return &ApikeysListOK{}
+}
+
+/*
+ApikeysListOK describes a response with status code 200, with default header values.
+
+Successful response.
+*/
+type ApikeysListOK struct {
+	Payload []*models.APIKey
+}
+
+// IsSuccess returns true when this apikeys list o k response has a 2xx status code
+func (o *ApikeysListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this apikeys list o k response has a 3xx status code
+func (o *ApikeysListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys list o k response has a 4xx status code
+func (o *ApikeysListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys list o k response has a 5xx status code
+func (o *ApikeysListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys list o k response a status code equal to that given
+func (o *ApikeysListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the apikeys list o k response
+func (o *ApikeysListOK) Code() int {
+	return 200
+}
+
+func (o *ApikeysListOK) Error() string {
+	return fmt.Sprintf("[GET /apikeys][%d] apikeysListOK  %+v", 200, o.Payload)
+}
+
+func (o *ApikeysListOK) String() string {
+	return fmt.Sprintf("[GET /apikeys][%d] apikeysListOK  %+v", 200, o.Payload)
+}
+
+func (o *ApikeysListOK) GetPayload() []*models.APIKey {
+	return o.Payload
+}
+
+func (o *ApikeysListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysListUnauthorized creates a ApikeysListUnauthorized with default headers values
+func NewApikeysListUnauthorized() *ApikeysListUnauthorized {
+	return &ApikeysListUnauthorized{}
+}
+
+/*
+ApikeysListUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ApikeysListUnauthorized struct {
+}
+
+// IsSuccess returns true when this apikeys list unauthorized response has a 2xx status code
+func (o *ApikeysListUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys list unauthorized response has a 3xx status code
+func (o *ApikeysListUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys list unauthorized response has a 4xx status code
+func (o *ApikeysListUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys list unauthorized response has a 5xx status code
+func (o *ApikeysListUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys list unauthorized response a status code equal to that given
+func (o *ApikeysListUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the apikeys list unauthorized response
+func (o *ApikeysListUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ApikeysListUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /apikeys][%d] apikeysListUnauthorized ", 401)
+}
+
+func (o *ApikeysListUnauthorized) String() string {
+	return fmt.Sprintf("[GET /apikeys][%d] apikeysListUnauthorized ", 401)
+}
+
+func (o *ApikeysListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysListForbidden creates a ApikeysListForbidden with default headers values
+func NewApikeysListForbidden() *ApikeysListForbidden {
+	return &ApikeysListForbidden{}
+}
+
+/*
+ApikeysListForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ApikeysListForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys list forbidden response has a 2xx status code
+func (o *ApikeysListForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys list forbidden response has a 3xx status code
+func (o *ApikeysListForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys list forbidden response has a 4xx status code
+func (o *ApikeysListForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys list forbidden response has a 5xx status code
+func (o *ApikeysListForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys list forbidden response a status code equal to that given
+func (o *ApikeysListForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the apikeys list forbidden response
+func (o *ApikeysListForbidden) Code() int {
+	return 403
+}
+
+func (o *ApikeysListForbidden) Error() string {
+	return fmt.Sprintf("[GET /apikeys][%d] apikeysListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysListForbidden) String() string {
+	return fmt.Sprintf("[GET /apikeys][%d] apikeysListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysListForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysListInternalServerError creates a ApikeysListInternalServerError with default headers values
+func NewApikeysListInternalServerError() *ApikeysListInternalServerError {
+	return &ApikeysListInternalServerError{}
+}
+
+/*
+ApikeysListInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ApikeysListInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys list internal server error response has a 2xx status code
+func (o *ApikeysListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys list internal server error response has a 3xx status code
+func (o *ApikeysListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys list internal server error response has a 4xx status code
+func (o *ApikeysListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys list internal server error response has a 5xx status code
+func (o *ApikeysListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this apikeys list internal server error response a status code equal to that given
+func (o *ApikeysListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the apikeys list internal server error response
+func (o *ApikeysListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ApikeysListInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /apikeys][%d] apikeysListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysListInternalServerError) String() string {
+	return fmt.Sprintf("[GET /apikeys][%d] apikeysListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysListInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewApikeysRevokeParams creates a new ApikeysRevokeParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewApikeysRevokeParams() *ApikeysRevokeParams {
+	return &ApikeysRevokeParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewApikeysRevokeParamsWithTimeout creates a new ApikeysRevokeParams object
+// with the ability to set a timeout on a request.
+func NewApikeysRevokeParamsWithTimeout(timeout time.Duration) *ApikeysRevokeParams {
+	return &ApikeysRevokeParams{
+		timeout: timeout,
+	}
+}
+
+// NewApikeysRevokeParamsWithContext creates a new ApikeysRevokeParams object
+// with the ability to set a context for a request.
+func NewApikeysRevokeParamsWithContext(ctx context.Context) *ApikeysRevokeParams {
+	return &ApikeysRevokeParams{
+		Context: ctx,
+	}
+}
+
+// NewApikeysRevokeParamsWithHTTPClient creates a new ApikeysRevokeParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewApikeysRevokeParamsWithHTTPClient(client *http.Client) *ApikeysRevokeParams {
+	return &ApikeysRevokeParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ApikeysRevokeParams contains all the parameters to send to the API endpoint
+
+	for the apikeys revoke operation.
+
+	Typically these are written to a http.Request.
+*/
+type ApikeysRevokeParams struct {
+
+	/* ID.
+
+	   id of the api key
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the apikeys revoke params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysRevokeParams) WithDefaults() *ApikeysRevokeParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the apikeys revoke params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysRevokeParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the apikeys revoke params
+func (o *ApikeysRevokeParams) WithTimeout(timeout time.Duration) *ApikeysRevokeParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the apikeys revoke params
+func (o *ApikeysRevokeParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the apikeys revoke params
+func (o *ApikeysRevokeParams) WithContext(ctx context.Context) *ApikeysRevokeParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the apikeys revoke params
+func (o *ApikeysRevokeParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the apikeys revoke params
+func (o *ApikeysRevokeParams) WithHTTPClient(client *http.Client) *ApikeysRevokeParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the apikeys revoke params
+func (o *ApikeysRevokeParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the apikeys revoke params
+func (o *ApikeysRevokeParams) WithID(id string) *ApikeysRevokeParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the apikeys revoke params
+func (o *ApikeysRevokeParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ApikeysRevokeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysRevokeReader is a Reader for the ApikeysRevoke structure.
+type ApikeysRevokeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApikeysRevokeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewApikeysRevokeNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewApikeysRevokeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewApikeysRevokeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewApikeysRevokeNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewApikeysRevokeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewApikeysRevokeNoContent creates a ApikeysRevokeNoContent with default headers values
+func NewApikeysRevokeNoContent() *ApikeysRevokeNoContent {
+	return &ApikeysRevokeNoContent{}
+}
+
+/*
+ApikeysRevokeNoContent describes a response with status code 204, with default header values.
+
+Api key revoked successfully
+*/
+type ApikeysRevokeNoContent struct {
+}
+
+// IsSuccess returns true when this apikeys revoke no content response has a 2xx status code
+func (o *ApikeysRevokeNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this apikeys revoke no content response has a 3xx status code
+func (o *ApikeysRevokeNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys revoke no content response has a 4xx status code
+func (o *ApikeysRevokeNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys revoke no content response has a 5xx status code
+func (o *ApikeysRevokeNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys revoke no content response a status code equal to that given
+func (o *ApikeysRevokeNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the apikeys revoke no content response
+func (o *ApikeysRevokeNoContent) Code() int {
+	return 204
+}
+
+func (o *ApikeysRevokeNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeNoContent ", 204)
+}
+
+func (o *ApikeysRevokeNoContent) String() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeNoContent ", 204)
+}
+
+func (o *ApikeysRevokeNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysRevokeUnauthorized creates a ApikeysRevokeUnauthorized with default headers values
+func NewApikeysRevokeUnauthorized() *ApikeysRevokeUnauthorized {
+	return &ApikeysRevokeUnauthorized{}
+}
+
+/*
+ApikeysRevokeUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ApikeysRevokeUnauthorized struct {
+}
+
+// IsSuccess returns true when this apikeys revoke unauthorized response has a 2xx status code
+func (o *ApikeysRevokeUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys revoke unauthorized response has a 3xx status code
+func (o *ApikeysRevokeUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys revoke unauthorized response has a 4xx status code
+func (o *ApikeysRevokeUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys revoke unauthorized response has a 5xx status code
+func (o *ApikeysRevokeUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys revoke unauthorized response a status code equal to that given
+func (o *ApikeysRevokeUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the apikeys revoke unauthorized response
+func (o *ApikeysRevokeUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ApikeysRevokeUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeUnauthorized ", 401)
+}
+
+func (o *ApikeysRevokeUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeUnauthorized ", 401)
+}
+
+func (o *ApikeysRevokeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysRevokeForbidden creates a ApikeysRevokeForbidden with default headers values
+func NewApikeysRevokeForbidden() *ApikeysRevokeForbidden {
+	return &ApikeysRevokeForbidden{}
+}
+
+/*
+ApikeysRevokeForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ApikeysRevokeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys revoke forbidden response has a 2xx status code
+func (o *ApikeysRevokeForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys revoke forbidden response has a 3xx status code
+func (o *ApikeysRevokeForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys revoke forbidden response has a 4xx status code
+func (o *ApikeysRevokeForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys revoke forbidden response has a 5xx status code
+func (o *ApikeysRevokeForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys revoke forbidden response a status code equal to that given
+func (o *ApikeysRevokeForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the apikeys revoke forbidden response
+func (o *ApikeysRevokeForbidden) Code() int {
+	return 403
+}
+
+func (o *ApikeysRevokeForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysRevokeForbidden) String() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysRevokeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysRevokeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysRevokeNotFound creates a ApikeysRevokeNotFound with default headers values
+func NewApikeysRevokeNotFound() *ApikeysRevokeNotFound {
+	return &ApikeysRevokeNotFound{}
+}
+
+/*
+ApikeysRevokeNotFound describes a response with status code 404, with default header values.
+
+no api key found
+*/
+type ApikeysRevokeNotFound struct {
+}
+
+// IsSuccess returns true when this apikeys revoke not found response has a 2xx status code
+func (o *ApikeysRevokeNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys revoke not found response has a 3xx status code
+func (o *ApikeysRevokeNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys revoke not found response has a 4xx status code
+func (o *ApikeysRevokeNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys revoke not found response has a 5xx status code
+func (o *ApikeysRevokeNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys revoke not found response a status code equal to that given
+func (o *ApikeysRevokeNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the apikeys revoke not found response
+func (o *ApikeysRevokeNotFound) Code() int {
+	return 404
+}
+
+func (o *ApikeysRevokeNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeNotFound ", 404)
+}
+
+func (o *ApikeysRevokeNotFound) String() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeNotFound ", 404)
+}
+
+func (o *ApikeysRevokeNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysRevokeInternalServerError creates a ApikeysRevokeInternalServerError with default headers values
+func NewApikeysRevokeInternalServerError() *ApikeysRevokeInternalServerError {
+	return &ApikeysRevokeInternalServerError{}
+}
+
+/*
+ApikeysRevokeInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ApikeysRevokeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys revoke internal server error response has a 2xx status code
+func (o *ApikeysRevokeInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys revoke internal server error response has a 3xx status code
+func (o *ApikeysRevokeInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys revoke internal server error response has a 4xx status code
+func (o *ApikeysRevokeInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys revoke internal server error response has a 5xx status code
+func (o *ApikeysRevokeInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this apikeys revoke internal server error response a status code equal to that given
+func (o *ApikeysRevokeInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the apikeys revoke internal server error response
+func (o *ApikeysRevokeInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ApikeysRevokeInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysRevokeInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /apikeys/{id}][%d] apikeysRevokeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysRevokeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysRevokeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
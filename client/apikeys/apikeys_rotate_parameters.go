@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewApikeysRotateParams creates a new ApikeysRotateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewApikeysRotateParams() *ApikeysRotateParams {
+	return &ApikeysRotateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewApikeysRotateParamsWithTimeout creates a new ApikeysRotateParams object
+// with the ability to set a timeout on a request.
+func NewApikeysRotateParamsWithTimeout(timeout time.Duration) *ApikeysRotateParams {
+	return &ApikeysRotateParams{
+		timeout: timeout,
+	}
+}
+
+// NewApikeysRotateParamsWithContext creates a new ApikeysRotateParams object
+// with the ability to set a context for a request.
+func NewApikeysRotateParamsWithContext(ctx context.Context) *ApikeysRotateParams {
+	return &ApikeysRotateParams{
+		Context: ctx,
+	}
+}
+
+// NewApikeysRotateParamsWithHTTPClient creates a new ApikeysRotateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewApikeysRotateParamsWithHTTPClient(client *http.Client) *ApikeysRotateParams {
+	return &ApikeysRotateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ApikeysRotateParams contains all the parameters to send to the API endpoint
+
+	for the apikeys rotate operation.
+
+	Typically these are written to a http.Request.
+*/
+type ApikeysRotateParams struct {
+
+	/* ID.
+
+	   id of the api key
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the apikeys rotate params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysRotateParams) WithDefaults() *ApikeysRotateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the apikeys rotate params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApikeysRotateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the apikeys rotate params
+func (o *ApikeysRotateParams) WithTimeout(timeout time.Duration) *ApikeysRotateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the apikeys rotate params
+func (o *ApikeysRotateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the apikeys rotate params
+func (o *ApikeysRotateParams) WithContext(ctx context.Context) *ApikeysRotateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the apikeys rotate params
+func (o *ApikeysRotateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the apikeys rotate params
+func (o *ApikeysRotateParams) WithHTTPClient(client *http.Client) *ApikeysRotateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the apikeys rotate params
+func (o *ApikeysRotateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the apikeys rotate params
+func (o *ApikeysRotateParams) WithID(id string) *ApikeysRotateParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the apikeys rotate params
+func (o *ApikeysRotateParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ApikeysRotateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package apikeys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ApikeysRotateReader is a Reader for the ApikeysRotate structure.
+type ApikeysRotateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApikeysRotateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewApikeysRotateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewApikeysRotateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewApikeysRotateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewApikeysRotateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewApikeysRotateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewApikeysRotateOK creates a ApikeysRotateOK with default headers values
+func NewApikeysRotateOK() *ApikeysRotateOK {
+	return &ApikeysRotateOK{}
+}
+
+/*
+ApikeysRotateOK describes a response with status code 200, with default header values.
+
+Api key rotated successfully
+*/
+type ApikeysRotateOK struct {
+	Payload *models.APIKey
+}
+
+// IsSuccess returns true when this apikeys rotate o k response has a 2xx status code
+func (o *ApikeysRotateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this apikeys rotate o k response has a 3xx status code
+func (o *ApikeysRotateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys rotate o k response has a 4xx status code
+func (o *ApikeysRotateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys rotate o k response has a 5xx status code
+func (o *ApikeysRotateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys rotate o k response a status code equal to that given
+func (o *ApikeysRotateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the apikeys rotate o k response
+func (o *ApikeysRotateOK) Code() int {
+	return 200
+}
+
+func (o *ApikeysRotateOK) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateOK  %+v", 200, o.Payload)
+}
+
+func (o *ApikeysRotateOK) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateOK  %+v", 200, o.Payload)
+}
+
+func (o *ApikeysRotateOK) GetPayload() *models.APIKey {
+	return o.Payload
+}
+
+func (o *ApikeysRotateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIKey)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysRotateUnauthorized creates a ApikeysRotateUnauthorized with default headers values
+func NewApikeysRotateUnauthorized() *ApikeysRotateUnauthorized {
+	return &ApikeysRotateUnauthorized{}
+}
+
+/*
+ApikeysRotateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ApikeysRotateUnauthorized struct {
+}
+
+// IsSuccess returns true when this apikeys rotate unauthorized response has a 2xx status code
+func (o *ApikeysRotateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys rotate unauthorized response has a 3xx status code
+func (o *ApikeysRotateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys rotate unauthorized response has a 4xx status code
+func (o *ApikeysRotateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys rotate unauthorized response has a 5xx status code
+func (o *ApikeysRotateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys rotate unauthorized response a status code equal to that given
+func (o *ApikeysRotateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the apikeys rotate unauthorized response
+func (o *ApikeysRotateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ApikeysRotateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateUnauthorized ", 401)
+}
+
+func (o *ApikeysRotateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateUnauthorized ", 401)
+}
+
+func (o *ApikeysRotateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysRotateForbidden creates a ApikeysRotateForbidden with default headers values
+func NewApikeysRotateForbidden() *ApikeysRotateForbidden {
+	return &ApikeysRotateForbidden{}
+}
+
+/*
+ApikeysRotateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ApikeysRotateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys rotate forbidden response has a 2xx status code
+func (o *ApikeysRotateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys rotate forbidden response has a 3xx status code
+func (o *ApikeysRotateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys rotate forbidden response has a 4xx status code
+func (o *ApikeysRotateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys rotate forbidden response has a 5xx status code
+func (o *ApikeysRotateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys rotate forbidden response a status code equal to that given
+func (o *ApikeysRotateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the apikeys rotate forbidden response
+func (o *ApikeysRotateForbidden) Code() int {
+	return 403
+}
+
+func (o *ApikeysRotateForbidden) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysRotateForbidden) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApikeysRotateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysRotateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApikeysRotateNotFound creates a ApikeysRotateNotFound with default headers values
+func NewApikeysRotateNotFound() *ApikeysRotateNotFound {
+	return &ApikeysRotateNotFound{}
+}
+
+/*
+ApikeysRotateNotFound describes a response with status code 404, with default header values.
+
+no api key found
+*/
+type ApikeysRotateNotFound struct {
+}
+
+// IsSuccess returns true when this apikeys rotate not found response has a 2xx status code
+func (o *ApikeysRotateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys rotate not found response has a 3xx status code
+func (o *ApikeysRotateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys rotate not found response has a 4xx status code
+func (o *ApikeysRotateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this apikeys rotate not found response has a 5xx status code
+func (o *ApikeysRotateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this apikeys rotate not found response a status code equal to that given
+func (o *ApikeysRotateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the apikeys rotate not found response
+func (o *ApikeysRotateNotFound) Code() int {
+	return 404
+}
+
+func (o *ApikeysRotateNotFound) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateNotFound ", 404)
+}
+
+func (o *ApikeysRotateNotFound) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateNotFound ", 404)
+}
+
+func (o *ApikeysRotateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApikeysRotateInternalServerError creates a ApikeysRotateInternalServerError with default headers values
+func NewApikeysRotateInternalServerError() *ApikeysRotateInternalServerError {
+	return &ApikeysRotateInternalServerError{}
+}
+
+/*
+ApikeysRotateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ApikeysRotateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this apikeys rotate internal server error response has a 2xx status code
+func (o *ApikeysRotateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this apikeys rotate internal server error response has a 3xx status code
+func (o *ApikeysRotateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this apikeys rotate internal server error response has a 4xx status code
+func (o *ApikeysRotateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this apikeys rotate internal server error response has a 5xx status code
+func (o *ApikeysRotateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this apikeys rotate internal server error response a status code equal to that given
+func (o *ApikeysRotateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the apikeys rotate internal server error response
+func (o *ApikeysRotateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ApikeysRotateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysRotateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /apikeys/{id}/rotate][%d] apikeysRotateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApikeysRotateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ApikeysRotateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzAssignRoleToGroupParams creates a new AuthzAssignRoleToGroupParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzAssignRoleToGroupParams() *AuthzAssignRoleToGroupParams {
+	return &AuthzAssignRoleToGroupParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzAssignRoleToGroupParamsWithTimeout creates a new AuthzAssignRoleToGroupParams object
+// with the ability to set a timeout on a request.
+func NewAuthzAssignRoleToGroupParamsWithTimeout(timeout time.Duration) *AuthzAssignRoleToGroupParams {
+	return &AuthzAssignRoleToGroupParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzAssignRoleToGroupParamsWithContext creates a new AuthzAssignRoleToGroupParams object
+// with the ability to set a context for a request.
+func NewAuthzAssignRoleToGroupParamsWithContext(ctx context.Context) *AuthzAssignRoleToGroupParams {
+	return &AuthzAssignRoleToGroupParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzAssignRoleToGroupParamsWithHTTPClient creates a new AuthzAssignRoleToGroupParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzAssignRoleToGroupParamsWithHTTPClient(client *http.Client) *AuthzAssignRoleToGroupParams {
+	return &AuthzAssignRoleToGroupParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzAssignRoleToGroupParams contains all the parameters to send to the API endpoint
+
+	for the authz assign role to group operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzAssignRoleToGroupParams struct {
+
+	// Body.
+	Body AuthzAssignRoleToGroupBody
+
+	/* ID.
+
+	   group name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz assign role to group params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzAssignRoleToGroupParams) WithDefaults() *AuthzAssignRoleToGroupParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz assign role to group params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzAssignRoleToGroupParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) WithTimeout(timeout time.Duration) *AuthzAssignRoleToGroupParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) WithContext(ctx context.Context) *AuthzAssignRoleToGroupParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) WithHTTPClient(client *http.Client) *AuthzAssignRoleToGroupParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) WithBody(body AuthzAssignRoleToGroupBody) *AuthzAssignRoleToGroupParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) SetBody(body AuthzAssignRoleToGroupBody) {
+	o.Body = body
+}
+
+// WithID adds the id to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) WithID(id string) *AuthzAssignRoleToGroupParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz assign role to group params
+func (o *AuthzAssignRoleToGroupParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzAssignRoleToGroupParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,488 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzAssignRoleToGroupReader is a Reader for the AuthzAssignRoleToGroup structure.
+type AuthzAssignRoleToGroupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzAssignRoleToGroupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzAssignRoleToGroupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzAssignRoleToGroupUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzAssignRoleToGroupForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAuthzAssignRoleToGroupNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAuthzAssignRoleToGroupUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzAssignRoleToGroupInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzAssignRoleToGroupOK creates a AuthzAssignRoleToGroupOK with default headers values
+func NewAuthzAssignRoleToGroupOK() *AuthzAssignRoleToGroupOK {
+	return &AuthzAssignRoleToGroupOK{}
+}
+
+/*
+AuthzAssignRoleToGroupOK describes a response with status code 200, with default header values.
+
+Role assigned successfully
+*/
+type AuthzAssignRoleToGroupOK struct {
+}
+
+// IsSuccess returns true when this authz assign role to group o k response has a 2xx status code
+func (o *AuthzAssignRoleToGroupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz assign role to group o k response has a 3xx status code
+func (o *AuthzAssignRoleToGroupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to group o k response has a 4xx status code
+func (o *AuthzAssignRoleToGroupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz assign role to group o k response has a 5xx status code
+func (o *AuthzAssignRoleToGroupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to group o k response a status code equal to that given
+func (o *AuthzAssignRoleToGroupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz assign role to group o k response
+func (o *AuthzAssignRoleToGroupOK) Code() int {
+	return 200
+}
+
+func (o *AuthzAssignRoleToGroupOK) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupOK ", 200)
+}
+
+func (o *AuthzAssignRoleToGroupOK) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupOK ", 200)
+}
+
+func (o *AuthzAssignRoleToGroupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzAssignRoleToGroupUnauthorized creates a AuthzAssignRoleToGroupUnauthorized with default headers values
+func NewAuthzAssignRoleToGroupUnauthorized() *AuthzAssignRoleToGroupUnauthorized {
+	return &AuthzAssignRoleToGroupUnauthorized{}
+}
+
+/*
+AuthzAssignRoleToGroupUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzAssignRoleToGroupUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz assign role to group unauthorized response has a 2xx status code
+func (o *AuthzAssignRoleToGroupUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to group unauthorized response has a 3xx status code
+func (o *AuthzAssignRoleToGroupUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to group unauthorized response has a 4xx status code
+func (o *AuthzAssignRoleToGroupUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz assign role to group unauthorized response has a 5xx status code
+func (o *AuthzAssignRoleToGroupUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to group unauthorized response a status code equal to that given
+func (o *AuthzAssignRoleToGroupUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz assign role to group unauthorized response
+func (o *AuthzAssignRoleToGroupUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzAssignRoleToGroupUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupUnauthorized ", 401)
+}
+
+func (o *AuthzAssignRoleToGroupUnauthorized) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupUnauthorized ", 401)
+}
+
+func (o *AuthzAssignRoleToGroupUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzAssignRoleToGroupForbidden creates a AuthzAssignRoleToGroupForbidden with default headers values
+func NewAuthzAssignRoleToGroupForbidden() *AuthzAssignRoleToGroupForbidden {
+	return &AuthzAssignRoleToGroupForbidden{}
+}
+
+/*
+AuthzAssignRoleToGroupForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzAssignRoleToGroupForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz assign role to group forbidden response has a 2xx status code
+func (o *AuthzAssignRoleToGroupForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to group forbidden response has a 3xx status code
+func (o *AuthzAssignRoleToGroupForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to group forbidden response has a 4xx status code
+func (o *AuthzAssignRoleToGroupForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz assign role to group forbidden response has a 5xx status code
+func (o *AuthzAssignRoleToGroupForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to group forbidden response a status code equal to that given
+func (o *AuthzAssignRoleToGroupForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz assign role to group forbidden response
+func (o *AuthzAssignRoleToGroupForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzAssignRoleToGroupForbidden) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzAssignRoleToGroupForbidden) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzAssignRoleToGroupForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzAssignRoleToGroupForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzAssignRoleToGroupNotFound creates a AuthzAssignRoleToGroupNotFound with default headers values
+func NewAuthzAssignRoleToGroupNotFound() *AuthzAssignRoleToGroupNotFound {
+	return &AuthzAssignRoleToGroupNotFound{}
+}
+
+/*
+AuthzAssignRoleToGroupNotFound describes a response with status code 404, with default header values.
+
+no role found
+*/
+type AuthzAssignRoleToGroupNotFound struct {
+}
+
+// IsSuccess returns true when this authz assign role to group not found response has a 2xx status code
+func (o *AuthzAssignRoleToGroupNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to group not found response has a 3xx status code
+func (o *AuthzAssignRoleToGroupNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to group not found response has a 4xx status code
+func (o *AuthzAssignRoleToGroupNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz assign role to group not found response has a 5xx status code
+func (o *AuthzAssignRoleToGroupNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to group not found response a status code equal to that given
+func (o *AuthzAssignRoleToGroupNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the authz assign role to group not found response
+func (o *AuthzAssignRoleToGroupNotFound) Code() int {
+	return 404
+}
+
+func (o *AuthzAssignRoleToGroupNotFound) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupNotFound ", 404)
+}
+
+func (o *AuthzAssignRoleToGroupNotFound) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupNotFound ", 404)
+}
+
+func (o *AuthzAssignRoleToGroupNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzAssignRoleToGroupUnprocessableEntity creates a AuthzAssignRoleToGroupUnprocessableEntity with default headers values
+func NewAuthzAssignRoleToGroupUnprocessableEntity() *AuthzAssignRoleToGroupUnprocessableEntity {
+	return &AuthzAssignRoleToGroupUnprocessableEntity{}
+}
+
+/*
+AuthzAssignRoleToGroupUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type AuthzAssignRoleToGroupUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz assign role to group unprocessable entity response has a 2xx status code
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to group unprocessable entity response has a 3xx status code
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to group unprocessable entity response has a 4xx status code
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz assign role to group unprocessable entity response has a 5xx status code
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to group unprocessable entity response a status code equal to that given
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the authz assign role to group unprocessable entity response
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzAssignRoleToGroupUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzAssignRoleToGroupInternalServerError creates a AuthzAssignRoleToGroupInternalServerError with default headers values
+func NewAuthzAssignRoleToGroupInternalServerError() *AuthzAssignRoleToGroupInternalServerError {
+	return &AuthzAssignRoleToGroupInternalServerError{}
+}
+
+/*
+AuthzAssignRoleToGroupInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzAssignRoleToGroupInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz assign role to group internal server error response has a 2xx status code
+func (o *AuthzAssignRoleToGroupInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to group internal server error response has a 3xx status code
+func (o *AuthzAssignRoleToGroupInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to group internal server error response has a 4xx status code
+func (o *AuthzAssignRoleToGroupInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz assign role to group internal server error response has a 5xx status code
+func (o *AuthzAssignRoleToGroupInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz assign role to group internal server error response a status code equal to that given
+func (o *AuthzAssignRoleToGroupInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz assign role to group internal server error response
+func (o *AuthzAssignRoleToGroupInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzAssignRoleToGroupInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzAssignRoleToGroupInternalServerError) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/assign][%d] authzAssignRoleToGroupInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzAssignRoleToGroupInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzAssignRoleToGroupInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+AuthzAssignRoleToGroupBody authz assign role to group body
+swagger:model AuthzAssignRoleToGroupBody
+*/
+type AuthzAssignRoleToGroupBody struct {
+
+	// names of the roles
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this authz assign role to group body
+func (o *AuthzAssignRoleToGroupBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this authz assign role to group body based on context it is used
+func (o *AuthzAssignRoleToGroupBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AuthzAssignRoleToGroupBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AuthzAssignRoleToGroupBody) UnmarshalBinary(b []byte) error {
+	var res AuthzAssignRoleToGroupBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
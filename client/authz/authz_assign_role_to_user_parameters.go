@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzAssignRoleToUserParams creates a new AuthzAssignRoleToUserParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzAssignRoleToUserParams() *AuthzAssignRoleToUserParams {
+	return &AuthzAssignRoleToUserParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzAssignRoleToUserParamsWithTimeout creates a new AuthzAssignRoleToUserParams object
+// with the ability to set a timeout on a request.
+func NewAuthzAssignRoleToUserParamsWithTimeout(timeout time.Duration) *AuthzAssignRoleToUserParams {
+	return &AuthzAssignRoleToUserParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzAssignRoleToUserParamsWithContext creates a new AuthzAssignRoleToUserParams object
+// with the ability to set a context for a request.
+func NewAuthzAssignRoleToUserParamsWithContext(ctx context.Context) *AuthzAssignRoleToUserParams {
+	return &AuthzAssignRoleToUserParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzAssignRoleToUserParamsWithHTTPClient creates a new AuthzAssignRoleToUserParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzAssignRoleToUserParamsWithHTTPClient(client *http.Client) *AuthzAssignRoleToUserParams {
+	return &AuthzAssignRoleToUserParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzAssignRoleToUserParams contains all the parameters to send to the API endpoint
+
+	for the authz assign role to user operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzAssignRoleToUserParams struct {
+
+	// Body.
+	Body AuthzAssignRoleToUserBody
+
+	/* ID.
+
+	   user name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz assign role to user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzAssignRoleToUserParams) WithDefaults() *AuthzAssignRoleToUserParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz assign role to user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzAssignRoleToUserParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) WithTimeout(timeout time.Duration) *AuthzAssignRoleToUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) WithContext(ctx context.Context) *AuthzAssignRoleToUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) WithHTTPClient(client *http.Client) *AuthzAssignRoleToUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) WithBody(body AuthzAssignRoleToUserBody) *AuthzAssignRoleToUserParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) SetBody(body AuthzAssignRoleToUserBody) {
+	o.Body = body
+}
+
+// WithID adds the id to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) WithID(id string) *AuthzAssignRoleToUserParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz assign role to user params
+func (o *AuthzAssignRoleToUserParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzAssignRoleToUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,488 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzAssignRoleToUserReader is a Reader for the AuthzAssignRoleToUser structure.
+type AuthzAssignRoleToUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzAssignRoleToUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzAssignRoleToUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzAssignRoleToUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzAssignRoleToUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAuthzAssignRoleToUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAuthzAssignRoleToUserUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzAssignRoleToUserInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzAssignRoleToUserOK creates a AuthzAssignRoleToUserOK with default headers values
+func NewAuthzAssignRoleToUserOK() *AuthzAssignRoleToUserOK {
+	return &AuthzAssignRoleToUserOK{}
+}
+
+/*
+AuthzAssignRoleToUserOK describes a response with status code 200, with default header values.
+
+Role assigned successfully
+*/
+type AuthzAssignRoleToUserOK struct {
+}
+
+// IsSuccess returns true when this authz assign role to user o k response has a 2xx status code
+func (o *AuthzAssignRoleToUserOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz assign role to user o k response has a 3xx status code
+func (o *AuthzAssignRoleToUserOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to user o k response has a 4xx status code
+func (o *AuthzAssignRoleToUserOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz assign role to user o k response has a 5xx status code
+func (o *AuthzAssignRoleToUserOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to user o k response a status code equal to that given
+func (o *AuthzAssignRoleToUserOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz assign role to user o k response
+func (o *AuthzAssignRoleToUserOK) Code() int {
+	return 200
+}
+
+func (o *AuthzAssignRoleToUserOK) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserOK ", 200)
+}
+
+func (o *AuthzAssignRoleToUserOK) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserOK ", 200)
+}
+
+func (o *AuthzAssignRoleToUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzAssignRoleToUserUnauthorized creates a AuthzAssignRoleToUserUnauthorized with default headers values
+func NewAuthzAssignRoleToUserUnauthorized() *AuthzAssignRoleToUserUnauthorized {
+	return &AuthzAssignRoleToUserUnauthorized{}
+}
+
+/*
+AuthzAssignRoleToUserUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzAssignRoleToUserUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz assign role to user unauthorized response has a 2xx status code
+func (o *AuthzAssignRoleToUserUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to user unauthorized response has a 3xx status code
+func (o *AuthzAssignRoleToUserUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to user unauthorized response has a 4xx status code
+func (o *AuthzAssignRoleToUserUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz assign role to user unauthorized response has a 5xx status code
+func (o *AuthzAssignRoleToUserUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to user unauthorized response a status code equal to that given
+func (o *AuthzAssignRoleToUserUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz assign role to user unauthorized response
+func (o *AuthzAssignRoleToUserUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzAssignRoleToUserUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserUnauthorized ", 401)
+}
+
+func (o *AuthzAssignRoleToUserUnauthorized) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserUnauthorized ", 401)
+}
+
+func (o *AuthzAssignRoleToUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzAssignRoleToUserForbidden creates a AuthzAssignRoleToUserForbidden with default headers values
+func NewAuthzAssignRoleToUserForbidden() *AuthzAssignRoleToUserForbidden {
+	return &AuthzAssignRoleToUserForbidden{}
+}
+
+/*
+AuthzAssignRoleToUserForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzAssignRoleToUserForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz assign role to user forbidden response has a 2xx status code
+func (o *AuthzAssignRoleToUserForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to user forbidden response has a 3xx status code
+func (o *AuthzAssignRoleToUserForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to user forbidden response has a 4xx status code
+func (o *AuthzAssignRoleToUserForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz assign role to user forbidden response has a 5xx status code
+func (o *AuthzAssignRoleToUserForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to user forbidden response a status code equal to that given
+func (o *AuthzAssignRoleToUserForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz assign role to user forbidden response
+func (o *AuthzAssignRoleToUserForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzAssignRoleToUserForbidden) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzAssignRoleToUserForbidden) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzAssignRoleToUserForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzAssignRoleToUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzAssignRoleToUserNotFound creates a AuthzAssignRoleToUserNotFound with default headers values
+func NewAuthzAssignRoleToUserNotFound() *AuthzAssignRoleToUserNotFound {
+	return &AuthzAssignRoleToUserNotFound{}
+}
+
+/*
+AuthzAssignRoleToUserNotFound describes a response with status code 404, with default header values.
+
+no role found
+*/
+type AuthzAssignRoleToUserNotFound struct {
+}
+
+// IsSuccess returns true when this authz assign role to user not found response has a 2xx status code
+func (o *AuthzAssignRoleToUserNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to user not found response has a 3xx status code
+func (o *AuthzAssignRoleToUserNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to user not found response has a 4xx status code
+func (o *AuthzAssignRoleToUserNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz assign role to user not found response has a 5xx status code
+func (o *AuthzAssignRoleToUserNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to user not found response a status code equal to that given
+func (o *AuthzAssignRoleToUserNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the authz assign role to user not found response
+func (o *AuthzAssignRoleToUserNotFound) Code() int {
+	return 404
+}
+
+func (o *AuthzAssignRoleToUserNotFound) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserNotFound ", 404)
+}
+
+func (o *AuthzAssignRoleToUserNotFound) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserNotFound ", 404)
+}
+
+func (o *AuthzAssignRoleToUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzAssignRoleToUserUnprocessableEntity creates a AuthzAssignRoleToUserUnprocessableEntity with default headers values
+func NewAuthzAssignRoleToUserUnprocessableEntity() *AuthzAssignRoleToUserUnprocessableEntity {
+	return &AuthzAssignRoleToUserUnprocessableEntity{}
+}
+
+/*
+AuthzAssignRoleToUserUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type AuthzAssignRoleToUserUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz assign role to user unprocessable entity response has a 2xx status code
+func (o *AuthzAssignRoleToUserUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to user unprocessable entity response has a 3xx status code
+func (o *AuthzAssignRoleToUserUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to user unprocessable entity response has a 4xx status code
+func (o *AuthzAssignRoleToUserUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz assign role to user unprocessable entity response has a 5xx status code
+func (o *AuthzAssignRoleToUserUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz assign role to user unprocessable entity response a status code equal to that given
+func (o *AuthzAssignRoleToUserUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the authz assign role to user unprocessable entity response
+func (o *AuthzAssignRoleToUserUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AuthzAssignRoleToUserUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzAssignRoleToUserUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzAssignRoleToUserUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzAssignRoleToUserUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzAssignRoleToUserInternalServerError creates a AuthzAssignRoleToUserInternalServerError with default headers values
+func NewAuthzAssignRoleToUserInternalServerError() *AuthzAssignRoleToUserInternalServerError {
+	return &AuthzAssignRoleToUserInternalServerError{}
+}
+
+/*
+AuthzAssignRoleToUserInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzAssignRoleToUserInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz assign role to user internal server error response has a 2xx status code
+func (o *AuthzAssignRoleToUserInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz assign role to user internal server error response has a 3xx status code
+func (o *AuthzAssignRoleToUserInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz assign role to user internal server error response has a 4xx status code
+func (o *AuthzAssignRoleToUserInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz assign role to user internal server error response has a 5xx status code
+func (o *AuthzAssignRoleToUserInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz assign role to user internal server error response a status code equal to that given
+func (o *AuthzAssignRoleToUserInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz assign role to user internal server error response
+func (o *AuthzAssignRoleToUserInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzAssignRoleToUserInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzAssignRoleToUserInternalServerError) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/assign][%d] authzAssignRoleToUserInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzAssignRoleToUserInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzAssignRoleToUserInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+AuthzAssignRoleToUserBody authz assign role to user body
+swagger:model AuthzAssignRoleToUserBody
+*/
+type AuthzAssignRoleToUserBody struct {
+
+	// names of the roles
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this authz assign role to user body
+func (o *AuthzAssignRoleToUserBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this authz assign role to user body based on context it is used
+func (o *AuthzAssignRoleToUserBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AuthzAssignRoleToUserBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AuthzAssignRoleToUserBody) UnmarshalBinary(b []byte) error {
+	var res AuthzAssignRoleToUserBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
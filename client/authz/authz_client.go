@@ -0,0 +1,501 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new authz API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for authz API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	AuthzAssignRoleToGroup(params *AuthzAssignRoleToGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzAssignRoleToGroupOK, error)
+
+	AuthzAssignRoleToUser(params *AuthzAssignRoleToUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzAssignRoleToUserOK, error)
+
+	AuthzCreateRole(params *AuthzCreateRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzCreateRoleCreated, error)
+
+	AuthzDeleteRole(params *AuthzDeleteRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzDeleteRoleNoContent, error)
+
+	AuthzGetRole(params *AuthzGetRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzGetRoleOK, error)
+
+	AuthzGetRoles(params *AuthzGetRolesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzGetRolesOK, error)
+
+	AuthzGetRolesForGroup(params *AuthzGetRolesForGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzGetRolesForGroupOK, error)
+
+	AuthzGetRolesForUser(params *AuthzGetRolesForUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzGetRolesForUserOK, error)
+
+	AuthzRevokeRoleFromGroup(params *AuthzRevokeRoleFromGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzRevokeRoleFromGroupOK, error)
+
+	AuthzRevokeRoleFromUser(params *AuthzRevokeRoleFromUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzRevokeRoleFromUserOK, error)
+
+	AuthzUpdateRole(params *AuthzUpdateRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzUpdateRoleOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+AuthzAssignRoleToGroup assign roles to a group
+*/
+func (a *Client) AuthzAssignRoleToGroup(params *AuthzAssignRoleToGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzAssignRoleToGroupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzAssignRoleToGroupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.assignRoleToGroup",
+		Method:             "POST",
+		PathPattern:        "/authz/groups/{id}/assign",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzAssignRoleToGroupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzAssignRoleToGroupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.assignRoleToGroup: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzAssignRoleToUser assign roles to a user
+*/
+func (a *Client) AuthzAssignRoleToUser(params *AuthzAssignRoleToUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzAssignRoleToUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzAssignRoleToUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.assignRoleToUser",
+		Method:             "POST",
+		PathPattern:        "/authz/users/{id}/assign",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzAssignRoleToUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzAssignRoleToUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.assignRoleToUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzCreateRole create a new role
+*/
+func (a *Client) AuthzCreateRole(params *AuthzCreateRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzCreateRoleCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzCreateRoleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.createRole",
+		Method:             "POST",
+		PathPattern:        "/authz/roles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzCreateRoleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzCreateRoleCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.createRole: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzDeleteRole delete a role, it is revoked from all users and groups
+*/
+func (a *Client) AuthzDeleteRole(params *AuthzDeleteRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzDeleteRoleNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzDeleteRoleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.deleteRole",
+		Method:             "DELETE",
+		PathPattern:        "/authz/roles/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzDeleteRoleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzDeleteRoleNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.deleteRole: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzGetRole get a role
+*/
+func (a *Client) AuthzGetRole(params *AuthzGetRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzGetRoleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzGetRoleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.getRole",
+		Method:             "GET",
+		PathPattern:        "/authz/roles/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzGetRoleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzGetRoleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.getRole: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzGetRoles get all roles
+*/
+func (a *Client) AuthzGetRoles(params *AuthzGetRolesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzGetRolesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzGetRolesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.getRoles",
+		Method:             "GET",
+		PathPattern:        "/authz/roles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzGetRolesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzGetRolesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.getRoles: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzGetRolesForGroup get the roles assigned to a group
+*/
+func (a *Client) AuthzGetRolesForGroup(params *AuthzGetRolesForGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzGetRolesForGroupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzGetRolesForGroupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.getRolesForGroup",
+		Method:             "GET",
+		PathPattern:        "/authz/groups/{id}/roles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzGetRolesForGroupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzGetRolesForGroupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.getRolesForGroup: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzGetRolesForUser get the roles assigned to a user
+*/
+func (a *Client) AuthzGetRolesForUser(params *AuthzGetRolesForUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzGetRolesForUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzGetRolesForUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.getRolesForUser",
+		Method:             "GET",
+		PathPattern:        "/authz/users/{id}/roles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzGetRolesForUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzGetRolesForUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.getRolesForUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzRevokeRoleFromGroup revoke roles from a group
+*/
+func (a *Client) AuthzRevokeRoleFromGroup(params *AuthzRevokeRoleFromGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzRevokeRoleFromGroupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzRevokeRoleFromGroupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.revokeRoleFromGroup",
+		Method:             "POST",
+		PathPattern:        "/authz/groups/{id}/revoke",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzRevokeRoleFromGroupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzRevokeRoleFromGroupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.revokeRoleFromGroup: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzRevokeRoleFromUser revoke roles from a user
+*/
+func (a *Client) AuthzRevokeRoleFromUser(params *AuthzRevokeRoleFromUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzRevokeRoleFromUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzRevokeRoleFromUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.revokeRoleFromUser",
+		Method:             "POST",
+		PathPattern:        "/authz/users/{id}/revoke",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzRevokeRoleFromUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzRevokeRoleFromUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.revokeRoleFromUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+AuthzUpdateRole replace the permissions of a role
+*/
+func (a *Client) AuthzUpdateRole(params *AuthzUpdateRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthzUpdateRoleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAuthzUpdateRoleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "authz.updateRole",
+		Method:             "PUT",
+		PathPattern:        "/authz/roles/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AuthzUpdateRoleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AuthzUpdateRoleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for authz.updateRole: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewAuthzCreateRoleParams creates a new AuthzCreateRoleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzCreateRoleParams() *AuthzCreateRoleParams {
+	return &AuthzCreateRoleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzCreateRoleParamsWithTimeout creates a new AuthzCreateRoleParams object
+// with the ability to set a timeout on a request.
+func NewAuthzCreateRoleParamsWithTimeout(timeout time.Duration) *AuthzCreateRoleParams {
+	return &AuthzCreateRoleParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzCreateRoleParamsWithContext creates a new AuthzCreateRoleParams object
+// with the ability to set a context for a request.
+func NewAuthzCreateRoleParamsWithContext(ctx context.Context) *AuthzCreateRoleParams {
+	return &AuthzCreateRoleParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzCreateRoleParamsWithHTTPClient creates a new AuthzCreateRoleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzCreateRoleParamsWithHTTPClient(client *http.Client) *AuthzCreateRoleParams {
+	return &AuthzCreateRoleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzCreateRoleParams contains all the parameters to send to the API endpoint
+
+	for the authz create role operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzCreateRoleParams struct {
+
+	// Body.
+	Body *models.Role
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz create role params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzCreateRoleParams) WithDefaults() *AuthzCreateRoleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz create role params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzCreateRoleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz create role params
+func (o *AuthzCreateRoleParams) WithTimeout(timeout time.Duration) *AuthzCreateRoleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz create role params
+func (o *AuthzCreateRoleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz create role params
+func (o *AuthzCreateRoleParams) WithContext(ctx context.Context) *AuthzCreateRoleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz create role params
+func (o *AuthzCreateRoleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz create role params
+func (o *AuthzCreateRoleParams) WithHTTPClient(client *http.Client) *AuthzCreateRoleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz create role params
+func (o *AuthzCreateRoleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the authz create role params
+func (o *AuthzCreateRoleParams) WithBody(body *models.Role) *AuthzCreateRoleParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the authz create role params
+func (o *AuthzCreateRoleParams) SetBody(body *models.Role) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzCreateRoleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
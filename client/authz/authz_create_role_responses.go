@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzCreateRoleReader is a Reader for the AuthzCreateRole structure.
+type AuthzCreateRoleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzCreateRoleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewAuthzCreateRoleCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzCreateRoleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzCreateRoleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewAuthzCreateRoleConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAuthzCreateRoleUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzCreateRoleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzCreateRoleCreated creates a AuthzCreateRoleCreated with default headers values
+func NewAuthzCreateRoleCreated() *AuthzCreateRoleCreated {
+	return &AuthzCreateRoleCreated{}
+}
+
+/*
+AuthzCreateRoleCreated describes a response with status code 201, with default header values.
+
+Role created successfully
+*/
+type AuthzCreateRoleCreated struct {
+}
+
+// IsSuccess returns true when this authz create role created response has a 2xx status code
+func (o *AuthzCreateRoleCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz create role created response has a 3xx status code
+func (o *AuthzCreateRoleCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz create role created response has a 4xx status code
+func (o *AuthzCreateRoleCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz create role created response has a 5xx status code
+func (o *AuthzCreateRoleCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz create role created response a status code equal to that given
+func (o *AuthzCreateRoleCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the authz create role created response
+func (o *AuthzCreateRoleCreated) Code() int {
+	return 201
+}
+
+func (o *AuthzCreateRoleCreated) Error() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleCreated ", 201)
+}
+
+func (o *AuthzCreateRoleCreated) String() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleCreated ", 201)
+}
+
+func (o *AuthzCreateRoleCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzCreateRoleUnauthorized creates a AuthzCreateRoleUnauthorized with default headers values
+func NewAuthzCreateRoleUnauthorized() *AuthzCreateRoleUnauthorized {
+	return &AuthzCreateRoleUnauthorized{}
+}
+
+/*
+AuthzCreateRoleUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzCreateRoleUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz create role unauthorized response has a 2xx status code
+func (o *AuthzCreateRoleUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz create role unauthorized response has a 3xx status code
+func (o *AuthzCreateRoleUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz create role unauthorized response has a 4xx status code
+func (o *AuthzCreateRoleUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz create role unauthorized response has a 5xx status code
+func (o *AuthzCreateRoleUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz create role unauthorized response a status code equal to that given
+func (o *AuthzCreateRoleUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz create role unauthorized response
+func (o *AuthzCreateRoleUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzCreateRoleUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleUnauthorized ", 401)
+}
+
+func (o *AuthzCreateRoleUnauthorized) String() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleUnauthorized ", 401)
+}
+
+func (o *AuthzCreateRoleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzCreateRoleForbidden creates a AuthzCreateRoleForbidden with default headers values
+func NewAuthzCreateRoleForbidden() *AuthzCreateRoleForbidden {
+	return &AuthzCreateRoleForbidden{}
+}
+
+/*
+AuthzCreateRoleForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzCreateRoleForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz create role forbidden response has a 2xx status code
+func (o *AuthzCreateRoleForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz create role forbidden response has a 3xx status code
+func (o *AuthzCreateRoleForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz create role forbidden response has a 4xx status code
+func (o *AuthzCreateRoleForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz create role forbidden response has a 5xx status code
+func (o *AuthzCreateRoleForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz create role forbidden response a status code equal to that given
+func (o *AuthzCreateRoleForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz create role forbidden response
+func (o *AuthzCreateRoleForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzCreateRoleForbidden) Error() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzCreateRoleForbidden) String() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzCreateRoleForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzCreateRoleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzCreateRoleConflict creates a AuthzCreateRoleConflict with default headers values
+func NewAuthzCreateRoleConflict() *AuthzCreateRoleConflict {
+	return &AuthzCreateRoleConflict{}
+}
+
+/*
+AuthzCreateRoleConflict describes a response with status code 409, with default header values.
+
+Role already exists
+*/
+type AuthzCreateRoleConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz create role conflict response has a 2xx status code
+func (o *AuthzCreateRoleConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz create role conflict response has a 3xx status code
+func (o *AuthzCreateRoleConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz create role conflict response has a 4xx status code
+func (o *AuthzCreateRoleConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz create role conflict response has a 5xx status code
+func (o *AuthzCreateRoleConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz create role conflict response a status code equal to that given
+func (o *AuthzCreateRoleConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the authz create role conflict response
+func (o *AuthzCreateRoleConflict) Code() int {
+	return 409
+}
+
+func (o *AuthzCreateRoleConflict) Error() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleConflict  %+v", 409, o.Payload)
+}
+
+func (o *AuthzCreateRoleConflict) String() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleConflict  %+v", 409, o.Payload)
+}
+
+func (o *AuthzCreateRoleConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzCreateRoleConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzCreateRoleUnprocessableEntity creates a AuthzCreateRoleUnprocessableEntity with default headers values
+func NewAuthzCreateRoleUnprocessableEntity() *AuthzCreateRoleUnprocessableEntity {
+	return &AuthzCreateRoleUnprocessableEntity{}
+}
+
+/*
+AuthzCreateRoleUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type AuthzCreateRoleUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz create role unprocessable entity response has a 2xx status code
+func (o *AuthzCreateRoleUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz create role unprocessable entity response has a 3xx status code
+func (o *AuthzCreateRoleUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz create role unprocessable entity response has a 4xx status code
+func (o *AuthzCreateRoleUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz create role unprocessable entity response has a 5xx status code
+func (o *AuthzCreateRoleUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz create role unprocessable entity response a status code equal to that given
+func (o *AuthzCreateRoleUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the authz create role unprocessable entity response
+func (o *AuthzCreateRoleUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AuthzCreateRoleUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzCreateRoleUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzCreateRoleUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzCreateRoleUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzCreateRoleInternalServerError creates a AuthzCreateRoleInternalServerError with default headers values
+func NewAuthzCreateRoleInternalServerError() *AuthzCreateRoleInternalServerError {
+	return &AuthzCreateRoleInternalServerError{}
+}
+
+/*
+AuthzCreateRoleInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzCreateRoleInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz create role internal server error response has a 2xx status code
+func (o *AuthzCreateRoleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz create role internal server error response has a 3xx status code
+func (o *AuthzCreateRoleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz create role internal server error response has a 4xx status code
+func (o *AuthzCreateRoleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz create role internal server error response has a 5xx status code
+func (o *AuthzCreateRoleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz create role internal server error response a status code equal to that given
+func (o *AuthzCreateRoleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz create role internal server error response
+func (o *AuthzCreateRoleInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzCreateRoleInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzCreateRoleInternalServerError) String() string {
+	return fmt.Sprintf("[POST /authz/roles][%d] authzCreateRoleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzCreateRoleInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzCreateRoleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
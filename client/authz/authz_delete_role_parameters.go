@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzDeleteRoleParams creates a new AuthzDeleteRoleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzDeleteRoleParams() *AuthzDeleteRoleParams {
+	return &AuthzDeleteRoleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzDeleteRoleParamsWithTimeout creates a new AuthzDeleteRoleParams object
+// with the ability to set a timeout on a request.
+func NewAuthzDeleteRoleParamsWithTimeout(timeout time.Duration) *AuthzDeleteRoleParams {
+	return &AuthzDeleteRoleParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzDeleteRoleParamsWithContext creates a new AuthzDeleteRoleParams object
+// with the ability to set a context for a request.
+func NewAuthzDeleteRoleParamsWithContext(ctx context.Context) *AuthzDeleteRoleParams {
+	return &AuthzDeleteRoleParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzDeleteRoleParamsWithHTTPClient creates a new AuthzDeleteRoleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzDeleteRoleParamsWithHTTPClient(client *http.Client) *AuthzDeleteRoleParams {
+	return &AuthzDeleteRoleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzDeleteRoleParams contains all the parameters to send to the API endpoint
+
+	for the authz delete role operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzDeleteRoleParams struct {
+
+	/* ID.
+
+	   role name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz delete role params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzDeleteRoleParams) WithDefaults() *AuthzDeleteRoleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz delete role params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzDeleteRoleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz delete role params
+func (o *AuthzDeleteRoleParams) WithTimeout(timeout time.Duration) *AuthzDeleteRoleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz delete role params
+func (o *AuthzDeleteRoleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz delete role params
+func (o *AuthzDeleteRoleParams) WithContext(ctx context.Context) *AuthzDeleteRoleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz delete role params
+func (o *AuthzDeleteRoleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz delete role params
+func (o *AuthzDeleteRoleParams) WithHTTPClient(client *http.Client) *AuthzDeleteRoleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz delete role params
+func (o *AuthzDeleteRoleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the authz delete role params
+func (o *AuthzDeleteRoleParams) WithID(id string) *AuthzDeleteRoleParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz delete role params
+func (o *AuthzDeleteRoleParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzDeleteRoleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,448 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzDeleteRoleReader is a Reader for the AuthzDeleteRole structure.
+type AuthzDeleteRoleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzDeleteRoleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewAuthzDeleteRoleNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzDeleteRoleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzDeleteRoleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAuthzDeleteRoleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAuthzDeleteRoleUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzDeleteRoleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzDeleteRoleNoContent creates a AuthzDeleteRoleNoContent with default headers values
+func NewAuthzDeleteRoleNoContent() *AuthzDeleteRoleNoContent {
+	return &AuthzDeleteRoleNoContent{}
+}
+
+/*
+AuthzDeleteRoleNoContent describes a response with status code 204, with default header values.
+
+Role deleted successfully
+*/
+type AuthzDeleteRoleNoContent struct {
+}
+
+// IsSuccess returns true when this authz delete role no content response has a 2xx status code
+func (o *AuthzDeleteRoleNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz delete role no content response has a 3xx status code
+func (o *AuthzDeleteRoleNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz delete role no content response has a 4xx status code
+func (o *AuthzDeleteRoleNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz delete role no content response has a 5xx status code
+func (o *AuthzDeleteRoleNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz delete role no content response a status code equal to that given
+func (o *AuthzDeleteRoleNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the authz delete role no content response
+func (o *AuthzDeleteRoleNoContent) Code() int {
+	return 204
+}
+
+func (o *AuthzDeleteRoleNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleNoContent ", 204)
+}
+
+func (o *AuthzDeleteRoleNoContent) String() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleNoContent ", 204)
+}
+
+func (o *AuthzDeleteRoleNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzDeleteRoleUnauthorized creates a AuthzDeleteRoleUnauthorized with default headers values
+func NewAuthzDeleteRoleUnauthorized() *AuthzDeleteRoleUnauthorized {
+	return &AuthzDeleteRoleUnauthorized{}
+}
+
+/*
+AuthzDeleteRoleUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzDeleteRoleUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz delete role unauthorized response has a 2xx status code
+func (o *AuthzDeleteRoleUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz delete role unauthorized response has a 3xx status code
+func (o *AuthzDeleteRoleUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz delete role unauthorized response has a 4xx status code
+func (o *AuthzDeleteRoleUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz delete role unauthorized response has a 5xx status code
+func (o *AuthzDeleteRoleUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz delete role unauthorized response a status code equal to that given
+func (o *AuthzDeleteRoleUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz delete role unauthorized response
+func (o *AuthzDeleteRoleUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzDeleteRoleUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleUnauthorized ", 401)
+}
+
+func (o *AuthzDeleteRoleUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleUnauthorized ", 401)
+}
+
+func (o *AuthzDeleteRoleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzDeleteRoleForbidden creates a AuthzDeleteRoleForbidden with default headers values
+func NewAuthzDeleteRoleForbidden() *AuthzDeleteRoleForbidden {
+	return &AuthzDeleteRoleForbidden{}
+}
+
+/*
+AuthzDeleteRoleForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzDeleteRoleForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz delete role forbidden response has a 2xx status code
+func (o *AuthzDeleteRoleForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz delete role forbidden response has a 3xx status code
+func (o *AuthzDeleteRoleForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz delete role forbidden response has a 4xx status code
+func (o *AuthzDeleteRoleForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz delete role forbidden response has a 5xx status code
+func (o *AuthzDeleteRoleForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz delete role forbidden response a status code equal to that given
+func (o *AuthzDeleteRoleForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz delete role forbidden response
+func (o *AuthzDeleteRoleForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzDeleteRoleForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzDeleteRoleForbidden) String() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzDeleteRoleForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzDeleteRoleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzDeleteRoleNotFound creates a AuthzDeleteRoleNotFound with default headers values
+func NewAuthzDeleteRoleNotFound() *AuthzDeleteRoleNotFound {
+	return &AuthzDeleteRoleNotFound{}
+}
+
+/*
+AuthzDeleteRoleNotFound describes a response with status code 404, with default header values.
+
+no role found
+*/
+type AuthzDeleteRoleNotFound struct {
+}
+
+// IsSuccess returns true when this authz delete role not found response has a 2xx status code
+func (o *AuthzDeleteRoleNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz delete role not found response has a 3xx status code
+func (o *AuthzDeleteRoleNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz delete role not found response has a 4xx status code
+func (o *AuthzDeleteRoleNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz delete role not found response has a 5xx status code
+func (o *AuthzDeleteRoleNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz delete role not found response a status code equal to that given
+func (o *AuthzDeleteRoleNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the authz delete role not found response
+func (o *AuthzDeleteRoleNotFound) Code() int {
+	return 404
+}
+
+func (o *AuthzDeleteRoleNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleNotFound ", 404)
+}
+
+func (o *AuthzDeleteRoleNotFound) String() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleNotFound ", 404)
+}
+
+func (o *AuthzDeleteRoleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzDeleteRoleUnprocessableEntity creates a AuthzDeleteRoleUnprocessableEntity with default headers values
+func NewAuthzDeleteRoleUnprocessableEntity() *AuthzDeleteRoleUnprocessableEntity {
+	return &AuthzDeleteRoleUnprocessableEntity{}
+}
+
+/*
+AuthzDeleteRoleUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type AuthzDeleteRoleUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz delete role unprocessable entity response has a 2xx status code
+func (o *AuthzDeleteRoleUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz delete role unprocessable entity response has a 3xx status code
+func (o *AuthzDeleteRoleUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz delete role unprocessable entity response has a 4xx status code
+func (o *AuthzDeleteRoleUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz delete role unprocessable entity response has a 5xx status code
+func (o *AuthzDeleteRoleUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz delete role unprocessable entity response a status code equal to that given
+func (o *AuthzDeleteRoleUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the authz delete role unprocessable entity response
+func (o *AuthzDeleteRoleUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AuthzDeleteRoleUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzDeleteRoleUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzDeleteRoleUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzDeleteRoleUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzDeleteRoleInternalServerError creates a AuthzDeleteRoleInternalServerError with default headers values
+func NewAuthzDeleteRoleInternalServerError() *AuthzDeleteRoleInternalServerError {
+	return &AuthzDeleteRoleInternalServerError{}
+}
+
+/*
+AuthzDeleteRoleInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzDeleteRoleInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz delete role internal server error response has a 2xx status code
+func (o *AuthzDeleteRoleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz delete role internal server error response has a 3xx status code
+func (o *AuthzDeleteRoleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz delete role internal server error response has a 4xx status code
+func (o *AuthzDeleteRoleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz delete role internal server error response has a 5xx status code
+func (o *AuthzDeleteRoleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz delete role internal server error response a status code equal to that given
+func (o *AuthzDeleteRoleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz delete role internal server error response
+func (o *AuthzDeleteRoleInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzDeleteRoleInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzDeleteRoleInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /authz/roles/{id}][%d] authzDeleteRoleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzDeleteRoleInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzDeleteRoleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzGetRoleParams creates a new AuthzGetRoleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzGetRoleParams() *AuthzGetRoleParams {
+	return &AuthzGetRoleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzGetRoleParamsWithTimeout creates a new AuthzGetRoleParams object
+// with the ability to set a timeout on a request.
+func NewAuthzGetRoleParamsWithTimeout(timeout time.Duration) *AuthzGetRoleParams {
+	return &AuthzGetRoleParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzGetRoleParamsWithContext creates a new AuthzGetRoleParams object
+// with the ability to set a context for a request.
+func NewAuthzGetRoleParamsWithContext(ctx context.Context) *AuthzGetRoleParams {
+	return &AuthzGetRoleParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzGetRoleParamsWithHTTPClient creates a new AuthzGetRoleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzGetRoleParamsWithHTTPClient(client *http.Client) *AuthzGetRoleParams {
+	return &AuthzGetRoleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzGetRoleParams contains all the parameters to send to the API endpoint
+
+	for the authz get role operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzGetRoleParams struct {
+
+	/* ID.
+
+	   role name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz get role params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzGetRoleParams) WithDefaults() *AuthzGetRoleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz get role params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzGetRoleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz get role params
+func (o *AuthzGetRoleParams) WithTimeout(timeout time.Duration) *AuthzGetRoleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz get role params
+func (o *AuthzGetRoleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz get role params
+func (o *AuthzGetRoleParams) WithContext(ctx context.Context) *AuthzGetRoleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz get role params
+func (o *AuthzGetRoleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz get role params
+func (o *AuthzGetRoleParams) WithHTTPClient(client *http.Client) *AuthzGetRoleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz get role params
+func (o *AuthzGetRoleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the authz get role params
+func (o *AuthzGetRoleParams) WithID(id string) *AuthzGetRoleParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz get role params
+func (o *AuthzGetRoleParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzGetRoleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRoleReader is a Reader for the AuthzGetRole structure.
+type AuthzGetRoleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzGetRoleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzGetRoleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzGetRoleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzGetRoleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAuthzGetRoleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzGetRoleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzGetRoleOK creates a AuthzGetRoleOK with default headers values
+func NewAuthzGetRoleOK() *AuthzGetRoleOK {
+	return &AuthzGetRoleOK{}
+}
+
+/*
+AuthzGetRoleOK describes a response with status code 200, with default header values.
+
+Successful response.
+*/
+type AuthzGetRoleOK struct {
+	Payload *models.Role
+}
+
+// IsSuccess returns true when this authz get role o k response has a 2xx status code
+func (o *AuthzGetRoleOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz get role o k response has a 3xx status code
+func (o *AuthzGetRoleOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get role o k response has a 4xx status code
+func (o *AuthzGetRoleOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz get role o k response has a 5xx status code
+func (o *AuthzGetRoleOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get role o k response a status code equal to that given
+func (o *AuthzGetRoleOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz get role o k response
+func (o *AuthzGetRoleOK) Code() int {
+	return 200
+}
+
+func (o *AuthzGetRoleOK) Error() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleOK  %+v", 200, o.Payload)
+}
+
+func (o *AuthzGetRoleOK) String() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleOK  %+v", 200, o.Payload)
+}
+
+func (o *AuthzGetRoleOK) GetPayload() *models.Role {
+	return o.Payload
+}
+
+func (o *AuthzGetRoleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Role)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzGetRoleUnauthorized creates a AuthzGetRoleUnauthorized with default headers values
+func NewAuthzGetRoleUnauthorized() *AuthzGetRoleUnauthorized {
+	return &AuthzGetRoleUnauthorized{}
+}
+
+/*
+AuthzGetRoleUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzGetRoleUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz get role unauthorized response has a 2xx status code
+func (o *AuthzGetRoleUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get role unauthorized response has a 3xx status code
+func (o *AuthzGetRoleUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get role unauthorized response has a 4xx status code
+func (o *AuthzGetRoleUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get role unauthorized response has a 5xx status code
+func (o *AuthzGetRoleUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get role unauthorized response a status code equal to that given
+func (o *AuthzGetRoleUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz get role unauthorized response
+func (o *AuthzGetRoleUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzGetRoleUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleUnauthorized ", 401)
+}
+
+func (o *AuthzGetRoleUnauthorized) String() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleUnauthorized ", 401)
+}
+
+func (o *AuthzGetRoleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzGetRoleForbidden creates a AuthzGetRoleForbidden with default headers values
+func NewAuthzGetRoleForbidden() *AuthzGetRoleForbidden {
+	return &AuthzGetRoleForbidden{}
+}
+
+/*
+AuthzGetRoleForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzGetRoleForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz get role forbidden response has a 2xx status code
+func (o *AuthzGetRoleForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get role forbidden response has a 3xx status code
+func (o *AuthzGetRoleForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get role forbidden response has a 4xx status code
+func (o *AuthzGetRoleForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get role forbidden response has a 5xx status code
+func (o *AuthzGetRoleForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get role forbidden response a status code equal to that given
+func (o *AuthzGetRoleForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz get role forbidden response
+func (o *AuthzGetRoleForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzGetRoleForbidden) Error() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzGetRoleForbidden) String() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzGetRoleForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzGetRoleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzGetRoleNotFound creates a AuthzGetRoleNotFound with default headers values
+func NewAuthzGetRoleNotFound() *AuthzGetRoleNotFound {
+	return &AuthzGetRoleNotFound{}
+}
+
+/*
+AuthzGetRoleNotFound describes a response with status code 404, with default header values.
+
+no role found
+*/
+type AuthzGetRoleNotFound struct {
+}
+
+// IsSuccess returns true when this authz get role not found response has a 2xx status code
+func (o *AuthzGetRoleNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get role not found response has a 3xx status code
+func (o *AuthzGetRoleNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get role not found response has a 4xx status code
+func (o *AuthzGetRoleNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get role not found response has a 5xx status code
+func (o *AuthzGetRoleNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get role not found response a status code equal to that given
+func (o *AuthzGetRoleNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the authz get role not found response
+func (o *AuthzGetRoleNotFound) Code() int {
+	return 404
+}
+
+func (o *AuthzGetRoleNotFound) Error() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleNotFound ", 404)
+}
+
+func (o *AuthzGetRoleNotFound) String() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleNotFound ", 404)
+}
+
+func (o *AuthzGetRoleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzGetRoleInternalServerError creates a AuthzGetRoleInternalServerError with default headers values
+func NewAuthzGetRoleInternalServerError() *AuthzGetRoleInternalServerError {
+	return &AuthzGetRoleInternalServerError{}
+}
+
+/*
+AuthzGetRoleInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzGetRoleInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz get role internal server error response has a 2xx status code
+func (o *AuthzGetRoleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get role internal server error response has a 3xx status code
+func (o *AuthzGetRoleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get role internal server error response has a 4xx status code
+func (o *AuthzGetRoleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz get role internal server error response has a 5xx status code
+func (o *AuthzGetRoleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz get role internal server error response a status code equal to that given
+func (o *AuthzGetRoleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz get role internal server error response
+func (o *AuthzGetRoleInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzGetRoleInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzGetRoleInternalServerError) String() string {
+	return fmt.Sprintf("[GET /authz/roles/{id}][%d] authzGetRoleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzGetRoleInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzGetRoleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
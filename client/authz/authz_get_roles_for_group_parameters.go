@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzGetRolesForGroupParams creates a new AuthzGetRolesForGroupParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzGetRolesForGroupParams() *AuthzGetRolesForGroupParams {
+	return &AuthzGetRolesForGroupParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzGetRolesForGroupParamsWithTimeout creates a new AuthzGetRolesForGroupParams object
+// with the ability to set a timeout on a request.
+func NewAuthzGetRolesForGroupParamsWithTimeout(timeout time.Duration) *AuthzGetRolesForGroupParams {
+	return &AuthzGetRolesForGroupParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzGetRolesForGroupParamsWithContext creates a new AuthzGetRolesForGroupParams object
+// with the ability to set a context for a request.
+func NewAuthzGetRolesForGroupParamsWithContext(ctx context.Context) *AuthzGetRolesForGroupParams {
+	return &AuthzGetRolesForGroupParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzGetRolesForGroupParamsWithHTTPClient creates a new AuthzGetRolesForGroupParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzGetRolesForGroupParamsWithHTTPClient(client *http.Client) *AuthzGetRolesForGroupParams {
+	return &AuthzGetRolesForGroupParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzGetRolesForGroupParams contains all the parameters to send to the API endpoint
+
+	for the authz get roles for group operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzGetRolesForGroupParams struct {
+
+	/* ID.
+
+	   group name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz get roles for group params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzGetRolesForGroupParams) WithDefaults() *AuthzGetRolesForGroupParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz get roles for group params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzGetRolesForGroupParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz get roles for group params
+func (o *AuthzGetRolesForGroupParams) WithTimeout(timeout time.Duration) *AuthzGetRolesForGroupParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz get roles for group params
+func (o *AuthzGetRolesForGroupParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz get roles for group params
+func (o *AuthzGetRolesForGroupParams) WithContext(ctx context.Context) *AuthzGetRolesForGroupParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz get roles for group params
+func (o *AuthzGetRolesForGroupParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz get roles for group params
+func (o *AuthzGetRolesForGroupParams) WithHTTPClient(client *http.Client) *AuthzGetRolesForGroupParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz get roles for group params
+func (o *AuthzGetRolesForGroupParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the authz get roles for group params
+func (o *AuthzGetRolesForGroupParams) WithID(id string) *AuthzGetRolesForGroupParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz get roles for group params
+func (o *AuthzGetRolesForGroupParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzGetRolesForGroupParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRolesForGroupReader is a Reader for the AuthzGetRolesForGroup structure.
+type AuthzGetRolesForGroupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzGetRolesForGroupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzGetRolesForGroupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzGetRolesForGroupUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzGetRolesForGroupForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzGetRolesForGroupInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzGetRolesForGroupOK creates a AuthzGetRolesForGroupOK with default headers values
+func NewAuthzGetRolesForGroupOK() *AuthzGetRolesForGroupOK {
+	return &AuthzGetRolesForGroupOK{}
+}
+
+/*
+AuthzGetRolesForGroupOK describes a response with status code 200, with default header values.
+
+Role assigned to the group
+*/
+type AuthzGetRolesForGroupOK struct {
+	Payload []*models.Role
+}
+
+// IsSuccess returns true when this authz get roles for group o k response has a 2xx status code
+func (o *AuthzGetRolesForGroupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz get roles for group o k response has a 3xx status code
+func (o *AuthzGetRolesForGroupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles for group o k response has a 4xx status code
+func (o *AuthzGetRolesForGroupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz get roles for group o k response has a 5xx status code
+func (o *AuthzGetRolesForGroupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles for group o k response a status code equal to that given
+func (o *AuthzGetRolesForGroupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz get roles for group o k response
+func (o *AuthzGetRolesForGroupOK) Code() int {
+	return 200
+}
+
+func (o *AuthzGetRolesForGroupOK) Error() string {
+	return fmt.Sprintf("[GET /authz/groups/{id}/roles][%d] authzGetRolesForGroupOK  %+v", 200, o.Payload)
+}
+
+func (o *AuthzGetRolesForGroupOK) String() string {
+	return fmt.Sprintf("[GET /authz/groups/{id}/roles][%d] authzGetRolesForGroupOK  %+v", 200, o.Payload)
+}
+
+func (o *AuthzGetRolesForGroupOK) GetPayload() []*models.Role {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesForGroupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzGetRolesForGroupUnauthorized creates a AuthzGetRolesForGroupUnauthorized with default headers values
+func NewAuthzGetRolesForGroupUnauthorized() *AuthzGetRolesForGroupUnauthorized {
+	return &AuthzGetRolesForGroupUnauthorized{}
+}
+
+/*
+AuthzGetRolesForGroupUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzGetRolesForGroupUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz get roles for group unauthorized response has a 2xx status code
+func (o *AuthzGetRolesForGroupUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles for group unauthorized response has a 3xx status code
+func (o *AuthzGetRolesForGroupUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles for group unauthorized response has a 4xx status code
+func (o *AuthzGetRolesForGroupUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get roles for group unauthorized response has a 5xx status code
+func (o *AuthzGetRolesForGroupUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles for group unauthorized response a status code equal to that given
+func (o *AuthzGetRolesForGroupUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz get roles for group unauthorized response
+func (o *AuthzGetRolesForGroupUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzGetRolesForGroupUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /authz/groups/{id}/roles][%d] authzGetRolesForGroupUnauthorized ", 401)
+}
+
+func (o *AuthzGetRolesForGroupUnauthorized) String() string {
+	return fmt.Sprintf("[GET /authz/groups/{id}/roles][%d] authzGetRolesForGroupUnauthorized ", 401)
+}
+
+func (o *AuthzGetRolesForGroupUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzGetRolesForGroupForbidden creates a AuthzGetRolesForGroupForbidden with default headers values
+func NewAuthzGetRolesForGroupForbidden() *AuthzGetRolesForGroupForbidden {
+	return &AuthzGetRolesForGroupForbidden{}
+}
+
+/*
+AuthzGetRolesForGroupForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzGetRolesForGroupForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz get roles for group forbidden response has a 2xx status code
+func (o *AuthzGetRolesForGroupForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles for group forbidden response has a 3xx status code
+func (o *AuthzGetRolesForGroupForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles for group forbidden response has a 4xx status code
+func (o *AuthzGetRolesForGroupForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get roles for group forbidden response has a 5xx status code
+func (o *AuthzGetRolesForGroupForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles for group forbidden response a status code equal to that given
+func (o *AuthzGetRolesForGroupForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz get roles for group forbidden response
+func (o *AuthzGetRolesForGroupForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzGetRolesForGroupForbidden) Error() string {
+	return fmt.Sprintf("[GET /authz/groups/{id}/roles][%d] authzGetRolesForGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzGetRolesForGroupForbidden) String() string {
+	return fmt.Sprintf("[GET /authz/groups/{id}/roles][%d] authzGetRolesForGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzGetRolesForGroupForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesForGroupForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzGetRolesForGroupInternalServerError creates a AuthzGetRolesForGroupInternalServerError with default headers values
+func NewAuthzGetRolesForGroupInternalServerError() *AuthzGetRolesForGroupInternalServerError {
+	return &AuthzGetRolesForGroupInternalServerError{}
+}
+
+/*
+AuthzGetRolesForGroupInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzGetRolesForGroupInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz get roles for group internal server error response has a 2xx status code
+func (o *AuthzGetRolesForGroupInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles for group internal server error response has a 3xx status code
+func (o *AuthzGetRolesForGroupInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles for group internal server error response has a 4xx status code
+func (o *AuthzGetRolesForGroupInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz get roles for group internal server error response has a 5xx status code
+func (o *AuthzGetRolesForGroupInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz get roles for group internal server error response a status code equal to that given
+func (o *AuthzGetRolesForGroupInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz get roles for group internal server error response
+func (o *AuthzGetRolesForGroupInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzGetRolesForGroupInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /authz/groups/{id}/roles][%d] authzGetRolesForGroupInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzGetRolesForGroupInternalServerError) String() string {
+	return fmt.Sprintf("[GET /authz/groups/{id}/roles][%d] authzGetRolesForGroupInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzGetRolesForGroupInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesForGroupInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
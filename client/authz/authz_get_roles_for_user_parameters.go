@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzGetRolesForUserParams creates a new AuthzGetRolesForUserParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzGetRolesForUserParams() *AuthzGetRolesForUserParams {
+	return &AuthzGetRolesForUserParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzGetRolesForUserParamsWithTimeout creates a new AuthzGetRolesForUserParams object
+// with the ability to set a timeout on a request.
+func NewAuthzGetRolesForUserParamsWithTimeout(timeout time.Duration) *AuthzGetRolesForUserParams {
+	return &AuthzGetRolesForUserParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzGetRolesForUserParamsWithContext creates a new AuthzGetRolesForUserParams object
+// with the ability to set a context for a request.
+func NewAuthzGetRolesForUserParamsWithContext(ctx context.Context) *AuthzGetRolesForUserParams {
+	return &AuthzGetRolesForUserParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzGetRolesForUserParamsWithHTTPClient creates a new AuthzGetRolesForUserParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzGetRolesForUserParamsWithHTTPClient(client *http.Client) *AuthzGetRolesForUserParams {
+	return &AuthzGetRolesForUserParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzGetRolesForUserParams contains all the parameters to send to the API endpoint
+
+	for the authz get roles for user operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzGetRolesForUserParams struct {
+
+	/* ID.
+
+	   user name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz get roles for user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzGetRolesForUserParams) WithDefaults() *AuthzGetRolesForUserParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz get roles for user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzGetRolesForUserParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz get roles for user params
+func (o *AuthzGetRolesForUserParams) WithTimeout(timeout time.Duration) *AuthzGetRolesForUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz get roles for user params
+func (o *AuthzGetRolesForUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz get roles for user params
+func (o *AuthzGetRolesForUserParams) WithContext(ctx context.Context) *AuthzGetRolesForUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz get roles for user params
+func (o *AuthzGetRolesForUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz get roles for user params
+func (o *AuthzGetRolesForUserParams) WithHTTPClient(client *http.Client) *AuthzGetRolesForUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz get roles for user params
+func (o *AuthzGetRolesForUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the authz get roles for user params
+func (o *AuthzGetRolesForUserParams) WithID(id string) *AuthzGetRolesForUserParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz get roles for user params
+func (o *AuthzGetRolesForUserParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzGetRolesForUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRolesForUserReader is a Reader for the AuthzGetRolesForUser structure.
+type AuthzGetRolesForUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzGetRolesForUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzGetRolesForUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzGetRolesForUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzGetRolesForUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzGetRolesForUserInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzGetRolesForUserOK creates a AuthzGetRolesForUserOK with default headers values
+func NewAuthzGetRolesForUserOK() *AuthzGetRolesForUserOK {
+	return &AuthzGetRolesForUserOK{}
+}
+
+/*
+AuthzGetRolesForUserOK describes a response with status code 200, with default header values.
+
+Role assigned to the user
+*/
+type AuthzGetRolesForUserOK struct {
+	Payload []*models.Role
+}
+
+// IsSuccess returns true when this authz get roles for user o k response has a 2xx status code
+func (o *AuthzGetRolesForUserOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz get roles for user o k response has a 3xx status code
+func (o *AuthzGetRolesForUserOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles for user o k response has a 4xx status code
+func (o *AuthzGetRolesForUserOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz get roles for user o k response has a 5xx status code
+func (o *AuthzGetRolesForUserOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles for user o k response a status code equal to that given
+func (o *AuthzGetRolesForUserOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz get roles for user o k response
+func (o *AuthzGetRolesForUserOK) Code() int {
+	return 200
+}
+
+func (o *AuthzGetRolesForUserOK) Error() string {
+	return fmt.Sprintf("[GET /authz/users/{id}/roles][%d] authzGetRolesForUserOK  %+v", 200, o.Payload)
+}
+
+func (o *AuthzGetRolesForUserOK) String() string {
+	return fmt.Sprintf("[GET /authz/users/{id}/roles][%d] authzGetRolesForUserOK  %+v", 200, o.Payload)
+}
+
+func (o *AuthzGetRolesForUserOK) GetPayload() []*models.Role {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesForUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzGetRolesForUserUnauthorized creates a AuthzGetRolesForUserUnauthorized with default headers values
+func NewAuthzGetRolesForUserUnauthorized() *AuthzGetRolesForUserUnauthorized {
+	return &AuthzGetRolesForUserUnauthorized{}
+}
+
+/*
+AuthzGetRolesForUserUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzGetRolesForUserUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz get roles for user unauthorized response has a 2xx status code
+func (o *AuthzGetRolesForUserUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles for user unauthorized response has a 3xx status code
+func (o *AuthzGetRolesForUserUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles for user unauthorized response has a 4xx status code
+func (o *AuthzGetRolesForUserUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get roles for user unauthorized response has a 5xx status code
+func (o *AuthzGetRolesForUserUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles for user unauthorized response a status code equal to that given
+func (o *AuthzGetRolesForUserUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz get roles for user unauthorized response
+func (o *AuthzGetRolesForUserUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzGetRolesForUserUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /authz/users/{id}/roles][%d] authzGetRolesForUserUnauthorized ", 401)
+}
+
+func (o *AuthzGetRolesForUserUnauthorized) String() string {
+	return fmt.Sprintf("[GET /authz/users/{id}/roles][%d] authzGetRolesForUserUnauthorized ", 401)
+}
+
+func (o *AuthzGetRolesForUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzGetRolesForUserForbidden creates a AuthzGetRolesForUserForbidden with default headers values
+func NewAuthzGetRolesForUserForbidden() *AuthzGetRolesForUserForbidden {
+	return &AuthzGetRolesForUserForbidden{}
+}
+
+/*
+AuthzGetRolesForUserForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzGetRolesForUserForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz get roles for user forbidden response has a 2xx status code
+func (o *AuthzGetRolesForUserForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles for user forbidden response has a 3xx status code
+func (o *AuthzGetRolesForUserForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles for user forbidden response has a 4xx status code
+func (o *AuthzGetRolesForUserForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get roles for user forbidden response has a 5xx status code
+func (o *AuthzGetRolesForUserForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles for user forbidden response a status code equal to that given
+func (o *AuthzGetRolesForUserForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz get roles for user forbidden response
+func (o *AuthzGetRolesForUserForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzGetRolesForUserForbidden) Error() string {
+	return fmt.Sprintf("[GET /authz/users/{id}/roles][%d] authzGetRolesForUserForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzGetRolesForUserForbidden) String() string {
+	return fmt.Sprintf("[GET /authz/users/{id}/roles][%d] authzGetRolesForUserForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzGetRolesForUserForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesForUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzGetRolesForUserInternalServerError creates a AuthzGetRolesForUserInternalServerError with default headers values
+func NewAuthzGetRolesForUserInternalServerError() *AuthzGetRolesForUserInternalServerError {
+	return &AuthzGetRolesForUserInternalServerError{}
+}
+
+/*
+AuthzGetRolesForUserInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzGetRolesForUserInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz get roles for user internal server error response has a 2xx status code
+func (o *AuthzGetRolesForUserInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles for user internal server error response has a 3xx status code
+func (o *AuthzGetRolesForUserInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles for user internal server error response has a 4xx status code
+func (o *AuthzGetRolesForUserInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz get roles for user internal server error response has a 5xx status code
+func (o *AuthzGetRolesForUserInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz get roles for user internal server error response a status code equal to that given
+func (o *AuthzGetRolesForUserInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz get roles for user internal server error response
+func (o *AuthzGetRolesForUserInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzGetRolesForUserInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /authz/users/{id}/roles][%d] authzGetRolesForUserInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzGetRolesForUserInternalServerError) String() string {
+	return fmt.Sprintf("[GET /authz/users/{id}/roles][%d] authzGetRolesForUserInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzGetRolesForUserInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesForUserInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
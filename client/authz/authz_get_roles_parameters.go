@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzGetRolesParams creates a new AuthzGetRolesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzGetRolesParams() *AuthzGetRolesParams {
+	return &AuthzGetRolesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzGetRolesParamsWithTimeout creates a new AuthzGetRolesParams object
+// with the ability to set a timeout on a request.
+func NewAuthzGetRolesParamsWithTimeout(timeout time.Duration) *AuthzGetRolesParams {
+	return &AuthzGetRolesParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzGetRolesParamsWithContext creates a new AuthzGetRolesParams object
+// with the ability to set a context for a request.
+func NewAuthzGetRolesParamsWithContext(ctx context.Context) *AuthzGetRolesParams {
+	return &AuthzGetRolesParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzGetRolesParamsWithHTTPClient creates a new AuthzGetRolesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzGetRolesParamsWithHTTPClient(client *http.Client) *AuthzGetRolesParams {
+	return &AuthzGetRolesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzGetRolesParams contains all the parameters to send to the API endpoint
+
+	for the authz get roles operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzGetRolesParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz get roles params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzGetRolesParams) WithDefaults() *AuthzGetRolesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz get roles params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzGetRolesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz get roles params
+func (o *AuthzGetRolesParams) WithTimeout(timeout time.Duration) *AuthzGetRolesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz get roles params
+func (o *AuthzGetRolesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz get roles params
+func (o *AuthzGetRolesParams) WithContext(ctx context.Context) *AuthzGetRolesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz get roles params
+func (o *AuthzGetRolesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz get roles params
+func (o *AuthzGetRolesParams) WithHTTPClient(client *http.Client) *AuthzGetRolesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz get roles params
+func (o *AuthzGetRolesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzGetRolesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzGetRolesReader is a Reader for the AuthzGetRoles structure.
+type AuthzGetRolesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzGetRolesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzGetRolesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzGetRolesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzGetRolesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzGetRolesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzGetRolesOK creates a AuthzGetRolesOK with default headers values
+func NewAuthzGetRolesOK() *AuthzGetRolesOK {
+	return &AuthzGetRolesOK{}
+}
+
+/*
+AuthzGetRolesOK describes a response with status code 200, with default header values.
+
+Successful response.
+*/
+type AuthzGetRolesOK struct {
+	Payload []*models.Role
+}
+
+// IsSuccess returns true when this authz get roles o k response has a 2xx status code
+func (o *AuthzGetRolesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz get roles o k response has a 3xx status code
+func (o *AuthzGetRolesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles o k response has a 4xx status code
+func (o *AuthzGetRolesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz get roles o k response has a 5xx status code
+func (o *AuthzGetRolesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles o k response a status code equal to that given
+func (o *AuthzGetRolesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz get roles o k response
+func (o *AuthzGetRolesOK) Code() int {
+	return 200
+}
+
+func (o *AuthzGetRolesOK) Error() string {
+	return fmt.Sprintf("[GET /authz/roles][%d] authzGetRolesOK  %+v", 200, o.Payload)
+}
+
+func (o *AuthzGetRolesOK) String() string {
+	return fmt.Sprintf("[GET /authz/roles][%d] authzGetRolesOK  %+v", 200, o.Payload)
+}
+
+func (o *AuthzGetRolesOK) GetPayload() []*models.Role {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzGetRolesUnauthorized creates a AuthzGetRolesUnauthorized with default headers values
+func NewAuthzGetRolesUnauthorized() *AuthzGetRolesUnauthorized {
+	return &AuthzGetRolesUnauthorized{}
+}
+
+/*
+AuthzGetRolesUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzGetRolesUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz get roles unauthorized response has a 2xx status code
+func (o *AuthzGetRolesUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles unauthorized response has a 3xx status code
+func (o *AuthzGetRolesUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles unauthorized response has a 4xx status code
+func (o *AuthzGetRolesUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get roles unauthorized response has a 5xx status code
+func (o *AuthzGetRolesUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles unauthorized response a status code equal to that given
+func (o *AuthzGetRolesUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz get roles unauthorized response
+func (o *AuthzGetRolesUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzGetRolesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /authz/roles][%d] authzGetRolesUnauthorized ", 401)
+}
+
+func (o *AuthzGetRolesUnauthorized) String() string {
+	return fmt.Sprintf("[GET /authz/roles][%d] authzGetRolesUnauthorized ", 401)
+}
+
+func (o *AuthzGetRolesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzGetRolesForbidden creates a AuthzGetRolesForbidden with default headers values
+func NewAuthzGetRolesForbidden() *AuthzGetRolesForbidden {
+	return &AuthzGetRolesForbidden{}
+}
+
+/*
+AuthzGetRolesForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzGetRolesForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz get roles forbidden response has a 2xx status code
+func (o *AuthzGetRolesForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles forbidden response has a 3xx status code
+func (o *AuthzGetRolesForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles forbidden response has a 4xx status code
+func (o *AuthzGetRolesForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz get roles forbidden response has a 5xx status code
+func (o *AuthzGetRolesForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz get roles forbidden response a status code equal to that given
+func (o *AuthzGetRolesForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz get roles forbidden response
+func (o *AuthzGetRolesForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzGetRolesForbidden) Error() string {
+	return fmt.Sprintf("[GET /authz/roles][%d] authzGetRolesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzGetRolesForbidden) String() string {
+	return fmt.Sprintf("[GET /authz/roles][%d] authzGetRolesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzGetRolesForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzGetRolesInternalServerError creates a AuthzGetRolesInternalServerError with default headers values
+func NewAuthzGetRolesInternalServerError() *AuthzGetRolesInternalServerError {
+	return &AuthzGetRolesInternalServerError{}
+}
+
+/*
+AuthzGetRolesInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzGetRolesInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz get roles internal server error response has a 2xx status code
+func (o *AuthzGetRolesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz get roles internal server error response has a 3xx status code
+func (o *AuthzGetRolesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz get roles internal server error response has a 4xx status code
+func (o *AuthzGetRolesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz get roles internal server error response has a 5xx status code
+func (o *AuthzGetRolesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz get roles internal server error response a status code equal to that given
+func (o *AuthzGetRolesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz get roles internal server error response
+func (o *AuthzGetRolesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzGetRolesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /authz/roles][%d] authzGetRolesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzGetRolesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /authz/roles][%d] authzGetRolesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzGetRolesInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzGetRolesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzRevokeRoleFromGroupParams creates a new AuthzRevokeRoleFromGroupParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzRevokeRoleFromGroupParams() *AuthzRevokeRoleFromGroupParams {
+	return &AuthzRevokeRoleFromGroupParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzRevokeRoleFromGroupParamsWithTimeout creates a new AuthzRevokeRoleFromGroupParams object
+// with the ability to set a timeout on a request.
+func NewAuthzRevokeRoleFromGroupParamsWithTimeout(timeout time.Duration) *AuthzRevokeRoleFromGroupParams {
+	return &AuthzRevokeRoleFromGroupParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzRevokeRoleFromGroupParamsWithContext creates a new AuthzRevokeRoleFromGroupParams object
+// with the ability to set a context for a request.
+func NewAuthzRevokeRoleFromGroupParamsWithContext(ctx context.Context) *AuthzRevokeRoleFromGroupParams {
+	return &AuthzRevokeRoleFromGroupParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzRevokeRoleFromGroupParamsWithHTTPClient creates a new AuthzRevokeRoleFromGroupParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzRevokeRoleFromGroupParamsWithHTTPClient(client *http.Client) *AuthzRevokeRoleFromGroupParams {
+	return &AuthzRevokeRoleFromGroupParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzRevokeRoleFromGroupParams contains all the parameters to send to the API endpoint
+
+	for the authz revoke role from group operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzRevokeRoleFromGroupParams struct {
+
+	// Body.
+	Body AuthzRevokeRoleFromGroupBody
+
+	/* ID.
+
+	   group name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz revoke role from group params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzRevokeRoleFromGroupParams) WithDefaults() *AuthzRevokeRoleFromGroupParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz revoke role from group params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzRevokeRoleFromGroupParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) WithTimeout(timeout time.Duration) *AuthzRevokeRoleFromGroupParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) WithContext(ctx context.Context) *AuthzRevokeRoleFromGroupParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) WithHTTPClient(client *http.Client) *AuthzRevokeRoleFromGroupParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) WithBody(body AuthzRevokeRoleFromGroupBody) *AuthzRevokeRoleFromGroupParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) SetBody(body AuthzRevokeRoleFromGroupBody) {
+	o.Body = body
+}
+
+// WithID adds the id to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) WithID(id string) *AuthzRevokeRoleFromGroupParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz revoke role from group params
+func (o *AuthzRevokeRoleFromGroupParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzRevokeRoleFromGroupParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
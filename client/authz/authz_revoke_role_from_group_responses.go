@@ -0,0 +1,488 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzRevokeRoleFromGroupReader is a Reader for the AuthzRevokeRoleFromGroup structure.
+type AuthzRevokeRoleFromGroupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzRevokeRoleFromGroupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzRevokeRoleFromGroupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzRevokeRoleFromGroupUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzRevokeRoleFromGroupForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAuthzRevokeRoleFromGroupNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAuthzRevokeRoleFromGroupUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzRevokeRoleFromGroupInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzRevokeRoleFromGroupOK creates a AuthzRevokeRoleFromGroupOK with default headers values
+func NewAuthzRevokeRoleFromGroupOK() *AuthzRevokeRoleFromGroupOK {
+	return &AuthzRevokeRoleFromGroupOK{}
+}
+
+/*
+AuthzRevokeRoleFromGroupOK describes a response with status code 200, with default header values.
+
+Role revoked successfully
+*/
+type AuthzRevokeRoleFromGroupOK struct {
+}
+
+// IsSuccess returns true when this authz revoke role from group o k response has a 2xx status code
+func (o *AuthzRevokeRoleFromGroupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz revoke role from group o k response has a 3xx status code
+func (o *AuthzRevokeRoleFromGroupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from group o k response has a 4xx status code
+func (o *AuthzRevokeRoleFromGroupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz revoke role from group o k response has a 5xx status code
+func (o *AuthzRevokeRoleFromGroupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from group o k response a status code equal to that given
+func (o *AuthzRevokeRoleFromGroupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz revoke role from group o k response
+func (o *AuthzRevokeRoleFromGroupOK) Code() int {
+	return 200
+}
+
+func (o *AuthzRevokeRoleFromGroupOK) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupOK ", 200)
+}
+
+func (o *AuthzRevokeRoleFromGroupOK) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupOK ", 200)
+}
+
+func (o *AuthzRevokeRoleFromGroupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromGroupUnauthorized creates a AuthzRevokeRoleFromGroupUnauthorized with default headers values
+func NewAuthzRevokeRoleFromGroupUnauthorized() *AuthzRevokeRoleFromGroupUnauthorized {
+	return &AuthzRevokeRoleFromGroupUnauthorized{}
+}
+
+/*
+AuthzRevokeRoleFromGroupUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzRevokeRoleFromGroupUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz revoke role from group unauthorized response has a 2xx status code
+func (o *AuthzRevokeRoleFromGroupUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from group unauthorized response has a 3xx status code
+func (o *AuthzRevokeRoleFromGroupUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from group unauthorized response has a 4xx status code
+func (o *AuthzRevokeRoleFromGroupUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz revoke role from group unauthorized response has a 5xx status code
+func (o *AuthzRevokeRoleFromGroupUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from group unauthorized response a status code equal to that given
+func (o *AuthzRevokeRoleFromGroupUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz revoke role from group unauthorized response
+func (o *AuthzRevokeRoleFromGroupUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzRevokeRoleFromGroupUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupUnauthorized ", 401)
+}
+
+func (o *AuthzRevokeRoleFromGroupUnauthorized) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupUnauthorized ", 401)
+}
+
+func (o *AuthzRevokeRoleFromGroupUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromGroupForbidden creates a AuthzRevokeRoleFromGroupForbidden with default headers values
+func NewAuthzRevokeRoleFromGroupForbidden() *AuthzRevokeRoleFromGroupForbidden {
+	return &AuthzRevokeRoleFromGroupForbidden{}
+}
+
+/*
+AuthzRevokeRoleFromGroupForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzRevokeRoleFromGroupForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz revoke role from group forbidden response has a 2xx status code
+func (o *AuthzRevokeRoleFromGroupForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from group forbidden response has a 3xx status code
+func (o *AuthzRevokeRoleFromGroupForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from group forbidden response has a 4xx status code
+func (o *AuthzRevokeRoleFromGroupForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz revoke role from group forbidden response has a 5xx status code
+func (o *AuthzRevokeRoleFromGroupForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from group forbidden response a status code equal to that given
+func (o *AuthzRevokeRoleFromGroupForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz revoke role from group forbidden response
+func (o *AuthzRevokeRoleFromGroupForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzRevokeRoleFromGroupForbidden) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromGroupForbidden) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromGroupForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzRevokeRoleFromGroupForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromGroupNotFound creates a AuthzRevokeRoleFromGroupNotFound with default headers values
+func NewAuthzRevokeRoleFromGroupNotFound() *AuthzRevokeRoleFromGroupNotFound {
+	return &AuthzRevokeRoleFromGroupNotFound{}
+}
+
+/*
+AuthzRevokeRoleFromGroupNotFound describes a response with status code 404, with default header values.
+
+no role found
+*/
+type AuthzRevokeRoleFromGroupNotFound struct {
+}
+
+// IsSuccess returns true when this authz revoke role from group not found response has a 2xx status code
+func (o *AuthzRevokeRoleFromGroupNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from group not found response has a 3xx status code
+func (o *AuthzRevokeRoleFromGroupNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from group not found response has a 4xx status code
+func (o *AuthzRevokeRoleFromGroupNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz revoke role from group not found response has a 5xx status code
+func (o *AuthzRevokeRoleFromGroupNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from group not found response a status code equal to that given
+func (o *AuthzRevokeRoleFromGroupNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the authz revoke role from group not found response
+func (o *AuthzRevokeRoleFromGroupNotFound) Code() int {
+	return 404
+}
+
+func (o *AuthzRevokeRoleFromGroupNotFound) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupNotFound ", 404)
+}
+
+func (o *AuthzRevokeRoleFromGroupNotFound) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupNotFound ", 404)
+}
+
+func (o *AuthzRevokeRoleFromGroupNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromGroupUnprocessableEntity creates a AuthzRevokeRoleFromGroupUnprocessableEntity with default headers values
+func NewAuthzRevokeRoleFromGroupUnprocessableEntity() *AuthzRevokeRoleFromGroupUnprocessableEntity {
+	return &AuthzRevokeRoleFromGroupUnprocessableEntity{}
+}
+
+/*
+AuthzRevokeRoleFromGroupUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type AuthzRevokeRoleFromGroupUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz revoke role from group unprocessable entity response has a 2xx status code
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from group unprocessable entity response has a 3xx status code
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from group unprocessable entity response has a 4xx status code
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz revoke role from group unprocessable entity response has a 5xx status code
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from group unprocessable entity response a status code equal to that given
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the authz revoke role from group unprocessable entity response
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzRevokeRoleFromGroupUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromGroupInternalServerError creates a AuthzRevokeRoleFromGroupInternalServerError with default headers values
+func NewAuthzRevokeRoleFromGroupInternalServerError() *AuthzRevokeRoleFromGroupInternalServerError {
+	return &AuthzRevokeRoleFromGroupInternalServerError{}
+}
+
+/*
+AuthzRevokeRoleFromGroupInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzRevokeRoleFromGroupInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz revoke role from group internal server error response has a 2xx status code
+func (o *AuthzRevokeRoleFromGroupInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from group internal server error response has a 3xx status code
+func (o *AuthzRevokeRoleFromGroupInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from group internal server error response has a 4xx status code
+func (o *AuthzRevokeRoleFromGroupInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz revoke role from group internal server error response has a 5xx status code
+func (o *AuthzRevokeRoleFromGroupInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz revoke role from group internal server error response a status code equal to that given
+func (o *AuthzRevokeRoleFromGroupInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz revoke role from group internal server error response
+func (o *AuthzRevokeRoleFromGroupInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzRevokeRoleFromGroupInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromGroupInternalServerError) String() string {
+	return fmt.Sprintf("[POST /authz/groups/{id}/revoke][%d] authzRevokeRoleFromGroupInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromGroupInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzRevokeRoleFromGroupInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+AuthzRevokeRoleFromGroupBody authz revoke role from group body
+swagger:model AuthzRevokeRoleFromGroupBody
+*/
+type AuthzRevokeRoleFromGroupBody struct {
+
+	// names of the roles
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this authz revoke role from group body
+func (o *AuthzRevokeRoleFromGroupBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this authz revoke role from group body based on context it is used
+func (o *AuthzRevokeRoleFromGroupBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AuthzRevokeRoleFromGroupBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AuthzRevokeRoleFromGroupBody) UnmarshalBinary(b []byte) error {
+	var res AuthzRevokeRoleFromGroupBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAuthzRevokeRoleFromUserParams creates a new AuthzRevokeRoleFromUserParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzRevokeRoleFromUserParams() *AuthzRevokeRoleFromUserParams {
+	return &AuthzRevokeRoleFromUserParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzRevokeRoleFromUserParamsWithTimeout creates a new AuthzRevokeRoleFromUserParams object
+// with the ability to set a timeout on a request.
+func NewAuthzRevokeRoleFromUserParamsWithTimeout(timeout time.Duration) *AuthzRevokeRoleFromUserParams {
+	return &AuthzRevokeRoleFromUserParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzRevokeRoleFromUserParamsWithContext creates a new AuthzRevokeRoleFromUserParams object
+// with the ability to set a context for a request.
+func NewAuthzRevokeRoleFromUserParamsWithContext(ctx context.Context) *AuthzRevokeRoleFromUserParams {
+	return &AuthzRevokeRoleFromUserParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzRevokeRoleFromUserParamsWithHTTPClient creates a new AuthzRevokeRoleFromUserParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzRevokeRoleFromUserParamsWithHTTPClient(client *http.Client) *AuthzRevokeRoleFromUserParams {
+	return &AuthzRevokeRoleFromUserParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzRevokeRoleFromUserParams contains all the parameters to send to the API endpoint
+
+	for the authz revoke role from user operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzRevokeRoleFromUserParams struct {
+
+	// Body.
+	Body AuthzRevokeRoleFromUserBody
+
+	/* ID.
+
+	   user name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz revoke role from user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzRevokeRoleFromUserParams) WithDefaults() *AuthzRevokeRoleFromUserParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz revoke role from user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzRevokeRoleFromUserParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) WithTimeout(timeout time.Duration) *AuthzRevokeRoleFromUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) WithContext(ctx context.Context) *AuthzRevokeRoleFromUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) WithHTTPClient(client *http.Client) *AuthzRevokeRoleFromUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) WithBody(body AuthzRevokeRoleFromUserBody) *AuthzRevokeRoleFromUserParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) SetBody(body AuthzRevokeRoleFromUserBody) {
+	o.Body = body
+}
+
+// WithID adds the id to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) WithID(id string) *AuthzRevokeRoleFromUserParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz revoke role from user params
+func (o *AuthzRevokeRoleFromUserParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzRevokeRoleFromUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
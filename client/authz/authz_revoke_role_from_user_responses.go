@@ -0,0 +1,488 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzRevokeRoleFromUserReader is a Reader for the AuthzRevokeRoleFromUser structure.
+type AuthzRevokeRoleFromUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzRevokeRoleFromUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzRevokeRoleFromUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzRevokeRoleFromUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzRevokeRoleFromUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAuthzRevokeRoleFromUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAuthzRevokeRoleFromUserUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzRevokeRoleFromUserInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzRevokeRoleFromUserOK creates a AuthzRevokeRoleFromUserOK with default headers values
+func NewAuthzRevokeRoleFromUserOK() *AuthzRevokeRoleFromUserOK {
+	return &AuthzRevokeRoleFromUserOK{}
+}
+
+/*
+AuthzRevokeRoleFromUserOK describes a response with status code 200, with default header values.
+
+Role revoked successfully
+*/
+type AuthzRevokeRoleFromUserOK struct {
+}
+
+// IsSuccess returns true when this authz revoke role from user o k response has a 2xx status code
+func (o *AuthzRevokeRoleFromUserOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz revoke role from user o k response has a 3xx status code
+func (o *AuthzRevokeRoleFromUserOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from user o k response has a 4xx status code
+func (o *AuthzRevokeRoleFromUserOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz revoke role from user o k response has a 5xx status code
+func (o *AuthzRevokeRoleFromUserOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from user o k response a status code equal to that given
+func (o *AuthzRevokeRoleFromUserOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz revoke role from user o k response
+func (o *AuthzRevokeRoleFromUserOK) Code() int {
+	return 200
+}
+
+func (o *AuthzRevokeRoleFromUserOK) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserOK ", 200)
+}
+
+func (o *AuthzRevokeRoleFromUserOK) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserOK ", 200)
+}
+
+func (o *AuthzRevokeRoleFromUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromUserUnauthorized creates a AuthzRevokeRoleFromUserUnauthorized with default headers values
+func NewAuthzRevokeRoleFromUserUnauthorized() *AuthzRevokeRoleFromUserUnauthorized {
+	return &AuthzRevokeRoleFromUserUnauthorized{}
+}
+
+/*
+AuthzRevokeRoleFromUserUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzRevokeRoleFromUserUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz revoke role from user unauthorized response has a 2xx status code
+func (o *AuthzRevokeRoleFromUserUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from user unauthorized response has a 3xx status code
+func (o *AuthzRevokeRoleFromUserUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from user unauthorized response has a 4xx status code
+func (o *AuthzRevokeRoleFromUserUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz revoke role from user unauthorized response has a 5xx status code
+func (o *AuthzRevokeRoleFromUserUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from user unauthorized response a status code equal to that given
+func (o *AuthzRevokeRoleFromUserUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz revoke role from user unauthorized response
+func (o *AuthzRevokeRoleFromUserUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzRevokeRoleFromUserUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserUnauthorized ", 401)
+}
+
+func (o *AuthzRevokeRoleFromUserUnauthorized) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserUnauthorized ", 401)
+}
+
+func (o *AuthzRevokeRoleFromUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromUserForbidden creates a AuthzRevokeRoleFromUserForbidden with default headers values
+func NewAuthzRevokeRoleFromUserForbidden() *AuthzRevokeRoleFromUserForbidden {
+	return &AuthzRevokeRoleFromUserForbidden{}
+}
+
+/*
+AuthzRevokeRoleFromUserForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzRevokeRoleFromUserForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz revoke role from user forbidden response has a 2xx status code
+func (o *AuthzRevokeRoleFromUserForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from user forbidden response has a 3xx status code
+func (o *AuthzRevokeRoleFromUserForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from user forbidden response has a 4xx status code
+func (o *AuthzRevokeRoleFromUserForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz revoke role from user forbidden response has a 5xx status code
+func (o *AuthzRevokeRoleFromUserForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from user forbidden response a status code equal to that given
+func (o *AuthzRevokeRoleFromUserForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz revoke role from user forbidden response
+func (o *AuthzRevokeRoleFromUserForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzRevokeRoleFromUserForbidden) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromUserForbidden) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromUserForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzRevokeRoleFromUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromUserNotFound creates a AuthzRevokeRoleFromUserNotFound with default headers values
+func NewAuthzRevokeRoleFromUserNotFound() *AuthzRevokeRoleFromUserNotFound {
+	return &AuthzRevokeRoleFromUserNotFound{}
+}
+
+/*
+AuthzRevokeRoleFromUserNotFound describes a response with status code 404, with default header values.
+
+no role found
+*/
+type AuthzRevokeRoleFromUserNotFound struct {
+}
+
+// IsSuccess returns true when this authz revoke role from user not found response has a 2xx status code
+func (o *AuthzRevokeRoleFromUserNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from user not found response has a 3xx status code
+func (o *AuthzRevokeRoleFromUserNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from user not found response has a 4xx status code
+func (o *AuthzRevokeRoleFromUserNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz revoke role from user not found response has a 5xx status code
+func (o *AuthzRevokeRoleFromUserNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from user not found response a status code equal to that given
+func (o *AuthzRevokeRoleFromUserNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the authz revoke role from user not found response
+func (o *AuthzRevokeRoleFromUserNotFound) Code() int {
+	return 404
+}
+
+func (o *AuthzRevokeRoleFromUserNotFound) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserNotFound ", 404)
+}
+
+func (o *AuthzRevokeRoleFromUserNotFound) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserNotFound ", 404)
+}
+
+func (o *AuthzRevokeRoleFromUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromUserUnprocessableEntity creates a AuthzRevokeRoleFromUserUnprocessableEntity with default headers values
+func NewAuthzRevokeRoleFromUserUnprocessableEntity() *AuthzRevokeRoleFromUserUnprocessableEntity {
+	return &AuthzRevokeRoleFromUserUnprocessableEntity{}
+}
+
+/*
+AuthzRevokeRoleFromUserUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type AuthzRevokeRoleFromUserUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz revoke role from user unprocessable entity response has a 2xx status code
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from user unprocessable entity response has a 3xx status code
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from user unprocessable entity response has a 4xx status code
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz revoke role from user unprocessable entity response has a 5xx status code
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz revoke role from user unprocessable entity response a status code equal to that given
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the authz revoke role from user unprocessable entity response
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzRevokeRoleFromUserUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzRevokeRoleFromUserInternalServerError creates a AuthzRevokeRoleFromUserInternalServerError with default headers values
+func NewAuthzRevokeRoleFromUserInternalServerError() *AuthzRevokeRoleFromUserInternalServerError {
+	return &AuthzRevokeRoleFromUserInternalServerError{}
+}
+
+/*
+AuthzRevokeRoleFromUserInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzRevokeRoleFromUserInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz revoke role from user internal server error response has a 2xx status code
+func (o *AuthzRevokeRoleFromUserInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz revoke role from user internal server error response has a 3xx status code
+func (o *AuthzRevokeRoleFromUserInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz revoke role from user internal server error response has a 4xx status code
+func (o *AuthzRevokeRoleFromUserInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz revoke role from user internal server error response has a 5xx status code
+func (o *AuthzRevokeRoleFromUserInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz revoke role from user internal server error response a status code equal to that given
+func (o *AuthzRevokeRoleFromUserInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz revoke role from user internal server error response
+func (o *AuthzRevokeRoleFromUserInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzRevokeRoleFromUserInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromUserInternalServerError) String() string {
+	return fmt.Sprintf("[POST /authz/users/{id}/revoke][%d] authzRevokeRoleFromUserInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzRevokeRoleFromUserInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzRevokeRoleFromUserInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+AuthzRevokeRoleFromUserBody authz revoke role from user body
+swagger:model AuthzRevokeRoleFromUserBody
+*/
+type AuthzRevokeRoleFromUserBody struct {
+
+	// names of the roles
+	Roles []string `json:"roles"`
+}
+
+// Validate validates this authz revoke role from user body
+func (o *AuthzRevokeRoleFromUserBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this authz revoke role from user body based on context it is used
+func (o *AuthzRevokeRoleFromUserBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AuthzRevokeRoleFromUserBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AuthzRevokeRoleFromUserBody) UnmarshalBinary(b []byte) error {
+	var res AuthzRevokeRoleFromUserBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewAuthzUpdateRoleParams creates a new AuthzUpdateRoleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthzUpdateRoleParams() *AuthzUpdateRoleParams {
+	return &AuthzUpdateRoleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthzUpdateRoleParamsWithTimeout creates a new AuthzUpdateRoleParams object
+// with the ability to set a timeout on a request.
+func NewAuthzUpdateRoleParamsWithTimeout(timeout time.Duration) *AuthzUpdateRoleParams {
+	return &AuthzUpdateRoleParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthzUpdateRoleParamsWithContext creates a new AuthzUpdateRoleParams object
+// with the ability to set a context for a request.
+func NewAuthzUpdateRoleParamsWithContext(ctx context.Context) *AuthzUpdateRoleParams {
+	return &AuthzUpdateRoleParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthzUpdateRoleParamsWithHTTPClient creates a new AuthzUpdateRoleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthzUpdateRoleParamsWithHTTPClient(client *http.Client) *AuthzUpdateRoleParams {
+	return &AuthzUpdateRoleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AuthzUpdateRoleParams contains all the parameters to send to the API endpoint
+
+	for the authz update role operation.
+
+	Typically these are written to a http.Request.
+*/
+type AuthzUpdateRoleParams struct {
+
+	// Body.
+	Body *models.Role
+
+	/* ID.
+
+	   role name
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authz update role params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzUpdateRoleParams) WithDefaults() *AuthzUpdateRoleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authz update role params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthzUpdateRoleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authz update role params
+func (o *AuthzUpdateRoleParams) WithTimeout(timeout time.Duration) *AuthzUpdateRoleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authz update role params
+func (o *AuthzUpdateRoleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authz update role params
+func (o *AuthzUpdateRoleParams) WithContext(ctx context.Context) *AuthzUpdateRoleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authz update role params
+func (o *AuthzUpdateRoleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authz update role params
+func (o *AuthzUpdateRoleParams) WithHTTPClient(client *http.Client) *AuthzUpdateRoleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authz update role params
+func (o *AuthzUpdateRoleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the authz update role params
+func (o *AuthzUpdateRoleParams) WithBody(body *models.Role) *AuthzUpdateRoleParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the authz update role params
+func (o *AuthzUpdateRoleParams) SetBody(body *models.Role) {
+	o.Body = body
+}
+
+// WithID adds the id to the authz update role params
+func (o *AuthzUpdateRoleParams) WithID(id string) *AuthzUpdateRoleParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the authz update role params
+func (o *AuthzUpdateRoleParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthzUpdateRoleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
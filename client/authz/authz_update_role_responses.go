@@ -0,0 +1,448 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package authz
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AuthzUpdateRoleReader is a Reader for the AuthzUpdateRole structure.
+type AuthzUpdateRoleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AuthzUpdateRoleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAuthzUpdateRoleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAuthzUpdateRoleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAuthzUpdateRoleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAuthzUpdateRoleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAuthzUpdateRoleUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAuthzUpdateRoleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewAuthzUpdateRoleOK creates a AuthzUpdateRoleOK with default headers values
+func NewAuthzUpdateRoleOK() *AuthzUpdateRoleOK {
+	return &AuthzUpdateRoleOK{}
+}
+
+/*
+AuthzUpdateRoleOK describes a response with status code 200, with default header values.
+
+Role updated successfully
+*/
+type AuthzUpdateRoleOK struct {
+}
+
+// IsSuccess returns true when this authz update role o k response has a 2xx status code
+func (o *AuthzUpdateRoleOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this authz update role o k response has a 3xx status code
+func (o *AuthzUpdateRoleOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz update role o k response has a 4xx status code
+func (o *AuthzUpdateRoleOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz update role o k response has a 5xx status code
+func (o *AuthzUpdateRoleOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz update role o k response a status code equal to that given
+func (o *AuthzUpdateRoleOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the authz update role o k response
+func (o *AuthzUpdateRoleOK) Code() int {
+	return 200
+}
+
+func (o *AuthzUpdateRoleOK) Error() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleOK ", 200)
+}
+
+func (o *AuthzUpdateRoleOK) String() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleOK ", 200)
+}
+
+func (o *AuthzUpdateRoleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzUpdateRoleUnauthorized creates a AuthzUpdateRoleUnauthorized with default headers values
+func NewAuthzUpdateRoleUnauthorized() *AuthzUpdateRoleUnauthorized {
+	return &AuthzUpdateRoleUnauthorized{}
+}
+
+/*
+AuthzUpdateRoleUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type AuthzUpdateRoleUnauthorized struct {
+}
+
+// IsSuccess returns true when this authz update role unauthorized response has a 2xx status code
+func (o *AuthzUpdateRoleUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz update role unauthorized response has a 3xx status code
+func (o *AuthzUpdateRoleUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz update role unauthorized response has a 4xx status code
+func (o *AuthzUpdateRoleUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz update role unauthorized response has a 5xx status code
+func (o *AuthzUpdateRoleUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz update role unauthorized response a status code equal to that given
+func (o *AuthzUpdateRoleUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the authz update role unauthorized response
+func (o *AuthzUpdateRoleUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AuthzUpdateRoleUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleUnauthorized ", 401)
+}
+
+func (o *AuthzUpdateRoleUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleUnauthorized ", 401)
+}
+
+func (o *AuthzUpdateRoleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzUpdateRoleForbidden creates a AuthzUpdateRoleForbidden with default headers values
+func NewAuthzUpdateRoleForbidden() *AuthzUpdateRoleForbidden {
+	return &AuthzUpdateRoleForbidden{}
+}
+
+/*
+AuthzUpdateRoleForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AuthzUpdateRoleForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz update role forbidden response has a 2xx status code
+func (o *AuthzUpdateRoleForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz update role forbidden response has a 3xx status code
+func (o *AuthzUpdateRoleForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz update role forbidden response has a 4xx status code
+func (o *AuthzUpdateRoleForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz update role forbidden response has a 5xx status code
+func (o *AuthzUpdateRoleForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz update role forbidden response a status code equal to that given
+func (o *AuthzUpdateRoleForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the authz update role forbidden response
+func (o *AuthzUpdateRoleForbidden) Code() int {
+	return 403
+}
+
+func (o *AuthzUpdateRoleForbidden) Error() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzUpdateRoleForbidden) String() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AuthzUpdateRoleForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzUpdateRoleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzUpdateRoleNotFound creates a AuthzUpdateRoleNotFound with default headers values
+func NewAuthzUpdateRoleNotFound() *AuthzUpdateRoleNotFound {
+	return &AuthzUpdateRoleNotFound{}
+}
+
+/*
+AuthzUpdateRoleNotFound describes a response with status code 404, with default header values.
+
+no role found
+*/
+type AuthzUpdateRoleNotFound struct {
+}
+
+// IsSuccess returns true when this authz update role not found response has a 2xx status code
+func (o *AuthzUpdateRoleNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz update role not found response has a 3xx status code
+func (o *AuthzUpdateRoleNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz update role not found response has a 4xx status code
+func (o *AuthzUpdateRoleNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz update role not found response has a 5xx status code
+func (o *AuthzUpdateRoleNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz update role not found response a status code equal to that given
+func (o *AuthzUpdateRoleNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the authz update role not found response
+func (o *AuthzUpdateRoleNotFound) Code() int {
+	return 404
+}
+
+func (o *AuthzUpdateRoleNotFound) Error() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleNotFound ", 404)
+}
+
+func (o *AuthzUpdateRoleNotFound) String() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleNotFound ", 404)
+}
+
+func (o *AuthzUpdateRoleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAuthzUpdateRoleUnprocessableEntity creates a AuthzUpdateRoleUnprocessableEntity with default headers values
+func NewAuthzUpdateRoleUnprocessableEntity() *AuthzUpdateRoleUnprocessableEntity {
+	return &AuthzUpdateRoleUnprocessableEntity{}
+}
+
+/*
+AuthzUpdateRoleUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type AuthzUpdateRoleUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz update role unprocessable entity response has a 2xx status code
+func (o *AuthzUpdateRoleUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz update role unprocessable entity response has a 3xx status code
+func (o *AuthzUpdateRoleUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz update role unprocessable entity response has a 4xx status code
+func (o *AuthzUpdateRoleUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this authz update role unprocessable entity response has a 5xx status code
+func (o *AuthzUpdateRoleUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this authz update role unprocessable entity response a status code equal to that given
+func (o *AuthzUpdateRoleUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the authz update role unprocessable entity response
+func (o *AuthzUpdateRoleUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AuthzUpdateRoleUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzUpdateRoleUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AuthzUpdateRoleUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzUpdateRoleUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAuthzUpdateRoleInternalServerError creates a AuthzUpdateRoleInternalServerError with default headers values
+func NewAuthzUpdateRoleInternalServerError() *AuthzUpdateRoleInternalServerError {
+	return &AuthzUpdateRoleInternalServerError{}
+}
+
+/*
+AuthzUpdateRoleInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type AuthzUpdateRoleInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this authz update role internal server error response has a 2xx status code
+func (o *AuthzUpdateRoleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this authz update role internal server error response has a 3xx status code
+func (o *AuthzUpdateRoleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this authz update role internal server error response has a 4xx status code
+func (o *AuthzUpdateRoleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this authz update role internal server error response has a 5xx status code
+func (o *AuthzUpdateRoleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this authz update role internal server error response a status code equal to that given
+func (o *AuthzUpdateRoleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the authz update role internal server error response
+func (o *AuthzUpdateRoleInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AuthzUpdateRoleInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzUpdateRoleInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /authz/roles/{id}][%d] authzUpdateRoleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AuthzUpdateRoleInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AuthzUpdateRoleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
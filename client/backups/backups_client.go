@@ -41,17 +41,64 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	BackupsCancel(params *BackupsCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsCancelNoContent, error)
+
 	BackupsCreate(params *BackupsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsCreateOK, error)
 
 	BackupsCreateStatus(params *BackupsCreateStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsCreateStatusOK, error)
 
+	BackupsDelete(params *BackupsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsDeleteNoContent, error)
+
+	BackupsList(params *BackupsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsListOK, error)
+
 	BackupsRestore(params *BackupsRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsRestoreOK, error)
 
+	BackupsRestoreCancel(params *BackupsRestoreCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsRestoreCancelNoContent, error)
+
 	BackupsRestoreStatus(params *BackupsRestoreStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsRestoreStatusOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+BackupsCancel Cancels the creation of a backup which is in progress. The operation is aborted on all participating nodes.
+*/
+func (a *Client) BackupsCancel(params *BackupsCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsCancelNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsCancelParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.cancel",
+		Method:             "POST",
+		PathPattern:        "/backups/{backend}/{id}/cancel",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsCancelNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BackupsCreate Starts a process of creating a backup for a set of classes
 */
@@ -130,6 +177,84 @@ func (a *Client) BackupsCreateStatus(params *BackupsCreateStatusParams, authInfo
 	panic(msg)
 }
 
+/*
+BackupsDelete Deletes a backup and all of its files from a backend. Backups which are in progress or which are the base of an incremental backup cannot be deleted.
+*/
+func (a *Client) BackupsDelete(params *BackupsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.delete",
+		Method:             "DELETE",
+		PathPattern:        "/backups/{backend}/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsList Lists all backups stored on a backend
+*/
+func (a *Client) BackupsList(params *BackupsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.list",
+		Method:             "GET",
+		PathPattern:        "/backups/{backend}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BackupsRestore Starts a process of restoring a backup for a set of classes
 */
@@ -169,6 +294,45 @@ func (a *Client) BackupsRestore(params *BackupsRestoreParams, authInfo runtime.C
 	panic(msg)
 }
 
+/*
+BackupsRestoreCancel Cancels the restoration of a backup which is in progress. The operation is aborted on all participating nodes.
+*/
+func (a *Client) BackupsRestoreCancel(params *BackupsRestoreCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsRestoreCancelNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsRestoreCancelParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.restore.cancel",
+		Method:             "POST",
+		PathPattern:        "/backups/{backend}/{id}/restore/cancel",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsRestoreCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsRestoreCancelNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.restore.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BackupsRestoreStatus Returns status of a backup restoration attempt for a set of classes
 */
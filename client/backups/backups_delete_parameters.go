@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewBackupsDeleteParams creates a new BackupsDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewBackupsDeleteParams() *BackupsDeleteParams {
+	return &BackupsDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBackupsDeleteParamsWithTimeout creates a new BackupsDeleteParams object
+// with the ability to set a timeout on a request.
+func NewBackupsDeleteParamsWithTimeout(timeout time.Duration) *BackupsDeleteParams {
+	return &BackupsDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewBackupsDeleteParamsWithContext creates a new BackupsDeleteParams object
+// with the ability to set a context for a request.
+func NewBackupsDeleteParamsWithContext(ctx context.Context) *BackupsDeleteParams {
+	return &BackupsDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewBackupsDeleteParamsWithHTTPClient creates a new BackupsDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewBackupsDeleteParamsWithHTTPClient(client *http.Client) *BackupsDeleteParams {
+	return &BackupsDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+BackupsDeleteParams contains all the parameters to send to the API endpoint
+
+	for the backups delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type BackupsDeleteParams struct {
+
+	/* Backend.
+
+	   Backup backend name e.g. filesystem, gcs, s3.
+	*/
+	Backend string
+
+	/* ID.
+
+	   The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the backups delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BackupsDeleteParams) WithDefaults() *BackupsDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the backups delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BackupsDeleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the backups delete params
+func (o *BackupsDeleteParams) WithTimeout(timeout time.Duration) *BackupsDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the backups delete params
+func (o *BackupsDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the backups delete params
+func (o *BackupsDeleteParams) WithContext(ctx context.Context) *BackupsDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the backups delete params
+func (o *BackupsDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the backups delete params
+func (o *BackupsDeleteParams) WithHTTPClient(client *http.Client) *BackupsDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the backups delete params
+func (o *BackupsDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBackend adds the backend to the backups delete params
+func (o *BackupsDeleteParams) WithBackend(backend string) *BackupsDeleteParams {
+	o.SetBackend(backend)
+	return o
+}
+
+// SetBackend adds the backend to the backups delete params
+func (o *BackupsDeleteParams) SetBackend(backend string) {
+	o.Backend = backend
+}
+
+// WithID adds the id to the backups delete params
+func (o *BackupsDeleteParams) WithID(id string) *BackupsDeleteParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the backups delete params
+func (o *BackupsDeleteParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BackupsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param backend
+	if err := r.SetPathParam("backend", o.Backend); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsDeleteReader is a Reader for the BackupsDelete structure.
+type BackupsDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupsDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewBackupsDeleteNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBackupsDeleteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBackupsDeleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewBackupsDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBackupsDeleteUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupsDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewBackupsDeleteNoContent creates a BackupsDeleteNoContent with default headers values
+func NewBackupsDeleteNoContent() *BackupsDeleteNoContent {
+	return &BackupsDeleteNoContent{}
+}
+
+/*
+BackupsDeleteNoContent describes a response with status code 204, with default header values.
+
+Backup successfully deleted.
+*/
+type BackupsDeleteNoContent struct {
+}
+
+// IsSuccess returns true when this backups delete no content response has a 2xx status code
+func (o *BackupsDeleteNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this backups delete no content response has a 3xx status code
+func (o *BackupsDeleteNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups delete no content response has a 4xx status code
+func (o *BackupsDeleteNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups delete no content response has a 5xx status code
+func (o *BackupsDeleteNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups delete no content response a status code equal to that given
+func (o *BackupsDeleteNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the backups delete no content response
+func (o *BackupsDeleteNoContent) Code() int {
+	return 204
+}
+
+func (o *BackupsDeleteNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteNoContent ", 204)
+}
+
+func (o *BackupsDeleteNoContent) String() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteNoContent ", 204)
+}
+
+func (o *BackupsDeleteNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsDeleteUnauthorized creates a BackupsDeleteUnauthorized with default headers values
+func NewBackupsDeleteUnauthorized() *BackupsDeleteUnauthorized {
+	return &BackupsDeleteUnauthorized{}
+}
+
+/*
+BackupsDeleteUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BackupsDeleteUnauthorized struct {
+}
+
+// IsSuccess returns true when this backups delete unauthorized response has a 2xx status code
+func (o *BackupsDeleteUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups delete unauthorized response has a 3xx status code
+func (o *BackupsDeleteUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups delete unauthorized response has a 4xx status code
+func (o *BackupsDeleteUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups delete unauthorized response has a 5xx status code
+func (o *BackupsDeleteUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups delete unauthorized response a status code equal to that given
+func (o *BackupsDeleteUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the backups delete unauthorized response
+func (o *BackupsDeleteUnauthorized) Code() int {
+	return 401
+}
+
+func (o *BackupsDeleteUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteUnauthorized ", 401)
+}
+
+func (o *BackupsDeleteUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteUnauthorized ", 401)
+}
+
+func (o *BackupsDeleteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsDeleteForbidden creates a BackupsDeleteForbidden with default headers values
+func NewBackupsDeleteForbidden() *BackupsDeleteForbidden {
+	return &BackupsDeleteForbidden{}
+}
+
+/*
+BackupsDeleteForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type BackupsDeleteForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups delete forbidden response has a 2xx status code
+func (o *BackupsDeleteForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups delete forbidden response has a 3xx status code
+func (o *BackupsDeleteForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups delete forbidden response has a 4xx status code
+func (o *BackupsDeleteForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups delete forbidden response has a 5xx status code
+func (o *BackupsDeleteForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups delete forbidden response a status code equal to that given
+func (o *BackupsDeleteForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the backups delete forbidden response
+func (o *BackupsDeleteForbidden) Code() int {
+	return 403
+}
+
+func (o *BackupsDeleteForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsDeleteForbidden) String() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsDeleteForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsDeleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsDeleteNotFound creates a BackupsDeleteNotFound with default headers values
+func NewBackupsDeleteNotFound() *BackupsDeleteNotFound {
+	return &BackupsDeleteNotFound{}
+}
+
+/*
+BackupsDeleteNotFound describes a response with status code 404, with default header values.
+
+Not Found - Backup does not exist
+*/
+type BackupsDeleteNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups delete not found response has a 2xx status code
+func (o *BackupsDeleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups delete not found response has a 3xx status code
+func (o *BackupsDeleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups delete not found response has a 4xx status code
+func (o *BackupsDeleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups delete not found response has a 5xx status code
+func (o *BackupsDeleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups delete not found response a status code equal to that given
+func (o *BackupsDeleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the backups delete not found response
+func (o *BackupsDeleteNotFound) Code() int {
+	return 404
+}
+
+func (o *BackupsDeleteNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteNotFound  %+v", 404, o.Payload)
+}
+
+func (o *BackupsDeleteNotFound) String() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteNotFound  %+v", 404, o.Payload)
+}
+
+func (o *BackupsDeleteNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsDeleteUnprocessableEntity creates a BackupsDeleteUnprocessableEntity with default headers values
+func NewBackupsDeleteUnprocessableEntity() *BackupsDeleteUnprocessableEntity {
+	return &BackupsDeleteUnprocessableEntity{}
+}
+
+/*
+BackupsDeleteUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid backup deletion attempt.
+*/
+type BackupsDeleteUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups delete unprocessable entity response has a 2xx status code
+func (o *BackupsDeleteUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups delete unprocessable entity response has a 3xx status code
+func (o *BackupsDeleteUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups delete unprocessable entity response has a 4xx status code
+func (o *BackupsDeleteUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups delete unprocessable entity response has a 5xx status code
+func (o *BackupsDeleteUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups delete unprocessable entity response a status code equal to that given
+func (o *BackupsDeleteUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the backups delete unprocessable entity response
+func (o *BackupsDeleteUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *BackupsDeleteUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BackupsDeleteUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BackupsDeleteUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsDeleteUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsDeleteInternalServerError creates a BackupsDeleteInternalServerError with default headers values
+func NewBackupsDeleteInternalServerError() *BackupsDeleteInternalServerError {
+	return &BackupsDeleteInternalServerError{}
+}
+
+/*
+BackupsDeleteInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BackupsDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups delete internal server error response has a 2xx status code
+func (o *BackupsDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups delete internal server error response has a 3xx status code
+func (o *BackupsDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups delete internal server error response has a 4xx status code
+func (o *BackupsDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups delete internal server error response has a 5xx status code
+func (o *BackupsDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this backups delete internal server error response a status code equal to that given
+func (o *BackupsDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the backups delete internal server error response
+func (o *BackupsDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *BackupsDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsDeleteInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /backups/{backend}/{id}][%d] backupsDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsDeleteInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
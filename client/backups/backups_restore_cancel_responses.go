@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsRestoreCancelReader is a Reader for the BackupsRestoreCancel structure.
+type BackupsRestoreCancelReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupsRestoreCancelReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewBackupsRestoreCancelNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBackupsRestoreCancelUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBackupsRestoreCancelForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewBackupsRestoreCancelNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBackupsRestoreCancelUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupsRestoreCancelInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewBackupsRestoreCancelNoContent creates a BackupsRestoreCancelNoContent with default headers values
+func NewBackupsRestoreCancelNoContent() *BackupsRestoreCancelNoContent {
+	return &BackupsRestoreCancelNoContent{}
+}
+
+/*
+BackupsRestoreCancelNoContent describes a response with status code 204, with default header values.
+
+Backup restoration successfully cancelled.
+*/
+type BackupsRestoreCancelNoContent struct {
+}
+
+// IsSuccess returns true when this backups restore cancel no content response has a 2xx status code
+func (o *BackupsRestoreCancelNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this backups restore cancel no content response has a 3xx status code
+func (o *BackupsRestoreCancelNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups restore cancel no content response has a 4xx status code
+func (o *BackupsRestoreCancelNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups restore cancel no content response has a 5xx status code
+func (o *BackupsRestoreCancelNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups restore cancel no content response a status code equal to that given
+func (o *BackupsRestoreCancelNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the backups restore cancel no content response
+func (o *BackupsRestoreCancelNoContent) Code() int {
+	return 204
+}
+
+func (o *BackupsRestoreCancelNoContent) Error() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelNoContent ", 204)
+}
+
+func (o *BackupsRestoreCancelNoContent) String() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelNoContent ", 204)
+}
+
+func (o *BackupsRestoreCancelNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsRestoreCancelUnauthorized creates a BackupsRestoreCancelUnauthorized with default headers values
+func NewBackupsRestoreCancelUnauthorized() *BackupsRestoreCancelUnauthorized {
+	return &BackupsRestoreCancelUnauthorized{}
+}
+
+/*
+BackupsRestoreCancelUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BackupsRestoreCancelUnauthorized struct {
+}
+
+// IsSuccess returns true when this backups restore cancel unauthorized response has a 2xx status code
+func (o *BackupsRestoreCancelUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups restore cancel unauthorized response has a 3xx status code
+func (o *BackupsRestoreCancelUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups restore cancel unauthorized response has a 4xx status code
+func (o *BackupsRestoreCancelUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups restore cancel unauthorized response has a 5xx status code
+func (o *BackupsRestoreCancelUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups restore cancel unauthorized response a status code equal to that given
+func (o *BackupsRestoreCancelUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the backups restore cancel unauthorized response
+func (o *BackupsRestoreCancelUnauthorized) Code() int {
+	return 401
+}
+
+func (o *BackupsRestoreCancelUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelUnauthorized ", 401)
+}
+
+func (o *BackupsRestoreCancelUnauthorized) String() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelUnauthorized ", 401)
+}
+
+func (o *BackupsRestoreCancelUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsRestoreCancelForbidden creates a BackupsRestoreCancelForbidden with default headers values
+func NewBackupsRestoreCancelForbidden() *BackupsRestoreCancelForbidden {
+	return &BackupsRestoreCancelForbidden{}
+}
+
+/*
+BackupsRestoreCancelForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type BackupsRestoreCancelForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups restore cancel forbidden response has a 2xx status code
+func (o *BackupsRestoreCancelForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups restore cancel forbidden response has a 3xx status code
+func (o *BackupsRestoreCancelForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups restore cancel forbidden response has a 4xx status code
+func (o *BackupsRestoreCancelForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups restore cancel forbidden response has a 5xx status code
+func (o *BackupsRestoreCancelForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups restore cancel forbidden response a status code equal to that given
+func (o *BackupsRestoreCancelForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the backups restore cancel forbidden response
+func (o *BackupsRestoreCancelForbidden) Code() int {
+	return 403
+}
+
+func (o *BackupsRestoreCancelForbidden) Error() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsRestoreCancelForbidden) String() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsRestoreCancelForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreCancelForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsRestoreCancelNotFound creates a BackupsRestoreCancelNotFound with default headers values
+func NewBackupsRestoreCancelNotFound() *BackupsRestoreCancelNotFound {
+	return &BackupsRestoreCancelNotFound{}
+}
+
+/*
+BackupsRestoreCancelNotFound describes a response with status code 404, with default header values.
+
+Not Found - Backup restoration is not in progress
+*/
+type BackupsRestoreCancelNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups restore cancel not found response has a 2xx status code
+func (o *BackupsRestoreCancelNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups restore cancel not found response has a 3xx status code
+func (o *BackupsRestoreCancelNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups restore cancel not found response has a 4xx status code
+func (o *BackupsRestoreCancelNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups restore cancel not found response has a 5xx status code
+func (o *BackupsRestoreCancelNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups restore cancel not found response a status code equal to that given
+func (o *BackupsRestoreCancelNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the backups restore cancel not found response
+func (o *BackupsRestoreCancelNotFound) Code() int {
+	return 404
+}
+
+func (o *BackupsRestoreCancelNotFound) Error() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelNotFound  %+v", 404, o.Payload)
+}
+
+func (o *BackupsRestoreCancelNotFound) String() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelNotFound  %+v", 404, o.Payload)
+}
+
+func (o *BackupsRestoreCancelNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreCancelNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsRestoreCancelUnprocessableEntity creates a BackupsRestoreCancelUnprocessableEntity with default headers values
+func NewBackupsRestoreCancelUnprocessableEntity() *BackupsRestoreCancelUnprocessableEntity {
+	return &BackupsRestoreCancelUnprocessableEntity{}
+}
+
+/*
+BackupsRestoreCancelUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid backup restoration cancellation attempt.
+*/
+type BackupsRestoreCancelUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups restore cancel unprocessable entity response has a 2xx status code
+func (o *BackupsRestoreCancelUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups restore cancel unprocessable entity response has a 3xx status code
+func (o *BackupsRestoreCancelUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups restore cancel unprocessable entity response has a 4xx status code
+func (o *BackupsRestoreCancelUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups restore cancel unprocessable entity response has a 5xx status code
+func (o *BackupsRestoreCancelUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups restore cancel unprocessable entity response a status code equal to that given
+func (o *BackupsRestoreCancelUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the backups restore cancel unprocessable entity response
+func (o *BackupsRestoreCancelUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *BackupsRestoreCancelUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BackupsRestoreCancelUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BackupsRestoreCancelUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreCancelUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsRestoreCancelInternalServerError creates a BackupsRestoreCancelInternalServerError with default headers values
+func NewBackupsRestoreCancelInternalServerError() *BackupsRestoreCancelInternalServerError {
+	return &BackupsRestoreCancelInternalServerError{}
+}
+
+/*
+BackupsRestoreCancelInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BackupsRestoreCancelInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups restore cancel internal server error response has a 2xx status code
+func (o *BackupsRestoreCancelInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups restore cancel internal server error response has a 3xx status code
+func (o *BackupsRestoreCancelInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups restore cancel internal server error response has a 4xx status code
+func (o *BackupsRestoreCancelInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups restore cancel internal server error response has a 5xx status code
+func (o *BackupsRestoreCancelInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this backups restore cancel internal server error response a status code equal to that given
+func (o *BackupsRestoreCancelInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the backups restore cancel internal server error response
+func (o *BackupsRestoreCancelInternalServerError) Code() int {
+	return 500
+}
+
+func (o *BackupsRestoreCancelInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsRestoreCancelInternalServerError) String() string {
+	return fmt.Sprintf("[POST /backups/{backend}/{id}/restore/cancel][%d] backupsRestoreCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsRestoreCancelInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreCancelInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
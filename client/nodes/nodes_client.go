@@ -41,13 +41,138 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	NodesDrain(params *NodesDrainParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainOK, error)
+
+	NodesDrainCancel(params *NodesDrainCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainCancelOK, error)
+
+	NodesDrainStatus(params *NodesDrainStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainStatusOK, error)
+
 	NodesGet(params *NodesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetOK, error)
 
 	NodesGetClass(params *NodesGetClassParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassOK, error)
 
+	NodesRebalance(params *NodesRebalanceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesRebalanceOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+NodesDrain Starts moving all shard replicas off the node, so that it can be removed from the cluster safely. No new replicas are placed on a node being drained. Replicas of multi-tenant classes are not moved, these classes are listed as skipped in the drain status.
+*/
+func (a *Client) NodesDrain(params *NodesDrainParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesDrainParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.drain",
+		Method:             "POST",
+		PathPattern:        "/nodes/{nodeName}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesDrainReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesDrainOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.drain: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesDrainCancel Stops draining the node. Replicas moved already stay on their new nodes.
+*/
+func (a *Client) NodesDrainCancel(params *NodesDrainCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainCancelOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesDrainCancelParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.drain.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/nodes/{nodeName}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesDrainCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesDrainCancelOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.drain.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesDrainStatus Returns whether the node is being drained or has been drained, in which case it is safe to remove.
+*/
+func (a *Client) NodesDrainStatus(params *NodesDrainStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDrainStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesDrainStatusParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.drain.status",
+		Method:             "GET",
+		PathPattern:        "/nodes/{nodeName}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesDrainStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesDrainStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.drain.status: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 NodesGet Returns status of Weaviate DB.
 */
@@ -126,6 +251,45 @@ func (a *Client) NodesGetClass(params *NodesGetClassParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+NodesRebalance Moves shard replicas between nodes, so that all nodes hold a similar number of replicas and use a similar share of their disk. Nodes being drained are left out.
+*/
+func (a *Client) NodesRebalance(params *NodesRebalanceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesRebalanceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesRebalanceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.rebalance",
+		Method:             "POST",
+		PathPattern:        "/nodes/rebalance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesRebalanceReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesRebalanceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.rebalance: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewNodesDrainCancelParams creates a new NodesDrainCancelParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewNodesDrainCancelParams() *NodesDrainCancelParams {
+	return &NodesDrainCancelParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewNodesDrainCancelParamsWithTimeout creates a new NodesDrainCancelParams object
+// with the ability to set a timeout on a request.
+func NewNodesDrainCancelParamsWithTimeout(timeout time.Duration) *NodesDrainCancelParams {
+	return &NodesDrainCancelParams{
+		timeout: timeout,
+	}
+}
+
+// NewNodesDrainCancelParamsWithContext creates a new NodesDrainCancelParams object
+// with the ability to set a context for a request.
+func NewNodesDrainCancelParamsWithContext(ctx context.Context) *NodesDrainCancelParams {
+	return &NodesDrainCancelParams{
+		Context: ctx,
+	}
+}
+
+// NewNodesDrainCancelParamsWithHTTPClient creates a new NodesDrainCancelParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewNodesDrainCancelParamsWithHTTPClient(client *http.Client) *NodesDrainCancelParams {
+	return &NodesDrainCancelParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+NodesDrainCancelParams contains all the parameters to send to the API endpoint
+
+	for the nodes drain cancel operation.
+
+	Typically these are written to a http.Request.
+*/
+type NodesDrainCancelParams struct {
+
+	/* NodeName.
+
+	   The name of the node.
+	*/
+	NodeName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the nodes drain cancel params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *NodesDrainCancelParams) WithDefaults() *NodesDrainCancelParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the nodes drain cancel params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *NodesDrainCancelParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the nodes drain cancel params
+func (o *NodesDrainCancelParams) WithTimeout(timeout time.Duration) *NodesDrainCancelParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the nodes drain cancel params
+func (o *NodesDrainCancelParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the nodes drain cancel params
+func (o *NodesDrainCancelParams) WithContext(ctx context.Context) *NodesDrainCancelParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the nodes drain cancel params
+func (o *NodesDrainCancelParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the nodes drain cancel params
+func (o *NodesDrainCancelParams) WithHTTPClient(client *http.Client) *NodesDrainCancelParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the nodes drain cancel params
+func (o *NodesDrainCancelParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNodeName adds the nodeName to the nodes drain cancel params
+func (o *NodesDrainCancelParams) WithNodeName(nodeName string) *NodesDrainCancelParams {
+	o.SetNodeName(nodeName)
+	return o
+}
+
+// SetNodeName adds the nodeName to the nodes drain cancel params
+func (o *NodesDrainCancelParams) SetNodeName(nodeName string) {
+	o.NodeName = nodeName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *NodesDrainCancelParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param nodeName
+	if err := r.SetPathParam("nodeName", o.NodeName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
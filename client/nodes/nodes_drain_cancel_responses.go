@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainCancelReader is a Reader for the NodesDrainCancel structure.
+type NodesDrainCancelReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *NodesDrainCancelReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewNodesDrainCancelOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewNodesDrainCancelUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewNodesDrainCancelForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewNodesDrainCancelNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewNodesDrainCancelUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewNodesDrainCancelInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewNodesDrainCancelOK creates a NodesDrainCancelOK with default headers values
+func NewNodesDrainCancelOK() *NodesDrainCancelOK {
+	return &NodesDrainCancelOK{}
+}
+
+/*
+NodesDrainCancelOK describes a response with status code 200, with default header values.
+
+Draining the node successfully stopped
+*/
+type NodesDrainCancelOK struct {
+	Payload *models.NodeDrainStatus
+}
+
+// IsSuccess returns true when this nodes drain cancel o k response has a 2xx status code
+func (o *NodesDrainCancelOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this nodes drain cancel o k response has a 3xx status code
+func (o *NodesDrainCancelOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain cancel o k response has a 4xx status code
+func (o *NodesDrainCancelOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes drain cancel o k response has a 5xx status code
+func (o *NodesDrainCancelOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain cancel o k response a status code equal to that given
+func (o *NodesDrainCancelOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the nodes drain cancel o k response
+func (o *NodesDrainCancelOK) Code() int {
+	return 200
+}
+
+func (o *NodesDrainCancelOK) Error() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesDrainCancelOK) String() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesDrainCancelOK) GetPayload() *models.NodeDrainStatus {
+	return o.Payload
+}
+
+func (o *NodesDrainCancelOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NodeDrainStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainCancelUnauthorized creates a NodesDrainCancelUnauthorized with default headers values
+func NewNodesDrainCancelUnauthorized() *NodesDrainCancelUnauthorized {
+	return &NodesDrainCancelUnauthorized{}
+}
+
+/*
+NodesDrainCancelUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type NodesDrainCancelUnauthorized struct {
+}
+
+// IsSuccess returns true when this nodes drain cancel unauthorized response has a 2xx status code
+func (o *NodesDrainCancelUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain cancel unauthorized response has a 3xx status code
+func (o *NodesDrainCancelUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain cancel unauthorized response has a 4xx status code
+func (o *NodesDrainCancelUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain cancel unauthorized response has a 5xx status code
+func (o *NodesDrainCancelUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain cancel unauthorized response a status code equal to that given
+func (o *NodesDrainCancelUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the nodes drain cancel unauthorized response
+func (o *NodesDrainCancelUnauthorized) Code() int {
+	return 401
+}
+
+func (o *NodesDrainCancelUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelUnauthorized ", 401)
+}
+
+func (o *NodesDrainCancelUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelUnauthorized ", 401)
+}
+
+func (o *NodesDrainCancelUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewNodesDrainCancelForbidden creates a NodesDrainCancelForbidden with default headers values
+func NewNodesDrainCancelForbidden() *NodesDrainCancelForbidden {
+	return &NodesDrainCancelForbidden{}
+}
+
+/*
+NodesDrainCancelForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type NodesDrainCancelForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain cancel forbidden response has a 2xx status code
+func (o *NodesDrainCancelForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain cancel forbidden response has a 3xx status code
+func (o *NodesDrainCancelForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain cancel forbidden response has a 4xx status code
+func (o *NodesDrainCancelForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain cancel forbidden response has a 5xx status code
+func (o *NodesDrainCancelForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain cancel forbidden response a status code equal to that given
+func (o *NodesDrainCancelForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the nodes drain cancel forbidden response
+func (o *NodesDrainCancelForbidden) Code() int {
+	return 403
+}
+
+func (o *NodesDrainCancelForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesDrainCancelForbidden) String() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesDrainCancelForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainCancelForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainCancelNotFound creates a NodesDrainCancelNotFound with default headers values
+func NewNodesDrainCancelNotFound() *NodesDrainCancelNotFound {
+	return &NodesDrainCancelNotFound{}
+}
+
+/*
+NodesDrainCancelNotFound describes a response with status code 404, with default header values.
+
+Not Found - Node does not exist
+*/
+type NodesDrainCancelNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain cancel not found response has a 2xx status code
+func (o *NodesDrainCancelNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain cancel not found response has a 3xx status code
+func (o *NodesDrainCancelNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain cancel not found response has a 4xx status code
+func (o *NodesDrainCancelNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain cancel not found response has a 5xx status code
+func (o *NodesDrainCancelNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain cancel not found response a status code equal to that given
+func (o *NodesDrainCancelNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the nodes drain cancel not found response
+func (o *NodesDrainCancelNotFound) Code() int {
+	return 404
+}
+
+func (o *NodesDrainCancelNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesDrainCancelNotFound) String() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesDrainCancelNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainCancelNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainCancelUnprocessableEntity creates a NodesDrainCancelUnprocessableEntity with default headers values
+func NewNodesDrainCancelUnprocessableEntity() *NodesDrainCancelUnprocessableEntity {
+	return &NodesDrainCancelUnprocessableEntity{}
+}
+
+/*
+NodesDrainCancelUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid drain cancellation attempt.
+*/
+type NodesDrainCancelUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain cancel unprocessable entity response has a 2xx status code
+func (o *NodesDrainCancelUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain cancel unprocessable entity response has a 3xx status code
+func (o *NodesDrainCancelUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain cancel unprocessable entity response has a 4xx status code
+func (o *NodesDrainCancelUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain cancel unprocessable entity response has a 5xx status code
+func (o *NodesDrainCancelUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain cancel unprocessable entity response a status code equal to that given
+func (o *NodesDrainCancelUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the nodes drain cancel unprocessable entity response
+func (o *NodesDrainCancelUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *NodesDrainCancelUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesDrainCancelUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesDrainCancelUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainCancelUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainCancelInternalServerError creates a NodesDrainCancelInternalServerError with default headers values
+func NewNodesDrainCancelInternalServerError() *NodesDrainCancelInternalServerError {
+	return &NodesDrainCancelInternalServerError{}
+}
+
+/*
+NodesDrainCancelInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type NodesDrainCancelInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain cancel internal server error response has a 2xx status code
+func (o *NodesDrainCancelInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain cancel internal server error response has a 3xx status code
+func (o *NodesDrainCancelInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain cancel internal server error response has a 4xx status code
+func (o *NodesDrainCancelInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes drain cancel internal server error response has a 5xx status code
+func (o *NodesDrainCancelInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this nodes drain cancel internal server error response a status code equal to that given
+func (o *NodesDrainCancelInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the nodes drain cancel internal server error response
+func (o *NodesDrainCancelInternalServerError) Code() int {
+	return 500
+}
+
+func (o *NodesDrainCancelInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesDrainCancelInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /nodes/{nodeName}/drain][%d] nodesDrainCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesDrainCancelInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainCancelInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
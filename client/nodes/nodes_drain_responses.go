@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainReader is a Reader for the NodesDrain structure.
+type NodesDrainReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *NodesDrainReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewNodesDrainOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewNodesDrainUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewNodesDrainForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewNodesDrainNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewNodesDrainUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewNodesDrainInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewNodesDrainOK creates a NodesDrainOK with default headers values
+func NewNodesDrainOK() *NodesDrainOK {
+	return &NodesDrainOK{}
+}
+
+/*
+NodesDrainOK describes a response with status code 200, with default header values.
+
+Draining the node successfully started
+*/
+type NodesDrainOK struct {
+	Payload *models.NodeDrainStatus
+}
+
+// IsSuccess returns true when this nodes drain o k response has a 2xx status code
+func (o *NodesDrainOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this nodes drain o k response has a 3xx status code
+func (o *NodesDrainOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain o k response has a 4xx status code
+func (o *NodesDrainOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes drain o k response has a 5xx status code
+func (o *NodesDrainOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain o k response a status code equal to that given
+func (o *NodesDrainOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the nodes drain o k response
+func (o *NodesDrainOK) Code() int {
+	return 200
+}
+
+func (o *NodesDrainOK) Error() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesDrainOK) String() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesDrainOK) GetPayload() *models.NodeDrainStatus {
+	return o.Payload
+}
+
+func (o *NodesDrainOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NodeDrainStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainUnauthorized creates a NodesDrainUnauthorized with default headers values
+func NewNodesDrainUnauthorized() *NodesDrainUnauthorized {
+	return &NodesDrainUnauthorized{}
+}
+
+/*
+NodesDrainUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type NodesDrainUnauthorized struct {
+}
+
+// IsSuccess returns true when this nodes drain unauthorized response has a 2xx status code
+func (o *NodesDrainUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain unauthorized response has a 3xx status code
+func (o *NodesDrainUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain unauthorized response has a 4xx status code
+func (o *NodesDrainUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain unauthorized response has a 5xx status code
+func (o *NodesDrainUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain unauthorized response a status code equal to that given
+func (o *NodesDrainUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the nodes drain unauthorized response
+func (o *NodesDrainUnauthorized) Code() int {
+	return 401
+}
+
+func (o *NodesDrainUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainUnauthorized ", 401)
+}
+
+func (o *NodesDrainUnauthorized) String() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainUnauthorized ", 401)
+}
+
+func (o *NodesDrainUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewNodesDrainForbidden creates a NodesDrainForbidden with default headers values
+func NewNodesDrainForbidden() *NodesDrainForbidden {
+	return &NodesDrainForbidden{}
+}
+
+/*
+NodesDrainForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type NodesDrainForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain forbidden response has a 2xx status code
+func (o *NodesDrainForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain forbidden response has a 3xx status code
+func (o *NodesDrainForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain forbidden response has a 4xx status code
+func (o *NodesDrainForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain forbidden response has a 5xx status code
+func (o *NodesDrainForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain forbidden response a status code equal to that given
+func (o *NodesDrainForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the nodes drain forbidden response
+func (o *NodesDrainForbidden) Code() int {
+	return 403
+}
+
+func (o *NodesDrainForbidden) Error() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesDrainForbidden) String() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesDrainForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainNotFound creates a NodesDrainNotFound with default headers values
+func NewNodesDrainNotFound() *NodesDrainNotFound {
+	return &NodesDrainNotFound{}
+}
+
+/*
+NodesDrainNotFound describes a response with status code 404, with default header values.
+
+Not Found - Node does not exist
+*/
+type NodesDrainNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain not found response has a 2xx status code
+func (o *NodesDrainNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain not found response has a 3xx status code
+func (o *NodesDrainNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain not found response has a 4xx status code
+func (o *NodesDrainNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain not found response has a 5xx status code
+func (o *NodesDrainNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain not found response a status code equal to that given
+func (o *NodesDrainNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the nodes drain not found response
+func (o *NodesDrainNotFound) Code() int {
+	return 404
+}
+
+func (o *NodesDrainNotFound) Error() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesDrainNotFound) String() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesDrainNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainUnprocessableEntity creates a NodesDrainUnprocessableEntity with default headers values
+func NewNodesDrainUnprocessableEntity() *NodesDrainUnprocessableEntity {
+	return &NodesDrainUnprocessableEntity{}
+}
+
+/*
+NodesDrainUnprocessableEntity describes a response with status code 422, with default header values.
+
+The node cannot be drained, e.g. since there is no other node to move its replicas to.
+*/
+type NodesDrainUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain unprocessable entity response has a 2xx status code
+func (o *NodesDrainUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain unprocessable entity response has a 3xx status code
+func (o *NodesDrainUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain unprocessable entity response has a 4xx status code
+func (o *NodesDrainUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain unprocessable entity response has a 5xx status code
+func (o *NodesDrainUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain unprocessable entity response a status code equal to that given
+func (o *NodesDrainUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the nodes drain unprocessable entity response
+func (o *NodesDrainUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *NodesDrainUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesDrainUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesDrainUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainInternalServerError creates a NodesDrainInternalServerError with default headers values
+func NewNodesDrainInternalServerError() *NodesDrainInternalServerError {
+	return &NodesDrainInternalServerError{}
+}
+
+/*
+NodesDrainInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type NodesDrainInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain internal server error response has a 2xx status code
+func (o *NodesDrainInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain internal server error response has a 3xx status code
+func (o *NodesDrainInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain internal server error response has a 4xx status code
+func (o *NodesDrainInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes drain internal server error response has a 5xx status code
+func (o *NodesDrainInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this nodes drain internal server error response a status code equal to that given
+func (o *NodesDrainInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the nodes drain internal server error response
+func (o *NodesDrainInternalServerError) Code() int {
+	return 500
+}
+
+func (o *NodesDrainInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesDrainInternalServerError) String() string {
+	return fmt.Sprintf("[POST /nodes/{nodeName}/drain][%d] nodesDrainInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesDrainInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
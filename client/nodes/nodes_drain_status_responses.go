@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDrainStatusReader is a Reader for the NodesDrainStatus structure.
+type NodesDrainStatusReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *NodesDrainStatusReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewNodesDrainStatusOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewNodesDrainStatusUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewNodesDrainStatusForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewNodesDrainStatusNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewNodesDrainStatusUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewNodesDrainStatusInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewNodesDrainStatusOK creates a NodesDrainStatusOK with default headers values
+func NewNodesDrainStatusOK() *NodesDrainStatusOK {
+	return &NodesDrainStatusOK{}
+}
+
+/*
+NodesDrainStatusOK describes a response with status code 200, with default header values.
+
+Drain status successfully returned
+*/
+type NodesDrainStatusOK struct {
+	Payload *models.NodeDrainStatus
+}
+
+// IsSuccess returns true when this nodes drain status o k response has a 2xx status code
+func (o *NodesDrainStatusOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this nodes drain status o k response has a 3xx status code
+func (o *NodesDrainStatusOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain status o k response has a 4xx status code
+func (o *NodesDrainStatusOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes drain status o k response has a 5xx status code
+func (o *NodesDrainStatusOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain status o k response a status code equal to that given
+func (o *NodesDrainStatusOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the nodes drain status o k response
+func (o *NodesDrainStatusOK) Code() int {
+	return 200
+}
+
+func (o *NodesDrainStatusOK) Error() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesDrainStatusOK) String() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesDrainStatusOK) GetPayload() *models.NodeDrainStatus {
+	return o.Payload
+}
+
+func (o *NodesDrainStatusOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NodeDrainStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainStatusUnauthorized creates a NodesDrainStatusUnauthorized with default headers values
+func NewNodesDrainStatusUnauthorized() *NodesDrainStatusUnauthorized {
+	return &NodesDrainStatusUnauthorized{}
+}
+
+/*
+NodesDrainStatusUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type NodesDrainStatusUnauthorized struct {
+}
+
+// IsSuccess returns true when this nodes drain status unauthorized response has a 2xx status code
+func (o *NodesDrainStatusUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain status unauthorized response has a 3xx status code
+func (o *NodesDrainStatusUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain status unauthorized response has a 4xx status code
+func (o *NodesDrainStatusUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain status unauthorized response has a 5xx status code
+func (o *NodesDrainStatusUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain status unauthorized response a status code equal to that given
+func (o *NodesDrainStatusUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the nodes drain status unauthorized response
+func (o *NodesDrainStatusUnauthorized) Code() int {
+	return 401
+}
+
+func (o *NodesDrainStatusUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusUnauthorized ", 401)
+}
+
+func (o *NodesDrainStatusUnauthorized) String() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusUnauthorized ", 401)
+}
+
+func (o *NodesDrainStatusUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewNodesDrainStatusForbidden creates a NodesDrainStatusForbidden with default headers values
+func NewNodesDrainStatusForbidden() *NodesDrainStatusForbidden {
+	return &NodesDrainStatusForbidden{}
+}
+
+/*
+NodesDrainStatusForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type NodesDrainStatusForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain status forbidden response has a 2xx status code
+func (o *NodesDrainStatusForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain status forbidden response has a 3xx status code
+func (o *NodesDrainStatusForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain status forbidden response has a 4xx status code
+func (o *NodesDrainStatusForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain status forbidden response has a 5xx status code
+func (o *NodesDrainStatusForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain status forbidden response a status code equal to that given
+func (o *NodesDrainStatusForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the nodes drain status forbidden response
+func (o *NodesDrainStatusForbidden) Code() int {
+	return 403
+}
+
+func (o *NodesDrainStatusForbidden) Error() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesDrainStatusForbidden) String() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesDrainStatusForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainStatusForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainStatusNotFound creates a NodesDrainStatusNotFound with default headers values
+func NewNodesDrainStatusNotFound() *NodesDrainStatusNotFound {
+	return &NodesDrainStatusNotFound{}
+}
+
+/*
+NodesDrainStatusNotFound describes a response with status code 404, with default header values.
+
+Not Found - Node does not exist
+*/
+type NodesDrainStatusNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain status not found response has a 2xx status code
+func (o *NodesDrainStatusNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain status not found response has a 3xx status code
+func (o *NodesDrainStatusNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain status not found response has a 4xx status code
+func (o *NodesDrainStatusNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain status not found response has a 5xx status code
+func (o *NodesDrainStatusNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain status not found response a status code equal to that given
+func (o *NodesDrainStatusNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the nodes drain status not found response
+func (o *NodesDrainStatusNotFound) Code() int {
+	return 404
+}
+
+func (o *NodesDrainStatusNotFound) Error() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesDrainStatusNotFound) String() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesDrainStatusNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainStatusNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainStatusUnprocessableEntity creates a NodesDrainStatusUnprocessableEntity with default headers values
+func NewNodesDrainStatusUnprocessableEntity() *NodesDrainStatusUnprocessableEntity {
+	return &NodesDrainStatusUnprocessableEntity{}
+}
+
+/*
+NodesDrainStatusUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid drain status request.
+*/
+type NodesDrainStatusUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain status unprocessable entity response has a 2xx status code
+func (o *NodesDrainStatusUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain status unprocessable entity response has a 3xx status code
+func (o *NodesDrainStatusUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain status unprocessable entity response has a 4xx status code
+func (o *NodesDrainStatusUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes drain status unprocessable entity response has a 5xx status code
+func (o *NodesDrainStatusUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes drain status unprocessable entity response a status code equal to that given
+func (o *NodesDrainStatusUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the nodes drain status unprocessable entity response
+func (o *NodesDrainStatusUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *NodesDrainStatusUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesDrainStatusUnprocessableEntity) String() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesDrainStatusUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainStatusUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDrainStatusInternalServerError creates a NodesDrainStatusInternalServerError with default headers values
+func NewNodesDrainStatusInternalServerError() *NodesDrainStatusInternalServerError {
+	return &NodesDrainStatusInternalServerError{}
+}
+
+/*
+NodesDrainStatusInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type NodesDrainStatusInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes drain status internal server error response has a 2xx status code
+func (o *NodesDrainStatusInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes drain status internal server error response has a 3xx status code
+func (o *NodesDrainStatusInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes drain status internal server error response has a 4xx status code
+func (o *NodesDrainStatusInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes drain status internal server error response has a 5xx status code
+func (o *NodesDrainStatusInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this nodes drain status internal server error response a status code equal to that given
+func (o *NodesDrainStatusInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the nodes drain status internal server error response
+func (o *NodesDrainStatusInternalServerError) Code() int {
+	return 500
+}
+
+func (o *NodesDrainStatusInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesDrainStatusInternalServerError) String() string {
+	return fmt.Sprintf("[GET /nodes/{nodeName}/drain][%d] nodesDrainStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesDrainStatusInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDrainStatusInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
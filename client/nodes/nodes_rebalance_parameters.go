@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewNodesRebalanceParams creates a new NodesRebalanceParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewNodesRebalanceParams() *NodesRebalanceParams {
+	return &NodesRebalanceParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewNodesRebalanceParamsWithTimeout creates a new NodesRebalanceParams object
+// with the ability to set a timeout on a request.
+func NewNodesRebalanceParamsWithTimeout(timeout time.Duration) *NodesRebalanceParams {
+	return &NodesRebalanceParams{
+		timeout: timeout,
+	}
+}
+
+// NewNodesRebalanceParamsWithContext creates a new NodesRebalanceParams object
+// with the ability to set a context for a request.
+func NewNodesRebalanceParamsWithContext(ctx context.Context) *NodesRebalanceParams {
+	return &NodesRebalanceParams{
+		Context: ctx,
+	}
+}
+
+// NewNodesRebalanceParamsWithHTTPClient creates a new NodesRebalanceParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewNodesRebalanceParamsWithHTTPClient(client *http.Client) *NodesRebalanceParams {
+	return &NodesRebalanceParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+NodesRebalanceParams contains all the parameters to send to the API endpoint
+
+	for the nodes rebalance operation.
+
+	Typically these are written to a http.Request.
+*/
+type NodesRebalanceParams struct {
+
+	/* DryRun.
+
+	   If true, the moves are only planned and returned but not executed.
+	*/
+	DryRun *bool
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the nodes rebalance params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *NodesRebalanceParams) WithDefaults() *NodesRebalanceParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the nodes rebalance params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *NodesRebalanceParams) SetDefaults() {
+	var (
+		dryRunDefault = bool(false)
+	)
+
+	val := NodesRebalanceParams{
+		DryRun: &dryRunDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the nodes rebalance params
+func (o *NodesRebalanceParams) WithTimeout(timeout time.Duration) *NodesRebalanceParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the nodes rebalance params
+func (o *NodesRebalanceParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the nodes rebalance params
+func (o *NodesRebalanceParams) WithContext(ctx context.Context) *NodesRebalanceParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the nodes rebalance params
+func (o *NodesRebalanceParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the nodes rebalance params
+func (o *NodesRebalanceParams) WithHTTPClient(client *http.Client) *NodesRebalanceParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the nodes rebalance params
+func (o *NodesRebalanceParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDryRun adds the dryRun to the nodes rebalance params
+func (o *NodesRebalanceParams) WithDryRun(dryRun *bool) *NodesRebalanceParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the nodes rebalance params
+func (o *NodesRebalanceParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *NodesRebalanceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.DryRun != nil {
+
+		// query param dryRun
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dryRun", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
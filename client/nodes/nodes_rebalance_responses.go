@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesRebalanceReader is a Reader for the NodesRebalance structure.
+type NodesRebalanceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *NodesRebalanceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewNodesRebalanceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewNodesRebalanceUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewNodesRebalanceForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewNodesRebalanceUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewNodesRebalanceInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewNodesRebalanceOK creates a NodesRebalanceOK with default headers values
+func NewNodesRebalanceOK() *NodesRebalanceOK {
+	return &NodesRebalanceOK{}
+}
+
+/*
+NodesRebalanceOK describes a response with status code 200, with default header values.
+
+Shard replicas successfully rebalanced
+*/
+type NodesRebalanceOK struct {
+	Payload *models.NodesRebalanceResponse
+}
+
+// IsSuccess returns true when this nodes rebalance o k response has a 2xx status code
+func (o *NodesRebalanceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this nodes rebalance o k response has a 3xx status code
+func (o *NodesRebalanceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes rebalance o k response has a 4xx status code
+func (o *NodesRebalanceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes rebalance o k response has a 5xx status code
+func (o *NodesRebalanceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes rebalance o k response a status code equal to that given
+func (o *NodesRebalanceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the nodes rebalance o k response
+func (o *NodesRebalanceOK) Code() int {
+	return 200
+}
+
+func (o *NodesRebalanceOK) Error() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesRebalanceOK) String() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesRebalanceOK) GetPayload() *models.NodesRebalanceResponse {
+	return o.Payload
+}
+
+func (o *NodesRebalanceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NodesRebalanceResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesRebalanceUnauthorized creates a NodesRebalanceUnauthorized with default headers values
+func NewNodesRebalanceUnauthorized() *NodesRebalanceUnauthorized {
+	return &NodesRebalanceUnauthorized{}
+}
+
+/*
+NodesRebalanceUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type NodesRebalanceUnauthorized struct {
+}
+
+// IsSuccess returns true when this nodes rebalance unauthorized response has a 2xx status code
+func (o *NodesRebalanceUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes rebalance unauthorized response has a 3xx status code
+func (o *NodesRebalanceUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes rebalance unauthorized response has a 4xx status code
+func (o *NodesRebalanceUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes rebalance unauthorized response has a 5xx status code
+func (o *NodesRebalanceUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes rebalance unauthorized response a status code equal to that given
+func (o *NodesRebalanceUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the nodes rebalance unauthorized response
+func (o *NodesRebalanceUnauthorized) Code() int {
+	return 401
+}
+
+func (o *NodesRebalanceUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceUnauthorized ", 401)
+}
+
+func (o *NodesRebalanceUnauthorized) String() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceUnauthorized ", 401)
+}
+
+func (o *NodesRebalanceUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewNodesRebalanceForbidden creates a NodesRebalanceForbidden with default headers values
+func NewNodesRebalanceForbidden() *NodesRebalanceForbidden {
+	return &NodesRebalanceForbidden{}
+}
+
+/*
+NodesRebalanceForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type NodesRebalanceForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes rebalance forbidden response has a 2xx status code
+func (o *NodesRebalanceForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes rebalance forbidden response has a 3xx status code
+func (o *NodesRebalanceForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes rebalance forbidden response has a 4xx status code
+func (o *NodesRebalanceForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes rebalance forbidden response has a 5xx status code
+func (o *NodesRebalanceForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes rebalance forbidden response a status code equal to that given
+func (o *NodesRebalanceForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the nodes rebalance forbidden response
+func (o *NodesRebalanceForbidden) Code() int {
+	return 403
+}
+
+func (o *NodesRebalanceForbidden) Error() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesRebalanceForbidden) String() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesRebalanceForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesRebalanceForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesRebalanceUnprocessableEntity creates a NodesRebalanceUnprocessableEntity with default headers values
+func NewNodesRebalanceUnprocessableEntity() *NodesRebalanceUnprocessableEntity {
+	return &NodesRebalanceUnprocessableEntity{}
+}
+
+/*
+NodesRebalanceUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid rebalance attempt.
+*/
+type NodesRebalanceUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes rebalance unprocessable entity response has a 2xx status code
+func (o *NodesRebalanceUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes rebalance unprocessable entity response has a 3xx status code
+func (o *NodesRebalanceUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes rebalance unprocessable entity response has a 4xx status code
+func (o *NodesRebalanceUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes rebalance unprocessable entity response has a 5xx status code
+func (o *NodesRebalanceUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes rebalance unprocessable entity response a status code equal to that given
+func (o *NodesRebalanceUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the nodes rebalance unprocessable entity response
+func (o *NodesRebalanceUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *NodesRebalanceUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesRebalanceUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesRebalanceUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesRebalanceUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesRebalanceInternalServerError creates a NodesRebalanceInternalServerError with default headers values
+func NewNodesRebalanceInternalServerError() *NodesRebalanceInternalServerError {
+	return &NodesRebalanceInternalServerError{}
+}
+
+/*
+NodesRebalanceInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type NodesRebalanceInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes rebalance internal server error response has a 2xx status code
+func (o *NodesRebalanceInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes rebalance internal server error response has a 3xx status code
+func (o *NodesRebalanceInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes rebalance internal server error response has a 4xx status code
+func (o *NodesRebalanceInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes rebalance internal server error response has a 5xx status code
+func (o *NodesRebalanceInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this nodes rebalance internal server error response a status code equal to that given
+func (o *NodesRebalanceInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the nodes rebalance internal server error response
+func (o *NodesRebalanceInternalServerError) Code() int {
+	return 500
+}
+
+func (o *NodesRebalanceInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesRebalanceInternalServerError) String() string {
+	return fmt.Sprintf("[POST /nodes/rebalance][%d] nodesRebalanceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesRebalanceInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesRebalanceInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ type Properties struct {
 	Classification     bool                   `json:"classification"`
 	RefMeta            bool                   `json:"refMeta"`
 	Vector             bool                   `json:"vector"`
+	Vectors            []string               `json:"vectors"`
 	Certainty          bool                   `json:"certainty"`
 	ID                 bool                   `json:"id"`
 	CreationTimeUnix   bool                   `json:"creationTimeUnix"`
@@ -33,6 +34,7 @@ type Properties struct {
 	Distance           bool                   `json:"distance"`
 	Score              bool                   `json:"score"`
 	ExplainScore       bool                   `json:"explainScore"`
+	Highlight          bool                   `json:"highlight"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
 
@@ -34,6 +34,7 @@ type Params struct {
 	NearVector       *searchparams.NearVector   `json:"nearVector"`
 	NearObject       *searchparams.NearObject   `json:"nearObject"`
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
+	TargetVector     string                     `json:"targetVector"`
 }
 
 type ParamProperty struct {
@@ -21,13 +21,20 @@ type NodeDescriptor struct {
 	Classes []string `json:"classes"`
 	Status  Status   `json:"status"`
 	Error   string   `json:"error"`
+	// BytesTransferred and BytesReused are the number of bytes of files
+	// uploaded by the node and of files referenced from the base backup
+	BytesTransferred int64 `json:"bytesTransferred,omitempty"`
+	BytesReused      int64 `json:"bytesReused,omitempty"`
 }
 
 // DistributedBAckupDescriptor contains everything need to completely restore a distributed backup
 type DistributedBackupDescriptor struct {
 	StartedAt     time.Time                  `json:"startedAt"`
 	CompletedAt   time.Time                  `json:"completedAt"`
-	ID            string                     `json:"id"` // User created backup id
+	ID            string                     `json:"id"`               // User created backup id
+	BaseID        string                     `json:"baseId,omitempty"` // Base of an incremental backup
+	ArchiveWAL    bool                       `json:"archiveWal,omitempty"`
+	PointInTime   *time.Time                 `json:"pointInTime,omitempty"` // Restored point in time
 	Nodes         map[string]*NodeDescriptor `json:"nodes"`
 	Status        Status                     `json:"status"`  //
 	Version       string                     `json:"version"` //
@@ -49,6 +56,15 @@ func (d *DistributedBackupDescriptor) Count() int {
 	return count
 }
 
+// Bytes returns the number of bytes transferred and reused by all nodes
+func (d *DistributedBackupDescriptor) Bytes() (transferred, reused int64) {
+	for _, desc := range d.Nodes {
+		transferred += desc.BytesTransferred
+		reused += desc.BytesReused
+	}
+	return transferred, reused
+}
+
 // RemoveEmpty removes any nodes with an empty class list
 func (d *DistributedBackupDescriptor) RemoveEmpty() *DistributedBackupDescriptor {
 	for node, desc := range d.Nodes {
@@ -187,6 +203,31 @@ type ShardDescriptor struct {
 	ShardVersionPath      string `json:"shardVersionPath,omitempty"`
 	Version               []byte `json:"version,omitempty"`
 	Chunk                 int32  `json:"chunk"`
+
+	// Segments are the immutable LSM segment files of the shard,
+	// including the ones stored by a previous backup
+	Segments []SegmentFile `json:"segments,omitempty"`
+}
+
+// SegmentFile is an immutable LSM segment file. Incremental backups reference
+// segment files of their base backup instead of uploading them again.
+type SegmentFile struct {
+	Path    string `json:"path"`
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"modTime"` // unix nano
+	// BackupID and Chunk locate the file if it is stored by another backup
+	BackupID string `json:"backupId,omitempty"`
+	Chunk    int32  `json:"chunk,omitempty"`
+}
+
+// Reused returns true if the file is stored by another backup
+func (f *SegmentFile) Reused() bool {
+	return f.BackupID != ""
+}
+
+// SameAs returns true if both files have the same path and fingerprint
+func (f *SegmentFile) SameAs(o *SegmentFile) bool {
+	return f.Path == o.Path && f.Size == o.Size && f.ModTime == o.ModTime
 }
 
 // ClearTemporary clears fields that are no longer needed once compression is done.
@@ -216,12 +257,17 @@ type ClassDescriptor struct {
 type BackupDescriptor struct {
 	StartedAt     time.Time         `json:"startedAt"`
 	CompletedAt   time.Time         `json:"completedAt"`
-	ID            string            `json:"id"` // User created backup id
+	ID            string            `json:"id"`               // User created backup id
+	BaseID        string            `json:"baseId,omitempty"` // Base of an incremental backup
+	ArchiveWAL    bool              `json:"archiveWal,omitempty"`
 	Classes       []ClassDescriptor `json:"classes"`
 	Status        string            `json:"status"`  // "STARTED|TRANSFERRING|TRANSFERRED|SUCCESS|FAILED"
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+
+	BytesTransferred int64 `json:"bytesTransferred,omitempty"`
+	BytesReused      int64 `json:"bytesReused,omitempty"`
 }
 
 // List all existing classes in d
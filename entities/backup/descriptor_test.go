@@ -473,3 +473,21 @@ func TestShardDescriptorClear(t *testing.T) {
 	s.ClearTemporary()
 	assert.Equal(t, want, s)
 }
+
+func TestDistributedBackupBytes(t *testing.T) {
+	d := DistributedBackupDescriptor{Nodes: map[string]*NodeDescriptor{
+		"N1": {BytesTransferred: 10, BytesReused: 100},
+		"N2": {BytesTransferred: 5},
+	}}
+	transferred, reused := d.Bytes()
+	assert.Equal(t, int64(15), transferred)
+	assert.Equal(t, int64(100), reused)
+}
+
+func TestSegmentFile(t *testing.T) {
+	f := SegmentFile{Path: "a_lsm/objects/segment-1.db", Size: 10, ModTime: 1}
+	assert.False(t, f.Reused())
+	assert.True(t, f.SameAs(&SegmentFile{Path: f.Path, Size: 10, ModTime: 1, BackupID: "base"}))
+	assert.False(t, f.SameAs(&SegmentFile{Path: f.Path, Size: 11, ModTime: 1}))
+	assert.False(t, f.SameAs(&SegmentFile{Path: f.Path, Size: 10, ModTime: 2}))
+}
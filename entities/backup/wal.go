@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"fmt"
+	"time"
+)
+
+// WALArchiveRef identifies the backup whose commit logs a node archives
+type WALArchiveRef struct {
+	Backend string `json:"backend"`
+	ID      string `json:"id"`
+}
+
+// WALArchive describes the write-ahead logs which a node archives after a
+// base backup. Replaying the logs on top of the backup restores the state
+// of a later point in time.
+type WALArchive struct {
+	ID        string    `json:"id"` // ID of the base backup
+	Node      string    `json:"node"`
+	Classes   []string  `json:"classes"`
+	StartedAt time.Time `json:"startedAt"` // start time of the base backup
+	// ArchivedAt is the time up to which all mutations have been archived
+	ArchivedAt time.Time    `json:"archivedAt"`
+	Segments   []WALSegment `json:"segments,omitempty"`
+	// Error is set if archiving stopped because of an error
+	Error string `json:"error,omitempty"`
+}
+
+// WALSegment contains the log bytes which have been written since the
+// previous segment. All mutations which completed before Time are part of
+// this or of a previous segment.
+type WALSegment struct {
+	Seq    int64      `json:"seq"`
+	Time   time.Time  `json:"time"`
+	Ranges []WALRange `json:"ranges"`
+}
+
+// WALRange is a range of bytes of a commit log. Shard metadata files, such
+// as the doc id counter, are archived as a whole and marked as Snapshot.
+type WALRange struct {
+	Class    string `json:"class"`
+	Path     string `json:"path"`
+	Offset   int64  `json:"offset"`
+	Size     int64  `json:"size"`
+	Snapshot bool   `json:"snapshot,omitempty"`
+}
+
+// Until returns the segments which need to be replayed to restore the
+// state at time t
+func (a *WALArchive) Until(t time.Time) ([]WALSegment, error) {
+	if t.Before(a.StartedAt) {
+		return nil, fmt.Errorf("point in time %s is before base backup %q of node %q started at %s",
+			t.Format(time.RFC3339), a.ID, a.Node, a.StartedAt.Format(time.RFC3339))
+	}
+	if t.After(a.ArchivedAt) {
+		return nil, fmt.Errorf("point in time %s is after the end of the log archive of node %q at %s",
+			t.Format(time.RFC3339), a.Node, a.ArchivedAt.Format(time.RFC3339))
+	}
+	n := 0
+	for n < len(a.Segments) && !a.Segments[n].Time.After(t) {
+		n++
+	}
+	return a.Segments[:n], nil
+}
+
+// ShardWAL lists the commit logs of a shard
+type ShardWAL struct {
+	// Meta contains the metadata files of the shard, its files are not set
+	Meta ShardDescriptor
+	Logs []WALFile
+}
+
+// WALFile is a commit log of a shard. Logs are only ever appended to.
+type WALFile struct {
+	// Path of the log relative to the data path
+	Path string
+	// Retained is the path of the log relative to the data path if it has
+	// been retained after its content was flushed, it is empty otherwise
+	Retained string
+	Size     int64
+}
+
+// Source returns the path the content of the log is read from
+func (f *WALFile) Source() string {
+	if f.Retained != "" {
+		return f.Retained
+	}
+	return f.Path
+}
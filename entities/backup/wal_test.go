@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWALArchiveUntil(t *testing.T) {
+	start := time.Date(2023, 5, 1, 14, 0, 0, 0, time.UTC)
+	archive := WALArchive{
+		ID:         "base",
+		Node:       "N1",
+		StartedAt:  start,
+		ArchivedAt: start.Add(5 * time.Minute),
+		Segments: []WALSegment{
+			{Seq: 1, Time: start.Add(time.Minute)},
+			{Seq: 2, Time: start.Add(2 * time.Minute)},
+			{Seq: 3, Time: start.Add(4 * time.Minute)},
+		},
+	}
+	tests := []struct {
+		name string
+		at   time.Time
+		seqs []int64
+		err  bool
+	}{
+		{name: "before backup", at: start.Add(-time.Second), err: true},
+		{name: "start of backup", at: start, seqs: []int64{}},
+		{name: "time of a segment", at: start.Add(2 * time.Minute), seqs: []int64{1, 2}},
+		{name: "between segments", at: start.Add(3 * time.Minute), seqs: []int64{1, 2}},
+		{name: "end of archive", at: start.Add(5 * time.Minute), seqs: []int64{1, 2, 3}},
+		{name: "after archive", at: start.Add(6 * time.Minute), err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			segments, err := archive.Until(test.at)
+			if test.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			seqs := make([]int64, len(segments))
+			for i, s := range segments {
+				seqs[i] = s.Seq
+			}
+			assert.Equal(t, test.seqs, seqs)
+		})
+	}
+}
@@ -62,9 +62,18 @@ func Prop(p *models.Property) *models.Property {
 		Tokenization:    p.Tokenization,
 		IndexFilterable: ptrBoolCopy(p.IndexFilterable),
 		IndexSearchable: ptrBoolCopy(p.IndexSearchable),
+		TextAnalyzer:    textAnalyzerCopy(p.TextAnalyzer),
 	}
 }
 
+func textAnalyzerCopy(cfg *models.TextAnalyzerConfig) *models.TextAnalyzerConfig {
+	if cfg != nil {
+		c := *cfg
+		return &c
+	}
+	return nil
+}
+
 func ptrBoolCopy(ptrBool *bool) *bool {
 	if ptrBool != nil {
 		b := *ptrBool
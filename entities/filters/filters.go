@@ -34,6 +34,8 @@ const (
 	OperatorIsNull
 	ContainsAny
 	ContainsAll
+	OperatorNot
+	ContainsNone
 )
 
 func (o Operator) OnValue() bool {
@@ -48,7 +50,8 @@ func (o Operator) OnValue() bool {
 		OperatorLike,
 		OperatorIsNull,
 		ContainsAny,
-		ContainsAll:
+		ContainsAll,
+		ContainsNone:
 		return true
 	default:
 		return false
@@ -83,6 +86,10 @@ func (o Operator) Name() string {
 		return "ContainsAny"
 	case ContainsAll:
 		return "ContainsAll"
+	case OperatorNot:
+		return "Not"
+	case ContainsNone:
+		return "ContainsNone"
 	default:
 		panic("Unknown operator")
 	}
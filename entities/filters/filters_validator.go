@@ -42,6 +42,11 @@ func ValidateFilters(sch schema.Schema, filters *LocalFilter) error {
 func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 	// check if nested
 	if cw.getOperands() != nil {
+		if cw.getOperator() == OperatorNot && len(cw.getOperands()) != 1 {
+			return errors.Errorf("operator Not requires exactly one operand, got %d",
+				len(cw.getOperands()))
+		}
+
 		var errs []error
 
 		for i, child := range cw.getOperands() {
@@ -82,6 +87,11 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return err
 	}
 
+	if _, isNested := schema.AsNested(prop.DataType); isNested {
+		return errors.Errorf("filtering on property %q of data type %q is not supported yet",
+			propName, prop.DataType[0])
+	}
+
 	if cw.getOperator() == OperatorIsNull {
 		if !cw.isType(schema.DataTypeBoolean) {
 			return errors.Errorf("operator IsNull requires a booleanValue, got %q instead",
@@ -205,7 +215,7 @@ func validateUUIDOperators(propName schema.PropertyName, cw *clauseWrapper) erro
 
 	switch op {
 	case OperatorEqual, OperatorNotEqual, OperatorLessThan, OperatorLessThanEqual,
-		OperatorGreaterThan, OperatorGreaterThanEqual, ContainsAll, ContainsAny:
+		OperatorGreaterThan, OperatorGreaterThanEqual, ContainsAll, ContainsAny, ContainsNone:
 		return nil
 	default:
 		return fmt.Errorf("operator %q cannot be used on uuid/uuid[] props", op.Name())
@@ -213,6 +213,68 @@ func TestValidateUUIDFilter(t *testing.T) {
 	}
 }
 
+func TestValidateNotOperator(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Car",
+				Properties: []*models.Property{
+					{Name: "horsepower", DataType: []string{"int"}},
+				},
+			},
+		},
+	}}
+	operand := func(value interface{}, dt schema.DataType) Clause {
+		return Clause{
+			Operator: OperatorEqual,
+			Value:    &Value{Value: value, Type: dt},
+			On:       &Path{Class: "Car", Property: "horsepower"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		operands []Clause
+		valid    bool
+	}{
+		{
+			name:     "single operand",
+			operands: []Clause{operand(100, schema.DataTypeInt)},
+			valid:    true,
+		},
+		{
+			name:     "no operands",
+			operands: []Clause{},
+			valid:    false,
+		},
+		{
+			name:     "multiple operands",
+			operands: []Clause{operand(100, schema.DataTypeInt), operand(200, schema.DataTypeInt)},
+			valid:    false,
+		},
+		{
+			name:     "invalid operand",
+			operands: []Clause{operand("100", schema.DataTypeText)},
+			valid:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: OperatorNot,
+				Operands: tt.operands,
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestClauseWrapper(t *testing.T) {
 	type testCase struct {
 		name         string
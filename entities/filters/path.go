@@ -39,6 +39,30 @@ func (p *Path) GetInnerMost() *Path {
 	return p.Child.GetInnerMost()
 }
 
+// ReferencedClasses returns the classes which the clause and its operands
+// reach by following cross-references, i.e. the classes of all child paths.
+// Every class is contained once.
+func (c *Clause) ReferencedClasses() []schema.ClassName {
+	seen := map[schema.ClassName]struct{}{}
+	var out []schema.ClassName
+	var collect func(clause *Clause)
+	collect = func(clause *Clause) {
+		if clause.On != nil {
+			for path := clause.On.Child; path != nil; path = path.Child {
+				if _, ok := seen[path.Class]; !ok {
+					seen[path.Class] = struct{}{}
+					out = append(out, path.Class)
+				}
+			}
+		}
+		for i := range clause.Operands {
+			collect(&clause.Operands[i])
+		}
+	}
+	collect(c)
+	return out
+}
+
 // Slice flattens the nested path into a slice of segments
 func (p *Path) Slice() []string {
 	return appendNestedPath(p, true)
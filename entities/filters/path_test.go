@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 func Test_ParsePath(t *testing.T) {
@@ -144,3 +145,38 @@ func Test_SlicePath(t *testing.T) {
 		})
 	})
 }
+
+func Test_ReferencedClasses(t *testing.T) {
+	clause := &Clause{
+		Operator: OperatorOr,
+		Operands: []Clause{
+			{
+				Operator: OperatorEqual,
+				On:       &Path{Class: "Article", Property: "title"},
+			},
+			{
+				Operator: OperatorNot,
+				Operands: []Clause{{
+					Operator: OperatorEqual,
+					On: &Path{
+						Class: "Article", Property: "writtenBy",
+						Child: &Path{
+							Class: "Author", Property: "livesIn",
+							Child: &Path{Class: "City", Property: "name"},
+						},
+					},
+				}},
+			},
+			{
+				Operator: OperatorEqual,
+				On: &Path{
+					Class: "Article", Property: "editedBy",
+					Child: &Path{Class: "Author", Property: "name"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []schema.ClassName{"Author", "City"}, clause.ReferencedClasses())
+	assert.Empty(t, (&Clause{On: &Path{Class: "Article", Property: "title"}}).ReferencedClasses())
+}
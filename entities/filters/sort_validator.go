@@ -75,6 +75,11 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 			return errors.Errorf("sorting by reference not supported, "+
 				"property %q is a ref prop to the class %q", propName, prop.DataType[0])
 		}
+
+		if _, isNested := schema.AsNested(prop.DataType); isNested {
+			return errors.Errorf("sorting by property %q of data type %q is not supported",
+				propName, prop.DataType[0])
+		}
 		return nil
 	default:
 		return errors.New("sorting by reference not supported, " +
@@ -28,6 +28,12 @@ import (
 // swagger:model BackupCreateRequest
 type BackupCreateRequest struct {
 
+	// Continuously archive the write-ahead logs of the backed up classes after the backup has been created. This allows restoring the classes at a later point in time. Archiving stops when the backup is deleted or when another backup archives its logs, it continues after a restart of the node.
+	ArchiveWal bool `json:"archiveWal,omitempty"`
+
+	// The ID of the base backup of an incremental backup. Segment files which didn't change since the base backup are referenced instead of uploaded again. The base backup must have been created successfully on the same backend.
+	BaseID string `json:"baseId,omitempty"`
+
 	// Custom configuration for the backup creation process
 	Config interface{} `json:"config,omitempty"`
 
@@ -34,6 +34,12 @@ type BackupCreateStatusResponse struct {
 	// Backup backend name e.g. filesystem, gcs, s3.
 	Backend string `json:"backend,omitempty"`
 
+	// number of bytes of files referenced from the base backup instead of being uploaded
+	BytesReused int64 `json:"bytesReused,omitempty"`
+
+	// number of bytes of files uploaded by this backup
+	BytesTransferred int64 `json:"bytesTransferred,omitempty"`
+
 	// error message if creation failed
 	Error string `json:"error,omitempty"`
 
@@ -44,7 +50,7 @@ type BackupCreateStatusResponse struct {
 	Path string `json:"path,omitempty"`
 
 	// phase of backup creation process
-	// Enum: [STARTED TRANSFERRING TRANSFERRED SUCCESS FAILED]
+	// Enum: [STARTED TRANSFERRING TRANSFERRED SUCCESS FAILED CANCELED]
 	Status *string `json:"status,omitempty"`
 }
 
@@ -66,7 +72,7 @@ var backupCreateStatusResponseTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["STARTED","TRANSFERRING","TRANSFERRED","SUCCESS","FAILED"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["STARTED","TRANSFERRING","TRANSFERRED","SUCCESS","FAILED","CANCELED"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -90,6 +96,9 @@ const (
 
 	// BackupCreateStatusResponseStatusFAILED captures enum value "FAILED"
 	BackupCreateStatusResponseStatusFAILED string = "FAILED"
+
+	// BackupCreateStatusResponseStatusCANCELED captures enum value "CANCELED"
+	BackupCreateStatusResponseStatusCANCELED string = "CANCELED"
 )
 
 // prop value enum
@@ -19,8 +19,10 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // BackupRestoreRequest Request body for restoring a backup for a set of classes
@@ -28,6 +30,9 @@ import (
 // swagger:model BackupRestoreRequest
 type BackupRestoreRequest struct {
 
+	// Restore classes under a different name. Maps class names of the backup to the names they are restored as.
+	ClassMapping map[string]string `json:"classMapping,omitempty"`
+
 	// Custom configuration for the backup restoration process
 	Config interface{} `json:"config,omitempty"`
 
@@ -36,10 +41,35 @@ type BackupRestoreRequest struct {
 
 	// List of classes to include in the backup restoration process
 	Include []string `json:"include"`
+
+	// Restore the state of the classes at this point in time by replaying the write-ahead logs archived after the backup. The backup must have been created with archiveWal. Logs are archived every 10 seconds, which is the granularity of the restored state.
+	// Format: date-time
+	PointInTime strfmt.DateTime `json:"pointInTime,omitempty"`
 }
 
 // Validate validates this backup restore request
 func (m *BackupRestoreRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePointInTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupRestoreRequest) validatePointInTime(formats strfmt.Registry) error {
+	if swag.IsZero(m.PointInTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("pointInTime", "body", "date-time", m.PointInTime.String(), formats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
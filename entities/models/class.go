@@ -23,13 +23,13 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Class class
 //
 // swagger:model Class
 type Class struct {
-
 	// Name of the class as URI relative to the schema URL.
 	Class string `json:"class,omitempty"`
 
@@ -54,10 +54,13 @@ type Class struct {
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// Configure named vectors. Either use this field or `vectorizer`, `vectorIndexType`, and `vectorIndexConfig` fields.
+	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
+
 	// Vector-index config, that is specific to the type of index selected in vectorIndexType
 	VectorIndexConfig interface{} `json:"vectorIndexConfig,omitempty"`
 
-	// Name of the vector index to use, eg. (HNSW)
+	// Name of the vector index to use, eg. (HNSW, flat, dynamic)
 	VectorIndexType string `json:"vectorIndexType,omitempty"`
 
 	// Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.
@@ -84,6 +87,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVectorConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -173,6 +180,32 @@ func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorConfig) { // not required
+		return nil
+	}
+
+	for k := range m.VectorConfig {
+
+		if err := validate.Required("vectorConfig"+"."+k, "body", m.VectorConfig[k]); err != nil {
+			return err
+		}
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorConfig" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorConfig" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this class based on the context it is used
 func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -193,6 +226,10 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVectorConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -200,7 +237,6 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 }
 
 func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats strfmt.Registry) error {
-
 	if m.InvertedIndexConfig != nil {
 		if err := m.InvertedIndexConfig.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
@@ -216,7 +252,6 @@ func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats
 }
 
 func (m *Class) contextValidateMultiTenancyConfig(ctx context.Context, formats strfmt.Registry) error {
-
 	if m.MultiTenancyConfig != nil {
 		if err := m.MultiTenancyConfig.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
@@ -232,9 +267,7 @@ func (m *Class) contextValidateMultiTenancyConfig(ctx context.Context, formats s
 }
 
 func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Registry) error {
-
 	for i := 0; i < len(m.Properties); i++ {
-
 		if m.Properties[i] != nil {
 			if err := m.Properties[i].ContextValidate(ctx, formats); err != nil {
 				if ve, ok := err.(*errors.Validation); ok {
@@ -245,14 +278,12 @@ func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Re
 				return err
 			}
 		}
-
 	}
 
 	return nil
 }
 
 func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats strfmt.Registry) error {
-
 	if m.ReplicationConfig != nil {
 		if err := m.ReplicationConfig.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
@@ -267,6 +298,18 @@ func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats st
 	return nil
 }
 
+func (m *Class) contextValidateVectorConfig(ctx context.Context, formats strfmt.Registry) error {
+	for k := range m.VectorConfig {
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
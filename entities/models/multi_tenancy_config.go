@@ -28,6 +28,12 @@ import (
 // swagger:model MultiTenancyConfig
 type MultiTenancyConfig struct {
 
+	// Whether or not COLD tenants are activated when they are read or written
+	AutoTenantActivation bool `json:"autoTenantActivation,omitempty"`
+
+	// Tenants which are neither read nor written for n seconds are deactivated. Requires autoTenantActivation, 0 disables the deactivation
+	AutoTenantDeactivationTimeoutSeconds int64 `json:"autoTenantDeactivationTimeoutSeconds,omitempty"`
+
 	// Whether or not multi-tenancy is enabled for this class
 	Enabled bool `json:"enabled"`
 }
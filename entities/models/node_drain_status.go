@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NodeDrainStatus The status of draining a node
+//
+// swagger:model NodeDrainStatus
+type NodeDrainStatus struct {
+
+	// The error which stopped draining the node.
+	Error string `json:"error,omitempty"`
+
+	// The name of the node.
+	Node string `json:"node,omitempty"`
+
+	// The number of shard replicas still owned by the node.
+	Replicas int64 `json:"replicas"`
+
+	// Multi-tenant classes whose tenant replicas are not moved off the node, moving tenants is not supported. The node keeps draining until these tenants are deleted or removed from the node by other means.
+	SkippedClasses []string `json:"skippedClasses"`
+
+	// The drain status of the node. A drained node owns no shard replicas anymore and is safe to remove.
+	// Enum: [ACTIVE DRAINING DRAINED FAILED]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this node drain status
+func (m *NodeDrainStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var nodeDrainStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ACTIVE","DRAINING","DRAINED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		nodeDrainStatusTypeStatusPropEnum = append(nodeDrainStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// NodeDrainStatusStatusACTIVE captures enum value "ACTIVE"
+	NodeDrainStatusStatusACTIVE string = "ACTIVE"
+
+	// NodeDrainStatusStatusDRAINING captures enum value "DRAINING"
+	NodeDrainStatusStatusDRAINING string = "DRAINING"
+
+	// NodeDrainStatusStatusDRAINED captures enum value "DRAINED"
+	NodeDrainStatusStatusDRAINED string = "DRAINED"
+
+	// NodeDrainStatusStatusFAILED captures enum value "FAILED"
+	NodeDrainStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *NodeDrainStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, nodeDrainStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NodeDrainStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this node drain status based on context it is used
+func (m *NodeDrainStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodeDrainStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodeDrainStatus) UnmarshalBinary(b []byte) error {
+	var res NodeDrainStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -36,6 +36,9 @@ type NodeShardStatus struct {
 
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
+
+	// The number of vectors waiting to be added to the vector index. Only set if asynchronous indexing is enabled.
+	VectorQueueLength int64 `json:"vectorQueueLength"`
 }
 
 // Validate validates this node shard status
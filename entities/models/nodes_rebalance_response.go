@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NodesRebalanceResponse The shard replica moves which even out the load of the nodes
+//
+// swagger:model NodesRebalanceResponse
+type NodesRebalanceResponse struct {
+
+	// Whether the moves were only planned but not executed.
+	DryRun bool `json:"dryRun"`
+
+	// The planned or executed shard replica moves.
+	Moves []*ReplicaMove `json:"moves"`
+}
+
+// Validate validates this nodes rebalance response
+func (m *NodesRebalanceResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMoves(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodesRebalanceResponse) validateMoves(formats strfmt.Registry) error {
+	if swag.IsZero(m.Moves) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Moves); i++ {
+		if swag.IsZero(m.Moves[i]) { // not required
+			continue
+		}
+
+		if m.Moves[i] != nil {
+			if err := m.Moves[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("moves" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("moves" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this nodes rebalance response based on the context it is used
+func (m *NodesRebalanceResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMoves(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodesRebalanceResponse) contextValidateMoves(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Moves); i++ {
+
+		if m.Moves[i] != nil {
+			if err := m.Moves[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("moves" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("moves" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodesRebalanceResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodesRebalanceResponse) UnmarshalBinary(b []byte) error {
+	var res NodesRebalanceResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
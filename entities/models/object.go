@@ -29,7 +29,6 @@ import (
 //
 // swagger:model Object
 type Object struct {
-
 	// additional
 	Additional AdditionalProperties `json:"additional,omitempty"`
 
@@ -57,6 +56,9 @@ type Object struct {
 
 	// vector weights
 	VectorWeights VectorWeights `json:"vectorWeights,omitempty"`
+
+	// This object's named vectors. Only used by classes that configure named vectors through `vectorConfig`.
+	Vectors Vectors `json:"vectors,omitempty"`
 }
 
 // Validate validates this object
@@ -75,6 +77,10 @@ func (m *Object) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVectors(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -129,6 +135,25 @@ func (m *Object) validateVector(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Object) validateVectors(formats strfmt.Registry) error {
+	if swag.IsZero(m.Vectors) { // not required
+		return nil
+	}
+
+	if m.Vectors != nil {
+		if err := m.Vectors.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("vectors")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("vectors")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this object based on the context it is used
 func (m *Object) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -141,6 +166,10 @@ func (m *Object) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVectors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -148,7 +177,6 @@ func (m *Object) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 }
 
 func (m *Object) contextValidateAdditional(ctx context.Context, formats strfmt.Registry) error {
-
 	if err := m.Additional.ContextValidate(ctx, formats); err != nil {
 		if ve, ok := err.(*errors.Validation); ok {
 			return ve.ValidateName("additional")
@@ -162,7 +190,6 @@ func (m *Object) contextValidateAdditional(ctx context.Context, formats strfmt.R
 }
 
 func (m *Object) contextValidateVector(ctx context.Context, formats strfmt.Registry) error {
-
 	if err := m.Vector.ContextValidate(ctx, formats); err != nil {
 		if ve, ok := err.(*errors.Validation); ok {
 			return ve.ValidateName("vector")
@@ -175,6 +202,19 @@ func (m *Object) contextValidateVector(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *Object) contextValidateVectors(ctx context.Context, formats strfmt.Registry) error {
+	if err := m.Vectors.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("vectors")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("vectors")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Object) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Permission permission to perform actions on the resources matching a pattern
+//
+// swagger:model Permission
+type Permission struct {
+
+	// actions which are allowed on the resources. Allowed values are `create`, `get`, `list`, `head`, `update`, `delete`, `validate`, `restore` or `*` for all actions
+	// Required: true
+	Actions []string `json:"actions"`
+
+	// pattern of the resources the actions are allowed on, `*` matches any sequence of characters. Resources are `schema/collections/{class}`, `schema/collections/{class}/shards/{shard}`, `schema/collections/{class}/tenants/{tenant}`, `data/collections/{class}/tenants/{tenant}/objects/{id}`, `backups/{backend}/{id}`, `classifications/{id}`, `cluster/nodes`, `graphql`, `roles/{name}`, `users/{name}` and `groups/{name}`
+	// Required: true
+	Resource *string `json:"resource"`
+}
+
+// Validate validates this permission
+func (m *Permission) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateActions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Permission) validateActions(formats strfmt.Registry) error {
+
+	if err := validate.Required("actions", "body", m.Actions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Permission) validateResource(formats strfmt.Registry) error {
+
+	if err := validate.Required("resource", "body", m.Resource); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this permission based on context it is used
+func (m *Permission) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Permission) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Permission) UnmarshalBinary(b []byte) error {
+	var res Permission
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
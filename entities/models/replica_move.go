@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReplicaMove A shard replica moved from one node to another node
+//
+// swagger:model ReplicaMove
+type ReplicaMove struct {
+
+	// The name of the class the shard belongs to.
+	Class string `json:"class,omitempty"`
+
+	// The node the replica is moved from.
+	From string `json:"from,omitempty"`
+
+	// The name of the shard.
+	Shard string `json:"shard,omitempty"`
+
+	// The node the replica is moved to.
+	To string `json:"to,omitempty"`
+}
+
+// Validate validates this replica move
+func (m *ReplicaMove) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this replica move based on context it is used
+func (m *ReplicaMove) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicaMove) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicaMove) UnmarshalBinary(b []byte) error {
+	var res ReplicaMove
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
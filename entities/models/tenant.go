@@ -31,8 +31,8 @@ import (
 // swagger:model Tenant
 type Tenant struct {
 
-	// activity status of the tenant's shard. Optional for creating tenant (implicit `HOT`) and required for updating tenant. Allowed values are `HOT` - tenant is fully active, `WARM` - tenant is active, some restrictions are imposed (TBD; not supported yet), `COLD` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, `FROZEN` - as COLD, but files are stored on cloud storage (not supported yet)
-	// Enum: [HOT WARM COLD FROZEN]
+	// activity status of the tenant's shard. Optional for creating tenant (implicit `HOT`) and required for updating tenant. Allowed values are `HOT` - tenant is fully active, `WARM` - tenant is active, some restrictions are imposed (TBD; not supported yet), `COLD` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, `FROZEN` - as COLD, but files are stored on the configured tenant offload backend instead of locally. `FREEZING` and `UNFREEZING` are only returned when listing tenants, while the tenant's files are uploaded to or downloaded from the offload backend
+	// Enum: [HOT WARM COLD FROZEN FREEZING UNFREEZING]
 	ActivityStatus string `json:"activityStatus,omitempty"`
 
 	// name of the tenant
@@ -57,7 +57,7 @@ var tenantTypeActivityStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["HOT","WARM","COLD","FROZEN"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["HOT","WARM","COLD","FROZEN","FREEZING","UNFREEZING"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -78,6 +78,12 @@ const (
 
 	// TenantActivityStatusFROZEN captures enum value "FROZEN"
 	TenantActivityStatusFROZEN string = "FROZEN"
+
+	// TenantActivityStatusFREEZING captures enum value "FREEZING"
+	TenantActivityStatusFREEZING string = "FREEZING"
+
+	// TenantActivityStatusUNFREEZING captures enum value "UNFREEZING"
+	TenantActivityStatusUNFREEZING string = "UNFREEZING"
 )
 
 // prop value enum
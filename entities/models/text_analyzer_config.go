@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TextAnalyzerConfig Language-specific analysis of the terms of a text property. It is applied when the property is indexed as well as to filter values and bm25 queries on the property.
+//
+// swagger:model TextAnalyzerConfig
+type TextAnalyzerConfig struct {
+
+	// Whether accents and other diacritics are removed from terms, e.g. `café` is indexed as `cafe`.
+	ASCIIFold bool `json:"asciiFold,omitempty"`
+
+	// Language of the text. English terms (`en`) are reduced to their stem by the Snowball (Porter2) stemmer, e.g. `generously` is indexed as `generous`. Stemming other languages is not supported, their terms can be folded with `asciiFold` and filtered with a `stopwordPreset` instead. Sequences of Chinese, Japanese or Korean characters (`zh`, `ja`, `ko`) are split into overlapping pairs of characters (bigrams), words are not segmented by a dictionary.
+	// Enum: [en zh ja ko]
+	Language string `json:"language,omitempty"`
+
+	// Stopword preset applied to bm25 queries on the property instead of the stopwords of the class, e.g. `de`.
+	StopwordPreset string `json:"stopwordPreset,omitempty"`
+}
+
+// Validate validates this text analyzer config
+func (m *TextAnalyzerConfig) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLanguage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var textAnalyzerConfigTypeLanguagePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["en","zh","ja","ko"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		textAnalyzerConfigTypeLanguagePropEnum = append(textAnalyzerConfigTypeLanguagePropEnum, v)
+	}
+}
+
+const (
+
+	// TextAnalyzerConfigLanguageEn captures enum value "en"
+	TextAnalyzerConfigLanguageEn string = "en"
+
+	// TextAnalyzerConfigLanguageZh captures enum value "zh"
+	TextAnalyzerConfigLanguageZh string = "zh"
+
+	// TextAnalyzerConfigLanguageJa captures enum value "ja"
+	TextAnalyzerConfigLanguageJa string = "ja"
+
+	// TextAnalyzerConfigLanguageKo captures enum value "ko"
+	TextAnalyzerConfigLanguageKo string = "ko"
+)
+
+// prop value enum
+func (m *TextAnalyzerConfig) validateLanguageEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, textAnalyzerConfigTypeLanguagePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TextAnalyzerConfig) validateLanguage(formats strfmt.Registry) error {
+	if swag.IsZero(m.Language) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateLanguageEnum("language", "body", m.Language); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this text analyzer config based on context it is used
+func (m *TextAnalyzerConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TextAnalyzerConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TextAnalyzerConfig) UnmarshalBinary(b []byte) error {
+	var res TextAnalyzerConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -44,4 +44,12 @@ type BackupBackend interface {
 
 	Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error)
+
+	// ListBackups returns the IDs of all backups stored in the backend
+	ListBackups(ctx context.Context) ([]string, error)
+	// DeleteBackup removes all objects of backup backupID
+	DeleteBackup(ctx context.Context, backupID string) error
+	// DeleteObject removes the object with key `key`, a missing object is
+	// not an error
+	DeleteObject(ctx context.Context, backupID, key string) error
 }
@@ -13,6 +13,7 @@ package moduletools
 
 type ObjectDiff struct {
 	oldVec        []float32
+	oldVecs       map[string][]float32
 	oldPropValues map[string]interface{}
 	newPropValues map[string]interface{}
 }
@@ -31,6 +32,22 @@ func (od *ObjectDiff) WithProp(propName string, oldValue, newValue interface{})
 	return od
 }
 
+// WithTargetVectors sets the previous named vectors of the object
+func (od *ObjectDiff) WithTargetVectors(oldVecs map[string][]float32) *ObjectDiff {
+	od.oldVecs = oldVecs
+	return od
+}
+
+// ForTargetVector returns a diff for the given named vector. It shares the
+// property changes, but its previous vector is the previous named vector.
+func (od *ObjectDiff) ForTargetVector(targetVector string) *ObjectDiff {
+	return &ObjectDiff{
+		oldVec:        od.oldVecs[targetVector],
+		oldPropValues: od.oldPropValues,
+		newPropValues: od.newPropValues,
+	}
+}
+
 func (od *ObjectDiff) GetVec() []float32 {
 	return od.oldVec
 }
@@ -55,6 +55,11 @@ const (
 	DataTypeUUID DataType = "uuid"
 	// DataTypeUUIDArray is the array version of DataTypeUUID
 	DataTypeUUIDArray DataType = "uuid[]"
+	// DataTypeObject is a nested JSON object, its structure is described by
+	// the nestedProperties of the property
+	DataTypeObject DataType = "object"
+	// DataTypeObjectArray is the array version of DataTypeObject
+	DataTypeObjectArray DataType = "object[]"
 
 	// deprecated as of v1.19, replaced by DataTypeText + relevant tokenization setting
 	// DataTypeString The data type is a value of type string
@@ -84,11 +89,16 @@ var DeprecatedPrimitiveDataTypes []DataType = []DataType{
 	DataTypeString, DataTypeStringArray,
 }
 
+var NestedDataTypes []DataType = []DataType{
+	DataTypeObject, DataTypeObjectArray,
+}
+
 type PropertyKind int
 
 const (
 	PropertyKindPrimitive PropertyKind = 1
 	PropertyKindRef       PropertyKind = 2
+	PropertyKindNested    PropertyKind = 3
 )
 
 type PropertyDataType interface {
@@ -98,12 +108,15 @@ type PropertyDataType interface {
 	IsReference() bool
 	Classes() []ClassName
 	ContainsClass(name ClassName) bool
+	IsNested() bool
+	AsNested() DataType
 }
 
 type propertyDataType struct {
 	kind          PropertyKind
 	primitiveType DataType
 	classes       []ClassName
+	nestedType    DataType
 }
 
 // IsPropertyLength returns if a string is a filters for property length. They have the form len(*PROPNAME*)
@@ -158,6 +171,18 @@ func (p *propertyDataType) IsReference() bool {
 	return p.kind == PropertyKindRef
 }
 
+func (p *propertyDataType) IsNested() bool {
+	return p.kind == PropertyKindNested
+}
+
+func (p *propertyDataType) AsNested() DataType {
+	if p.kind != PropertyKindNested {
+		panic("not nested type")
+	}
+
+	return p.nestedType
+}
+
 func (p *propertyDataType) Classes() []ClassName {
 	if p.kind != PropertyKindRef {
 		panic("not MultipleRef type")
@@ -212,6 +237,14 @@ func (s *Schema) FindPropertyDataTypeWithRefs(
 				}, nil
 			}
 		}
+		for _, dt := range NestedDataTypes {
+			if dataType[0] == dt.String() {
+				return &propertyDataType{
+					kind:       PropertyKindNested,
+					nestedType: dt,
+				}, nil
+			}
+		}
 		if len(dataType[0]) == 0 {
 			return nil, fmt.Errorf("dataType cannot be an empty string")
 		}
@@ -259,3 +292,23 @@ func AsPrimitive(dataType []string) (DataType, bool) {
 	}
 	return "", false
 }
+
+func AsNested(dataType []string) (DataType, bool) {
+	if len(dataType) == 1 {
+		for _, dt := range NestedDataTypes {
+			if dataType[0] == dt.String() {
+				return dt, true
+			}
+		}
+	}
+	return "", false
+}
+
+func IsNested(dataType DataType) bool {
+	for _, dt := range NestedDataTypes {
+		if dt == dataType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NamedVectorsEnabled indicates whether the class configures its vectors
+// through vectorConfig rather than through the class-level vectorizer and
+// vector index settings
+func NamedVectorsEnabled(class *models.Class) bool {
+	return class != nil && len(class.VectorConfig) > 0
+}
+
+// TargetVectors returns the names of all vectors configured on the class in
+// a stable order
+func TargetVectors(class *models.Class) []string {
+	if !NamedVectorsEnabled(class) {
+		return nil
+	}
+
+	names := make([]string, 0, len(class.VectorConfig))
+	for name := range class.VectorConfig {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NamedVectorizer extracts the vectorizer module name and its config from a
+// named vector config. The vectorizer is set as an object with a single key,
+// the module name, such as {"text2vec-contextionary": {...}}. If no
+// vectorizer is set "none" is returned.
+func NamedVectorizer(cfg models.VectorConfig) (string, map[string]interface{}, error) {
+	if cfg.Vectorizer == nil {
+		return "none", nil, nil
+	}
+
+	asMap, ok := cfg.Vectorizer.(map[string]interface{})
+	if !ok {
+		return "", nil, fmt.Errorf("vectorizer must be an object, got %T", cfg.Vectorizer)
+	}
+
+	if len(asMap) == 0 {
+		return "none", nil, nil
+	}
+	if len(asMap) > 1 {
+		return "", nil, fmt.Errorf("vectorizer must have exactly one module, got %d", len(asMap))
+	}
+
+	var (
+		module    string
+		moduleCfg interface{}
+	)
+	for module, moduleCfg = range asMap {
+	}
+
+	if moduleCfg == nil {
+		return module, map[string]interface{}{}, nil
+	}
+	asCfgMap, ok := moduleCfg.(map[string]interface{})
+	if !ok {
+		return "", nil, fmt.Errorf("config of vectorizer %q must be an object, got %T",
+			module, moduleCfg)
+	}
+	return module, asCfgMap, nil
+}
+
+// ResolveTargetVector picks the vector a search on the class should run
+// against. Classes without named vectors don't accept a target vector.
+// Classes with named vectors require one, unless they only configure a
+// single vector, in which case that one is used.
+func ResolveTargetVector(class *models.Class, targetVector string) (string, error) {
+	if !NamedVectorsEnabled(class) {
+		if targetVector != "" {
+			return "", fmt.Errorf("class %s does not have named vectors configured, "+
+				"target vector %q is not allowed", class.Class, targetVector)
+		}
+		return "", nil
+	}
+
+	if targetVector == "" {
+		if len(class.VectorConfig) == 1 {
+			for name := range class.VectorConfig {
+				return name, nil
+			}
+		}
+		return "", fmt.Errorf("class %s has multiple vectors, but no target vector was "+
+			"specified, use one of: %v", class.Class, TargetVectors(class))
+	}
+
+	if _, ok := class.VectorConfig[targetVector]; !ok {
+		return "", fmt.Errorf("class %s does not have a vector named %q, use one of: %v",
+			class.Class, targetVector, TargetVectors(class))
+	}
+
+	return targetVector, nil
+}
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_NamedVectorizer(t *testing.T) {
+	t.Run("no vectorizer", func(t *testing.T) {
+		module, cfg, err := NamedVectorizer(models.VectorConfig{})
+		require.Nil(t, err)
+		assert.Equal(t, "none", module)
+		assert.Nil(t, cfg)
+	})
+
+	t.Run("module with config", func(t *testing.T) {
+		module, cfg, err := NamedVectorizer(models.VectorConfig{
+			Vectorizer: map[string]interface{}{
+				"text2vec-contextionary": map[string]interface{}{
+					"vectorizeClassName": false,
+				},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "text2vec-contextionary", module)
+		assert.Equal(t, map[string]interface{}{"vectorizeClassName": false}, cfg)
+	})
+
+	t.Run("module without config", func(t *testing.T) {
+		module, cfg, err := NamedVectorizer(models.VectorConfig{
+			Vectorizer: map[string]interface{}{"text2vec-contextionary": nil},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "text2vec-contextionary", module)
+		assert.Equal(t, map[string]interface{}{}, cfg)
+	})
+
+	t.Run("multiple modules", func(t *testing.T) {
+		_, _, err := NamedVectorizer(models.VectorConfig{
+			Vectorizer: map[string]interface{}{
+				"text2vec-contextionary": nil,
+				"text2vec-openai":        nil,
+			},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("vectorizer is not an object", func(t *testing.T) {
+		_, _, err := NamedVectorizer(models.VectorConfig{Vectorizer: "none"})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_ResolveTargetVector(t *testing.T) {
+	legacy := &models.Class{Class: "Legacy"}
+	single := &models.Class{
+		Class:        "Single",
+		VectorConfig: map[string]models.VectorConfig{"title": {}},
+	}
+	multi := &models.Class{
+		Class: "Multi",
+		VectorConfig: map[string]models.VectorConfig{
+			"title":   {},
+			"content": {},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		class        *models.Class
+		targetVector string
+		expected     string
+		expectErr    bool
+	}{
+		{name: "legacy class without target", class: legacy, expected: ""},
+		{name: "legacy class with target", class: legacy, targetVector: "title", expectErr: true},
+		{name: "single vector defaults", class: single, expected: "title"},
+		{name: "single vector explicit", class: single, targetVector: "title", expected: "title"},
+		{name: "single vector unknown", class: single, targetVector: "content", expectErr: true},
+		{name: "multiple vectors without target", class: multi, expectErr: true},
+		{name: "multiple vectors explicit", class: multi, targetVector: "content", expected: "content"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			targetVector, err := ResolveTargetVector(test.class, test.targetVector)
+			if test.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, targetVector)
+		})
+	}
+}
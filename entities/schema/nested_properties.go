@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NestedPropertyDataTypes are the data types a nested property can have.
+// Cross-references, geo coordinates and phone numbers can only be set on
+// top-level properties.
+var NestedPropertyDataTypes []DataType = []DataType{
+	DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate,
+	DataTypeUUID, DataTypeBlob, DataTypeTextArray, DataTypeIntArray,
+	DataTypeNumberArray, DataTypeBooleanArray, DataTypeDateArray,
+	DataTypeUUIDArray, DataTypeObject, DataTypeObjectArray,
+}
+
+// AsNestedPropertyDataType returns the data type of a nested property, if it
+// is one of NestedPropertyDataTypes
+func AsNestedPropertyDataType(dataType []string) (DataType, bool) {
+	if len(dataType) == 1 {
+		for _, dt := range NestedPropertyDataTypes {
+			if dataType[0] == dt.String() {
+				return dt, true
+			}
+		}
+	}
+	return "", false
+}
+
+// GetNestedPropertyByName returns the nested property with the given name
+func GetNestedPropertyByName(nestedProps []*models.NestedProperty,
+	propName string,
+) (*models.NestedProperty, error) {
+	for _, prop := range nestedProps {
+		if prop.Name == propName {
+			return prop, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no such nested property with name '%s' found", propName)
+}
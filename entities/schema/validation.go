@@ -51,6 +51,18 @@ func ValidatePropertyName(name string) (PropertyName, error) {
 		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
 }
 
+// ValidateNamedVectorName validates that this string is a valid name for a
+// named vector. The names are exposed as GraphQL fields, so they follow the
+// same rules as property names.
+func ValidateNamedVectorName(name string) error {
+	if validatePropertyNameRegex.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid vector name. "+
+		"Vector names in Weaviate are restricted to valid GraphQL names, "+
+		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
+}
+
 // ValidateReservedPropertyName validates that a string is not a reserved property name
 func ValidateReservedPropertyName(name string) error {
 	for i := range reservedPropertyNames {
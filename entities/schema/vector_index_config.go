@@ -11,6 +11,39 @@
 
 package schema
 
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
 type VectorIndexConfig interface {
 	IndexType() string
+	DistanceName() string
+}
+
+// TypeAssertVectorIndex returns the parsed config of the class-level vector
+// index or, if a target vector is set, the one of the named vector
+func TypeAssertVectorIndex(class *models.Class, targetVector string) (VectorIndexConfig, error) {
+	if targetVector != "" {
+		vectorConfig, ok := class.VectorConfig[targetVector]
+		if !ok {
+			return nil, fmt.Errorf("class '%s' vector index: target vector %q not found",
+				class.Class, targetVector)
+		}
+		vectorIndexConfig, ok := vectorConfig.VectorIndexConfig.(VectorIndexConfig)
+		if !ok {
+			return nil, fmt.Errorf("class '%s' vector index %q: config is not schema.VectorIndexConfig: %T",
+				class.Class, targetVector, vectorConfig.VectorIndexConfig)
+		}
+		return vectorIndexConfig, nil
+	}
+
+	vectorIndexConfig, ok := class.VectorIndexConfig.(VectorIndexConfig)
+	if !ok {
+		return nil, fmt.Errorf("class '%s' vector index: config is not schema.VectorIndexConfig: %T",
+			class.Class, class.VectorIndexConfig)
+	}
+
+	return vectorIndexConfig, nil
 }
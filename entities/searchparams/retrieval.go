@@ -23,6 +23,15 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// Fuzziness is the number of edits a term may differ from a query term
+	// by and still match it, 0 matches exact terms only
+	Fuzziness int `json:"fuzziness"`
+	// Prefix matches the last query term as the prefix of terms, i.e. as one
+	// that is still being typed
+	Prefix bool `json:"prefix"`
+	// Highlight marks the query terms in fragments of the matching text
+	// properties of the results
+	Highlight bool `json:"highlight"`
 }
 
 type WeightedSearchResult struct {
@@ -39,6 +48,8 @@ type HybridSearch struct {
 	Vector          []float32   `json:"vector"`
 	Properties      []string    `json:"properties"`
 	FusionAlgorithm int         `json:"fusionalgorithm"`
+	Fuzziness       int         `json:"fuzziness"`
+	Prefix          bool        `json:"prefix"`
 }
 
 type NearObject struct {
@@ -17,86 +17,164 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
-func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
-	if schema == nil {
+// enrichSchemaTypes turns the unmarshalled JSON values of the properties into
+// their Go types. The data types are taken from the class, the ones of
+// properties missing in the class or of objects parsed without a class are
+// guessed from the values.
+func (ko *Object) enrichSchemaTypes(props map[string]interface{}, class *models.Class) error {
+	if props == nil {
 		return nil
 	}
 
-	for propName, value := range schema {
-		switch typed := value.(type) {
-		case []interface{}:
-			if isArrayValue(typed) {
-				switch typed[0].(type) {
-				case float64:
-					parsed, err := parseNumberArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type string array", propName)
-					}
-
-					schema[propName] = parsed
-				case bool:
-					parsed, err := parseBoolArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type boolean array", propName)
-					}
-
-					schema[propName] = parsed
-				default:
-					parsed, err := parseStringArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type string array", propName)
-					}
-
-					schema[propName] = parsed
-				}
-			} else if len(typed) == 0 {
-				// empty arrays. Here we use []interface{} as a placeholder
-				// type for an empty array, since we cannot determine its
-				// actual type. in the future, we should persist the schema
-				// property type information alongside the value to avoid
-				// this situation
-				schema[propName] = typed
-			} else {
-				parsed, err := parseCrossRef(typed)
-				if err != nil {
-					return errors.Wrapf(err, "property %q of type cross-ref", propName)
-				}
-
-				schema[propName] = parsed
-			}
-		case map[string]interface{}:
-			parsed, err := parseMapProp(typed)
-			if err != nil {
-				return errors.Wrapf(err, "property %q of type map", propName)
+	for propName, value := range props {
+		var dataType []string
+		if class != nil {
+			if prop, err := schema.GetPropertyByName(class, propName); err == nil {
+				dataType = prop.DataType
 			}
+		}
 
-			schema[propName] = parsed
-		default:
-			continue
+		var (
+			parsed interface{}
+			err    error
+		)
+		if len(dataType) == 0 {
+			parsed, err = guessSchemaType(propName, value)
+		} else {
+			parsed, err = parseSchemaType(propName, value, dataType)
+		}
+		if err != nil {
+			return err
 		}
+		props[propName] = parsed
 	}
 
 	return nil
 }
 
+// parseSchemaType parses a value of a property of the given data type
+func parseSchemaType(propName string, value interface{}, dataType []string) (interface{}, error) {
+	switch schema.DataType(dataType[0]) {
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		// the values of nested objects are kept as they were unmarshalled
+		return value, nil
+	case schema.DataTypeGeoCoordinates:
+		if typed, ok := value.(map[string]interface{}); ok {
+			parsed, err := parseGeoProp(typed["latitude"], typed["longitude"])
+			return parsed, errors.Wrapf(err, "property %q of type geoCoordinates", propName)
+		}
+	case schema.DataTypePhoneNumber:
+		if typed, ok := value.(map[string]interface{}); ok {
+			parsed, err := parsePhoneNumber(typed)
+			return parsed, errors.Wrapf(err, "property %q of type phoneNumber", propName)
+		}
+	default:
+		if typed, ok := value.([]interface{}); ok && len(typed) == 0 {
+			// empty arrays are kept as placeholders, the same as the ones of
+			// properties of an unknown type
+			return typed, nil
+		}
+		if typed, ok := value.([]interface{}); ok && schema.IsRefDataType(dataType) {
+			parsed, err := parseCrossRef(typed)
+			return parsed, errors.Wrapf(err, "property %q of type cross-ref", propName)
+		}
+		if typed, ok := value.([]interface{}); ok && isArrayValue(typed) {
+			return parseArrayValue(propName, typed)
+		}
+	}
+	return value, nil
+}
+
+// guessSchemaType parses a value of a property of an unknown data type by
+// looking at its shape
+func guessSchemaType(propName string, value interface{}) (interface{}, error) {
+	switch typed := value.(type) {
+	case []interface{}:
+		if isArrayValue(typed) {
+			return parseArrayValue(propName, typed)
+		} else if len(typed) == 0 {
+			// empty arrays. Here we use []interface{} as a placeholder
+			// type for an empty array, since we cannot determine its
+			// actual type. in the future, we should persist the schema
+			// property type information alongside the value to avoid
+			// this situation
+			return typed, nil
+		} else if isCrossRefValue(typed) {
+			parsed, err := parseCrossRef(typed)
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %q of type cross-ref", propName)
+			}
+			return parsed, nil
+		}
+		// an array of nested objects, the values are kept as they were
+		// unmarshalled
+		return typed, nil
+	case map[string]interface{}:
+		parsed, err := parseMapProp(typed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q of type map", propName)
+		}
+		return parsed, nil
+	default:
+		return value, nil
+	}
+}
+
+func parseArrayValue(propName string, value []interface{}) (interface{}, error) {
+	switch value[0].(type) {
+	case float64:
+		parsed, err := parseNumberArrayValue(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q of type string array", propName)
+		}
+		return parsed, nil
+	case bool:
+		parsed, err := parseBoolArrayValue(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q of type boolean array", propName)
+		}
+		return parsed, nil
+	default:
+		parsed, err := parseStringArrayValue(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q of type string array", propName)
+		}
+		return parsed, nil
+	}
+}
+
 func parseMapProp(input map[string]interface{}) (interface{}, error) {
 	lat, latOK := input["latitude"]
 	lon, lonOK := input["longitude"]
 	_, phoneInputOK := input["input"]
 
-	if latOK && lonOK {
+	if latOK && lonOK && len(input) == 2 {
 		// this is a geoCoordinates prop
 		return parseGeoProp(lat, lon)
 	}
 
-	if phoneInputOK {
+	if phoneInputOK && isPhoneNumberValue(input) {
 		// this is a phone number
 		return parsePhoneNumber(input)
 	}
 
-	return nil, fmt.Errorf("unknown map prop which is not a geo prop or phone: %v", input)
+	// this is a nested object, the values are kept as they were unmarshalled
+	return input, nil
+}
+
+func isPhoneNumberValue(input map[string]interface{}) bool {
+	for key := range input {
+		switch key {
+		case "input", "internationalFormatted", "nationalFormatted", "national",
+			"countryCode", "defaultCountry", "valid":
+		default:
+			return false
+		}
+	}
+	return true
 }
 
 func parseGeoProp(lat interface{}, lon interface{}) (*models.GeoCoordinates, error) {
@@ -215,6 +293,15 @@ func isArrayValue(value []interface{}) bool {
 	return false
 }
 
+func isCrossRefValue(value []interface{}) bool {
+	asMap, ok := value[0].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = asMap["beacon"]
+	return ok
+}
+
 func parseStringArrayValue(value []interface{}) ([]string, error) {
 	parsed := make([]string, len(value))
 	for i := range value {
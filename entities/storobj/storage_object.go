@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	"github.com/buger/jsonparser"
 
@@ -34,12 +35,13 @@ import (
 
 type Object struct {
 	MarshallerVersion uint8
-	Object            models.Object `json:"object"`
-	Vector            []float32     `json:"vector"`
-	VectorLen         int           `json:"-"`
-	BelongsToNode     string        `json:"-"`
-	BelongsToShard    string        `json:"-"`
-	IsConsistent      bool          `json:"-"`
+	Object            models.Object        `json:"object"`
+	Vector            []float32            `json:"vector"`
+	VectorLen         int                  `json:"-"`
+	Vectors           map[string][]float32 `json:"vectors"`
+	BelongsToNode     string               `json:"-"`
+	BelongsToShard    string               `json:"-"`
+	IsConsistent      bool                 `json:"-"`
 
 	docID uint64
 }
@@ -63,17 +65,34 @@ func FromObject(object *models.Object, vector []float32) *Object {
 		object.Properties = properties
 	}
 
+	var vectors map[string][]float32
+	if len(object.Vectors) > 0 {
+		vectors = make(map[string][]float32, len(object.Vectors))
+		for name, vec := range object.Vectors {
+			vectors[name] = vec
+		}
+	}
+
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
+		Vectors:           vectors,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 	}
 }
 
 func FromBinary(data []byte) (*Object, error) {
+	return FromBinaryWithClass(data, nil)
+}
+
+// FromBinaryWithClass unmarshals an object like FromBinary, the data types of
+// its properties are taken from the class. Without a class they are guessed
+// from the values, which can't tell nested objects from geo coordinates,
+// phone numbers or cross-references of the same shape.
+func FromBinaryWithClass(data []byte, class *models.Class) (*Object, error) {
 	ko := &Object{}
-	if err := ko.UnmarshalBinary(data); err != nil {
+	if err := ko.unmarshalBinary(data, class); err != nil {
 		return nil, err
 	}
 
@@ -108,8 +127,11 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 	return ko, nil
 }
 
+// FromBinaryOptional unmarshals only the parts of an object that are needed
+// for the additional properties. The data types of the properties are taken
+// from the class if it is not nil, see FromBinaryWithClass.
 func FromBinaryOptional(data []byte,
-	addProp additional.Properties,
+	addProp additional.Properties, class *models.Class,
 ) (*Object, error) {
 	if addProp.NoProps {
 		return FromBinaryUUIDOnly(data)
@@ -180,6 +202,16 @@ func FromBinaryOptional(data []byte,
 	_, err = r.Read(vectorWeights)
 	ec.AddWrap(err, "vector weights")
 
+	if (addProp.Vector || len(addProp.Vectors) > 0) && r.Len() > 0 {
+		var namedVectorsLength uint32
+		ec.AddWrap(binary.Read(r, le, &namedVectorsLength), "named vectors length")
+		namedVectors := make([]byte, namedVectorsLength)
+		_, err = io.ReadFull(r, namedVectors)
+		ec.AddWrap(err, "named vectors")
+		ko.Vectors, err = unmarshalNamedVectors(namedVectors)
+		ec.AddWrap(err, "parse named vectors")
+	}
+
 	if err := ec.ToError(); err != nil {
 		return nil, errors.Wrap(err, "compound err")
 	}
@@ -197,6 +229,7 @@ func FromBinaryOptional(data []byte,
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	); err != nil {
 		return nil, errors.Wrap(err, "parse")
 	}
@@ -209,7 +242,7 @@ type bucket interface {
 }
 
 func ObjectsByDocID(bucket bucket, ids []uint64,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*Object, error) {
 	if bucket == nil {
 		return nil, fmt.Errorf("objects bucket not found")
@@ -232,7 +265,7 @@ func ObjectsByDocID(bucket bucket, ids []uint64,
 			continue
 		}
 
-		unmarshalled, err := FromBinaryOptional(res, additional)
+		unmarshalled, err := FromBinaryOptional(res, additional, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
 		}
@@ -369,6 +402,9 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		if additional.Group {
 			additionalProperties["group"] = ko.AdditionalProperties()["group"]
 		}
+		if highlight := ko.AdditionalProperties()["highlight"]; highlight != nil {
+			additionalProperties["highlight"] = highlight
+		}
 	}
 	if ko.ExplainScore() != "" {
 		additionalProperties["explainScore"] = ko.ExplainScore()
@@ -379,6 +415,7 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
+		Vectors:   ko.Vectors,
 		Dims:      ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
@@ -466,6 +503,12 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 4          | uint32    | length of named vectors section
+// n          | []byte    | named vectors, see marshalNamedVectors
+//
+// The named vectors section was appended later on. Objects written before
+// it existed simply end after the vector weights, which readers need to
+// tolerate.
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -501,8 +544,10 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return nil, err
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
+	namedVectors := marshalNamedVectors(ko.Vectors)
+	namedVectorsLength := uint32(len(namedVectors))
 
-	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength + 4 + namedVectorsLength
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
 	rw.WriteByte(ko.MarshallerVersion)
@@ -542,9 +587,72 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return byteBuffer, errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	rw.WriteUint32(namedVectorsLength)
+	err = rw.CopyBytesToBuffer(namedVectors)
+	if err != nil {
+		return byteBuffer, errors.Wrap(err, "Could not copy named vectors")
+	}
+
 	return byteBuffer, nil
 }
 
+// marshalNamedVectors serializes the named vectors of an object. For each
+// vector it writes a uint16 length of the name, the name, a uint16 length of
+// the vector and the vector as float32s. Names are written in sorted order,
+// so that the same vectors always result in the same bytes.
+func marshalNamedVectors(vectors map[string][]float32) []byte {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(vectors))
+	length := 0
+	for name, vec := range vectors {
+		names = append(names, name)
+		length += 2 + len(name) + 2 + len(vec)*4
+	}
+	sort.Strings(names)
+
+	rw := byteops.NewReadWriter(make([]byte, length))
+	for _, name := range names {
+		vec := vectors[name]
+		rw.WriteUint16(uint16(len(name)))
+		rw.CopyBytesToBuffer([]byte(name))
+		rw.WriteUint16(uint16(len(vec)))
+		for _, v := range vec {
+			rw.WriteUint32(math.Float32bits(v))
+		}
+	}
+
+	return rw.Buffer
+}
+
+func unmarshalNamedVectors(data []byte) (map[string][]float32, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	vectors := map[string][]float32{}
+	rw := byteops.NewReadWriter(data)
+	for rw.Position < uint64(len(data)) {
+		if uint64(len(data))-rw.Position < 2 {
+			return nil, errors.Errorf("corrupt named vectors section")
+		}
+		name := string(rw.ReadBytesFromBuffer(uint64(rw.ReadUint16())))
+		vecLen := int(rw.ReadUint16())
+		if uint64(len(data))-rw.Position < uint64(vecLen*4) {
+			return nil, errors.Errorf("corrupt named vector %q", name)
+		}
+		vec := make([]float32, vecLen)
+		for i := range vec {
+			vec[i] = math.Float32frombits(rw.ReadUint32())
+		}
+		vectors[name] = vec
+	}
+
+	return vectors, nil
+}
+
 // UnmarshalPropertiesFromObject only unmarshals and returns the properties part of the object
 //
 // Check MarshalBinary for the order of elements in the input array
@@ -634,6 +742,10 @@ func parseValues(dt jsonparser.ValueType, value []byte) (interface{}, error) {
 // UnmarshalBinary is the versioned way to unmarshal a kind object from binary,
 // see MarshalBinary for the exact contents of each version
 func (ko *Object) UnmarshalBinary(data []byte) error {
+	return ko.unmarshalBinary(data, nil)
+}
+
+func (ko *Object) unmarshalBinary(data []byte, class *models.Class) error {
 	version := data[0]
 	if version != 1 {
 		return errors.Errorf("unsupported binary marshaller version %d", version)
@@ -684,6 +796,14 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	if rw.Position < uint64(len(data)) {
+		namedVectorsLength := uint64(rw.ReadUint32())
+		ko.Vectors, err = unmarshalNamedVectors(rw.ReadBytesFromBuffer(namedVectorsLength))
+		if err != nil {
+			return errors.Wrap(err, "Could not parse named vectors")
+		}
+	}
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -692,6 +812,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	)
 }
 
@@ -730,15 +851,66 @@ func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
 	return out, nil
 }
 
+// TargetVectorFromBinary is the named vector equivalent of VectorFromBinary.
+// It only parses as much of the named vectors section as is required to find
+// the requested vector. A nil vector is returned if the object has no vector
+// with the given name.
+func TargetVectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	rw := byteops.NewReadWriter(in, byteops.WithPosition(42))
+	vecLen := uint64(rw.ReadUint16())
+	rw.MoveBufferPositionForward(vecLen * 4)
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint16())) // class name
+	rw.DiscardBytesFromBufferWithUint32LengthIndicator()  // schema
+	rw.DiscardBytesFromBufferWithUint32LengthIndicator()  // meta
+	rw.DiscardBytesFromBufferWithUint32LengthIndicator()  // vector weights
+
+	if rw.Position >= uint64(len(in)) {
+		// object was written before named vectors existed
+		return nil, nil
+	}
+
+	end := uint64(rw.ReadUint32()) + rw.Position
+	for rw.Position < end {
+		name := rw.ReadBytesFromBuffer(uint64(rw.ReadUint16()))
+		vecLen := int(rw.ReadUint16())
+		if string(name) != targetVector {
+			rw.MoveBufferPositionForward(uint64(vecLen * 4))
+			continue
+		}
+
+		var out []float32
+		if cap(buffer) >= vecLen {
+			out = buffer[:vecLen]
+		} else {
+			out = make([]float32, vecLen)
+		}
+		for i := range out {
+			out[i] = math.Float32frombits(rw.ReadUint32())
+		}
+		return out, nil
+	}
+
+	return nil, nil
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
-	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
+	schemaB []byte, additionalB []byte, vectorWeightsB []byte, class *models.Class,
 ) error {
 	var schema map[string]interface{}
 	if err := json.Unmarshal(schemaB, &schema); err != nil {
 		return err
 	}
 
-	if err := ko.enrichSchemaTypes(schema); err != nil {
+	if err := ko.enrichSchemaTypes(schema, class); err != nil {
 		return errors.Wrap(err, "enrich schema datatypes")
 	}
 
@@ -825,6 +997,7 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		Vectors:           deepCopyVectors(ko.Vectors),
 	}
 }
 
@@ -841,6 +1014,18 @@ func deepCopyVector(orig []float32) []float32 {
 	return out
 }
 
+func deepCopyVectors(orig map[string][]float32) map[string][]float32 {
+	if orig == nil {
+		return nil
+	}
+
+	out := make(map[string][]float32, len(orig))
+	for name, vec := range orig {
+		out[name] = deepCopyVector(vec)
+	}
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
@@ -142,7 +142,7 @@ func TestStorageObjectUnmarshallingSpecificProps(t *testing.T) {
 	require.Nil(t, err)
 
 	t.Run("without any optional", func(t *testing.T) {
-		after, err := FromBinaryOptional(asBinary, additional.Properties{})
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
 		require.Nil(t, err)
 
 		t.Run("compare", func(t *testing.T) {
@@ -333,6 +333,121 @@ func TestStorageArrayObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageNestedObjectMarshalling(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"variant": map[string]interface{}{
+					"color": "red",
+					"sizes": []interface{}{"S", "M"},
+					"price": map[string]interface{}{
+						"amount":   float64(17),
+						"currency": "EUR",
+					},
+				},
+				"attributes": []interface{}{
+					map[string]interface{}{"name": "material", "value": "cotton"},
+					map[string]interface{}{"name": "input"},
+				},
+				"location": &models.GeoCoordinates{
+					Latitude:  ptFloat32(1.5),
+					Longitude: ptFloat32(2.5),
+				},
+				"ref": models.MultipleRef{
+					&models.SingleRef{Beacon: "weaviate://localhost/73f2eb5f-5abf-447a-81ca-74b1dd168247"},
+				},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+
+	t.Run("compare", func(t *testing.T) {
+		assert.Equal(t, before, after)
+	})
+}
+
+func TestStorageNestedObjectMarshallingWithClass(t *testing.T) {
+	class := &models.Class{
+		Class: "MyFavoriteClass",
+		Properties: []*models.Property{
+			{Name: "position", DataType: schema.DataTypeObject.PropString()},
+			{Name: "contact", DataType: schema.DataTypeObject.PropString()},
+			{Name: "links", DataType: schema.DataTypeObjectArray.PropString()},
+			{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+			{Name: "phone", DataType: schema.DataTypePhoneNumber.PropString()},
+			{Name: "ref", DataType: []string{"MyFavoriteClass"}},
+		},
+	}
+	// nested objects which look like geo coordinates, phone numbers and
+	// cross-refs
+	position := map[string]interface{}{"latitude": 52.366667, "longitude": 4.9}
+	contact := map[string]interface{}{"input": "020 1234567"}
+	links := []interface{}{
+		map[string]interface{}{"beacon": "weaviate://localhost/73f2eb5f-5abf-447a-81ca-74b1dd168247"},
+	}
+
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"position": position,
+				"contact":  contact,
+				"links":    links,
+				"location": &models.GeoCoordinates{
+					Latitude:  ptFloat32(1.5),
+					Longitude: ptFloat32(2.5),
+				},
+				"phone": &models.PhoneNumber{Input: "020 1234567", Valid: true},
+				"ref": models.MultipleRef{
+					&models.SingleRef{Beacon: "weaviate://localhost/73f2eb5f-5abf-447a-81ca-74b1dd168247"},
+				},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("with class", func(t *testing.T) {
+		after, err := FromBinaryWithClass(asBinary, class)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("with class from binary optional", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, class)
+		require.Nil(t, err)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("without class the types are guessed", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		props := after.Properties().(map[string]interface{})
+		assert.IsType(t, &models.GeoCoordinates{}, props["position"])
+		assert.IsType(t, &models.PhoneNumber{}, props["contact"])
+		assert.IsType(t, models.MultipleRef{}, props["links"])
+		assert.Equal(t, before.Object.Properties.(map[string]interface{})["location"], props["location"])
+	})
+}
+
 func TestExtractionOfSingleProperties(t *testing.T) {
 	expected := map[string]interface{}{
 		"numberArray":  []interface{}{1.1, 2.1},
@@ -565,7 +680,7 @@ func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 				require.Nil(t, err)
 
 				t.Run("get without additional properties", func(t *testing.T) {
-					after, err := FromBinaryOptional(asBinary, additional.Properties{})
+					after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
 					require.Nil(t, err)
 					// modify before to match expectations of after
 					before.Object.Additional = nil
@@ -581,7 +696,7 @@ func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 				})
 
 				t.Run("get with additional property vector", func(t *testing.T) {
-					after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true})
+					after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true}, nil)
 					require.Nil(t, err)
 					// modify before to match expectations of after
 					before.Object.Additional = nil
@@ -600,3 +715,58 @@ func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageObjectMarshallingWithNamedVectors(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:            "MyFavoriteClass",
+			CreationTimeUnix: 123456,
+			ID:               strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "myName",
+			},
+			Vectors: models.Vectors{
+				"title":   {1, 2, 3},
+				"content": {4, 5, 6, 7},
+			},
+		},
+		nil,
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("unmarshal full object", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+	})
+
+	t.Run("unmarshal optional without vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+	})
+
+	t.Run("unmarshal optional with vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary,
+			additional.Properties{Vectors: []string{"title"}}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+	})
+
+	t.Run("extract single target vector", func(t *testing.T) {
+		vec, err := TargetVectorFromBinary(asBinary, nil, "content")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{4, 5, 6, 7}, vec)
+
+		vec, err = TargetVectorFromBinary(asBinary, make([]float32, 0, 8), "title")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2, 3}, vec)
+
+		vec, err = TargetVectorFromBinary(asBinary, nil, "unknown")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+	})
+}
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorindex
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	VectorIndexTypeHNSW    = "hnsw"
+	VectorIndexTypeFLAT    = "flat"
+	VectorIndexTypeDYNAMIC = "dynamic"
+
+	DefaultVectorIndexType = VectorIndexTypeHNSW
+)
+
+// ParseAndValidateConfig parses the user-provided vector index config
+// according to the given vector index type
+func ParseAndValidateConfig(input interface{}, vectorIndexType string) (schema.VectorIndexConfig, error) {
+	switch vectorIndexType {
+	case VectorIndexTypeHNSW:
+		return hnsw.ParseAndValidateConfig(input)
+	case VectorIndexTypeFLAT:
+		return flat.ParseAndValidateConfig(input)
+	case VectorIndexTypeDYNAMIC:
+		return dynamic.ParseAndValidateConfig(input)
+	default:
+		return nil, fmt.Errorf("unsupported vector index type: %q", vectorIndexType)
+	}
+}
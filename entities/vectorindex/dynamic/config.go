@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultDistanceMetric = vectorIndexCommon.DefaultDistanceMetric
+	DefaultThreshold      = 10_000
+
+	// Fail validation if those criteria are not met
+	MinimumThreshold = 1
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings.
+// A dynamic index starts out as a flat index and is upgraded to an hnsw index
+// once it holds more than Threshold vectors. The distance is shared by both
+// indexes and overrides the distance of the nested configs.
+type UserConfig struct {
+	Distance  string          `json:"distance"`
+	Threshold int             `json:"threshold"`
+	HnswUC    hnsw.UserConfig `json:"hnsw"`
+	FlatUC    flat.UserConfig `json:"flat"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "dynamic"
+}
+
+// DistanceName returns the distance metric used by the index
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = DefaultDistanceMetric
+	u.Threshold = DefaultThreshold
+	u.HnswUC = hnsw.NewDefaultUserConfig()
+	u.FlatUC = flat.NewDefaultUserConfig()
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "threshold", func(v int) {
+		uc.Threshold = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if hnswConfig, ok := asMap["hnsw"]; ok {
+		parsed, err := hnsw.ParseAndValidateConfig(hnswConfig)
+		if err != nil {
+			return uc, fmt.Errorf("hnsw: %w", err)
+		}
+		uc.HnswUC = parsed.(hnsw.UserConfig)
+	}
+
+	if flatConfig, ok := asMap["flat"]; ok {
+		parsed, err := flat.ParseAndValidateConfig(flatConfig)
+		if err != nil {
+			return uc, fmt.Errorf("flat: %w", err)
+		}
+		uc.FlatUC = parsed.(flat.UserConfig)
+	}
+
+	uc.HnswUC.Distance = uc.Distance
+	uc.FlatUC.Distance = uc.Distance
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	if u.Threshold < MinimumThreshold {
+		return fmt.Errorf("invalid dynamic config: threshold must be a positive "+
+			"integer with a minimum of %d", MinimumThreshold)
+	}
+
+	if u.HnswUC.Skip {
+		return fmt.Errorf("invalid dynamic config: hnsw.skip is not supported, " +
+			"the dynamic index is upgraded to hnsw once it grows past the threshold")
+	}
+
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name      string
+		input     interface{}
+		expected  func() UserConfig
+		expectErr bool
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: func() UserConfig {
+				return UserConfig{
+					Distance:  DefaultDistanceMetric,
+					Threshold: DefaultThreshold,
+					HnswUC:    hnsw.NewDefaultUserConfig(),
+					FlatUC:    flat.NewDefaultUserConfig(),
+				}
+			},
+		},
+		{
+			name: "with distance, threshold and nested configs",
+			input: map[string]interface{}{
+				"distance":  "l2-squared",
+				"threshold": json.Number("500"),
+				"hnsw": map[string]interface{}{
+					"maxConnections": json.Number("16"),
+				},
+				"flat": map[string]interface{}{
+					"bq": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			},
+			expected: func() UserConfig {
+				uc := UserConfig{
+					Distance:  "l2-squared",
+					Threshold: 500,
+					HnswUC:    hnsw.NewDefaultUserConfig(),
+					FlatUC:    flat.NewDefaultUserConfig(),
+				}
+				uc.HnswUC.Distance = "l2-squared"
+				uc.HnswUC.MaxConnections = 16
+				uc.FlatUC.Distance = "l2-squared"
+				uc.FlatUC.BQ.Enabled = true
+				return uc
+			},
+		},
+		{
+			name: "the distance of the nested configs is overridden",
+			input: map[string]interface{}{
+				"hnsw": map[string]interface{}{
+					"distance": "dot",
+				},
+			},
+			expected: func() UserConfig {
+				return UserConfig{
+					Distance:  DefaultDistanceMetric,
+					Threshold: DefaultThreshold,
+					HnswUC:    hnsw.NewDefaultUserConfig(),
+					FlatUC:    flat.NewDefaultUserConfig(),
+				}
+			},
+		},
+		{
+			name: "with invalid threshold",
+			input: map[string]interface{}{
+				"threshold": json.Number("0"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "with hnsw skip",
+			input: map[string]interface{}{
+				"hnsw": map[string]interface{}{
+					"skip": true,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "with invalid nested config",
+			input: map[string]interface{}{
+				"flat": map[string]interface{}{
+					"bq": map[string]interface{}{
+						"rescoreLimit": json.Number("0"),
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected(), cfg)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name      string
+		input     interface{}
+		expected  UserConfig
+		expectErr bool
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance: DefaultDistanceMetric,
+				BQ: BQConfig{
+					Enabled:      DefaultBQEnabled,
+					RescoreLimit: DefaultBQRescoreLimit,
+				},
+			},
+		},
+		{
+			name: "with distance and bq",
+			input: map[string]interface{}{
+				"distance": "l2-squared",
+				"bq": map[string]interface{}{
+					"enabled":      true,
+					"rescoreLimit": json.Number("50"),
+				},
+			},
+			expected: UserConfig{
+				Distance: "l2-squared",
+				BQ: BQConfig{
+					Enabled:      true,
+					RescoreLimit: 50,
+				},
+			},
+		},
+		{
+			name: "with rescore limit as float64 from the REST API",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"rescoreLimit": float64(20),
+				},
+			},
+			expected: UserConfig{
+				Distance: DefaultDistanceMetric,
+				BQ: BQConfig{
+					Enabled:      DefaultBQEnabled,
+					RescoreLimit: 20,
+				},
+			},
+		},
+		{
+			name: "with invalid rescore limit",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"rescoreLimit": json.Number("0"),
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, cfg)
+		})
+	}
+}
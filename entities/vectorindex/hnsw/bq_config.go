@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+const (
+	DefaultBQEnabled = false
+)
+
+// Binary Quantization configuration. Unlike product quantization it does not
+// require a training phase, so it can only be enabled when the index is
+// created and is active from the very first insert.
+type BQConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+func parseBQMap(in map[string]interface{}, bq *BQConfig) error {
+	bqConfigValue, ok := in["bq"]
+	if !ok {
+		return nil
+	}
+
+	bqConfigMap, ok := bqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(bqConfigMap, "enabled", func(v bool) {
+		bq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -18,18 +18,17 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/weaviate/weaviate/entities/models"
-
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/schema"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
 const (
-	DistanceCosine    = "cosine"
-	DistanceDot       = "dot"
-	DistanceL2Squared = "l2-squared"
-	DistanceManhattan = "manhattan"
-	DistanceHamming   = "hamming"
+	DistanceCosine    = vectorIndexCommon.DistanceCosine
+	DistanceDot       = vectorIndexCommon.DistanceDot
+	DistanceL2Squared = vectorIndexCommon.DistanceL2Squared
+	DistanceManhattan = vectorIndexCommon.DistanceManhattan
+	DistanceHamming   = vectorIndexCommon.DistanceHamming
 )
 
 const (
@@ -65,6 +64,7 @@ type UserConfig struct {
 	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
 	Distance               string   `json:"distance"`
 	PQ                     PQConfig `json:"pq"`
+	BQ                     BQConfig `json:"bq"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -73,6 +73,11 @@ func (u UserConfig) IndexType() string {
 	return "hnsw"
 }
 
+// DistanceName returns the distance metric used by the index
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.MaxConnections = DefaultMaxConnections
@@ -97,6 +102,9 @@ func (u *UserConfig) SetDefaults() {
 			Distribution: DefaultPQEncoderDistribution,
 		},
 	}
+	u.BQ = BQConfig{
+		Enabled: DefaultBQEnabled,
+	}
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -184,6 +192,10 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseBQMap(asMap, &uc.BQ); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -203,6 +215,10 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	if u.PQ.Enabled && u.BQ.Enabled {
+		errMsgs = append(errMsgs, "pq and bq cannot be enabled at the same time")
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -286,13 +302,3 @@ func NewDefaultUserConfig() UserConfig {
 	uc.SetDefaults()
 	return uc
 }
-
-func TypeAssertVectorIndex(class *models.Class) (UserConfig, error) {
-	hnswConfig, ok := class.VectorIndexConfig.(UserConfig)
-	if !ok {
-		return UserConfig{}, fmt.Errorf("class '%s' vector index: config is not hnsw.UserConfig: %T",
-			class.Class, class.VectorIndexConfig)
-	}
-
-	return hnswConfig, nil
-}
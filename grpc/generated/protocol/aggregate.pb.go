@@ -0,0 +1,2854 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AggregateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassName string `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Tenant    string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// the number of objects in the collection or in each group
+	ObjectsCount bool                            `protobuf:"varint,3,opt,name=objects_count,json=objectsCount,proto3" json:"objects_count,omitempty"`
+	Aggregations []*AggregateRequest_Aggregation `protobuf:"bytes,4,rep,name=aggregations,proto3" json:"aggregations,omitempty"`
+	// the number of groups to return, only applies together with group_by
+	Limit   *uint32                   `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	GroupBy *AggregateRequest_GroupBy `protobuf:"bytes,6,opt,name=group_by,json=groupBy,proto3,oneof" json:"group_by,omitempty"`
+	Filters *Filters                  `protobuf:"bytes,7,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	// the maximum number of objects to aggregate over, only applies together with
+	// a near search
+	ObjectLimit *uint32                `protobuf:"varint,8,opt,name=object_limit,json=objectLimit,proto3,oneof" json:"object_limit,omitempty"`
+	NearVector  *NearVectorParams      `protobuf:"bytes,9,opt,name=near_vector,json=nearVector,proto3,oneof" json:"near_vector,omitempty"`
+	NearObject  *NearObjectParams      `protobuf:"bytes,10,opt,name=near_object,json=nearObject,proto3,oneof" json:"near_object,omitempty"`
+	NearText    *NearTextSearchParams  `protobuf:"bytes,11,opt,name=near_text,json=nearText,proto3,oneof" json:"near_text,omitempty"`
+	NearImage   *NearImageSearchParams `protobuf:"bytes,12,opt,name=near_image,json=nearImage,proto3,oneof" json:"near_image,omitempty"`
+	NearAudio   *NearAudioSearchParams `protobuf:"bytes,13,opt,name=near_audio,json=nearAudio,proto3,oneof" json:"near_audio,omitempty"`
+	NearVideo   *NearVideoSearchParams `protobuf:"bytes,14,opt,name=near_video,json=nearVideo,proto3,oneof" json:"near_video,omitempty"`
+}
+
+func (x *AggregateRequest) Reset() {
+	*x = AggregateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest) ProtoMessage() {}
+
+func (x *AggregateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest.ProtoReflect.Descriptor instead.
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AggregateRequest) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetObjectsCount() bool {
+	if x != nil {
+		return x.ObjectsCount
+	}
+	return false
+}
+
+func (x *AggregateRequest) GetAggregations() []*AggregateRequest_Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *AggregateRequest) GetGroupBy() *AggregateRequest_GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetObjectLimit() uint32 {
+	if x != nil && x.ObjectLimit != nil {
+		return *x.ObjectLimit
+	}
+	return 0
+}
+
+func (x *AggregateRequest) GetNearVector() *NearVectorParams {
+	if x != nil {
+		return x.NearVector
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearObject() *NearObjectParams {
+	if x != nil {
+		return x.NearObject
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearText() *NearTextSearchParams {
+	if x != nil {
+		return x.NearText
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearImage() *NearImageSearchParams {
+	if x != nil {
+		return x.NearImage
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearAudio() *NearAudioSearchParams {
+	if x != nil {
+		return x.NearAudio
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearVideo() *NearVideoSearchParams {
+	if x != nil {
+		return x.NearVideo
+	}
+	return nil
+}
+
+type AggregateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took float32 `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	// Types that are assignable to Result:
+	//	*AggregateReply_SingleResult
+	//	*AggregateReply_GroupedResults
+	Result isAggregateReply_Result `protobuf_oneof:"result"`
+}
+
+func (x *AggregateReply) Reset() {
+	*x = AggregateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply) ProtoMessage() {}
+
+func (x *AggregateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply.ProtoReflect.Descriptor instead.
+func (*AggregateReply) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AggregateReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (m *AggregateReply) GetResult() isAggregateReply_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *AggregateReply) GetSingleResult() *AggregateReply_Single {
+	if x, ok := x.GetResult().(*AggregateReply_SingleResult); ok {
+		return x.SingleResult
+	}
+	return nil
+}
+
+func (x *AggregateReply) GetGroupedResults() *AggregateReply_Grouped {
+	if x, ok := x.GetResult().(*AggregateReply_GroupedResults); ok {
+		return x.GroupedResults
+	}
+	return nil
+}
+
+type isAggregateReply_Result interface {
+	isAggregateReply_Result()
+}
+
+type AggregateReply_SingleResult struct {
+	SingleResult *AggregateReply_Single `protobuf:"bytes,2,opt,name=single_result,json=singleResult,proto3,oneof"`
+}
+
+type AggregateReply_GroupedResults struct {
+	GroupedResults *AggregateReply_Grouped `protobuf:"bytes,3,opt,name=grouped_results,json=groupedResults,proto3,oneof"`
+}
+
+func (*AggregateReply_SingleResult) isAggregateReply_Result() {}
+
+func (*AggregateReply_GroupedResults) isAggregateReply_Result() {}
+
+type AggregateRequest_Aggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	// Types that are assignable to Aggregation:
+	//	*AggregateRequest_Aggregation_Int
+	//	*AggregateRequest_Aggregation_Number_
+	//	*AggregateRequest_Aggregation_Text_
+	//	*AggregateRequest_Aggregation_Boolean_
+	//	*AggregateRequest_Aggregation_Date_
+	//	*AggregateRequest_Aggregation_Reference_
+	Aggregation isAggregateRequest_Aggregation_Aggregation `protobuf_oneof:"aggregation"`
+}
+
+func (x *AggregateRequest_Aggregation) Reset() {
+	*x = AggregateRequest_Aggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest_Aggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AggregateRequest_Aggregation) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (m *AggregateRequest_Aggregation) GetAggregation() isAggregateRequest_Aggregation_Aggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return nil
+}
+
+func (x *AggregateRequest_Aggregation) GetInt() *AggregateRequest_Aggregation_Integer {
+	if x, ok := x.GetAggregation().(*AggregateRequest_Aggregation_Int); ok {
+		return x.Int
+	}
+	return nil
+}
+
+func (x *AggregateRequest_Aggregation) GetNumber() *AggregateRequest_Aggregation_Number {
+	if x, ok := x.GetAggregation().(*AggregateRequest_Aggregation_Number_); ok {
+		return x.Number
+	}
+	return nil
+}
+
+func (x *AggregateRequest_Aggregation) GetText() *AggregateRequest_Aggregation_Text {
+	if x, ok := x.GetAggregation().(*AggregateRequest_Aggregation_Text_); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *AggregateRequest_Aggregation) GetBoolean() *AggregateRequest_Aggregation_Boolean {
+	if x, ok := x.GetAggregation().(*AggregateRequest_Aggregation_Boolean_); ok {
+		return x.Boolean
+	}
+	return nil
+}
+
+func (x *AggregateRequest_Aggregation) GetDate() *AggregateRequest_Aggregation_Date {
+	if x, ok := x.GetAggregation().(*AggregateRequest_Aggregation_Date_); ok {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *AggregateRequest_Aggregation) GetReference() *AggregateRequest_Aggregation_Reference {
+	if x, ok := x.GetAggregation().(*AggregateRequest_Aggregation_Reference_); ok {
+		return x.Reference
+	}
+	return nil
+}
+
+type isAggregateRequest_Aggregation_Aggregation interface {
+	isAggregateRequest_Aggregation_Aggregation()
+}
+
+type AggregateRequest_Aggregation_Int struct {
+	Int *AggregateRequest_Aggregation_Integer `protobuf:"bytes,2,opt,name=int,proto3,oneof"`
+}
+
+type AggregateRequest_Aggregation_Number_ struct {
+	Number *AggregateRequest_Aggregation_Number `protobuf:"bytes,3,opt,name=number,proto3,oneof"`
+}
+
+type AggregateRequest_Aggregation_Text_ struct {
+	Text *AggregateRequest_Aggregation_Text `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+type AggregateRequest_Aggregation_Boolean_ struct {
+	Boolean *AggregateRequest_Aggregation_Boolean `protobuf:"bytes,5,opt,name=boolean,proto3,oneof"`
+}
+
+type AggregateRequest_Aggregation_Date_ struct {
+	Date *AggregateRequest_Aggregation_Date `protobuf:"bytes,6,opt,name=date,proto3,oneof"`
+}
+
+type AggregateRequest_Aggregation_Reference_ struct {
+	Reference *AggregateRequest_Aggregation_Reference `protobuf:"bytes,7,opt,name=reference,proto3,oneof"`
+}
+
+func (*AggregateRequest_Aggregation_Int) isAggregateRequest_Aggregation_Aggregation() {}
+
+func (*AggregateRequest_Aggregation_Number_) isAggregateRequest_Aggregation_Aggregation() {}
+
+func (*AggregateRequest_Aggregation_Text_) isAggregateRequest_Aggregation_Aggregation() {}
+
+func (*AggregateRequest_Aggregation_Boolean_) isAggregateRequest_Aggregation_Aggregation() {}
+
+func (*AggregateRequest_Aggregation_Date_) isAggregateRequest_Aggregation_Aggregation() {}
+
+func (*AggregateRequest_Aggregation_Reference_) isAggregateRequest_Aggregation_Aggregation() {}
+
+type AggregateRequest_GroupBy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+}
+
+func (x *AggregateRequest_GroupBy) Reset() {
+	*x = AggregateRequest_GroupBy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest_GroupBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_GroupBy) ProtoMessage() {}
+
+func (x *AggregateRequest_GroupBy) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_GroupBy.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_GroupBy) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *AggregateRequest_GroupBy) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+type AggregateRequest_Aggregation_Integer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   bool `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Type    bool `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Sum     bool `protobuf:"varint,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Mean    bool `protobuf:"varint,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Mode    bool `protobuf:"varint,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	Median  bool `protobuf:"varint,6,opt,name=median,proto3" json:"median,omitempty"`
+	Maximum bool `protobuf:"varint,7,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	Minimum bool `protobuf:"varint,8,opt,name=minimum,proto3" json:"minimum,omitempty"`
+}
+
+func (x *AggregateRequest_Aggregation_Integer) Reset() {
+	*x = AggregateRequest_Aggregation_Integer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest_Aggregation_Integer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation_Integer) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation_Integer) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation_Integer.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation_Integer) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *AggregateRequest_Aggregation_Integer) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Integer) GetType() bool {
+	if x != nil {
+		return x.Type
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Integer) GetSum() bool {
+	if x != nil {
+		return x.Sum
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Integer) GetMean() bool {
+	if x != nil {
+		return x.Mean
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Integer) GetMode() bool {
+	if x != nil {
+		return x.Mode
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Integer) GetMedian() bool {
+	if x != nil {
+		return x.Median
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Integer) GetMaximum() bool {
+	if x != nil {
+		return x.Maximum
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Integer) GetMinimum() bool {
+	if x != nil {
+		return x.Minimum
+	}
+	return false
+}
+
+type AggregateRequest_Aggregation_Number struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   bool `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Type    bool `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Sum     bool `protobuf:"varint,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Mean    bool `protobuf:"varint,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Mode    bool `protobuf:"varint,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	Median  bool `protobuf:"varint,6,opt,name=median,proto3" json:"median,omitempty"`
+	Maximum bool `protobuf:"varint,7,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	Minimum bool `protobuf:"varint,8,opt,name=minimum,proto3" json:"minimum,omitempty"`
+}
+
+func (x *AggregateRequest_Aggregation_Number) Reset() {
+	*x = AggregateRequest_Aggregation_Number{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest_Aggregation_Number) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation_Number) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation_Number) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation_Number.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation_Number) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *AggregateRequest_Aggregation_Number) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Number) GetType() bool {
+	if x != nil {
+		return x.Type
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Number) GetSum() bool {
+	if x != nil {
+		return x.Sum
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Number) GetMean() bool {
+	if x != nil {
+		return x.Mean
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Number) GetMode() bool {
+	if x != nil {
+		return x.Mode
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Number) GetMedian() bool {
+	if x != nil {
+		return x.Median
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Number) GetMaximum() bool {
+	if x != nil {
+		return x.Maximum
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Number) GetMinimum() bool {
+	if x != nil {
+		return x.Minimum
+	}
+	return false
+}
+
+type AggregateRequest_Aggregation_Text struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count               bool    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Type                bool    `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	TopOccurrences      bool    `protobuf:"varint,3,opt,name=top_occurrences,json=topOccurrences,proto3" json:"top_occurrences,omitempty"`
+	TopOccurrencesLimit *uint32 `protobuf:"varint,4,opt,name=top_occurrences_limit,json=topOccurrencesLimit,proto3,oneof" json:"top_occurrences_limit,omitempty"`
+}
+
+func (x *AggregateRequest_Aggregation_Text) Reset() {
+	*x = AggregateRequest_Aggregation_Text{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest_Aggregation_Text) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation_Text) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation_Text) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation_Text.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation_Text) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *AggregateRequest_Aggregation_Text) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Text) GetType() bool {
+	if x != nil {
+		return x.Type
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Text) GetTopOccurrences() bool {
+	if x != nil {
+		return x.TopOccurrences
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Text) GetTopOccurrencesLimit() uint32 {
+	if x != nil && x.TopOccurrencesLimit != nil {
+		return *x.TopOccurrencesLimit
+	}
+	return 0
+}
+
+type AggregateRequest_Aggregation_Boolean struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count           bool `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Type            bool `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	TotalTrue       bool `protobuf:"varint,3,opt,name=total_true,json=totalTrue,proto3" json:"total_true,omitempty"`
+	TotalFalse      bool `protobuf:"varint,4,opt,name=total_false,json=totalFalse,proto3" json:"total_false,omitempty"`
+	PercentageTrue  bool `protobuf:"varint,5,opt,name=percentage_true,json=percentageTrue,proto3" json:"percentage_true,omitempty"`
+	PercentageFalse bool `protobuf:"varint,6,opt,name=percentage_false,json=percentageFalse,proto3" json:"percentage_false,omitempty"`
+}
+
+func (x *AggregateRequest_Aggregation_Boolean) Reset() {
+	*x = AggregateRequest_Aggregation_Boolean{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest_Aggregation_Boolean) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation_Boolean) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation_Boolean) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation_Boolean.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation_Boolean) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0, 0, 3}
+}
+
+func (x *AggregateRequest_Aggregation_Boolean) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Boolean) GetType() bool {
+	if x != nil {
+		return x.Type
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Boolean) GetTotalTrue() bool {
+	if x != nil {
+		return x.TotalTrue
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Boolean) GetTotalFalse() bool {
+	if x != nil {
+		return x.TotalFalse
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Boolean) GetPercentageTrue() bool {
+	if x != nil {
+		return x.PercentageTrue
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Boolean) GetPercentageFalse() bool {
+	if x != nil {
+		return x.PercentageFalse
+	}
+	return false
+}
+
+type AggregateRequest_Aggregation_Date struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   bool `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Type    bool `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Median  bool `protobuf:"varint,3,opt,name=median,proto3" json:"median,omitempty"`
+	Mode    bool `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Maximum bool `protobuf:"varint,5,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	Minimum bool `protobuf:"varint,6,opt,name=minimum,proto3" json:"minimum,omitempty"`
+}
+
+func (x *AggregateRequest_Aggregation_Date) Reset() {
+	*x = AggregateRequest_Aggregation_Date{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest_Aggregation_Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation_Date) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation_Date) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation_Date.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation_Date) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0, 0, 4}
+}
+
+func (x *AggregateRequest_Aggregation_Date) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Date) GetType() bool {
+	if x != nil {
+		return x.Type
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Date) GetMedian() bool {
+	if x != nil {
+		return x.Median
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Date) GetMode() bool {
+	if x != nil {
+		return x.Mode
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Date) GetMaximum() bool {
+	if x != nil {
+		return x.Maximum
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Date) GetMinimum() bool {
+	if x != nil {
+		return x.Minimum
+	}
+	return false
+}
+
+type AggregateRequest_Aggregation_Reference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       bool `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	PointingTo bool `protobuf:"varint,2,opt,name=pointing_to,json=pointingTo,proto3" json:"pointing_to,omitempty"`
+}
+
+func (x *AggregateRequest_Aggregation_Reference) Reset() {
+	*x = AggregateRequest_Aggregation_Reference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest_Aggregation_Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest_Aggregation_Reference) ProtoMessage() {}
+
+func (x *AggregateRequest_Aggregation_Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregation_Reference.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation_Reference) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{0, 0, 5}
+}
+
+func (x *AggregateRequest_Aggregation_Reference) GetType() bool {
+	if x != nil {
+		return x.Type
+	}
+	return false
+}
+
+func (x *AggregateRequest_Aggregation_Reference) GetPointingTo() bool {
+	if x != nil {
+		return x.PointingTo
+	}
+	return false
+}
+
+type AggregateReply_Aggregations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aggregations []*AggregateReply_Aggregations_Aggregation `protobuf:"bytes,1,rep,name=aggregations,proto3" json:"aggregations,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations) Reset() {
+	*x = AggregateReply_Aggregations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *AggregateReply_Aggregations) GetAggregations() []*AggregateReply_Aggregations_Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
+type AggregateReply_Single struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjectsCount *int64                       `protobuf:"varint,1,opt,name=objects_count,json=objectsCount,proto3,oneof" json:"objects_count,omitempty"`
+	Aggregations *AggregateReply_Aggregations `protobuf:"bytes,2,opt,name=aggregations,proto3" json:"aggregations,omitempty"`
+}
+
+func (x *AggregateReply_Single) Reset() {
+	*x = AggregateReply_Single{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Single) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Single) ProtoMessage() {}
+
+func (x *AggregateReply_Single) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Single.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Single) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *AggregateReply_Single) GetObjectsCount() int64 {
+	if x != nil && x.ObjectsCount != nil {
+		return *x.ObjectsCount
+	}
+	return 0
+}
+
+func (x *AggregateReply_Single) GetAggregations() *AggregateReply_Aggregations {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
+type AggregateReply_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjectsCount *int64                          `protobuf:"varint,1,opt,name=objects_count,json=objectsCount,proto3,oneof" json:"objects_count,omitempty"`
+	Aggregations *AggregateReply_Aggregations    `protobuf:"bytes,2,opt,name=aggregations,proto3" json:"aggregations,omitempty"`
+	GroupedBy    *AggregateReply_Group_GroupedBy `protobuf:"bytes,3,opt,name=grouped_by,json=groupedBy,proto3" json:"grouped_by,omitempty"`
+}
+
+func (x *AggregateReply_Group) Reset() {
+	*x = AggregateReply_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Group) ProtoMessage() {}
+
+func (x *AggregateReply_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Group.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Group) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *AggregateReply_Group) GetObjectsCount() int64 {
+	if x != nil && x.ObjectsCount != nil {
+		return *x.ObjectsCount
+	}
+	return 0
+}
+
+func (x *AggregateReply_Group) GetAggregations() *AggregateReply_Aggregations {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
+func (x *AggregateReply_Group) GetGroupedBy() *AggregateReply_Group_GroupedBy {
+	if x != nil {
+		return x.GroupedBy
+	}
+	return nil
+}
+
+type AggregateReply_Grouped struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*AggregateReply_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *AggregateReply_Grouped) Reset() {
+	*x = AggregateReply_Grouped{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Grouped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Grouped) ProtoMessage() {}
+
+func (x *AggregateReply_Grouped) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Grouped.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Grouped) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *AggregateReply_Grouped) GetGroups() []*AggregateReply_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type AggregateReply_Aggregations_Aggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	// Types that are assignable to Aggregation:
+	//	*AggregateReply_Aggregations_Aggregation_Int
+	//	*AggregateReply_Aggregations_Aggregation_Number_
+	//	*AggregateReply_Aggregations_Aggregation_Text_
+	//	*AggregateReply_Aggregations_Aggregation_Boolean_
+	//	*AggregateReply_Aggregations_Aggregation_Date_
+	//	*AggregateReply_Aggregations_Aggregation_Reference_
+	Aggregation isAggregateReply_Aggregations_Aggregation_Aggregation `protobuf_oneof:"aggregation"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (m *AggregateReply_Aggregations_Aggregation) GetAggregation() isAggregateReply_Aggregations_Aggregation_Aggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return nil
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) GetInt() *AggregateReply_Aggregations_Aggregation_Integer {
+	if x, ok := x.GetAggregation().(*AggregateReply_Aggregations_Aggregation_Int); ok {
+		return x.Int
+	}
+	return nil
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) GetNumber() *AggregateReply_Aggregations_Aggregation_Number {
+	if x, ok := x.GetAggregation().(*AggregateReply_Aggregations_Aggregation_Number_); ok {
+		return x.Number
+	}
+	return nil
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) GetText() *AggregateReply_Aggregations_Aggregation_Text {
+	if x, ok := x.GetAggregation().(*AggregateReply_Aggregations_Aggregation_Text_); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) GetBoolean() *AggregateReply_Aggregations_Aggregation_Boolean {
+	if x, ok := x.GetAggregation().(*AggregateReply_Aggregations_Aggregation_Boolean_); ok {
+		return x.Boolean
+	}
+	return nil
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) GetDate() *AggregateReply_Aggregations_Aggregation_Date {
+	if x, ok := x.GetAggregation().(*AggregateReply_Aggregations_Aggregation_Date_); ok {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *AggregateReply_Aggregations_Aggregation) GetReference() *AggregateReply_Aggregations_Aggregation_Reference {
+	if x, ok := x.GetAggregation().(*AggregateReply_Aggregations_Aggregation_Reference_); ok {
+		return x.Reference
+	}
+	return nil
+}
+
+type isAggregateReply_Aggregations_Aggregation_Aggregation interface {
+	isAggregateReply_Aggregations_Aggregation_Aggregation()
+}
+
+type AggregateReply_Aggregations_Aggregation_Int struct {
+	Int *AggregateReply_Aggregations_Aggregation_Integer `protobuf:"bytes,2,opt,name=int,proto3,oneof"`
+}
+
+type AggregateReply_Aggregations_Aggregation_Number_ struct {
+	Number *AggregateReply_Aggregations_Aggregation_Number `protobuf:"bytes,3,opt,name=number,proto3,oneof"`
+}
+
+type AggregateReply_Aggregations_Aggregation_Text_ struct {
+	Text *AggregateReply_Aggregations_Aggregation_Text `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+type AggregateReply_Aggregations_Aggregation_Boolean_ struct {
+	Boolean *AggregateReply_Aggregations_Aggregation_Boolean `protobuf:"bytes,5,opt,name=boolean,proto3,oneof"`
+}
+
+type AggregateReply_Aggregations_Aggregation_Date_ struct {
+	Date *AggregateReply_Aggregations_Aggregation_Date `protobuf:"bytes,6,opt,name=date,proto3,oneof"`
+}
+
+type AggregateReply_Aggregations_Aggregation_Reference_ struct {
+	Reference *AggregateReply_Aggregations_Aggregation_Reference `protobuf:"bytes,7,opt,name=reference,proto3,oneof"`
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Int) isAggregateReply_Aggregations_Aggregation_Aggregation() {
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Number_) isAggregateReply_Aggregations_Aggregation_Aggregation() {
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Text_) isAggregateReply_Aggregations_Aggregation_Aggregation() {
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Boolean_) isAggregateReply_Aggregations_Aggregation_Aggregation() {
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Date_) isAggregateReply_Aggregations_Aggregation_Aggregation() {
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Reference_) isAggregateReply_Aggregations_Aggregation_Aggregation() {
+}
+
+type AggregateReply_Aggregations_Aggregation_Integer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   *int64   `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Type    *string  `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Mean    *float64 `protobuf:"fixed64,3,opt,name=mean,proto3,oneof" json:"mean,omitempty"`
+	Median  *float64 `protobuf:"fixed64,4,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode    *int64   `protobuf:"varint,5,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum *int64   `protobuf:"varint,6,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum *int64   `protobuf:"varint,7,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Sum     *int64   `protobuf:"varint,8,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_Integer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Integer) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_Integer.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_Integer) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 0}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetMean() float64 {
+	if x != nil && x.Mean != nil {
+		return *x.Mean
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetMedian() float64 {
+	if x != nil && x.Median != nil {
+		return *x.Median
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetMode() int64 {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetMaximum() int64 {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetMinimum() int64 {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Integer) GetSum() int64 {
+	if x != nil && x.Sum != nil {
+		return *x.Sum
+	}
+	return 0
+}
+
+type AggregateReply_Aggregations_Aggregation_Number struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   *int64   `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Type    *string  `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Mean    *float64 `protobuf:"fixed64,3,opt,name=mean,proto3,oneof" json:"mean,omitempty"`
+	Median  *float64 `protobuf:"fixed64,4,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode    *float64 `protobuf:"fixed64,5,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum *float64 `protobuf:"fixed64,6,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum *float64 `protobuf:"fixed64,7,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Sum     *float64 `protobuf:"fixed64,8,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_Number{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Number) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_Number.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_Number) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 1}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetMean() float64 {
+	if x != nil && x.Mean != nil {
+		return *x.Mean
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetMedian() float64 {
+	if x != nil && x.Median != nil {
+		return *x.Median
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetMode() float64 {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetMaximum() float64 {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetMinimum() float64 {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Number) GetSum() float64 {
+	if x != nil && x.Sum != nil {
+		return *x.Sum
+	}
+	return 0
+}
+
+type AggregateReply_Aggregations_Aggregation_Text struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count          *int64                                                       `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Type           *string                                                      `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	TopOccurrences *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences `protobuf:"bytes,3,opt,name=top_occurrences,json=topOccurrences,proto3,oneof" json:"top_occurrences,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_Text{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Text) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_Text.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_Text) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 2}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text) GetTopOccurrences() *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences {
+	if x != nil {
+		return x.TopOccurrences
+	}
+	return nil
+}
+
+type AggregateReply_Aggregations_Aggregation_Boolean struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count           *int64   `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Type            *string  `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	TotalTrue       *int64   `protobuf:"varint,3,opt,name=total_true,json=totalTrue,proto3,oneof" json:"total_true,omitempty"`
+	TotalFalse      *int64   `protobuf:"varint,4,opt,name=total_false,json=totalFalse,proto3,oneof" json:"total_false,omitempty"`
+	PercentageTrue  *float64 `protobuf:"fixed64,5,opt,name=percentage_true,json=percentageTrue,proto3,oneof" json:"percentage_true,omitempty"`
+	PercentageFalse *float64 `protobuf:"fixed64,6,opt,name=percentage_false,json=percentageFalse,proto3,oneof" json:"percentage_false,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_Boolean{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Boolean) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_Boolean.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_Boolean) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 3}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) GetTotalTrue() int64 {
+	if x != nil && x.TotalTrue != nil {
+		return *x.TotalTrue
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) GetTotalFalse() int64 {
+	if x != nil && x.TotalFalse != nil {
+		return *x.TotalFalse
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) GetPercentageTrue() float64 {
+	if x != nil && x.PercentageTrue != nil {
+		return *x.PercentageTrue
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Boolean) GetPercentageFalse() float64 {
+	if x != nil && x.PercentageFalse != nil {
+		return *x.PercentageFalse
+	}
+	return 0
+}
+
+type AggregateReply_Aggregations_Aggregation_Date struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   *int64  `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Type    *string `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Median  *string `protobuf:"bytes,3,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode    *string `protobuf:"bytes,4,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum *string `protobuf:"bytes,5,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum *string `protobuf:"bytes,6,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_Date{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Date) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_Date.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_Date) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 4}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) GetMedian() string {
+	if x != nil && x.Median != nil {
+		return *x.Median
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) GetMaximum() string {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Date) GetMinimum() string {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return ""
+}
+
+type AggregateReply_Aggregations_Aggregation_Reference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	PointingTo []string `protobuf:"bytes,2,rep,name=pointing_to,json=pointingTo,proto3" json:"pointing_to,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Reference) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_Reference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Reference) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_Reference.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_Reference) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 5}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Reference) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Reference) GetPointingTo() []string {
+	if x != nil {
+		return x.PointingTo
+	}
+	return nil
+}
+
+type AggregateReply_Aggregations_Aggregation_Text_TopOccurrences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_Text_TopOccurrences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_Text_TopOccurrences.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 2, 0}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences) GetItems() []*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value  string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Occurs int64  `protobuf:"varint,2,opt,name=occurs,proto3" json:"occurs,omitempty"`
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) Reset() {
+	*x = AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) ProtoMessage() {}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 0, 0, 2, 0, 0}
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence) GetOccurs() int64 {
+	if x != nil {
+		return x.Occurs
+	}
+	return 0
+}
+
+type AggregateReply_Group_GroupedBy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	// Types that are assignable to Value:
+	//	*AggregateReply_Group_GroupedBy_Text
+	//	*AggregateReply_Group_GroupedBy_Int
+	//	*AggregateReply_Group_GroupedBy_Boolean
+	//	*AggregateReply_Group_GroupedBy_Number
+	Value isAggregateReply_Group_GroupedBy_Value `protobuf_oneof:"value"`
+}
+
+func (x *AggregateReply_Group_GroupedBy) Reset() {
+	*x = AggregateReply_Group_GroupedBy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aggregate_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Group_GroupedBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Group_GroupedBy) ProtoMessage() {}
+
+func (x *AggregateReply_Group_GroupedBy) ProtoReflect() protoreflect.Message {
+	mi := &file_aggregate_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Group_GroupedBy.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Group_GroupedBy) Descriptor() ([]byte, []int) {
+	return file_aggregate_proto_rawDescGZIP(), []int{1, 2, 0}
+}
+
+func (x *AggregateReply_Group_GroupedBy) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (m *AggregateReply_Group_GroupedBy) GetValue() isAggregateReply_Group_GroupedBy_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *AggregateReply_Group_GroupedBy) GetText() string {
+	if x, ok := x.GetValue().(*AggregateReply_Group_GroupedBy_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AggregateReply_Group_GroupedBy) GetInt() int64 {
+	if x, ok := x.GetValue().(*AggregateReply_Group_GroupedBy_Int); ok {
+		return x.Int
+	}
+	return 0
+}
+
+func (x *AggregateReply_Group_GroupedBy) GetBoolean() bool {
+	if x, ok := x.GetValue().(*AggregateReply_Group_GroupedBy_Boolean); ok {
+		return x.Boolean
+	}
+	return false
+}
+
+func (x *AggregateReply_Group_GroupedBy) GetNumber() float64 {
+	if x, ok := x.GetValue().(*AggregateReply_Group_GroupedBy_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+type isAggregateReply_Group_GroupedBy_Value interface {
+	isAggregateReply_Group_GroupedBy_Value()
+}
+
+type AggregateReply_Group_GroupedBy_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type AggregateReply_Group_GroupedBy_Int struct {
+	Int int64 `protobuf:"varint,3,opt,name=int,proto3,oneof"`
+}
+
+type AggregateReply_Group_GroupedBy_Boolean struct {
+	Boolean bool `protobuf:"varint,4,opt,name=boolean,proto3,oneof"`
+}
+
+type AggregateReply_Group_GroupedBy_Number struct {
+	Number float64 `protobuf:"fixed64,5,opt,name=number,proto3,oneof"`
+}
+
+func (*AggregateReply_Group_GroupedBy_Text) isAggregateReply_Group_GroupedBy_Value() {}
+
+func (*AggregateReply_Group_GroupedBy_Int) isAggregateReply_Group_GroupedBy_Value() {}
+
+func (*AggregateReply_Group_GroupedBy_Boolean) isAggregateReply_Group_GroupedBy_Value() {}
+
+func (*AggregateReply_Group_GroupedBy_Number) isAggregateReply_Group_GroupedBy_Value() {}
+
+var File_aggregate_proto protoreflect.FileDescriptor
+
+var file_aggregate_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x1a,
+	0x10, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xab, 0x13, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x48, 0x01, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x02, 0x52,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x03, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x6e, 0x65, 0x61,
+	0x72, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65,
+	0x61, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x05,
+	0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x44, 0x0a, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x06, 0x52, 0x08, 0x6e, 0x65, 0x61, 0x72, 0x54, 0x65,
+	0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x07,
+	0x52, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47,
+	0x0a, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x08, 0x52, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x5f,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x48, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x88, 0x01, 0x01,
+	0x1a, 0xc6, 0x0b, 0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x03,
+	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x03, 0x69, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x45, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x78, 0x74,
+	0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4e, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c,
+	0x65, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x48, 0x00, 0x52,
+	0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x45, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x54, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0xb9, 0x01, 0x0a, 0x07, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x65, 0x61,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x1a, 0xb8, 0x01, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x1a, 0xac, 0x01, 0x0a,
+	0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x4f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x15, 0x74, 0x6f, 0x70, 0x5f,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x13, 0x74, 0x6f, 0x70, 0x4f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0xc7, 0x01, 0x0a, 0x07,
+	0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61, 0x6c, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x72, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x54, 0x72, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x46, 0x61, 0x6c, 0x73, 0x65, 0x1a, 0x90, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x1a, 0x40, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x42, 0x0d, 0x0a, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x25, 0x0a, 0x07, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22,
+	0xcf, 0x18, 0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x12, 0x4a, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x4f, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x1a, 0xb7, 0x12, 0x0a, 0x0c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0xcb, 0x11, 0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x03, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12, 0x56,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x78, 0x74,
+	0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x59, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c,
+	0x65, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c,
+	0x65, 0x61, 0x6e, 0x12, 0x50, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0xb1, 0x02, 0x0a, 0x07, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x03, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x07, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x75, 0x6d, 0x1a, 0xb0, 0x02, 0x0a, 0x06, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x65, 0x61,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x17, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x06, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x07, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x75, 0x6d, 0x1a, 0x9b, 0x03,
+	0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x77, 0x0a, 0x0f, 0x74, 0x6f,
+	0x70, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x2e,
+	0x54, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x48, 0x02,
+	0x52, 0x0e, 0x74, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x1a, 0xbe, 0x01, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x57, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x2e, 0x54, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x2e, 0x54, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x3d, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x6f, 0x70, 0x5f,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0xc0, 0x02, 0x0a, 0x07,
+	0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x02, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61, 0x6c,
+	0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04,
+	0x52, 0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x54, 0x72, 0x75, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52,
+	0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x74, 0x72, 0x75, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x1a, 0xed,
+	0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x1a, 0x4e,
+	0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x6f, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d,
+	0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x93, 0x01,
+	0x0a, 0x06, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x4d, 0x0a, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0xea, 0x02, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x28, 0x0a,
+	0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x42, 0x79, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x64, 0x42, 0x79, 0x1a, 0x88, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x69,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x1a, 0x45, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x69, 0x0a, 0x19, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x16,
+	0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_aggregate_proto_rawDescOnce sync.Once
+	file_aggregate_proto_rawDescData = file_aggregate_proto_rawDesc
+)
+
+func file_aggregate_proto_rawDescGZIP() []byte {
+	file_aggregate_proto_rawDescOnce.Do(func() {
+		file_aggregate_proto_rawDescData = protoimpl.X.CompressGZIP(file_aggregate_proto_rawDescData)
+	})
+	return file_aggregate_proto_rawDescData
+}
+
+var (
+	file_aggregate_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+	file_aggregate_proto_goTypes  = []interface{}{
+		(*AggregateRequest)(nil),                                                          // 0: weaviategrpc.AggregateRequest
+		(*AggregateReply)(nil),                                                            // 1: weaviategrpc.AggregateReply
+		(*AggregateRequest_Aggregation)(nil),                                              // 2: weaviategrpc.AggregateRequest.Aggregation
+		(*AggregateRequest_GroupBy)(nil),                                                  // 3: weaviategrpc.AggregateRequest.GroupBy
+		(*AggregateRequest_Aggregation_Integer)(nil),                                      // 4: weaviategrpc.AggregateRequest.Aggregation.Integer
+		(*AggregateRequest_Aggregation_Number)(nil),                                       // 5: weaviategrpc.AggregateRequest.Aggregation.Number
+		(*AggregateRequest_Aggregation_Text)(nil),                                         // 6: weaviategrpc.AggregateRequest.Aggregation.Text
+		(*AggregateRequest_Aggregation_Boolean)(nil),                                      // 7: weaviategrpc.AggregateRequest.Aggregation.Boolean
+		(*AggregateRequest_Aggregation_Date)(nil),                                         // 8: weaviategrpc.AggregateRequest.Aggregation.Date
+		(*AggregateRequest_Aggregation_Reference)(nil),                                    // 9: weaviategrpc.AggregateRequest.Aggregation.Reference
+		(*AggregateReply_Aggregations)(nil),                                               // 10: weaviategrpc.AggregateReply.Aggregations
+		(*AggregateReply_Single)(nil),                                                     // 11: weaviategrpc.AggregateReply.Single
+		(*AggregateReply_Group)(nil),                                                      // 12: weaviategrpc.AggregateReply.Group
+		(*AggregateReply_Grouped)(nil),                                                    // 13: weaviategrpc.AggregateReply.Grouped
+		(*AggregateReply_Aggregations_Aggregation)(nil),                                   // 14: weaviategrpc.AggregateReply.Aggregations.Aggregation
+		(*AggregateReply_Aggregations_Aggregation_Integer)(nil),                           // 15: weaviategrpc.AggregateReply.Aggregations.Aggregation.Integer
+		(*AggregateReply_Aggregations_Aggregation_Number)(nil),                            // 16: weaviategrpc.AggregateReply.Aggregations.Aggregation.Number
+		(*AggregateReply_Aggregations_Aggregation_Text)(nil),                              // 17: weaviategrpc.AggregateReply.Aggregations.Aggregation.Text
+		(*AggregateReply_Aggregations_Aggregation_Boolean)(nil),                           // 18: weaviategrpc.AggregateReply.Aggregations.Aggregation.Boolean
+		(*AggregateReply_Aggregations_Aggregation_Date)(nil),                              // 19: weaviategrpc.AggregateReply.Aggregations.Aggregation.Date
+		(*AggregateReply_Aggregations_Aggregation_Reference)(nil),                         // 20: weaviategrpc.AggregateReply.Aggregations.Aggregation.Reference
+		(*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences)(nil),               // 21: weaviategrpc.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences
+		(*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence)(nil), // 22: weaviategrpc.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.TopOccurrence
+		(*AggregateReply_Group_GroupedBy)(nil),                                            // 23: weaviategrpc.AggregateReply.Group.GroupedBy
+		(*Filters)(nil),                                                                   // 24: weaviategrpc.Filters
+		(*NearVectorParams)(nil),                                                          // 25: weaviategrpc.NearVectorParams
+		(*NearObjectParams)(nil),                                                          // 26: weaviategrpc.NearObjectParams
+		(*NearTextSearchParams)(nil),                                                      // 27: weaviategrpc.NearTextSearchParams
+		(*NearImageSearchParams)(nil),                                                     // 28: weaviategrpc.NearImageSearchParams
+		(*NearAudioSearchParams)(nil),                                                     // 29: weaviategrpc.NearAudioSearchParams
+		(*NearVideoSearchParams)(nil),                                                     // 30: weaviategrpc.NearVideoSearchParams
+	}
+)
+
+var file_aggregate_proto_depIdxs = []int32{
+	2,  // 0: weaviategrpc.AggregateRequest.aggregations:type_name -> weaviategrpc.AggregateRequest.Aggregation
+	3,  // 1: weaviategrpc.AggregateRequest.group_by:type_name -> weaviategrpc.AggregateRequest.GroupBy
+	24, // 2: weaviategrpc.AggregateRequest.filters:type_name -> weaviategrpc.Filters
+	25, // 3: weaviategrpc.AggregateRequest.near_vector:type_name -> weaviategrpc.NearVectorParams
+	26, // 4: weaviategrpc.AggregateRequest.near_object:type_name -> weaviategrpc.NearObjectParams
+	27, // 5: weaviategrpc.AggregateRequest.near_text:type_name -> weaviategrpc.NearTextSearchParams
+	28, // 6: weaviategrpc.AggregateRequest.near_image:type_name -> weaviategrpc.NearImageSearchParams
+	29, // 7: weaviategrpc.AggregateRequest.near_audio:type_name -> weaviategrpc.NearAudioSearchParams
+	30, // 8: weaviategrpc.AggregateRequest.near_video:type_name -> weaviategrpc.NearVideoSearchParams
+	11, // 9: weaviategrpc.AggregateReply.single_result:type_name -> weaviategrpc.AggregateReply.Single
+	13, // 10: weaviategrpc.AggregateReply.grouped_results:type_name -> weaviategrpc.AggregateReply.Grouped
+	4,  // 11: weaviategrpc.AggregateRequest.Aggregation.int:type_name -> weaviategrpc.AggregateRequest.Aggregation.Integer
+	5,  // 12: weaviategrpc.AggregateRequest.Aggregation.number:type_name -> weaviategrpc.AggregateRequest.Aggregation.Number
+	6,  // 13: weaviategrpc.AggregateRequest.Aggregation.text:type_name -> weaviategrpc.AggregateRequest.Aggregation.Text
+	7,  // 14: weaviategrpc.AggregateRequest.Aggregation.boolean:type_name -> weaviategrpc.AggregateRequest.Aggregation.Boolean
+	8,  // 15: weaviategrpc.AggregateRequest.Aggregation.date:type_name -> weaviategrpc.AggregateRequest.Aggregation.Date
+	9,  // 16: weaviategrpc.AggregateRequest.Aggregation.reference:type_name -> weaviategrpc.AggregateRequest.Aggregation.Reference
+	14, // 17: weaviategrpc.AggregateReply.Aggregations.aggregations:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation
+	10, // 18: weaviategrpc.AggregateReply.Single.aggregations:type_name -> weaviategrpc.AggregateReply.Aggregations
+	10, // 19: weaviategrpc.AggregateReply.Group.aggregations:type_name -> weaviategrpc.AggregateReply.Aggregations
+	23, // 20: weaviategrpc.AggregateReply.Group.grouped_by:type_name -> weaviategrpc.AggregateReply.Group.GroupedBy
+	12, // 21: weaviategrpc.AggregateReply.Grouped.groups:type_name -> weaviategrpc.AggregateReply.Group
+	15, // 22: weaviategrpc.AggregateReply.Aggregations.Aggregation.int:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation.Integer
+	16, // 23: weaviategrpc.AggregateReply.Aggregations.Aggregation.number:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation.Number
+	17, // 24: weaviategrpc.AggregateReply.Aggregations.Aggregation.text:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation.Text
+	18, // 25: weaviategrpc.AggregateReply.Aggregations.Aggregation.boolean:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation.Boolean
+	19, // 26: weaviategrpc.AggregateReply.Aggregations.Aggregation.date:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation.Date
+	20, // 27: weaviategrpc.AggregateReply.Aggregations.Aggregation.reference:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation.Reference
+	21, // 28: weaviategrpc.AggregateReply.Aggregations.Aggregation.Text.top_occurrences:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences
+	22, // 29: weaviategrpc.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.items:type_name -> weaviategrpc.AggregateReply.Aggregations.Aggregation.Text.TopOccurrences.TopOccurrence
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_aggregate_proto_init() }
+func file_aggregate_proto_init() {
+	if File_aggregate_proto != nil {
+		return
+	}
+	file_search_get_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_aggregate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_Aggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_GroupBy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_Aggregation_Integer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_Aggregation_Number); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_Aggregation_Text); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_Aggregation_Boolean); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_Aggregation_Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_Aggregation_Reference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Single); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Grouped); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation_Integer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation_Number); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation_Text); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation_Boolean); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation_Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation_Reference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aggregate_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Group_GroupedBy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_aggregate_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*AggregateReply_SingleResult)(nil),
+		(*AggregateReply_GroupedResults)(nil),
+	}
+	file_aggregate_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*AggregateRequest_Aggregation_Int)(nil),
+		(*AggregateRequest_Aggregation_Number_)(nil),
+		(*AggregateRequest_Aggregation_Text_)(nil),
+		(*AggregateRequest_Aggregation_Boolean_)(nil),
+		(*AggregateRequest_Aggregation_Date_)(nil),
+		(*AggregateRequest_Aggregation_Reference_)(nil),
+	}
+	file_aggregate_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*AggregateReply_Aggregations_Aggregation_Int)(nil),
+		(*AggregateReply_Aggregations_Aggregation_Number_)(nil),
+		(*AggregateReply_Aggregations_Aggregation_Text_)(nil),
+		(*AggregateReply_Aggregations_Aggregation_Boolean_)(nil),
+		(*AggregateReply_Aggregations_Aggregation_Date_)(nil),
+		(*AggregateReply_Aggregations_Aggregation_Reference_)(nil),
+	}
+	file_aggregate_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_aggregate_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*AggregateReply_Group_GroupedBy_Text)(nil),
+		(*AggregateReply_Group_GroupedBy_Int)(nil),
+		(*AggregateReply_Group_GroupedBy_Boolean)(nil),
+		(*AggregateReply_Group_GroupedBy_Number)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_aggregate_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_aggregate_proto_goTypes,
+		DependencyIndexes: file_aggregate_proto_depIdxs,
+		MessageInfos:      file_aggregate_proto_msgTypes,
+	}.Build()
+	File_aggregate_proto = out.File
+	file_aggregate_proto_rawDesc = nil
+	file_aggregate_proto_goTypes = nil
+	file_aggregate_proto_depIdxs = nil
+}
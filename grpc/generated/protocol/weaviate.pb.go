@@ -29,9 +29,14 @@ var File_weaviate_proto protoreflect.FileDescriptor
 
 var file_weaviate_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0c, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x0b,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa4, 0x01,
+	0x12, 0x0c, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x0f,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0c, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xb2, 0x0e,
 	0x0a, 0x08, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x53, 0x65,
 	0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
 	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
@@ -42,32 +47,212 @@ var file_weaviate_proto_rawDesc = []byte{
 	0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
 	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x42, 0x60, 0x0a, 0x19, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
-	0x61, 0x74, 0x65, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x42, 0x0d, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61,
-	0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67,
-	0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x09, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x06, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x4e, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x47, 0x65, 0x74, 0x12,
+	0x1f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0a, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x75, 0x74, 0x12, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x50, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0c, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x64, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0f, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x09,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x47, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x08, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x47, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x20,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x41, 0x64, 0x64, 0x12, 0x20, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0a, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x41, 0x64, 0x64, 0x12, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x50, 0x0a, 0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x42, 0x60, 0x0a, 0x19, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42,
+	0x0d, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_weaviate_proto_goTypes = []interface{}{
-	(*SearchRequest)(nil),       // 0: weaviategrpc.SearchRequest
-	(*BatchObjectsRequest)(nil), // 1: weaviategrpc.BatchObjectsRequest
-	(*SearchReply)(nil),         // 2: weaviategrpc.SearchReply
-	(*BatchObjectsReply)(nil),   // 3: weaviategrpc.BatchObjectsReply
+	(*SearchRequest)(nil),        // 0: weaviategrpc.SearchRequest
+	(*BatchObjectsRequest)(nil),  // 1: weaviategrpc.BatchObjectsRequest
+	(*BatchStreamRequest)(nil),   // 2: weaviategrpc.BatchStreamRequest
+	(*AggregateRequest)(nil),     // 3: weaviategrpc.AggregateRequest
+	(*BatchDeleteRequest)(nil),   // 4: weaviategrpc.BatchDeleteRequest
+	(*ExportRequest)(nil),        // 5: weaviategrpc.ExportRequest
+	(*ObjectsGetRequest)(nil),    // 6: weaviategrpc.ObjectsGetRequest
+	(*ObjectsExistsRequest)(nil), // 7: weaviategrpc.ObjectsExistsRequest
+	(*ObjectsPutRequest)(nil),    // 8: weaviategrpc.ObjectsPutRequest
+	(*ObjectsMergeRequest)(nil),  // 9: weaviategrpc.ObjectsMergeRequest
+	(*ObjectsDeleteRequest)(nil), // 10: weaviategrpc.ObjectsDeleteRequest
+	(*ReferenceRequest)(nil),     // 11: weaviategrpc.ReferenceRequest
+	(*SchemaGetRequest)(nil),     // 12: weaviategrpc.SchemaGetRequest
+	(*ClassGetRequest)(nil),      // 13: weaviategrpc.ClassGetRequest
+	(*ClassCreateRequest)(nil),   // 14: weaviategrpc.ClassCreateRequest
+	(*ClassUpdateRequest)(nil),   // 15: weaviategrpc.ClassUpdateRequest
+	(*ClassDeleteRequest)(nil),   // 16: weaviategrpc.ClassDeleteRequest
+	(*PropertyAddRequest)(nil),   // 17: weaviategrpc.PropertyAddRequest
+	(*TenantsGetRequest)(nil),    // 18: weaviategrpc.TenantsGetRequest
+	(*TenantsRequest)(nil),       // 19: weaviategrpc.TenantsRequest
+	(*TenantsDeleteRequest)(nil), // 20: weaviategrpc.TenantsDeleteRequest
+	(*SearchReply)(nil),          // 21: weaviategrpc.SearchReply
+	(*BatchObjectsReply)(nil),    // 22: weaviategrpc.BatchObjectsReply
+	(*BatchStreamReply)(nil),     // 23: weaviategrpc.BatchStreamReply
+	(*AggregateReply)(nil),       // 24: weaviategrpc.AggregateReply
+	(*BatchDeleteReply)(nil),     // 25: weaviategrpc.BatchDeleteReply
+	(*ExportReply)(nil),          // 26: weaviategrpc.ExportReply
+	(*ObjectsGetReply)(nil),      // 27: weaviategrpc.ObjectsGetReply
+	(*ObjectsExistsReply)(nil),   // 28: weaviategrpc.ObjectsExistsReply
+	(*ObjectsPutReply)(nil),      // 29: weaviategrpc.ObjectsPutReply
+	(*ObjectsMergeReply)(nil),    // 30: weaviategrpc.ObjectsMergeReply
+	(*ObjectsDeleteReply)(nil),   // 31: weaviategrpc.ObjectsDeleteReply
+	(*ReferenceReply)(nil),       // 32: weaviategrpc.ReferenceReply
+	(*SchemaGetReply)(nil),       // 33: weaviategrpc.SchemaGetReply
+	(*ClassGetReply)(nil),        // 34: weaviategrpc.ClassGetReply
+	(*SchemaReply)(nil),          // 35: weaviategrpc.SchemaReply
+	(*TenantsGetReply)(nil),      // 36: weaviategrpc.TenantsGetReply
 }
 
 var file_weaviate_proto_depIdxs = []int32{
-	0, // 0: weaviategrpc.Weaviate.Search:input_type -> weaviategrpc.SearchRequest
-	1, // 1: weaviategrpc.Weaviate.BatchObjects:input_type -> weaviategrpc.BatchObjectsRequest
-	2, // 2: weaviategrpc.Weaviate.Search:output_type -> weaviategrpc.SearchReply
-	3, // 3: weaviategrpc.Weaviate.BatchObjects:output_type -> weaviategrpc.BatchObjectsReply
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: weaviategrpc.Weaviate.Search:input_type -> weaviategrpc.SearchRequest
+	1,  // 1: weaviategrpc.Weaviate.BatchObjects:input_type -> weaviategrpc.BatchObjectsRequest
+	2,  // 2: weaviategrpc.Weaviate.BatchStream:input_type -> weaviategrpc.BatchStreamRequest
+	3,  // 3: weaviategrpc.Weaviate.Aggregate:input_type -> weaviategrpc.AggregateRequest
+	4,  // 4: weaviategrpc.Weaviate.BatchDelete:input_type -> weaviategrpc.BatchDeleteRequest
+	5,  // 5: weaviategrpc.Weaviate.Export:input_type -> weaviategrpc.ExportRequest
+	6,  // 6: weaviategrpc.Weaviate.ObjectsGet:input_type -> weaviategrpc.ObjectsGetRequest
+	7,  // 7: weaviategrpc.Weaviate.ObjectsExists:input_type -> weaviategrpc.ObjectsExistsRequest
+	8,  // 8: weaviategrpc.Weaviate.ObjectsPut:input_type -> weaviategrpc.ObjectsPutRequest
+	9,  // 9: weaviategrpc.Weaviate.ObjectsMerge:input_type -> weaviategrpc.ObjectsMergeRequest
+	10, // 10: weaviategrpc.Weaviate.ObjectsDelete:input_type -> weaviategrpc.ObjectsDeleteRequest
+	11, // 11: weaviategrpc.Weaviate.ReferenceAdd:input_type -> weaviategrpc.ReferenceRequest
+	11, // 12: weaviategrpc.Weaviate.ReferenceDelete:input_type -> weaviategrpc.ReferenceRequest
+	12, // 13: weaviategrpc.Weaviate.SchemaGet:input_type -> weaviategrpc.SchemaGetRequest
+	13, // 14: weaviategrpc.Weaviate.ClassGet:input_type -> weaviategrpc.ClassGetRequest
+	14, // 15: weaviategrpc.Weaviate.ClassCreate:input_type -> weaviategrpc.ClassCreateRequest
+	15, // 16: weaviategrpc.Weaviate.ClassUpdate:input_type -> weaviategrpc.ClassUpdateRequest
+	16, // 17: weaviategrpc.Weaviate.ClassDelete:input_type -> weaviategrpc.ClassDeleteRequest
+	17, // 18: weaviategrpc.Weaviate.PropertyAdd:input_type -> weaviategrpc.PropertyAddRequest
+	18, // 19: weaviategrpc.Weaviate.TenantsGet:input_type -> weaviategrpc.TenantsGetRequest
+	19, // 20: weaviategrpc.Weaviate.TenantsAdd:input_type -> weaviategrpc.TenantsRequest
+	19, // 21: weaviategrpc.Weaviate.TenantsUpdate:input_type -> weaviategrpc.TenantsRequest
+	20, // 22: weaviategrpc.Weaviate.TenantsDelete:input_type -> weaviategrpc.TenantsDeleteRequest
+	21, // 23: weaviategrpc.Weaviate.Search:output_type -> weaviategrpc.SearchReply
+	22, // 24: weaviategrpc.Weaviate.BatchObjects:output_type -> weaviategrpc.BatchObjectsReply
+	23, // 25: weaviategrpc.Weaviate.BatchStream:output_type -> weaviategrpc.BatchStreamReply
+	24, // 26: weaviategrpc.Weaviate.Aggregate:output_type -> weaviategrpc.AggregateReply
+	25, // 27: weaviategrpc.Weaviate.BatchDelete:output_type -> weaviategrpc.BatchDeleteReply
+	26, // 28: weaviategrpc.Weaviate.Export:output_type -> weaviategrpc.ExportReply
+	27, // 29: weaviategrpc.Weaviate.ObjectsGet:output_type -> weaviategrpc.ObjectsGetReply
+	28, // 30: weaviategrpc.Weaviate.ObjectsExists:output_type -> weaviategrpc.ObjectsExistsReply
+	29, // 31: weaviategrpc.Weaviate.ObjectsPut:output_type -> weaviategrpc.ObjectsPutReply
+	30, // 32: weaviategrpc.Weaviate.ObjectsMerge:output_type -> weaviategrpc.ObjectsMergeReply
+	31, // 33: weaviategrpc.Weaviate.ObjectsDelete:output_type -> weaviategrpc.ObjectsDeleteReply
+	32, // 34: weaviategrpc.Weaviate.ReferenceAdd:output_type -> weaviategrpc.ReferenceReply
+	32, // 35: weaviategrpc.Weaviate.ReferenceDelete:output_type -> weaviategrpc.ReferenceReply
+	33, // 36: weaviategrpc.Weaviate.SchemaGet:output_type -> weaviategrpc.SchemaGetReply
+	34, // 37: weaviategrpc.Weaviate.ClassGet:output_type -> weaviategrpc.ClassGetReply
+	35, // 38: weaviategrpc.Weaviate.ClassCreate:output_type -> weaviategrpc.SchemaReply
+	35, // 39: weaviategrpc.Weaviate.ClassUpdate:output_type -> weaviategrpc.SchemaReply
+	35, // 40: weaviategrpc.Weaviate.ClassDelete:output_type -> weaviategrpc.SchemaReply
+	35, // 41: weaviategrpc.Weaviate.PropertyAdd:output_type -> weaviategrpc.SchemaReply
+	36, // 42: weaviategrpc.Weaviate.TenantsGet:output_type -> weaviategrpc.TenantsGetReply
+	35, // 43: weaviategrpc.Weaviate.TenantsAdd:output_type -> weaviategrpc.SchemaReply
+	35, // 44: weaviategrpc.Weaviate.TenantsUpdate:output_type -> weaviategrpc.SchemaReply
+	35, // 45: weaviategrpc.Weaviate.TenantsDelete:output_type -> weaviategrpc.SchemaReply
+	23, // [23:46] is the sub-list for method output_type
+	0,  // [0:23] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_weaviate_proto_init() }
@@ -75,7 +260,12 @@ func file_weaviate_proto_init() {
 	if File_weaviate_proto != nil {
 		return
 	}
+	file_aggregate_proto_init()
 	file_batch_proto_init()
+	file_batch_delete_proto_init()
+	file_export_proto_init()
+	file_objects_proto_init()
+	file_schema_proto_init()
 	file_search_get_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -32,6 +32,27 @@ const _ = grpc.SupportPackageIsVersion7
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
+	BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error)
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
+	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Weaviate_ExportClient, error)
+	ObjectsGet(ctx context.Context, in *ObjectsGetRequest, opts ...grpc.CallOption) (*ObjectsGetReply, error)
+	ObjectsExists(ctx context.Context, in *ObjectsExistsRequest, opts ...grpc.CallOption) (*ObjectsExistsReply, error)
+	ObjectsPut(ctx context.Context, in *ObjectsPutRequest, opts ...grpc.CallOption) (*ObjectsPutReply, error)
+	ObjectsMerge(ctx context.Context, in *ObjectsMergeRequest, opts ...grpc.CallOption) (*ObjectsMergeReply, error)
+	ObjectsDelete(ctx context.Context, in *ObjectsDeleteRequest, opts ...grpc.CallOption) (*ObjectsDeleteReply, error)
+	ReferenceAdd(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*ReferenceReply, error)
+	ReferenceDelete(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*ReferenceReply, error)
+	SchemaGet(ctx context.Context, in *SchemaGetRequest, opts ...grpc.CallOption) (*SchemaGetReply, error)
+	ClassGet(ctx context.Context, in *ClassGetRequest, opts ...grpc.CallOption) (*ClassGetReply, error)
+	ClassCreate(ctx context.Context, in *ClassCreateRequest, opts ...grpc.CallOption) (*SchemaReply, error)
+	ClassUpdate(ctx context.Context, in *ClassUpdateRequest, opts ...grpc.CallOption) (*SchemaReply, error)
+	ClassDelete(ctx context.Context, in *ClassDeleteRequest, opts ...grpc.CallOption) (*SchemaReply, error)
+	PropertyAdd(ctx context.Context, in *PropertyAddRequest, opts ...grpc.CallOption) (*SchemaReply, error)
+	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
+	TenantsAdd(ctx context.Context, in *TenantsRequest, opts ...grpc.CallOption) (*SchemaReply, error)
+	TenantsUpdate(ctx context.Context, in *TenantsRequest, opts ...grpc.CallOption) (*SchemaReply, error)
+	TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*SchemaReply, error)
 }
 
 type weaviateClient struct {
@@ -60,12 +81,267 @@ func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsReque
 	return out, nil
 }
 
+func (c *weaviateClient) BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviategrpc.Weaviate/BatchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateBatchStreamClient{stream}
+	return x, nil
+}
+
+type Weaviate_BatchStreamClient interface {
+	Send(*BatchStreamRequest) error
+	Recv() (*BatchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateBatchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateBatchStreamClient) Send(m *BatchStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamClient) Recv() (*BatchStreamReply, error) {
+	m := new(BatchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error) {
+	out := new(AggregateReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/Aggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error) {
+	out := new(BatchDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Weaviate_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], "/weaviategrpc.Weaviate/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_ExportClient interface {
+	Recv() (*ExportReply, error)
+	grpc.ClientStream
+}
+
+type weaviateExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateExportClient) Recv() (*ExportReply, error) {
+	m := new(ExportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) ObjectsGet(ctx context.Context, in *ObjectsGetRequest, opts ...grpc.CallOption) (*ObjectsGetReply, error) {
+	out := new(ObjectsGetReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ObjectsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsExists(ctx context.Context, in *ObjectsExistsRequest, opts ...grpc.CallOption) (*ObjectsExistsReply, error) {
+	out := new(ObjectsExistsReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ObjectsExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsPut(ctx context.Context, in *ObjectsPutRequest, opts ...grpc.CallOption) (*ObjectsPutReply, error) {
+	out := new(ObjectsPutReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ObjectsPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsMerge(ctx context.Context, in *ObjectsMergeRequest, opts ...grpc.CallOption) (*ObjectsMergeReply, error) {
+	out := new(ObjectsMergeReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ObjectsMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsDelete(ctx context.Context, in *ObjectsDeleteRequest, opts ...grpc.CallOption) (*ObjectsDeleteReply, error) {
+	out := new(ObjectsDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ObjectsDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ReferenceAdd(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*ReferenceReply, error) {
+	out := new(ReferenceReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ReferenceAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ReferenceDelete(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*ReferenceReply, error) {
+	out := new(ReferenceReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ReferenceDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) SchemaGet(ctx context.Context, in *SchemaGetRequest, opts ...grpc.CallOption) (*SchemaGetReply, error) {
+	out := new(SchemaGetReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/SchemaGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ClassGet(ctx context.Context, in *ClassGetRequest, opts ...grpc.CallOption) (*ClassGetReply, error) {
+	out := new(ClassGetReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ClassGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ClassCreate(ctx context.Context, in *ClassCreateRequest, opts ...grpc.CallOption) (*SchemaReply, error) {
+	out := new(SchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ClassCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ClassUpdate(ctx context.Context, in *ClassUpdateRequest, opts ...grpc.CallOption) (*SchemaReply, error) {
+	out := new(SchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ClassUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ClassDelete(ctx context.Context, in *ClassDeleteRequest, opts ...grpc.CallOption) (*SchemaReply, error) {
+	out := new(SchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/ClassDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) PropertyAdd(ctx context.Context, in *PropertyAddRequest, opts ...grpc.CallOption) (*SchemaReply, error) {
+	out := new(SchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/PropertyAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error) {
+	out := new(TenantsGetReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/TenantsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsAdd(ctx context.Context, in *TenantsRequest, opts ...grpc.CallOption) (*SchemaReply, error) {
+	out := new(SchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/TenantsAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsUpdate(ctx context.Context, in *TenantsRequest, opts ...grpc.CallOption) (*SchemaReply, error) {
+	out := new(SchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/TenantsUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*SchemaReply, error) {
+	out := new(SchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/TenantsDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
+	BatchStream(Weaviate_BatchStreamServer) error
+	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
+	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
+	Export(*ExportRequest, Weaviate_ExportServer) error
+	ObjectsGet(context.Context, *ObjectsGetRequest) (*ObjectsGetReply, error)
+	ObjectsExists(context.Context, *ObjectsExistsRequest) (*ObjectsExistsReply, error)
+	ObjectsPut(context.Context, *ObjectsPutRequest) (*ObjectsPutReply, error)
+	ObjectsMerge(context.Context, *ObjectsMergeRequest) (*ObjectsMergeReply, error)
+	ObjectsDelete(context.Context, *ObjectsDeleteRequest) (*ObjectsDeleteReply, error)
+	ReferenceAdd(context.Context, *ReferenceRequest) (*ReferenceReply, error)
+	ReferenceDelete(context.Context, *ReferenceRequest) (*ReferenceReply, error)
+	SchemaGet(context.Context, *SchemaGetRequest) (*SchemaGetReply, error)
+	ClassGet(context.Context, *ClassGetRequest) (*ClassGetReply, error)
+	ClassCreate(context.Context, *ClassCreateRequest) (*SchemaReply, error)
+	ClassUpdate(context.Context, *ClassUpdateRequest) (*SchemaReply, error)
+	ClassDelete(context.Context, *ClassDeleteRequest) (*SchemaReply, error)
+	PropertyAdd(context.Context, *PropertyAddRequest) (*SchemaReply, error)
+	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
+	TenantsAdd(context.Context, *TenantsRequest) (*SchemaReply, error)
+	TenantsUpdate(context.Context, *TenantsRequest) (*SchemaReply, error)
+	TenantsDelete(context.Context, *TenantsDeleteRequest) (*SchemaReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -79,6 +355,69 @@ func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*Sea
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
 }
+func (UnimplementedWeaviateServer) BatchStream(Weaviate_BatchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchStream not implemented")
+}
+func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
+func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (UnimplementedWeaviateServer) Export(*ExportRequest, Weaviate_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsGet(context.Context, *ObjectsGetRequest) (*ObjectsGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsGet not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsExists(context.Context, *ObjectsExistsRequest) (*ObjectsExistsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsExists not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsPut(context.Context, *ObjectsPutRequest) (*ObjectsPutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsPut not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsMerge(context.Context, *ObjectsMergeRequest) (*ObjectsMergeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsMerge not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsDelete(context.Context, *ObjectsDeleteRequest) (*ObjectsDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsDelete not implemented")
+}
+func (UnimplementedWeaviateServer) ReferenceAdd(context.Context, *ReferenceRequest) (*ReferenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceAdd not implemented")
+}
+func (UnimplementedWeaviateServer) ReferenceDelete(context.Context, *ReferenceRequest) (*ReferenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceDelete not implemented")
+}
+func (UnimplementedWeaviateServer) SchemaGet(context.Context, *SchemaGetRequest) (*SchemaGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchemaGet not implemented")
+}
+func (UnimplementedWeaviateServer) ClassGet(context.Context, *ClassGetRequest) (*ClassGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassGet not implemented")
+}
+func (UnimplementedWeaviateServer) ClassCreate(context.Context, *ClassCreateRequest) (*SchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassCreate not implemented")
+}
+func (UnimplementedWeaviateServer) ClassUpdate(context.Context, *ClassUpdateRequest) (*SchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) ClassDelete(context.Context, *ClassDeleteRequest) (*SchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassDelete not implemented")
+}
+func (UnimplementedWeaviateServer) PropertyAdd(context.Context, *PropertyAddRequest) (*SchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropertyAdd not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsGet not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsAdd(context.Context, *TenantsRequest) (*SchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsAdd not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsUpdate(context.Context, *TenantsRequest) (*SchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsDelete(context.Context, *TenantsDeleteRequest) (*SchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsDelete not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -128,6 +467,395 @@ func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_BatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WeaviateServer).BatchStream(&weaviateBatchStreamServer{stream})
+}
+
+type Weaviate_BatchStreamServer interface {
+	Send(*BatchStreamReply) error
+	Recv() (*BatchStreamRequest, error)
+	grpc.ServerStream
+}
+
+type weaviateBatchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateBatchStreamServer) Send(m *BatchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamServer) Recv() (*BatchStreamRequest, error) {
+	m := new(BatchStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Weaviate_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/Aggregate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).Aggregate(ctx, req.(*AggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BatchDelete(ctx, req.(*BatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).Export(m, &weaviateExportServer{stream})
+}
+
+type Weaviate_ExportServer interface {
+	Send(*ExportReply) error
+	grpc.ServerStream
+}
+
+type weaviateExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateExportServer) Send(m *ExportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_ObjectsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ObjectsGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsGet(ctx, req.(*ObjectsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ObjectsExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsExists(ctx, req.(*ObjectsExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ObjectsPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsPut(ctx, req.(*ObjectsPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ObjectsMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsMerge(ctx, req.(*ObjectsMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ObjectsDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsDelete(ctx, req.(*ObjectsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ReferenceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ReferenceAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ReferenceAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ReferenceAdd(ctx, req.(*ReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ReferenceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ReferenceDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ReferenceDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ReferenceDelete(ctx, req.(*ReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_SchemaGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).SchemaGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/SchemaGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).SchemaGet(ctx, req.(*SchemaGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ClassGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ClassGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ClassGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ClassGet(ctx, req.(*ClassGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ClassCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ClassCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ClassCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ClassCreate(ctx, req.(*ClassCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ClassUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ClassUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ClassUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ClassUpdate(ctx, req.(*ClassUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ClassDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ClassDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/ClassDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ClassDelete(ctx, req.(*ClassDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_PropertyAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertyAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).PropertyAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/PropertyAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).PropertyAdd(ctx, req.(*PropertyAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/TenantsGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsGet(ctx, req.(*TenantsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/TenantsAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsAdd(ctx, req.(*TenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/TenantsUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, req.(*TenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/TenantsDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsDelete(ctx, req.(*TenantsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -143,7 +871,95 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchObjects",
 			Handler:    _Weaviate_BatchObjects_Handler,
 		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _Weaviate_Aggregate_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _Weaviate_BatchDelete_Handler,
+		},
+		{
+			MethodName: "ObjectsGet",
+			Handler:    _Weaviate_ObjectsGet_Handler,
+		},
+		{
+			MethodName: "ObjectsExists",
+			Handler:    _Weaviate_ObjectsExists_Handler,
+		},
+		{
+			MethodName: "ObjectsPut",
+			Handler:    _Weaviate_ObjectsPut_Handler,
+		},
+		{
+			MethodName: "ObjectsMerge",
+			Handler:    _Weaviate_ObjectsMerge_Handler,
+		},
+		{
+			MethodName: "ObjectsDelete",
+			Handler:    _Weaviate_ObjectsDelete_Handler,
+		},
+		{
+			MethodName: "ReferenceAdd",
+			Handler:    _Weaviate_ReferenceAdd_Handler,
+		},
+		{
+			MethodName: "ReferenceDelete",
+			Handler:    _Weaviate_ReferenceDelete_Handler,
+		},
+		{
+			MethodName: "SchemaGet",
+			Handler:    _Weaviate_SchemaGet_Handler,
+		},
+		{
+			MethodName: "ClassGet",
+			Handler:    _Weaviate_ClassGet_Handler,
+		},
+		{
+			MethodName: "ClassCreate",
+			Handler:    _Weaviate_ClassCreate_Handler,
+		},
+		{
+			MethodName: "ClassUpdate",
+			Handler:    _Weaviate_ClassUpdate_Handler,
+		},
+		{
+			MethodName: "ClassDelete",
+			Handler:    _Weaviate_ClassDelete_Handler,
+		},
+		{
+			MethodName: "PropertyAdd",
+			Handler:    _Weaviate_PropertyAdd_Handler,
+		},
+		{
+			MethodName: "TenantsGet",
+			Handler:    _Weaviate_TenantsGet_Handler,
+		},
+		{
+			MethodName: "TenantsAdd",
+			Handler:    _Weaviate_TenantsAdd_Handler,
+		},
+		{
+			MethodName: "TenantsUpdate",
+			Handler:    _Weaviate_TenantsUpdate_Handler,
+		},
+		{
+			MethodName: "TenantsDelete",
+			Handler:    _Weaviate_TenantsDelete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchStream",
+			Handler:       _Weaviate_BatchStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _Weaviate_Export_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "weaviate.proto",
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/backup"
 )
@@ -263,6 +264,59 @@ func (a *azureClient) SourceDataPath() string {
 	return a.dataPath
 }
 
+func (a *azureClient) ListBackups(ctx context.Context) ([]string, error) {
+	prefix := a.makeObjectName()
+	if prefix != "" {
+		prefix += "/"
+	}
+	ids := []string{}
+	pager := a.client.ServiceClient().NewContainerClient(a.config.Container).
+		NewListBlobsHierarchyPager("/", &container.ListBlobsHierarchyOptions{Prefix: &prefix})
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(err, "list blobs '%s'", prefix))
+		}
+		// backups are the top level folders
+		for _, p := range resp.Segment.BlobPrefixes {
+			if p.Name != nil {
+				ids = append(ids, strings.TrimSuffix(strings.TrimPrefix(*p.Name, prefix), "/"))
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (a *azureClient) DeleteBackup(ctx context.Context, backupID string) error {
+	prefix := a.makeObjectName(backupID) + "/"
+	pager := a.client.NewListBlobsFlatPager(a.config.Container, &azblob.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs '%s'", prefix))
+		}
+		for _, blob := range resp.Segment.BlobItems {
+			if blob.Name == nil {
+				continue
+			}
+			if _, err := a.client.DeleteBlob(ctx, a.config.Container, *blob.Name, nil); err != nil &&
+				!bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob '%s'", *blob.Name))
+			}
+		}
+	}
+	return nil
+}
+
+func (a *azureClient) DeleteObject(ctx context.Context, backupID, key string) error {
+	objectName := a.makeObjectName(backupID, key)
+	if _, err := a.client.DeleteBlob(ctx, a.config.Container, objectName, nil); err != nil &&
+		!bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete blob '%s'", objectName))
+	}
+	return nil
+}
+
 // reader is a wrapper used to count number of written bytes
 // Unlike GCS and S3 Azure Interface does not provide this information
 type reader struct {
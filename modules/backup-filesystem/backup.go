@@ -159,16 +159,25 @@ func (m *Module) Write(ctx context.Context, backupID, key string, r io.ReadClose
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
 		return 0, fmt.Errorf("make dir %q: %w", dir, err)
 	}
-	f, err := os.OpenFile(backupPath, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	// written to a temporary file first, so that a failed write leaves a
+	// previous object with the same key intact
+	tmpPath := backupPath + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, os.ModePerm)
 	if err != nil {
-		return 0, fmt.Errorf("open file %q: %w", backupPath, err)
+		return 0, fmt.Errorf("open file %q: %w", tmpPath, err)
 	}
-	defer f.Close()
+	defer os.Remove(tmpPath)
 
 	written, err := io.Copy(f, r)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
 	if err != nil {
 		return 0, fmt.Errorf("write file %q: %w", backupPath, err)
 	}
+	if err := os.Rename(tmpPath, backupPath); err != nil {
+		return 0, fmt.Errorf("rename file %q: %w", tmpPath, err)
+	}
 	if metric, err := monitoring.GetMetrics().BackupStoreDataTransferred.
 		GetMetricWithLabelValues(m.Name(), "class"); err == nil {
 		metric.Add(float64(written))
@@ -208,6 +217,38 @@ func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
 
+func (m *Module) ListBackups(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(m.backupsPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, backup.NewErrInternal(errors.Wrapf(err, "read dir '%s'", m.backupsPath))
+	}
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			ids = append(ids, e.Name())
+		}
+	}
+	return ids, nil
+}
+
+func (m *Module) DeleteBackup(ctx context.Context, backupID string) error {
+	if err := os.RemoveAll(m.makeBackupDirPath(backupID)); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "remove backup '%s'", backupID))
+	}
+	return nil
+}
+
+func (m *Module) DeleteObject(ctx context.Context, backupID, key string) error {
+	objectPath := filepath.Join(m.backupsPath, backupID, key)
+	if err := os.Remove(objectPath); err != nil && !os.IsNotExist(err) {
+		return backup.NewErrInternal(errors.Wrapf(err, "remove object '%s'", objectPath))
+	}
+	return nil
+}
+
 func (m *Module) initBackupBackend(ctx context.Context, backupsPath string) error {
 	if backupsPath == "" {
 		return fmt.Errorf("empty backup path provided")
@@ -13,9 +13,13 @@ package modstgfs
 
 import (
 	"context"
+	"errors"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -52,3 +56,63 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_ListAndDeleteBackups(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	ids, err := module.ListBackups(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, ids)
+
+	assert.Nil(t, module.PutObject(ctx, "first", "backup_config.json", []byte("{}")))
+	assert.Nil(t, module.PutObject(ctx, "second", "node1/backup.json", []byte("{}")))
+
+	ids, err = module.ListBackups(ctx)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"first", "second"}, ids)
+
+	assert.Nil(t, module.DeleteBackup(ctx, "second"))
+	ids, err = module.ListBackups(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first"}, ids)
+
+	// deleting a missing backup is not an error
+	assert.Nil(t, module.DeleteBackup(ctx, "missing"))
+}
+
+func TestBackend_DeleteObject(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	assert.Nil(t, module.PutObject(ctx, "first", "class/shard.tar.gz", []byte("{}")))
+	assert.Nil(t, module.DeleteObject(ctx, "first", "class/shard.tar.gz"))
+	_, err := module.GetObject(ctx, "first", "class/shard.tar.gz")
+	assert.NotNil(t, err)
+
+	// deleting a missing object is not an error
+	assert.Nil(t, module.DeleteObject(ctx, "first", "class/shard.tar.gz"))
+}
+
+func TestBackend_WriteReplacesObject(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	_, err := module.Write(ctx, "first", "shard.tar.gz", io.NopCloser(strings.NewReader("a longer object")))
+	assert.Nil(t, err)
+	_, err = module.Write(ctx, "first", "shard.tar.gz", io.NopCloser(strings.NewReader("short")))
+	assert.Nil(t, err)
+
+	contents, err := module.GetObject(ctx, "first", "shard.tar.gz")
+	assert.Nil(t, err)
+	assert.Equal(t, "short", string(contents))
+
+	_, err = module.Write(ctx, "first", "shard.tar.gz", io.NopCloser(iotest.ErrReader(errors.New("broken"))))
+	assert.NotNil(t, err)
+	contents, err = module.GetObject(ctx, "first", "shard.tar.gz")
+	assert.Nil(t, err)
+	assert.Equal(t, "short", string(contents), "a failed write keeps the object")
+}
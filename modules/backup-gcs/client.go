@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -371,3 +372,62 @@ func (g *gcsClient) Read(ctx context.Context, backupID, key string, w io.WriteCl
 func (g *gcsClient) SourceDataPath() string {
 	return g.dataPath
 }
+
+func (g *gcsClient) ListBackups(ctx context.Context) ([]string, error) {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return nil, backup.NewErrInternal(errors.Wrapf(err, "find bucket '%s'", g.config.Bucket))
+	}
+	prefix := g.makeObjectName()
+	if prefix != "" {
+		prefix += "/"
+	}
+	ids := []string{}
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix, Delimiter: "/"})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		// backups are the top level folders
+		if attrs.Prefix != "" {
+			ids = append(ids, strings.TrimSuffix(strings.TrimPrefix(attrs.Prefix, prefix), "/"))
+		}
+	}
+}
+
+func (g *gcsClient) DeleteBackup(ctx context.Context, backupID string) error {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "find bucket '%s'", g.config.Bucket))
+	}
+	prefix := g.makeObjectName(backupID) + "/"
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", attrs.Name))
+		}
+	}
+}
+
+func (g *gcsClient) DeleteObject(ctx context.Context, backupID, key string) error {
+	objectName := g.makeObjectName(backupID, key)
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "find bucket '%s'", g.config.Bucket))
+	}
+	if err := bucket.Object(objectName).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", objectName))
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -227,3 +228,45 @@ func (s *s3Client) Read(ctx context.Context, backupID, key string, w io.WriteClo
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
+
+func (s *s3Client) ListBackups(ctx context.Context) ([]string, error) {
+	prefix := s.makeObjectName()
+	if prefix != "" {
+		prefix += "/"
+	}
+	ids := []string{}
+	opt := minio.ListObjectsOptions{Prefix: prefix}
+	for obj := range s.client.ListObjects(ctx, s.config.Bucket, opt) {
+		if obj.Err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(obj.Err, "list objects '%s'", prefix))
+		}
+		// backups are the top level folders
+		if strings.HasSuffix(obj.Key, "/") {
+			ids = append(ids, strings.TrimSuffix(strings.TrimPrefix(obj.Key, prefix), "/"))
+		}
+	}
+	return ids, nil
+}
+
+func (s *s3Client) DeleteBackup(ctx context.Context, backupID string) error {
+	prefix := s.makeObjectName(backupID) + "/"
+	opt := minio.ListObjectsOptions{Prefix: prefix, Recursive: true}
+	objects := s.client.ListObjects(ctx, s.config.Bucket, opt)
+
+	var err error
+	for rerr := range s.client.RemoveObjects(ctx, s.config.Bucket, objects, minio.RemoveObjectsOptions{}) {
+		if err == nil {
+			err = backup.NewErrInternal(errors.Wrapf(rerr.Err, "remove object '%s'", rerr.ObjectName))
+		}
+	}
+	return err
+}
+
+func (s *s3Client) DeleteObject(ctx context.Context, backupID, key string) error {
+	objectName := s.makeObjectName(backupID, key)
+	// removing a missing object succeeds
+	if err := s.client.RemoveObject(ctx, s.config.Bucket, objectName, minio.RemoveObjectOptions{}); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "remove object '%s'", objectName))
+	}
+	return nil
+}
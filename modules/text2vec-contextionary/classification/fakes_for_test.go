@@ -329,7 +329,7 @@ type fakeModulesProvider struct {
 	contextualClassifier modulecapabilities.Classifier
 }
 
-func (fmp *fakeModulesProvider) VectorFromInput(ctx context.Context, className string, input string) ([]float32, error) {
+func (fmp *fakeModulesProvider) VectorFromInput(ctx context.Context, className, input, targetVector string) ([]float32, error) {
 	panic("not implemented")
 }
 
@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikeys
+
+// ErrUnprocessable means that an api key is invalid
+type ErrUnprocessable struct {
+	err error
+}
+
+func (e ErrUnprocessable) Error() string {
+	return e.err.Error()
+}
+
+func NewErrUnprocessable(err error) ErrUnprocessable {
+	return ErrUnprocessable{err}
+}
+
+// ErrNotFound means that an api key does not exist
+type ErrNotFound struct {
+	err error
+}
+
+func (e ErrNotFound) Error() string {
+	return e.err.Error()
+}
+
+func NewErrNotFound(err error) ErrNotFound {
+	return ErrNotFound{err}
+}
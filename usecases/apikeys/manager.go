@@ -0,0 +1,293 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikeys
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// Repo stores the api keys which were created at runtime. Save replaces the
+// keys on all nodes of the cluster and fails if they were changed
+// concurrently.
+type Repo interface {
+	Load(ctx context.Context) (*apikey.KeySet, error)
+	Save(ctx context.Context, keys *apikey.KeySet) error
+	// RegisterUpdateCallback is called whenever the keys were changed by
+	// another node
+	RegisterUpdateCallback(func(keys *apikey.KeySet))
+	// LastUsed returns when the keys were used last on any node
+	LastUsed(ctx context.Context) (map[string]time.Time, error)
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// Manager manages the api keys which are created at runtime
+type Manager struct {
+	// writeLock serializes changes made through this node. It is never held
+	// by setKeys, which is called while the repo replicates a change.
+	writeLock sync.Mutex
+
+	sync.Mutex
+	repo       Repo
+	authorizer authorizer
+	client     *apikey.Client
+	keys       *apikey.KeySet
+	// rootUsers may only be given keys by themselves, a key for them grants
+	// every permission
+	rootUsers map[string]struct{}
+}
+
+// NewManager loads the stored keys and keeps the api key client up to date
+// with any change of the keys in the cluster. Keys for the root users can
+// only be created by the root users themselves.
+func NewManager(ctx context.Context, repo Repo, authorizer authorizer,
+	client *apikey.Client, rootUsers []string,
+) (*Manager, error) {
+	keys, err := repo.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load api keys: %w", err)
+	}
+	if keys == nil {
+		keys = apikey.NewKeySet()
+	}
+
+	m := &Manager{
+		repo:       repo,
+		authorizer: authorizer,
+		client:     client,
+		keys:       keys,
+		rootUsers:  make(map[string]struct{}, len(rootUsers)),
+	}
+	for _, user := range rootUsers {
+		m.rootUsers[user] = struct{}{}
+	}
+	client.SetKeys(keys)
+	repo.RegisterUpdateCallback(m.setKeys)
+	return m, nil
+}
+
+func (m *Manager) setKeys(keys *apikey.KeySet) {
+	m.Lock()
+	defer m.Unlock()
+	if keys.Version < m.keys.Version {
+		return
+	}
+	m.keys = keys
+	m.client.SetKeys(keys)
+}
+
+// List returns all keys, their secrets are never returned
+func (m *Manager) List(ctx context.Context, principal *models.Principal) ([]*models.APIKey, error) {
+	if err := m.authorizer.Authorize(principal, "list", authorization.APIKeys(authorization.All)); err != nil {
+		return nil, err
+	}
+
+	lastUsed, err := m.repo.LastUsed(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read usage of api keys: %w", err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	out := make([]*models.APIKey, 0, len(m.keys.Keys))
+	for _, key := range m.keys.Keys {
+		out = append(out, toModel(key, "", lastUsed[key.ID]))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return time.Time(out[i].CreatedAt).Before(time.Time(out[j].CreatedAt))
+	})
+	return out, nil
+}
+
+// Create creates a key for a user, the returned model contains the api key
+func (m *Manager) Create(ctx context.Context, principal *models.Principal,
+	params *models.APIKey,
+) (*models.APIKey, error) {
+	if err := m.authorizer.Authorize(principal, "create", authorization.APIKeys(authorization.All)); err != nil {
+		return nil, err
+	}
+	if params == nil || params.User == nil || *params.User == "" {
+		return nil, NewErrUnprocessable(fmt.Errorf("user is required"))
+	}
+	if err := m.authorizeUser(principal, "create", *params.User); err != nil {
+		return nil, err
+	}
+	var expiresAt time.Time
+	if params.ExpiresAt != nil {
+		expiresAt = time.Time(*params.ExpiresAt)
+		if !expiresAt.After(time.Now()) {
+			return nil, NewErrUnprocessable(fmt.Errorf("expiration time must be in the future"))
+		}
+	}
+
+	key, raw, err := apikey.NewKey(*params.User, params.Description, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.update(ctx, func(keys *apikey.KeySet) error {
+		keys.Keys[key.ID] = key
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toModel(key, raw, time.Time{}), nil
+}
+
+// authorizeUser makes sure the principal may act on behalf of the user, as a
+// key grants all permissions of its user. Otherwise anyone allowed to manage
+// keys could create or rotate one of a more privileged user. Keys of root
+// users can only be managed by the root users themselves.
+func (m *Manager) authorizeUser(principal *models.Principal, verb, user string) error {
+	if principal != nil && principal.Username == user {
+		return nil
+	}
+	if _, ok := m.rootUsers[user]; ok {
+		return errors.NewForbidden(principal, verb, authorization.Users(user))
+	}
+	return m.authorizer.Authorize(principal, "update", authorization.Users(user))
+}
+
+// Rotate replaces the secret of a key, the returned model contains the new
+// api key
+func (m *Manager) Rotate(ctx context.Context, principal *models.Principal, id string) (*models.APIKey, error) {
+	if err := m.authorizer.Authorize(principal, "update", authorization.APIKeys(id)); err != nil {
+		return nil, err
+	}
+
+	var (
+		rotated *apikey.Key
+		raw     string
+	)
+	err := m.update(ctx, func(keys *apikey.KeySet) error {
+		key, ok := keys.Keys[id]
+		if !ok {
+			return NewErrNotFound(fmt.Errorf("api key %q not found", id))
+		}
+		if err := m.authorizeUser(principal, "update", key.User); err != nil {
+			return err
+		}
+		var err error
+		raw, err = key.Rotate()
+		rotated = key
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toModel(rotated, raw, time.Time{}), nil
+}
+
+// Expire sets the expiration time of a key, a zero time expires the key
+// immediately
+func (m *Manager) Expire(ctx context.Context, principal *models.Principal,
+	id string, expiresAt time.Time,
+) (*models.APIKey, error) {
+	if err := m.authorizer.Authorize(principal, "update", authorization.APIKeys(id)); err != nil {
+		return nil, err
+	}
+	if expiresAt.IsZero() {
+		expiresAt = time.Now()
+	}
+
+	var expired *apikey.Key
+	err := m.update(ctx, func(keys *apikey.KeySet) error {
+		key, ok := keys.Keys[id]
+		if !ok {
+			return NewErrNotFound(fmt.Errorf("api key %q not found", id))
+		}
+		if err := m.authorizeUser(principal, "update", key.User); err != nil {
+			return err
+		}
+		key.ExpiresAt = expiresAt
+		expired = key
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toModel(expired, "", time.Time{}), nil
+}
+
+// Revoke deletes a key
+func (m *Manager) Revoke(ctx context.Context, principal *models.Principal, id string) error {
+	if err := m.authorizer.Authorize(principal, "delete", authorization.APIKeys(id)); err != nil {
+		return err
+	}
+
+	return m.update(ctx, func(keys *apikey.KeySet) error {
+		key, ok := keys.Keys[id]
+		if !ok {
+			return NewErrNotFound(fmt.Errorf("api key %q not found", id))
+		}
+		if err := m.authorizeUser(principal, "delete", key.User); err != nil {
+			return err
+		}
+		delete(keys.Keys, id)
+		return nil
+	})
+}
+
+// update applies a change to a copy of the current keys and replaces the
+// keys in the cluster
+func (m *Manager) update(ctx context.Context, change func(keys *apikey.KeySet) error) error {
+	m.writeLock.Lock()
+	defer m.writeLock.Unlock()
+
+	m.Lock()
+	keys := m.keys.DeepCopy()
+	m.Unlock()
+
+	if err := change(keys); err != nil {
+		return err
+	}
+	keys.Version++
+
+	if err := m.repo.Save(ctx, keys); err != nil {
+		return fmt.Errorf("save api keys: %w", err)
+	}
+	m.setKeys(keys)
+	return nil
+}
+
+func toModel(key *apikey.Key, raw string, lastUsed time.Time) *models.APIKey {
+	user := key.User
+	out := &models.APIKey{
+		ID:          key.ID,
+		User:        &user,
+		Description: key.Description,
+		Key:         raw,
+		CreatedAt:   strfmt.DateTime(key.CreatedAt),
+	}
+	if !key.ExpiresAt.IsZero() {
+		expiresAt := strfmt.DateTime(key.ExpiresAt)
+		out.ExpiresAt = &expiresAt
+	}
+	if !lastUsed.IsZero() {
+		used := strfmt.DateTime(lastUsed)
+		out.LastUsedAt = &used
+	}
+	return out
+}
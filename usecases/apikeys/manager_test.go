@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikeys
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeRepo struct {
+	keys     *apikey.KeySet
+	lastUsed map[string]time.Time
+	callback func(*apikey.KeySet)
+}
+
+func (f *fakeRepo) Load(ctx context.Context) (*apikey.KeySet, error) {
+	return f.keys, nil
+}
+
+func (f *fakeRepo) Save(ctx context.Context, keys *apikey.KeySet) error {
+	f.keys = keys
+	return nil
+}
+
+func (f *fakeRepo) RegisterUpdateCallback(callback func(*apikey.KeySet)) {
+	f.callback = callback
+}
+
+func (f *fakeRepo) LastUsed(ctx context.Context) (map[string]time.Time, error) {
+	return f.lastUsed, nil
+}
+
+// fakeAuthorizer allows the root user everything and the key managers
+// everything but the root user
+type fakeAuthorizer struct{}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		return errors.NewForbidden(principal, verb, resource)
+	}
+	switch principal.Username {
+	case "admin":
+		return nil
+	case "key-manager":
+		if resource != "users/admin" {
+			return nil
+		}
+	}
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func newTestManager(t *testing.T) (*Manager, *fakeRepo, *apikey.Client) {
+	client, err := apikey.New(config.Config{
+		Authentication: config.Authentication{
+			APIKey: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"static-key"},
+				Users:       []string{"admin"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	repo := &fakeRepo{}
+	m, err := NewManager(context.Background(), repo, &fakeAuthorizer{}, client, []string{"admin"})
+	require.Nil(t, err)
+	return m, repo, client
+}
+
+func Test_Manager(t *testing.T) {
+	ctx := context.Background()
+	admin := &models.Principal{Username: "admin"}
+	user := "jane"
+
+	t.Run("key lifecycle", func(t *testing.T) {
+		m, repo, client := newTestManager(t)
+
+		created, err := m.Create(ctx, admin, &models.APIKey{User: &user, Description: "ci"})
+		require.Nil(t, err)
+		require.NotEmpty(t, created.Key)
+		assert.Equal(t, uint64(1), repo.keys.Version)
+		assert.NotContains(t, repo.keys.Keys[created.ID].Hash, created.Key)
+
+		principal, err := client.ValidateAndExtract(created.Key, nil)
+		require.Nil(t, err)
+		assert.Equal(t, user, principal.Username)
+
+		repo.lastUsed = client.LastUsed()
+		keys, err := m.List(ctx, admin)
+		require.Nil(t, err)
+		require.Len(t, keys, 1)
+		assert.Empty(t, keys[0].Key)
+		assert.Equal(t, "ci", keys[0].Description)
+		assert.NotNil(t, keys[0].LastUsedAt)
+
+		rotated, err := m.Rotate(ctx, admin, created.ID)
+		require.Nil(t, err)
+		assert.Equal(t, created.ID, rotated.ID)
+		_, err = client.ValidateAndExtract(created.Key, nil)
+		assert.NotNil(t, err)
+		_, err = client.ValidateAndExtract(rotated.Key, nil)
+		assert.Nil(t, err)
+
+		expired, err := m.Expire(ctx, admin, created.ID, time.Time{})
+		require.Nil(t, err)
+		assert.NotNil(t, expired.ExpiresAt)
+		_, err = client.ValidateAndExtract(rotated.Key, nil)
+		assert.NotNil(t, err)
+
+		require.Nil(t, m.Revoke(ctx, admin, created.ID))
+		keys, err = m.List(ctx, admin)
+		require.Nil(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		m, _, _ := newTestManager(t)
+
+		_, err := m.Create(ctx, admin, &models.APIKey{})
+		assert.IsType(t, ErrUnprocessable{}, err)
+
+		past := strfmt.DateTime(time.Now().Add(-time.Hour))
+		_, err = m.Create(ctx, admin, &models.APIKey{User: &user, ExpiresAt: &past})
+		assert.IsType(t, ErrUnprocessable{}, err)
+
+		_, err = m.Rotate(ctx, admin, "missing")
+		assert.IsType(t, ErrNotFound{}, err)
+		assert.IsType(t, ErrNotFound{}, m.Revoke(ctx, admin, "missing"))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		m, _, _ := newTestManager(t)
+		jane := &models.Principal{Username: user}
+
+		_, err := m.Create(ctx, jane, &models.APIKey{User: &user})
+		assert.IsType(t, errors.Forbidden{}, err)
+		_, err = m.List(ctx, jane)
+		assert.IsType(t, errors.Forbidden{}, err)
+	})
+
+	t.Run("keys for root users", func(t *testing.T) {
+		m, repo, _ := newTestManager(t)
+		root := "admin"
+		manager := &models.Principal{Username: "key-manager"}
+
+		_, err := m.Create(ctx, manager, &models.APIKey{User: &root})
+		assert.IsType(t, errors.Forbidden{}, err)
+		assert.Nil(t, repo.keys)
+
+		_, err = m.Create(ctx, manager, &models.APIKey{User: &user})
+		assert.Nil(t, err)
+		_, err = m.Create(ctx, admin, &models.APIKey{User: &root})
+		assert.Nil(t, err)
+	})
+
+	t.Run("root users' keys can't be changed by others", func(t *testing.T) {
+		m, _, _ := newTestManager(t)
+		root := "admin"
+		manager := &models.Principal{Username: "key-manager"}
+		rootKey, err := m.Create(ctx, admin, &models.APIKey{User: &root})
+		require.Nil(t, err)
+		userKey, err := m.Create(ctx, manager, &models.APIKey{User: &user})
+		require.Nil(t, err)
+
+		_, err = m.Rotate(ctx, manager, rootKey.ID)
+		assert.IsType(t, errors.Forbidden{}, err)
+		_, err = m.Expire(ctx, manager, rootKey.ID, time.Time{})
+		assert.IsType(t, errors.Forbidden{}, err)
+		err = m.Revoke(ctx, manager, rootKey.ID)
+		assert.IsType(t, errors.Forbidden{}, err)
+
+		listed, err := m.List(ctx, admin)
+		require.Nil(t, err)
+		for _, key := range listed {
+			if key.ID == rootKey.ID {
+				assert.Nil(t, key.ExpiresAt, "root key is unchanged")
+			}
+		}
+
+		_, err = m.Rotate(ctx, manager, userKey.ID)
+		assert.Nil(t, err)
+		_, err = m.Expire(ctx, manager, userKey.ID, time.Time{})
+		assert.Nil(t, err)
+		assert.Nil(t, m.Revoke(ctx, manager, userKey.ID))
+		_, err = m.Rotate(ctx, admin, rootKey.ID)
+		assert.Nil(t, err)
+	})
+
+	t.Run("keys changed by another node", func(t *testing.T) {
+		m, repo, client := newTestManager(t)
+
+		key, raw, err := apikey.NewKey(user, "", time.Time{})
+		require.Nil(t, err)
+		keys := apikey.NewKeySet()
+		keys.Version = 1
+		keys.Keys[key.ID] = key
+		repo.callback(keys)
+
+		_, err = client.ValidateAndExtract(raw, nil)
+		assert.Nil(t, err)
+		listed, err := m.List(ctx, admin)
+		require.Nil(t, err)
+		assert.Len(t, listed, 1)
+	})
+}
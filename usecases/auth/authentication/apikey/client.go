@@ -15,6 +15,8 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"fmt"
+	"sync"
+	"time"
 
 	errors "github.com/go-openapi/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -24,6 +26,12 @@ import (
 type Client struct {
 	config     config.APIKey
 	keystorage [][sha256.Size]byte
+
+	// keys created at runtime, the static keys of the config are always
+	// valid in addition to them
+	sync.RWMutex
+	keys     map[string]*Key
+	lastUsed sync.Map
 }
 
 func New(cfg config.Config) (*Client, error) {
@@ -86,13 +94,19 @@ func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Prin
 	}
 
 	tokenPos, ok := c.isTokenAllowed(token)
-	if !ok {
-		return nil, errors.New(401, "invalid api key, please provide a valid api key")
+	if ok {
+		return &models.Principal{
+			Username: c.getUser(tokenPos),
+		}, nil
+	}
+
+	if key, ok := c.isRuntimeKeyAllowed(token); ok {
+		return &models.Principal{
+			Username: key.User,
+		}, nil
 	}
 
-	return &models.Principal{
-		Username: c.getUser(tokenPos),
-	}, nil
+	return nil, errors.New(401, "invalid api key, please provide a valid api key")
 }
 
 func (c *Client) isTokenAllowed(token string) (int, bool) {
@@ -107,6 +121,68 @@ func (c *Client) isTokenAllowed(token string) (int, bool) {
 	return -1, false
 }
 
+func (c *Client) isRuntimeKeyAllowed(token string) (*Key, bool) {
+	id, secret, ok := parseKey(token)
+	if !ok {
+		return nil, false
+	}
+
+	c.RLock()
+	key, ok := c.keys[id]
+	c.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	hash := hashSecret(secret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(key.Hash)) != 1 {
+		return nil, false
+	}
+
+	now := time.Now()
+	if key.Expired(now) {
+		return nil, false
+	}
+
+	c.lastUsed.Store(id, now)
+	return key, true
+}
+
+// SetKeys replaces the keys which were created at runtime
+func (c *Client) SetKeys(keys *KeySet) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.keys = keys.Keys
+	c.lastUsed.Range(func(id, _ any) bool {
+		if _, ok := c.keys[id.(string)]; !ok {
+			c.lastUsed.Delete(id)
+		}
+		return true
+	})
+}
+
+// LastUsed returns when the keys which were created at runtime were used
+// last on this node
+func (c *Client) LastUsed() map[string]time.Time {
+	lastUsed := map[string]time.Time{}
+	c.lastUsed.Range(func(id, used any) bool {
+		lastUsed[id.(string)] = used.(time.Time)
+		return true
+	})
+	return lastUsed
+}
+
+// RestoreLastUsed adds usage which was recorded before, e.g. before the node
+// was restarted. More recent usage is kept.
+func (c *Client) RestoreLastUsed(lastUsed map[string]time.Time) {
+	for id, used := range lastUsed {
+		if prev, loaded := c.lastUsed.LoadOrStore(id, used); loaded && prev.(time.Time).Before(used) {
+			c.lastUsed.Store(id, used)
+		}
+	}
+}
+
 func (c *Client) getUser(pos int) string {
 	// passed validation guarantees that one of those options will work
 	if pos >= len(c.config.Users) {
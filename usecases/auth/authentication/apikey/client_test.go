@@ -13,6 +13,7 @@ package apikey
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -186,3 +187,72 @@ func Test_APIKeyClient(t *testing.T) {
 		})
 	}
 }
+
+func Test_APIKeyClient_RuntimeKeys(t *testing.T) {
+	c, err := New(config.Config{
+		Authentication: config.Authentication{
+			APIKey: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"static-key"},
+				Users:       []string{"admin"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	key, raw, err := NewKey("jane", "ci", time.Time{})
+	require.Nil(t, err)
+	assert.NotContains(t, key.Hash, raw)
+
+	expired, expiredRaw, err := NewKey("john", "", time.Now().Add(-time.Second))
+	require.Nil(t, err)
+
+	keys := NewKeySet()
+	keys.Keys[key.ID] = key
+	keys.Keys[expired.ID] = expired
+	c.SetKeys(keys)
+
+	t.Run("static keys are still valid", func(t *testing.T) {
+		principal, err := c.ValidateAndExtract("static-key", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "admin", principal.Username)
+	})
+
+	t.Run("runtime key", func(t *testing.T) {
+		principal, err := c.ValidateAndExtract(raw, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "jane", principal.Username)
+		assert.Contains(t, c.LastUsed(), key.ID)
+	})
+
+	t.Run("expired key", func(t *testing.T) {
+		_, err := c.ValidateAndExtract(expiredRaw, nil)
+		assert.NotNil(t, err)
+		assert.NotContains(t, c.LastUsed(), expired.ID)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		_, err := c.ValidateAndExtract(keyPrefix+key.ID+".wrong", nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("rotated key", func(t *testing.T) {
+		rotated := *key
+		newRaw, err := rotated.Rotate()
+		require.Nil(t, err)
+		keys := NewKeySet()
+		keys.Keys[rotated.ID] = &rotated
+		c.SetKeys(keys)
+
+		_, err = c.ValidateAndExtract(raw, nil)
+		assert.NotNil(t, err)
+		principal, err := c.ValidateAndExtract(newRaw, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "jane", principal.Username)
+	})
+
+	t.Run("revoked key", func(t *testing.T) {
+		c.SetKeys(NewKeySet())
+		assert.Empty(t, c.LastUsed())
+	})
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// keyPrefix identifies api keys which were created at runtime, the id of the
+// key and its secret follow the prefix separated by a dot
+const keyPrefix = "wvk-"
+
+// Key is an api key which was created at runtime. Only the hash of its
+// secret is stored, the secret itself is returned once when the key is
+// created or rotated.
+type Key struct {
+	ID          string    `json:"id"`
+	User        string    `json:"user"`
+	Description string    `json:"description,omitempty"`
+	Hash        string    `json:"hash"`
+	CreatedAt   time.Time `json:"createdAt"`
+	// ExpiresAt is zero if the key does not expire
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+}
+
+// Expired is true if the key has expired at the given time
+func (k *Key) Expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
+
+// KeySet contains all keys which were created at runtime. The version is
+// increased with every change, so that nodes can tell which set is the most
+// recent one.
+type KeySet struct {
+	Version uint64          `json:"version"`
+	Keys    map[string]*Key `json:"keys"`
+}
+
+func NewKeySet() *KeySet {
+	return &KeySet{Keys: map[string]*Key{}}
+}
+
+func (s *KeySet) DeepCopy() *KeySet {
+	out := &KeySet{Version: s.Version, Keys: make(map[string]*Key, len(s.Keys))}
+	for id, key := range s.Keys {
+		k := *key
+		out.Keys[id] = &k
+	}
+	return out
+}
+
+// NewKey creates a key for a user and returns it together with the api key
+// which has to be handed to the user
+func NewKey(user, description string, expiresAt time.Time) (*Key, string, error) {
+	id, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &Key{
+		ID:          id,
+		User:        user,
+		Description: description,
+		CreatedAt:   time.Now(),
+		ExpiresAt:   expiresAt,
+	}
+	raw, err := key.Rotate()
+	if err != nil {
+		return nil, "", err
+	}
+	return key, raw, nil
+}
+
+// Rotate replaces the secret of the key and returns the new api key
+func (k *Key) Rotate() (string, error) {
+	secret, err := randomString(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return "", err
+	}
+	k.Hash = hashSecret(secret)
+	return keyPrefix + k.ID + "." + secret, nil
+}
+
+// parseKey splits an api key which was created at runtime into its id and
+// secret
+func parseKey(raw string) (string, string, bool) {
+	if !strings.HasPrefix(raw, keyPrefix) {
+		return "", "", false
+	}
+	id, secret, ok := strings.Cut(strings.TrimPrefix(raw, keyPrefix), ".")
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func randomString(n int, encode func([]byte) string) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random key: %w", err)
+	}
+	return encode(b), nil
+}
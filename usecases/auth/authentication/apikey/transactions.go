@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	// PutKeys replaces the api keys on all nodes
+	PutKeys cluster.TransactionType = "put_apikeys"
+	// ReadKeys reads the api keys of all nodes, it is used to sync the keys
+	// of a node which (re-)joins the cluster
+	ReadKeys cluster.TransactionType = "read_apikeys"
+	// ReadUsage reads when the api keys were used last on each node
+	ReadUsage cluster.TransactionType = "read_apikey_usage"
+)
+
+type PutKeysPayload struct {
+	Keys *KeySet `json:"keys"`
+}
+
+type ReadKeysPayload struct {
+	Keys *KeySet `json:"keys"`
+}
+
+type ReadUsagePayload struct {
+	LastUsed map[string]time.Time `json:"lastUsed"`
+}
+
+func UnmarshalTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case PutKeys:
+		var pl PutKeysPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case ReadKeys:
+		var pl ReadKeysPayload
+		if len(payload) == 0 {
+			return pl, nil
+		}
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case ReadUsage:
+		var pl ReadUsagePayload
+		if len(payload) == 0 {
+			return pl, nil
+		}
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized apikey transaction type %q", txType)
+	}
+}
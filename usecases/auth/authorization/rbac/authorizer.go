@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// Authorizer grants access based on the roles assigned to the user and to
+// the groups of the user. The policy is replaced whenever it changes anywhere
+// in the cluster.
+type Authorizer struct {
+	rootUsers  map[string]struct{}
+	rootGroups map[string]struct{}
+
+	sync.RWMutex
+	roles  map[string]compiledRole
+	users  map[string][]string
+	groups map[string][]string
+}
+
+// New Authorizer using role based access control. Until a policy is set only
+// the root users and groups have access.
+func New(cfg Config) *Authorizer {
+	a := &Authorizer{
+		rootUsers:  make(map[string]struct{}, len(cfg.RootUsers)),
+		rootGroups: make(map[string]struct{}, len(cfg.RootGroups)),
+	}
+	for _, user := range cfg.RootUsers {
+		a.rootUsers[user] = struct{}{}
+	}
+	for _, group := range cfg.RootGroups {
+		a.rootGroups[group] = struct{}{}
+	}
+	a.SetPolicy(NewPolicy())
+	return a
+}
+
+// SetPolicy replaces the roles and assignments used for authorization
+func (a *Authorizer) SetPolicy(p *Policy) {
+	roles := make(map[string]compiledRole, len(builtinRoles)+len(p.Roles))
+	for name, role := range builtinRoles {
+		roles[name] = compileRole(role)
+	}
+	for name, role := range p.Roles {
+		roles[name] = compileRole(role)
+	}
+	users := copyAssignments(p.Users)
+	groups := copyAssignments(p.Groups)
+
+	a.Lock()
+	defer a.Unlock()
+	a.roles, a.users, a.groups = roles, users, groups
+}
+
+// Authorize allows the action if any role of the user or of one of their
+// groups allows the verb on the resource
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		principal = &models.Principal{Username: adminlist.AnonymousPrincipalUsername}
+	}
+
+	if a.isRoot(principal) {
+		return nil
+	}
+
+	a.RLock()
+	defer a.RUnlock()
+
+	for _, name := range a.users[principal.Username] {
+		if a.roles[name].allows(verb, resource) {
+			return nil
+		}
+	}
+	for _, group := range principal.Groups {
+		for _, name := range a.groups[group] {
+			if a.roles[name].allows(verb, resource) {
+				return nil
+			}
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func (a *Authorizer) isRoot(principal *models.Principal) bool {
+	if _, ok := a.rootUsers[principal.Username]; ok {
+		return true
+	}
+	for _, group := range principal.Groups {
+		if _, ok := a.rootGroups[group]; ok {
+			return true
+		}
+	}
+	return false
+}
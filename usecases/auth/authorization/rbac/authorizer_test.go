@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+func testPolicy() *Policy {
+	p := NewPolicy()
+	p.Roles["article-reader"] = newRole("article-reader", []string{"get", "list"},
+		"data/collections/Article/*")
+	p.Roles["tenant-a-writer"] = newRole("tenant-a-writer", []string{"*"},
+		"data/collections/*/tenants/tenant-a*/objects/*")
+	p.Users["alice"] = []string{"article-reader"}
+	p.Users["bob"] = []string{RoleViewer}
+	p.Groups["service-a"] = []string{"tenant-a-writer"}
+	return p
+}
+
+func Test_RBAC_Authorizer(t *testing.T) {
+	a := New(Config{
+		Enabled:    true,
+		RootUsers:  []string{"root"},
+		RootGroups: []string{"ops"},
+	})
+	a.SetPolicy(testPolicy())
+
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		verb      string
+		resource  string
+		allowed   bool
+	}{
+		{
+			name:      "root user is allowed everything",
+			principal: &models.Principal{Username: "root"},
+			verb:      "delete",
+			resource:  "schema/collections/Article",
+			allowed:   true,
+		},
+		{
+			name:      "member of root group is allowed everything",
+			principal: &models.Principal{Username: "carol", Groups: []string{"ops"}},
+			verb:      "delete",
+			resource:  "roles/*",
+			allowed:   true,
+		},
+		{
+			name:      "anonymous user without roles is denied",
+			principal: nil,
+			verb:      "get",
+			resource:  "data/collections/Article/tenants/*/objects/*",
+			allowed:   false,
+		},
+		{
+			name:      "role allows action on matching class",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "get",
+			resource:  "data/collections/Article/tenants/*/objects/*",
+			allowed:   true,
+		},
+		{
+			name:      "role does not allow other actions",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "update",
+			resource:  "data/collections/Article/tenants/*/objects/*",
+			allowed:   false,
+		},
+		{
+			name:      "role does not allow other classes",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "get",
+			resource:  "data/collections/Articles/tenants/*/objects/*",
+			allowed:   false,
+		},
+		{
+			name:      "class pattern does not grant access to all classes",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "list",
+			resource:  "data/collections/*/tenants/*/objects/*",
+			allowed:   false,
+		},
+		{
+			name:      "group role allows matching tenant",
+			principal: &models.Principal{Username: "svc", Groups: []string{"service-a"}},
+			verb:      "create",
+			resource:  "data/collections/Article/tenants/tenant-a1/objects/*",
+			allowed:   true,
+		},
+		{
+			name:      "group role denies other tenants",
+			principal: &models.Principal{Username: "svc", Groups: []string{"service-a"}},
+			verb:      "create",
+			resource:  "data/collections/Article/tenants/tenant-b/objects/*",
+			allowed:   false,
+		},
+		{
+			name:      "built-in viewer role allows reading",
+			principal: &models.Principal{Username: "bob"},
+			verb:      "list",
+			resource:  "backups/*/*",
+			allowed:   true,
+		},
+		{
+			name:      "built-in viewer role denies writing",
+			principal: &models.Principal{Username: "bob"},
+			verb:      "create",
+			resource:  "backups/s3/my-backup",
+			allowed:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := a.Authorize(test.principal, test.verb, test.resource)
+			if test.allowed {
+				assert.Nil(t, err)
+				return
+			}
+			principal := test.principal
+			if principal == nil {
+				principal = &models.Principal{Username: "anonymous"}
+			}
+			assert.Equal(t, errors.NewForbidden(principal, test.verb, test.resource), err)
+		})
+	}
+
+	t.Run("replacing the policy revokes access", func(t *testing.T) {
+		principal := &models.Principal{Username: "alice"}
+		resource := "data/collections/Article/tenants/*/objects/*"
+		a := New(Config{Enabled: true, RootUsers: []string{"root"}})
+		a.SetPolicy(testPolicy())
+		assert.Nil(t, a.Authorize(principal, "get", resource))
+
+		a.SetPolicy(NewPolicy())
+		assert.NotNil(t, a.Authorize(principal, "get", resource))
+	})
+}
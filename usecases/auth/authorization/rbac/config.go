@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import "fmt"
+
+// Config enables role based access control. Root users and the members of
+// root groups have access to every resource, they are needed to create the
+// first roles and assign them to the other subjects.
+type Config struct {
+	Enabled    bool     `json:"enabled" yaml:"enabled"`
+	RootUsers  []string `json:"root_users" yaml:"root_users"`
+	RootGroups []string `json:"root_groups" yaml:"root_groups"`
+}
+
+// Validate rbac config for viability, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if len(c.RootUsers) == 0 && len(c.RootGroups) == 0 {
+		return fmt.Errorf("rbac: at least one root user or root group is required")
+	}
+
+	return nil
+}
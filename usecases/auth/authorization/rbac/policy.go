@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Built-in roles are always present and cannot be changed or deleted
+const (
+	// RoleAdmin allows every action on every resource
+	RoleAdmin = "admin"
+	// RoleViewer allows reading every resource
+	RoleViewer = "viewer"
+)
+
+// allActions is used in place of the actions of a permission to allow every
+// action
+const allActions = "*"
+
+var actions = map[string]struct{}{
+	allActions: {},
+	"create":   {},
+	"get":      {},
+	"list":     {},
+	"head":     {},
+	"update":   {},
+	"delete":   {},
+	"validate": {},
+	"restore":  {},
+}
+
+var validRoleName = regexp.MustCompile(`^[a-zA-Z0-9_\-.@]+$`)
+
+// Policy contains the roles and which users and groups they are assigned to.
+// It is replicated to all nodes of the cluster, the version is incremented
+// with every change, so that a node can tell which policy is the most recent.
+type Policy struct {
+	Version uint64                  `json:"version"`
+	Roles   map[string]*models.Role `json:"roles"`
+	Users   map[string][]string     `json:"users"`
+	Groups  map[string][]string     `json:"groups"`
+}
+
+// NewPolicy returns an empty policy without any custom roles or assignments
+func NewPolicy() *Policy {
+	return &Policy{
+		Roles:  map[string]*models.Role{},
+		Users:  map[string][]string{},
+		Groups: map[string][]string{},
+	}
+}
+
+// UnmarshalJSON makes sure that the maps of a parsed policy are never nil
+func (p *Policy) UnmarshalJSON(data []byte) error {
+	type plain Policy
+	var parsed plain
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+
+	*p = *NewPolicy()
+	p.Version = parsed.Version
+	for name, role := range parsed.Roles {
+		p.Roles[name] = role
+	}
+	for subject, roles := range parsed.Users {
+		p.Users[subject] = roles
+	}
+	for subject, roles := range parsed.Groups {
+		p.Groups[subject] = roles
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the policy which can be changed without
+// affecting the original
+func (p *Policy) DeepCopy() *Policy {
+	out := &Policy{
+		Version: p.Version,
+		Roles:   make(map[string]*models.Role, len(p.Roles)),
+		Users:   copyAssignments(p.Users),
+		Groups:  copyAssignments(p.Groups),
+	}
+	for name, role := range p.Roles {
+		out.Roles[name] = copyRole(role)
+	}
+	return out
+}
+
+// Role returns the custom or built-in role with the given name
+func (p *Policy) Role(name string) (*models.Role, bool) {
+	if role, ok := builtinRoles[name]; ok {
+		return copyRole(role), true
+	}
+	role, ok := p.Roles[name]
+	if !ok {
+		return nil, false
+	}
+	return copyRole(role), true
+}
+
+// AllRoles returns the built-in and custom roles sorted by name
+func (p *Policy) AllRoles() []*models.Role {
+	roles := make([]*models.Role, 0, len(builtinRoles)+len(p.Roles))
+	for _, role := range builtinRoles {
+		roles = append(roles, copyRole(role))
+	}
+	for _, role := range p.Roles {
+		roles = append(roles, copyRole(role))
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return *roles[i].Name < *roles[j].Name
+	})
+	return roles
+}
+
+// DeleteRole deletes a custom role and removes it from all users and groups
+func (p *Policy) DeleteRole(name string) {
+	delete(p.Roles, name)
+	removeRoleAssignments(p.Users, name)
+	removeRoleAssignments(p.Groups, name)
+}
+
+func removeRoleAssignments(assignments map[string][]string, role string) {
+	for subject, roles := range assignments {
+		kept := roles[:0]
+		for _, name := range roles {
+			if name != role {
+				kept = append(kept, name)
+			}
+		}
+		if len(kept) == 0 {
+			delete(assignments, subject)
+		} else {
+			assignments[subject] = kept
+		}
+	}
+}
+
+func copyAssignments(in map[string][]string) map[string][]string {
+	out := make(map[string][]string, len(in))
+	for subject, roles := range in {
+		out[subject] = append([]string(nil), roles...)
+	}
+	return out
+}
+
+func copyRole(role *models.Role) *models.Role {
+	name := *role.Name
+	out := &models.Role{
+		Name:        &name,
+		Permissions: make([]*models.Permission, len(role.Permissions)),
+	}
+	for i, perm := range role.Permissions {
+		resource := *perm.Resource
+		out.Permissions[i] = &models.Permission{
+			Actions:  append([]string(nil), perm.Actions...),
+			Resource: &resource,
+		}
+	}
+	return out
+}
+
+var builtinRoles = map[string]*models.Role{
+	RoleAdmin:  newRole(RoleAdmin, []string{allActions}, "*"),
+	RoleViewer: newRole(RoleViewer, []string{"get", "list", "head"}, "*"),
+}
+
+func newRole(name string, actions []string, resource string) *models.Role {
+	return &models.Role{
+		Name: &name,
+		Permissions: []*models.Permission{{
+			Actions:  actions,
+			Resource: &resource,
+		}},
+	}
+}
+
+// IsBuiltinRole returns whether the role is a built-in role
+func IsBuiltinRole(name string) bool {
+	_, ok := builtinRoles[name]
+	return ok
+}
+
+// ValidateRole checks that a custom role has a valid name and only grants
+// known actions on non-empty resource patterns
+func ValidateRole(role *models.Role) error {
+	if role == nil || role.Name == nil || *role.Name == "" {
+		return fmt.Errorf("role name is required")
+	}
+	name := *role.Name
+	if !validRoleName.MatchString(name) {
+		return fmt.Errorf("role name %q must only contain letters, digits and the characters _-.@", name)
+	}
+	if IsBuiltinRole(name) {
+		return fmt.Errorf("role %q is a built-in role", name)
+	}
+	if len(role.Permissions) == 0 {
+		return fmt.Errorf("role %q: at least one permission is required", name)
+	}
+	for i, perm := range role.Permissions {
+		if perm == nil || perm.Resource == nil || strings.TrimSpace(*perm.Resource) == "" {
+			return fmt.Errorf("role %q: permission %d: resource is required", name, i)
+		}
+		if len(perm.Actions) == 0 {
+			return fmt.Errorf("role %q: permission %d: at least one action is required", name, i)
+		}
+		for _, action := range perm.Actions {
+			if _, ok := actions[action]; !ok {
+				return fmt.Errorf("role %q: permission %d: unknown action %q", name, i, action)
+			}
+		}
+	}
+	return nil
+}
+
+// compiledRole is a role prepared for fast authorization decisions
+type compiledRole []compiledPermission
+
+type compiledPermission struct {
+	allActions bool
+	actions    map[string]struct{}
+	resource   *regexp.Regexp
+}
+
+func compileRole(role *models.Role) compiledRole {
+	out := make(compiledRole, 0, len(role.Permissions))
+	for _, perm := range role.Permissions {
+		cp := compiledPermission{
+			actions:  make(map[string]struct{}, len(perm.Actions)),
+			resource: compilePattern(*perm.Resource),
+		}
+		for _, action := range perm.Actions {
+			if action == allActions {
+				cp.allActions = true
+			}
+			cp.actions[action] = struct{}{}
+		}
+		out = append(out, cp)
+	}
+	return out
+}
+
+func (r compiledRole) allows(verb, resource string) bool {
+	for _, perm := range r {
+		if _, ok := perm.actions[verb]; !ok && !perm.allActions {
+			continue
+		}
+		if perm.resource.MatchString(resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// compilePattern turns a resource pattern into a regular expression. "*"
+// matches any sequence of characters including "/", so that a single pattern
+// can match a subtree of resources.
+func compilePattern(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_ValidateRole(t *testing.T) {
+	resource := "schema/collections/*"
+	tests := []struct {
+		name  string
+		role  *models.Role
+		valid bool
+	}{
+		{
+			name:  "valid role",
+			role:  newRole("editor", []string{"create", "update"}, resource),
+			valid: true,
+		},
+		{
+			name:  "all actions",
+			role:  newRole("editor", []string{"*"}, resource),
+			valid: true,
+		},
+		{
+			name: "missing name",
+			role: &models.Role{Permissions: newRole("x", []string{"get"}, resource).Permissions},
+		},
+		{
+			name: "invalid name",
+			role: newRole("edi tor", []string{"get"}, resource),
+		},
+		{
+			name: "built-in role",
+			role: newRole(RoleAdmin, []string{"get"}, resource),
+		},
+		{
+			name: "no permissions",
+			role: &models.Role{Name: newRole("editor", nil, resource).Name},
+		},
+		{
+			name: "no actions",
+			role: newRole("editor", nil, resource),
+		},
+		{
+			name: "unknown action",
+			role: newRole("editor", []string{"write"}, resource),
+		},
+		{
+			name: "empty resource",
+			role: newRole("editor", []string{"get"}, ""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateRole(test.role)
+			if test.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func Test_Policy(t *testing.T) {
+	t.Run("deleting a role removes its assignments", func(t *testing.T) {
+		p := testPolicy()
+		p.Users["dave"] = []string{"article-reader", RoleViewer}
+
+		p.DeleteRole("article-reader")
+
+		_, ok := p.Role("article-reader")
+		assert.False(t, ok)
+		assert.NotContains(t, p.Users, "alice")
+		assert.Equal(t, []string{RoleViewer}, p.Users["dave"])
+	})
+
+	t.Run("a copy is independent of the original", func(t *testing.T) {
+		p := testPolicy()
+		cp := p.DeepCopy()
+
+		cp.Users["alice"][0] = "other"
+		*cp.Roles["article-reader"].Permissions[0].Resource = "other"
+
+		assert.Equal(t, []string{"article-reader"}, p.Users["alice"])
+		assert.Equal(t, "data/collections/Article/*",
+			*p.Roles["article-reader"].Permissions[0].Resource)
+	})
+
+	t.Run("all roles include the built-in roles", func(t *testing.T) {
+		var names []string
+		for _, role := range testPolicy().AllRoles() {
+			names = append(names, *role.Name)
+		}
+		assert.Equal(t, []string{RoleAdmin, "article-reader", "tenant-a-writer", RoleViewer}, names)
+	})
+
+	t.Run("patterns", func(t *testing.T) {
+		assert.True(t, compilePattern("*").MatchString("schema/collections/Article"))
+		assert.True(t, compilePattern("schema/*/Article").MatchString("schema/collections/Article"))
+		assert.False(t, compilePattern("schema/*/Article").MatchString("schema/collections/Article2"))
+		assert.True(t, compilePattern("a.b").MatchString("a.b"))
+		assert.False(t, compilePattern("a.b").MatchString("axb"))
+	})
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	// PutPolicy replaces the policy on all nodes
+	PutPolicy cluster.TransactionType = "put_policy"
+	// ReadPolicy reads the policy of all nodes, it is used to sync the policy
+	// of a node which (re-)joins the cluster
+	ReadPolicy cluster.TransactionType = "read_policy"
+)
+
+type PutPolicyPayload struct {
+	Policy *Policy `json:"policy"`
+}
+
+type ReadPolicyPayload struct {
+	Policy *Policy `json:"policy"`
+}
+
+func UnmarshalTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case PutPolicy:
+		var pl PutPolicyPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case ReadPolicy:
+		var pl ReadPolicyPayload
+		if len(payload) == 0 {
+			return pl, nil
+		}
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized rbac transaction type %q", txType)
+	}
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// All is used in place of a resource name if an operation applies to all
+// resources of a kind, e.g. listing all classes or searching all tenants
+const All = "*"
+
+// Resources which are passed to the Authorizer. They are paths, so that
+// permissions can grant access to a subtree of resources with a single
+// pattern, e.g. "data/collections/Article/*" grants access to all objects of
+// all tenants of the class Article.
+const (
+	// GraphQL is required to send any request to the GraphQL API, the
+	// resolvers authorize the individual queries
+	GraphQL = "graphql"
+	// Nodes is the status of the nodes of the cluster
+	Nodes = "cluster/nodes"
+)
+
+// Collections is the definition of a class in the schema
+func Collections(class string) string {
+	return fmt.Sprintf("schema/collections/%s", orAll(class))
+}
+
+// Shards is a shard of a class
+func Shards(class, shard string) string {
+	return fmt.Sprintf("schema/collections/%s/shards/%s", orAll(class), orAll(shard))
+}
+
+// Tenants is a tenant of a class
+func Tenants(class, tenant string) string {
+	return fmt.Sprintf("schema/collections/%s/tenants/%s", orAll(class), orAll(tenant))
+}
+
+// Objects are the objects of a tenant of a class. The tenant is All for
+// classes without multi-tenancy.
+func Objects(class, tenant string, id strfmt.UUID) string {
+	return fmt.Sprintf("data/collections/%s/tenants/%s/objects/%s",
+		orAll(class), orAll(tenant), orAll(id.String()))
+}
+
+// Backups is a backup stored on a backend
+func Backups(backend, id string) string {
+	return fmt.Sprintf("backups/%s/%s", orAll(backend), orAll(id))
+}
+
+// Classifications is a classification
+func Classifications(id string) string {
+	return fmt.Sprintf("classifications/%s", orAll(id))
+}
+
+// Roles is a role of role based access control
+func Roles(name string) string {
+	return fmt.Sprintf("roles/%s", orAll(name))
+}
+
+// Users is a user, roles are assigned to users
+func Users(name string) string {
+	return fmt.Sprintf("users/%s", orAll(name))
+}
+
+// Groups is a group of users, roles are assigned to groups
+func Groups(name string) string {
+	return fmt.Sprintf("groups/%s", orAll(name))
+}
+
+// APIKeys is an api key which was created at runtime
+func APIKeys(id string) string {
+	return fmt.Sprintf("apikeys/%s", orAll(id))
+}
+
+func orAll(name string) string {
+	if name == "" {
+		return All
+	}
+	return name
+}
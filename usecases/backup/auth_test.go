@@ -40,7 +40,7 @@ func Test_Authorization(t *testing.T) {
 		{
 			methodName:       "Backup",
 			additionalArgs:   []interface{}{req},
-			expectedVerb:     "add",
+			expectedVerb:     "create",
 			expectedResource: "backups/s3/123",
 		},
 		{
@@ -53,13 +53,37 @@ func Test_Authorization(t *testing.T) {
 			methodName:       "Restore",
 			additionalArgs:   []interface{}{req},
 			expectedVerb:     "restore",
-			expectedResource: "backups/s3/123/restore",
+			expectedResource: "backups/s3/123",
 		},
 		{
 			methodName:       "RestorationStatus",
 			additionalArgs:   []interface{}{"s3", "123"},
 			expectedVerb:     "get",
-			expectedResource: "backups/s3/123/restore",
+			expectedResource: "backups/s3/123",
+		},
+		{
+			methodName:       "Cancel",
+			additionalArgs:   []interface{}{"s3", "123"},
+			expectedVerb:     "create",
+			expectedResource: "backups/s3/123",
+		},
+		{
+			methodName:       "CancelRestore",
+			additionalArgs:   []interface{}{"s3", "123"},
+			expectedVerb:     "restore",
+			expectedResource: "backups/s3/123",
+		},
+		{
+			methodName:       "List",
+			additionalArgs:   []interface{}{"s3"},
+			expectedVerb:     "list",
+			expectedResource: "backups/s3/*",
+		},
+		{
+			methodName:       "Delete",
+			additionalArgs:   []interface{}{"s3", "123"},
+			expectedVerb:     "delete",
+			expectedResource: "backups/s3/123",
 		},
 	}
 
@@ -71,7 +95,7 @@ func Test_Authorization(t *testing.T) {
 
 		for _, method := range allExportedMethods(&Scheduler{}) {
 			switch method {
-			case "OnCommit", "OnAbort", "OnCanCommit", "OnStatus":
+			case "OnCommit", "OnAbort", "OnCanCommit", "OnStatus", "OnCancel":
 				continue
 			}
 			assert.Contains(t, testedMethods, method)
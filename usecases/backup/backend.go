@@ -19,6 +19,7 @@ import (
 	"os"
 	"path"
 	"runtime"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -174,6 +175,11 @@ type uploader struct {
 	zipConfig
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+
+	// base contains the segment files of the base of an incremental backup
+	base        segmentIndex
+	transferred atomic.Int64
+	reused      atomic.Int64
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
@@ -185,6 +191,9 @@ func newUploader(sourcer Sourcer, backend nodeStore,
 		newZipConfig(0, 50, defaultChunkSize),
 		setstatus,
 		l,
+		segmentIndex{},
+		atomic.Int64{},
+		atomic.Int64{},
 	}
 }
 
@@ -193,6 +202,13 @@ func (u *uploader) withCompression(cfg zipConfig) *uploader {
 	return u
 }
 
+// withBase makes the backup reference unchanged segment files of base
+// instead of uploading them again
+func (u *uploader) withBase(base *backup.BackupDescriptor) *uploader {
+	u.base = newSegmentIndex(base)
+	return u
+}
+
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor) (err error) {
 	u.setStatus(backup.Transferring)
@@ -201,6 +217,7 @@ func (u *uploader) all(ctx context.Context, classes []string, desc *backup.Backu
 	defer func() {
 		//  make sure context is not cancelled when uploading metadata
 		ctx := context.Background()
+		desc.BytesTransferred, desc.BytesReused = u.transferred.Load(), u.reused.Load()
 		if err != nil {
 			desc.Error = err.Error()
 			err = fmt.Errorf("upload %w: %v", err, u.backend.PutMeta(ctx, desc))
@@ -347,9 +364,16 @@ func (u *uploader) compress(ctx context.Context,
 		defer zip.Close()
 		lastShardSize := int64(0)
 		for shard := range ch {
-			if _, err := zip.WriteShard(ctx, shard); err != nil {
+			reused, err := u.base.split(zip.sourcePath, class, shard)
+			if err != nil {
 				return err
 			}
+			written, err := zip.WriteShard(ctx, shard)
+			if err != nil {
+				return err
+			}
+			u.transferred.Add(written)
+			u.reused.Add(reused)
 			shard.Chunk = chunk
 			shards = append(shards, shard.Name)
 			shard.ClearTemporary()
@@ -383,11 +407,17 @@ func (u *uploader) compress(ctx context.Context,
 type fileWriter struct {
 	sourcer    Sourcer
 	backend    nodeStore
+	backupID   string
 	tempDir    string
 	destDir    string
 	movedFiles []string // files successfully moved to destination folder
 	compressed bool
 	GoPoolSize int
+	// rename and filter are applied to the relative paths of restored files
+	rename func(string) string
+	filter func(string) bool
+	// wal contains archived commit logs which are written on top of the files
+	wal []backup.WALSegment
 }
 
 func newFileWriter(sourcer Sourcer, backend nodeStore,
@@ -397,6 +427,7 @@ func newFileWriter(sourcer Sourcer, backend nodeStore,
 	return &fileWriter{
 		sourcer:    sourcer,
 		backend:    backend,
+		backupID:   backupID,
 		destDir:    destDir,
 		tempDir:    path.Join(destDir, _TempDirectory),
 		movedFiles: make([]string, 0, 64),
@@ -410,6 +441,33 @@ func (fw *fileWriter) WithPoolPercentage(p int) *fileWriter {
 	return fw
 }
 
+// WithRename renames restored files, it is used to restore a class under another name
+func (fw *fileWriter) WithRename(rename func(string) string) *fileWriter {
+	fw.rename = rename
+	return fw
+}
+
+// WithFilter restores only the files of compressed chunks selected by filter
+func (fw *fileWriter) WithFilter(filter func(string) bool) *fileWriter {
+	fw.filter = filter
+	return fw
+}
+
+// WithWAL writes the archived commit logs of segments on top of the restored
+// files, it is used for point-in-time recovery
+func (fw *fileWriter) WithWAL(segments []backup.WALSegment) *fileWriter {
+	fw.wal = segments
+	return fw
+}
+
+// target returns the path of the restored file relPath
+func (fw *fileWriter) target(relPath string) string {
+	if fw.rename != nil {
+		return fw.rename(relPath)
+	}
+	return relPath
+}
+
 // Write downloads files and put them in the destination directory
 func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor) (rollback func() error, err error) {
 	if len(desc.Shards) == 0 { // nothing to copy
@@ -428,6 +486,9 @@ func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor) (
 	if err := fw.writeTempFiles(ctx, classTempDir, desc); err != nil {
 		return nil, fmt.Errorf("get files: %w", err)
 	}
+	if err := fw.replayWAL(ctx, classTempDir, desc.Name); err != nil {
+		return nil, fmt.Errorf("replay commit logs: %w", err)
+	}
 	if err := fw.moveAll(classTempDir); err != nil {
 		return nil, fmt.Errorf("move files to destination: %w", err)
 	}
@@ -465,6 +526,7 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 		chunk := chunkKey(desc.Name, k)
 		eg.Go(func() error {
 			uz, w := NewUnzip(classTempDir)
+			uz.filter, uz.rename = fw.filter, fw.rename
 			go func() {
 				fw.backend.Read(ctx, chunk, w)
 			}()
@@ -472,12 +534,40 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 			return err
 		})
 	}
+
+	// segment files of incremental backups might be stored by other backups
+	for ref, files := range references(desc) {
+		ref, files := ref, files
+		store := fw.refStore(ref.backupID)
+		chunk := chunkKey(desc.Name, ref.chunk)
+		eg.Go(func() error {
+			uz, w := NewUnzip(classTempDir)
+			uz.filter = func(name string) bool {
+				_, ok := files[name]
+				return ok
+			}
+			uz.rename = fw.rename
+			go func() {
+				store.Read(ctx, chunk, w)
+			}()
+			if _, err := uz.ReadChunk(); err != nil {
+				return fmt.Errorf("backup %s: %w", ref.backupID, err)
+			}
+			return nil
+		})
+	}
 	return eg.Wait()
 }
 
+// refStore returns the store of this node for backup id
+func (fw *fileWriter) refStore(id string) nodeStore {
+	basePath := strings.TrimPrefix(fw.backend.BasePath, fw.backupID)
+	return nodeStore{objStore{b: fw.backend.b, BasePath: id + basePath}}
+}
+
 func (fw *fileWriter) writeTempShard(ctx context.Context, sd *backup.ShardDescriptor, classTempDir string) error {
 	for _, key := range sd.Files {
-		destPath := path.Join(classTempDir, key)
+		destPath := path.Join(classTempDir, fw.target(key))
 		destDir := path.Dir(destPath)
 		if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
 			return fmt.Errorf("create folder %s: %w", destDir, err)
@@ -486,15 +576,15 @@ func (fw *fileWriter) writeTempShard(ctx context.Context, sd *backup.ShardDescri
 			return fmt.Errorf("write file %s: %w", destPath, err)
 		}
 	}
-	destPath := path.Join(classTempDir, sd.DocIDCounterPath)
+	destPath := path.Join(classTempDir, fw.target(sd.DocIDCounterPath))
 	if err := os.WriteFile(destPath, sd.DocIDCounter, os.ModePerm); err != nil {
 		return fmt.Errorf("write counter file %s: %w", destPath, err)
 	}
-	destPath = path.Join(classTempDir, sd.PropLengthTrackerPath)
+	destPath = path.Join(classTempDir, fw.target(sd.PropLengthTrackerPath))
 	if err := os.WriteFile(destPath, sd.PropLengthTracker, os.ModePerm); err != nil {
 		return fmt.Errorf("write prop file %s: %w", destPath, err)
 	}
-	destPath = path.Join(classTempDir, sd.ShardVersionPath)
+	destPath = path.Join(classTempDir, fw.target(sd.ShardVersionPath))
 	if err := os.WriteFile(destPath, sd.Version, os.ModePerm); err != nil {
 		return fmt.Errorf("write version file %s: %w", destPath, err)
 	}
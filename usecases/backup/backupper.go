@@ -28,6 +28,8 @@ type backupper struct {
 	logger   logrus.FieldLogger
 	sourcer  Sourcer
 	backends BackupBackendProvider
+	// wal archives commit logs after backups for point-in-time recovery
+	wal *walArchiver
 	// shardCoordinationChan is sync and coordinate operations
 	shardSyncChan
 }
@@ -39,6 +41,7 @@ func newBackupper(node string, logger logrus.FieldLogger, sourcer Sourcer, backe
 		logger:        logger,
 		sourcer:       sourcer,
 		backends:      backends,
+		wal:           newWALArchiver(node, sourcer, logger),
 		shardSyncChan: shardSyncChan{coordChan: make(chan interface{}, 5)},
 	}
 }
@@ -110,10 +113,12 @@ func (b *backupper) OnStatus(ctx context.Context, req *StatusRequest) (reqStat,
 	}
 
 	return reqStat{
-		Starttime: meta.StartedAt,
-		ID:        req.ID,
-		Path:      store.HomeDir(),
-		Status:    backup.Status(meta.Status),
+		Starttime:        meta.StartedAt,
+		ID:               req.ID,
+		Path:             store.HomeDir(),
+		Status:           backup.Status(meta.Status),
+		BytesTransferred: meta.BytesTransferred,
+		BytesReused:      meta.BytesReused,
 	}, nil
 }
 
@@ -156,6 +161,8 @@ func (b *backupper) backup(ctx context.Context,
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
 			ID:            id,
+			BaseID:        req.BaseID,
+			ArchiveWAL:    req.ArchiveWAL,
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
@@ -166,14 +173,67 @@ func (b *backupper) backup(ctx context.Context,
 		ctx := b.withCancellation(context.Background(), id, done)
 		defer close(done)
 
+		if req.BaseID != "" {
+			base, err := b.baseDescriptor(ctx, store, req.BaseID)
+			if err != nil {
+				b.logger.WithField("action", "create_backup").
+					Error(err)
+				b.lastAsyncError = err
+				result.Error = err.Error()
+				store.PutMeta(context.Background(), &result)
+				return
+			}
+			provider.withBase(base)
+		}
+
+		// logs need to be retained before the backup is taken,
+		// such that none of them is lost until archiving starts
+		if req.ArchiveWAL {
+			archive := backup.WALArchiveRef{Backend: req.Backend, ID: req.ID}
+			if err := b.wal.prepare(ctx, req.Classes, archive); err != nil {
+				b.logger.WithField("action", "create_backup").
+					Error(err)
+				b.lastAsyncError = err
+				result.Error = err.Error()
+				store.PutMeta(context.Background(), &result)
+				return
+			}
+		}
+
 		if err := provider.all(ctx, req.Classes, &result); err != nil {
 			b.logger.WithField("action", "create_backup").
 				Error(err)
 			b.lastAsyncError = err
-
+			if req.ArchiveWAL {
+				b.wal.abort(req.Classes)
+			}
+		} else if req.ArchiveWAL {
+			b.wal.start(store, &result)
 		}
 		result.CompletedAt = time.Now().UTC()
 	}()
 
 	return ret, nil
 }
+
+// baseDescriptor returns the descriptor of this node of the base backup baseID.
+// It returns nil if the node didn't take part in the base backup.
+func (b *backupper) baseDescriptor(ctx context.Context, store nodeStore, baseID string,
+) (*backup.BackupDescriptor, error) {
+	baseStore := nodeStore{objStore{b: store.b, BasePath: fmt.Sprintf("%s/%s", baseID, b.node)}}
+	var meta backup.BackupDescriptor
+	if err := baseStore.meta(ctx, BackupFile, &meta); err != nil {
+		errnf := backup.ErrNotFound{}
+		if errors.As(err, &errnf) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("base backup %q: %w", baseID, err)
+	}
+	if st := backup.Status(meta.Status); st != backup.Success {
+		return nil, fmt.Errorf("base backup %q: invalid status %q", baseID, st)
+	}
+	if meta.Version < version2 {
+		return nil, fmt.Errorf("base backup %q: version %s is not supported", baseID, meta.Version)
+	}
+	return &meta, nil
+}
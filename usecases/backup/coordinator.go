@@ -38,6 +38,7 @@ var (
 	errCannotCommit = errors.New("cannot commit")
 	errMetaNotFound = errors.New("metadata not found")
 	errUnknownOp    = errors.New("unknown backup operation")
+	errNotRunning   = errors.New("no backup operation in progress")
 )
 
 const (
@@ -56,6 +57,9 @@ type participantStatus struct {
 	Status   backup.Status
 	LastTime time.Time
 	Reason   string
+
+	BytesTransferred int64
+	BytesReused      int64
 }
 
 // selector is used to select participant nodes
@@ -97,6 +101,11 @@ type coordinator struct {
 	descriptor   *backup.DistributedBackupDescriptor
 	shardSyncChan
 
+	// cancelCh is closed if the operation cancelID is cancelled
+	cancelMu sync.Mutex
+	cancelID string
+	cancelCh chan struct{}
+
 	// timeouts
 	timeoutNodeDown    time.Duration
 	timeoutQueryStatus time.Duration
@@ -134,11 +143,14 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 	if prevID := c.lastOp.renew(req.ID, store.HomeDir()); prevID != "" {
 		return fmt.Errorf("backup %s already in progress", prevID)
 	}
+	cancelled := c.trackCancellation(req.ID)
 
 	c.descriptor = &backup.DistributedBackupDescriptor{
 		StartedAt:     time.Now().UTC(),
 		Status:        backup.Started,
 		ID:            req.ID,
+		BaseID:        req.BaseID,
+		ArchiveWAL:    req.ArchiveWAL,
 		Nodes:         groups,
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
@@ -148,7 +160,7 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 		delete(c.Participants, key)
 	}
 
-	nodes, err := c.canCommit(ctx, OpCreate, req.Backend)
+	nodes, err := c.canCommit(ctx, OpCreate, req.Backend, nil)
 	if err != nil {
 		c.lastOp.reset()
 		return err
@@ -168,7 +180,7 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 	go func() {
 		defer c.lastOp.reset()
 		ctx := context.Background()
-		c.commit(ctx, &statusReq, nodes, false)
+		c.commit(ctx, &statusReq, nodes, false, cancelled)
 		if err := store.PutMeta(ctx, GlobalBackupFile, c.descriptor); err != nil {
 			c.log.WithField("action", OpCreate).
 				WithField("backup_id", req.ID).Errorf("put_meta: %v", err)
@@ -178,24 +190,28 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 	return nil
 }
 
-// Restore coordinates a distributed restoration among participants
+// Restore coordinates a distributed restoration among participants.
+// plans tells each node how to restore classes which are renamed or whose
+// shards move to other nodes, it is nil if classes are restored as they are.
 func (c *coordinator) Restore(
 	ctx context.Context,
 	store coordStore,
 	backend string,
 	desc *backup.DistributedBackupDescriptor,
+	plans map[string]map[string]*ClassRestore,
 ) error {
 	// make sure there is no active backup
 	if prevID := c.lastOp.renew(desc.ID, store.HomeDir()); prevID != "" {
 		return fmt.Errorf("restoration %s already in progress", prevID)
 	}
+	cancelled := c.trackCancellation(desc.ID)
 
 	for key := range c.Participants {
 		delete(c.Participants, key)
 	}
 	c.descriptor = desc.ResetStatus()
 
-	nodes, err := c.canCommit(ctx, OpRestore, backend)
+	nodes, err := c.canCommit(ctx, OpRestore, backend, plans)
 	if err != nil {
 		c.lastOp.reset()
 		return err
@@ -213,7 +229,7 @@ func (c *coordinator) Restore(
 	go func() {
 		defer c.lastOp.reset()
 		ctx := context.Background()
-		c.commit(ctx, &statusReq, nodes, true)
+		c.commit(ctx, &statusReq, nodes, true, cancelled)
 		if err := store.PutMeta(ctx, GlobalRestoreFile, c.descriptor); err != nil {
 			c.log.WithField("action", OpRestore).
 				WithField("backup_id", desc.ID).Errorf("put_meta: %v", err)
@@ -223,6 +239,49 @@ func (c *coordinator) Restore(
 	return nil
 }
 
+// Cancel cancels the operation id if it is in progress. The operation is
+// aborted on all participants.
+func (c *coordinator) Cancel(id string) error {
+	c.cancelMu.Lock()
+	defer c.cancelMu.Unlock()
+	if st := c.lastOp.get(); st.ID != id || c.cancelID != id {
+		return fmt.Errorf("%w: %q", errNotRunning, id)
+	}
+	select {
+	case <-c.cancelCh: // already cancelled
+	default:
+		close(c.cancelCh)
+	}
+	return nil
+}
+
+// forwardCancel asks the other nodes to cancel the operation they coordinate.
+// It returns nil as soon as one of them has cancelled it.
+func (c *coordinator) forwardCancel(ctx context.Context, req *AbortRequest) error {
+	local := c.nodeResolver.LocalName()
+	for _, node := range c.nodeResolver.AllNames() {
+		if node == local {
+			continue
+		}
+		host, found := c.nodeResolver.NodeHostname(node)
+		if !found {
+			continue
+		}
+		if err := c.client.Cancel(ctx, host, req); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", errNotRunning, req.ID)
+}
+
+// trackCancellation returns a channel which is closed if operation id is cancelled
+func (c *coordinator) trackCancellation(id string) <-chan struct{} {
+	c.cancelMu.Lock()
+	defer c.cancelMu.Unlock()
+	c.cancelID, c.cancelCh = id, make(chan struct{})
+	return c.cancelCh
+}
+
 func (c *coordinator) OnStatus(ctx context.Context, store coordStore, req *StatusRequest) (*Status, error) {
 	// check if backup is still active
 	st := c.lastOp.get()
@@ -240,18 +299,23 @@ func (c *coordinator) OnStatus(ctx context.Context, store coordStore, req *Statu
 		return nil, fmt.Errorf("%w: %q: %v", errMetaNotFound, path, err)
 	}
 
+	transferred, reused := meta.Bytes()
 	return &Status{
-		Path:        store.HomeDir(),
-		StartedAt:   meta.StartedAt,
-		CompletedAt: meta.CompletedAt,
-		Status:      meta.Status,
-		Err:         meta.Error,
+		Path:             store.HomeDir(),
+		StartedAt:        meta.StartedAt,
+		CompletedAt:      meta.CompletedAt,
+		Status:           meta.Status,
+		Err:              meta.Error,
+		BytesTransferred: transferred,
+		BytesReused:      reused,
 	}, nil
 }
 
 // canCommit asks candidates if they agree to participate in DBRO
 // It returns and error if any candidates refuses to participate
-func (c *coordinator) canCommit(ctx context.Context, method Op, backend string) (map[string]string, error) {
+func (c *coordinator) canCommit(ctx context.Context, method Op, backend string,
+	plans map[string]map[string]*ClassRestore,
+) (map[string]string, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeoutCanCommit)
 	defer cancel()
 
@@ -264,8 +328,12 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 		r *Request
 	}
 
-	id := c.descriptor.ID
+	id, baseID := c.descriptor.ID, c.descriptor.BaseID
 	groups := c.descriptor.Nodes
+	var pointInTime time.Time
+	if t := c.descriptor.PointInTime; t != nil && method == OpRestore {
+		pointInTime = *t
+	}
 
 	g, ctx := errgroup.WithContext(ctx)
 	g.SetLimit(_MaxNumberConns)
@@ -292,6 +360,11 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 					Backend:  backend,
 					Classes:  gr.Classes,
 					Duration: _BookingPeriod,
+					BaseID:   baseID,
+					Restore:  plans[node],
+
+					ArchiveWAL:  c.descriptor.ArchiveWAL && method == OpCreate,
+					PointInTime: pointInTime,
 				},
 			}
 		}
@@ -326,11 +399,21 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 
 // commit tells each participant to commit its backup operation
 // It stores the final result in the provided backend
+//
+// The operation is aborted on all participants if cancelled is closed.
 func (c *coordinator) commit(ctx context.Context,
 	req *StatusRequest,
 	node2Addr map[string]string,
 	toleratePartialFailure bool,
+	cancelled <-chan struct{},
 ) {
+	select {
+	case <-cancelled:
+		c.cancel(req, node2Addr)
+		return
+	default:
+	}
+
 	// create a new copy for commitAll and queryAll to mutate
 	node2Host := make(map[string]string, len(node2Addr))
 	for k, v := range node2Addr {
@@ -340,7 +423,12 @@ func (c *coordinator) commit(ctx context.Context,
 	retryAfter := c.timeoutNextRound / 5 // 2s for first time
 	canContinue := len(node2Host) > 0 && (toleratePartialFailure || nFailures == 0)
 	for canContinue {
-		<-time.After(retryAfter)
+		select {
+		case <-time.After(retryAfter):
+		case <-cancelled:
+			c.cancel(req, node2Addr)
+			return
+		}
 		retryAfter = c.timeoutNextRound
 		nFailures += c.queryAll(ctx, req, node2Host)
 		canContinue = len(node2Host) > 0 && (toleratePartialFailure || nFailures == 0)
@@ -356,6 +444,7 @@ func (c *coordinator) commit(ctx context.Context,
 	for node, p := range c.Participants {
 		st := groups[node]
 		st.Status, st.Error = p.Status, p.Reason
+		st.BytesTransferred, st.BytesReused = p.BytesTransferred, p.BytesReused
 		if p.Status != backup.Success {
 			status = backup.Failed
 			reason = p.Reason
@@ -366,6 +455,20 @@ func (c *coordinator) commit(ctx context.Context,
 	c.descriptor.Error = reason
 }
 
+// cancel aborts a cancelled operation on all participants
+func (c *coordinator) cancel(req *StatusRequest, node2Addr map[string]string) {
+	abortReq := &AbortRequest{Method: req.Method, ID: req.ID, Backend: req.Backend}
+	c.abortAll(context.Background(), abortReq, node2Addr)
+	c.descriptor.CompletedAt = time.Now().UTC()
+	c.descriptor.Status = backup.Cancelled
+	c.descriptor.Error = "cancelled by user"
+	for _, st := range c.descriptor.Nodes {
+		st.Status = backup.Cancelled
+	}
+	c.log.WithField("action", req.Method).
+		WithField("backup_id", req.ID).Info("operation cancelled")
+}
+
 // queryAll queries all participant and store their statuses internally
 //
 // It returns the number of failed node backups
@@ -393,6 +496,7 @@ func (c *coordinator) queryAll(ctx context.Context, req *StatusRequest, nodes ma
 		st := c.Participants[r.node]
 		if r.err == nil {
 			st.LastTime, st.Status, st.Reason = now, r.Status, r.Err
+			st.BytesTransferred, st.BytesReused = r.BytesTransferred, r.BytesReused
 			if r.Status == backup.Success {
 				delete(nodes, r.node)
 			}
@@ -13,6 +13,7 @@ package backup
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -317,7 +318,7 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, backendName, genReq(), nil)
 		assert.Nil(t, err)
 	})
 
@@ -332,7 +333,7 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, backendName, genReq(), nil)
 		assert.ErrorIs(t, err, errCannotCommit)
 		assert.Contains(t, err.Error(), nodes[1])
 	})
@@ -350,7 +351,7 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, backendName, genReq(), nil)
 		assert.ErrorIs(t, err, ErrAny)
 		assert.Contains(t, err.Error(), "initial")
 	})
@@ -397,7 +398,11 @@ type fakeNodeResolver struct {
 }
 
 func (r *fakeNodeResolver) NodeHostname(nodeName string) (string, bool) {
-	return r.hosts[nodeName], true
+	if r.hosts == nil {
+		return "", true
+	}
+	host, ok := r.hosts[nodeName]
+	return host, ok
 }
 
 func (r *fakeNodeResolver) NodeCount() int {
@@ -407,6 +412,26 @@ func (r *fakeNodeResolver) NodeCount() int {
 	return 1
 }
 
+func (r *fakeNodeResolver) Candidates() []string {
+	nodes := make([]string, 0, len(r.hosts))
+	for node := range r.hosts {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+func (r *fakeNodeResolver) AllNames() []string {
+	return r.Candidates()
+}
+
+func (r *fakeNodeResolver) LocalName() string {
+	if nodes := r.Candidates(); len(nodes) > 0 {
+		return nodes[0]
+	}
+	return ""
+}
+
 func newFakeNodeResolver(nodes []string) *fakeNodeResolver {
 	hosts := make(map[string]string)
 	for _, node := range nodes {
@@ -450,3 +475,8 @@ func (f *fakeClient) Abort(ctx context.Context, node string, req *AbortRequest)
 	args := f.Called(ctx, node, req)
 	return args.Error(0)
 }
+
+func (f *fakeClient) Cancel(ctx context.Context, node string, req *AbortRequest) error {
+	args := f.Called(ctx, node, req)
+	return args.Error(0)
+}
@@ -77,6 +77,28 @@ func (s *fakeSourcer) ClassExists(name string) bool {
 	return args.Bool(0)
 }
 
+func (s *fakeSourcer) RetainWAL(ctx context.Context, classes []string, archive *backup.WALArchiveRef) error {
+	args := s.Called(ctx, classes, archive)
+	return args.Error(0)
+}
+
+func (s *fakeSourcer) RetainedWAL() map[string]backup.WALArchiveRef {
+	args := s.Called()
+	retained, _ := args.Get(0).(map[string]backup.WALArchiveRef)
+	return retained
+}
+
+func (s *fakeSourcer) WALFiles(ctx context.Context, class string) ([]backup.ShardWAL, error) {
+	args := s.Called(ctx, class)
+	shards, _ := args.Get(0).([]backup.ShardWAL)
+	return shards, args.Error(1)
+}
+
+func (s *fakeSourcer) ReleaseWAL(ctx context.Context, class string, paths []string) error {
+	args := s.Called(ctx, class, paths)
+	return args.Error(0)
+}
+
 type fakeBackend struct {
 	mock.Mock
 	sync.RWMutex
@@ -141,6 +163,13 @@ func (fb *fakeBackend) Initialize(ctx context.Context, backupID string) error {
 	return args.Error(0)
 }
 
+func (fb *fakeBackend) DeleteObject(ctx context.Context, backupID, key string) error {
+	fb.Lock()
+	defer fb.Unlock()
+	args := fb.Called(ctx, backupID, key)
+	return args.Error(0)
+}
+
 func (fb *fakeBackend) SourceDataPath() string {
 	fb.RLock()
 	defer fb.RUnlock()
@@ -148,6 +177,21 @@ func (fb *fakeBackend) SourceDataPath() string {
 	return args.String(0)
 }
 
+func (fb *fakeBackend) ListBackups(ctx context.Context) ([]string, error) {
+	fb.Lock()
+	defer fb.Unlock()
+	args := fb.Called(ctx)
+	ids, _ := args.Get(0).([]string)
+	return ids, args.Error(1)
+}
+
+func (fb *fakeBackend) DeleteBackup(ctx context.Context, backupID string) error {
+	fb.Lock()
+	defer fb.Unlock()
+	args := fb.Called(ctx, backupID)
+	return args.Error(0)
+}
+
 func (fb *fakeBackend) IsExternal() bool {
 	return true
 }
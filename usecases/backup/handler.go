@@ -26,7 +26,10 @@ import (
 // Version of backup structure
 const (
 	// Version > version1 support compression
-	Version = "2.0"
+	// Version > version2 support incremental backups
+	Version = "2.1"
+	// version2 stores compressed chunks
+	version2 = "2.0"
 	// version1 store plain files without compression
 	version1 = "1.0"
 )
@@ -52,6 +55,9 @@ type schemaManger interface {
 type nodeResolver interface {
 	NodeHostname(nodeName string) (string, bool)
 	NodeCount() int
+	Candidates() []string
+	AllNames() []string
+	LocalName() string
 }
 
 type Status struct {
@@ -60,6 +66,10 @@ type Status struct {
 	CompletedAt time.Time
 	Status      backup.Status
 	Err         string
+	// BytesTransferred and BytesReused are the number of bytes uploaded and
+	// referenced from the base of an incremental backup
+	BytesTransferred int64
+	BytesReused      int64
 }
 
 type Handler struct {
@@ -97,6 +107,13 @@ func NewHandler(
 	return m
 }
 
+// ResumeWALArchive continues archiving the commit logs of the last backup
+// requesting it after the node has been restarted. It must be called once
+// the database has been loaded.
+func (m *Handler) ResumeWALArchive(ctx context.Context) {
+	m.backupper.wal.resume(ctx, m.backends)
+}
+
 type BackupRequest struct {
 	// ID is the backup ID
 	ID string
@@ -109,6 +126,21 @@ type BackupRequest struct {
 	// Exclude means include all classes but those specified in Exclude
 	// The same class cannot appear in both Include and Exclude in the same request
 	Exclude []string
+
+	// BaseID is the ID of the base backup of an incremental backup.
+	// Segment files which didn't change since the base backup are not uploaded again.
+	BaseID string
+
+	// ClassMapping restores classes under a different name (backup class -> new class)
+	ClassMapping map[string]string
+
+	// ArchiveWAL continuously archives the commit logs of the backed up classes
+	// after the backup has been created, which enables point-in-time recovery
+	ArchiveWAL bool
+
+	// PointInTime restores the state of the classes at this time. It requires
+	// a backup whose commit logs are archived.
+	PointInTime time.Time
 }
 
 // OnCanCommit will be triggered when coordinator asks the node to participate
@@ -138,12 +170,15 @@ func (m *Handler) OnCanCommit(ctx context.Context, req *Request) *CanCommitRespo
 		}
 		ret.Timeout = res.Timeout
 	case OpRestore:
-		meta, _, err := m.restorer.validate(ctx, &store, req)
-		if err != nil {
-			ret.Err = err.Error()
-			return ret
+		var res CanCommitResponse
+		if req.Restore != nil {
+			res, err = m.restorer.restorePlanned(ctx, req, store)
+		} else {
+			var meta *backup.BackupDescriptor
+			if meta, _, err = m.restorer.validate(ctx, &store, req); err == nil {
+				res, err = m.restorer.restore(ctx, req, meta, store)
+			}
 		}
-		res, err := m.restorer.restore(ctx, req, meta, store)
 		if err != nil {
 			ret.Err = err.Error()
 			return ret
@@ -191,6 +226,7 @@ func (m *Handler) OnStatus(ctx context.Context, req *StatusRequest) *StatusRespo
 	case OpCreate:
 		st, err := m.backupper.OnStatus(ctx, req)
 		ret.Status = st.Status
+		ret.BytesTransferred, ret.BytesReused = st.BytesTransferred, st.BytesReused
 		if err != nil {
 			ret.Status = backup.Failed
 			ret.Err = err.Error()
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+// segmentIndex contains the segment files of the base backup of an
+// incremental backup. A file is keyed by its class and relative path.
+//
+// The references of the base backup are flattened, such that every file
+// points to the backup storing it. Thus restoring a backup never needs to
+// walk the chain of its base backups.
+type segmentIndex map[string]backup.SegmentFile
+
+func newSegmentIndex(base *backup.BackupDescriptor) segmentIndex {
+	idx := segmentIndex{}
+	if base == nil {
+		return idx
+	}
+	for _, class := range base.Classes {
+		for _, shard := range class.Shards {
+			for _, f := range shard.Segments {
+				if !f.Reused() {
+					f.BackupID, f.Chunk = base.ID, shard.Chunk
+				}
+				idx[segmentKey(class.Name, f.Path)] = f
+			}
+		}
+	}
+	return idx
+}
+
+// split records the segment files of shard sd and removes the files which
+// are already stored by the base backup from the list of files to upload.
+// It returns the number of bytes which don't need to be uploaded.
+func (idx segmentIndex) split(sourcePath, class string, sd *backup.ShardDescriptor) (reused int64, err error) {
+	files := make([]string, 0, len(sd.Files))
+	sd.Segments = sd.Segments[:0]
+	for _, relPath := range sd.Files {
+		if !isSegmentFile(relPath) {
+			files = append(files, relPath)
+			continue
+		}
+		info, err := os.Stat(filepath.Join(sourcePath, relPath))
+		if err != nil {
+			return reused, fmt.Errorf("stat segment: %w", err)
+		}
+		f := backup.SegmentFile{
+			Path:    relPath,
+			Size:    info.Size(),
+			ModTime: info.ModTime().UnixNano(),
+		}
+		if base, ok := idx[segmentKey(class, relPath)]; ok && base.SameAs(&f) {
+			f.BackupID, f.Chunk = base.BackupID, base.Chunk
+			reused += f.Size
+		} else {
+			files = append(files, relPath)
+		}
+		sd.Segments = append(sd.Segments, f)
+	}
+	sd.Files = files
+	return reused, nil
+}
+
+// references groups the reused segment files of a class by the chunk
+// storing them
+func references(desc *backup.ClassDescriptor) map[chunkRef]map[string]struct{} {
+	refs := map[chunkRef]map[string]struct{}{}
+	for _, shard := range desc.Shards {
+		for _, f := range shard.Segments {
+			if !f.Reused() {
+				continue
+			}
+			ref := chunkRef{f.BackupID, f.Chunk}
+			if refs[ref] == nil {
+				refs[ref] = map[string]struct{}{}
+			}
+			refs[ref][f.Path] = struct{}{}
+		}
+	}
+	return refs
+}
+
+// chunkRef locates a chunk of another backup
+type chunkRef struct {
+	backupID string
+	chunk    int32
+}
+
+// isSegmentFile returns true if relPath is an immutable segment file of an
+// LSM bucket. Segments are never changed once written. A compaction might
+// replace a segment by a new file of the same name, which is detected by
+// comparing size and modification time.
+func isSegmentFile(relPath string) bool {
+	dir, name := filepath.Split(relPath)
+	if !strings.Contains(dir, "_lsm/") || !strings.HasPrefix(name, "segment-") {
+		return false
+	}
+	switch filepath.Ext(name) {
+	case ".db", ".bloom", ".cna":
+		return true
+	default:
+		return false
+	}
+}
+
+func segmentKey(class, relPath string) string {
+	return class + "/" + relPath
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestIsSegmentFile(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"cls_shard_lsm/objects/segment-123.db", true},
+		{"cls_shard_lsm/objects/segment-123.bloom", true},
+		{"cls_shard_lsm/objects/segment-123.secondary.0.bloom", true},
+		{"cls_shard_lsm/objects/segment-123.cna", true},
+		{"cls_shard_lsm/objects/segment-123.wal", false},
+		{"cls_shard_lsm/objects/segment-123.db.tmp", false},
+		{"cls_shard.hnsw.commitlog.d/1234", false},
+		{"cls_shard.indexcount", false},
+		{"segment-123.db", false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, isSegmentFile(test.path), test.path)
+	}
+}
+
+func TestSegmentIndex(t *testing.T) {
+	var (
+		sourcePath = t.TempDir()
+		cls        = "Article"
+		unchanged  = "article_s1_lsm/objects/segment-1.db"
+		compacted  = "article_s1_lsm/objects/segment-2.db"
+		added      = "article_s1_lsm/objects/segment-3.db"
+		wal        = "article_s1_lsm/objects/segment-4.wal"
+		commitLog  = "article_s1.hnsw.commitlog.d/1"
+	)
+	for _, p := range []string{unchanged, compacted, added, wal, commitLog} {
+		writeFile(t, sourcePath, p, "data")
+	}
+	stat := func(relPath string) (int64, int64) {
+		info, err := os.Stat(filepath.Join(sourcePath, relPath))
+		require.Nil(t, err)
+		return info.Size(), info.ModTime().UnixNano()
+	}
+	size, modTime := stat(unchanged)
+	size2, modTime2 := stat(compacted)
+
+	// the base backup stores segment-2 and references segment-1 of its own base
+	base := &backup.BackupDescriptor{
+		ID: "base",
+		Classes: []backup.ClassDescriptor{{
+			Name: cls,
+			Shards: []*backup.ShardDescriptor{{
+				Name:  "s1",
+				Chunk: 3,
+				Segments: []backup.SegmentFile{
+					{Path: unchanged, Size: size, ModTime: modTime, BackupID: "first", Chunk: 1},
+					{Path: compacted, Size: size2 + 1, ModTime: modTime2},
+				},
+			}},
+		}},
+	}
+	idx := newSegmentIndex(base)
+	assert.Equal(t, "first", idx[segmentKey(cls, unchanged)].BackupID)
+	assert.Equal(t, backup.SegmentFile{Path: compacted, Size: size2 + 1, ModTime: modTime2, BackupID: "base", Chunk: 3},
+		idx[segmentKey(cls, compacted)])
+
+	sd := &backup.ShardDescriptor{
+		Name:  "s1",
+		Files: []string{unchanged, compacted, added, wal, commitLog},
+	}
+	reused, err := idx.split(sourcePath, cls, sd)
+	require.Nil(t, err)
+	assert.Equal(t, size, reused)
+	assert.ElementsMatch(t, []string{compacted, added, wal, commitLog}, sd.Files)
+	require.Len(t, sd.Segments, 3)
+	assert.Equal(t, backup.SegmentFile{Path: unchanged, Size: size, ModTime: modTime, BackupID: "first", Chunk: 1},
+		sd.Segments[0])
+	assert.False(t, sd.Segments[1].Reused())
+	assert.False(t, sd.Segments[2].Reused())
+
+	// files of another class are never reused
+	sd = &backup.ShardDescriptor{Name: "s1", Files: []string{unchanged}}
+	reused, err = idx.split(sourcePath, "Other", sd)
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), reused)
+	assert.Equal(t, []string{unchanged}, sd.Files)
+
+	refs := references(&backup.ClassDescriptor{Name: cls, Shards: []*backup.ShardDescriptor{
+		{Segments: []backup.SegmentFile{
+			{Path: unchanged, BackupID: "first", Chunk: 1},
+			{Path: compacted, BackupID: "base", Chunk: 3},
+			{Path: added},
+		}},
+		{Segments: []backup.SegmentFile{{Path: "b", BackupID: "first", Chunk: 1}}},
+	}})
+	assert.Equal(t, map[chunkRef]map[string]struct{}{
+		{"first", 1}: {unchanged: {}, "b": {}},
+		{"base", 3}:  {compacted: {}},
+	}, refs)
+}
+
+func TestUnzipFilter(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		sourcePath = t.TempDir()
+		destPath   = t.TempDir()
+		files      = []string{"dir/a", "dir/b", "c"}
+	)
+	for _, p := range files {
+		writeFile(t, sourcePath, p, p)
+	}
+
+	buf := bytes.Buffer{}
+	z, rc := NewZip(sourcePath, 0)
+	go func() {
+		if _, err := z.WriteRegulars(ctx, files); err != nil {
+			t.Errorf("compress: %v", err)
+		}
+		z.Close()
+	}()
+	_, err := io.Copy(&buf, rc)
+	require.Nil(t, err)
+
+	uz, wc := NewUnzip(destPath)
+	uz.filter = func(name string) bool { return name != "dir/b" }
+	go func() {
+		io.Copy(wc, &buf)
+		wc.Close()
+	}()
+	_, err = uz.ReadChunk()
+	require.Nil(t, err)
+
+	for _, p := range []string{"dir/a", "c"} {
+		data, err := os.ReadFile(filepath.Join(destPath, p))
+		require.Nil(t, err)
+		assert.Equal(t, p, string(data))
+	}
+	_, err = os.Stat(filepath.Join(destPath, "dir/b"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func writeFile(t *testing.T, dir, relPath, data string) {
+	p := filepath.Join(dir, relPath)
+	require.Nil(t, os.MkdirAll(filepath.Dir(p), os.ModePerm))
+	require.Nil(t, os.WriteFile(p, []byte(data), os.ModePerm))
+	// make sure modification times differ between files
+	time.Sleep(time.Millisecond)
+}
@@ -0,0 +1,443 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ClassRestore tells a node how to restore a class whose name or shard
+// placement differs from the backup
+type ClassRestore struct {
+	// Name of the class after restore
+	Name string
+	// ShardingState of the class after restore
+	ShardingState []byte
+	// ReplicationFactor of the class after restore
+	ReplicationFactor int64
+	// SchemaNode is a node whose backup contains the class
+	SchemaNode string
+	// Shards maps the shards restored by this node to the nodes which backed them up
+	Shards map[string]string
+}
+
+// needsRestorePlan returns true if classes are renamed or if nodes of the
+// backup are not part of the cluster. meta.Nodes only contains the nodes
+// holding the backed up classes, they can be a subset of the cluster.
+func needsRestorePlan(meta *backup.DistributedBackupDescriptor,
+	mapping map[string]string, nodes nodeResolver,
+) bool {
+	for from, to := range mapping {
+		if from != to {
+			return true
+		}
+	}
+	for node := range meta.Nodes {
+		if _, ok := nodes.NodeHostname(node); !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// planRestore computes how each node of the cluster restores the classes of
+// meta. Shards are distributed among the nodes of the cluster and the
+// replication factor is reduced if there are not enough nodes.
+// meta.Nodes is replaced by the nodes of the cluster.
+func planRestore(ctx context.Context, store coordStore,
+	meta *backup.DistributedBackupDescriptor,
+	mapping map[string]string, resolver nodeResolver,
+) (map[string]map[string]*ClassRestore, error) {
+	nodes := staticNodes{names: resolver.Candidates(), local: resolver.LocalName()}
+	if len(nodes.names) == 0 {
+		return nil, fmt.Errorf("list of node candidates is empty")
+	}
+	descs, err := nodeDescriptors(ctx, store, meta)
+	if err != nil {
+		return nil, err
+	}
+	sources := make([]string, 0, len(descs))
+	for node := range descs {
+		sources = append(sources, node)
+	}
+	sort.Strings(sources)
+
+	classes := meta.Classes()
+	sort.Strings(classes)
+	plans := make(map[string]map[string]*ClassRestore, len(nodes.names))
+	for _, node := range nodes.names {
+		plans[node] = make(map[string]*ClassRestore, len(classes))
+	}
+	for _, class := range classes {
+		var (
+			schemaNode string
+			cdesc      *backup.ClassDescriptor
+			holders    = make(map[string][]string) // shard -> nodes which backed it up
+		)
+		for _, node := range sources {
+			cd := findClass(descs[node], class)
+			if cd == nil {
+				continue
+			}
+			if cdesc == nil {
+				schemaNode, cdesc = node, cd
+			}
+			for _, sd := range cd.Shards {
+				holders[sd.Name] = append(holders[sd.Name], node)
+			}
+		}
+		if cdesc == nil {
+			return nil, fmt.Errorf("class %s not found in any node backup", class)
+		}
+
+		var state sharding.State
+		if err := json.Unmarshal(cdesc.ShardingState, &state); err != nil {
+			return nil, fmt.Errorf("class %s: unmarshal sharding state: %w", class, err)
+		}
+		var cls models.Class
+		if err := json.Unmarshal(cdesc.Schema, &cls); err != nil {
+			return nil, fmt.Errorf("class %s: unmarshal schema: %w", class, err)
+		}
+		factor := int64(1)
+		if cls.ReplicationConfig != nil && cls.ReplicationConfig.Factor > 1 {
+			factor = cls.ReplicationConfig.Factor
+		}
+		if n := int64(len(nodes.names)); factor > n {
+			factor = n
+		}
+		if err := state.Reassign(nodes, factor); err != nil {
+			return nil, fmt.Errorf("class %s: distribute shards: %w", class, err)
+		}
+		name := class
+		if to, ok := mapping[class]; ok {
+			name = to
+		}
+		state.IndexID = name
+		shardingState, err := json.Marshal(&state)
+		if err != nil {
+			return nil, fmt.Errorf("class %s: marshal sharding state: %w", class, err)
+		}
+
+		for _, node := range nodes.names {
+			plans[node][class] = &ClassRestore{
+				Name:              name,
+				ShardingState:     shardingState,
+				ReplicationFactor: factor,
+				SchemaNode:        schemaNode,
+				Shards:            make(map[string]string),
+			}
+		}
+		for shard, p := range state.Physical {
+			from := holders[shard]
+			if len(from) == 0 {
+				if state.PartitioningEnabled {
+					continue // inactive tenants are not part of the backup
+				}
+				return nil, fmt.Errorf("class %s: shard %s not found in backup", class, shard)
+			}
+			for _, node := range p.BelongsToNodes {
+				src := from[0]
+				for _, n := range from {
+					if n == node { // prefer a local copy
+						src = n
+						break
+					}
+				}
+				plans[node][class].Shards[shard] = src
+			}
+		}
+	}
+
+	meta.Nodes = make(map[string]*backup.NodeDescriptor, len(nodes.names))
+	for _, node := range nodes.names {
+		meta.Nodes[node] = &backup.NodeDescriptor{Classes: classes}
+	}
+	return plans, nil
+}
+
+// nodeDescriptors loads the backup descriptors of all nodes of meta
+func nodeDescriptors(ctx context.Context, store coordStore,
+	meta *backup.DistributedBackupDescriptor,
+) (map[string]*backup.BackupDescriptor, error) {
+	descs := make(map[string]*backup.BackupDescriptor, len(meta.Nodes))
+	for node := range meta.Nodes {
+		ns := nodeStore{objStore{b: store.b, BasePath: fmt.Sprintf("%s/%s", meta.ID, node)}}
+		desc, err := ns.Meta(ctx, meta.ID, false)
+		if err != nil {
+			return nil, fmt.Errorf("backup of node %q: %w", node, err)
+		}
+		descs[node] = desc
+	}
+	return descs, nil
+}
+
+// staticNodes is a snapshot of the nodes of the cluster
+type staticNodes struct {
+	names []string
+	local string
+}
+
+func (n staticNodes) Candidates() []string { return n.names }
+func (n staticNodes) LocalName() string    { return n.local }
+
+// classRestore is a class to restore along with the node backups holding its shards
+type classRestore struct {
+	// desc is passed on to the schema once all files have been written
+	desc  *backup.ClassDescriptor
+	parts []classPart
+	// rename maps file names of the backup to the ones of the restored class.
+	// It is nil if the class is restored under its own name.
+	rename func(string) string
+}
+
+// classPart is the part of a class restored from the backup of a single node
+type classPart struct {
+	store      nodeStore
+	desc       *backup.ClassDescriptor
+	compressed bool
+	// filter selects the files of desc.Shards if chunks contain other shards too
+	filter func(string) bool
+	// wal contains the archived commit logs replayed for point-in-time recovery
+	wal []backup.WALSegment
+}
+
+// localClasses returns the classes of desc which are restored
+// from this node's own backup as they are
+func localClasses(desc *backup.BackupDescriptor, store nodeStore) []classRestore {
+	compressed := desc.Version > version1
+	classes := make([]classRestore, len(desc.Classes))
+	for i := range desc.Classes {
+		cdesc := &desc.Classes[i]
+		classes[i] = classRestore{
+			desc:  cdesc,
+			parts: []classPart{{store: store, desc: cdesc, compressed: compressed}},
+		}
+	}
+	return classes
+}
+
+// plannedClasses loads the classes to restore according to the restore plan
+// of req. Shards are read from the backups of the nodes which stored them.
+func (r *restorer) plannedClasses(ctx context.Context, store nodeStore, req *Request,
+) ([]classRestore, error) {
+	var (
+		descs  = make(map[string]*backup.BackupDescriptor)
+		stores = make(map[string]nodeStore)
+		names  = make(map[string]string, len(req.Restore))
+	)
+	for from, plan := range req.Restore {
+		names[from] = plan.Name
+	}
+	load := func(node string) (*backup.BackupDescriptor, error) {
+		if desc, ok := descs[node]; ok {
+			return desc, nil
+		}
+		ns := nodeStore{objStore{b: store.b, BasePath: fmt.Sprintf("%s/%s", req.ID, node)}}
+		desc, err := ns.Meta(ctx, req.ID, true)
+		if err != nil {
+			nerr := backup.ErrNotFound{}
+			if errors.As(err, &nerr) {
+				return nil, fmt.Errorf("%w: %q", errMetaNotFound, ns.HomeDir())
+			}
+			return nil, fmt.Errorf("find backup of node %q: %w", node, err)
+		}
+		if desc.Status != string(backup.Success) {
+			return nil, fmt.Errorf("invalid backup of node %q status: %s", node, desc.Status)
+		}
+		if v := desc.Version; v > Version {
+			return nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
+		}
+		descs[node], stores[node] = desc, ns
+		return desc, nil
+	}
+
+	classes := make([]classRestore, 0, len(req.Classes))
+	for _, class := range req.Classes {
+		plan := req.Restore[class]
+		if plan == nil {
+			return nil, fmt.Errorf("class %s: missing restore plan", class)
+		}
+		desc, err := load(plan.SchemaNode)
+		if err != nil {
+			return nil, err
+		}
+		cdesc := findClass(desc, class)
+		if cdesc == nil {
+			return nil, fmt.Errorf("class %s not found in backup of node %q", class, plan.SchemaNode)
+		}
+		schema, err := restoredSchema(cdesc.Schema, plan, names)
+		if err != nil {
+			return nil, fmt.Errorf("class %s: %w", class, err)
+		}
+		cr := classRestore{
+			desc: &backup.ClassDescriptor{
+				Name:          plan.Name,
+				Schema:        schema,
+				ShardingState: plan.ShardingState,
+			},
+			rename: renameFiles(class, plan.Name),
+		}
+
+		bySource := make(map[string][]string)
+		for shard, node := range plan.Shards {
+			bySource[node] = append(bySource[node], shard)
+		}
+		sources := make([]string, 0, len(bySource))
+		for node := range bySource {
+			sources = append(sources, node)
+		}
+		sort.Strings(sources)
+		for _, node := range sources {
+			desc, err := load(node)
+			if err != nil {
+				return nil, err
+			}
+			cdesc := findClass(desc, class)
+			if cdesc == nil {
+				return nil, fmt.Errorf("class %s not found in backup of node %q", class, node)
+			}
+			part, err := newClassPart(cdesc, bySource[node])
+			if err != nil {
+				return nil, fmt.Errorf("class %s: node %q: %w", class, node, err)
+			}
+			part.store, part.compressed = stores[node], desc.Version > version1
+			cr.parts = append(cr.parts, part)
+		}
+		classes = append(classes, cr)
+	}
+	return classes, nil
+}
+
+// newClassPart returns the part of cdesc which contains shards
+func newClassPart(cdesc *backup.ClassDescriptor, shards []string) (classPart, error) {
+	wanted := make(map[string]struct{}, len(shards))
+	for _, s := range shards {
+		wanted[s] = struct{}{}
+	}
+	part := classPart{desc: &backup.ClassDescriptor{
+		Name:          cdesc.Name,
+		Schema:        cdesc.Schema,
+		ShardingState: cdesc.ShardingState,
+	}}
+	all := make([]string, 0, len(cdesc.Shards))
+	for _, sd := range cdesc.Shards {
+		all = append(all, sd.Name)
+		if _, ok := wanted[sd.Name]; ok {
+			part.desc.Shards = append(part.desc.Shards, sd)
+		}
+	}
+	if len(part.desc.Shards) != len(wanted) {
+		return part, fmt.Errorf("missing shards: want %v, backup has %v", shards, all)
+	}
+	if len(part.desc.Shards) == len(cdesc.Shards) {
+		part.desc.Chunks = cdesc.Chunks
+		return part, nil
+	}
+
+	// chunks might contain other shards as well
+	part.desc.Chunks = make(map[int32][]string, len(cdesc.Chunks))
+	for k, names := range cdesc.Chunks {
+		for _, name := range names {
+			if _, ok := wanted[name]; ok {
+				part.desc.Chunks[k] = names
+				break
+			}
+		}
+	}
+	index := strings.ToLower(cdesc.Name)
+	part.filter = func(name string) bool {
+		_, ok := wanted[shardOf(name, index, all)]
+		return ok
+	}
+	return part, nil
+}
+
+// shardOf returns the shard which the file name belongs to or "" if unknown.
+// Files of a shard are prefixed by "{index}_{shard}" followed by "_lsm",
+// "_vectors_" or ".". The longest match wins as shard names may contain "_".
+func shardOf(name, index string, shards []string) string {
+	first := name
+	if i := strings.IndexByte(name, '/'); i >= 0 {
+		first = name[:i]
+	}
+	prefix := index + "_"
+	if !strings.HasPrefix(first, prefix) {
+		return ""
+	}
+	first = first[len(prefix):]
+	match := ""
+	for _, s := range shards {
+		if len(s) <= len(match) || !strings.HasPrefix(first, s) {
+			continue
+		}
+		if rest := first[len(s):]; rest == "_lsm" ||
+			strings.HasPrefix(rest, ".") || strings.HasPrefix(rest, "_vectors_") {
+			match = s
+		}
+	}
+	return match
+}
+
+// renameFiles returns a function which renames the files of class from to
+// the ones of class to. It returns nil if the names of the files don't change.
+func renameFiles(from, to string) func(string) string {
+	src, dst := strings.ToLower(from)+"_", strings.ToLower(to)+"_"
+	if src == dst {
+		return nil
+	}
+	return func(name string) string {
+		if strings.HasPrefix(name, src) {
+			return dst + name[len(src):]
+		}
+		return name
+	}
+}
+
+// restoredSchema renames the class and references to renamed classes
+// and sets the replication factor of the plan
+func restoredSchema(schema []byte, plan *ClassRestore, names map[string]string) ([]byte, error) {
+	var cls models.Class
+	if err := json.Unmarshal(schema, &cls); err != nil {
+		return nil, fmt.Errorf("unmarshal schema: %w", err)
+	}
+	cls.Class = plan.Name
+	for _, prop := range cls.Properties {
+		for i, dt := range prop.DataType {
+			if to, ok := names[dt]; ok {
+				prop.DataType[i] = to
+			}
+		}
+	}
+	if cls.ReplicationConfig == nil {
+		cls.ReplicationConfig = &models.ReplicationConfig{}
+	}
+	cls.ReplicationConfig.Factor = plan.ReplicationFactor
+	return json.Marshal(&cls)
+}
+
+func findClass(desc *backup.BackupDescriptor, class string) *backup.ClassDescriptor {
+	for i := range desc.Classes {
+		if desc.Classes[i].Name == class {
+			return &desc.Classes[i]
+		}
+	}
+	return nil
+}
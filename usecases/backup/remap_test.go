@@ -0,0 +1,351 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestShardOf(t *testing.T) {
+	shards := []string{"s1", "tenant", "tenant_x"}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"product_s1_lsm/objects/segment-1.db", "s1"},
+		{"product_s1.indexcount", "s1"},
+		{"product_s1.hnsw.commitlog.d/1", "s1"},
+		{"product_s1_vectors_title.hnsw.commitlog.d/1", "s1"},
+		{"product_tenant_lsm/objects/segment-1.db", "tenant"},
+		{"product_tenant_x_lsm/objects/segment-1.db", "tenant_x"},
+		{"product_tenant_x.version", "tenant_x"},
+		{"product_s2_lsm/objects/segment-1.db", ""},
+		{"other_s1_lsm/objects/segment-1.db", ""},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, shardOf(test.name, "product", shards), test.name)
+	}
+}
+
+func TestRenameFiles(t *testing.T) {
+	assert.Nil(t, renameFiles("Product", "Product"))
+	rename := renameFiles("Product", "ProductStaging")
+	assert.Equal(t, "productstaging_s1_lsm/objects/segment-1.db", rename("product_s1_lsm/objects/segment-1.db"))
+	assert.Equal(t, "productstaging_s1.indexcount", rename("product_s1.indexcount"))
+	assert.Equal(t, "other_s1.indexcount", rename("other_s1.indexcount"))
+}
+
+func TestRestoredSchema(t *testing.T) {
+	schema, err := json.Marshal(&models.Class{
+		Class: "Product",
+		Properties: []*models.Property{
+			{Name: "related", DataType: []string{"Product", "Category"}},
+			{Name: "title", DataType: []string{"text"}},
+		},
+		ReplicationConfig: &models.ReplicationConfig{Factor: 3},
+	})
+	require.Nil(t, err)
+	plan := &ClassRestore{Name: "ProductStaging", ReplicationFactor: 1}
+	names := map[string]string{"Product": "ProductStaging", "Category": "Category"}
+
+	data, err := restoredSchema(schema, plan, names)
+	require.Nil(t, err)
+	var got models.Class
+	require.Nil(t, json.Unmarshal(data, &got))
+	assert.Equal(t, "ProductStaging", got.Class)
+	assert.Equal(t, int64(1), got.ReplicationConfig.Factor)
+	assert.Equal(t, []string{"ProductStaging", "Category"}, got.Properties[0].DataType)
+	assert.Equal(t, []string{"text"}, got.Properties[1].DataType)
+}
+
+func TestNewClassPart(t *testing.T) {
+	cdesc := &backup.ClassDescriptor{
+		Name:   "Product",
+		Shards: []*backup.ShardDescriptor{{Name: "s1"}, {Name: "s2"}, {Name: "s3"}},
+		Chunks: map[int32][]string{1: {"s1", "s2"}, 2: {"s3"}},
+	}
+
+	t.Run("AllShards", func(t *testing.T) {
+		part, err := newClassPart(cdesc, []string{"s3", "s2", "s1"})
+		require.Nil(t, err)
+		assert.Len(t, part.desc.Shards, 3)
+		assert.Equal(t, cdesc.Chunks, part.desc.Chunks)
+		assert.Nil(t, part.filter)
+	})
+
+	t.Run("SomeShards", func(t *testing.T) {
+		part, err := newClassPart(cdesc, []string{"s2"})
+		require.Nil(t, err)
+		require.Len(t, part.desc.Shards, 1)
+		assert.Equal(t, "s2", part.desc.Shards[0].Name)
+		assert.Equal(t, map[int32][]string{1: {"s1", "s2"}}, part.desc.Chunks)
+		require.NotNil(t, part.filter)
+		assert.True(t, part.filter("product_s2_lsm/objects/segment-1.db"))
+		assert.False(t, part.filter("product_s1_lsm/objects/segment-1.db"))
+	})
+
+	t.Run("MissingShard", func(t *testing.T) {
+		_, err := newClassPart(cdesc, []string{"s4"})
+		assert.ErrorContains(t, err, "missing shards")
+	})
+}
+
+func TestUnzipRename(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		sourcePath = t.TempDir()
+		destPath   = t.TempDir()
+		files      = []string{"product_s1_lsm/objects/segment-1.db", "product_s2_lsm/objects/segment-1.db"}
+	)
+	for _, p := range files {
+		writeFile(t, sourcePath, p, p)
+	}
+
+	buf := bytes.Buffer{}
+	z, rc := NewZip(sourcePath, 0)
+	go func() {
+		if _, err := z.WriteRegulars(ctx, files); err != nil {
+			t.Errorf("compress: %v", err)
+		}
+		z.Close()
+	}()
+	_, err := io.Copy(&buf, rc)
+	require.Nil(t, err)
+
+	uz, wc := NewUnzip(destPath)
+	uz.filter = func(name string) bool { return shardOf(name, "product", []string{"s1", "s2"}) == "s1" }
+	uz.rename = renameFiles("Product", "ProductStaging")
+	go func() {
+		io.Copy(wc, &buf)
+		wc.Close()
+	}()
+	_, err = uz.ReadChunk()
+	require.Nil(t, err)
+
+	data, err := os.ReadFile(filepath.Join(destPath, "productstaging_s1_lsm/objects/segment-1.db"))
+	require.Nil(t, err)
+	assert.Equal(t, files[0], string(data))
+	for _, p := range []string{files[0], files[1], "productstaging_s2_lsm"} {
+		_, err = os.Stat(filepath.Join(destPath, p))
+		assert.True(t, os.IsNotExist(err), p)
+	}
+}
+
+func TestValidateClassMapping(t *testing.T) {
+	classes := []string{"Product", "Category"}
+
+	got, err := validateClassMapping(classes, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, got)
+
+	got, err = validateClassMapping(classes, map[string]string{"Product": "productStaging"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"Product": "ProductStaging"}, got)
+
+	_, err = validateClassMapping(classes, map[string]string{"Unknown": "Other"})
+	assert.ErrorContains(t, err, "not restored")
+
+	_, err = validateClassMapping(classes, map[string]string{"Product": "Invalid-Name"})
+	assert.ErrorContains(t, err, "not a valid class name")
+
+	_, err = validateClassMapping(classes, map[string]string{"Product": "CATEGORY"})
+	assert.ErrorContains(t, err, "would both be restored as")
+}
+
+func TestPlanRestore(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		backupID = "1"
+		cls      = "Product"
+	)
+	// newBackup returns a backup of cls taken on nodes with replication factor rf
+	newBackup := func(t *testing.T, nodes []string, rf int64) (*fakeScheduler, *backup.DistributedBackupDescriptor) {
+		cfg, err := sharding.ParseConfig(map[string]interface{}{"desiredCount": float64(len(nodes))}, len(nodes))
+		require.Nil(t, err)
+		state, err := sharding.InitState(cls, cfg, staticNodes{names: nodes, local: nodes[0]}, rf, false)
+		require.Nil(t, err)
+		shardingState, err := json.Marshal(state)
+		require.Nil(t, err)
+		schema, err := json.Marshal(&models.Class{Class: cls, ReplicationConfig: &models.ReplicationConfig{Factor: rf}})
+		require.Nil(t, err)
+
+		fs := newFakeScheduler(nil)
+		meta := &backup.DistributedBackupDescriptor{ID: backupID, Nodes: map[string]*backup.NodeDescriptor{}}
+		for _, node := range nodes {
+			cdesc := backup.ClassDescriptor{Name: cls, Schema: schema, ShardingState: shardingState}
+			for name, p := range state.Physical {
+				for _, owner := range p.BelongsToNodes {
+					if owner == node {
+						cdesc.Shards = append(cdesc.Shards, &backup.ShardDescriptor{Name: name, Node: node})
+					}
+				}
+			}
+			desc := backup.BackupDescriptor{ID: backupID, Status: string(backup.Success), Classes: []backup.ClassDescriptor{cdesc}}
+			fs.backend.On("GetObject", ctx, backupID+"/"+node, BackupFile).Return(marshalMeta(desc), nil)
+			meta.Nodes[node] = &backup.NodeDescriptor{Classes: []string{cls}}
+		}
+		return fs, meta
+	}
+	restoredState := func(t *testing.T, plan *ClassRestore) sharding.State {
+		var state sharding.State
+		require.Nil(t, json.Unmarshal(plan.ShardingState, &state))
+		return state
+	}
+
+	t.Run("ShrinkClusterAndRename", func(t *testing.T) {
+		fs, meta := newBackup(t, []string{"N1", "N2", "N3"}, 1)
+		resolver := newFakeNodeResolver([]string{"M1"})
+		mapping := map[string]string{cls: "ProductStaging"}
+		require.True(t, needsRestorePlan(meta, mapping, resolver))
+
+		store := coordStore{objStore{b: fs.backend, BasePath: backupID}}
+		plans, err := planRestore(ctx, store, meta, mapping, resolver)
+		require.Nil(t, err)
+		require.Len(t, plans, 1)
+		plan := plans["M1"][cls]
+		require.NotNil(t, plan)
+		assert.Equal(t, "ProductStaging", plan.Name)
+		assert.Equal(t, int64(1), plan.ReplicationFactor)
+		assert.Len(t, plan.Shards, 3)
+		sources := map[string]bool{}
+		for _, node := range plan.Shards {
+			sources[node] = true
+		}
+		assert.Len(t, sources, 3, "every shard is read from the node which backed it up")
+
+		state := restoredState(t, plan)
+		assert.Equal(t, "ProductStaging", state.IndexID)
+		for _, p := range state.Physical {
+			assert.Equal(t, []string{"M1"}, p.BelongsToNodes)
+		}
+		assert.Equal(t, map[string]*backup.NodeDescriptor{"M1": {Classes: []string{cls}}}, meta.Nodes)
+	})
+
+	t.Run("GrowReplicatedCluster", func(t *testing.T) {
+		fs, meta := newBackup(t, []string{"N1", "N2"}, 2)
+		resolver := newFakeNodeResolver([]string{"N1", "N2", "N3", "N4", "N5"})
+
+		store := coordStore{objStore{b: fs.backend, BasePath: backupID}}
+		plans, err := planRestore(ctx, store, meta, nil, resolver)
+		require.Nil(t, err)
+		require.Len(t, plans, 5)
+		replicas := 0
+		for node, classes := range plans {
+			plan := classes[cls]
+			require.NotNil(t, plan, node)
+			assert.Equal(t, cls, plan.Name)
+			assert.Equal(t, int64(2), plan.ReplicationFactor)
+			for _, src := range plan.Shards {
+				if node == "N1" || node == "N2" {
+					assert.Equal(t, node, src, "local copies are preferred")
+				}
+			}
+			replicas += len(plan.Shards)
+		}
+		assert.Equal(t, 4, replicas)
+		for _, p := range restoredState(t, plans["N3"][cls]).Physical {
+			assert.Len(t, p.BelongsToNodes, 2)
+		}
+	})
+
+	t.Run("SameTopology", func(t *testing.T) {
+		_, meta := newBackup(t, []string{"N1", "N2"}, 1)
+		assert.False(t, needsRestorePlan(meta, map[string]string{cls: cls}, newFakeNodeResolver([]string{"N1", "N2"})))
+	})
+
+	t.Run("SubsetOfCluster", func(t *testing.T) {
+		_, meta := newBackup(t, []string{"N1"}, 1)
+		assert.False(t, needsRestorePlan(meta, nil, newFakeNodeResolver([]string{"N1", "N2", "N3"})),
+			"shards are restored on the nodes which backed them up")
+		assert.True(t, needsRestorePlan(meta, nil, newFakeNodeResolver([]string{"M1", "M2", "M3"})))
+	})
+
+	t.Run("NodeBackupNotFound", func(t *testing.T) {
+		fs, meta := newBackup(t, []string{"N1"}, 1)
+		meta.Nodes["N2"] = &backup.NodeDescriptor{Classes: []string{cls}}
+		fs.backend.On("GetObject", ctx, backupID+"/N2", BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.ErrNotFound{})
+		store := coordStore{objStore{b: fs.backend, BasePath: backupID}}
+		_, err := planRestore(ctx, store, meta, nil, newFakeNodeResolver([]string{"N3"}))
+		assert.ErrorContains(t, err, "N2")
+	})
+}
+
+func TestPlannedClasses(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		backupID = "1"
+		cls      = "Product"
+		backend  = newFakeBackend()
+		store    = nodeStore{objStore{b: backend, BasePath: backupID + "/M1"}}
+		schema   = []byte(`{"class":"Product"}`)
+	)
+	for node, shards := range map[string][]string{"N1": {"s1", "s2"}, "N2": {"s3"}} {
+		cdesc := backup.ClassDescriptor{Name: cls, Schema: schema, Chunks: map[int32][]string{1: shards}}
+		for _, s := range shards {
+			cdesc.Shards = append(cdesc.Shards, &backup.ShardDescriptor{Name: s, Node: node})
+		}
+		desc := backup.BackupDescriptor{
+			ID: backupID, Status: string(backup.Success), Version: Version,
+			Classes: []backup.ClassDescriptor{cdesc},
+		}
+		backend.On("GetObject", ctx, backupID+"/"+node, BackupFile).Return(marshalMeta(desc), nil)
+	}
+	req := &Request{
+		ID:      backupID,
+		Classes: []string{cls},
+		Restore: map[string]*ClassRestore{cls: {
+			Name:              "ProductStaging",
+			ShardingState:     []byte(`{}`),
+			ReplicationFactor: 1,
+			SchemaNode:        "N2",
+			Shards:            map[string]string{"s1": "N1", "s3": "N2"},
+		}},
+	}
+
+	classes, err := createManager(nil, nil, backend, nil).restorer.plannedClasses(ctx, store, req)
+	require.Nil(t, err)
+	require.Len(t, classes, 1)
+	got := classes[0]
+	assert.Equal(t, "ProductStaging", got.desc.Name)
+	assert.Equal(t, []byte(`{}`), got.desc.ShardingState)
+	assert.JSONEq(t, `{"class":"ProductStaging","properties":null,"replicationConfig":{"factor":1}}`, string(got.desc.Schema))
+	require.NotNil(t, got.rename)
+	require.Len(t, got.parts, 2)
+
+	assert.Equal(t, backupID+"/N1", got.parts[0].store.BasePath)
+	assert.Equal(t, []*backup.ShardDescriptor{{Name: "s1", Node: "N1"}}, got.parts[0].desc.Shards)
+	assert.NotNil(t, got.parts[0].filter, "s2 is restored by another node")
+	assert.True(t, got.parts[0].compressed)
+
+	assert.Equal(t, backupID+"/N2", got.parts[1].store.BasePath)
+	assert.Equal(t, []*backup.ShardDescriptor{{Name: "s3", Node: "N2"}}, got.parts[1].desc.Shards)
+	assert.Nil(t, got.parts[1].filter)
+
+	t.Run("MissingPlan", func(t *testing.T) {
+		req := &Request{ID: backupID, Classes: []string{cls}, Restore: map[string]*ClassRestore{}}
+		_, err := createManager(nil, nil, backend, nil).restorer.plannedClasses(ctx, store, req)
+		assert.ErrorContains(t, err, "missing restore plan")
+	})
+}
@@ -59,6 +59,26 @@ func (r *restorer) restore(ctx context.Context,
 	req *Request,
 	desc *backup.BackupDescriptor,
 	store nodeStore,
+) (CanCommitResponse, error) {
+	return r.restoreClasses(ctx, req, localClasses(desc, store), store)
+}
+
+// restorePlanned restores classes according to the restore plan of req
+func (r *restorer) restorePlanned(ctx context.Context,
+	req *Request,
+	store nodeStore,
+) (CanCommitResponse, error) {
+	classes, err := r.plannedClasses(ctx, store, req)
+	if err != nil {
+		return CanCommitResponse{Method: OpCreate, ID: req.ID}, err
+	}
+	return r.restoreClasses(ctx, req, classes, store)
+}
+
+func (r *restorer) restoreClasses(ctx context.Context,
+	req *Request,
+	classes []classRestore,
+	store nodeStore,
 ) (CanCommitResponse, error) {
 	expiration := req.Duration
 	if expiration > _TimeoutShardCommit {
@@ -72,6 +92,12 @@ func (r *restorer) restore(ctx context.Context,
 
 	destPath := store.HomeDir()
 
+	if t := req.PointInTime; !t.IsZero() {
+		if err := loadWAL(ctx, classes, t); err != nil {
+			return ret, fmt.Errorf("point-in-time recovery: %w", err)
+		}
+	}
+
 	// make sure there is no active restore
 	if prevID := r.lastOp.renew(req.ID, destPath); prevID != "" {
 		err := fmt.Errorf("restore %s already in progress", prevID)
@@ -105,9 +131,14 @@ func (r *restorer) restore(ctx context.Context,
 			return
 		}
 
-		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store)
+		// the coordinator might want to abort the restoration
+		done := make(chan struct{})
+		ctx := r.withCancellation(context.Background(), req.ID, done)
+		defer close(done)
+
+		err = r.restoreAll(ctx, req.ID, classes, req.CPUPercentage)
 		if err != nil {
-			r.logger.WithField("action", "restore").WithField("backup_id", desc.ID).Error(err)
+			r.logger.WithField("action", "restore").WithField("backup_id", req.ID).Error(err)
 		}
 	}()
 
@@ -115,18 +146,19 @@ func (r *restorer) restore(ctx context.Context,
 }
 
 func (r *restorer) restoreAll(ctx context.Context,
-	desc *backup.BackupDescriptor, cpuPercentage int,
-	store nodeStore,
+	backupID string, classes []classRestore, cpuPercentage int,
 ) (err error) {
-	compressed := desc.Version > version1
 	r.lastOp.set(backup.Transferring)
-	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, desc.ID, &cdesc, compressed, cpuPercentage, store); err != nil {
-			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
+	for _, cls := range classes {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("restore class %s: %w", cls.desc.Name, err)
+		}
+		if err := r.restoreOne(ctx, backupID, cls, cpuPercentage); err != nil {
+			return fmt.Errorf("restore class %s: %w", cls.desc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
-			WithField("backup_id", desc.ID).
-			WithField("class", cdesc.Name).Info("successfully restored")
+			WithField("backup_id", backupID).
+			WithField("class", cls.desc.Name).Info("successfully restored")
 	}
 	return nil
 }
@@ -140,29 +172,44 @@ func getType(myvar interface{}) string {
 }
 
 func (r *restorer) restoreOne(ctx context.Context,
-	backupID string, desc *backup.ClassDescriptor,
-	compressed bool, cpuPercentage int, store nodeStore,
+	backupID string, cls classRestore, cpuPercentage int,
 ) (err error) {
-	metric, err := monitoring.GetMetrics().BackupRestoreDurations.GetMetricWithLabelValues(getType(store.b), desc.Name)
-	if err != nil {
-		timer := prometheus.NewTimer(metric)
-		defer timer.ObserveDuration()
+	desc := cls.desc
+	if len(cls.parts) > 0 {
+		metric, err := monitoring.GetMetrics().BackupRestoreDurations.GetMetricWithLabelValues(getType(cls.parts[0].store.b), desc.Name)
+		if err != nil {
+			timer := prometheus.NewTimer(metric)
+			defer timer.ObserveDuration()
+		}
 	}
 
 	if r.sourcer.ClassExists(desc.Name) {
 		return fmt.Errorf("already exists")
 	}
-	fw := newFileWriter(r.sourcer, store, backupID, compressed).
-		WithPoolPercentage(cpuPercentage)
+	rollbacks := make([]func() error, 0, len(cls.parts))
+	rollback := func() {
+		for _, f := range rollbacks {
+			if rerr := f(); rerr != nil {
+				r.logger.WithField("className", desc.Name).WithField("action", "rollback").Error(rerr)
+			}
+		}
+	}
+	for _, part := range cls.parts {
+		fw := newFileWriter(r.sourcer, part.store, backupID, part.compressed).
+			WithPoolPercentage(cpuPercentage).
+			WithRename(cls.rename).
+			WithFilter(part.filter).
+			WithWAL(part.wal)
 
-	rollback, err := fw.Write(ctx, desc)
-	if err != nil {
-		return fmt.Errorf("write files: %w", err)
+		f, err := fw.Write(ctx, part.desc)
+		if err != nil {
+			rollback()
+			return fmt.Errorf("write files: %w", err)
+		}
+		rollbacks = append(rollbacks, f)
 	}
 	if err := r.schema.RestoreClass(ctx, desc); err != nil {
-		if rerr := rollback(); rerr != nil {
-			r.logger.WithField("className", desc.Name).WithField("action", "rollback").Error(rerr)
-		}
+		rollback()
 		return fmt.Errorf("restore schema: %w", err)
 	}
 	return nil
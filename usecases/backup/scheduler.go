@@ -15,11 +15,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 var (
@@ -72,8 +77,8 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		logOperation(s.logger, "try_backup", req.ID, req.Backend, begin, err)
 	}(time.Now())
 
-	path := fmt.Sprintf("backups/%s/%s", req.Backend, req.ID)
-	if err := s.authorizer.Authorize(pr, "add", path); err != nil {
+	path := authorization.Backups(req.Backend, req.ID)
+	if err := s.authorizer.Authorize(pr, "create", path); err != nil {
 		return nil, err
 	}
 	store, err := coordBackend(s.backends, req.Backend, req.ID)
@@ -91,10 +96,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
 	breq := Request{
-		Method:  OpCreate,
-		ID:      req.ID,
-		Backend: req.Backend,
-		Classes: classes,
+		Method:     OpCreate,
+		ID:         req.ID,
+		Backend:    req.Backend,
+		Classes:    classes,
+		BaseID:     req.BaseID,
+		ArchiveWAL: req.ArchiveWAL,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -117,7 +124,7 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 	defer func(begin time.Time) {
 		logOperation(s.logger, "try_restore", req.ID, req.Backend, begin, err)
 	}(time.Now())
-	path := fmt.Sprintf("backups/%s/%s/restore", req.Backend, req.ID)
+	path := authorization.Backups(req.Backend, req.ID)
 	if err := s.authorizer.Authorize(pr, "restore", path); err != nil {
 		return nil, err
 	}
@@ -133,14 +140,27 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 		}
 		return nil, backup.NewErrUnprocessable(err)
 	}
+	var plans map[string]map[string]*ClassRestore
+	if needsRestorePlan(meta, req.ClassMapping, s.restorer.nodeResolver) {
+		plans, err = planRestore(ctx, store, meta, req.ClassMapping, s.restorer.nodeResolver)
+		if err != nil {
+			return nil, backup.NewErrUnprocessable(fmt.Errorf("plan restore: %w", err))
+		}
+	}
+	classes := meta.Classes()
+	for i, class := range classes {
+		if to, ok := req.ClassMapping[class]; ok {
+			classes[i] = to
+		}
+	}
 	status := string(backup.Started)
 	data := &models.BackupRestoreResponse{
 		Backend: req.Backend,
 		ID:      req.ID,
 		Path:    store.HomeDir(),
-		Classes: meta.Classes(),
+		Classes: classes,
 	}
-	err = s.restorer.Restore(ctx, store, req.Backend, meta)
+	err = s.restorer.Restore(ctx, store, req.Backend, meta, plans)
 	if err != nil {
 		status = string(backup.Failed)
 		data.Error = err.Error()
@@ -157,7 +177,7 @@ func (s *Scheduler) BackupStatus(ctx context.Context, principal *models.Principa
 	defer func(begin time.Time) {
 		logOperation(s.logger, "backup_status", backupID, backend, begin, err)
 	}(time.Now())
-	path := fmt.Sprintf("backups/%s/%s", backend, backupID)
+	path := authorization.Backups(backend, backupID)
 	if err := s.authorizer.Authorize(principal, "get", path); err != nil {
 		return nil, err
 	}
@@ -180,7 +200,7 @@ func (s *Scheduler) RestorationStatus(ctx context.Context, principal *models.Pri
 	defer func(begin time.Time) {
 		logOperation(s.logger, "restoration_status", backupID, backend, time.Now(), err)
 	}(time.Now())
-	path := fmt.Sprintf("backups/%s/%s/restore", backend, backupID)
+	path := authorization.Backups(backend, backupID)
 	if err := s.authorizer.Authorize(principal, "get", path); err != nil {
 		return nil, err
 	}
@@ -197,6 +217,200 @@ func (s *Scheduler) RestorationStatus(ctx context.Context, principal *models.Pri
 	return st, nil
 }
 
+// Cancel cancels the creation of backup backupID if it is in progress
+func (s *Scheduler) Cancel(ctx context.Context, principal *models.Principal, backend, backupID string,
+) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "cancel_backup", backupID, backend, begin, err)
+	}(time.Now())
+	path := authorization.Backups(backend, backupID)
+	if err := s.authorizer.Authorize(principal, "create", path); err != nil {
+		return err
+	}
+	if s.backupper.Cancel(backupID) == nil {
+		return nil
+	}
+	// the backup might be coordinated by another node
+	req := &AbortRequest{Method: OpCreate, ID: backupID, Backend: backend}
+	if err := s.backupper.forwardCancel(ctx, req); err != nil {
+		return backup.NewErrNotFound(err)
+	}
+	return nil
+}
+
+// CancelRestore cancels the restoration of backup backupID if it is in progress.
+// Classes which have been completely restored already are kept.
+func (s *Scheduler) CancelRestore(ctx context.Context, principal *models.Principal, backend, backupID string,
+) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "cancel_restore", backupID, backend, begin, err)
+	}(time.Now())
+	path := authorization.Backups(backend, backupID)
+	if err := s.authorizer.Authorize(principal, "restore", path); err != nil {
+		return err
+	}
+	if s.restorer.Cancel(backupID) == nil {
+		return nil
+	}
+	// the restoration might be coordinated by another node
+	req := &AbortRequest{Method: OpRestore, ID: backupID, Backend: backend}
+	if err := s.restorer.forwardCancel(ctx, req); err != nil {
+		return backup.NewErrNotFound(err)
+	}
+	return nil
+}
+
+// OnCancel cancels an operation coordinated by this node on behalf of another
+// node which received the cancel request
+func (s *Scheduler) OnCancel(ctx context.Context, req *AbortRequest) error {
+	switch req.Method {
+	case OpCreate:
+		return s.backupper.Cancel(req.ID)
+	case OpRestore:
+		return s.restorer.Cancel(req.ID)
+	default:
+		return fmt.Errorf("%w: %s", errUnknownOp, req.Method)
+	}
+}
+
+// List returns all backups stored on a backend ordered by their start time
+func (s *Scheduler) List(ctx context.Context, principal *models.Principal, backend string,
+) (_ models.BackupListResponse, err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "list_backups", "", backend, begin, err)
+	}(time.Now())
+	path := authorization.Backups(backend, "")
+	if err := s.authorizer.Authorize(principal, "list", path); err != nil {
+		return nil, err
+	}
+	store, err := coordBackend(s.backends, backend, "")
+	if err != nil {
+		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	metas, err := s.allBackups(ctx, store)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	sort.Slice(metas, func(i, j int) bool {
+		return metas[i].StartedAt.Before(metas[j].StartedAt)
+	})
+
+	resp := make(models.BackupListResponse, len(metas))
+	for i, meta := range metas {
+		classes := meta.Classes()
+		sort.Strings(classes)
+		resp[i] = &models.BackupListResponseItems0{
+			ID:        meta.ID,
+			BaseID:    meta.BaseID,
+			Classes:   classes,
+			Status:    string(meta.Status),
+			StartedAt: strfmt.DateTime(meta.StartedAt),
+		}
+		if !meta.CompletedAt.IsZero() {
+			completedAt := strfmt.DateTime(meta.CompletedAt)
+			resp[i].CompletedAt = &completedAt
+		}
+	}
+	return resp, nil
+}
+
+// Delete removes backup backupID and all of its files from a backend.
+// Backups which are in progress or which are the base of an incremental
+// backup cannot be deleted.
+func (s *Scheduler) Delete(ctx context.Context, principal *models.Principal, backend, backupID string,
+) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "delete_backup", backupID, backend, begin, err)
+	}(time.Now())
+	path := authorization.Backups(backend, backupID)
+	if err := s.authorizer.Authorize(principal, "delete", path); err != nil {
+		return err
+	}
+	store, err := coordBackend(s.backends, backend, backupID)
+	if err != nil {
+		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
+		return backup.NewErrUnprocessable(err)
+	}
+	metas, err := s.allBackups(ctx, store)
+	if err != nil {
+		return backup.NewErrUnprocessable(err)
+	}
+	bases, found := make(map[string]string, len(metas)), false
+	for _, meta := range metas {
+		bases[meta.ID] = meta.BaseID
+		if meta.ID == backupID {
+			found = true
+			if !isFinal(meta.Status) {
+				return backup.NewErrUnprocessable(
+					fmt.Errorf("backup %q is in progress, cancel it first", backupID))
+			}
+		}
+	}
+	if !found {
+		return backup.NewErrNotFound(fmt.Errorf("%w: %q", errMetaNotFound, store.HomeDir()))
+	}
+	// the backup might be restored by any node of the cluster
+	restore, err := store.Meta(ctx, GlobalRestoreFile)
+	notFoundErr := backup.ErrNotFound{}
+	if err != nil && !errors.As(err, &notFoundErr) {
+		return backup.NewErrUnprocessable(fmt.Errorf("get restore status: %w", err))
+	}
+	if err == nil && !isFinal(restore.Status) {
+		return backup.NewErrUnprocessable(
+			fmt.Errorf("backup %q is being restored, cancel the restoration first", backupID))
+	}
+	// incremental backups might reference files of any backup of their chain
+	for id, base := range bases {
+		for n := 0; base != "" && id != backupID && n < len(bases); n++ {
+			if base == backupID {
+				return backup.NewErrUnprocessable(
+					fmt.Errorf("backup %q is the base of incremental backup %q", backupID, id))
+			}
+			base = bases[base]
+		}
+	}
+
+	if err := store.b.DeleteBackup(ctx, backupID); err != nil {
+		return fmt.Errorf("delete backup %q: %w", backupID, err)
+	}
+	return nil
+}
+
+// allBackups returns the metadata of all backups stored on a backend
+func (s *Scheduler) allBackups(ctx context.Context, store coordStore) ([]*backup.DistributedBackupDescriptor, error) {
+	ids, err := store.b.ListBackups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list backups: %w", err)
+	}
+	metas := make([]*backup.DistributedBackupDescriptor, 0, len(ids))
+	for _, id := range ids {
+		// skip folders which are not backups, e.g. offloaded tenants
+		if validateID(id) != nil {
+			continue
+		}
+		cs := coordStore{objStore{b: store.b, BasePath: id}}
+		meta, err := cs.Meta(ctx, GlobalBackupFile)
+		if err != nil {
+			notFoundErr := backup.ErrNotFound{}
+			if errors.As(err, &notFoundErr) {
+				continue
+			}
+			return nil, fmt.Errorf("get backup %q: %w", id, err)
+		}
+		if meta.ID == "" {
+			meta.ID = id
+		}
+		metas = append(metas, meta)
+	}
+	return metas, nil
+}
+
+// isFinal returns true if an operation with status st is not in progress anymore
+func isFinal(st backup.Status) bool {
+	return st == backup.Success || st == backup.Failed || st == backup.Cancelled
+}
+
 func coordBackend(provider BackupBackendProvider, backend, id string) (coordStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
@@ -243,9 +457,41 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.BaseID != "" {
+		if err := s.validateBaseBackup(ctx, store, req); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// validateBaseBackup makes sure that the base of an incremental backup was
+// successfully created on the same backend
+func (s *Scheduler) validateBaseBackup(ctx context.Context, store coordStore, req *BackupRequest) error {
+	if req.BaseID == req.ID {
+		return fmt.Errorf("backup %q cannot be its own base", req.ID)
+	}
+	if err := validateID(req.BaseID); err != nil {
+		return fmt.Errorf("base backup: %w", err)
+	}
+	baseStore := coordStore{objStore{b: store.b, BasePath: req.BaseID}}
+	meta, err := baseStore.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		notFoundErr := backup.ErrNotFound{}
+		if errors.As(err, &notFoundErr) {
+			return fmt.Errorf("base backup %q doesn't exist at %q", req.BaseID, baseStore.HomeDir())
+		}
+		return fmt.Errorf("find base backup %q: %w", req.BaseID, err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("invalid base backup %q status: %s", req.BaseID, meta.Status)
+	}
+	if meta.Version < version2 {
+		return fmt.Errorf("base backup %q: version %s doesn't support incremental backups", req.BaseID, meta.Version)
+	}
+	return nil
+}
+
 func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore, req *BackupRequest) (*backup.DistributedBackupDescriptor, error) {
 	if !store.b.IsExternal() && s.restorer.nodeResolver.NodeCount() > 1 {
 		return nil, errLocalBackendDBRO
@@ -290,9 +536,61 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if meta.RemoveEmpty().Count() == 0 {
 		return nil, fmt.Errorf("nothing left to restore: please choose from : %v", cs)
 	}
+	if req.ClassMapping, err = validateClassMapping(meta.Classes(), req.ClassMapping); err != nil {
+		return nil, err
+	}
+	meta.PointInTime = nil
+	if t := req.PointInTime; !t.IsZero() {
+		if !meta.ArchiveWAL {
+			return nil, fmt.Errorf("point-in-time recovery: commit logs of backup %q are not archived", req.ID)
+		}
+		if t.Before(meta.StartedAt) {
+			return nil, fmt.Errorf("point-in-time recovery: %s is before backup %q started at %s",
+				t.Format(time.RFC3339), req.ID, meta.StartedAt.Format(time.RFC3339))
+		}
+		t = t.UTC()
+		meta.PointInTime = &t
+	}
 	return meta, nil
 }
 
+// validateClassMapping makes sure that restored classes are renamed to valid
+// and distinct class names. It returns the mapping with normalized class names.
+func validateClassMapping(classes []string, mapping map[string]string) (map[string]string, error) {
+	if len(mapping) == 0 {
+		return nil, nil
+	}
+	restored := make(map[string]struct{}, len(classes))
+	for _, class := range classes {
+		restored[class] = struct{}{}
+	}
+	out := make(map[string]string, len(mapping))
+	for from, to := range mapping {
+		if _, ok := restored[from]; !ok {
+			return nil, fmt.Errorf("class mapping: class %s is not restored, restored classes are %v", from, classes)
+		}
+		name, err := schema.ValidateClassName(schema.UppercaseClassName(to))
+		if err != nil {
+			return nil, fmt.Errorf("class mapping: %w", err)
+		}
+		out[from] = string(name)
+	}
+	sort.Strings(classes)
+	seen := make(map[string]string, len(classes))
+	for _, class := range classes {
+		name := class
+		if to, ok := out[class]; ok {
+			name = to
+		}
+		// class names map to case insensitive index names
+		if prev, ok := seen[strings.ToLower(name)]; ok {
+			return nil, fmt.Errorf("class mapping: classes %s and %s would both be restored as %s", prev, class, name)
+		}
+		seen[strings.ToLower(name)] = class
+	}
+	return out, nil
+}
+
 func logOperation(logger logrus.FieldLogger, name, id, backend string, begin time.Time, err error) {
 	le := logger.WithField("action", name).
 		WithField("backup_id", id).WithField("backend", backend).
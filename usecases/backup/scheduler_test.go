@@ -20,10 +20,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -135,6 +137,61 @@ func TestSchedulerValidateCreateBackup(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("backup %q already exists", id))
 		assert.IsType(t, backup.ErrUnprocessable{}, err)
 	})
+
+	t.Run("BaseBackupNotFound", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, mock.Anything, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, mock.Anything, BackupFile).Return(nil, backup.ErrNotFound{})
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend: backendName,
+			ID:      id,
+			BaseID:  "base",
+			Include: []string{cls},
+		})
+
+		assert.Nil(t, meta)
+		assert.Contains(t, err.Error(), `base backup "base" doesn't exist`)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("BaseBackupFailed", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		bytes := marshalCoordinatorMeta(backup.DistributedBackupDescriptor{
+			ID: "base", Status: backup.Failed, Version: Version,
+		})
+		fs.backend.On("GetObject", ctx, "base", GlobalBackupFile).Return(bytes, nil)
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend: backendName,
+			ID:      id,
+			BaseID:  "base",
+			Include: []string{cls},
+		})
+
+		assert.Nil(t, meta)
+		assert.Contains(t, err.Error(), `invalid base backup "base" status`)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("BaseBackupIsItself", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		_, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend: backendName,
+			ID:      id,
+			BaseID:  id,
+			Include: []string{cls},
+		})
+		assert.Contains(t, err.Error(), "cannot be its own base")
+	})
 }
 
 func TestSchedulerBackupStatus(t *testing.T) {
@@ -682,6 +739,32 @@ func TestSchedulerRestoreRequestValidation(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), cls)
 	})
+
+	t.Run("PointInTimeWithoutArchivedLogs", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{ID: id, PointInTime: timePt.Add(time.Minute)})
+		assert.NotNil(t, err)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "not archived")
+	})
+
+	t.Run("PointInTimeBeforeBackup", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+
+		meta := meta
+		meta.ArchiveWAL = true
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{ID: id, PointInTime: timePt.Add(-time.Minute)})
+		assert.NotNil(t, err)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "before backup")
+	})
 }
 
 type fakeScheduler struct {
@@ -741,3 +824,187 @@ func TestFirstDuplicate(t *testing.T) {
 		}
 	}
 }
+
+func TestSchedulerCancelBackup(t *testing.T) {
+	var (
+		cls         = "Class-A"
+		node        = "Node-A"
+		backendName = "gcs"
+		backupID    = "1"
+		any         = mock.Anything
+		ctx         = context.Background()
+		path        = "dst/path"
+		req         = BackupRequest{
+			ID:      backupID,
+			Include: []string{cls},
+			Backend: backendName,
+		}
+		cresp = &CanCommitResponse{Method: OpCreate, ID: backupID, Timeout: 1}
+		sReq  = &StatusRequest{OpCreate, backupID, backendName}
+		sresp = &StatusResponse{Status: backup.Transferring, ID: backupID, Method: OpCreate}
+		aReq  = &AbortRequest{OpCreate, backupID, backendName}
+	)
+
+	t.Run("NotInProgress", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		err := fs.scheduler().Cancel(ctx, nil, backendName, backupID)
+		assert.IsType(t, backup.ErrNotFound{}, err)
+		err = fs.scheduler().CancelRestore(ctx, nil, backendName, backupID)
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+
+	t.Run("ForwardedToCoordinator", func(t *testing.T) {
+		fs := newFakeScheduler(newFakeNodeResolver([]string{"A", "B", "C"}))
+		fs.client.On("Cancel", any, "B", aReq).Return(ErrAny)
+		fs.client.On("Cancel", any, "C", aReq).Return(nil)
+		assert.Nil(t, fs.scheduler().Cancel(ctx, nil, backendName, backupID))
+		fs.client.AssertNotCalled(t, "Cancel", any, "A", aReq)
+		fs.client.AssertCalled(t, "Cancel", any, "C", aReq)
+
+		rReq := &AbortRequest{OpRestore, backupID, backendName}
+		fs.client.On("Cancel", any, mock.Anything, rReq).Return(ErrAny)
+		err := fs.scheduler().CancelRestore(ctx, nil, backendName, backupID)
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		fs := newFakeScheduler(newFakeNodeResolver([]string{node}))
+		fs.selector.On("Backupable", ctx, req.Include).Return(nil)
+		fs.selector.On("Shards", ctx, cls).Return([]string{node})
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("Initialize", ctx, mock.Anything).Return(nil)
+		fs.client.On("CanCommit", any, node, any).Return(cresp, nil)
+		fs.client.On("Commit", any, node, sReq).Return(nil)
+		fs.client.On("Status", any, node, sReq).Return(sresp, nil)
+		fs.client.On("Abort", any, node, aReq).Return(nil)
+		fs.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		s := fs.scheduler()
+		_, err := s.Backup(ctx, nil, &req)
+		assert.Nil(t, err)
+
+		assert.Nil(t, s.Cancel(ctx, nil, backendName, backupID))
+		for i := 0; i < 10; i++ {
+			time.Sleep(time.Millisecond * 50)
+			if s.backupper.lastOp.get().Status == "" {
+				break
+			}
+		}
+		fs.client.AssertCalled(t, "Abort", any, node, aReq)
+		fs.backend.RLock()
+		defer fs.backend.RUnlock()
+		assert.Equal(t, backup.Cancelled, fs.backend.glMeta.Status)
+		assert.Equal(t, backup.Cancelled, fs.backend.glMeta.Nodes[node].Status)
+	})
+}
+
+func TestSchedulerListAndDeleteBackups(t *testing.T) {
+	var (
+		backendName = "s3"
+		ctx         = context.Background()
+		path        = "bucket/backups"
+		start       = time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC)
+		completed   = start.Add(time.Minute)
+		metas       = map[string]backup.DistributedBackupDescriptor{
+			"incr": {
+				ID: "incr", BaseID: "full", Status: backup.Success,
+				StartedAt: start.Add(time.Hour), CompletedAt: completed.Add(time.Hour),
+				Nodes: map[string]*backup.NodeDescriptor{nodeName: {Classes: []string{"A"}}},
+			},
+			"full": {
+				ID: "full", Status: backup.Success, StartedAt: start, CompletedAt: completed,
+				Nodes: map[string]*backup.NodeDescriptor{nodeName: {Classes: []string{"B", "A"}}},
+			},
+			"incr2": {
+				ID: "incr2", BaseID: "incr", Status: backup.Failed,
+				StartedAt: start.Add(2 * time.Hour), CompletedAt: completed.Add(2 * time.Hour),
+				Nodes: map[string]*backup.NodeDescriptor{nodeName: {Classes: []string{"A"}}},
+			},
+			"running": {
+				ID: "running", Status: backup.Transferring, StartedAt: start.Add(3 * time.Hour),
+				Nodes: map[string]*backup.NodeDescriptor{nodeName: {Classes: []string{"B"}}},
+			},
+		}
+	)
+	// restoring is the backup being restored
+	newScheduler := func(restoring string) *fakeScheduler {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("ListBackups", ctx).Return([]string{"incr", "full", "incr2", "running", "junk", ".frozen-tenants"}, nil)
+		for id, meta := range metas {
+			fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(marshalCoordinatorMeta(meta), nil)
+			restore := backup.DistributedBackupDescriptor{ID: id, Status: backup.Success}
+			if id == restoring {
+				restore.Status = backup.Transferring
+			}
+			fs.backend.On("GetObject", ctx, id, GlobalRestoreFile).Return(marshalCoordinatorMeta(restore), nil)
+		}
+		fs.backend.On("GetObject", ctx, "junk", GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, "junk", BackupFile).Return(nil, backup.ErrNotFound{})
+		return fs
+	}
+
+	t.Run("List", func(t *testing.T) {
+		list, err := newScheduler("").scheduler().List(ctx, nil, backendName)
+		require.Nil(t, err)
+		require.Len(t, list, 4)
+		completedAt := strfmt.DateTime(completed)
+		assert.Equal(t, &models.BackupListResponseItems0{
+			ID:          "full",
+			Classes:     []string{"A", "B"},
+			Status:      string(backup.Success),
+			StartedAt:   strfmt.DateTime(start),
+			CompletedAt: &completedAt,
+		}, list[0])
+		assert.Equal(t, "incr", list[1].ID)
+		assert.Equal(t, "full", list[1].BaseID)
+		assert.Equal(t, "incr2", list[2].ID)
+		assert.Equal(t, "running", list[3].ID)
+		assert.Nil(t, list[3].CompletedAt)
+	})
+
+	t.Run("ListFails", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("ListBackups", ctx).Return(nil, ErrAny)
+		_, err := fs.scheduler().List(ctx, nil, backendName)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("DeleteNotFound", func(t *testing.T) {
+		err := newScheduler("").scheduler().Delete(ctx, nil, backendName, "missing")
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+
+	t.Run("DeleteBaseOfIncrementalBackup", func(t *testing.T) {
+		for _, id := range []string{"full", "incr"} {
+			err := newScheduler("").scheduler().Delete(ctx, nil, backendName, id)
+			assert.IsType(t, backup.ErrUnprocessable{}, err)
+			assert.ErrorContains(t, err, "is the base of incremental backup")
+		}
+	})
+
+	t.Run("DeleteInProgress", func(t *testing.T) {
+		err := newScheduler("").scheduler().Delete(ctx, nil, backendName, "running")
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "is in progress")
+
+		err = newScheduler("incr2").scheduler().Delete(ctx, nil, backendName, "incr2")
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "is being restored")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		fs := newScheduler("")
+		fs.backend.On("DeleteBackup", ctx, "incr2").Return(nil)
+		assert.Nil(t, fs.scheduler().Delete(ctx, nil, backendName, "incr2"))
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, "incr2")
+	})
+
+	t.Run("DeleteFails", func(t *testing.T) {
+		fs := newScheduler("")
+		fs.backend.On("DeleteBackup", ctx, "incr2").Return(ErrAny)
+		err := fs.scheduler().Delete(ctx, nil, backendName, "incr2")
+		assert.ErrorContains(t, err, ErrAny.Error())
+	})
+}
@@ -30,6 +30,9 @@ type reqStat struct {
 	ID        string
 	Status    backup.Status
 	Path      string
+
+	BytesTransferred int64
+	BytesReused      int64
 }
 
 type backupStat struct {
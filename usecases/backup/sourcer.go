@@ -42,4 +42,20 @@ type Sourcer interface { // implemented by the index
 	//
 	// A class cannot be backed up either if it doesn't exist or if it has more than one physical shard.
 	ListBackupable() []string
+
+	// RetainWAL makes the shards of classes retain their commit logs instead of
+	// deleting them once their content has been flushed, until they are
+	// archived to archive. Retention survives restarts. Retained logs are
+	// deleted if archive is nil.
+	RetainWAL(_ context.Context, classes []string, archive *backup.WALArchiveRef) error
+
+	// RetainedWAL returns the classes whose commit logs are retained along
+	// with the backup archiving them.
+	RetainedWAL() map[string]backup.WALArchiveRef
+
+	// WALFiles lists the commit logs of all shards of class.
+	WALFiles(_ context.Context, class string) ([]backup.ShardWAL, error)
+
+	// ReleaseWAL deletes retained commit logs of class which have been archived.
+	ReleaseWAL(_ context.Context, class string, paths []string) error
 }
@@ -27,6 +27,8 @@ type client interface {
 	Status(_ context.Context, node string, _ *StatusRequest) (*StatusResponse, error)
 	// Abort tells a node to abort the previous backup operation
 	Abort(_ context.Context, node string, _ *AbortRequest) error
+	// Cancel asks a node to cancel an operation it coordinates
+	Cancel(_ context.Context, node string, _ *AbortRequest) error
 }
 
 type Request struct {
@@ -51,6 +53,20 @@ type Request struct {
 	CPUPercentage int
 
 	CompressionLevel int
+
+	// BaseID is the ID of the base backup of an incremental backup
+	BaseID string
+
+	// Restore maps the backup classes to the way this node restores them.
+	// It is only set if classes are renamed or the cluster topology changed.
+	Restore map[string]*ClassRestore
+
+	// ArchiveWAL makes the node archive its commit logs after the backup
+	ArchiveWAL bool
+
+	// PointInTime restores the state at this time by replaying archived
+	// commit logs on top of the backup. It is zero if not requested.
+	PointInTime time.Time
 }
 
 type CanCommitResponse struct {
@@ -79,6 +95,9 @@ type StatusResponse struct {
 	ID     string
 	Status backup.Status
 	Err    string
+
+	BytesTransferred int64
+	BytesReused      int64
 }
 
 type (
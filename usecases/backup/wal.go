@@ -0,0 +1,617 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	"golang.org/x/sync/errgroup"
+)
+
+// WALArchiveFile is used by a node to store the manifest of the commit logs
+// it archives after a backup
+const WALArchiveFile = "wal.json"
+
+// walArchiveInterval is the interval at which commit logs are archived. It is
+// the granularity of point-in-time recovery.
+const walArchiveInterval = 10 * time.Second
+
+var errBaseBackupDeleted = errors.New("backup has been deleted")
+
+func walSegmentKey(seq int64) string {
+	return fmt.Sprintf("wal/segment-%d", seq)
+}
+
+// walArchiver archives the commit logs which are written after a backup to
+// the backend of the backup. Only the logs of the last backup requesting it
+// are archived. Archiving stops if the backup is deleted and is resumed
+// after the node restarts.
+type walArchiver struct {
+	node    string
+	sourcer Sourcer
+	logger  logrus.FieldLogger
+
+	sync.Mutex
+	active *walArchive
+}
+
+func newWALArchiver(node string, sourcer Sourcer, logger logrus.FieldLogger) *walArchiver {
+	return &walArchiver{node: node, sourcer: sourcer, logger: logger}
+}
+
+// prepare makes the shards of classes retain their commit logs for archive
+// before the backup is taken, such that no log is lost until archiving
+// starts. Archiving of a previous backup is stopped.
+func (a *walArchiver) prepare(ctx context.Context, classes []string, archive backup.WALArchiveRef) error {
+	a.stop()
+	if err := a.sourcer.RetainWAL(ctx, classes, &archive); err != nil {
+		return fmt.Errorf("retain commit logs: %w", err)
+	}
+	return nil
+}
+
+// abort stops retaining the commit logs of classes if the backup failed
+func (a *walArchiver) abort(classes []string) {
+	if err := a.sourcer.RetainWAL(context.Background(), classes, nil); err != nil {
+		a.logger.WithField("action", "archive_wal").Error(err)
+	}
+}
+
+// start archives the commit logs of the successful backup desc to store
+func (a *walArchiver) start(store nodeStore, desc *backup.BackupDescriptor) {
+	w := newWALArchive(a.node, a.sourcer, store, desc, a.logger)
+	a.Lock()
+	a.active = w
+	a.Unlock()
+	go w.run(walArchiveInterval)
+}
+
+// resume continues archiving the commit logs which are still retained after
+// a restart. Classes whose logs are retained for another backup than the
+// most recent one, or for a backup which is gone, are released.
+func (a *walArchiver) resume(ctx context.Context, backends BackupBackendProvider) {
+	retained := a.sourcer.RetainedWAL()
+	if len(retained) == 0 {
+		return
+	}
+	logger := a.logger.WithField("action", "archive_wal")
+
+	w, err := a.resumable(ctx, backends, retained)
+	if err != nil {
+		logger.Errorf("cannot resume archiving commit logs: %v", err)
+	}
+	var stale []string
+	for class, ref := range retained {
+		if w == nil || ref.ID != w.manifest.ID || !w.covers(class) {
+			stale = append(stale, class)
+		}
+	}
+	if len(stale) > 0 {
+		if err := a.sourcer.RetainWAL(ctx, stale, nil); err != nil {
+			logger.Error(err)
+		}
+	}
+	if w == nil {
+		return
+	}
+
+	logger.WithField("backup_id", w.manifest.ID).Info("resume archiving commit logs")
+	a.Lock()
+	a.active = w
+	a.Unlock()
+	go w.run(walArchiveInterval)
+}
+
+// resumable returns the archive of the most recent backup the logs are
+// retained for, or nil if it can't be continued
+func (a *walArchiver) resumable(ctx context.Context, backends BackupBackendProvider,
+	retained map[string]backup.WALArchiveRef,
+) (*walArchive, error) {
+	var (
+		store nodeStore
+		desc  *backup.BackupDescriptor
+	)
+	for _, ref := range retained {
+		s, err := nodeBackend(a.node, backends, ref.Backend, ref.ID)
+		if err != nil {
+			return nil, fmt.Errorf("backup %s: %w", ref.ID, err)
+		}
+		var d backup.BackupDescriptor
+		if err := s.meta(ctx, BackupFile, &d); err != nil {
+			nerr := backup.ErrNotFound{}
+			if errors.As(err, &nerr) {
+				continue
+			}
+			return nil, fmt.Errorf("backup %s: %w", ref.ID, err)
+		}
+		if desc == nil || d.StartedAt.After(desc.StartedAt) {
+			store, desc = s, &d
+		}
+	}
+	if desc == nil {
+		return nil, nil
+	}
+
+	w := newWALArchive(a.node, a.sourcer, store, desc, a.logger)
+	var manifest backup.WALArchive
+	if err := store.meta(ctx, WALArchiveFile, &manifest); err != nil {
+		nerr := backup.ErrNotFound{}
+		if !errors.As(err, &nerr) {
+			return nil, fmt.Errorf("backup %s: %w", desc.ID, err)
+		}
+		// the backup completed, but nothing has been archived yet
+		return w, nil
+	}
+	if manifest.Error != "" {
+		return nil, nil
+	}
+	w.restore(manifest)
+	return w, nil
+}
+
+// stop stops archiving once the logs written so far have been archived
+func (a *walArchiver) stop() {
+	a.Lock()
+	w := a.active
+	a.active = nil
+	a.Unlock()
+	if w != nil {
+		w.stop()
+	}
+}
+
+// walArchive archives the commit logs written after a single backup.
+//
+// Each run uploads the bytes which have been appended to the logs since the
+// previous run as a new segment, along with the metadata of the modified
+// shards. Logs which are deleted by the shards, because their content has been
+// flushed, are retained by them until they have been archived.
+type walArchive struct {
+	sourcer  Sourcer
+	store    nodeStore
+	logger   logrus.FieldLogger
+	manifest backup.WALArchive
+	// base contains the files of the backup keyed by class and path
+	base map[string]struct{}
+	// offsets contains the number of archived bytes of each log
+	offsets map[string]int64
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+func newWALArchive(node string, sourcer Sourcer, store nodeStore,
+	desc *backup.BackupDescriptor, logger logrus.FieldLogger,
+) *walArchive {
+	w := &walArchive{
+		sourcer: sourcer,
+		store:   store,
+		logger:  logger.WithField("action", "archive_wal").WithField("backup_id", desc.ID),
+		manifest: backup.WALArchive{
+			ID:         desc.ID,
+			Node:       node,
+			Classes:    desc.List(),
+			StartedAt:  desc.StartedAt,
+			ArchivedAt: desc.StartedAt,
+		},
+		base:    map[string]struct{}{},
+		offsets: map[string]int64{},
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	for _, class := range desc.Classes {
+		for _, shard := range class.Shards {
+			for _, f := range shard.Files {
+				w.base[segmentKey(class.Name, f)] = struct{}{}
+			}
+			for _, f := range shard.Segments {
+				w.base[segmentKey(class.Name, f.Path)] = struct{}{}
+			}
+		}
+	}
+	return w
+}
+
+// restore continues an archive whose manifest was written before a restart
+func (w *walArchive) restore(manifest backup.WALArchive) {
+	w.manifest = manifest
+	for _, seg := range manifest.Segments {
+		for _, r := range seg.Ranges {
+			key := segmentKey(r.Class, r.Path)
+			if !r.Snapshot && r.Offset+r.Size > w.offsets[key] {
+				w.offsets[key] = r.Offset + r.Size
+			}
+		}
+	}
+}
+
+// covers returns true if the logs of class are archived
+func (w *walArchive) covers(class string) bool {
+	for _, c := range w.manifest.Classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *walArchive) run(interval time.Duration) {
+	defer close(w.done)
+	defer func() {
+		err := w.sourcer.RetainWAL(context.Background(), w.manifest.Classes, nil)
+		if err != nil {
+			w.logger.Error(err)
+		}
+	}()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.quit:
+			w.tick()
+			return
+		case <-ticker.C:
+			if err := w.tick(); errors.Is(err, errBaseBackupDeleted) {
+				w.logger.Info("stop archiving commit logs: backup has been deleted")
+				return
+			}
+		}
+	}
+}
+
+func (w *walArchive) stop() {
+	close(w.quit)
+	<-w.done
+}
+
+// tick archives the logs and logs failures, which are retried next time
+func (w *walArchive) tick() error {
+	ctx, cancel := context.WithTimeout(context.Background(), metaTimeout)
+	defer cancel()
+	err := w.archive(ctx)
+	if err != nil && !errors.Is(err, errBaseBackupDeleted) {
+		w.logger.Error(err)
+	}
+	return err
+}
+
+// walSource is a range of bytes to archive along with its content source
+type walSource struct {
+	backup.WALRange
+	// path relative to the data path the range is read from
+	path     string
+	retained bool
+	// data is the content of a snapshot
+	data []byte
+}
+
+// archive uploads the bytes appended to the logs since the last call as a new
+// segment and updates the manifest. Retained logs are released afterwards.
+func (w *walArchive) archive(ctx context.Context) error {
+	var desc backup.BackupDescriptor
+	if err := w.store.meta(ctx, BackupFile, &desc); err != nil {
+		nerr := backup.ErrNotFound{}
+		if errors.As(err, &nerr) {
+			return errBaseBackupDeleted
+		}
+		return fmt.Errorf("find backup: %w", err)
+	}
+
+	// all mutations completed before now are contained in the listed logs
+	now := time.Now().UTC()
+	var (
+		sources []walSource
+		release = map[string][]backup.WALFile{} // released after upload
+	)
+	for _, class := range w.manifest.Classes {
+		if !w.sourcer.ClassExists(class) {
+			continue
+		}
+		shards, err := w.sourcer.WALFiles(ctx, class)
+		if err != nil {
+			return fmt.Errorf("class %s: list commit logs: %w", class, err)
+		}
+		var free []string // retained logs which don't need to be archived
+		for i := range shards {
+			n := len(sources)
+			for _, f := range shards[i].Logs {
+				offset := w.offsets[segmentKey(class, f.Path)]
+				switch {
+				case !w.archives(class, f.Path):
+					if f.Retained != "" {
+						free = append(free, f.Retained)
+					}
+				case f.Size > offset:
+					sources = append(sources, walSource{
+						WALRange: backup.WALRange{Class: class, Path: f.Path, Offset: offset, Size: f.Size - offset},
+						path:     f.Source(),
+						retained: f.Retained != "",
+					})
+					if f.Retained != "" {
+						release[class] = append(release[class], f)
+					}
+				case f.Retained != "":
+					free = append(free, f.Retained)
+					delete(w.offsets, segmentKey(class, f.Path))
+				}
+			}
+			if len(sources) > n {
+				sources = append(sources, walSnapshots(class, &shards[i].Meta)...)
+			}
+		}
+		if len(free) > 0 {
+			if err := w.sourcer.ReleaseWAL(ctx, class, free); err != nil {
+				return fmt.Errorf("class %s: %w", class, err)
+			}
+		}
+	}
+
+	if len(sources) > 0 {
+		seg, err := w.upload(ctx, now, sources)
+		if err != nil {
+			return fmt.Errorf("upload segment %d: %w", seg.Seq, err)
+		}
+		for _, r := range seg.Ranges {
+			if !r.Snapshot {
+				w.offsets[segmentKey(r.Class, r.Path)] = r.Offset + r.Size
+			}
+		}
+		w.manifest.Segments = append(w.manifest.Segments, seg)
+	}
+	w.manifest.ArchivedAt = now
+	if err := w.store.putMeta(ctx, WALArchiveFile, &w.manifest); err != nil {
+		return err
+	}
+
+	for class, files := range release {
+		paths := make([]string, len(files))
+		for i, f := range files {
+			paths[i] = f.Retained
+			delete(w.offsets, segmentKey(class, f.Path))
+		}
+		if err := w.sourcer.ReleaseWAL(ctx, class, paths); err != nil {
+			return fmt.Errorf("class %s: %w", class, err)
+		}
+	}
+	return nil
+}
+
+// upload writes sources to a new segment. Live logs which have been retained
+// in the meantime are skipped, they are archived from their new location
+// next time.
+func (w *walArchive) upload(ctx context.Context, now time.Time, sources []walSource,
+) (seg backup.WALSegment, err error) {
+	seg = backup.WALSegment{Seq: int64(len(w.manifest.Segments)) + 1, Time: now}
+	sourcePath := w.store.SourceDataPath()
+
+	// open all logs first to skip the ones which disappeared
+	files := make([]*os.File, len(sources))
+	defer func() {
+		for _, f := range files {
+			if f != nil {
+				f.Close()
+			}
+		}
+	}()
+	for i, src := range sources {
+		if src.Snapshot {
+			continue
+		}
+		f, err := os.Open(filepath.Join(sourcePath, src.path))
+		if err != nil {
+			if os.IsNotExist(err) && !src.retained {
+				continue
+			}
+			return seg, fmt.Errorf("open commit log: %w", err)
+		}
+		files[i] = f
+	}
+
+	zip, reader := NewZip(sourcePath, int(DefaultCompression))
+	var eg errgroup.Group
+	eg.Go(func() error {
+		_, err := w.store.Write(ctx, walSegmentKey(seg.Seq), reader)
+		return err
+	})
+	producer := func() error {
+		defer zip.Close()
+		for i, src := range sources {
+			var r io.Reader
+			switch {
+			case src.Snapshot:
+				r = bytes.NewReader(src.data)
+			case files[i] == nil:
+				continue
+			default:
+				if _, err := files[i].Seek(src.Offset, io.SeekStart); err != nil {
+					return fmt.Errorf("seek %s: %w", src.path, err)
+				}
+				r = io.LimitReader(files[i], src.Size)
+			}
+			info := vFileInfo{name: filepath.Base(src.Path), size: int(src.Size), modTime: now}
+			if _, err := zip.writeOne(info, src.Path, r); err != nil {
+				return err
+			}
+			seg.Ranges = append(seg.Ranges, src.WALRange)
+		}
+		return nil
+	}
+	err = producer()
+	if werr := eg.Wait(); err == nil {
+		err = werr
+	}
+	return seg, err
+}
+
+// archives returns true if the log at relPath has been created after the
+// backup started. Logs whose content is part of the backup are not archived.
+func (w *walArchive) archives(class, relPath string) bool {
+	dir, name := filepath.Split(relPath)
+	if strings.HasSuffix(name, ".wal") { // write-ahead log of an LSM bucket
+		ts, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, "segment-"), ".wal"), 10, 64)
+		if err != nil {
+			return false
+		}
+		if _, ok := w.base[segmentKey(class, dir+strings.TrimSuffix(name, ".wal")+".db")]; ok {
+			return false
+		}
+		return !time.Unix(0, ts).Before(w.manifest.StartedAt)
+	}
+
+	// commit log of a vector index, names have a resolution of seconds
+	ts, err := strconv.ParseInt(name, 10, 64)
+	if err != nil {
+		return false
+	}
+	for _, p := range [2]string{relPath, relPath + ".condensed"} {
+		if _, ok := w.base[segmentKey(class, p)]; ok {
+			return false
+		}
+	}
+	return ts >= w.manifest.StartedAt.Unix()
+}
+
+// walSnapshots returns the metadata files of a shard
+func walSnapshots(class string, sd *backup.ShardDescriptor) []walSource {
+	snapshot := func(path string, data []byte) walSource {
+		return walSource{
+			WALRange: backup.WALRange{Class: class, Path: path, Size: int64(len(data)), Snapshot: true},
+			data:     data,
+		}
+	}
+	return []walSource{
+		snapshot(sd.DocIDCounterPath, sd.DocIDCounter),
+		snapshot(sd.PropLengthTrackerPath, sd.PropLengthTracker),
+		snapshot(sd.ShardVersionPath, sd.Version),
+	}
+}
+
+// loadWAL sets the archived log segments which restore the classes at time t
+func loadWAL(ctx context.Context, classes []classRestore, t time.Time) error {
+	segments := map[string][]backup.WALSegment{} // by node store
+	for i := range classes {
+		for j := range classes[i].parts {
+			part := &classes[i].parts[j]
+			segs, ok := segments[part.store.BasePath]
+			if !ok {
+				var archive backup.WALArchive
+				if err := part.store.meta(ctx, WALArchiveFile, &archive); err != nil {
+					return fmt.Errorf("find commit log archive %q: %w", part.store.HomeDir(), err)
+				}
+				var err error
+				if segs, err = archive.Until(t); err != nil {
+					return err
+				}
+				segments[part.store.BasePath] = segs
+			}
+			part.wal = segs
+		}
+	}
+	return nil
+}
+
+// replayWAL writes the archived logs of class on top of the files restored
+// in classTempDir. The logs are replayed once the shards are loaded.
+func (fw *fileWriter) replayWAL(ctx context.Context, classTempDir, class string) error {
+	for i := range fw.wal {
+		seg := &fw.wal[i]
+		if !hasWALRange(seg, class) {
+			continue
+		}
+		if err := fw.writeWALSegment(ctx, classTempDir, class, seg); err != nil {
+			return fmt.Errorf("segment %d: %w", seg.Seq, err)
+		}
+	}
+	return nil
+}
+
+func (fw *fileWriter) writeWALSegment(ctx context.Context, classTempDir, class string,
+	seg *backup.WALSegment,
+) error {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		_, err := fw.backend.Read(ctx, walSegmentKey(seg.Seq), pw)
+		pw.CloseWithError(err)
+	}()
+	gz, err := gzip.NewReader(pr)
+	if err != nil {
+		return fmt.Errorf("gzip.NewReader: %w", err)
+	}
+	defer gz.Close()
+
+	// files are stored in the order of the ranges
+	r := tar.NewReader(gz)
+	for _, rng := range seg.Ranges {
+		header, err := r.Next()
+		if err != nil {
+			return fmt.Errorf("fetch %s: %w", rng.Path, err)
+		}
+		if header.Name != rng.Path {
+			return fmt.Errorf("unexpected file %s, want %s", header.Name, rng.Path)
+		}
+		if rng.Class != class || (fw.filter != nil && !fw.filter(rng.Path)) {
+			continue
+		}
+		target := filepath.Join(classTempDir, fw.target(rng.Path))
+		if err := writeWALRange(target, &rng, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeWALRange writes the range rng read from r to the file target.
+// Snapshots replace the file.
+func writeWALRange(target string, rng *backup.WALRange, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return fmt.Errorf("create folder %s: %w", filepath.Dir(target), err)
+	}
+	flags := os.O_CREATE | os.O_WRONLY
+	if rng.Snapshot {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(target, flags, 0o644)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", target, err)
+	}
+	defer f.Close()
+	if _, err := f.Seek(rng.Offset, io.SeekStart); err != nil {
+		return fmt.Errorf("seek %s: %w", target, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("write %s: %w", target, err)
+	}
+	return f.Close()
+}
+
+func hasWALRange(seg *backup.WALSegment, class string) bool {
+	for _, r := range seg.Ranges {
+		if r.Class == class {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestWALArchiveArchives(t *testing.T) {
+	var (
+		cls     = "Article"
+		start   = time.Unix(1000, 500)
+		flushed = fmt.Sprintf("article_s1_lsm/objects/segment-%d", start.UnixNano()+1)
+	)
+	logger, _ := test.NewNullLogger()
+	desc := &backup.BackupDescriptor{
+		ID:        "base",
+		StartedAt: start,
+		Classes: []backup.ClassDescriptor{{
+			Name: cls,
+			Shards: []*backup.ShardDescriptor{{
+				Name:     "s1",
+				Files:    []string{"article_s1.hnsw.commitlog.d/1000", "article_s1.hnsw.commitlog.d/1001.condensed"},
+				Segments: []backup.SegmentFile{{Path: flushed + ".db"}},
+			}},
+		}},
+	}
+	w := newWALArchive("N1", nil, nodeStore{}, desc, logger)
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{fmt.Sprintf("article_s1_lsm/objects/segment-%d.wal", start.UnixNano()-1), false},
+		{fmt.Sprintf("article_s1_lsm/objects/segment-%d.wal", start.UnixNano()), true},
+		{flushed + ".wal", false},
+		{"article_s1_lsm/objects/invalid.wal", false},
+		{"article_s1.hnsw.commitlog.d/999", false},
+		{"article_s1.hnsw.commitlog.d/1000", false},
+		{"article_s1.hnsw.commitlog.d/1001", false},
+		{"article_s1.hnsw.commitlog.d/1002", true},
+		{"article_s1_vectors_title.hnsw.commitlog.d/1000", true},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, w.archives(cls, test.path), test.path)
+	}
+}
+
+func TestWALArchive(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		cls       = "Article"
+		start     = time.Now().UTC().Add(-time.Hour)
+		sourceDir = t.TempDir()
+		basePath  = "base/N1"
+		wal       = fmt.Sprintf("article_s1_lsm/objects/segment-%d.wal", start.UnixNano()+1)
+		retained  = filepath.Join("article_s1.wal_archive", wal)
+		old       = filepath.Join("article_s1.wal_archive", "article_s1_lsm/objects/segment-1.wal")
+	)
+	logger, _ := test.NewNullLogger()
+	desc := &backup.BackupDescriptor{
+		ID:        "base",
+		StartedAt: start,
+		Status:    string(backup.Success),
+		Classes:   []backup.ClassDescriptor{{Name: cls}},
+	}
+	descBytes, err := json.Marshal(desc)
+	require.Nil(t, err)
+	meta := func(counter string) backup.ShardDescriptor {
+		return backup.ShardDescriptor{
+			DocIDCounterPath:      "article_s1.indexcount",
+			DocIDCounter:          []byte(counter),
+			PropLengthTrackerPath: "article_s1.proplengths",
+			PropLengthTracker:     []byte("{}"),
+			ShardVersionPath:      "article_s1.version",
+			Version:               []byte("1"),
+		}
+	}
+
+	backend := newFakeBackend()
+	backend.chunks = map[string][]byte{}
+	backend.On("SourceDataPath").Return(sourceDir)
+	backend.On("GetObject", mock.Anything, basePath, BackupFile).Return(descBytes, nil)
+	backend.On("Write", mock.Anything, basePath, mock.Anything, mock.Anything).Return(int64(0), nil)
+	backend.On("PutObject", mock.Anything, basePath, WALArchiveFile, mock.Anything).Return(nil)
+	sourcer := &fakeSourcer{}
+	sourcer.On("ClassExists", cls).Return(true)
+	store := nodeStore{objStore{b: backend, BasePath: basePath}}
+	w := newWALArchive("N1", sourcer, store, desc, logger)
+
+	t.Run("live log", func(t *testing.T) {
+		writeFile(t, sourceDir, wal, "abc")
+		sourcer.On("WALFiles", mock.Anything, cls).Return([]backup.ShardWAL{{
+			Meta: meta("1"),
+			Logs: []backup.WALFile{{Path: wal, Size: 3}},
+		}}, nil).Once()
+		require.Nil(t, w.archive(ctx))
+		require.Len(t, w.manifest.Segments, 1)
+		assert.Equal(t, []backup.WALRange{
+			{Class: cls, Path: wal, Offset: 0, Size: 3},
+			{Class: cls, Path: "article_s1.indexcount", Size: 1, Snapshot: true},
+			{Class: cls, Path: "article_s1.proplengths", Size: 2, Snapshot: true},
+			{Class: cls, Path: "article_s1.version", Size: 1, Snapshot: true},
+		}, w.manifest.Segments[0].Ranges)
+		assert.Equal(t, w.manifest.Segments[0].Time, w.manifest.ArchivedAt)
+	})
+
+	t.Run("retained log", func(t *testing.T) {
+		require.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(sourceDir, retained)), os.ModePerm))
+		require.Nil(t, os.Rename(filepath.Join(sourceDir, wal), filepath.Join(sourceDir, retained)))
+		f, err := os.OpenFile(filepath.Join(sourceDir, retained), os.O_APPEND|os.O_WRONLY, 0o644)
+		require.Nil(t, err)
+		_, err = f.WriteString("de")
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		sourcer.On("WALFiles", mock.Anything, cls).Return([]backup.ShardWAL{{
+			Meta: meta("2"),
+			Logs: []backup.WALFile{{Path: wal, Retained: retained, Size: 5}},
+		}}, nil).Once()
+		sourcer.On("ReleaseWAL", mock.Anything, cls, []string{retained}).Return(nil).Once()
+		require.Nil(t, w.archive(ctx))
+		require.Len(t, w.manifest.Segments, 2)
+		assert.Equal(t, backup.WALRange{Class: cls, Path: wal, Offset: 3, Size: 2}, w.manifest.Segments[1].Ranges[0])
+		assert.Empty(t, w.offsets)
+	})
+
+	t.Run("nothing new", func(t *testing.T) {
+		archivedAt := w.manifest.ArchivedAt
+		sourcer.On("WALFiles", mock.Anything, cls).Return([]backup.ShardWAL{{
+			Meta: meta("2"),
+			Logs: []backup.WALFile{{Path: "article_s1_lsm/objects/segment-1.wal", Retained: old, Size: 7}},
+		}}, nil).Once()
+		sourcer.On("ReleaseWAL", mock.Anything, cls, []string{old}).Return(nil).Once()
+		require.Nil(t, w.archive(ctx))
+		assert.Len(t, w.manifest.Segments, 2)
+		assert.True(t, w.manifest.ArchivedAt.After(archivedAt))
+	})
+	sourcer.AssertExpectations(t)
+
+	t.Run("replay", func(t *testing.T) {
+		for _, seg := range w.manifest.Segments {
+			key := walSegmentKey(seg.Seq)
+			backend.chunks[key] = backend.files[basePath+"/"+key]
+		}
+		backend.On("Read", mock.Anything, basePath, mock.Anything, mock.Anything).Return(int64(0), nil)
+		destDir := t.TempDir()
+		fw := newFileWriter(sourcer, store, "base", true).
+			WithRename(renameFiles(cls, "Paper")).
+			WithWAL(w.manifest.Segments)
+		require.Nil(t, fw.replayWAL(ctx, destDir, cls))
+
+		for path, want := range map[string]string{
+			"paper" + wal[len("article"):]: "abcde",
+			"paper_s1.indexcount":          "2",
+			"paper_s1.version":             "1",
+		} {
+			data, err := os.ReadFile(filepath.Join(destDir, path))
+			require.Nil(t, err)
+			assert.Equal(t, want, string(data), path)
+		}
+	})
+
+	t.Run("backup deleted", func(t *testing.T) {
+		backend := newFakeBackend()
+		backend.On("GetObject", mock.Anything, basePath, BackupFile).Return(nil, backup.ErrNotFound{})
+		w := newWALArchive("N1", sourcer, nodeStore{objStore{b: backend, BasePath: basePath}}, desc, logger)
+		assert.ErrorIs(t, w.archive(ctx), errBaseBackupDeleted)
+	})
+}
+
+func TestWALArchiverResume(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = "Article"
+		wal = "article_s1_lsm/objects/segment-1.wal"
+	)
+	logger, _ := test.NewNullLogger()
+	desc := &backup.BackupDescriptor{
+		ID:        "base",
+		StartedAt: time.Now().UTC().Add(-time.Hour),
+		Classes:   []backup.ClassDescriptor{{Name: cls}},
+	}
+	descBytes, err := json.Marshal(desc)
+	require.Nil(t, err)
+	manifest := backup.WALArchive{
+		ID:      "base",
+		Node:    "N1",
+		Classes: []string{cls},
+		Segments: []backup.WALSegment{
+			{Seq: 1, Ranges: []backup.WALRange{{Class: cls, Path: wal, Offset: 0, Size: 3}}},
+			{Seq: 2, Ranges: []backup.WALRange{
+				{Class: cls, Path: wal, Offset: 3, Size: 2},
+				{Class: cls, Path: "article_s1.indexcount", Size: 1, Snapshot: true},
+			}},
+		},
+	}
+	manifestBytes, err := json.Marshal(manifest)
+	require.Nil(t, err)
+
+	backend := newFakeBackend()
+	backend.On("GetObject", mock.Anything, "base/N1", BackupFile).Return(descBytes, nil)
+	backend.On("GetObject", mock.Anything, "base/N1", WALArchiveFile).Return(manifestBytes, nil)
+	backend.On("GetObject", mock.Anything, "deleted/N1", BackupFile).Return(nil, backup.ErrNotFound{})
+	backend.On("PutObject", mock.Anything, "base/N1", WALArchiveFile, mock.Anything).Return(nil)
+	sourcer := &fakeSourcer{}
+	sourcer.On("RetainedWAL").Return(map[string]backup.WALArchiveRef{
+		cls:   {Backend: "fake", ID: "base"},
+		"Old": {Backend: "fake", ID: "deleted"},
+	})
+	// logs retained for a deleted backup are released right away
+	sourcer.On("RetainWAL", mock.Anything, []string{"Old"}, (*backup.WALArchiveRef)(nil)).Return(nil).Once()
+	sourcer.On("ClassExists", cls).Return(false)
+	sourcer.On("RetainWAL", mock.Anything, []string{cls}, (*backup.WALArchiveRef)(nil)).Return(nil).Once()
+
+	a := newWALArchiver("N1", sourcer, logger)
+	a.resume(ctx, &fakeBackupBackendProvider{backend: backend})
+	require.NotNil(t, a.active)
+	assert.Equal(t, "base", a.active.manifest.ID)
+	assert.Len(t, a.active.manifest.Segments, 2)
+	assert.Equal(t, map[string]int64{segmentKey(cls, wal): 5}, a.active.offsets)
+
+	a.stop()
+	sourcer.AssertExpectations(t)
+}
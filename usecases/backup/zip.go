@@ -166,6 +166,10 @@ type unzip struct {
 	gzr        *gzip.Reader
 	r          *tar.Reader
 	pipeReader *io.PipeReader
+	// filter selects the files to extract, all files are extracted if nil
+	filter func(name string) bool
+	// rename changes the path of extracted files if not nil
+	rename func(name string) string
 }
 
 func NewUnzip(dst string) (unzip, io.WriteCloser) {
@@ -220,9 +224,16 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 		if header == nil {
 			continue
 		}
+		if u.filter != nil && header.Typeflag == tar.TypeReg && !u.filter(header.Name) {
+			continue
+		}
 
 		// target file
-		target := filepath.Join(u.destPath, header.Name)
+		name := header.Name
+		if u.rename != nil {
+			name = u.rename(name)
+		}
+		target := filepath.Join(u.destPath, name)
 		switch header.Typeflag {
 		case tar.TypeDir:
 			if err := os.MkdirAll(target, 0o755); err != nil {
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
@@ -132,7 +133,7 @@ type NeighborRef struct {
 }
 
 func (c *Classifier) Schedule(ctx context.Context, principal *models.Principal, params models.Classification) (*models.Classification, error) {
-	err := c.authorizer.Authorize(principal, "create", "classifications/*")
+	err := c.authorizer.Authorize(principal, "create", authorization.Classifications(authorization.All))
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +245,7 @@ func (c *Classifier) assignNewID(params *models.Classification) error {
 }
 
 func (c *Classifier) Get(ctx context.Context, principal *models.Principal, id strfmt.UUID) (*models.Classification, error) {
-	err := c.authorizer.Authorize(principal, "get", "classifications/*")
+	err := c.authorizer.Authorize(principal, "get", authorization.Classifications(id.String()))
 	if err != nil {
 		return nil, err
 	}
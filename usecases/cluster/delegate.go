@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/memberlist"
@@ -33,6 +34,8 @@ const (
 	_OpCodeDisk _OpCode = 1
 	// _ProtoTTL used to decide when to update the cache
 	_ProtoTTL = time.Second * 8
+	// _MetaDraining flag set in the node meta data of a node being drained
+	_MetaDraining byte = 1 << 0
 )
 
 // spaceMsg is used to notify other nodes about current disk usage
@@ -116,6 +119,9 @@ type delegate struct {
 
 	mutex    sync.Mutex
 	hostInfo NodeInfo
+
+	// draining is broadcast as part of the node meta data
+	draining atomic.Bool
 }
 
 func (d *delegate) setOwnSpace(x DiskUsage) {
@@ -153,7 +159,15 @@ func (d *delegate) init(diskSpace func(path string) (DiskUsage, error)) error {
 // when broadcasting an alive message. It's length is limited to
 // the given byte size. This metadata is available in the Node structure.
 func (d *delegate) NodeMeta(limit int) (meta []byte) {
-	return nil
+	if limit < 1 || !d.draining.Load() {
+		return nil
+	}
+	return []byte{_MetaDraining}
+}
+
+// isDraining returns whether the meta data of a node has the draining flag set
+func isDraining(meta []byte) bool {
+	return len(meta) > 0 && meta[0]&_MetaDraining != 0
 }
 
 // LocalState is used for a TCP Push/Pull. This is sent to
@@ -211,6 +211,19 @@ func TestDelegateSort(t *testing.T) {
 	}
 }
 
+func TestDelegateNodeMeta(t *testing.T) {
+	d := delegate{Name: "N0"}
+	assert.Nil(t, d.NodeMeta(512))
+	assert.False(t, isDraining(d.NodeMeta(512)))
+
+	d.draining.Store(true)
+	assert.True(t, isDraining(d.NodeMeta(512)))
+	assert.Nil(t, d.NodeMeta(0), "meta data must not exceed limit")
+
+	d.draining.Store(false)
+	assert.False(t, isDraining(d.NodeMeta(512)))
+}
+
 func TestDelegateCleanUp(t *testing.T) {
 	st := State{
 		delegate: delegate{
@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/memberlist"
 	"github.com/pkg/errors"
@@ -156,9 +157,38 @@ func (s *State) AllNames() []string {
 }
 
 // Candidates returns list of nodes (names) sorted by the
-// free amount of disk space in descending order
+// free amount of disk space in descending order.
+// Nodes being drained are not candidates.
 func (s *State) Candidates() []string {
-	return s.delegate.sortCandidates(s.AllNames())
+	mem := s.list.Members()
+	names := make([]string, 0, len(mem))
+	for _, m := range mem {
+		if !isDraining(m.Meta) {
+			names = append(names, m.Name)
+		}
+	}
+	return s.delegate.sortCandidates(names)
+}
+
+// SetDraining marks this node as being drained or not. A node being drained
+// is excluded from the candidates for new shard replicas. The flag is
+// broadcast to the other nodes as part of the node meta data.
+func (s *State) SetDraining(draining bool) error {
+	s.delegate.draining.Store(draining)
+	if err := s.list.UpdateNode(5 * time.Second); err != nil {
+		return errors.Wrap(err, "broadcast node meta data")
+	}
+	return nil
+}
+
+// Draining returns whether the given node is being drained
+func (s *State) Draining(nodeName string) bool {
+	for _, mem := range s.list.Members() {
+		if mem.Name == nodeName {
+			return isDraining(mem.Meta)
+		}
+	}
+	return false
 }
 
 // All node names (not their hostnames!) for live members, including self.
@@ -99,8 +99,12 @@ type Config struct {
 	RecountPropertiesAtStartup          bool                     `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
 	ReindexSetToRoaringsetAtStartup     bool                     `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
 	IndexMissingTextFilterableAtStartup bool                     `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	IndexSearchablePositionsAtStartup   bool                     `json:"index_searchable_positions_at_startup" yaml:"index_searchable_positions_at_startup"`
 	DisableGraphQL                      bool                     `json:"disable_graphql" yaml:"disable_graphql"`
 	AvoidMmap                           bool                     `json:"avoid_mmap" yaml:"avoid_mmap"`
+	AsyncIndexing                       bool                     `json:"async_indexing" yaml:"async_indexing"`
+	TenantOffloadBackend                string                   `json:"tenant_offload_backend" yaml:"tenant_offload_backend"`
+	MaximumActiveTenants                int                      `json:"maximum_active_tenants" yaml:"maximum_active_tenants"`
 }
 
 type moduleProvider interface {
@@ -50,6 +50,10 @@ func FromEnv(config *Config) error {
 		config.TrackVectorDimensions = true
 	}
 
+	if enabled(os.Getenv("ASYNC_INDEXING")) {
+		config.AsyncIndexing = true
+	}
+
 	if enabled(os.Getenv("REINDEX_VECTOR_DIMENSIONS_AT_STARTUP")) {
 		if config.TrackVectorDimensions {
 			config.ReindexVectorDimensionsAtStartup = true
@@ -69,6 +73,10 @@ func FromEnv(config *Config) error {
 		config.IndexMissingTextFilterableAtStartup = true
 	}
 
+	if enabled(os.Getenv("INDEX_SEARCHABLE_POSITIONS_AT_STARTUP")) {
+		config.IndexSearchablePositionsAtStartup = true
+	}
+
 	if v := os.Getenv("PROMETHEUS_MONITORING_PORT"); v != "" {
 		asInt, err := strconv.Atoi(v)
 		if err != nil {
@@ -138,6 +146,18 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.RBAC.Enabled = true
+
+		if usersString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ROOT_USERS"); ok {
+			config.Authorization.RBAC.RootUsers = strings.Split(usersString, ",")
+		}
+
+		if groupsString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ROOT_GROUPS"); ok {
+			config.Authorization.RBAC.RootGroups = strings.Split(groupsString, ",")
+		}
+	}
+
 	if os.Getenv("PERSISTENCE_LSM_ACCESS_STRATEGY") == "pread" {
 		config.AvoidMmap = true
 	}
@@ -237,6 +257,10 @@ func FromEnv(config *Config) error {
 		config.EnableModules = v
 	}
 
+	if v := os.Getenv("TENANT_OFFLOAD_BACKEND"); v != "" {
+		config.TenantOffloadBackend = v
+	}
+
 	config.AutoSchema.Enabled = true
 	if v := os.Getenv("AUTOSCHEMA_ENABLED"); v != "" {
 		config.AutoSchema.Enabled = !(strings.ToLower(v) == "false")
@@ -298,6 +322,15 @@ func FromEnv(config *Config) error {
 
 	config.DisableGraphQL = enabled(os.Getenv("DISABLE_GRAPHQL"))
 
+	// 0 means that the number of active tenants is not limited
+	if err := parsePositiveInt(
+		"MAXIMUM_ACTIVE_TENANTS",
+		func(val int) { config.MaximumActiveTenants = val },
+		0,
+	); err != nil {
+		return err
+	}
+
 	if err := parsePositiveInt(
 		"REPLICATION_MINIMUM_FACTOR",
 		func(val int) { config.Replication.MinimumFactor = val },
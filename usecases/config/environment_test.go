@@ -407,6 +407,32 @@ func TestEnvironmentDisableGraphQL(t *testing.T) {
 	}
 }
 
+func TestEnvironmentAsyncIndexing(t *testing.T) {
+	factors := []struct {
+		name     string
+		value    []string
+		expected bool
+	}{
+		{"Valid: true", []string{"true"}, true},
+		{"Valid: false", []string{"false"}, false},
+		{"Valid: 1", []string{"1"}, true},
+		{"Valid: 0", []string{"0"}, false},
+		{"not given", []string{}, false},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("ASYNC_INDEXING", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, conf.AsyncIndexing)
+		})
+	}
+}
+
 func TestEnvironmentPrometheusGroupClasses_OldName(t *testing.T) {
 	factors := []struct {
 		name        string
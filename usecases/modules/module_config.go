@@ -12,8 +12,11 @@
 package modules
 
 import (
+	"fmt"
+
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
 )
 
 type ClassBasedModuleConfig struct {
@@ -90,3 +93,42 @@ func (cbmc *ClassBasedModuleConfig) Property(propName string) map[string]interfa
 
 	return asMap
 }
+
+// classForTargetVector returns a copy of the class in which the vectorizer
+// and module config of the named vector take the place of the class-level
+// ones. This allows the modules to treat each named vector like a regular
+// class vector. Classes without named vectors are returned as is.
+func classForTargetVector(class *models.Class, targetVector string) (*models.Class, error) {
+	if !schema.NamedVectorsEnabled(class) {
+		return class, nil
+	}
+
+	cfg, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("class %s does not have a vector named %q",
+			class.Class, targetVector)
+	}
+
+	vectorizer, vectorizerCfg, err := schema.NamedVectorizer(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("vector config %s: %w", targetVector, err)
+	}
+
+	moduleConfig := map[string]interface{}{}
+	if classModuleConfig, ok := class.ModuleConfig.(map[string]interface{}); ok {
+		for name, value := range classModuleConfig {
+			moduleConfig[name] = value
+		}
+	}
+	if vectorizer != config.VectorizerModuleNone {
+		moduleConfig[vectorizer] = vectorizerCfg
+	}
+
+	out := *class
+	out.Vectorizer = vectorizer
+	out.ModuleConfig = moduleConfig
+	out.VectorIndexType = cfg.VectorIndexType
+	out.VectorIndexConfig = cfg.VectorIndexConfig
+	out.VectorConfig = nil
+	return &out, nil
+}
@@ -23,6 +23,11 @@ import (
 // SetClassDefaults sets the module-specific defaults for the class itself, but
 // also for each prop
 func (p *Provider) SetClassDefaults(class *models.Class) {
+	if schema.NamedVectorsEnabled(class) {
+		p.setNamedVectorsDefaults(class)
+		return
+	}
+
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return
@@ -41,11 +46,57 @@ func (p *Provider) SetClassDefaults(class *models.Class) {
 	p.setPerPropertyConfigDefaults(class, cfg, cc)
 }
 
+// setNamedVectorsDefaults sets the module-specific defaults for every named
+// vector of the class. The class-level defaults end up in the vectorizer
+// config of the named vector, the property defaults are shared by all named
+// vectors which use the same module.
+func (p *Provider) setNamedVectorsDefaults(class *models.Class) {
+	for name, vectorCfg := range class.VectorConfig {
+		vectorizer, _, err := schema.NamedVectorizer(vectorCfg)
+		if err != nil || vectorizer == "none" {
+			// invalid configs are rejected by the validation
+			continue
+		}
+
+		cc, ok := p.GetByName(vectorizer).(modulecapabilities.ClassConfigurator)
+		if !ok {
+			continue
+		}
+
+		virtual, err := classForTargetVector(class, name)
+		if err != nil {
+			continue
+		}
+
+		cfg := NewClassBasedModuleConfig(virtual, vectorizer, "")
+		p.setPerClassConfigDefaults(virtual, cfg, cc)
+		p.setPerPropertyConfigDefaults(virtual, cfg, cc)
+
+		vectorCfg.Vectorizer = map[string]interface{}{
+			vectorizer: virtual.ModuleConfig.(map[string]interface{})[vectorizer],
+		}
+		class.VectorConfig[name] = vectorCfg
+	}
+}
+
 // SetSinglePropertyDefaults can be used when a property is added later, e.g.
 // as part of merging in a ref prop after a class has already been created
 func (p *Provider) SetSinglePropertyDefaults(class *models.Class,
 	prop *models.Property,
 ) {
+	if schema.NamedVectorsEnabled(class) {
+		for name := range class.VectorConfig {
+			virtual, err := classForTargetVector(class, name)
+			if err != nil || virtual.Vectorizer == "none" {
+				continue
+			}
+			if cc, ok := p.GetByName(virtual.Vectorizer).(modulecapabilities.ClassConfigurator); ok {
+				p.setSinglePropertyConfigDefaults(virtual, prop, cc)
+			}
+		}
+		return
+	}
+
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return
@@ -99,7 +150,9 @@ func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
 	userSpecified := make(map[string]interface{})
 
 	if prop.ModuleConfig != nil {
-		userSpecified = prop.ModuleConfig.(map[string]interface{})[class.Vectorizer].(map[string]interface{})
+		if cfg, ok := prop.ModuleConfig.(map[string]interface{})[class.Vectorizer].(map[string]interface{}); ok {
+			userSpecified = cfg
+		}
 	}
 
 	for key, value := range modDefaults {
@@ -117,6 +170,23 @@ func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
 }
 
 func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error {
+	if schema.NamedVectorsEnabled(class) {
+		for name := range class.VectorConfig {
+			virtual, err := classForTargetVector(class, name)
+			if err != nil {
+				return err
+			}
+			if err := p.validateClass(ctx, virtual); err != nil {
+				return errors.Wrapf(err, "vector config %s", name)
+			}
+		}
+		return nil
+	}
+
+	return p.validateClass(ctx, class)
+}
+
+func (p *Provider) validateClass(ctx context.Context, class *models.Class) error {
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return nil
@@ -257,6 +257,14 @@ func (p *Provider) shouldIncludeClassArgument(class *models.Class, module string
 	moduleType modulecapabilities.ModuleType,
 ) bool {
 	if p.isVectorizerModule(moduleType) {
+		if schema.NamedVectorsEnabled(class) {
+			for _, cfg := range class.VectorConfig {
+				if vectorizer, _, err := schema.NamedVectorizer(cfg); err == nil && vectorizer == module {
+					return true
+				}
+			}
+			return false
+		}
 		return class.Vectorizer == module
 	}
 	if moduleConfig, ok := class.ModuleConfig.(map[string]interface{}); ok {
@@ -298,7 +306,8 @@ func (p *Provider) GetArguments(class *models.Class) map[string]*graphql.Argumen
 			if arg, ok := module.(modulecapabilities.GraphQLArguments); ok {
 				for name, argument := range arg.Arguments() {
 					if argument.GetArgumentsFunction != nil {
-						arguments[name] = argument.GetArgumentsFunction(class.Class)
+						arguments[name] = withTargetVectorsArgument(class,
+							argument.GetArgumentsFunction(class.Class))
 					}
 				}
 			}
@@ -315,7 +324,8 @@ func (p *Provider) AggregateArguments(class *models.Class) map[string]*graphql.A
 			if arg, ok := module.(modulecapabilities.GraphQLArguments); ok {
 				for name, argument := range arg.Arguments() {
 					if argument.AggregateArgumentsFunction != nil {
-						arguments[name] = argument.AggregateArgumentsFunction(class.Class)
+						arguments[name] = withTargetVectorsArgument(class,
+							argument.AggregateArgumentsFunction(class.Class))
 					}
 				}
 			}
@@ -324,6 +334,26 @@ func (p *Provider) AggregateArguments(class *models.Class) map[string]*graphql.A
 	return arguments
 }
 
+// withTargetVectorsArgument adds the targetVectors field to the search
+// argument of a class with named vectors, so that the search can be pointed
+// at one of them
+func withTargetVectorsArgument(class *models.Class,
+	argument *graphql.ArgumentConfig,
+) *graphql.ArgumentConfig {
+	if !schema.NamedVectorsEnabled(class) {
+		return argument
+	}
+
+	if inputObject, ok := argument.Type.(*graphql.InputObject); ok {
+		inputObject.AddFieldConfig("targetVectors", &graphql.InputObjectFieldConfig{
+			Description: "Target vectors",
+			Type:        graphql.NewList(graphql.String),
+		})
+	}
+
+	return argument
+}
+
 // ExploreArguments provides GraphQL Explore arguments
 func (p *Provider) ExploreArguments(schema *models.Schema) map[string]*graphql.ArgumentConfig {
 	arguments := map[string]*graphql.ArgumentConfig{}
@@ -606,12 +636,16 @@ func (p *Provider) RestApiAdditionalProperties(includeProp string, class *models
 // Get { Class() } for example
 func (p *Provider) VectorFromSearchParam(ctx context.Context,
 	className string, param string, params interface{},
-	findVectorFn modulecapabilities.FindVectorFn, tenant string,
+	findVectorFn modulecapabilities.FindVectorFn, tenant, targetVector string,
 ) ([]float32, error) {
 	class, err := p.getClass(className)
 	if err != nil {
 		return nil, err
 	}
+	class, err = classForTargetVector(class, targetVector)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, mod := range p.GetAll() {
 		if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
@@ -668,12 +702,16 @@ func (p *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 }
 
 func (p *Provider) VectorFromInput(ctx context.Context,
-	className string, input string,
+	className, input, targetVector string,
 ) ([]float32, error) {
 	class, err := p.getClass(className)
 	if err != nil {
 		return nil, err
 	}
+	class, err = classForTargetVector(class, targetVector)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, mod := range p.GetAll() {
 		if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
@@ -528,6 +528,18 @@ func (m *dummyBackupModuleWithAltNames) SourceDataPath() string {
 	return ""
 }
 
+func (m *dummyBackupModuleWithAltNames) ListBackups(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (m *dummyBackupModuleWithAltNames) DeleteBackup(ctx context.Context, backupID string) error {
+	return nil
+}
+
+func (m *dummyBackupModuleWithAltNames) DeleteObject(ctx context.Context, backupID, key string) error {
+	return nil
+}
+
 func (*dummyBackupModuleWithAltNames) IsExternal() bool {
 	return true
 }
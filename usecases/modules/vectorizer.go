@@ -21,6 +21,8 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -29,8 +31,8 @@ const (
 	errorVectorizerCapability = "module %q exists, but does not provide the " +
 		"Vectorizer or ReferenceVectorizer capability"
 
-	errorVectorIndexType = "vector index config (%T) is not of type HNSW, " +
-		"but objects manager is restricted to HNSW"
+	errorVectorIndexType = "vector index config (%T) is not of type HNSW, flat or dynamic, " +
+		"but objects manager is restricted to HNSW, flat and dynamic"
 
 	warningVectorIgnored = "This vector will be ignored. If you meant to index " +
 		"the vector, make sure to set vectorIndexConfig.skip to 'false'. If the previous " +
@@ -65,6 +67,16 @@ func (p *Provider) UsingRef2Vec(className string) bool {
 		return false
 	}
 
+	for _, vectorCfg := range class.VectorConfig {
+		vectorizer, _, err := schema.NamedVectorizer(vectorCfg)
+		if err != nil {
+			continue
+		}
+		if _, ok := p.GetByName(vectorizer).(modulecapabilities.ReferenceVectorizer); ok {
+			return true
+		}
+	}
+
 	cfg := class.ModuleConfig
 	if cfg == nil {
 		return false
@@ -84,13 +96,66 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
 	logger logrus.FieldLogger,
 ) error {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
-	if !ok {
+	if schema.NamedVectorsEnabled(class) {
+		return p.updateTargetVectors(ctx, object, class, objectDiff, findObjectFn, logger)
+	}
+
+	return p.updateVector(ctx, object, class, objectDiff, findObjectFn, logger)
+}
+
+// updateTargetVectors vectorizes every named vector of the object which has
+// a vectorizer configured and was not provided by the user
+func (p *Provider) updateTargetVectors(ctx context.Context, object *models.Object, class *models.Class,
+	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) error {
+	for _, targetVector := range schema.TargetVectors(class) {
+		virtual, err := classForTargetVector(class, targetVector)
+		if err != nil {
+			return err
+		}
+
+		var diff *moduletools.ObjectDiff
+		if objectDiff != nil {
+			diff = objectDiff.ForTargetVector(targetVector)
+		}
+
+		// the modules operate on object.Vector, so hand them a shallow copy
+		// which carries the named vector instead
+		target := *object
+		target.Vector = object.Vectors[targetVector]
+		target.Vectors = nil
+		if err := p.updateVector(ctx, &target, virtual, diff, findObjectFn, logger); err != nil {
+			return fmt.Errorf("vector %s: %w", targetVector, err)
+		}
+
+		if target.Vector != nil {
+			if object.Vectors == nil {
+				object.Vectors = models.Vectors{}
+			}
+			object.Vectors[targetVector] = target.Vector
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) updateVector(ctx context.Context, object *models.Object, class *models.Class,
+	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) error {
+	var skip bool
+	switch vectorIndexConfig := class.VectorIndexConfig.(type) {
+	case hnsw.UserConfig:
+		skip = vectorIndexConfig.Skip
+	case flat.UserConfig, dynamic.UserConfig:
+		// the flat and dynamic indexes have no option to skip vector indexing
+	default:
 		return fmt.Errorf(errorVectorIndexType, class.VectorIndexConfig)
 	}
 
 	if class.Vectorizer == config.VectorizerModuleNone {
-		if hnswConfig.Skip && len(object.Vector) > 0 {
+		if skip && len(object.Vector) > 0 {
 			logger.WithField("className", object.Class).
 				Warningf(warningSkipVectorProvided)
 		}
@@ -98,7 +163,7 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 		return nil
 	}
 
-	if hnswConfig.Skip {
+	if skip {
 		logger.WithField("className", object.Class).
 			WithField("vectorizer", class.Vectorizer).
 			Warningf(warningSkipVectorGenerated, class.Vectorizer)
@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// drainJob is a drain of a node started by this node
+type drainJob struct {
+	cancel context.CancelFunc
+	done   bool
+	err    error
+}
+
+// DrainNode starts moving all shard replicas off a node, so that the node
+// can be removed from the cluster safely. The node is marked as draining
+// first, which prevents new replicas from being placed on it.
+//
+// Replicas are moved class by class in the background. The node is drained
+// once it doesn't own any replicas anymore, see DrainStatus. Tenants can't be
+// moved, multi-tenant classes are skipped and listed in the status until
+// their tenants on the node are gone.
+func (m *Manager) DrainNode(ctx context.Context, principal *models.Principal,
+	nodeName string,
+) (*models.NodeDrainStatus, error) {
+	if err := m.authorizer.Authorize(principal, "update", authorization.Nodes); err != nil {
+		return nil, err
+	}
+	if err := m.validateNode(nodeName); err != nil {
+		return nil, err
+	}
+
+	m.drainLock.Lock()
+	defer m.drainLock.Unlock()
+	if job := m.drains[nodeName]; job != nil && !job.done && job.err == nil {
+		return m.drainStatus(nodeName, job), nil
+	}
+	others := 0
+	for _, node := range m.cluster.Candidates() {
+		if node != nodeName {
+			others++
+		}
+	}
+	if others == 0 {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("no node left to move the replicas of node %q to", nodeName))
+	}
+	if err := m.setDraining(ctx, nodeName, true); err != nil {
+		return nil, err
+	}
+
+	dctx, cancel := context.WithCancel(context.Background())
+	job := &drainJob{cancel: cancel}
+	m.drains[nodeName] = job
+	go func() {
+		err := m.drain(dctx, principal, nodeName)
+		m.drainLock.Lock()
+		defer m.drainLock.Unlock()
+		job.done, job.err = true, err
+		if err != nil && dctx.Err() == nil {
+			m.logger.WithField("action", "drain_node").WithField("node", nodeName).
+				WithError(err).Error("draining node failed")
+		}
+	}()
+	return m.drainStatus(nodeName, job), nil
+}
+
+// DrainStatus returns whether a node is being drained or has been drained
+func (m *Manager) DrainStatus(ctx context.Context, principal *models.Principal,
+	nodeName string,
+) (*models.NodeDrainStatus, error) {
+	if err := m.authorizer.Authorize(principal, "list", authorization.Nodes); err != nil {
+		return nil, err
+	}
+	if err := m.validateNode(nodeName); err != nil {
+		return nil, err
+	}
+
+	m.drainLock.Lock()
+	defer m.drainLock.Unlock()
+	return m.drainStatus(nodeName, m.drains[nodeName]), nil
+}
+
+// CancelDrain stops draining a node. Replicas which have been moved already
+// are not moved back. The node becomes a candidate for new replicas again.
+func (m *Manager) CancelDrain(ctx context.Context, principal *models.Principal,
+	nodeName string,
+) (*models.NodeDrainStatus, error) {
+	if err := m.authorizer.Authorize(principal, "update", authorization.Nodes); err != nil {
+		return nil, err
+	}
+	if err := m.validateNode(nodeName); err != nil {
+		return nil, err
+	}
+
+	m.drainLock.Lock()
+	defer m.drainLock.Unlock()
+	if job := m.drains[nodeName]; job != nil {
+		job.cancel()
+		delete(m.drains, nodeName)
+	}
+	if err := m.setDraining(ctx, nodeName, false); err != nil {
+		return nil, err
+	}
+	status := m.drainStatus(nodeName, nil)
+	// the flag might not have been gossiped back yet
+	status.Status = models.NodeDrainStatusStatusACTIVE
+	return status, nil
+}
+
+// drain moves the replicas of all classes without multi-tenancy off the node
+func (m *Manager) drain(ctx context.Context, principal *models.Principal, nodeName string) error {
+	for _, class := range m.schemaManager.GetSchemaSkipAuth().Objects.Classes {
+		state := m.schemaManager.CopyShardingState(class.Class)
+		if state == nil || state.ReplicaLoad()[nodeName] == 0 {
+			continue
+		}
+		if state.PartitioningEnabled {
+			m.logger.WithField("action", "drain_node").WithField("node", nodeName).
+				WithField("class", class.Class).
+				Warn("skipping class, moving tenants is not supported")
+			continue
+		}
+		moves, err := state.MoveReplicas([]string{nodeName}, m.cluster)
+		if err != nil {
+			return fmt.Errorf("class %q: %w", class.Class, err)
+		}
+		if err := m.schemaManager.MoveReplicas(ctx, principal, class.Class, moves); err != nil {
+			return fmt.Errorf("class %q: %w", class.Class, err)
+		}
+		m.logger.WithField("action", "drain_node").WithField("node", nodeName).
+			WithField("class", class.Class).
+			Infof("moved %d shard replicas to other nodes", len(moves))
+	}
+	return nil
+}
+
+// drainStatus derives the status of a node from its draining flag and the
+// replicas it still owns. job is the drain started by this node, if any.
+func (m *Manager) drainStatus(nodeName string, job *drainJob) *models.NodeDrainStatus {
+	replicas, tenantClasses := m.replicaCount(nodeName)
+	status := &models.NodeDrainStatus{Node: nodeName, Replicas: int64(replicas)}
+	if job != nil || m.cluster.Draining(nodeName) {
+		status.SkippedClasses = tenantClasses
+	}
+	switch {
+	case job != nil && job.err != nil:
+		status.Status = models.NodeDrainStatusStatusFAILED
+		status.Error = job.err.Error()
+	case job == nil && !m.cluster.Draining(nodeName):
+		status.Status = models.NodeDrainStatusStatusACTIVE
+	case replicas == 0 && (job == nil || job.done):
+		status.Status = models.NodeDrainStatusStatusDRAINED
+	default:
+		status.Status = models.NodeDrainStatusStatusDRAINING
+	}
+	return status
+}
+
+// replicaCount returns the number of shard replicas owned by a node and the
+// multi-tenant classes among them, which are not moved by a drain
+func (m *Manager) replicaCount(nodeName string) (n int, tenantClasses []string) {
+	for _, class := range m.schemaManager.GetSchemaSkipAuth().Objects.Classes {
+		state := m.schemaManager.CopyShardingState(class.Class)
+		if state == nil {
+			continue
+		}
+		load := state.ReplicaLoad()[nodeName]
+		n += load
+		if load > 0 && state.PartitioningEnabled {
+			tenantClasses = append(tenantClasses, class.Class)
+		}
+	}
+	sort.Strings(tenantClasses)
+	return n, tenantClasses
+}
+
+func (m *Manager) validateNode(nodeName string) error {
+	for _, node := range m.cluster.AllNames() {
+		if node == nodeName {
+			return nil
+		}
+	}
+	return enterrors.NewErrNotFound(fmt.Errorf("node %q is not part of the cluster", nodeName))
+}
+
+// setDraining sets the draining flag on the node, which then broadcasts it
+func (m *Manager) setDraining(ctx context.Context, nodeName string, draining bool) error {
+	if nodeName == m.cluster.LocalName() {
+		return m.cluster.SetDraining(draining)
+	}
+	host, ok := m.cluster.NodeHostname(nodeName)
+	if !ok {
+		return fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+	if err := m.client.SetDraining(ctx, host, draining); err != nil {
+		return fmt.Errorf("mark node %q as draining: %w", nodeName, err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestDrainNode(t *testing.T) {
+	ctx := context.Background()
+	states := func() map[string]*sharding.State {
+		return map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N1", "N2"}, "S2": {"N2", "N3"}}),
+			"B": newState(map[string][]string{"S1": {"N3"}}),
+		}
+	}
+	waitFor := func(t *testing.T, m *Manager, node, status string) *models.NodeDrainStatus {
+		var res *models.NodeDrainStatus
+		require.Eventually(t, func() bool {
+			var err error
+			res, err = m.DrainStatus(ctx, nil, node)
+			require.Nil(t, err)
+			return res.Status == status
+		}, time.Second, 5*time.Millisecond)
+		return res
+	}
+
+	t.Run("Remote", func(t *testing.T) {
+		m, sm, cl, c := newFakeManager(states(), "N1", "N2", "N3", "N4")
+		res, err := m.DrainNode(ctx, nil, "N2")
+		require.Nil(t, err)
+		assert.Equal(t, "N2", res.Node)
+		assert.Equal(t, []string{"host-N2"}, c.hosts)
+		assert.True(t, cl.Draining("N2"))
+
+		res = waitFor(t, m, "N2", models.NodeDrainStatusStatusDRAINED)
+		assert.Equal(t, int64(0), res.Replicas)
+		assert.Equal(t, []string{"N1", "N4"}, sm.states["A"].Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"N1", "N3"}, sm.states["A"].Physical["S2"].BelongsToNodes)
+		assert.Empty(t, sm.moved["B"], "classes without replicas on the node are skipped")
+	})
+
+	t.Run("Local", func(t *testing.T) {
+		m, _, cl, c := newFakeManager(states(), "N1", "N2", "N3")
+		_, err := m.DrainNode(ctx, nil, "N1")
+		require.Nil(t, err)
+		assert.Empty(t, c.hosts)
+		assert.True(t, cl.Draining("N1"))
+		waitFor(t, m, "N1", models.NodeDrainStatusStatusDRAINED)
+	})
+
+	t.Run("UnknownNode", func(t *testing.T) {
+		m, _, _, _ := newFakeManager(states(), "N1", "N2")
+		_, err := m.DrainNode(ctx, nil, "N9")
+		assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+	})
+
+	t.Run("NoNodeLeft", func(t *testing.T) {
+		m, _, _, _ := newFakeManager(states(), "N1")
+		_, err := m.DrainNode(ctx, nil, "N1")
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		m, sm, _, _ := newFakeManager(states(), "N1", "N2", "N3", "N4")
+		sm.moveErr = errors.New("move failed")
+		_, err := m.DrainNode(ctx, nil, "N2")
+		require.Nil(t, err)
+		res := waitFor(t, m, "N2", models.NodeDrainStatusStatusFAILED)
+		assert.Contains(t, res.Error, "move failed")
+		assert.Equal(t, int64(2), res.Replicas)
+	})
+
+	t.Run("MultiTenancy", func(t *testing.T) {
+		s := states()
+		s["A"].PartitioningEnabled = true
+		s["C"] = newState(map[string][]string{"S1": {"N2"}})
+		m, sm, _, _ := newFakeManager(s, "N1", "N2", "N3", "N4")
+		_, err := m.DrainNode(ctx, nil, "N2")
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			sm.Lock()
+			defer sm.Unlock()
+			return len(sm.moved["C"]) == 1
+		}, time.Second, 5*time.Millisecond, "classes without tenants are moved")
+		res, err := m.DrainStatus(ctx, nil, "N2")
+		require.Nil(t, err)
+		assert.Equal(t, models.NodeDrainStatusStatusDRAINING, res.Status)
+		assert.Equal(t, int64(2), res.Replicas)
+		assert.Equal(t, []string{"A"}, res.SkippedClasses)
+		assert.Empty(t, sm.moved["A"])
+
+		// the tenants are deleted
+		sm.Lock()
+		sm.states["A"] = newState(map[string][]string{"S1": {"N1"}})
+		sm.Unlock()
+		res = waitFor(t, m, "N2", models.NodeDrainStatusStatusDRAINED)
+		assert.Empty(t, res.SkippedClasses)
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		m, _, cl, _ := newFakeManager(states(), "N1", "N2", "N3", "N4")
+		res, err := m.DrainStatus(ctx, nil, "N2")
+		require.Nil(t, err)
+		assert.Equal(t, models.NodeDrainStatusStatusACTIVE, res.Status)
+		assert.Equal(t, int64(2), res.Replicas)
+
+		_, err = m.DrainNode(ctx, nil, "N2")
+		require.Nil(t, err)
+		res, err = m.CancelDrain(ctx, nil, "N2")
+		require.Nil(t, err)
+		assert.Equal(t, models.NodeDrainStatusStatusACTIVE, res.Status)
+		assert.False(t, cl.Draining("N2"))
+	})
+}
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeAuthorizer struct{}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type fakeSchemaManager struct {
+	sync.Mutex
+	states  map[string]*sharding.State
+	moveErr error
+	moved   map[string][]sharding.ReplicaMove
+}
+
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	f.Lock()
+	defer f.Unlock()
+	var classes []*models.Class
+	for name := range f.states {
+		classes = append(classes, &models.Class{Class: name})
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i].Class < classes[j].Class })
+	return schema.Schema{Objects: &models.Schema{Classes: classes}}
+}
+
+func (f *fakeSchemaManager) CopyShardingState(className string) *sharding.State {
+	f.Lock()
+	defer f.Unlock()
+	state, ok := f.states[className]
+	if !ok {
+		return nil
+	}
+	cp := state.DeepCopy()
+	return &cp
+}
+
+func (f *fakeSchemaManager) MoveReplicas(ctx context.Context, principal *models.Principal,
+	className string, moves []sharding.ReplicaMove,
+) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.moveErr != nil {
+		return f.moveErr
+	}
+	if f.moved == nil {
+		f.moved = map[string][]sharding.ReplicaMove{}
+	}
+	f.moved[className] = append(f.moved[className], moves...)
+	return f.states[className].ApplyMoves(moves)
+}
+
+type fakeCluster struct {
+	sync.Mutex
+	local    string
+	nodes    []string
+	info     map[string]cluster.NodeInfo
+	draining map[string]bool
+}
+
+func (f *fakeCluster) AllNames() []string { return f.nodes }
+
+func (f *fakeCluster) Candidates() []string {
+	f.Lock()
+	defer f.Unlock()
+	var names []string
+	for _, node := range f.nodes {
+		if !f.draining[node] {
+			names = append(names, node)
+		}
+	}
+	return names
+}
+
+func (f *fakeCluster) LocalName() string { return f.local }
+
+func (f *fakeCluster) NodeHostname(nodeName string) (string, bool) {
+	return "host-" + nodeName, true
+}
+
+func (f *fakeCluster) NodeInfo(nodeName string) (cluster.NodeInfo, bool) {
+	info, ok := f.info[nodeName]
+	return info, ok
+}
+
+func (f *fakeCluster) Draining(nodeName string) bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.draining[nodeName]
+}
+
+func (f *fakeCluster) SetDraining(draining bool) error {
+	f.setDraining(f.local, draining)
+	return nil
+}
+
+func (f *fakeCluster) setDraining(nodeName string, draining bool) {
+	f.Lock()
+	defer f.Unlock()
+	if f.draining == nil {
+		f.draining = map[string]bool{}
+	}
+	f.draining[nodeName] = draining
+}
+
+// fakeClient sets the draining flag of remote nodes in the shared cluster
+type fakeClient struct {
+	cluster *fakeCluster
+	hosts   []string
+}
+
+func (f *fakeClient) SetDraining(ctx context.Context, hostName string, draining bool) error {
+	f.hosts = append(f.hosts, hostName)
+	f.cluster.setDraining(hostName[len("host-"):], draining)
+	return nil
+}
+
+func newFakeManager(states map[string]*sharding.State, nodes ...string) (*Manager, *fakeSchemaManager, *fakeCluster, *fakeClient) {
+	logger, _ := test.NewNullLogger()
+	sm := &fakeSchemaManager{states: states}
+	cl := &fakeCluster{local: nodes[0], nodes: nodes}
+	c := &fakeClient{cluster: cl}
+	return NewManager(logger, &fakeAuthorizer{}, nil, sm, cl, c), sm, cl, c
+}
+
+// newState creates the sharding state of a class with a shard per node list
+func newState(shards map[string][]string) *sharding.State {
+	state := &sharding.State{Physical: map[string]sharding.Physical{}}
+	for name, nodes := range shards {
+		state.Physical[name] = sharding.Physical{Name: name, BelongsToNodes: nodes}
+	}
+	return state
+}
@@ -13,10 +13,14 @@ package nodes
 
 import (
 	"context"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/models"
-	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type authorizer interface {
@@ -27,23 +31,59 @@ type db interface {
 	GetNodeStatus(ctx context.Context, className string) ([]*models.NodeStatus, error)
 }
 
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	CopyShardingState(className string) *sharding.State
+	MoveReplicas(ctx context.Context, principal *models.Principal,
+		className string, moves []sharding.ReplicaMove) error
+}
+
+type clusterState interface {
+	AllNames() []string
+	Candidates() []string
+	LocalName() string
+	NodeHostname(nodeName string) (string, bool)
+	NodeInfo(nodeName string) (cluster.NodeInfo, bool)
+	Draining(nodeName string) bool
+	SetDraining(draining bool) error
+}
+
+// client marks remote nodes as being drained
+type client interface {
+	SetDraining(ctx context.Context, hostName string, draining bool) error
+}
+
 type Manager struct {
 	logger        logrus.FieldLogger
 	authorizer    authorizer
 	db            db
-	schemaManager *schemaUC.Manager
+	schemaManager schemaManager
+	cluster       clusterState
+	client        client
+
+	// drains are the nodes drained by this node
+	drainLock sync.Mutex
+	drains    map[string]*drainJob
 }
 
 func NewManager(logger logrus.FieldLogger, authorizer authorizer,
-	db db, schemaManager *schemaUC.Manager,
+	db db, schemaManager schemaManager, cluster clusterState, client client,
 ) *Manager {
-	return &Manager{logger, authorizer, db, schemaManager}
+	return &Manager{
+		logger:        logger,
+		authorizer:    authorizer,
+		db:            db,
+		schemaManager: schemaManager,
+		cluster:       cluster,
+		client:        client,
+		drains:        map[string]*drainJob{},
+	}
 }
 
 func (m *Manager) GetNodeStatus(ctx context.Context,
 	principal *models.Principal, className string,
 ) ([]*models.NodeStatus, error) {
-	if err := m.authorizer.Authorize(principal, "list", "nodes"); err != nil {
+	if err := m.authorizer.Authorize(principal, "list", authorization.Nodes); err != nil {
 		return nil, err
 	}
 	return m.db.GetNodeStatus(ctx, className)
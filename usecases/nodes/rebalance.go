@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Rebalance moves shard replicas between nodes, so that all nodes hold a
+// similar number of replicas and use a similar share of their disk. Nodes
+// being drained are left out. If dryRun is set, the moves are only planned.
+func (m *Manager) Rebalance(ctx context.Context, principal *models.Principal,
+	dryRun bool,
+) (*models.NodesRebalanceResponse, error) {
+	if err := m.authorizer.Authorize(principal, "update", authorization.Nodes); err != nil {
+		return nil, err
+	}
+
+	loads := map[string]*nodeLoad{}
+	for _, node := range m.cluster.Candidates() {
+		load := &nodeLoad{}
+		if info, ok := m.cluster.NodeInfo(node); ok && info.Total >= info.Available {
+			load.total, load.used = info.Total, info.Total-info.Available
+		}
+		loads[node] = load
+	}
+	states := map[string]*sharding.State{}
+	for _, class := range m.schemaManager.GetSchemaSkipAuth().Objects.Classes {
+		if state := m.schemaManager.CopyShardingState(class.Class); state != nil {
+			states[class.Class] = state
+		}
+	}
+
+	moves := planRebalance(states, loads)
+	res := &models.NodesRebalanceResponse{DryRun: dryRun, Moves: []*models.ReplicaMove{}}
+	for _, cm := range moves {
+		for _, move := range cm.moves {
+			res.Moves = append(res.Moves, &models.ReplicaMove{
+				Class: cm.class, Shard: move.Shard, From: move.From, To: move.To,
+			})
+		}
+	}
+	if dryRun {
+		return res, nil
+	}
+	for _, cm := range moves {
+		if err := m.schemaManager.MoveReplicas(ctx, principal, cm.class, cm.moves); err != nil {
+			return nil, fmt.Errorf("class %q: %w", cm.class, err)
+		}
+	}
+	return res, nil
+}
+
+// nodeLoad is the load of a node taken into account for rebalancing
+type nodeLoad struct {
+	replicas int    // number of shard replicas
+	used     uint64 // used disk space in bytes
+	total    uint64 // total disk space in bytes
+}
+
+// usage is the share of the disk space in use
+func (n *nodeLoad) usage() float64 {
+	if n.total == 0 {
+		return 0
+	}
+	return float64(n.used) / float64(n.total)
+}
+
+// classMoves are the replica moves of a single class
+type classMoves struct {
+	class string
+	moves []sharding.ReplicaMove
+}
+
+// planRebalance plans replica moves which even out the load of the nodes.
+//
+// The load of a node is its number of replicas plus its disk usage, each
+// relative to the average over all nodes. Since the sizes of shards are not
+// gossiped, every replica is assumed to take up the average space used per
+// replica. Disk usage is ignored unless it is known for all nodes. A replica
+// is moved from a node with a high load to a node with a
+// low load as long as this lowers the sum of the squared loads, which makes
+// sure that replicas are not moved back and forth. Replicas of multi-tenant
+// classes count towards the load but are not moved.
+func planRebalance(states map[string]*sharding.State, loads map[string]*nodeLoad) []classMoves {
+	if len(loads) < 2 {
+		return nil
+	}
+	classes := make([]string, 0, len(states))
+	total := 0
+	for class, state := range states {
+		classes = append(classes, class)
+		for node, n := range state.ReplicaLoad() {
+			if load, ok := loads[node]; ok {
+				load.replicas += n
+				total += n
+			}
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	sort.Strings(classes)
+	nodes := make([]string, 0, len(loads))
+	avgUsage, used, diskKnown := 0.0, uint64(0), true
+	for node, load := range loads {
+		nodes = append(nodes, node)
+		avgUsage += load.usage() / float64(len(loads))
+		used += load.used
+		diskKnown = diskKnown && load.total > 0
+	}
+	avgReplicas := float64(total) / float64(len(loads))
+	size := used / uint64(total)
+	score := func(l nodeLoad) float64 {
+		s := float64(l.replicas) / avgReplicas
+		if diskKnown && avgUsage > 0 {
+			s += l.usage() / avgUsage
+		}
+		return s
+	}
+
+	planned := map[string][]sharding.ReplicaMove{}
+	// there cannot be more useful moves than replicas
+	for i := 0; i < total; i++ {
+		sort.Slice(nodes, func(i, j int) bool {
+			a, b := score(*loads[nodes[i]]), score(*loads[nodes[j]])
+			if a != b {
+				return a > b
+			}
+			return nodes[i] < nodes[j]
+		})
+		if !planMove(states, classes, nodes, loads, size, score, planned) {
+			break
+		}
+	}
+
+	var moves []classMoves
+	for _, class := range classes {
+		if len(planned[class]) > 0 {
+			moves = append(moves, classMoves{class, planned[class]})
+		}
+	}
+	return moves
+}
+
+// planMove plans a single move of a replica of the given size from one of
+// the nodes (ordered by descending load) to a node with a lower load. It
+// returns false if no move lowers the sum of the squared loads.
+func planMove(states map[string]*sharding.State, classes, nodes []string,
+	loads map[string]*nodeLoad, size uint64, score func(nodeLoad) float64,
+	planned map[string][]sharding.ReplicaMove,
+) bool {
+	squared := func(a, b nodeLoad) float64 {
+		return score(a)*score(a) + score(b)*score(b)
+	}
+	for i, from := range nodes {
+		src := *loads[from]
+		srcAfter := nodeLoad{src.replicas - 1, src.used, src.total}
+		if srcAfter.used >= size {
+			srcAfter.used -= size
+		}
+		for j := len(nodes) - 1; j > i; j-- {
+			to := nodes[j]
+			dst := *loads[to]
+			dstAfter := nodeLoad{dst.replicas + 1, dst.used + size, dst.total}
+			if squared(srcAfter, dstAfter) >= squared(src, dst)-1e-9 {
+				continue
+			}
+			for _, class := range classes {
+				state := states[class]
+				if state.PartitioningEnabled {
+					continue
+				}
+				shard := movableShard(state, from, to)
+				if shard == "" {
+					continue
+				}
+				move := sharding.ReplicaMove{Shard: shard, From: from, To: to}
+				if err := state.ApplyMoves([]sharding.ReplicaMove{move}); err != nil {
+					continue
+				}
+				planned[class] = append(planned[class], move)
+				*loads[from], *loads[to] = srcAfter, dstAfter
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// movableShard returns the first shard which has a replica on node from but
+// not on node to
+func movableShard(state *sharding.State, from, to string) string {
+	names := make([]string, 0, len(state.Physical))
+	for name := range state.Physical {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		owners := state.Physical[name].BelongsToNodes
+		if containsNode(owners, from) && !containsNode(owners, to) {
+			return name
+		}
+	}
+	return ""
+}
+
+func containsNode(nodes []string, node string) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
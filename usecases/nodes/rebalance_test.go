@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestPlanRebalance(t *testing.T) {
+	loads := func(nodes ...string) map[string]*nodeLoad {
+		m := map[string]*nodeLoad{}
+		for _, node := range nodes {
+			m[node] = &nodeLoad{}
+		}
+		return m
+	}
+	// replicas returns the number of replicas per node after the moves
+	replicas := func(states map[string]*sharding.State) map[string]int {
+		count := map[string]int{}
+		for _, state := range states {
+			for node, n := range state.ReplicaLoad() {
+				count[node] += n
+			}
+		}
+		return count
+	}
+
+	t.Run("ShardCount", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N1"}, "S2": {"N1"}, "S3": {"N1"}}),
+			"B": newState(map[string][]string{"S1": {"N1", "N2"}, "S2": {"N1", "N2"}}),
+		}
+		moves := planRebalance(states, loads("N1", "N2", "N3"))
+		require.NotEmpty(t, moves)
+		assert.Equal(t, map[string]int{"N1": 3, "N2": 2, "N3": 2}, replicas(states))
+		for _, cm := range moves {
+			for _, m := range cm.moves {
+				assert.Equal(t, "N1", m.From)
+			}
+		}
+	})
+
+	t.Run("Balanced", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N1"}, "S2": {"N2"}, "S3": {"N1"}}),
+		}
+		assert.Empty(t, planRebalance(states, loads("N1", "N2")))
+	})
+
+	t.Run("DiskUsage", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N1"}, "S2": {"N1"}, "S3": {"N2"}, "S4": {"N2"}}),
+		}
+		l := map[string]*nodeLoad{
+			"N1": {used: 90, total: 100},
+			"N2": {used: 10, total: 100},
+		}
+		moves := planRebalance(states, l)
+		require.Len(t, moves, 1)
+		require.Len(t, moves[0].moves, 1)
+		assert.Equal(t, "N1", moves[0].moves[0].From, "moves off the fuller disk")
+		assert.Equal(t, "N2", moves[0].moves[0].To)
+	})
+
+	t.Run("MultiTenancy", func(t *testing.T) {
+		mt := newState(map[string][]string{"T1": {"N1"}, "T2": {"N1"}, "T3": {"N1"}})
+		mt.PartitioningEnabled = true
+		states := map[string]*sharding.State{
+			"MT": mt,
+			"A":  newState(map[string][]string{"S1": {"N2"}}),
+		}
+		assert.Empty(t, planRebalance(states, loads("N1", "N2")))
+	})
+
+	t.Run("DrainingNode", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N3"}, "S2": {"N3"}, "S3": {"N1"}}),
+		}
+		assert.Empty(t, planRebalance(states, loads("N1", "N2")), "moves only between candidates")
+	})
+}
+
+func TestRebalance(t *testing.T) {
+	ctx := context.Background()
+	states := func() map[string]*sharding.State {
+		return map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N1"}, "S2": {"N1"}}),
+		}
+	}
+
+	t.Run("DryRun", func(t *testing.T) {
+		m, sm, cl, _ := newFakeManager(states(), "N1", "N2")
+		// disk usage is ignored since it is unknown for N2
+		cl.info = map[string]cluster.NodeInfo{"N1": {DiskUsage: cluster.DiskUsage{Total: 100, Available: 50}}}
+		res, err := m.Rebalance(ctx, nil, true)
+		require.Nil(t, err)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, []*models.ReplicaMove{{Class: "A", Shard: "S1", From: "N1", To: "N2"}}, res.Moves)
+		assert.Empty(t, sm.moved)
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		m, sm, _, _ := newFakeManager(states(), "N1", "N2")
+		res, err := m.Rebalance(ctx, nil, false)
+		require.Nil(t, err)
+		assert.False(t, res.DryRun)
+		assert.Len(t, res.Moves, 1)
+		assert.Equal(t, []string{"N2"}, sm.states["A"].Physical["S1"].BelongsToNodes)
+	})
+
+	t.Run("DrainingNodesAreLeftOut", func(t *testing.T) {
+		m, _, cl, _ := newFakeManager(states(), "N1", "N2")
+		cl.setDraining("N2", true)
+		res, err := m.Rebalance(ctx, nil, true)
+		require.Nil(t, err)
+		assert.Empty(t, res.Moves)
+	})
+}
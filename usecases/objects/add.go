@@ -39,7 +39,7 @@ type schemaManager interface {
 func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, object *models.Object,
 	repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "create", "objects")
+	err := m.authorizer.Authorize(principal, "create", objectsResource(object))
 	if err != nil {
 		return nil, err
 	}
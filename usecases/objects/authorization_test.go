@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
 
@@ -44,43 +45,43 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "AddObject",
 			additionalArgs:   []interface{}{(*models.Object)(nil)},
 			expectedVerb:     "create",
-			expectedResource: "objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 		{
 			methodName:       "ValidateObject",
 			additionalArgs:   []interface{}{(*models.Object)(nil)},
 			expectedVerb:     "validate",
-			expectedResource: "objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 		{
 			methodName:       "GetObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo"), additional.Properties{}},
 			expectedVerb:     "get",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "DeleteObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/class/tenants/*/objects/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "DeleteObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "UpdateObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), (*models.Object)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/class/tenants/*/objects/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "UpdateObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo"), (*models.Object)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName: "MergeObject",
@@ -89,31 +90,31 @@ func Test_Kinds_Authorization(t *testing.T) {
 				(*additional.ReplicationProperties)(nil),
 			},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/class/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "GetObjectsClass",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
 			expectedVerb:     "get",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "GetObjectClassFromName",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
 			expectedVerb:     "get",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "HeadObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo")},
 			expectedVerb:     "head",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/class/tenants/*/objects/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "HeadObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo")},
 			expectedVerb:     "head",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 
 		// query objects
@@ -121,14 +122,22 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "Query",
 			additionalArgs:   []interface{}{new(QueryParams)},
 			expectedVerb:     "list",
-			expectedResource: "objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 
 		{ // list objects is deprecated by query
 			methodName:       "GetObjects",
 			additionalArgs:   []interface{}{(*int64)(nil), (*int64)(nil), (*string)(nil), (*string)(nil), additional.Properties{}},
 			expectedVerb:     "list",
-			expectedResource: "objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
+		},
+
+		// export objects
+		{
+			methodName:       "Export",
+			additionalArgs:   []interface{}{ExportParams{Class: "class"}, func(ExportPage) error { return nil }},
+			expectedVerb:     "list",
+			expectedResource: "data/collections/class/tenants/*/objects/*",
 		},
 
 		// reference on objects
@@ -136,19 +145,19 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "AddObjectReference",
 			additionalArgs:   []interface{}{AddReferenceInput{Class: "class", ID: strfmt.UUID("foo"), Property: "some prop"}, (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/class/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "DeleteObjectReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "data/collections/*/tenants/*/objects/foo",
 		},
 		{
 			methodName:       "UpdateObjectReferences",
 			additionalArgs:   []interface{}{&PutReferenceInput{Class: "class", ID: strfmt.UUID("foo"), Property: "some prop"}},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "data/collections/class/tenants/*/objects/foo",
 		},
 	}
 
@@ -209,35 +218,59 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 		{
 			methodName: "AddObjects",
 			additionalArgs: []interface{}{
-				[]*models.Object{},
+				[]*models.Object{{Class: "Article", Tenant: "tenant1"}},
 				[]*string{},
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "create",
-			expectedResource: "batch/objects",
+			expectedResource: "data/collections/Article/tenants/tenant1/objects/*",
 		},
 
 		{
 			methodName: "AddReferences",
 			additionalArgs: []interface{}{
-				[]*models.BatchReference{},
+				[]*models.BatchReference{{From: "weaviate://localhost/Article/8d5a3aa2-3c8d-4589-9ae1-3f638f506970/hasAuthors"}},
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "update",
-			expectedResource: "batch/*",
+			expectedResource: "data/collections/Article/tenants/*/objects/*",
 		},
 
 		{
 			methodName: "DeleteObjects",
 			additionalArgs: []interface{}{
-				&models.BatchDeleteMatch{},
+				&models.BatchDeleteMatch{Class: "Article"},
 				(*bool)(nil),
 				(*string)(nil),
 				&additional.ReplicationProperties{},
+				"tenant1",
+			},
+			expectedVerb:     "delete",
+			expectedResource: "data/collections/Article/tenants/tenant1/objects/*",
+		},
+
+		{
+			methodName: "DeleteObjectsFromGRPC",
+			additionalArgs: []interface{}{
+				BatchDeleteParams{},
+				&additional.ReplicationProperties{},
 				"",
 			},
 			expectedVerb:     "delete",
-			expectedResource: "batch/objects",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
+		},
+
+		{
+			methodName: "ImportStream",
+			additionalArgs: []interface{}{
+				&additional.ReplicationProperties{},
+				streamOf(&BatchStreamItem{Object: &models.Object{Class: "Article"}}),
+				// a forbidden batch is answered per item, ending the stream with
+				// the error of the item makes it the result of the call
+				func(res BatchStreamResult) error { return res.Err },
+			},
+			expectedVerb:     "create",
+			expectedResource: "data/collections/Article/tenants/*/objects/*",
 		},
 	}
 
@@ -261,6 +294,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			cfg := &config.WeaviateConfig{}
 			authorizer := &authDenier{}
 			vectorRepo := &fakeVectorRepo{}
+			vectorRepo.On("ResourceUsageExceeded").Return(nil)
 			modulesProvider := getFakeModulesProvider()
 			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil)
 
@@ -276,6 +310,18 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 	})
 }
 
+// streamOf returns a recv func of a stream which ends after the items
+func streamOf(items ...*BatchStreamItem) func() (*BatchStreamItem, error) {
+	return func() (*BatchStreamItem, error) {
+		if len(items) == 0 {
+			return nil, io.EOF
+		}
+		item := items[0]
+		items = items[1:]
+		return item, nil
+	}
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
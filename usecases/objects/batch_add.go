@@ -30,7 +30,7 @@ import (
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
-	err := b.authorizer.Authorize(principal, "create", "batch/objects")
+	err := b.authorizeBatch(principal, "create", objectsTargets(objects))
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +140,7 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	object.LastUpdateTimeUnix = 0
 	object.ID = id
 	object.Vector = concept.Vector
+	object.Vectors = concept.Vectors
 	object.Tenant = concept.Tenant
 
 	if _, ok := fieldsToKeep["class"]; ok {
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 const (
@@ -33,7 +34,11 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties, tenant string,
 ) (*BatchDeleteResponse, error) {
-	err := b.authorizer.Authorize(principal, "delete", "batch/objects")
+	var class string
+	if match != nil {
+		class = match.Class
+	}
+	err := b.authorizer.Authorize(principal, "delete", authorization.Objects(class, tenant, ""))
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +55,54 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 	return b.deleteObjects(ctx, principal, match, dryRun, output, repl, tenant)
 }
 
+// DeleteObjectsFromGRPC deletes objects in batch based on already parsed
+// filters. Contrary to DeleteObjects the gRPC API does not use the REST
+// representation of the where filter, so there is no match clause to parse.
+func (b *BatchManager) DeleteObjectsFromGRPC(ctx context.Context, principal *models.Principal,
+	params BatchDeleteParams, repl *additional.ReplicationProperties, tenant string,
+) (BatchDeleteResult, error) {
+	err := b.authorizer.Authorize(principal, "delete",
+		authorization.Objects(params.ClassName.String(), tenant, ""))
+	if err != nil {
+		return BatchDeleteResult{}, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return BatchDeleteResult{}, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	b.metrics.BatchDeleteInc()
+	defer b.metrics.BatchDeleteDec()
+
+	if params.Filters == nil {
+		return BatchDeleteResult{}, NewErrInvalidUserInput("validate: empty filters")
+	}
+
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return BatchDeleteResult{}, fmt.Errorf("failed to get schema: %w", err)
+	}
+	if s.FindClassByName(params.ClassName) == nil {
+		return BatchDeleteResult{}, NewErrInvalidUserInput("validate: class: %v doesn't exist",
+			params.ClassName)
+	}
+	if err := filters.ValidateFilters(s, params.Filters); err != nil {
+		return BatchDeleteResult{}, NewErrInvalidUserInput("validate: invalid filters: %v", err)
+	}
+	if err := b.authorizeFilterReferences(principal, s, params.Filters, tenant); err != nil {
+		return BatchDeleteResult{}, err
+	}
+
+	result, err := b.vectorRepo.BatchDeleteObjects(ctx, params, repl, tenant)
+	if err != nil {
+		return BatchDeleteResult{}, fmt.Errorf("batch delete objects: %w", err)
+	}
+
+	return result, nil
+}
+
 func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties, tenant string,
@@ -59,6 +112,14 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 		return nil, NewErrInvalidUserInput("validate: %v", err)
 	}
 
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+	if err := b.authorizeFilterReferences(principal, s, params.Filters, tenant); err != nil {
+		return nil, err
+	}
+
 	result, err := b.vectorRepo.BatchDeleteObjects(ctx, *params, repl, tenant)
 	if err != nil {
 		return nil, fmt.Errorf("batch delete objects: %w", err)
@@ -67,6 +128,26 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 	return b.toResponse(match, params.Output, result)
 }
 
+// authorizeFilterReferences makes sure the principal may read the classes a
+// filter reaches through cross-references, otherwise the matches would reveal
+// the contents of classes the principal has no access to
+func (b *BatchManager) authorizeFilterReferences(principal *models.Principal,
+	s schema.Schema, filter *filters.LocalFilter, tenant string,
+) error {
+	for _, className := range filter.Root.ReferencedClasses() {
+		refTenant := ""
+		if class := s.FindClassByName(className); class != nil && schema.MultiTenancyEnabled(class) {
+			refTenant = tenant
+		}
+		err := b.authorizer.Authorize(principal, "get",
+			authorization.Objects(className.String(), refTenant, ""))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *BatchManager) toResponse(match *models.BatchDeleteMatch, output string,
 	result BatchDeleteResult,
 ) (*BatchDeleteResponse, error) {
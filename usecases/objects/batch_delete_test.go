@@ -17,9 +17,12 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -175,6 +178,140 @@ func Test_BatchDelete_RequestValidation(t *testing.T) {
 			assert.Equal(t, test.expectedError, err.Error())
 		}
 	})
+
+	t.Run("with invalid gRPC input", func(t *testing.T) {
+		tests := []struct {
+			input         BatchDeleteParams
+			expectedError string
+		}{
+			{
+				input: BatchDeleteParams{
+					ClassName: "SomeClass",
+					Filters:   nameFilter("SomeClass", "name"),
+				},
+				expectedError: "validate: class: SomeClass doesn't exist",
+			},
+			{
+				input: BatchDeleteParams{
+					ClassName: "Foo",
+					Filters:   nameFilter("Foo", "some"),
+				},
+				expectedError: "validate: invalid filters: no such prop with name 'some' found in class 'Foo' " +
+					"in the schema. Check your schema files for which properties in this class are available",
+			},
+			{
+				input:         BatchDeleteParams{ClassName: "Foo"},
+				expectedError: "validate: empty filters",
+			},
+		}
+
+		for _, test := range tests {
+			_, err := manager.DeleteObjectsFromGRPC(ctx, nil, test.input, nil, "")
+			assert.Equal(t, test.expectedError, err.Error())
+		}
+	})
+
+	t.Run("with valid gRPC input", func(t *testing.T) {
+		reset()
+		params := BatchDeleteParams{
+			ClassName: "Foo",
+			Filters:   nameFilter("Foo", "name"),
+			DryRun:    true,
+			Output:    OutputVerbose,
+		}
+		expected := BatchDeleteResult{Matches: 1, DryRun: true, Objects: BatchSimpleObjects{{UUID: "foo"}}}
+		vectorRepo.On("BatchDeleteObjects", params).Return(expected, nil).Once()
+
+		res, err := manager.DeleteObjectsFromGRPC(ctx, nil, params, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		vectorRepo.AssertExpectations(t)
+	})
+}
+
+func Test_BatchDelete_FilterOnReferenceRequiresRead(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "A",
+				Properties: []*models.Property{
+					{Name: "toB", DataType: []string{"B"}},
+				},
+			},
+			{
+				Class: "B",
+				Properties: []*models.Property{
+					{
+						Name:         "name",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWhitespace,
+					},
+				},
+			},
+		},
+	}}
+
+	// a role which is limited to class A
+	role, resource := "a-admin", "data/collections/A/*"
+	policy := rbac.NewPolicy()
+	policy.Roles[role] = &models.Role{Name: &role, Permissions: []*models.Permission{
+		{Actions: []string{"*"}, Resource: &resource},
+	}}
+	policy.Users["alice"] = []string{role}
+	authorizer := rbac.New(rbac.Config{Enabled: true})
+	authorizer.SetPolicy(policy)
+	principal := &models.Principal{Username: "alice"}
+
+	logger, _ := test.NewNullLogger()
+	vectorRepo := &fakeVectorRepo{}
+	manager := NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{},
+		&fakeSchemaManager{GetSchemaResponse: sch}, &config.WeaviateConfig{}, logger, authorizer, nil)
+	ctx := context.Background()
+
+	t.Run("REST", func(t *testing.T) {
+		match := &models.BatchDeleteMatch{
+			Class: "A",
+			Where: &models.WhereFilter{
+				Path:      []string{"toB", "B", "name"},
+				Operator:  "Equal",
+				ValueText: ptString("value"),
+			},
+		}
+		_, err := manager.DeleteObjects(ctx, principal, match, ptBool(true), nil, nil, "")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "data/collections/B/")
+	})
+
+	t.Run("gRPC", func(t *testing.T) {
+		params := BatchDeleteParams{
+			ClassName: "A",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class: "A", Property: "toB",
+					Child: &filters.Path{Class: "B", Property: "name"},
+				},
+				Value: &filters.Value{Value: "value", Type: schema.DataTypeText},
+			}},
+			DryRun: true,
+		}
+		_, err := manager.DeleteObjectsFromGRPC(ctx, principal, params, nil, "")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "data/collections/B/")
+	})
+
+	vectorRepo.AssertNotCalled(t, "BatchDeleteObjects")
+}
+
+func nameFilter(className, propName string) *filters.LocalFilter {
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On: &filters.Path{
+			Class:    schema.ClassName(className),
+			Property: schema.PropertyName(propName),
+		},
+		Value: &filters.Value{Value: "value", Type: schema.DataTypeText},
+	}}
 }
 
 func ptBool(b bool) *bool {
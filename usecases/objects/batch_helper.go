@@ -11,7 +11,62 @@
 
 package objects
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type classTenant struct {
+	class, tenant string
+}
+
+// authorizeBatch authorizes the verb on the objects of every class and tenant
+// of a batch. A batch without any known class requires access to the objects
+// of all classes.
+func (b *BatchManager) authorizeBatch(principal *models.Principal, verb string,
+	targets map[classTenant]struct{},
+) error {
+	if len(targets) == 0 {
+		return b.authorizer.Authorize(principal, verb, authorization.Objects("", "", ""))
+	}
+	for target := range targets {
+		if err := b.authorizer.Authorize(principal, verb,
+			authorization.Objects(target.class, target.tenant, "")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func objectsTargets(objects []*models.Object) map[classTenant]struct{} {
+	targets := map[classTenant]struct{}{}
+	for _, obj := range objects {
+		if obj != nil && obj.Class != "" {
+			targets[classTenant{obj.Class, obj.Tenant}] = struct{}{}
+		}
+	}
+	return targets
+}
+
+// referencesTargets returns the source objects of the references, invalid
+// references are skipped as they fail validation later on
+func referencesTargets(refs []*models.BatchReference) map[classTenant]struct{} {
+	targets := map[classTenant]struct{}{}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		source, err := crossref.ParseSource(string(ref.From))
+		if err != nil || source.Class == "" {
+			continue
+		}
+		targets[classTenant{source.Class.String(), ref.Tenant}] = struct{}{}
+	}
+	return targets
+}
 
 // determine which field values not to return
 func determineResponseFields(fields []*string) map[string]struct{} {
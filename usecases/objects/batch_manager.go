@@ -37,6 +37,9 @@ type BatchManager struct {
 type BatchVectorRepo interface {
 	VectorRepo
 	batchRepoNew
+	// ResourceUsageExceeded returns an error if memory or disk usage is above
+	// the readonly thresholds
+	ResourceUsageExceeded() error
 }
 
 type batchRepoNew interface {
@@ -28,7 +28,7 @@ import (
 func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Principal,
 	refs []*models.BatchReference, repl *additional.ReplicationProperties,
 ) (BatchReferences, error) {
-	err := b.authorizer.Authorize(principal, "update", "batch/*")
+	err := b.authorizeBatch(principal, "update", referencesTargets(refs))
 	if err != nil {
 		return nil, err
 	}
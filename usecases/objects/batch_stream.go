@@ -0,0 +1,372 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BatchStreamSize is the number of objects or references of a streaming
+// import that are imported together
+const BatchStreamSize = 100
+
+const (
+	// batchStreamFlushInterval is how long received items wait at most before
+	// they are imported, even if the batch is not full
+	batchStreamFlushInterval = 100 * time.Millisecond
+	// batchStreamResourceCheckInterval limits how often the memory and disk
+	// usage are read
+	batchStreamResourceCheckInterval = time.Second
+	// batchStreamResourceTimeout is how long a streaming import waits for the
+	// memory and disk usage to drop below the readonly thresholds
+	batchStreamResourceTimeout = time.Minute
+)
+
+// BatchStreamItem is a single object or reference of a streaming import. Err
+// is set if the item could not be parsed, such items are answered with the
+// error without being imported.
+type BatchStreamItem struct {
+	Object    *models.Object
+	Reference *models.BatchReference
+	Err       error
+}
+
+// BatchStreamResult is the outcome of a single item of a streaming import
+type BatchStreamResult struct {
+	// Index is the position of the item in the stream
+	Index int
+	// UUID of the imported object, empty for references
+	UUID strfmt.UUID
+	Err  error
+}
+
+// ImportStream imports objects and references as they are received. recv is
+// called until it returns io.EOF. Every item is answered through send as soon
+// as its batch is imported, so results can arrive out of order.
+//
+// At most as many batches as MaxImportGoroutinesFactor allows are imported at
+// once, and no batch is started while memory or disk usage is above the
+// readonly thresholds. recv is not called while the import waits, so a fast
+// client is slowed down by the flow control of the transport.
+//
+// References are imported after all objects received before them, so they
+// can point to objects of the same stream. Every batch is authorized on its
+// own, as the classes and tenants of a stream are only known once received.
+//
+// A batch that fails as a whole is answered with its error for every item and
+// the import goes on. Only errors of recv and send end the import.
+func (b *BatchManager) ImportStream(ctx context.Context, principal *models.Principal,
+	repl *additional.ReplicationProperties, recv func() (*BatchStreamItem, error),
+	send func(BatchStreamResult) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s := &batchStream{
+		manager:   b,
+		principal: principal,
+		repl:      repl,
+		send:      send,
+		cancel:    cancel,
+		slots:     make(chan struct{}, b.maxImportBatches()),
+	}
+
+	items := make(chan indexedBatchStreamItem)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(items)
+		for i := 0; ; i++ {
+			item, err := recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					recvErr <- err
+				}
+				return
+			}
+
+			select {
+			case items <- indexedBatchStreamItem{BatchStreamItem: item, index: i}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(batchStreamFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case item, ok := <-items:
+			if !ok {
+				select {
+				case err := <-recvErr:
+					s.wait()
+					return err
+				default:
+				}
+
+				s.flushObjects(ctx)
+				s.flushReferences(ctx)
+				s.wait()
+				return s.error()
+			}
+			s.add(ctx, item)
+		case <-ticker.C:
+			s.flushObjects(ctx)
+			s.flushReferences(ctx)
+		case <-ctx.Done():
+			s.wait()
+			if err := s.error(); err != nil {
+				return err
+			}
+			return ctx.Err()
+		}
+	}
+}
+
+// maxImportBatches is the number of batches a streaming import imports at
+// once, it scales with the cores like the goroutines of the db
+func (b *BatchManager) maxImportBatches() int {
+	n := int(math.Round(b.config.Config.MaxImportGoroutinesFactor *
+		float64(runtime.GOMAXPROCS(0))))
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+type indexedBatchStreamItem struct {
+	*BatchStreamItem
+	index int
+}
+
+// batchStream groups the received items into batches. Everything but the
+// results is only accessed by the goroutine receiving the items.
+type batchStream struct {
+	manager   *BatchManager
+	principal *models.Principal
+	repl      *additional.ReplicationProperties
+	cancel    context.CancelFunc
+
+	slots             chan struct{}
+	objectsWg         sync.WaitGroup
+	referencesWg      sync.WaitGroup
+	objects           []indexedBatchStreamItem
+	references        []indexedBatchStreamItem
+	lastResourceCheck time.Time
+
+	// sendLock guards send and err, the first error of send stops the import
+	sendLock sync.Mutex
+	send     func(BatchStreamResult) error
+	err      error
+}
+
+func (s *batchStream) add(ctx context.Context, item indexedBatchStreamItem) {
+	switch {
+	case item.Err != nil:
+		s.reply(BatchStreamResult{Index: item.index, Err: item.Err})
+	case item.Object != nil:
+		s.objects = append(s.objects, item)
+		if len(s.objects) >= BatchStreamSize {
+			s.flushObjects(ctx)
+		}
+	case item.Reference != nil:
+		s.references = append(s.references, item)
+		if len(s.references) >= BatchStreamSize {
+			s.flushReferences(ctx)
+		}
+	default:
+		s.reply(BatchStreamResult{
+			Index: item.index,
+			Err:   fmt.Errorf("neither an object nor a reference"),
+		})
+	}
+}
+
+func (s *batchStream) flushObjects(ctx context.Context) {
+	if len(s.objects) == 0 {
+		return
+	}
+	batch := s.objects
+	s.objects = nil
+
+	if err := s.acquire(ctx); err != nil {
+		s.replyAll(batch, err)
+		return
+	}
+	s.objectsWg.Add(1)
+	go func() {
+		defer s.objectsWg.Done()
+		defer s.release()
+		s.importObjects(ctx, batch)
+	}()
+}
+
+func (s *batchStream) flushReferences(ctx context.Context) {
+	if len(s.references) == 0 {
+		return
+	}
+	batch := s.references
+	s.references = nil
+
+	// the references may point to objects that are still being imported
+	s.flushObjects(ctx)
+	s.objectsWg.Wait()
+
+	if err := s.acquire(ctx); err != nil {
+		s.replyAll(batch, err)
+		return
+	}
+	s.referencesWg.Add(1)
+	go func() {
+		defer s.referencesWg.Done()
+		defer s.release()
+		s.importReferences(ctx, batch)
+	}()
+}
+
+func (s *batchStream) importObjects(ctx context.Context, batch []indexedBatchStreamItem) {
+	objs := make([]*models.Object, len(batch))
+	for i, item := range batch {
+		objs[i] = item.Object
+	}
+
+	all := "ALL"
+	res, err := s.manager.AddObjects(ctx, s.principal, objs, []*string{&all}, s.repl)
+	if err != nil {
+		s.replyAll(batch, err)
+		return
+	}
+
+	for _, obj := range res {
+		s.reply(BatchStreamResult{
+			Index: batch[obj.OriginalIndex].index,
+			UUID:  obj.UUID,
+			Err:   obj.Err,
+		})
+	}
+}
+
+func (s *batchStream) importReferences(ctx context.Context, batch []indexedBatchStreamItem) {
+	refs := make([]*models.BatchReference, len(batch))
+	for i, item := range batch {
+		refs[i] = item.Reference
+	}
+
+	res, err := s.manager.AddReferences(ctx, s.principal, refs, s.repl)
+	if err != nil {
+		s.replyAll(batch, err)
+		return
+	}
+
+	for _, ref := range res {
+		s.reply(BatchStreamResult{
+			Index: batch[ref.OriginalIndex].index,
+			Err:   ref.Err,
+		})
+	}
+}
+
+// acquire blocks until the batch may be imported, it returns an error if the
+// resources don't become available in time or the import was stopped in the
+// meantime
+func (s *batchStream) acquire(ctx context.Context) error {
+	if err := s.waitForResources(ctx); err != nil {
+		return err
+	}
+
+	select {
+	case s.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *batchStream) release() {
+	<-s.slots
+}
+
+// waitForResources blocks while memory or disk usage is above the readonly
+// thresholds and gives up after batchStreamResourceTimeout
+func (s *batchStream) waitForResources(ctx context.Context) error {
+	if time.Since(s.lastResourceCheck) < batchStreamResourceCheckInterval {
+		return nil
+	}
+
+	deadline := time.Now().Add(batchStreamResourceTimeout)
+	for {
+		s.lastResourceCheck = time.Now()
+		err := s.manager.vectorRepo.ResourceUsageExceeded()
+		if err == nil {
+			return nil
+		}
+		if s.lastResourceCheck.After(deadline) {
+			return NewErrResourceExhausted(err)
+		}
+
+		s.manager.logger.WithField("action", "batch_stream_backoff").
+			WithError(err).
+			Debug("pausing streaming import")
+
+		select {
+		case <-time.After(batchStreamResourceCheckInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *batchStream) reply(res BatchStreamResult) {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+
+	if s.err != nil {
+		return
+	}
+	if err := s.send(res); err != nil {
+		s.err = err
+		s.cancel()
+	}
+}
+
+// replyAll answers every item of a batch that failed as a whole with the
+// error of the batch
+func (s *batchStream) replyAll(batch []indexedBatchStreamItem, err error) {
+	for _, item := range batch {
+		s.reply(BatchStreamResult{Index: item.index, Err: err})
+	}
+}
+
+func (s *batchStream) error() error {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+
+	return s.err
+}
+
+func (s *batchStream) wait() {
+	s.objectsWg.Wait()
+	s.referencesWg.Wait()
+}
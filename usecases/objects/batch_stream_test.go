@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_ImportStream(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+	)
+
+	const (
+		id1 = strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")
+		id2 = strfmt.UUID("9a4a3b1a-fd02-4d93-9e5c-4b7e3d0b1f6b")
+	)
+
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Foo",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{Name: "ref", DataType: []string{"Foo"}},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.MaxImportGoroutinesFactor = 1
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema}
+		logger, _ := test.NewNullLogger()
+		modulesProvider = getFakeModulesProvider()
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, cfg, logger, &fakeAuthorizer{}, nil)
+	}
+
+	// stream returns the recv and send functions of a streaming import of the
+	// given items and collects the results
+	stream := func(items ...*BatchStreamItem) (func() (*BatchStreamItem, error),
+		func(BatchStreamResult) error, func() []BatchStreamResult,
+	) {
+		var (
+			lock    sync.Mutex
+			results []BatchStreamResult
+		)
+		recv := func() (*BatchStreamItem, error) {
+			if len(items) == 0 {
+				return nil, io.EOF
+			}
+			item := items[0]
+			items = items[1:]
+			return item, nil
+		}
+		send := func(res BatchStreamResult) error {
+			lock.Lock()
+			defer lock.Unlock()
+			results = append(results, res)
+			return nil
+		}
+		sorted := func() []BatchStreamResult {
+			lock.Lock()
+			defer lock.Unlock()
+			sort.Slice(results, func(i, j int) bool {
+				return results[i].Index < results[j].Index
+			})
+			return results
+		}
+		return recv, send, sorted
+	}
+
+	object := func(id strfmt.UUID) *BatchStreamItem {
+		return &BatchStreamItem{Object: &models.Object{
+			Class:  "Foo",
+			ID:     id,
+			Vector: []float32{0.1, 0.2},
+		}}
+	}
+
+	t.Run("objects, references and parse errors", func(t *testing.T) {
+		reset()
+		var calls []string
+		vectorRepo.On("ResourceUsageExceeded").Return(nil)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).
+			Run(func(mock.Arguments) { calls = append(calls, "objects") })
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil).
+			Run(func(mock.Arguments) { calls = append(calls, "references") })
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		parseErr := errors.New("invalid uuid")
+		recv, send, results := stream(
+			object(id1),
+			&BatchStreamItem{Err: parseErr},
+			object(id2),
+			&BatchStreamItem{Reference: &models.BatchReference{
+				From: strfmt.URI("weaviate://localhost/Foo/" + id1 + "/ref"),
+				To:   strfmt.URI("weaviate://localhost/" + id2),
+			}},
+		)
+
+		err := manager.ImportStream(context.Background(), nil, nil, recv, send)
+		require.Nil(t, err)
+
+		assert.Equal(t, []BatchStreamResult{
+			{Index: 0, UUID: id1},
+			{Index: 1, Err: parseErr},
+			{Index: 2, UUID: id2},
+			{Index: 3},
+		}, results())
+		assert.Equal(t, []string{"objects", "references"}, calls,
+			"references are imported after the objects")
+	})
+
+	t.Run("invalid objects are reported without stopping the import", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ResourceUsageExceeded").Return(nil)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil)
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		recv, send, results := stream(
+			&BatchStreamItem{Object: &models.Object{Class: "Bar", ID: id1}},
+			object(id2),
+		)
+
+		err := manager.ImportStream(context.Background(), nil, nil, recv, send)
+		require.Nil(t, err)
+
+		res := results()
+		require.Len(t, res, 2)
+		assert.NotNil(t, res[0].Err)
+		assert.Equal(t, BatchStreamResult{Index: 1, UUID: id2}, res[1])
+	})
+
+	t.Run("a failed batch is reported for every item", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ResourceUsageExceeded").Return(nil)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(errors.New("disk full")).Once()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil)
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		// the first batch fails, the second one is imported
+		items := make([]*BatchStreamItem, BatchStreamSize+1)
+		for i := range items {
+			items[i] = object(strfmt.UUID(uuid.NewString()))
+		}
+		recv, send, results := stream(items...)
+
+		err := manager.ImportStream(context.Background(), nil, nil, recv, send)
+		require.Nil(t, err)
+
+		res := results()
+		require.Len(t, res, len(items))
+		for i := 0; i < BatchStreamSize; i++ {
+			assert.Equal(t, i, res[i].Index)
+			assert.IsType(t, ErrInternal{}, res[i].Err)
+		}
+		assert.Equal(t, BatchStreamResult{Index: BatchStreamSize, UUID: items[BatchStreamSize].Object.ID},
+			res[BatchStreamSize])
+	})
+
+	t.Run("a failed send stops the import", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ResourceUsageExceeded").Return(nil)
+
+		sendErr := errors.New("stream closed")
+		recv, _, _ := stream(&BatchStreamItem{Err: errors.New("invalid uuid")})
+
+		err := manager.ImportStream(context.Background(), nil, nil, recv,
+			func(BatchStreamResult) error { return sendErr })
+		assert.Equal(t, sendErr, err)
+	})
+
+	t.Run("a failed recv stops the import", func(t *testing.T) {
+		reset()
+
+		recvErr := errors.New("connection reset")
+		err := manager.ImportStream(context.Background(), nil, nil,
+			func() (*BatchStreamItem, error) { return nil, recvErr },
+			func(BatchStreamResult) error { return nil })
+		assert.Equal(t, recvErr, err)
+	})
+
+	t.Run("import waits for the resource usage to drop", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ResourceUsageExceeded").Return(errors.New("memory usage too high")).Once()
+		vectorRepo.On("ResourceUsageExceeded").Return(nil)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil)
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		recv, send, results := stream(object(id1))
+
+		err := manager.ImportStream(context.Background(), nil, nil, recv, send)
+		require.Nil(t, err)
+		assert.Equal(t, []BatchStreamResult{{Index: 0, UUID: id1}}, results())
+		vectorRepo.AssertNumberOfCalls(t, "ResourceUsageExceeded", 2)
+	})
+}
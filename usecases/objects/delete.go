@@ -18,6 +18,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // DeleteObject Class Instance from the connected DB
@@ -32,7 +33,7 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	if class == "" {
 		path = fmt.Sprintf("objects/%s", id)
 	}
-	err := m.authorizer.Authorize(principal, "delete", path)
+	err := m.authorizer.Authorize(principal, "delete", authorization.Objects(class, tenant, id))
 	if err != nil {
 		return err
 	}
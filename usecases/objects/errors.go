@@ -110,3 +110,18 @@ func (e ErrMultiTenancy) Error() string {
 func NewErrMultiTenancy(err error) ErrMultiTenancy {
 	return ErrMultiTenancy{err}
 }
+
+// ErrResourceExhausted indicates that the database is running out of memory
+// or disk
+type ErrResourceExhausted struct {
+	err error
+}
+
+func (e ErrResourceExhausted) Error() string {
+	return e.err.Error()
+}
+
+// NewErrResourceExhausted with error signature
+func NewErrResourceExhausted(err error) ErrResourceExhausted {
+	return ErrResourceExhausted{err}
+}
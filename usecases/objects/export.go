@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// DefaultExportPageSize is the number of objects read from a shard at once
+// if the export does not set a page size
+const DefaultExportPageSize = 100
+
+// ExportCheckpoint is the position of an export: the last object that was
+// exported and the shard it was read from. Shards are exported one after the
+// other, so everything before the checkpoint has already been exported.
+type ExportCheckpoint struct {
+	Shard string      `json:"shard"`
+	After strfmt.UUID `json:"after"`
+}
+
+// Token encodes the checkpoint, so that clients can treat it as opaque
+func (c ExportCheckpoint) Token() string {
+	asJSON, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(asJSON)
+}
+
+// ParseExportCheckpoint decodes a checkpoint token created by Token
+func ParseExportCheckpoint(token string) (*ExportCheckpoint, error) {
+	asJSON, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid checkpoint: %v", err)
+	}
+
+	var c ExportCheckpoint
+	if err := json.Unmarshal(asJSON, &c); err != nil {
+		return nil, NewErrInvalidUserInput("invalid checkpoint: %v", err)
+	}
+	if c.Shard == "" {
+		return nil, NewErrInvalidUserInput("invalid checkpoint: no shard")
+	}
+	if _, err := uuid.Parse(c.After.String()); err != nil {
+		return nil, NewErrInvalidUserInput("invalid checkpoint: %v", err)
+	}
+
+	return &c, nil
+}
+
+type ExportParams struct {
+	Class  string
+	Tenant string
+	// PageSize is the number of objects read from a shard at once,
+	// DefaultExportPageSize if not set
+	PageSize int
+	// Checkpoint resumes an earlier export after the checkpoint's object
+	Checkpoint *ExportCheckpoint
+}
+
+type ExportPage struct {
+	Objects    []*models.Object
+	Checkpoint ExportCheckpoint
+}
+
+// Export walks all objects of a class, or of a single tenant, shard by shard
+// and passes them page by page to fn. The next page is only read once fn
+// returned, so a slow consumer slows down the export instead of having pages
+// pile up in memory. Returning an error from fn stops the export.
+func (m *Manager) Export(ctx context.Context, principal *models.Principal,
+	params ExportParams, fn func(page ExportPage) error,
+) error {
+	path := authorization.Objects(params.Class, params.Tenant, "")
+	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
+		return err
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultExportPageSize
+	}
+	if pageSize < 0 {
+		return NewErrInvalidUserInput("page size must be a positive integer")
+	}
+	if max := m.config.Config.QueryMaximumResults; max > 0 && int64(pageSize) > max {
+		return NewErrInvalidUserInput("page size must not be larger than %d", max)
+	}
+
+	shards, err := m.vectorRepo.ExportShards(ctx, params.Class, params.Tenant)
+	if err != nil {
+		return err
+	}
+
+	var after strfmt.UUID
+	if c := params.Checkpoint; c != nil {
+		start := -1
+		for i, shard := range shards {
+			if shard == c.Shard {
+				start = i
+				break
+			}
+		}
+		if start < 0 {
+			return NewErrInvalidUserInput("invalid checkpoint: shard %q does not belong "+
+				"to class %q", c.Shard, params.Class)
+		}
+		shards = shards[start:]
+		after = c.After
+	}
+
+	for _, shard := range shards {
+		for {
+			objs, err := m.exportPage(ctx, params, shard, after, pageSize)
+			if err != nil {
+				return err
+			}
+			if len(objs) == 0 {
+				break
+			}
+
+			after = objs[len(objs)-1].ID
+			page := ExportPage{
+				Objects:    objs,
+				Checkpoint: ExportCheckpoint{Shard: shard, After: after},
+			}
+			if err := fn(page); err != nil {
+				return err
+			}
+
+			if len(objs) < pageSize {
+				break
+			}
+		}
+		after = ""
+	}
+
+	return nil
+}
+
+// exportPage only holds the lock while reading from the shard, a slow
+// consumer must not block schema changes
+func (m *Manager) exportPage(ctx context.Context, params ExportParams, shard string,
+	after strfmt.UUID, pageSize int,
+) ([]*models.Object, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	res, err := m.vectorRepo.ExportObjects(ctx, params.Class, shard, after,
+		pageSize, params.Tenant)
+	if err != nil {
+		return nil, fmt.Errorf("export shard %s: %w", shard, err)
+	}
+
+	return res.ObjectsWithVector(true), nil
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_Export(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	const (
+		id1 = strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")
+		id2 = strfmt.UUID("9a4a3b1a-fd02-4d93-9e5c-4b7e3d0b1f6b")
+		id3 = strfmt.UUID("c6b1a5b4-3fb0-4d2b-8e64-cf3c2a4b1e8c")
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.QueryMaximumResults = 200
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{}, cfg, logger,
+			&fakeAuthorizer{}, vectorRepo, getFakeModulesProvider(), &fakeMetrics{})
+	}
+
+	results := func(ids ...strfmt.UUID) []search.Result {
+		out := make([]search.Result, len(ids))
+		for i, id := range ids {
+			out[i] = search.Result{ClassName: "Foo", ID: id, Vector: []float32{1, 2}}
+		}
+		return out
+	}
+
+	collect := func(params ExportParams) ([]ExportPage, error) {
+		var pages []ExportPage
+		err := manager.Export(context.Background(), &models.Principal{}, params,
+			func(page ExportPage) error {
+				pages = append(pages, page)
+				return nil
+			})
+		return pages, err
+	}
+
+	t.Run("export pages through all shards", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ExportShards", "Foo", "").Return([]string{"shard1", "shard2"}, nil)
+		vectorRepo.On("ExportObjects", "Foo", "shard1", strfmt.UUID(""), 2).
+			Return(results(id1, id2), nil).Once()
+		vectorRepo.On("ExportObjects", "Foo", "shard1", id2, 2).
+			Return([]search.Result{}, nil).Once()
+		vectorRepo.On("ExportObjects", "Foo", "shard2", strfmt.UUID(""), 2).
+			Return(results(id3), nil).Once()
+
+		pages, err := collect(ExportParams{Class: "Foo", PageSize: 2})
+		require.Nil(t, err)
+		require.Len(t, pages, 2)
+
+		assert.Len(t, pages[0].Objects, 2)
+		assert.Equal(t, id1, pages[0].Objects[0].ID)
+		assert.Equal(t, []float32{1, 2}, []float32(pages[0].Objects[0].Vector))
+		assert.Equal(t, ExportCheckpoint{Shard: "shard1", After: id2}, pages[0].Checkpoint)
+
+		assert.Len(t, pages[1].Objects, 1)
+		assert.Equal(t, ExportCheckpoint{Shard: "shard2", After: id3}, pages[1].Checkpoint)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("resume from checkpoint", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ExportShards", "Foo", "").Return([]string{"shard1", "shard2"}, nil)
+		vectorRepo.On("ExportObjects", "Foo", "shard2", id1, DefaultExportPageSize).
+			Return(results(id3), nil).Once()
+
+		pages, err := collect(ExportParams{
+			Class:      "Foo",
+			Checkpoint: &ExportCheckpoint{Shard: "shard2", After: id1},
+		})
+		require.Nil(t, err)
+		require.Len(t, pages, 1)
+		assert.Equal(t, id3, pages[0].Objects[0].ID)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("checkpoint of unknown shard", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ExportShards", "Foo", "").Return([]string{"shard1"}, nil)
+
+		_, err := collect(ExportParams{
+			Class:      "Foo",
+			Checkpoint: &ExportCheckpoint{Shard: "shard2", After: id1},
+		})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("page size too large", func(t *testing.T) {
+		reset()
+		_, err := collect(ExportParams{Class: "Foo", PageSize: 201})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("consumer error stops the export", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ExportShards", "Foo", "").Return([]string{"shard1", "shard2"}, nil)
+		vectorRepo.On("ExportObjects", "Foo", "shard1", strfmt.UUID(""), 1).
+			Return(results(id1), nil).Once()
+
+		consumerErr := errors.New("stream closed")
+		err := manager.Export(context.Background(), &models.Principal{},
+			ExportParams{Class: "Foo", PageSize: 1},
+			func(page ExportPage) error { return consumerErr })
+		assert.Equal(t, consumerErr, err)
+		vectorRepo.AssertExpectations(t)
+	})
+}
+
+func Test_ExportCheckpoint(t *testing.T) {
+	c := ExportCheckpoint{Shard: "shard1", After: "8d5a3aa2-3c8d-4589-9ae1-3f638f506970"}
+
+	parsed, err := ParseExportCheckpoint(c.Token())
+	require.Nil(t, err)
+	assert.Equal(t, c, *parsed)
+
+	for _, token := range []string{
+		"not base64!",
+		ExportCheckpoint{Shard: "shard1", After: "foo"}.Token(),
+		ExportCheckpoint{After: c.After}.Token(),
+	} {
+		_, err := ParseExportCheckpoint(token)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	}
+}
@@ -230,6 +230,23 @@ func (f *fakeVectorRepo) AddReference(ctx context.Context, source *crossref.RefS
 	return args.Error(0)
 }
 
+func (f *fakeVectorRepo) ResourceUsageExceeded() error {
+	args := f.Called()
+	return args.Error(0)
+}
+
+func (f *fakeVectorRepo) ExportShards(ctx context.Context, class, tenant string) ([]string, error) {
+	args := f.Called(class, tenant)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ExportObjects(ctx context.Context, class, shard string,
+	after strfmt.UUID, limit int, tenant string,
+) (search.Results, error) {
+	args := f.Called(class, shard, after, limit)
+	return args.Get(0).([]search.Result), args.Error(1)
+}
+
 func (f *fakeVectorRepo) ReferenceVectorSearch(ctx context.Context,
 	obj *models.Object, refProps map[string]struct{},
 ) ([][]float32, error) {
@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -89,6 +90,14 @@ type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
+// objectsResource is the resource of a single object used for authorization
+func objectsResource(obj *models.Object) string {
+	if obj == nil {
+		return authorization.Objects("", "", "")
+	}
+	return authorization.Objects(obj.Class, obj.Tenant, obj.ID)
+}
+
 type VectorRepo interface {
 	PutObject(ctx context.Context, concept *models.Object, vector []float32,
 		repl *additional.ReplicationProperties) error
@@ -109,6 +118,13 @@ type VectorRepo interface {
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	// ExportShards returns the shards of a class, or of a single tenant, in
+	// the order they are exported in
+	ExportShards(ctx context.Context, class, tenant string) ([]string, error)
+	// ExportObjects returns up to limit objects of a shard, starting after
+	// the given id
+	ExportObjects(ctx context.Context, class, shard string, after strfmt.UUID,
+		limit int, tenant string) (search.Results, error)
 }
 
 type ModulesProvider interface {
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -31,6 +32,7 @@ type MergeDocument struct {
 	PrimitiveSchema      map[string]interface{}      `json:"primitiveSchema"`
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
+	Vectors              models.Vectors              `json:"vectors"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
@@ -43,7 +45,7 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		return &Error{"bad request", StatusBadRequest, err}
 	}
 	cls, id := updates.Class, updates.ID
-	path := fmt.Sprintf("objects/%s/%s", cls, id)
+	path := authorization.Objects(cls, updates.Tenant, id)
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
@@ -93,7 +95,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	cls, id := updates.Class, updates.ID
 	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
 	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
-		primitive, principal, obj.Vector, updates.Vector)
+		primitive, principal, obj.Vector, updates.Vector, obj.Vectors, updates.Vectors)
 	if err != nil {
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
@@ -103,6 +105,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		PrimitiveSchema:    primitive,
 		References:         refs,
 		Vector:             objWithVec.Vector,
+		Vectors:            objWithVec.Vectors,
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
 	}
@@ -134,21 +137,29 @@ func (m *Manager) validateInputs(updates *models.Object) error {
 func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className string,
 	old interface{}, new map[string]interface{},
 	principal *models.Principal, oldVec, newVec []float32,
+	oldVecs map[string][]float32, newVecs models.Vectors,
 ) (*models.Object, error) {
 	var merged map[string]interface{}
 	var vector []float32
+	var vectors models.Vectors
 	var objDiff *moduletools.ObjectDiff
 
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return nil, err
+	}
+
 	if old == nil {
 		merged = new
 		vector = newVec
+		vectors = newVecs
 	} else {
 		oldMap, ok := old.(map[string]interface{})
 		if !ok {
 			return nil, fmt.Errorf("expected previous schema to be map, but got %#v", old)
 		}
 
-		objDiff = moduletools.NewObjectDiff(oldVec)
+		objDiff = moduletools.NewObjectDiff(oldVec).WithTargetVectors(oldVecs)
 		for key, value := range new {
 			objDiff.WithProp(key, oldMap[key], value)
 			oldMap[key] = value
@@ -166,15 +177,13 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 				vector = oldVec
 			}
 		}
+
+		vectors = mergeTargetVectors(class, oldVecs, newVecs)
 	}
 
 	// Note: vector could be a nil vector in case a vectorizer is configured,
-	// then the vectorizer will set it
-	obj := &models.Object{Class: className, Properties: merged, Vector: vector}
-	class, err := m.schemaManager.GetClass(ctx, principal, className)
-	if err != nil {
-		return nil, err
-	}
+	// then the vectorizer will set it. The same applies to the named vectors.
+	obj := &models.Object{Class: className, Properties: merged, Vector: vector, Vectors: vectors}
 	if err := m.modulesProvider.UpdateVector(ctx, obj, class, objDiff, m.findObject, m.logger); err != nil {
 		return nil, err
 	}
@@ -182,6 +191,35 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	return obj, nil
 }
 
+// mergeTargetVectors keeps the previous named vectors which are not updated
+// and can't be recalculated, because no vectorizer is configured for them
+func mergeTargetVectors(class *models.Class, oldVecs map[string][]float32,
+	newVecs models.Vectors,
+) models.Vectors {
+	if !schema.NamedVectorsEnabled(class) {
+		return newVecs
+	}
+
+	out := models.Vectors{}
+	for name, vec := range newVecs {
+		out[name] = vec
+	}
+	for name, vec := range oldVecs {
+		if _, ok := out[name]; ok {
+			continue
+		}
+		vectorizer, _, err := schema.NamedVectorizer(class.VectorConfig[name])
+		if err == nil && vectorizer == config.VectorizerModuleNone {
+			out[name] = vec
+		}
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
 func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
 	sourceID strfmt.UUID,
 ) (map[string]interface{}, BatchReferences) {
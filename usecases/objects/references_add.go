@@ -14,13 +14,13 @@ package objects
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
@@ -48,7 +48,7 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 		}
 		input.Class = objectRes.Object().Class
 	}
-	path := fmt.Sprintf("objects/%s/%s", input.Class, input.ID)
+	path := authorization.Objects(input.Class, tenant, input.ID)
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
@@ -14,12 +14,12 @@ package objects
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // DeleteReferenceInput represents required inputs to delete a reference from an existing object.
@@ -69,7 +69,7 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	}
 	input.Class = res.ClassName
 
-	path := fmt.Sprintf("objects/%s/%s", input.Class, input.ID)
+	path := authorization.Objects(input.Class, tenant, input.ID)
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
@@ -60,7 +61,7 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	}
 	input.Class = res.ClassName
 
-	path := fmt.Sprintf("objects/%s/%s", input.Class, input.ID)
+	path := authorization.Objects(input.Class, tenant, input.ID)
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
@@ -172,6 +173,9 @@ func validateReferenceSchema(class, property string, sch schema.Schema) error {
 	if dt.IsPrimitive() {
 		return fmt.Errorf("property '%s' is a primitive datatype, not a reference-type", property)
 	}
+	if dt.IsNested() {
+		return fmt.Errorf("property '%s' is a nested datatype, not a reference-type", property)
+	}
 
 	return nil
 }
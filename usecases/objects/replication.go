@@ -27,6 +27,8 @@ type VObject struct {
 
 	Vector []float32 `json:"vector"`
 
+	Vectors map[string][]float32 `json:"vectors"`
+
 	// StaleUpdateTime is the LastUpdateTimeUnix of the stale object sent to the coordinator
 	StaleUpdateTime int64 `json:"updateTime,omitempty"`
 
@@ -43,6 +45,7 @@ type vobjectMarshaler struct {
 	StaleUpdateTime int64
 	Version         uint64
 	Vector          []float32
+	Vectors         map[string][]float32
 	LatestObject    []byte
 }
 
@@ -50,6 +53,7 @@ func (vo *VObject) MarshalBinary() ([]byte, error) {
 	b := vobjectMarshaler{
 		StaleUpdateTime: vo.StaleUpdateTime,
 		Vector:          vo.Vector,
+		Vectors:         vo.Vectors,
 		Version:         vo.Version,
 	}
 	if vo.LatestObject != nil {
@@ -72,6 +76,7 @@ func (vo *VObject) UnmarshalBinary(data []byte) error {
 	}
 	vo.StaleUpdateTime = b.StaleUpdateTime
 	vo.Vector = b.Vector
+	vo.Vectors = b.Vectors
 	vo.Version = b.Version
 
 	if b.LatestObject != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -84,9 +85,36 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return err
 	}
 
+	if err := validateVectors(class, incoming); err != nil {
+		return err
+	}
+
 	return v.properties(ctx, class, incoming, existing)
 }
 
+func validateVectors(class *models.Class, incoming *models.Object) error {
+	if !schema.NamedVectorsEnabled(class) {
+		if len(incoming.Vectors) > 0 {
+			return fmt.Errorf("class %s does not have named vectors configured, "+
+				"use 'vector' instead of 'vectors'", class.Class)
+		}
+		return nil
+	}
+
+	if len(incoming.Vector) > 0 {
+		return fmt.Errorf("class %s has named vectors configured, "+
+			"use 'vectors' instead of 'vector'", class.Class)
+	}
+
+	for name := range incoming.Vectors {
+		if _, ok := class.VectorConfig[name]; !ok {
+			return fmt.Errorf("class %s does not have a vector named %q", class.Class, name)
+		}
+	}
+
+	return nil
+}
+
 func validateClass(class string) error {
 	// If the given class is empty, return an error
 	if class == "" {
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func (v *Validator) extractAndValidateNestedProperty(propertyName string, pv interface{},
+	className string, dataType *schema.DataType, nestedProps []*models.NestedProperty,
+) (interface{}, error) {
+	var (
+		data interface{}
+		err  error
+	)
+
+	switch *dataType {
+	case schema.DataTypeObject:
+		data, err = objectVal(pv, nestedProps)
+		if err != nil {
+			return nil, fmt.Errorf("invalid object property '%s' on class '%s': %s", propertyName, className, err)
+		}
+	case schema.DataTypeObjectArray:
+		data, err = objectArrayVal(pv, nestedProps)
+		if err != nil {
+			return nil, fmt.Errorf("invalid object array property '%s' on class '%s': %s", propertyName, className, err)
+		}
+	default:
+		return nil, fmt.Errorf("unrecognized data type '%s'", *dataType)
+	}
+
+	return data, nil
+}
+
+// objectVal validates a single nested object against the nested properties
+// of its schema property. Values are validated recursively, keys without a
+// matching nested property are rejected and nil values are removed.
+func objectVal(val interface{}, nestedProps []*models.NestedProperty) (map[string]interface{}, error) {
+	typed, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not an object, but %T", val)
+	}
+
+	out := make(map[string]interface{}, len(typed))
+	for key, nestedValue := range typed {
+		if nestedValue == nil {
+			continue
+		}
+
+		nestedProp, err := schema.GetNestedPropertyByName(nestedProps, key)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := nestedPropertyVal(nestedValue, nestedProp)
+		if err != nil {
+			return nil, fmt.Errorf("nested property '%s': %w", key, err)
+		}
+		out[key] = data
+	}
+
+	return out, nil
+}
+
+func objectArrayVal(val interface{}, nestedProps []*models.NestedProperty) ([]interface{}, error) {
+	typed, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not an object array, but %T", val)
+	}
+
+	out := make([]interface{}, len(typed))
+	for i := range typed {
+		data, err := objectVal(typed[i], nestedProps)
+		if err != nil {
+			return nil, fmt.Errorf("invalid object array value at position %d: %w", i, err)
+		}
+		out[i] = data
+	}
+
+	return out, nil
+}
+
+func nestedPropertyVal(val interface{}, nestedProp *models.NestedProperty) (interface{}, error) {
+	dataType, ok := schema.AsNestedPropertyDataType(nestedProp.DataType)
+	if !ok {
+		return nil, fmt.Errorf("unrecognized data type '%v'", nestedProp.DataType)
+	}
+
+	switch dataType {
+	case schema.DataTypeText:
+		return stringVal(val)
+	case schema.DataTypeUUID:
+		asStr, err := stringVal(val)
+		if err != nil {
+			return nil, err
+		}
+		return uuid.Parse(asStr)
+	case schema.DataTypeInt:
+		return intVal(val)
+	case schema.DataTypeNumber:
+		return numberVal(val)
+	case schema.DataTypeBoolean:
+		return boolVal(val)
+	case schema.DataTypeDate:
+		return dateVal(val)
+	case schema.DataTypeBlob:
+		return blobVal(val)
+	case schema.DataTypeTextArray:
+		return stringArrayVal(val, "text")
+	case schema.DataTypeIntArray:
+		return intArrayVal(val)
+	case schema.DataTypeNumberArray:
+		return numberArrayVal(val)
+	case schema.DataTypeBooleanArray:
+		return boolArrayVal(val)
+	case schema.DataTypeDateArray:
+		return dateArrayVal(val)
+	case schema.DataTypeUUIDArray:
+		return ParseUUIDArray(val)
+	case schema.DataTypeObject:
+		return objectVal(val, nestedProp.NestedProperties)
+	case schema.DataTypeObjectArray:
+		return objectArrayVal(val, nestedProp.NestedProperties)
+	default:
+		return nil, fmt.Errorf("unrecognized data type '%s'", dataType)
+	}
+}
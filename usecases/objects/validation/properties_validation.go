@@ -115,9 +115,22 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 			}
 		}
 
-		data, err := v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
-		if err != nil {
-			return err
+		var data interface{}
+		if schema.IsNested(*dataType) {
+			prop, err := schema.GetPropertyByName(class, propertyKeyLowerCase)
+			if err != nil {
+				return err
+			}
+			data, err = v.extractAndValidateNestedProperty(propertyKeyLowerCase, propertyValue,
+				className, dataType, prop.NestedProperties)
+			if err != nil {
+				return err
+			}
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
+			if err != nil {
+				return err
+			}
 		}
 
 		returnSchema[propertyKeyLowerCase] = data
@@ -13,6 +13,7 @@ package validation
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -142,6 +143,117 @@ func TestValidator_extractAndValidateProperty(t *testing.T) {
 	}
 }
 
+func TestValidator_extractAndValidateNestedProperty(t *testing.T) {
+	nestedProps := []*models.NestedProperty{
+		{Name: "color", DataType: schema.DataTypeText.PropString()},
+		{Name: "sizes", DataType: schema.DataTypeTextArray.PropString()},
+		{Name: "stock", DataType: schema.DataTypeInt.PropString()},
+		{
+			Name:     "price",
+			DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "amount", DataType: schema.DataTypeNumber.PropString()},
+				{Name: "currency", DataType: schema.DataTypeText.PropString()},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		pv       interface{}
+		dataType schema.DataType
+		want     interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "valid object",
+			dataType: schema.DataTypeObject,
+			pv: map[string]interface{}{
+				"color": "red",
+				"sizes": []interface{}{"S", "M"},
+				"stock": json.Number("3"),
+				"price": map[string]interface{}{
+					"amount":   json.Number("17.5"),
+					"currency": "EUR",
+				},
+			},
+			want: map[string]interface{}{
+				"color": "red",
+				"sizes": []interface{}{"S", "M"},
+				"stock": int64(3),
+				"price": map[string]interface{}{
+					"amount":   17.5,
+					"currency": "EUR",
+				},
+			},
+		},
+		{
+			name:     "valid object with nil values",
+			dataType: schema.DataTypeObject,
+			pv:       map[string]interface{}{"color": "red", "stock": nil},
+			want:     map[string]interface{}{"color": "red"},
+		},
+		{
+			name:     "valid object array",
+			dataType: schema.DataTypeObjectArray,
+			pv: []interface{}{
+				map[string]interface{}{"color": "red"},
+				map[string]interface{}{"color": "blue"},
+			},
+			want: []interface{}{
+				map[string]interface{}{"color": "red"},
+				map[string]interface{}{"color": "blue"},
+			},
+		},
+		{
+			name:     "object is not a map",
+			dataType: schema.DataTypeObject,
+			pv:       "red",
+			wantErr:  true,
+		},
+		{
+			name:     "object array is not an array",
+			dataType: schema.DataTypeObjectArray,
+			pv:       map[string]interface{}{"color": "red"},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown nested property",
+			dataType: schema.DataTypeObject,
+			pv:       map[string]interface{}{"weight": "heavy"},
+			wantErr:  true,
+		},
+		{
+			name:     "wrong nested data type",
+			dataType: schema.DataTypeObject,
+			pv:       map[string]interface{}{"stock": "many"},
+			wantErr:  true,
+		},
+		{
+			name:     "wrong data type in deeper nested object",
+			dataType: schema.DataTypeObjectArray,
+			pv: []interface{}{
+				map[string]interface{}{"price": map[string]interface{}{"amount": "cheap"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &Validator{}
+			got, err := v.extractAndValidateNestedProperty("variant", tt.pv, "ProductClass",
+				getDataType(tt.dataType), nestedProps)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validator.extractAndValidateNestedProperty() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validator.extractAndValidateNestedProperty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func getDataType(dataType schema.DataType) *schema.DataType {
 	return &dataType
 }
@@ -0,0 +1,325 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package roles
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+// Repo stores the rbac policy. Save replaces the policy on all nodes of the
+// cluster and fails if it was changed concurrently.
+type Repo interface {
+	Load(ctx context.Context) (*rbac.Policy, error)
+	Save(ctx context.Context, policy *rbac.Policy) error
+	// RegisterUpdateCallback is called whenever the policy was changed by
+	// another node
+	RegisterUpdateCallback(func(policy *rbac.Policy))
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// Manager manages the roles and their assignments to users and groups
+type Manager struct {
+	// writeLock serializes changes made through this node. It is never held
+	// by setPolicy, which is called while the repo replicates a change.
+	writeLock sync.Mutex
+
+	sync.Mutex
+	repo       Repo
+	authorizer authorizer
+	enforcer   *rbac.Authorizer
+	policy     *rbac.Policy
+}
+
+// NewManager loads the stored policy and keeps the authorizer up to date
+// with any change of the policy in the cluster
+func NewManager(ctx context.Context, repo Repo, enforcer *rbac.Authorizer) (*Manager, error) {
+	policy, err := repo.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load rbac policy: %w", err)
+	}
+	if policy == nil {
+		policy = rbac.NewPolicy()
+	}
+
+	m := &Manager{repo: repo, authorizer: enforcer, enforcer: enforcer, policy: policy}
+	enforcer.SetPolicy(policy)
+	repo.RegisterUpdateCallback(m.setPolicy)
+	return m, nil
+}
+
+func (m *Manager) setPolicy(policy *rbac.Policy) {
+	m.Lock()
+	defer m.Unlock()
+	if policy.Version < m.policy.Version {
+		return
+	}
+	m.policy = policy
+	m.enforcer.SetPolicy(policy)
+}
+
+// GetRoles returns all built-in and custom roles
+func (m *Manager) GetRoles(ctx context.Context, principal *models.Principal) ([]*models.Role, error) {
+	if err := m.authorizer.Authorize(principal, "list", authorization.Roles(authorization.All)); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	return m.policy.AllRoles(), nil
+}
+
+// GetRole returns a single role
+func (m *Manager) GetRole(ctx context.Context, principal *models.Principal, name string) (*models.Role, error) {
+	if err := m.authorizer.Authorize(principal, "get", authorization.Roles(name)); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	role, ok := m.policy.Role(name)
+	if !ok {
+		return nil, NewErrNotFound(fmt.Errorf("role %q not found", name))
+	}
+	return role, nil
+}
+
+// CreateRole adds a new custom role
+func (m *Manager) CreateRole(ctx context.Context, principal *models.Principal, role *models.Role) error {
+	if role != nil && role.Name != nil {
+		if err := m.authorizer.Authorize(principal, "create", authorization.Roles(*role.Name)); err != nil {
+			return err
+		}
+	}
+	if err := rbac.ValidateRole(role); err != nil {
+		return NewErrUnprocessable(err)
+	}
+	if err := m.authorizeGrant(principal, role); err != nil {
+		return err
+	}
+
+	return m.update(ctx, func(p *rbac.Policy) error {
+		if _, ok := p.Roles[*role.Name]; ok {
+			return NewErrConflict(fmt.Errorf("role %q already exists", *role.Name))
+		}
+		p.Roles[*role.Name] = role
+		return nil
+	})
+}
+
+// UpdateRole replaces the permissions of an existing custom role
+func (m *Manager) UpdateRole(ctx context.Context, principal *models.Principal, name string, role *models.Role) error {
+	if err := m.authorizer.Authorize(principal, "update", authorization.Roles(name)); err != nil {
+		return err
+	}
+	if rbac.IsBuiltinRole(name) {
+		return NewErrUnprocessable(fmt.Errorf("role %q is a built-in role", name))
+	}
+	if role != nil && role.Name != nil && *role.Name != name {
+		return NewErrUnprocessable(fmt.Errorf("role name %q does not match %q", *role.Name, name))
+	}
+	if err := rbac.ValidateRole(role); err != nil {
+		return NewErrUnprocessable(err)
+	}
+	if err := m.authorizeGrant(principal, role); err != nil {
+		return err
+	}
+
+	return m.update(ctx, func(p *rbac.Policy) error {
+		if _, ok := p.Roles[name]; !ok {
+			return NewErrNotFound(fmt.Errorf("role %q not found", name))
+		}
+		p.Roles[name] = role
+		return nil
+	})
+}
+
+// DeleteRole deletes a custom role and removes it from all users and groups
+func (m *Manager) DeleteRole(ctx context.Context, principal *models.Principal, name string) error {
+	if err := m.authorizer.Authorize(principal, "delete", authorization.Roles(name)); err != nil {
+		return err
+	}
+	if rbac.IsBuiltinRole(name) {
+		return NewErrUnprocessable(fmt.Errorf("role %q is a built-in role", name))
+	}
+
+	return m.update(ctx, func(p *rbac.Policy) error {
+		if _, ok := p.Roles[name]; !ok {
+			return NewErrNotFound(fmt.Errorf("role %q not found", name))
+		}
+		p.DeleteRole(name)
+		return nil
+	})
+}
+
+// GetRolesForUser returns the roles assigned to a user
+func (m *Manager) GetRolesForUser(ctx context.Context, principal *models.Principal, user string) ([]*models.Role, error) {
+	if err := m.authorizer.Authorize(principal, "get", authorization.Users(user)); err != nil {
+		return nil, err
+	}
+	return m.assignedRoles(func(p *rbac.Policy) map[string][]string { return p.Users }, user), nil
+}
+
+// AssignRolesToUser assigns roles to a user
+func (m *Manager) AssignRolesToUser(ctx context.Context, principal *models.Principal, user string, roles []string) error {
+	if err := m.authorizer.Authorize(principal, "update", authorization.Users(user)); err != nil {
+		return err
+	}
+	return m.assign(ctx, principal, func(p *rbac.Policy) map[string][]string { return p.Users }, user, roles)
+}
+
+// RevokeRolesFromUser removes roles from a user
+func (m *Manager) RevokeRolesFromUser(ctx context.Context, principal *models.Principal, user string, roles []string) error {
+	if err := m.authorizer.Authorize(principal, "update", authorization.Users(user)); err != nil {
+		return err
+	}
+	return m.revoke(ctx, func(p *rbac.Policy) map[string][]string { return p.Users }, user, roles)
+}
+
+// GetRolesForGroup returns the roles assigned to a group
+func (m *Manager) GetRolesForGroup(ctx context.Context, principal *models.Principal, group string) ([]*models.Role, error) {
+	if err := m.authorizer.Authorize(principal, "get", authorization.Groups(group)); err != nil {
+		return nil, err
+	}
+	return m.assignedRoles(func(p *rbac.Policy) map[string][]string { return p.Groups }, group), nil
+}
+
+// AssignRolesToGroup assigns roles to a group
+func (m *Manager) AssignRolesToGroup(ctx context.Context, principal *models.Principal, group string, roles []string) error {
+	if err := m.authorizer.Authorize(principal, "update", authorization.Groups(group)); err != nil {
+		return err
+	}
+	return m.assign(ctx, principal, func(p *rbac.Policy) map[string][]string { return p.Groups }, group, roles)
+}
+
+// RevokeRolesFromGroup removes roles from a group
+func (m *Manager) RevokeRolesFromGroup(ctx context.Context, principal *models.Principal, group string, roles []string) error {
+	if err := m.authorizer.Authorize(principal, "update", authorization.Groups(group)); err != nil {
+		return err
+	}
+	return m.revoke(ctx, func(p *rbac.Policy) map[string][]string { return p.Groups }, group, roles)
+}
+
+type assignmentsFn func(p *rbac.Policy) map[string][]string
+
+func (m *Manager) assignedRoles(assignments assignmentsFn, subject string) []*models.Role {
+	m.Lock()
+	defer m.Unlock()
+
+	names := assignments(m.policy)[subject]
+	roles := make([]*models.Role, 0, len(names))
+	for _, name := range names {
+		if role, ok := m.policy.Role(name); ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func (m *Manager) assign(ctx context.Context, principal *models.Principal,
+	assignments assignmentsFn, subject string, roles []string,
+) error {
+	if subject == "" {
+		return NewErrUnprocessable(fmt.Errorf("user or group name is required"))
+	}
+	return m.update(ctx, func(p *rbac.Policy) error {
+		current := assignments(p)[subject]
+		for _, name := range roles {
+			role, ok := p.Role(name)
+			if !ok {
+				return NewErrNotFound(fmt.Errorf("role %q not found", name))
+			}
+			if err := m.authorizeGrant(principal, role); err != nil {
+				return err
+			}
+			if !contains(current, name) {
+				current = append(current, name)
+			}
+		}
+		assignments(p)[subject] = current
+		return nil
+	})
+}
+
+func (m *Manager) revoke(ctx context.Context, assignments assignmentsFn, subject string, roles []string) error {
+	return m.update(ctx, func(p *rbac.Policy) error {
+		current := assignments(p)[subject]
+		kept := current[:0]
+		for _, name := range current {
+			if !contains(roles, name) {
+				kept = append(kept, name)
+			}
+		}
+		if len(kept) == 0 {
+			delete(assignments(p), subject)
+		} else {
+			assignments(p)[subject] = kept
+		}
+		return nil
+	})
+}
+
+// authorizeGrant makes sure the principal holds every permission of a role it
+// creates, changes or assigns, so that nobody can grant more than they are
+// allowed themselves. The actions and resource patterns of the role are
+// authorized as they are: "*" is only granted by a permission with "*" at the
+// same place, e.g. a role with all actions on "data/*" requires all actions on
+// "data/*" or on "*".
+func (m *Manager) authorizeGrant(principal *models.Principal, role *models.Role) error {
+	for _, perm := range role.Permissions {
+		for _, action := range perm.Actions {
+			if err := m.authorizer.Authorize(principal, action, *perm.Resource); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// update applies a change to a copy of the current policy and replaces the
+// policy in the cluster
+func (m *Manager) update(ctx context.Context, change func(p *rbac.Policy) error) error {
+	m.writeLock.Lock()
+	defer m.writeLock.Unlock()
+
+	m.Lock()
+	policy := m.policy.DeepCopy()
+	m.Unlock()
+
+	if err := change(policy); err != nil {
+		return err
+	}
+	policy.Version++
+
+	if err := m.repo.Save(ctx, policy); err != nil {
+		return fmt.Errorf("save rbac policy: %w", err)
+	}
+	m.setPolicy(policy)
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, elem := range list {
+		if elem == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package roles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+type fakeRepo struct {
+	policy   *rbac.Policy
+	callback func(*rbac.Policy)
+}
+
+func (f *fakeRepo) Load(ctx context.Context) (*rbac.Policy, error) {
+	return f.policy, nil
+}
+
+func (f *fakeRepo) Save(ctx context.Context, policy *rbac.Policy) error {
+	f.policy = policy
+	return nil
+}
+
+func (f *fakeRepo) RegisterUpdateCallback(callback func(*rbac.Policy)) {
+	f.callback = callback
+}
+
+func newTestRole(name, action, resource string) *models.Role {
+	return &models.Role{
+		Name: &name,
+		Permissions: []*models.Permission{{
+			Actions:  []string{action},
+			Resource: &resource,
+		}},
+	}
+}
+
+func newTestManager(t *testing.T) (*Manager, *fakeRepo, *rbac.Authorizer) {
+	repo := &fakeRepo{}
+	authorizer := rbac.New(rbac.Config{Enabled: true, RootUsers: []string{"root"}})
+	m, err := NewManager(context.Background(), repo, authorizer)
+	require.Nil(t, err)
+	return m, repo, authorizer
+}
+
+func Test_Manager(t *testing.T) {
+	ctx := context.Background()
+	root := &models.Principal{Username: "root"}
+	alice := &models.Principal{Username: "alice"}
+	resource := "data/collections/Article/tenants/*/objects/*"
+
+	t.Run("roles grant access once assigned", func(t *testing.T) {
+		m, repo, authorizer := newTestManager(t)
+
+		require.Nil(t, m.CreateRole(ctx, root, newTestRole("reader", "get", "data/collections/Article/*")))
+		assert.NotNil(t, authorizer.Authorize(alice, "get", resource))
+
+		require.Nil(t, m.AssignRolesToUser(ctx, root, "alice", []string{"reader"}))
+		assert.Nil(t, authorizer.Authorize(alice, "get", resource))
+		assert.Equal(t, uint64(2), repo.policy.Version)
+
+		roles, err := m.GetRolesForUser(ctx, root, "alice")
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		assert.Equal(t, "reader", *roles[0].Name)
+
+		require.Nil(t, m.RevokeRolesFromUser(ctx, root, "alice", []string{"reader"}))
+		assert.NotNil(t, authorizer.Authorize(alice, "get", resource))
+	})
+
+	t.Run("groups", func(t *testing.T) {
+		m, _, authorizer := newTestManager(t)
+		member := &models.Principal{Username: "svc", Groups: []string{"services"}}
+
+		require.Nil(t, m.AssignRolesToGroup(ctx, root, "services", []string{rbac.RoleViewer}))
+		assert.Nil(t, authorizer.Authorize(member, "get", resource))
+
+		roles, err := m.GetRolesForGroup(ctx, root, "services")
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		assert.Equal(t, rbac.RoleViewer, *roles[0].Name)
+	})
+
+	t.Run("deleting a role revokes it", func(t *testing.T) {
+		m, _, authorizer := newTestManager(t)
+
+		require.Nil(t, m.CreateRole(ctx, root, newTestRole("reader", "get", "*")))
+		require.Nil(t, m.AssignRolesToUser(ctx, root, "alice", []string{"reader"}))
+		require.Nil(t, m.DeleteRole(ctx, root, "reader"))
+
+		assert.NotNil(t, authorizer.Authorize(alice, "get", resource))
+		_, err := m.GetRole(ctx, root, "reader")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		m, _, _ := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, root, newTestRole("reader", "get", "*")))
+
+		err := m.CreateRole(ctx, root, newTestRole("reader", "get", "*"))
+		assert.IsType(t, ErrConflict{}, err)
+
+		err = m.CreateRole(ctx, root, newTestRole("writer", "write", "*"))
+		assert.IsType(t, ErrUnprocessable{}, err)
+
+		err = m.UpdateRole(ctx, root, "writer", newTestRole("writer", "get", "*"))
+		assert.IsType(t, ErrNotFound{}, err)
+
+		err = m.DeleteRole(ctx, root, rbac.RoleAdmin)
+		assert.IsType(t, ErrUnprocessable{}, err)
+
+		err = m.AssignRolesToUser(ctx, root, "alice", []string{"writer"})
+		assert.IsType(t, ErrNotFound{}, err)
+
+		err = m.CreateRole(ctx, alice, newTestRole("writer", "get", "*"))
+		assert.IsType(t, errors.Forbidden{}, err)
+	})
+
+	t.Run("no permissions beyond the own ones are granted", func(t *testing.T) {
+		m, _, authorizer := newTestManager(t)
+		// alice manages roles and her own user, and may read articles
+		require.Nil(t, m.CreateRole(ctx, root, &models.Role{
+			Name: strPtr("manager"),
+			Permissions: []*models.Permission{
+				{Actions: []string{"*"}, Resource: strPtr("roles/*")},
+				{Actions: []string{"update"}, Resource: strPtr("users/alice")},
+				{Actions: []string{"get"}, Resource: strPtr("data/collections/Article/*")},
+			},
+		}))
+		require.Nil(t, m.AssignRolesToUser(ctx, root, "alice", []string{"manager"}))
+
+		err := m.AssignRolesToUser(ctx, alice, "alice", []string{rbac.RoleAdmin})
+		assert.IsType(t, errors.Forbidden{}, err)
+		err = m.CreateRole(ctx, alice, newTestRole("everything", "*", "*"))
+		assert.IsType(t, errors.Forbidden{}, err)
+		err = m.CreateRole(ctx, alice, newTestRole("writer", "update", "data/collections/Article/*"))
+		assert.IsType(t, errors.Forbidden{}, err)
+		err = m.CreateRole(ctx, alice, newTestRole("all-articles", "get", "data/*"))
+		assert.IsType(t, errors.Forbidden{}, err)
+
+		// a subset of her own permissions can be granted
+		require.Nil(t, m.CreateRole(ctx, alice,
+			newTestRole("reader", "get", "data/collections/Article/tenants/*")))
+		require.Nil(t, m.CreateRole(ctx, root, newTestRole("deleter", "delete", "*")))
+		err = m.UpdateRole(ctx, alice, "reader", newTestRole("reader", "delete", "*"))
+		assert.IsType(t, errors.Forbidden{}, err)
+		err = m.AssignRolesToUser(ctx, alice, "alice", []string{"deleter"})
+		assert.IsType(t, errors.Forbidden{}, err)
+		assert.NotNil(t, authorizer.Authorize(alice, "delete", resource))
+	})
+
+	t.Run("policy changed by another node", func(t *testing.T) {
+		m, repo, authorizer := newTestManager(t)
+
+		policy := rbac.NewPolicy()
+		policy.Version = 3
+		policy.Users["alice"] = []string{rbac.RoleAdmin}
+		repo.callback(policy)
+
+		assert.Nil(t, authorizer.Authorize(alice, "delete", resource))
+
+		require.Nil(t, m.RevokeRolesFromUser(ctx, root, "alice", []string{rbac.RoleAdmin}))
+		assert.Equal(t, uint64(4), repo.policy.Version)
+	})
+}
+
+func strPtr(s string) *string {
+	return &s
+}
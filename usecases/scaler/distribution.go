@@ -22,6 +22,11 @@ type (
 	ShardDist map[string][]string
 	// nodeShardDist map a node its shard distribution
 	nodeShardDist map[string]ShardDist
+	// CopyDist maps a source shard to the distribution of its copies, which
+	// are shards with new names
+	CopyDist map[string]ShardDist
+	// nodeCopyDist map a node to the copies it creates
+	nodeCopyDist map[string]CopyDist
 )
 
 // distributions returns shard distribution for local node as well as remote nodes
@@ -30,6 +35,9 @@ func distributions(before, after *sharding.State) (ShardDist, nodeShardDist) {
 	nodeDist := make(map[string]ShardDist)
 	for name := range before.Physical {
 		newNodes := difference(after.Physical[name].BelongsToNodes, before.Physical[name].BelongsToNodes)
+		if len(newNodes) == 0 {
+			continue
+		}
 		if before.IsLocalShard(name) {
 			localDist[name] = newNodes
 		} else {
@@ -45,6 +53,29 @@ func distributions(before, after *sharding.State) (ShardDist, nodeShardDist) {
 	return localDist, nodeDist
 }
 
+// copyDistributions returns the distribution of the new shards split off
+// existing shards. A split shard is copied by the local node if it owns the
+// source shard and by the first owner of the source shard otherwise.
+func copyDistributions(before, after *sharding.State, splits []sharding.ShardSplit) (CopyDist, nodeCopyDist) {
+	localDist := make(CopyDist)
+	nodeDist := make(nodeCopyDist)
+	for _, split := range splits {
+		dist := localDist
+		if !before.IsLocalShard(split.From) {
+			belongsTo := before.Physical[split.From].BelongsToNode()
+			if dist = nodeDist[belongsTo]; dist == nil {
+				dist = make(CopyDist)
+				nodeDist[belongsTo] = dist
+			}
+		}
+		if dist[split.From] == nil {
+			dist[split.From] = make(ShardDist)
+		}
+		dist[split.From][split.To] = after.Physical[split.To].BelongsToNodes
+	}
+	return localDist, nodeDist
+}
+
 // nodes return node names
 func (m nodeShardDist) nodes() []string {
 	ns := make([]string, 0, len(m))
@@ -54,6 +85,15 @@ func (m nodeShardDist) nodes() []string {
 	return ns
 }
 
+// nodes return node names
+func (m nodeCopyDist) nodes() []string {
+	ns := make([]string, 0, len(m))
+	for node := range m {
+		ns = append(ns, node)
+	}
+	return ns
+}
+
 // hosts resolve node names into host addresses
 func hosts(nodes []string, resolver cluster) ([]string, error) {
 	hs := make([]string, len(nodes))
@@ -76,6 +116,15 @@ func (m ShardDist) shards() []string {
 	return ns
 }
 
+// shards return names of all source shards
+func (m CopyDist) shards() []string {
+	ns := make([]string, 0, len(m))
+	for shard := range m {
+		ns = append(ns, shard)
+	}
+	return ns
+}
+
 // difference returns elements in xs which doesn't exists in ys
 func difference(xs, ys []string) []string {
 	m := make(map[string]struct{}, len(ys))
@@ -79,9 +79,16 @@ func (f *fakeFactory) Scaler(dataPath string) *Scaler {
 type fakeShardingState struct {
 	LocalNode string
 	M         map[string][]string
+	// State takes precedence over M if set
+	State *sharding.State
 }
 
 func (f *fakeShardingState) CopyShardingState(class string) *sharding.State {
+	if f.State != nil {
+		state := f.State.DeepCopy()
+		state.SetLocalName(f.LocalNode)
+		return &state
+	}
 	if len(f.M) == 0 {
 		return nil
 	}
@@ -178,3 +185,10 @@ func (f *fakeClient) IncreaseReplicationFactor(ctx context.Context,
 	args := f.Called(ctx, host, class, dist)
 	return args.Error(0)
 }
+
+func (f *fakeClient) CopyShards(ctx context.Context,
+	host, class string, dist CopyDist,
+) error {
+	args := f.Called(ctx, host, class, dist)
+	return args.Error(0)
+}
@@ -17,6 +17,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/weaviate/weaviate/entities/backup"
 	"golang.org/x/sync/errgroup"
@@ -38,6 +39,9 @@ type client interface {
 	ReInitShard(ctx context.Context,
 		hostName, indexName, shardName string) error
 	IncreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
+
+	// CopyShards makes the remote node copy its local shards under new names
+	CopyShards(ctx context.Context, host, class string, dist CopyDist) error
 }
 
 // rsync synchronizes shards with remote nodes
@@ -67,38 +71,66 @@ func (r *rsync) Push(ctx context.Context, shardsBackups []*backup.ShardDescripto
 	return g.Wait()
 }
 
+// Copy copies local shards of a class to new shards on remote nodes
+func (r *rsync) Copy(ctx context.Context, shardsBackups []*backup.ShardDescriptor, dist CopyDist, className string) error {
+	var g errgroup.Group
+	g.SetLimit(_NUMCPU * 2)
+	for _, desc := range shardsBackups {
+		for shardName, nodes := range dist[desc.Name] {
+			desc, shardName, nodes := desc, shardName, nodes
+			g.Go(func() error {
+				return r.PushShardAs(ctx, className, desc, shardName, nodes)
+			})
+		}
+	}
+	return g.Wait()
+}
+
 // PushShard replicates a shard on a set of nodes
 func (r *rsync) PushShard(ctx context.Context, className string, desc *backup.ShardDescriptor, nodes []string) error {
+	return r.PushShardAs(ctx, className, desc, desc.Name, nodes)
+}
+
+// PushShardAs copies a shard to a set of nodes under the name shardName.
+// Shard files are prefixed with the shard name, so they are renamed
+// accordingly.
+func (r *rsync) PushShardAs(ctx context.Context, className string,
+	desc *backup.ShardDescriptor, shardName string, nodes []string,
+) error {
+	rename := shardFileRenamer(className, desc.Name, shardName)
 	// Iterate over the new target nodes and copy files
 	for _, node := range nodes {
 		host, ok := r.cluster.NodeHostname(node)
 		if !ok {
 			return fmt.Errorf("%w: %q", ErrUnresolvedName, node)
 		}
-		if err := r.client.CreateShard(ctx, host, className, desc.Name); err != nil {
+		if err := r.client.CreateShard(ctx, host, className, shardName); err != nil {
 			return fmt.Errorf("create new shard on remote node %q: %w", node, err)
 		}
 
 		// Transfer each file that's part of the backup.
 		for _, file := range desc.Files {
-			err := r.PutFile(ctx, file, host, className, desc.Name)
+			err := r.PutFile(ctx, file, rename(file), host, className, shardName)
 			if err != nil {
 				return fmt.Errorf("copy files to remote node %q: %w", node, err)
 			}
 		}
 
 		// Transfer shard metadata files
-		err := r.PutFile(ctx, desc.ShardVersionPath, host, className, desc.Name)
+		err := r.PutFile(ctx, desc.ShardVersionPath, rename(desc.ShardVersionPath),
+			host, className, shardName)
 		if err != nil {
 			return fmt.Errorf("copy shard version to remote node %q: %w", node, err)
 		}
 
-		err = r.PutFile(ctx, desc.DocIDCounterPath, host, className, desc.Name)
+		err = r.PutFile(ctx, desc.DocIDCounterPath, rename(desc.DocIDCounterPath),
+			host, className, shardName)
 		if err != nil {
 			return fmt.Errorf("copy index counter to remote node %q: %w", node, err)
 		}
 
-		err = r.PutFile(ctx, desc.PropLengthTrackerPath, host, className, desc.Name)
+		err = r.PutFile(ctx, desc.PropLengthTrackerPath, rename(desc.PropLengthTrackerPath),
+			host, className, shardName)
 		if err != nil {
 			return fmt.Errorf("copy prop length tracker to remote node %q: %w", node, err)
 		}
@@ -106,14 +138,14 @@ func (r *rsync) PushShard(ctx context.Context, className string, desc *backup.Sh
 		// Now that all files are on the remote node's new shard, the shard needs
 		// to be reinitialized. Otherwise, it would not recognize the files when
 		// serving traffic later.
-		if err := r.client.ReInitShard(ctx, host, className, desc.Name); err != nil {
+		if err := r.client.ReInitShard(ctx, host, className, shardName); err != nil {
 			return fmt.Errorf("create new shard on remote node %q: %w", node, err)
 		}
 	}
 	return nil
 }
 
-func (r *rsync) PutFile(ctx context.Context, sourceFileName string,
+func (r *rsync) PutFile(ctx context.Context, sourceFileName, targetFileName string,
 	hostname, className, shardName string,
 ) error {
 	absPath := filepath.Join(r.persistenceRoot, sourceFileName)
@@ -122,5 +154,22 @@ func (r *rsync) PutFile(ctx context.Context, sourceFileName string,
 		return fmt.Errorf("open file %q for reading: %w", absPath, err)
 	}
 
-	return r.client.PutFile(ctx, hostname, className, shardName, sourceFileName, f)
+	return r.client.PutFile(ctx, hostname, className, shardName, targetFileName, f)
+}
+
+// shardFileRenamer returns a function which renames files of shard "from"
+// to files of shard "to". Files of a shard are prefixed with the index ID,
+// i.e. the lowercase class name, followed by the shard name.
+func shardFileRenamer(className, from, to string) func(string) string {
+	if from == to {
+		return func(path string) string { return path }
+	}
+	index := strings.ToLower(className)
+	oldPrefix, newPrefix := index+"_"+from, index+"_"+to
+	return func(path string) string {
+		if rest, ok := strings.CutPrefix(path, oldPrefix); ok {
+			return newPrefix + rest
+		}
+		return path
+	}
 }
@@ -30,8 +30,6 @@ import (
 // We could concurrently sync same files to different nodes  while avoiding overlapping
 //
 // 2. To fail fast, we might consider creating all shards at once and re-initialize them in the final step
-//
-// 3. implement scaler.scaleIn
 
 var (
 	// ErrUnresolvedName cannot resolve the host address of a node
@@ -39,9 +37,15 @@ var (
 	_NUMCPU           = runtime.NumCPU()
 )
 
-// Scaler scales out/in class replicas.
+// Scaler scales out/in class replicas and changes the number of shards of a
+// class.
 //
-// It scales out a class by replicating its shards on new replicas
+// It scales out a class by replicating its shards on new replicas. It scales
+// in a class by removing replicas, which nodes drop once they apply the new
+// sharding state. It reshards a class by splitting shards into copies, which
+// later drop the objects they don't own anymore, or by merging shards into
+// others. Objects of merged shards are moved to their new shard by each node
+// of that shard.
 type Scaler struct {
 	schema          SchemaManager
 	cluster         cluster
@@ -91,7 +95,7 @@ func (s *Scaler) SetSchemaManager(sm SchemaManager) {
 	s.schema = sm
 }
 
-// Scale increase/decrease class replicas.
+// Scale increase/decrease class replicas or changes the number of shards.
 //
 // It returns the updated sharding state if successful. The caller must then
 // make sure to broadcast that state to all nodes as part of the "update"
@@ -106,12 +110,18 @@ func (s *Scaler) Scale(ctx context.Context, className string,
 	if ssBefore == nil {
 		return nil, fmt.Errorf("no sharding state for class %q", className)
 	}
+	if !ssBefore.PartitioningEnabled && updated.DesiredCount != ssBefore.Config.DesiredCount {
+		if newReplFactor != prevReplFactor {
+			return nil, fmt.Errorf("shard count and replication factor cannot be changed at the same time")
+		}
+		return s.reshard(ctx, className, ssBefore, updated)
+	}
 	if newReplFactor > prevReplFactor {
 		return s.scaleOut(ctx, className, ssBefore, updated, newReplFactor)
 	}
 
 	if newReplFactor < prevReplFactor {
-		return s.scaleIn(ctx, className, updated)
+		return s.scaleIn(ctx, className, ssBefore, updated, newReplFactor)
 	}
 
 	return nil, nil
@@ -140,13 +150,28 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 		}
 		ssAfter.Physical[name] = shard
 	}
-	lDist, nodeDist := distributions(ssBefore, &ssAfter)
+	if err := s.replicate(ctx, className, ssBefore, &ssAfter); err != nil {
+		return nil, err
+	}
+
+	// Finally, return sharding state back to schema manager. The schema manager
+	// will then broadcast this updated state to the cluster. This is essentially
+	// what will take the new replication shards live: On the new nodes, if
+	// traffic is incoming, IsShardLocal() would have returned false before. But
+	// now that a copy of the local shard is present it will return true and
+	// serve the traffic.
+	return &ssAfter, nil
+}
+
+// replicate pushes shards to the nodes they belong to after but not before
+func (s *Scaler) replicate(ctx context.Context, className string, before, after *sharding.State) error {
+	lDist, nodeDist := distributions(before, after)
 	g, ctx := errgroup.WithContext(ctx)
 	// resolve hosts beforehand
 	nodes := nodeDist.nodes()
 	hosts, err := hosts(nodes, s.cluster)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	for i, node := range nodes {
 		dist := nodeDist[node]
@@ -166,17 +191,7 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 		}
 		return nil
 	})
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
-
-	// Finally, return sharding state back to schema manager. The schema manager
-	// will then broadcast this updated state to the cluster. This is essentially
-	// what will take the new replication shards live: On the new nodes, if
-	// traffic is incoming, IsShardLocal() would have returned false before. But
-	// now that a copy of the local shard is present it will return true and
-	// serve the traffic.
-	return &ssAfter, nil
+	return g.Wait()
 }
 
 // LocalScaleOut syncs local shards with new replicas.
@@ -213,8 +228,135 @@ func (s *Scaler) LocalScaleOut(ctx context.Context,
 	return rsync.Push(ctx, bak.Shards, dist, className)
 }
 
-func (s *Scaler) scaleIn(ctx context.Context, className string,
-	updated sharding.Config,
+// LocalCopy copies local shards to new shards.
+//
+// It works like LocalScaleOut, except that the copies are created under the
+// names of the new shards, which is why the files are renamed accordingly.
+func (s *Scaler) LocalCopy(ctx context.Context,
+	className string, dist CopyDist,
+) error {
+	if len(dist) < 1 {
+		return nil
+	}
+	bakID := fmt.Sprintf("_internal_scaler_%s", uuid.New().String())
+	bak, err := s.source.ShardsBackup(ctx, bakID, className, dist.shards())
+	if err != nil {
+		return fmt.Errorf("create snapshot: %w", err)
+	}
+
+	defer func() {
+		err := s.source.ReleaseBackup(context.Background(), bakID, className)
+		if err != nil {
+			s.logger.WithField("scaler", "releaseBackup").WithField("class", className).Error(err)
+		}
+	}()
+	rsync := newRSync(s.client, s.cluster, s.persistenceRoot)
+	return rsync.Copy(ctx, bak.Shards, dist, className)
+}
+
+// scaleIn removes class replicas. No data needs to be moved: each node drops
+// the replicas it doesn't own anymore once it applies the new sharding state.
+func (s *Scaler) scaleIn(ctx context.Context, className string, ssBefore *sharding.State,
+	updated sharding.Config, replFactor int64,
 ) (*sharding.State, error) {
-	return nil, errors.Errorf("scaling in not supported yet")
+	ssAfter := ssBefore.DeepCopy()
+	ssAfter.Config = updated
+	if err := ssAfter.ShrinkReplicas(int(replFactor), nil); err != nil {
+		return nil, err
+	}
+	return &ssAfter, nil
+}
+
+// Move moves replicas of a class to other nodes, e.g. in order to
+// decommission a node or to rebalance the cluster. Replicas are pushed to
+// their new nodes before the updated sharding state is returned. The nodes
+// being left must still be reachable since they may be the source of a
+// replica.
+//
+// The caller must broadcast the returned state as part of the "update"
+// transaction, after which the nodes being left drop their replicas.
+func (s *Scaler) Move(ctx context.Context, className string,
+	moves []sharding.ReplicaMove,
+) (*sharding.State, error) {
+	ssBefore := s.schema.CopyShardingState(className)
+	if ssBefore == nil {
+		return nil, fmt.Errorf("no sharding state for class %q", className)
+	}
+	if ssBefore.PartitioningEnabled {
+		return nil, fmt.Errorf("moving replicas of class %q: not supported with multi-tenancy", className)
+	}
+	ssAfter := ssBefore.DeepCopy()
+	if err := ssAfter.ApplyMoves(moves); err != nil {
+		return nil, fmt.Errorf("move replicas of class %q: %w", className, err)
+	}
+	if err := s.replicate(ctx, className, ssBefore, &ssAfter); err != nil {
+		return nil, err
+	}
+	return &ssAfter, nil
+}
+
+// reshard changes the number of shards of a class.
+//
+// * New shards are copies of the shards they are split off and are placed on
+// the same nodes. Once the new state is applied, each copy drops the objects
+// which belong to the other one.
+// * A merged shard is replicated on the nodes of the shard it is merged into.
+// Once the new state is applied, these nodes move its objects into the
+// remaining shard and drop it.
+func (s *Scaler) reshard(ctx context.Context, className string,
+	ssBefore *sharding.State, updated sharding.Config,
+) (*sharding.State, error) {
+	ssAfter := ssBefore.DeepCopy()
+	splits, merges, err := ssAfter.Reshard(updated.DesiredCount)
+	if err != nil {
+		return nil, fmt.Errorf("reshard class %q: %w", className, err)
+	}
+	// virtual shards are fixed
+	config := updated
+	config.DesiredVirtualCount = ssBefore.Config.DesiredVirtualCount
+	config.ActualVirtualCount = ssBefore.Config.ActualVirtualCount
+	config.ActualCount = ssAfter.Config.ActualCount
+	ssAfter.Config = config
+
+	target := ssBefore.DeepCopy()
+	for _, m := range merges {
+		p := target.Physical[m.From]
+		p.BelongsToNodes = append(p.BelongsToNodes,
+			difference(ssAfter.Physical[m.Into].BelongsToNodes, p.BelongsToNodes)...)
+		target.Physical[m.From] = p
+	}
+
+	lDist, nodeDist := copyDistributions(ssBefore, &ssAfter, splits)
+	nodes := nodeDist.nodes()
+	hosts, err := hosts(nodes, s.cluster)
+	if err != nil {
+		return nil, err
+	}
+	g, gctx := errgroup.WithContext(ctx)
+	for i, node := range nodes {
+		dist := nodeDist[node]
+		i := i
+		g.Go(func() error {
+			if err := s.client.CopyShards(gctx, hosts[i], className, dist); err != nil {
+				return fmt.Errorf("split shards of class %q on node %q: %w", className, nodes[i], err)
+			}
+			return nil
+		})
+	}
+	g.Go(func() error {
+		if err := s.LocalCopy(gctx, className, lDist); err != nil {
+			return fmt.Errorf("split local shards: %w", err)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		if err := s.replicate(gctx, className, ssBefore, &target); err != nil {
+			return fmt.Errorf("replicate merged shards: %w", err)
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return &ssAfter, nil
 }
@@ -15,6 +15,7 @@ import (
 	"context"
 	"os"
 	"path"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -40,12 +41,19 @@ func TestScalerScale(t *testing.T) {
 		_, err := scaler.Scale(ctx, "C", old, 2, 2)
 		assert.Nil(t, err)
 	})
-	t.Run("ScaleInNotSupported", func(t *testing.T) {
+	t.Run("ScaleIn", func(t *testing.T) {
 		scaler := newFakeFactory().Scaler("")
 		old := sharding.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 2, 1)
-		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "not supported")
+		ss, err := scaler.Scale(ctx, "C", old, 2, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"N1"}, ss.Physical["S1"].BelongsToNodes)
+		assert.Len(t, ss.Physical["S3"].BelongsToNodes, 1)
+	})
+	t.Run("ReshardAndScale", func(t *testing.T) {
+		scaler := newFakeFactory().Scaler("")
+		updated := sharding.Config{DesiredCount: 2}
+		_, err := scaler.Scale(ctx, "C", updated, 1, 2)
+		assert.ErrorContains(t, err, "at the same time")
 	})
 }
 
@@ -168,3 +176,139 @@ func TestScalerScaleOut(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestScalerReshard(t *testing.T) {
+	var (
+		dataDir = t.TempDir()
+		ctx     = context.Background()
+		cls     = "C"
+		files   = []string{"c_S1_lsm/objects/segment-1.db", "c_S1.version", "c_S1.indexcount", "c_S1.proplengths"}
+		bak     = backup.ClassDescriptor{
+			Name: cls,
+			Shards: []*backup.ShardDescriptor{{
+				Name:                  "S1",
+				Files:                 files[:1],
+				ShardVersionPath:      files[1],
+				DocIDCounterPath:      files[2],
+				PropLengthTrackerPath: files[3],
+			}},
+		}
+	)
+	for _, f := range files {
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(dataDir, f)), os.ModePerm))
+		assert.Nil(t, os.WriteFile(filepath.Join(dataDir, f), nil, os.ModePerm))
+	}
+	state := func() *sharding.State {
+		return &sharding.State{
+			Config: sharding.Config{
+				DesiredCount: 2, ActualCount: 2,
+				DesiredVirtualCount: 4, ActualVirtualCount: 4,
+			},
+			Physical: map[string]sharding.Physical{
+				"S1": {Name: "S1", OwnsVirtual: []string{"v1", "v2"}, OwnsPercentage: 0.5, BelongsToNodes: []string{"N1"}},
+				"S2": {Name: "S2", OwnsVirtual: []string{"v3", "v4"}, OwnsPercentage: 0.5, BelongsToNodes: []string{"N2"}},
+			},
+			Virtual: []sharding.Virtual{
+				{Name: "v1", Upper: 1 << 60, OwnsPercentage: 0.25, AssignedToPhysical: "S1"},
+				{Name: "v2", Upper: 2 << 60, OwnsPercentage: 0.25, AssignedToPhysical: "S1"},
+				{Name: "v3", Upper: 3 << 60, OwnsPercentage: 0.25, AssignedToPhysical: "S2"},
+				{Name: "v4", Upper: 4 << 60, OwnsPercentage: 0.25, AssignedToPhysical: "S2"},
+			},
+		}
+	}
+
+	t.Run("Split", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.State = state()
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, cls).Return(nil)
+		f.Client.On("CreateShard", anyVal, "H1", cls, anyVal).Return(nil)
+		f.Client.On("PutFile", anyVal, "H1", cls, anyVal, anyVal, anyVal).Return(nil)
+		f.Client.On("ReInitShard", anyVal, "H1", cls, anyVal).Return(nil)
+		f.Client.On("CopyShards", anyVal, "H2", cls, anyVal).Return(nil)
+
+		ss, err := f.Scaler(dataDir).Scale(ctx, cls, sharding.Config{DesiredCount: 4}, 1, 1)
+		assert.Nil(t, err)
+		assert.Len(t, ss.Physical, 4)
+		assert.Equal(t, 4, ss.Config.ActualCount)
+		assert.Equal(t, 4, ss.Config.ActualVirtualCount)
+
+		for name, p := range ss.Physical {
+			if name == "S1" || name == "S2" {
+				continue
+			}
+			switch p.BelongsToNodes[0] {
+			case "N1": // split off local shard S1
+				for _, file := range files {
+					renamed := "c_" + name + file[len("c_S1"):]
+					f.Client.AssertCalled(t, "PutFile", anyVal, "H1", cls, name, renamed, anyVal)
+				}
+				f.Client.AssertCalled(t, "ReInitShard", anyVal, "H1", cls, name)
+			case "N2": // split off remote shard S2
+				f.Client.AssertCalled(t, "CopyShards", anyVal, "H2", cls,
+					CopyDist{"S2": ShardDist{name: {"N2"}}})
+			default:
+				t.Errorf("unexpected nodes of shard %s: %v", name, p.BelongsToNodes)
+			}
+		}
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.State = state()
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, cls).Return(nil)
+		// S1 is merged into S2 and therefore replicated on N2
+		f.Client.On("CreateShard", anyVal, "H2", cls, "S1").Return(nil)
+		for _, file := range files {
+			f.Client.On("PutFile", anyVal, "H2", cls, "S1", file, anyVal).Return(nil)
+		}
+		f.Client.On("ReInitShard", anyVal, "H2", cls, "S1").Return(nil)
+
+		ss, err := f.Scaler(dataDir).Scale(ctx, cls, sharding.Config{DesiredCount: 1}, 1, 1)
+		assert.Nil(t, err)
+		assert.Len(t, ss.Physical, 1)
+		assert.Len(t, ss.Physical["S2"].OwnsVirtual, 4)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("CopyError", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.State = state()
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, errAny)
+		f.Client.On("CopyShards", anyVal, "H2", cls, anyVal).Return(nil)
+
+		_, err := f.Scaler(dataDir).Scale(ctx, cls, sharding.Config{DesiredCount: 4}, 1, 1)
+		assert.ErrorIs(t, err, errAny)
+	})
+}
+
+func TestScalerMove(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Success", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", "C", ShardDist{"S3": {"N2"}}).Return(nil)
+		ss, err := f.Scaler("").Move(ctx, "C", []sharding.ReplicaMove{
+			{Shard: "S3", From: "N3", To: "N2"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"N1"}, ss.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"N2", "N4"}, ss.Physical["S3"].BelongsToNodes)
+		f.Client.AssertExpectations(t)
+	})
+	t.Run("PushError", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", "C", anyVal).Return(errAny)
+		_, err := f.Scaler("").Move(ctx, "C", []sharding.ReplicaMove{
+			{Shard: "S3", From: "N3", To: "N2"},
+		})
+		assert.ErrorIs(t, err, errAny)
+	})
+	t.Run("InvalidMove", func(t *testing.T) {
+		f := newFakeFactory()
+		_, err := f.Scaler("").Move(ctx, "C", []sharding.ReplicaMove{
+			{Shard: "S3", From: "N1", To: "N2"},
+		})
+		assert.ErrorContains(t, err, "no replica on node")
+	})
+}
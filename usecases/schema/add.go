@@ -31,6 +31,8 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -41,7 +43,11 @@ import (
 func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
 	class *models.Class,
 ) error {
-	err := m.Authorizer.Authorize(principal, "create", "schema/objects")
+	var className string
+	if class != nil {
+		className = class.Class
+	}
+	err := m.Authorizer.Authorize(principal, "create", authorization.Collections(className))
 	if err != nil {
 		return err
 	}
@@ -141,6 +147,10 @@ func (m *Manager) addClass(ctx context.Context, class *models.Class,
 		class.ShardingConfig = sharding.Config{DesiredCount: 0} // tenant shards will be created dynamically
 	}
 
+	if err := validateMultiTenancyConfig(class.MultiTenancyConfig); err != nil {
+		return nil, err
+	}
+
 	m.setClassDefaults(class)
 	err := m.validateCanAddClass(ctx, class, false)
 	if err != nil {
@@ -227,11 +237,16 @@ func (m *Manager) addClassApplyChanges(ctx context.Context, class *models.Class,
 
 func (m *Manager) setClassDefaults(class *models.Class) {
 	if class.Vectorizer == "" {
-		class.Vectorizer = m.config.DefaultVectorizerModule
+		if schema.NamedVectorsEnabled(class) {
+			// the vectorizers are configured per named vector
+			class.Vectorizer = config.VectorizerModuleNone
+		} else {
+			class.Vectorizer = m.config.DefaultVectorizerModule
+		}
 	}
 
 	if class.VectorIndexType == "" {
-		class.VectorIndexType = "hnsw"
+		class.VectorIndexType = vectorindex.DefaultVectorIndexType
 	}
 
 	if m.config.DefaultVectorDistanceMetric != "" {
@@ -242,6 +257,10 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 		}
 	}
 
+	for name, cfg := range class.VectorConfig {
+		class.VectorConfig[name] = m.setVectorConfigDefaults(cfg)
+	}
+
 	setInvertedConfigDefaults(class)
 	for _, prop := range class.Properties {
 		setPropertyDefaults(prop)
@@ -250,9 +269,26 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 	m.moduleConfig.SetClassDefaults(class)
 }
 
+func (m *Manager) setVectorConfigDefaults(cfg models.VectorConfig) models.VectorConfig {
+	if cfg.VectorIndexType == "" {
+		cfg.VectorIndexType = vectorindex.DefaultVectorIndexType
+	}
+
+	if m.config.DefaultVectorDistanceMetric != "" {
+		if cfg.VectorIndexConfig == nil {
+			cfg.VectorIndexConfig = map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
+		} else if asMap, ok := cfg.VectorIndexConfig.(map[string]interface{}); ok && asMap["distance"] == nil {
+			asMap["distance"] = m.config.DefaultVectorDistanceMetric
+		}
+	}
+
+	return cfg
+}
+
 func setPropertyDefaults(prop *models.Property) {
 	setPropertyDefaultTokenization(prop)
 	setPropertyDefaultIndexing(prop)
+	setNestedPropertiesDefaults(prop.NestedProperties)
 }
 
 func setPropertyDefaultTokenization(prop *models.Property) {
@@ -283,7 +319,13 @@ func setPropertyDefaultIndexing(prop *models.Property) {
 
 	vTrue := true
 	if prop.IndexFilterable == nil {
-		prop.IndexFilterable = &vTrue
+		if _, isNested := schema.AsNested(prop.DataType); isNested {
+			// filtering on nested properties is not supported yet
+			vFalse := false
+			prop.IndexFilterable = &vFalse
+		} else {
+			prop.IndexFilterable = &vTrue
+		}
 	}
 	if prop.IndexSearchable == nil {
 		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
@@ -392,6 +434,21 @@ func (m *Manager) validateCanAddClass(
 	return nil
 }
 
+// validateMultiTenancyConfig validates the policy for activating and
+// deactivating tenants automatically
+func validateMultiTenancyConfig(cfg *models.MultiTenancyConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.AutoTenantDeactivationTimeoutSeconds < 0 {
+		return fmt.Errorf("autoTenantDeactivationTimeoutSeconds must not be negative")
+	}
+	if cfg.AutoTenantDeactivationTimeoutSeconds > 0 && !cfg.AutoTenantActivation {
+		return fmt.Errorf("autoTenantDeactivationTimeoutSeconds requires autoTenantActivation")
+	}
+	return nil
+}
+
 func (m *Manager) validateProperty(
 	property *models.Property, className string,
 	existingPropertyNames map[string]bool, relaxCrossRefValidation bool,
@@ -421,6 +478,19 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := validatePropertyTextAnalyzer(property); err != nil {
+		return err
+	}
+
+	if propertyDataType.IsNested() {
+		if err := validateNestedProperties(property.Name, property.NestedProperties); err != nil {
+			return err
+		}
+	} else if len(property.NestedProperties) > 0 {
+		return fmt.Errorf("property '%s': nestedProperties are only allowed "+
+			"for data types object and object[]", property.Name)
+	}
+
 	if err := m.validatePropertyIndexing(property); err != nil {
 		return err
 	}
@@ -432,22 +502,48 @@ func (m *Manager) validateProperty(
 func (m *Manager) parseVectorIndexConfig(ctx context.Context,
 	class *models.Class,
 ) error {
-	if class.VectorIndexType != "hnsw" {
+	if !isSupportedVectorIndexType(class.VectorIndexType) {
 		return errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			class.VectorIndexType)
 	}
 
-	parsed, err := m.hnswConfigParser(class.VectorIndexConfig)
+	parsed, err := m.configParser(class.VectorIndexConfig, class.VectorIndexType)
 	if err != nil {
 		return errors.Wrap(err, "parse vector index config")
 	}
 
 	class.VectorIndexConfig = parsed
 
+	for name, cfg := range class.VectorConfig {
+		if !isSupportedVectorIndexType(cfg.VectorIndexType) {
+			return errors.Errorf(
+				"parse vector config for %s: unsupported vector index type: %q",
+				name, cfg.VectorIndexType)
+		}
+
+		parsed, err := m.configParser(cfg.VectorIndexConfig, cfg.VectorIndexType)
+		if err != nil {
+			return errors.Wrapf(err, "parse vector config for %s", name)
+		}
+
+		cfg.VectorIndexConfig = parsed
+		class.VectorConfig[name] = cfg
+	}
+
 	return nil
 }
 
+func isSupportedVectorIndexType(vectorIndexType string) bool {
+	switch vectorIndexType {
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+		vectorindex.VectorIndexTypeDYNAMIC:
+		return true
+	default:
+		return false
+	}
+}
+
 func (m *Manager) parseShardingConfig(ctx context.Context, class *models.Class) (err error) {
 	// multiTenancyConfig and shardingConfig are mutually exclusive
 	cfg := sharding.Config{} // cfg is empty in case of MT
@@ -21,13 +21,14 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // AddClassProperty to an existing Class
 func (m *Manager) AddClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property *models.Property,
 ) error {
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	err := m.Authorizer.Authorize(principal, "update", authorization.Collections(class))
 	if err != nil {
 		return err
 	}
@@ -107,6 +108,16 @@ func validateUserProp(class *models.Class, prop *models.Property) error {
 		if !ok {
 			return fmt.Errorf("%v property config invalid", prop.Name)
 		}
+		if schema.NamedVectorsEnabled(class) {
+			// named vectors can use different vectorizers, each of them may
+			// have its own config on the property
+			for module, vectorizerConfig := range modconfig {
+				if _, ok := vectorizerConfig.(map[string]interface{}); !ok {
+					return fmt.Errorf("vectorizer config for vectorizer %v, not of type map[string]interface{}", module)
+				}
+			}
+			return nil
+		}
 		vectorizerConfig, ok := modconfig[class.Vectorizer]
 		if !ok {
 			return fmt.Errorf("%v vectorizer module not part of the property", class.Vectorizer)
@@ -530,6 +530,50 @@ func TestAddClass(t *testing.T) {
 			require.Nil(t, err)
 		})
 
+		t.Run("automatic tenant activation", func(t *testing.T) {
+			tests := []struct {
+				name   string
+				cfg    *models.MultiTenancyConfig
+				errMsg string
+			}{
+				{
+					name: "activation and deactivation",
+					cfg: &models.MultiTenancyConfig{
+						Enabled: true, AutoTenantActivation: true, AutoTenantDeactivationTimeoutSeconds: 60,
+					},
+				},
+				{
+					name: "deactivation without activation",
+					cfg: &models.MultiTenancyConfig{
+						Enabled: true, AutoTenantDeactivationTimeoutSeconds: 60,
+					},
+					errMsg: "autoTenantDeactivationTimeoutSeconds requires autoTenantActivation",
+				},
+				{
+					name: "negative timeout",
+					cfg: &models.MultiTenancyConfig{
+						Enabled: true, AutoTenantActivation: true, AutoTenantDeactivationTimeoutSeconds: -1,
+					},
+					errMsg: "autoTenantDeactivationTimeoutSeconds must not be negative",
+				},
+			}
+
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					mgr := newSchemaManager()
+					err := mgr.AddClass(context.Background(), nil, &models.Class{
+						Class:              "NewClass",
+						MultiTenancyConfig: tt.cfg,
+					})
+					if tt.errMsg == "" {
+						require.Nil(t, err)
+					} else {
+						require.EqualError(t, err, tt.errMsg)
+					}
+				})
+			}
+		})
+
 		t.Run("multiTenancyConfig and shardingConfig both provided but multi tenancy is nil", func(t *testing.T) {
 			mgr := newSchemaManager()
 			err := mgr.AddClass(context.Background(),
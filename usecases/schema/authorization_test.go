@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 // A component-test like test suite that makes sure that every available UC is
@@ -40,61 +41,67 @@ func Test_Schema_Authorization(t *testing.T) {
 		{
 			methodName:       "GetSchema",
 			expectedVerb:     "list",
-			expectedResource: "schema/*",
+			expectedResource: "schema/collections/*",
 		},
 		{
 			methodName:       "GetClass",
 			additionalArgs:   []interface{}{"classname"},
-			expectedVerb:     "list",
-			expectedResource: "schema/*",
+			expectedVerb:     "get",
+			expectedResource: "schema/collections/classname",
 		},
 		{
 			methodName:       "GetShardsStatus",
 			additionalArgs:   []interface{}{"className"},
 			expectedVerb:     "list",
-			expectedResource: "schema/className/shards",
+			expectedResource: "schema/collections/className/shards/*",
 		},
 		{
 			methodName:       "AddClass",
-			additionalArgs:   []interface{}{&models.Class{}},
+			additionalArgs:   []interface{}{&models.Class{Class: "somename"}},
 			expectedVerb:     "create",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/somename",
 		},
 		{
 			methodName:       "UpdateClass",
 			additionalArgs:   []interface{}{"somename", &models.Class{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/somename",
+		},
+		{
+			methodName:       "MoveReplicas",
+			additionalArgs:   []interface{}{"somename", []sharding.ReplicaMove{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/collections/somename",
 		},
 		{
 			methodName:       "DeleteClass",
 			additionalArgs:   []interface{}{"somename"},
 			expectedVerb:     "delete",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/somename",
 		},
 		{
 			methodName:       "AddClassProperty",
 			additionalArgs:   []interface{}{"somename", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/somename",
 		},
 		{
 			methodName:       "DeleteClassProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/collections/somename",
 		},
 		{
 			methodName:       "UpdateShardStatus",
 			additionalArgs:   []interface{}{"className", "shardName", "targetStatus"},
 			expectedVerb:     "update",
-			expectedResource: "schema/className/shards/shardName",
+			expectedResource: "schema/collections/className/shards/shardName",
 		},
 		{
 			methodName:       "AddTenants",
 			additionalArgs:   []interface{}{"className", []*models.Tenant{{Name: "P1"}}},
-			expectedVerb:     "update",
-			expectedResource: tenantsPath,
+			expectedVerb:     "create",
+			expectedResource: "schema/collections/className/tenants/P1",
 		},
 		{
 			methodName: "UpdateTenants",
@@ -102,19 +109,19 @@ func Test_Schema_Authorization(t *testing.T) {
 				{Name: "P1", ActivityStatus: models.TenantActivityStatusHOT},
 			}},
 			expectedVerb:     "update",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/collections/className/tenants/P1",
 		},
 		{
 			methodName:       "DeleteTenants",
 			additionalArgs:   []interface{}{"className", []string{"P1"}},
 			expectedVerb:     "delete",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/collections/className/tenants/P1",
 		},
 		{
 			methodName:       "GetTenants",
 			additionalArgs:   []interface{}{"className"},
-			expectedVerb:     "get",
-			expectedResource: tenantsPath,
+			expectedVerb:     "list",
+			expectedResource: "schema/collections/className/tenants/*",
 		},
 	}
 
@@ -132,6 +139,7 @@ func Test_Schema_Authorization(t *testing.T) {
 				"Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
 				"CopyShardingState", "TxManager", "RestoreClass",
 				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas",
+				"ActivateTenant", "DeactivateTenants",
 				"StartServing", "Shutdown": // internal methods to indicate readiness state
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
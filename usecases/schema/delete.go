@@ -16,11 +16,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // DeleteClass from the schema
 func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
-	err := m.Authorizer.Authorize(principal, "delete", "schema/objects")
+	err := m.Authorizer.Authorize(principal, "delete", authorization.Collections(class))
 	if err != nil {
 		return err
 	}
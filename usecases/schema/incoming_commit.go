@@ -44,6 +44,9 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 func (m *Manager) handleTxResponse(ctx context.Context,
 	tx *cluster.Transaction,
 ) (data []byte, err error) {
+	if tx.Type == updateTenants {
+		return nil, m.handleUpdateTenantsPrepare(ctx, tx)
+	}
 	if tx.Type != ReadSchema {
 		return nil, nil
 	}
@@ -217,6 +220,23 @@ func (m *Manager) handleUpdateTenantsCommit(ctx context.Context,
 	return err
 }
 
+// handleUpdateTenantsPrepare prepares an update of tenants when the
+// transaction is opened. An error aborts the transaction on all nodes.
+func (m *Manager) handleUpdateTenantsPrepare(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	req, ok := tx.Payload.(UpdateTenantsPayload)
+	if !ok {
+		return errors.Errorf("expected payload to be UpdateTenants, but got %T",
+			tx.Payload)
+	}
+	cls := m.getClassByName(req.Class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", req.Class, ErrNotFound)
+	}
+	return m.prepareUpdateTenants(ctx, cls, req)
+}
+
 func (m *Manager) handleDeleteTenantsCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
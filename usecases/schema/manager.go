@@ -42,7 +42,7 @@ type Manager struct {
 	moduleConfig            ModuleConfig
 	cluster                 *cluster.TxManager
 	clusterState            clusterState
-	hnswConfigParser        VectorConfigParser
+	configParser            VectorConfigParser
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
 	RestoreStatus           sync.Map
@@ -58,7 +58,7 @@ type Manager struct {
 	schemaCache
 }
 
-type VectorConfigParser func(in interface{}) (schema.VectorIndexConfig, error)
+type VectorConfigParser func(in interface{}, vectorIndexType string) (schema.VectorIndexConfig, error)
 
 type InvertedConfigValidator func(in *models.InvertedIndexConfig) error
 
@@ -153,12 +153,14 @@ type scaleOut interface {
 	SetSchemaManager(sm scaler.SchemaManager)
 	Scale(ctx context.Context, className string,
 		updated sharding.Config, prevReplFactor, newReplFactor int64) (*sharding.State, error)
+	Move(ctx context.Context, className string,
+		moves []sharding.ReplicaMove) (*sharding.State, error)
 }
 
 // NewManager creates a new manager
 func NewManager(migrator migrate.Migrator, repo SchemaStore,
 	logger logrus.FieldLogger, authorizer authorizer, config config.Config,
-	hnswConfigParser VectorConfigParser, vectorizerValidator VectorizerValidator,
+	configParser VectorConfigParser, vectorizerValidator VectorizerValidator,
 	invertedConfigValidator InvertedConfigValidator,
 	moduleConfig ModuleConfig, clusterState clusterState,
 	txClient cluster.Client, txPersistence cluster.Persistence,
@@ -172,7 +174,7 @@ func NewManager(migrator migrate.Migrator, repo SchemaStore,
 		schemaCache:             schemaCache{State: State{}},
 		logger:                  logger,
 		Authorizer:              authorizer,
-		hnswConfigParser:        hnswConfigParser,
+		configParser:            configParser,
 		vectorizerValidator:     vectorizerValidator,
 		invertedConfigValidator: invertedConfigValidator,
 		moduleConfig:            moduleConfig,
@@ -49,6 +49,14 @@ func (n *NilMigrator) GetShardsStatus(ctx context.Context, className string) (ma
 	return nil, nil
 }
 
+func (n *NilMigrator) TenantTransitions(className string) map[string]string {
+	return nil
+}
+
+func (n *NilMigrator) UpdateShardingState(ctx context.Context, className string, state *sharding.State) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string) error {
 	return nil
 }
@@ -61,6 +69,10 @@ func (n *NilMigrator) NewTenants(ctx context.Context, class *models.Class, creat
 	return func(bool) {}, nil
 }
 
+func (n *NilMigrator) PrepareUpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) (commit func(success bool), err error) {
 	return func(bool) {}, nil
 }
@@ -89,6 +101,10 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfigs(ctx context.Context, className string, updated map[string]schema.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context, old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
@@ -655,6 +671,12 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 	return nil, nil
 }
 
+func (f *fakeScaleOutManager) Move(ctx context.Context,
+	className string, moves []sharding.ReplicaMove,
+) (*sharding.State, error) {
+	return nil, nil
+}
+
 func (f *fakeScaleOutManager) SetSchemaManager(sm scaler.SchemaManager) {
 }
 
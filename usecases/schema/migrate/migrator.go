@@ -46,13 +46,20 @@ type Migrator interface {
 		propName string, newName *string) error
 
 	NewTenants(ctx context.Context, class *models.Class, creates []*CreateTenantPayload) (commit func(success bool), err error)
+	// PrepareUpdateTenants does the work of an update that may fail, before
+	// the update is committed anywhere in the cluster
+	PrepareUpdateTenants(ctx context.Context, class *models.Class, updates []*UpdateTenantPayload) error
 	UpdateTenants(ctx context.Context, class *models.Class, updates []*UpdateTenantPayload) (commit func(success bool), err error)
 	DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
+	TenantTransitions(className string) map[string]string
+	UpdateShardingState(ctx context.Context, className string, state *sharding.State) error
 
 	ValidateVectorIndexConfigUpdate(ctx context.Context,
 		old, updated schema.VectorIndexConfig) error
 	UpdateVectorIndexConfig(ctx context.Context, className string,
 		updated schema.VectorIndexConfig) error
+	UpdateVectorIndexConfigs(ctx context.Context, className string,
+		updated map[string]schema.VectorIndexConfig) error
 	ValidateInvertedIndexConfigUpdate(ctx context.Context,
 		old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// validateNestedProperties validates the nested properties of an object or
+// object[] property recursively. propPath is the dot-separated path of the
+// parent property and is only used in error messages.
+func validateNestedProperties(propPath string, nestedProps []*models.NestedProperty) error {
+	if len(nestedProps) == 0 {
+		return fmt.Errorf("property '%s': at least one nested property is required "+
+			"for data types object and object[]", propPath)
+	}
+
+	existingNames := map[string]bool{}
+	for _, nestedProp := range nestedProps {
+		if _, err := schema.ValidatePropertyName(nestedProp.Name); err != nil {
+			return fmt.Errorf("property '%s': %w", propPath, err)
+		}
+
+		nestedPath := propPath + "." + nestedProp.Name
+		if existingNames[strings.ToLower(nestedProp.Name)] {
+			return fmt.Errorf("property '%s': already in use or provided multiple times", nestedPath)
+		}
+		existingNames[strings.ToLower(nestedProp.Name)] = true
+
+		dataType, ok := schema.AsNestedPropertyDataType(nestedProp.DataType)
+		if !ok {
+			return fmt.Errorf("property '%s': invalid dataType %v, nested properties "+
+				"support only the data types %v", nestedPath, nestedProp.DataType,
+				schema.NestedPropertyDataTypes)
+		}
+
+		if schema.IsNested(dataType) {
+			if err := validateNestedProperties(nestedPath, nestedProp.NestedProperties); err != nil {
+				return err
+			}
+		} else if len(nestedProp.NestedProperties) > 0 {
+			return fmt.Errorf("property '%s': nestedProperties are only allowed "+
+				"for data types object and object[]", nestedPath)
+		}
+
+		if err := validateNestedPropertyTokenization(nestedPath, dataType,
+			nestedProp.Tokenization); err != nil {
+			return err
+		}
+
+		if err := validateNestedPropertyIndexing(nestedPath, nestedProp.IndexFilterable,
+			nestedProp.IndexSearchable); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateNestedPropertyTokenization(propPath string, dataType schema.DataType,
+	tokenization string,
+) error {
+	switch dataType {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		switch tokenization {
+		case models.PropertyTokenizationField, models.PropertyTokenizationWord,
+			models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase:
+			return nil
+		}
+		return fmt.Errorf("property '%s': Tokenization '%s' is not allowed for data type '%s'",
+			propPath, tokenization, dataType)
+	default:
+		if tokenization == "" {
+			return nil
+		}
+		return fmt.Errorf("property '%s': Tokenization is not allowed for data type '%s'",
+			propPath, dataType)
+	}
+}
+
+// validateNestedPropertyIndexing rejects inverted index settings on object
+// properties and their nested properties, as filtering and searching on
+// nested paths is not supported yet.
+func validateNestedPropertyIndexing(propPath string, indexFilterable, indexSearchable *bool) error {
+	if indexFilterable != nil && *indexFilterable {
+		return fmt.Errorf("property '%s': `indexFilterable` is not supported for object "+
+			"data types and their nested properties yet. Set false or leave empty", propPath)
+	}
+	if indexSearchable != nil && *indexSearchable {
+		return fmt.Errorf("property '%s': `indexSearchable` is not supported for object "+
+			"data types and their nested properties yet. Set false or leave empty", propPath)
+	}
+	return nil
+}
+
+func setNestedPropertiesDefaults(nestedProps []*models.NestedProperty) {
+	for _, nestedProp := range nestedProps {
+		switch dataType, _ := schema.AsNestedPropertyDataType(nestedProp.DataType); dataType {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+			if nestedProp.Tokenization == "" {
+				nestedProp.Tokenization = models.PropertyTokenizationWord
+			}
+		default:
+			// tokenization not supported for other data types
+		}
+
+		if nestedProp.IndexFilterable == nil {
+			vFalse := false
+			nestedProp.IndexFilterable = &vFalse
+		}
+		if nestedProp.IndexSearchable == nil {
+			vFalse := false
+			nestedProp.IndexSearchable = &vFalse
+		}
+
+		setNestedPropertiesDefaults(nestedProp.NestedProperties)
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	uco "github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -27,8 +28,28 @@ import (
 
 var regexTenantName = regexp.MustCompile(`^` + schema.ShardNameRegexCore + `$`)
 
-// tenantsPath is the main path used for authorization
-const tenantsPath = "schema/tenants"
+// authorizeTenants authorizes the verb on each tenant of the class
+func (m *Manager) authorizeTenants(principal *models.Principal, verb, class string,
+	tenants []string,
+) error {
+	for _, tenant := range tenants {
+		if err := m.Authorizer.Authorize(principal, verb,
+			authorization.Tenants(class, tenant)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func tenantNames(tenants []*models.Tenant) []string {
+	names := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		if tenant != nil {
+			names = append(names, tenant.Name)
+		}
+	}
+	return names
+}
 
 // AddTenants is used to add new tenants to a class
 // Class must exist and has partitioning enabled
@@ -37,7 +58,7 @@ func (m *Manager) AddTenants(ctx context.Context,
 	class string,
 	tenants []*models.Tenant,
 ) (created []*models.Tenant, err error) {
-	if err = m.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+	if err = m.authorizeTenants(principal, "create", class, tenantNames(tenants)); err != nil {
 		return
 	}
 
@@ -45,7 +66,7 @@ func (m *Manager) AddTenants(ctx context.Context,
 	if err != nil {
 		return
 	}
-	if err = validateActivityStatuses(validated, true); err != nil {
+	if err = validateActivityStatuses(validated, true, false); err != nil {
 		return
 	}
 	cls := m.getClassByName(class)
@@ -150,14 +171,22 @@ func validateTenants(tenants []*models.Tenant) (validated []*models.Tenant, err
 	return
 }
 
-func validateActivityStatuses(tenants []*models.Tenant, allowEmpty bool) error {
+// validateActivityStatuses validates the requested activity statuses. FROZEN
+// is only supported if allowFrozen is set, tenants can't be created frozen
+// and updating them to FROZEN requires a tenant offload backend.
+func validateActivityStatuses(tenants []*models.Tenant, allowEmpty, allowFrozen bool) error {
 	msgs := make([]string, 0, len(tenants))
 
 	for _, tenant := range tenants {
 		switch status := tenant.ActivityStatus; status {
 		case models.TenantActivityStatusHOT, models.TenantActivityStatusCOLD:
 			// ok
-		case models.TenantActivityStatusWARM, models.TenantActivityStatusFROZEN:
+		case models.TenantActivityStatusFROZEN:
+			if !allowFrozen {
+				msgs = append(msgs, fmt.Sprintf(
+					"not supported activity status '%s' for tenant %q", status, tenant.Name))
+			}
+		case models.TenantActivityStatusWARM:
 			msgs = append(msgs, fmt.Sprintf(
 				"not yet supported activity status '%s' for tenant %q", status, tenant.Name))
 		default:
@@ -232,14 +261,15 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal,
 	class string, tenants []*models.Tenant,
 ) error {
-	if err := m.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+	if err := m.authorizeTenants(principal, "update", class, tenantNames(tenants)); err != nil {
 		return err
 	}
 	validated, err := validateTenants(tenants)
 	if err != nil {
 		return err
 	}
-	if err := validateActivityStatuses(validated, false); err != nil {
+	allowFrozen := m.config.TenantOffloadBackend != ""
+	if err := validateActivityStatuses(validated, false, allowFrozen); err != nil {
 		return err
 	}
 	cls := m.getClassByName(class)
@@ -257,6 +287,15 @@ func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal
 	for i, tenant := range tenants {
 		request.Tenants[i] = TenantUpdate{Name: tenant.Name, Status: tenant.ActivityStatus}
 	}
+	return m.updateTenants(ctx, cls, request)
+}
+
+func (m *Manager) updateTenants(ctx context.Context, class *models.Class, request UpdateTenantsPayload,
+) error {
+	// the other nodes prepare the update when the transaction is opened
+	if err := m.prepareUpdateTenants(ctx, class, request); err != nil {
+		return err
+	}
 
 	// open cluster-wide transaction
 	tx, err := m.cluster.BeginTransaction(ctx, updateTenants,
@@ -269,11 +308,133 @@ func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal
 		m.logger.WithError(err).Errorf("not every node was able to commit")
 	}
 
-	return m.onUpdateTenants(ctx, cls, request) // actual update
+	return m.onUpdateTenants(ctx, class, request) // actual update
+}
+
+// ActivateTenant sets a COLD tenant to HOT on behalf of a request that
+// accesses it. It is meant for internal use, no authorization is performed.
+//
+// The class must have automatic tenant activation enabled
+func (m *Manager) ActivateTenant(ctx context.Context, class, tenant string) error {
+	cls, err := m.autoActivationClass(class)
+	if err != nil {
+		return err
+	}
+	_, status := m.TenantShard(cls.Class, tenant)
+	switch status {
+	case "":
+		return fmt.Errorf("tenant %q not found", tenant)
+	case models.TenantActivityStatusHOT:
+		return nil
+	case models.TenantActivityStatusCOLD:
+	default:
+		return fmt.Errorf("tenant %q with activity status %q can't be activated automatically",
+			tenant, status)
+	}
+
+	return m.updateTenants(ctx, cls, UpdateTenantsPayload{
+		Class:   cls.Class,
+		Tenants: []TenantUpdate{{Name: tenant, Status: models.TenantActivityStatusHOT}},
+	})
+}
+
+// DeactivateTenants sets idle or evicted tenants to COLD. Tenants that are
+// not HOT anymore are skipped. It is meant for internal use, no
+// authorization is performed.
+//
+// The class must have automatic tenant activation enabled
+func (m *Manager) DeactivateTenants(ctx context.Context, class string, tenants []string) error {
+	cls, err := m.autoActivationClass(class)
+	if err != nil {
+		return err
+	}
+	request := UpdateTenantsPayload{Class: cls.Class}
+	for _, tenant := range tenants {
+		if _, status := m.TenantShard(cls.Class, tenant); status == models.TenantActivityStatusHOT {
+			request.Tenants = append(request.Tenants,
+				TenantUpdate{Name: tenant, Status: models.TenantActivityStatusCOLD})
+		}
+	}
+	if len(request.Tenants) == 0 {
+		return nil
+	}
+	return m.updateTenants(ctx, cls, request)
+}
+
+func (m *Manager) autoActivationClass(class string) (*models.Class, error) {
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(cls) {
+		return nil, fmt.Errorf("multi-tenancy is not enabled for class %q", class)
+	}
+	if !cls.MultiTenancyConfig.AutoTenantActivation {
+		return nil, fmt.Errorf("automatic tenant activation is not enabled for class %q", class)
+	}
+	return cls, nil
+}
+
+// prepareUpdateTenants lets the migrator do the work of an update that may
+// fail, e.g. downloading tenants leaving FROZEN, before the update is
+// committed anywhere. A failure aborts the update on all nodes.
+func (m *Manager) prepareUpdateTenants(ctx context.Context, class *models.Class, request UpdateTenantsPayload,
+) error {
+	_, _, migratorUpdates, err := m.tenantUpdates(class, request)
+	if err != nil {
+		return err
+	}
+	if len(migratorUpdates) == 0 {
+		return nil
+	}
+	if err := m.migrator.PrepareUpdateTenants(ctx, class, migratorUpdates); err != nil {
+		m.logger.WithField("action", "prepare_update_tenants").
+			WithField("class", request.Class).Error(err)
+		return fmt.Errorf("migrator.prepare_update_tenants: %w", err)
+	}
+	return nil
 }
 
 func (m *Manager) onUpdateTenants(ctx context.Context, class *models.Class, request UpdateTenantsPayload,
 ) error {
+	ssCopy, schemaUpdates, migratorUpdates, err := m.tenantUpdates(class, request)
+	if err != nil {
+		return err
+	}
+
+	commit, err := m.migrator.UpdateTenants(ctx, class, migratorUpdates)
+	if err != nil {
+		m.logger.WithField("action", "update_tenants").
+			WithField("class", request.Class).Error(err)
+		return fmt.Errorf("migrator.update_tenants: %w", err)
+	}
+
+	m.logger.
+		WithField("action", "schema.update_tenants").
+		WithField("n", len(request.Tenants)).Debugf("persist schema updates")
+
+	if err := m.repo.UpdateShards(ctx, class.Class, schemaUpdates); err != nil {
+		commit(false) // rollback update of tenants
+		return err
+	}
+	commit(true) // commit update of tenants
+
+	// update cache
+	m.schemaCache.LockGuard(func() {
+		if ss := m.schemaCache.ShardingState[request.Class]; ss != nil {
+			for name, physical := range ssCopy.Physical {
+				ss.Physical[name] = physical
+			}
+		}
+	})
+
+	return nil
+}
+
+// tenantUpdates returns the tenants whose status changes, the updates of the
+// schema and the updates of the local tenants for the migrator
+func (m *Manager) tenantUpdates(class *models.Class, request UpdateTenantsPayload,
+) (sharding.State, []KeyValuePair, []*migrate.UpdateTenantPayload, error) {
 	ssCopy := sharding.State{Physical: make(map[string]sharding.Physical)}
 	ssCopy.SetLocalName(m.clusterState.LocalName())
 
@@ -295,7 +456,7 @@ func (m *Manager) onUpdateTenants(ctx context.Context, class *models.Class, requ
 		}
 		return nil
 	}); err != nil {
-		return err
+		return ssCopy, nil, nil, err
 	}
 
 	schemaUpdates := make([]KeyValuePair, 0, len(ssCopy.Physical))
@@ -310,7 +471,7 @@ func (m *Manager) onUpdateTenants(ctx context.Context, class *models.Class, requ
 		ssCopy.Physical[tu.Name] = physical
 		data, err := json.Marshal(physical)
 		if err != nil {
-			return fmt.Errorf("cannot marshal shard %s: %w", tu.Name, err)
+			return ssCopy, nil, nil, fmt.Errorf("cannot marshal shard %s: %w", tu.Name, err)
 		}
 		schemaUpdates = append(schemaUpdates, KeyValuePair{tu.Name, data})
 
@@ -322,40 +483,14 @@ func (m *Manager) onUpdateTenants(ctx context.Context, class *models.Class, requ
 			})
 		}
 	}
-
-	commit, err := m.migrator.UpdateTenants(ctx, class, migratorUpdates)
-	if err != nil {
-		m.logger.WithField("action", "update_tenants").
-			WithField("class", request.Class).Error(err)
-	}
-
-	m.logger.
-		WithField("action", "schema.update_tenants").
-		WithField("n", len(request.Tenants)).Debugf("persist schema updates")
-
-	if err := m.repo.UpdateShards(ctx, class.Class, schemaUpdates); err != nil {
-		commit(false) // rollback update of tenants
-		return err
-	}
-	commit(true) // commit update of tenants
-
-	// update cache
-	m.schemaCache.LockGuard(func() {
-		if ss := m.schemaCache.ShardingState[request.Class]; ss != nil {
-			for name, physical := range ssCopy.Physical {
-				ss.Physical[name] = physical
-			}
-		}
-	})
-
-	return nil
+	return ssCopy, schemaUpdates, migratorUpdates, nil
 }
 
 // DeleteTenants is used to delete tenants of a class.
 //
 // Class must exist and has partitioning enabled
 func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) error {
-	if err := m.Authorizer.Authorize(principal, "delete", tenantsPath); err != nil {
+	if err := m.authorizeTenants(principal, "delete", class, tenants); err != nil {
 		return err
 	}
 	for i, name := range tenants {
@@ -424,7 +559,8 @@ func (m *Manager) onDeleteTenants(ctx context.Context, class *models.Class, req
 //
 // Class must exist and has partitioning enabled
 func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, class string) ([]*models.Tenant, error) {
-	if err := m.Authorizer.Authorize(principal, "get", tenantsPath); err != nil {
+	if err := m.Authorizer.Authorize(principal, "list",
+		authorization.Tenants(class, authorization.All)); err != nil {
 		return nil, err
 	}
 	// validation
@@ -436,15 +572,22 @@ func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, c
 		return nil, fmt.Errorf("multi-tenancy is not enabled for class %q", class)
 	}
 
+	// tenants being frozen or unfrozen are listed with their transitional status
+	transitions := m.migrator.TenantTransitions(cls.Class)
+
 	var tenants []*models.Tenant
 	m.schemaCache.RLockGuard(func() error {
 		if ss := m.schemaCache.ShardingState[cls.Class]; ss != nil {
 			tenants = make([]*models.Tenant, len(ss.Physical))
 			i := 0
 			for tenant := range ss.Physical {
+				status := schema.ActivityStatus(ss.Physical[tenant].Status)
+				if transition, ok := transitions[tenant]; ok {
+					status = transition
+				}
 				tenants[i] = &models.Tenant{
 					Name:           tenant,
-					ActivityStatus: schema.ActivityStatus(ss.Physical[tenant].Status),
+					ActivityStatus: status,
 				}
 				i++
 			}
@@ -13,11 +13,15 @@ package schema
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 )
 
 func TestAddTenants(t *testing.T) {
@@ -124,9 +128,8 @@ func TestAddTenants(t *testing.T) {
 				ReplicationConfig:  repConfig,
 			},
 			errMsgs: []string{
-				"not yet supported activity status",
-				models.TenantActivityStatusWARM,
-				models.TenantActivityStatusFROZEN,
+				"not yet supported activity status 'WARM'",
+				"not supported activity status 'FROZEN'",
 			},
 		},
 		{
@@ -193,6 +196,7 @@ func TestUpdateTenants(t *testing.T) {
 		initial       *models.Class
 		errMsgs       []string
 		skipAdd       bool
+		offload       bool
 	}
 	tests := []test{
 		{
@@ -278,9 +282,8 @@ func TestUpdateTenants(t *testing.T) {
 				ReplicationConfig:  repConfig,
 			},
 			errMsgs: []string{
-				"not yet supported activity status",
-				models.TenantActivityStatusWARM,
-				models.TenantActivityStatusFROZEN,
+				"not yet supported activity status 'WARM'",
+				"not supported activity status 'FROZEN'",
 			},
 		},
 		{
@@ -313,10 +316,44 @@ func TestUpdateTenants(t *testing.T) {
 			},
 			errMsgs: []string{},
 		},
+		{
+			name:  "FrozenWithOffloadBackend",
+			Class: cls,
+			updateTenants: []*models.Tenant{
+				{Name: tenants[0].Name, ActivityStatus: models.TenantActivityStatusFROZEN},
+				{Name: tenants[1].Name, ActivityStatus: models.TenantActivityStatusCOLD},
+			},
+			initial: &models.Class{
+				Class:              cls,
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties:         properties,
+				ReplicationConfig:  repConfig,
+			},
+			errMsgs: []string{},
+			offload: true,
+		},
+		{
+			name:  "TransitionalActivityStatus",
+			Class: cls,
+			updateTenants: []*models.Tenant{
+				{Name: tenants[0].Name, ActivityStatus: models.TenantActivityStatusFREEZING},
+			},
+			initial: &models.Class{
+				Class:              cls,
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties:         properties,
+				ReplicationConfig:  repConfig,
+			},
+			errMsgs: []string{"invalid activity status 'FREEZING'"},
+			offload: true,
+		},
 	}
 
 	for _, test := range tests {
 		sm := newSchemaManager()
+		if test.offload {
+			sm.config.TenantOffloadBackend = "backup-filesystem"
+		}
 		if err := sm.AddClass(ctx, nil, test.initial); err != nil {
 			t.Fatalf("%s: add class: %v", test.name, err)
 		}
@@ -471,3 +508,145 @@ func TestDeleteTenants(t *testing.T) {
 
 	}
 }
+
+type transitionsMigrator struct {
+	NilMigrator
+	transitions map[string]string
+}
+
+func (m *transitionsMigrator) TenantTransitions(className string) map[string]string {
+	return m.transitions
+}
+
+type failingPrepareMigrator struct {
+	NilMigrator
+	updated bool
+}
+
+func (m *failingPrepareMigrator) PrepareUpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) error {
+	return fmt.Errorf("download failed")
+}
+
+func (m *failingPrepareMigrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) (commit func(success bool), err error) {
+	m.updated = true
+	return func(bool) {}, nil
+}
+
+func TestUpdateTenantsPrepareFails(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	sm.config.TenantOffloadBackend = "backup-filesystem"
+	cls := "C1"
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              cls,
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}))
+	_, err := sm.AddTenants(ctx, nil, cls, []*models.Tenant{{Name: "T1"}})
+	require.Nil(t, err)
+	require.Nil(t, sm.UpdateTenants(ctx, nil, cls, []*models.Tenant{
+		{Name: "T1", ActivityStatus: models.TenantActivityStatusFROZEN},
+	}))
+
+	migrator := &failingPrepareMigrator{}
+	sm.migrator = migrator
+
+	t.Run("unfreeze", func(t *testing.T) {
+		err := sm.UpdateTenants(ctx, nil, cls, []*models.Tenant{
+			{Name: "T1", ActivityStatus: models.TenantActivityStatusHOT},
+		})
+		assert.ErrorContains(t, err, "download failed")
+		assert.False(t, migrator.updated, "nothing is committed")
+		assert.Equal(t, models.TenantActivityStatusFROZEN,
+			sm.schemaCache.ShardingState[cls].Physical["T1"].Status)
+	})
+
+	t.Run("incoming transaction", func(t *testing.T) {
+		_, err := sm.handleTxResponse(ctx, &cluster.Transaction{
+			Type: updateTenants,
+			Payload: UpdateTenantsPayload{
+				Class:   cls,
+				Tenants: []TenantUpdate{{Name: "T1", Status: models.TenantActivityStatusHOT}},
+			},
+		})
+		assert.ErrorContains(t, err, "download failed", "the transaction is not opened")
+	})
+}
+
+func TestGetTenantsTransitions(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	sm.migrator = &transitionsMigrator{transitions: map[string]string{
+		"USER1": models.TenantActivityStatusFREEZING,
+	}}
+
+	class := &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}
+	require.Nil(t, sm.AddClass(ctx, nil, class))
+	_, err := sm.AddTenants(ctx, nil, "C1", []*models.Tenant{{Name: "USER1"}, {Name: "USER2"}})
+	require.Nil(t, err)
+
+	tenants, err := sm.GetTenants(ctx, nil, "C1")
+	require.Nil(t, err)
+	statuses := make(map[string]string, len(tenants))
+	for _, tenant := range tenants {
+		statuses[tenant.Name] = tenant.ActivityStatus
+	}
+	assert.Equal(t, map[string]string{
+		"USER1": models.TenantActivityStatusFREEZING,
+		"USER2": models.TenantActivityStatusHOT,
+	}, statuses)
+}
+
+func TestActivateAndDeactivateTenants(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Manual",
+		MultiTenancyConfig: &models.MultiTenancyConfig{
+			Enabled: true,
+		},
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+	}))
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Auto",
+		MultiTenancyConfig: &models.MultiTenancyConfig{
+			Enabled: true, AutoTenantActivation: true,
+		},
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+	}))
+	for _, class := range []string{"Manual", "Auto"} {
+		_, err := sm.AddTenants(ctx, nil, class, []*models.Tenant{
+			{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD},
+		})
+		require.Nil(t, err)
+	}
+
+	t.Run("automatic activation disabled", func(t *testing.T) {
+		assert.ErrorContains(t, sm.ActivateTenant(ctx, "Manual", "USER1"), "not enabled")
+		assert.ErrorContains(t, sm.DeactivateTenants(ctx, "Manual", []string{"USER1"}), "not enabled")
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		assert.ErrorContains(t, sm.ActivateTenant(ctx, "Auto", "USER2"), "not found")
+	})
+
+	t.Run("activate", func(t *testing.T) {
+		require.Nil(t, sm.ActivateTenant(ctx, "Auto", "USER1"))
+		_, status := sm.TenantShard("Auto", "USER1")
+		assert.Equal(t, models.TenantActivityStatusHOT, status)
+
+		// activating a HOT tenant is a no-op
+		require.Nil(t, sm.ActivateTenant(ctx, "Auto", "USER1"))
+	})
+
+	t.Run("deactivate", func(t *testing.T) {
+		require.Nil(t, sm.DeactivateTenants(ctx, "Auto", []string{"USER1", "USER2"}))
+		_, status := sm.TenantShard("Auto", "USER1")
+		assert.Equal(t, models.TenantActivityStatusCOLD, status)
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -29,7 +30,7 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	m.Lock()
 	defer m.Unlock()
 
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	err := m.Authorizer.Authorize(principal, "update", authorization.Collections(className))
 	if err != nil {
 		return err
 	}
@@ -42,6 +43,9 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return err
 	}
+	if err := validateMultiTenancyConfig(updated.MultiTenancyConfig); err != nil {
+		return err
+	}
 
 	// make sure unset optionals on 'updated' don't lead to an error, as all
 	// optionals would have been set with defaults on the initial already
@@ -65,6 +69,14 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	for name, cfg := range updated.VectorConfig {
+		if err := m.migrator.ValidateVectorIndexConfigUpdate(ctx,
+			initial.VectorConfig[name].VectorIndexConfig.(schema.VectorIndexConfig),
+			cfg.VectorIndexConfig.(schema.VectorIndexConfig)); err != nil {
+			return errors.Wrapf(err, "vector config %s", name)
+		}
+	}
+
 	if err := m.migrator.ValidateInvertedIndexConfigUpdate(ctx,
 		initial.InvertedIndexConfig, updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
@@ -88,6 +100,18 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 				initialRF, updatedRF)
 		}
 		updatedState = uss
+	} else if initialCount := initial.ShardingConfig.(sharding.Config).DesiredCount; !mtEnabled &&
+		initialCount != updatedSharding.DesiredCount {
+		uss, err := m.scaleOut.Scale(ctx, className, updatedSharding, initialRF, updatedRF)
+		if err != nil {
+			return errors.Wrapf(err, "reshard from %d to %d shards",
+				initialCount, updatedSharding.DesiredCount)
+		}
+		if uss != nil {
+			// the number of virtual shards doesn't change
+			updated.ShardingConfig = uss.Config
+		}
+		updatedState = uss
 	}
 
 	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
@@ -106,6 +130,46 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	return m.updateClassApplyChanges(ctx, className, updated, updatedState)
 }
 
+// MoveReplicas moves shard replicas of a class to other nodes. The replicas
+// are copied to their new nodes before the updated sharding state is
+// broadcast, after which the nodes being left drop their replicas.
+func (m *Manager) MoveReplicas(ctx context.Context, principal *models.Principal,
+	className string, moves []sharding.ReplicaMove,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	err := m.Authorizer.Authorize(principal, "update", authorization.Collections(className))
+	if err != nil {
+		return err
+	}
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return ErrNotFound
+	}
+	if len(moves) == 0 {
+		return nil
+	}
+
+	updatedState, err := m.scaleOut.Move(ctx, className, moves)
+	if err != nil {
+		return errors.Wrap(err, "move replicas")
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, class, updatedState}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.updateClassApplyChanges(ctx, className, class, updatedState)
+}
+
 // validateUpdatingMT validates toggling MT and returns whether mt is enabled
 func validateUpdatingMT(current, update *models.Class) (enabled bool, err error) {
 	enabled = schema.MultiTenancyEnabled(current)
@@ -145,7 +209,17 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		// explicitly now.
 		updatedShardingState.SetLocalName(m.clusterState.LocalName())
 	}
-	if err := m.migrator.UpdateVectorIndexConfig(ctx,
+	if schema.NamedVectorsEnabled(updated) {
+		// the class-level vector index is not used by classes with named
+		// vectors, only the per-vector indexes need to be updated
+		configs := make(map[string]schema.VectorIndexConfig, len(updated.VectorConfig))
+		for name, cfg := range updated.VectorConfig {
+			configs[name] = cfg.VectorIndexConfig.(schema.VectorIndexConfig)
+		}
+		if err := m.migrator.UpdateVectorIndexConfigs(ctx, className, configs); err != nil {
+			return errors.Wrap(err, "vector config")
+		}
+	} else if err := m.migrator.UpdateVectorIndexConfig(ctx,
 		className, updated.VectorIndexConfig.(schema.VectorIndexConfig)); err != nil {
 		return errors.Wrap(err, "vector index config")
 	}
@@ -174,6 +248,13 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	if err := m.repo.UpdateClass(ctx, payload); err != nil {
 		return err
 	}
+	if updatedShardingState != nil {
+		// move objects to their new shards and drop shards which are not
+		// owned by this node anymore
+		if err := m.migrator.UpdateShardingState(ctx, className, updatedShardingState); err != nil {
+			return errors.Wrap(err, "sharding state")
+		}
+	}
 	m.triggerSchemaUpdateCallbacks()
 
 	return nil
@@ -212,6 +293,36 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		return errors.Errorf("module config is immutable")
 	}
 
+	if err := validateImmutableNamedVectors(initial, updated); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateImmutableNamedVectors(initial, updated *models.Class) error {
+	if len(initial.VectorConfig) != len(updated.VectorConfig) {
+		return errors.Errorf("named vectors cannot be added or removed by updating the class")
+	}
+
+	for name, initialCfg := range initial.VectorConfig {
+		updatedCfg, ok := updated.VectorConfig[name]
+		if !ok {
+			return errors.Errorf("named vectors cannot be added or removed by updating the class: "+
+				"missing vector %q", name)
+		}
+
+		if initialCfg.VectorIndexType != updatedCfg.VectorIndexType {
+			return errors.Errorf("vector index type of vector %q is immutable: "+
+				"attempted change from %q to %q",
+				name, initialCfg.VectorIndexType, updatedCfg.VectorIndexType)
+		}
+
+		if !reflect.DeepEqual(initialCfg.Vectorizer, updatedCfg.Vectorizer) {
+			return errors.Errorf("vectorizer config of vector %q is immutable", name)
+		}
+	}
+
 	return nil
 }
 
@@ -237,7 +348,7 @@ func (m *Manager) UpdateShardStatus(ctx context.Context, principal *models.Princ
 	className, shardName, targetStatus string,
 ) error {
 	err := m.Authorizer.Authorize(principal, "update",
-		fmt.Sprintf("schema/%s/shards/%s", className, shardName))
+		authorization.Shards(className, shardName))
 	if err != nil {
 		return err
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 // As of now, most class settings are immutable, but we need to allow some
@@ -348,6 +349,54 @@ func TestClassUpdates(t *testing.T) {
 				assert.Equal(t, expectedVectorIndexConfig, class.VectorIndexConfig)
 			})
 		})
+
+		t.Run("with named vectors", func(t *testing.T) {
+			sm := newSchemaManager()
+			migrator := &configMigrator{}
+			sm.migrator = migrator
+
+			t.Run("create an initial class", func(t *testing.T) {
+				err := sm.AddClass(context.Background(), nil, &models.Class{
+					Class: "ClassWithNamedVectors",
+					VectorConfig: map[string]models.VectorConfig{
+						"title": {
+							VectorIndexConfig: map[string]interface{}{
+								"setting-1": "value-1",
+							},
+						},
+					},
+				})
+
+				assert.Nil(t, err)
+			})
+
+			t.Run("update the named vector index config", func(t *testing.T) {
+				err := sm.UpdateClass(context.Background(), nil,
+					"ClassWithNamedVectors", &models.Class{
+						Class: "ClassWithNamedVectors",
+						VectorConfig: map[string]models.VectorConfig{
+							"title": {
+								VectorIndexConfig: map[string]interface{}{
+									"setting-1": "updated-value",
+								},
+							},
+						},
+					})
+				expectedUpdateCalledWith := map[string]schema.VectorIndexConfig{
+					"title": fakeVectorConfig{
+						raw: map[string]interface{}{
+							"distance":  "cosine",
+							"setting-1": "updated-value",
+						},
+					},
+				}
+
+				require.Nil(t, err)
+				assert.False(t, migrator.vectorConfigUpdateCalled)
+				assert.True(t, migrator.vectorConfigsUpdateCalled)
+				assert.Equal(t, expectedUpdateCalledWith, migrator.vectorConfigsUpdateCalledWith)
+			})
+		})
 	})
 
 	t.Run("update sharding config", func(t *testing.T) {
@@ -369,14 +418,49 @@ func TestClassUpdates(t *testing.T) {
 					"ClassWithShardingConfig", &models.Class{
 						Class: "ClassWithShardingConfig",
 						ShardingConfig: map[string]interface{}{
-							"desiredCount": json.Number("7"),
+							"desiredCount": json.Number("129"),
 						},
 					})
-				expectedErrMsg := "re-sharding not supported yet: shard count is immutable: attempted change from \"1\" to \"7\""
+				expectedErrMsg := "shard count cannot exceed the number of virtual shards 128: attempted change from \"1\" to \"129\""
 				require.NotNil(t, err)
 				assert.Contains(t, err.Error(), expectedErrMsg)
 			})
 		})
+
+		t.Run("changing the shard count", func(t *testing.T) {
+			sm := newSchemaManager()
+			sm.migrator = &NilMigrator{}
+
+			require.Nil(t, sm.AddClass(context.Background(), nil, &models.Class{
+				Class: "ClassToReshard",
+			}))
+			err := sm.UpdateClass(context.Background(), nil,
+				"ClassToReshard", &models.Class{
+					Class: "ClassToReshard",
+					ShardingConfig: map[string]interface{}{
+						"desiredCount": json.Number("2"),
+					},
+				})
+			assert.Nil(t, err)
+		})
+	})
+}
+
+func TestMoveReplicas(t *testing.T) {
+	ctx := context.Background()
+	moves := []sharding.ReplicaMove{{Shard: "S1", From: "node1", To: "node2"}}
+
+	t.Run("class does not exist", func(t *testing.T) {
+		sm := newSchemaManager()
+		err := sm.MoveReplicas(ctx, nil, "UnknownClass", moves)
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("replicas are moved", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "ClassToMove"}))
+		assert.Nil(t, sm.MoveReplicas(ctx, nil, "ClassToMove", moves))
+		assert.NotNil(t, sm.getClassByName("ClassToMove"))
 	})
 }
 
@@ -386,6 +470,8 @@ type configMigrator struct {
 	vectorConfigValidateCalledWith schema.VectorIndexConfig
 	vectorConfigUpdateCalled       bool
 	vectorConfigUpdateCalledWith   schema.VectorIndexConfig
+	vectorConfigsUpdateCalled      bool
+	vectorConfigsUpdateCalledWith  map[string]schema.VectorIndexConfig
 }
 
 func (m *configMigrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
@@ -402,3 +488,11 @@ func (m *configMigrator) UpdateVectorIndexConfig(ctx context.Context,
 	m.vectorConfigUpdateCalled = true
 	return nil
 }
+
+func (m *configMigrator) UpdateVectorIndexConfigs(ctx context.Context,
+	className string, updated map[string]schema.VectorIndexConfig,
+) error {
+	m.vectorConfigsUpdateCalledWith = updated
+	m.vectorConfigsUpdateCalled = true
+	return nil
+}
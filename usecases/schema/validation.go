@@ -17,6 +17,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -78,12 +79,45 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 		return fmt.Errorf("Tokenization '%s' is not allowed for data type '%s'", tokenization, primitiveDataType)
 	}
 
+	if propertyDataType.IsNested() {
+		if tokenization == "" {
+			return nil
+		}
+		return fmt.Errorf("Tokenization is not allowed for data type '%s'", propertyDataType.AsNested())
+	}
+
 	if tokenization == "" {
 		return nil
 	}
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
+func validatePropertyTextAnalyzer(prop *models.Property) error {
+	cfg := prop.TextAnalyzer
+	if cfg == nil {
+		return nil
+	}
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeString, schema.DataTypeStringArray:
+	default:
+		return fmt.Errorf("property '%s': textAnalyzer is allowed only for text/text[] data types", prop.Name)
+	}
+	if prop.Tokenization == models.PropertyTokenizationField {
+		return fmt.Errorf("property '%s': textAnalyzer is not allowed for tokenization '%s'",
+			prop.Name, prop.Tokenization)
+	}
+	if err := cfg.Validate(nil); err != nil {
+		return fmt.Errorf("property '%s': textAnalyzer: %w", prop.Name, err)
+	}
+	if cfg.StopwordPreset != "" {
+		if _, ok := stopwords.Presets[cfg.StopwordPreset]; !ok {
+			return fmt.Errorf("property '%s': stopwordPreset '%s' does not exist",
+				prop.Name, cfg.StopwordPreset)
+		}
+	}
+	return nil
+}
+
 func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 	if prop.IndexInverted != nil {
 		if prop.IndexFilterable != nil || prop.IndexSearchable != nil {
@@ -91,6 +125,14 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 		}
 	}
 
+	if _, isNested := schema.AsNested(prop.DataType); isNested {
+		indexFilterable := prop.IndexFilterable
+		if prop.IndexInverted != nil {
+			indexFilterable = prop.IndexInverted
+		}
+		return validateNestedPropertyIndexing(prop.Name, indexFilterable, prop.IndexSearchable)
+	}
+
 	if prop.IndexSearchable != nil {
 		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
 		case schema.DataTypeString, schema.DataTypeStringArray:
@@ -119,6 +161,44 @@ func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Clas
 		return err
 	}
 
+	if err := m.validateNamedVectors(ctx, class); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Manager) validateNamedVectors(ctx context.Context, class *models.Class) error {
+	if !schema.NamedVectorsEnabled(class) {
+		return nil
+	}
+
+	if class.Vectorizer != config.VectorizerModuleNone {
+		return errors.Errorf("class.vectorizer %q can not be set if class.vectorConfig is configured",
+			class.Vectorizer)
+	}
+
+	for name, cfg := range class.VectorConfig {
+		if err := schema.ValidateNamedVectorName(name); err != nil {
+			return err
+		}
+
+		vectorizer, _, err := schema.NamedVectorizer(cfg)
+		if err != nil {
+			return errors.Wrapf(err, "vector config %s", name)
+		}
+		if vectorizer != config.VectorizerModuleNone {
+			if err := m.vectorizerValidator.ValidateVectorizer(vectorizer); err != nil {
+				return errors.Wrapf(err, "vector config %s: vectorizer", name)
+			}
+		}
+
+		if !isSupportedVectorIndexType(cfg.VectorIndexType) {
+			return errors.Errorf("vector config %s: unrecognized or unsupported vectorIndexType %q",
+				name, cfg.VectorIndexType)
+		}
+	}
+
 	return nil
 }
 
@@ -135,11 +215,10 @@ func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) e
 }
 
 func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class) error {
-	switch class.VectorIndexType {
-	case "hnsw":
-		return nil
-	default:
+	if !isSupportedVectorIndexType(class.VectorIndexType) {
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
 			class.VectorIndexType)
 	}
+
+	return nil
 }
@@ -432,6 +432,86 @@ func Test_Validation_PropertyTokenization(t *testing.T) {
 	})
 }
 
+func Test_Validation_PropertyTextAnalyzer(t *testing.T) {
+	type testCase struct {
+		name           string
+		prop           *models.Property
+		expectedErrMsg string
+	}
+
+	testCases := []testCase{
+		{
+			name: "no text analyzer",
+			prop: &models.Property{Name: "prop", DataType: schema.DataTypeInt.PropString()},
+		},
+		{
+			name: "text with language and stopword preset",
+			prop: &models.Property{
+				Name: "prop", DataType: schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{Language: "en", ASCIIFold: true, StopwordPreset: "de"},
+			},
+		},
+		{
+			name: "text[] with cjk language",
+			prop: &models.Property{
+				Name: "prop", DataType: schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationLowercase,
+				TextAnalyzer: &models.TextAnalyzerConfig{Language: "ja"},
+			},
+		},
+		{
+			name: "int",
+			prop: &models.Property{
+				Name: "prop", DataType: schema.DataTypeInt.PropString(),
+				TextAnalyzer: &models.TextAnalyzerConfig{ASCIIFold: true},
+			},
+			expectedErrMsg: "property 'prop': textAnalyzer is allowed only for text/text[] data types",
+		},
+		{
+			name: "field tokenization",
+			prop: &models.Property{
+				Name: "prop", DataType: schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+				TextAnalyzer: &models.TextAnalyzerConfig{ASCIIFold: true},
+			},
+			expectedErrMsg: "property 'prop': textAnalyzer is not allowed for tokenization 'field'",
+		},
+		{
+			name: "unknown stopword preset",
+			prop: &models.Property{
+				Name: "prop", DataType: schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{StopwordPreset: "xx"},
+			},
+			expectedErrMsg: "property 'prop': stopwordPreset 'xx' does not exist",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePropertyTextAnalyzer(tc.prop)
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			}
+		})
+	}
+
+	t.Run("unknown or unsupported language", func(t *testing.T) {
+		for _, language := range []string{"xx", "de", "fr", "es", "it"} {
+			err := validatePropertyTextAnalyzer(&models.Property{
+				Name: "prop", DataType: schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{Language: language},
+			})
+			require.NotNil(t, err, language)
+			assert.Contains(t, err.Error(), "property 'prop': textAnalyzer:")
+		}
+	})
+}
+
 func Test_Validation_PropertyIndexing(t *testing.T) {
 	t.Run("validates indexInverted / indexFilterable / indexSearchable combinations", func(t *testing.T) {
 		vFalse := false
@@ -529,6 +609,109 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 	})
 }
 
+func Test_Validation_NestedProperties(t *testing.T) {
+	vTrue := true
+
+	type testCase struct {
+		name           string
+		nestedProps    []*models.NestedProperty
+		expectedErrMsg string
+	}
+
+	testCases := []testCase{
+		{
+			name: "valid nested properties",
+			nestedProps: []*models.NestedProperty{
+				{Name: "color", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+				{Name: "sizes", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationWord},
+				{
+					Name:     "price",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "amount", DataType: schema.DataTypeNumber.PropString()},
+					},
+				},
+			},
+		},
+		{
+			name:           "no nested properties",
+			nestedProps:    nil,
+			expectedErrMsg: "property 'variant': at least one nested property is required for data types object and object[]",
+		},
+		{
+			name: "invalid nested property name",
+			nestedProps: []*models.NestedProperty{
+				{Name: "color-code", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+			},
+			expectedErrMsg: "property 'variant': 'color-code' is not a valid property name. Property names in Weaviate are restricted to valid GraphQL names, which must be “/[_A-Za-z][_0-9A-Za-z]*/”.",
+		},
+		{
+			name: "duplicate nested property names",
+			nestedProps: []*models.NestedProperty{
+				{Name: "stock", DataType: schema.DataTypeInt.PropString()},
+				{Name: "Stock", DataType: schema.DataTypeInt.PropString()},
+			},
+			expectedErrMsg: "property 'variant.Stock': already in use or provided multiple times",
+		},
+		{
+			name: "cross-reference is not a nested data type",
+			nestedProps: []*models.NestedProperty{
+				{Name: "ref", DataType: []string{"SomeClass"}},
+			},
+			expectedErrMsg: "property 'variant.ref': invalid dataType [SomeClass], nested properties support only the data types " +
+				"[text int number boolean date uuid blob text[] int[] number[] boolean[] date[] uuid[] object object[]]",
+		},
+		{
+			name: "nested object without nested properties",
+			nestedProps: []*models.NestedProperty{
+				{Name: "price", DataType: schema.DataTypeObject.PropString()},
+			},
+			expectedErrMsg: "property 'variant.price': at least one nested property is required for data types object and object[]",
+		},
+		{
+			name: "nested properties on primitive data type",
+			nestedProps: []*models.NestedProperty{
+				{
+					Name:     "stock",
+					DataType: schema.DataTypeInt.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "amount", DataType: schema.DataTypeInt.PropString()},
+					},
+				},
+			},
+			expectedErrMsg: "property 'variant.stock': nestedProperties are only allowed for data types object and object[]",
+		},
+		{
+			name: "tokenization on non-text data type",
+			nestedProps: []*models.NestedProperty{
+				{Name: "stock", DataType: schema.DataTypeInt.PropString(), Tokenization: models.PropertyTokenizationWord},
+			},
+			expectedErrMsg: "property 'variant.stock': Tokenization is not allowed for data type 'int'",
+		},
+		{
+			name: "filterable nested property",
+			nestedProps: []*models.NestedProperty{
+				{Name: "stock", DataType: schema.DataTypeInt.PropString(), IndexFilterable: &vTrue},
+			},
+			expectedErrMsg: "property 'variant.stock': `indexFilterable` is not supported for object data types " +
+				"and their nested properties yet. Set false or leave empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateNestedProperties("variant", tc.nestedProps)
+
+			if tc.expectedErrMsg != "" {
+				require.NotNil(t, err)
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
 type fakePropertyDataType struct {
 	primitiveDataType schema.DataType
 }
@@ -566,3 +749,11 @@ func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 func (pdt *fakePropertyDataType) ContainsClass(name schema.ClassName) bool {
 	return false
 }
+
+func (pdt *fakePropertyDataType) IsNested() bool {
+	return false
+}
+
+func (pdt *fakePropertyDataType) AsNested() schema.DataType {
+	return ""
+}
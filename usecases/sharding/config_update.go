@@ -21,9 +21,17 @@ type nodeCounter interface {
 
 func ValidateConfigUpdate(old, updated Config, nodeCounter nodeCounter) error {
 	if old.DesiredCount != updated.DesiredCount {
-		return fmt.Errorf("re-sharding not supported yet: shard count is immutable: "+
-			"attempted change from \"%d\" to \"%d\"", old.DesiredCount,
-			updated.DesiredCount)
+		// re-sharding only reassigns the existing virtual shards
+		if updated.DesiredCount < 1 {
+			return fmt.Errorf("shard count must be positive: "+
+				"attempted change from \"%d\" to \"%d\"", old.DesiredCount,
+				updated.DesiredCount)
+		}
+		if updated.DesiredCount > old.ActualVirtualCount {
+			return fmt.Errorf("shard count cannot exceed the number of virtual shards %d: "+
+				"attempted change from \"%d\" to \"%d\"", old.ActualVirtualCount,
+				old.DesiredCount, updated.DesiredCount)
+		}
 	}
 
 	if old.VirtualPerPhysical != updated.VirtualPerPhysical {
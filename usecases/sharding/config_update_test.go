@@ -30,15 +30,28 @@ func TestConfigUpdates(t *testing.T) {
 
 		tests := []test{
 			{
-				name:    "attempting to shard count",
-				initial: Config{DesiredCount: 7},
+				name:    "changing shard count",
+				initial: Config{DesiredCount: 7, ActualVirtualCount: 896},
 				update:  Config{DesiredCount: 8},
+			},
+			{
+				name:    "attempting to remove all shards",
+				initial: Config{DesiredCount: 7, ActualVirtualCount: 896},
+				update:  Config{DesiredCount: 0},
+				expectedError: fmt.Errorf(
+					"shard count must be positive: " +
+						"attempted change from \"7\" to \"0\""),
+			},
+			{
+				name:    "attempting to exceed virtual shards",
+				initial: Config{DesiredCount: 1, ActualVirtualCount: 128},
+				update:  Config{DesiredCount: 129},
 				expectedError: fmt.Errorf(
-					"re-sharding not supported yet: shard count is immutable: " +
-						"attempted change from \"7\" to \"8\""),
+					"shard count cannot exceed the number of virtual shards 128: " +
+						"attempted change from \"1\" to \"129\""),
 			},
 			{
-				name:    "attempting to shard count",
+				name:    "attempting to change virtual shards per physical",
 				initial: Config{VirtualPerPhysical: 128},
 				update:  Config{VirtualPerPhysical: 256},
 				expectedError: fmt.Errorf(
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -76,7 +77,7 @@ type RemoteIndexClient interface {
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []float32, limit int, filters *filters.LocalFilter,
+		searchVector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties,
@@ -90,6 +91,8 @@ type RemoteIndexClient interface {
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 		targetStatus string) error
+	TenantsLastUsed(ctx context.Context, hostName, indexName string,
+		shardNames []string) (map[string]time.Time, error)
 
 	PutFile(ctx context.Context, hostName, indexName, shardName, fileName string,
 		payload io.ReadSeekCloser) error
@@ -240,6 +243,7 @@ func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	queryVec []float32,
+	targetVector string,
 	limit int,
 	filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking,
@@ -255,7 +259,7 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	}
 	f := func(node, host string) (interface{}, error) {
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
-			queryVec, limit, filters, keywordRanking, sort, cursor, groupBy, adds)
+			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds)
 		if err != nil {
 			return nil, err
 		}
@@ -350,6 +354,19 @@ func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName, targetS
 	return ri.client.UpdateShardStatus(ctx, host, ri.class, shardName, targetStatus)
 }
 
+// TenantsLastUsed returns when the tenant shards were used last on node.
+// Shards which are not loaded on node are omitted.
+func (ri *RemoteIndex) TenantsLastUsed(ctx context.Context, node string,
+	shardNames []string,
+) (map[string]time.Time, error) {
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", node)
+	}
+
+	return ri.client.TenantsLastUsed(ctx, host, ri.class, shardNames)
+}
+
 func (ri *RemoteIndex) queryReplicas(
 	ctx context.Context,
 	shard string,
@@ -0,0 +1,318 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ShardSplit describes a new physical shard which starts as a copy of an
+// existing shard (From) and takes over part of its virtual shards
+type ShardSplit struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ShardMerge describes a physical shard (From) whose virtual shards have been
+// taken over by another shard (Into). The objects of From need to be moved
+// into Into before From can be dropped.
+type ShardMerge struct {
+	From string `json:"from"`
+	Into string `json:"into"`
+}
+
+// ReplicaMove describes moving the replica of a physical shard from one node
+// to another node
+type ReplicaMove struct {
+	Shard string `json:"shard"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Reshard changes the number of physical shards to count by reassigning
+// virtual shards. The number of virtual shards never changes, which is why
+// count cannot exceed it.
+//
+// A new shard is split off the shard owning the most virtual shards and is
+// placed on the same nodes. A shard is merged into the remaining shard owning
+// the fewest virtual shards, which keeps its nodes.
+func (s *State) Reshard(count int) (splits []ShardSplit, merges []ShardMerge, err error) {
+	if s.PartitioningEnabled {
+		return nil, nil, fmt.Errorf("resharding is not supported with multi-tenancy")
+	}
+	if count < 1 {
+		return nil, nil, fmt.Errorf("shard count must be positive, got %d", count)
+	}
+	if n := len(s.Virtual); count > n {
+		return nil, nil, fmt.Errorf("shard count %d exceeds number of virtual shards %d", count, n)
+	}
+	s.MigrateFromOldFormat()
+
+	// origin of new shards, since a new shard can be split again
+	origin := map[string]string{}
+	for len(s.Physical) < count {
+		from := s.physicalBySize(true)
+		source := s.Physical[from]
+		if len(source.OwnsVirtual) < 2 {
+			return nil, nil, fmt.Errorf("shard %q owns too few virtual shards to be split", from)
+		}
+		name := s.uniqueShardName()
+		half := len(source.OwnsVirtual) / 2
+		moved := source.OwnsVirtual[half:]
+		source.OwnsVirtual = source.OwnsVirtual[:half]
+		s.Physical[from] = source
+		s.Physical[name] = Physical{
+			Name:           name,
+			BelongsToNodes: append([]string{}, source.BelongsToNodes...),
+			Status:         source.Status,
+		}
+		s.assignVirtual(name, moved)
+
+		if o, ok := origin[from]; ok {
+			from = o
+		}
+		origin[name] = from
+	}
+	for _, name := range sortedKeys(origin) {
+		splits = append(splits, ShardSplit{From: origin[name], To: name})
+	}
+
+	for len(s.Physical) > count {
+		from := s.physicalBySize(false)
+		vs := s.Physical[from].OwnsVirtual
+		delete(s.Physical, from)
+		into := s.physicalBySize(false)
+		s.assignVirtual(into, vs)
+
+		// shards merged into the removed shard move on to the new target
+		for i := range merges {
+			if merges[i].Into == from {
+				merges[i].Into = into
+			}
+		}
+		merges = append(merges, ShardMerge{From: from, Into: into})
+	}
+
+	s.updatePercentages()
+	s.Config.DesiredCount = count
+	s.Config.ActualCount = count
+	return splits, merges, nil
+}
+
+// ShrinkReplicas reduces the number of replicas of each shard to count.
+// Replicas on the nodes in drain are removed first, followed by the replicas
+// on the nodes which hold the most shards.
+func (s *State) ShrinkReplicas(count int, drain []string) error {
+	if count < 1 {
+		return fmt.Errorf("replication factor must be positive, got %d", count)
+	}
+	s.MigrateFromOldFormat()
+	draining := make(map[string]bool, len(drain))
+	for _, node := range drain {
+		draining[node] = true
+	}
+	load := s.replicaLoad()
+	for _, name := range s.physicalNames() {
+		p := s.Physical[name]
+		for len(p.BelongsToNodes) > count {
+			i := 0
+			for j, node := range p.BelongsToNodes {
+				a, b := p.BelongsToNodes[i], node
+				if draining[a] != draining[b] {
+					if draining[b] {
+						i = j
+					}
+					continue
+				}
+				if load[b] > load[a] {
+					i = j
+				}
+			}
+			load[p.BelongsToNodes[i]]--
+			p.BelongsToNodes = append(p.BelongsToNodes[:i:i], p.BelongsToNodes[i+1:]...)
+		}
+		s.Physical[name] = p
+	}
+	return nil
+}
+
+// MoveReplicas replaces the replicas on the nodes in from by replicas on the
+// other node candidates. A replacement is a node which doesn't own the shard
+// yet and holds the fewest shards. The number of replicas is kept.
+func (s *State) MoveReplicas(from []string, nodes nodes) ([]ReplicaMove, error) {
+	s.MigrateFromOldFormat()
+	moving := make(map[string]bool, len(from))
+	for _, node := range from {
+		moving[node] = true
+	}
+	var candidates []string
+	for _, node := range nodes.Candidates() {
+		if !moving[node] {
+			candidates = append(candidates, node)
+		}
+	}
+	sort.Strings(candidates)
+
+	var moves []ReplicaMove
+	load := s.replicaLoad()
+	for _, name := range s.physicalNames() {
+		p := s.Physical[name]
+		for i, node := range p.BelongsToNodes {
+			if !moving[node] {
+				continue
+			}
+			target := ""
+			for _, c := range candidates {
+				if contains(p.BelongsToNodes, c) {
+					continue
+				}
+				if target == "" || load[c] < load[target] {
+					target = c
+				}
+			}
+			if target == "" {
+				return nil, fmt.Errorf("no node left to move replica of shard %q from node %q to", name, node)
+			}
+			load[node]--
+			load[target]++
+			p.BelongsToNodes[i] = target
+			moves = append(moves, ReplicaMove{Shard: name, From: node, To: target})
+		}
+		s.Physical[name] = p
+	}
+	return moves, nil
+}
+
+// ApplyMoves moves shard replicas as described by moves. The replica to be
+// moved must exist and its target node must not own the shard yet.
+func (s *State) ApplyMoves(moves []ReplicaMove) error {
+	s.MigrateFromOldFormat()
+	for _, m := range moves {
+		p, ok := s.Physical[m.Shard]
+		if !ok {
+			return fmt.Errorf("shard %q does not exist", m.Shard)
+		}
+		i := -1
+		for j, node := range p.BelongsToNodes {
+			if node == m.From {
+				i = j
+			}
+		}
+		if i < 0 {
+			return fmt.Errorf("shard %q has no replica on node %q", m.Shard, m.From)
+		}
+		if contains(p.BelongsToNodes, m.To) {
+			return fmt.Errorf("shard %q has a replica on node %q already", m.Shard, m.To)
+		}
+		nodes := append([]string{}, p.BelongsToNodes...)
+		nodes[i] = m.To
+		p.BelongsToNodes = nodes
+		s.Physical[m.Shard] = p
+	}
+	return nil
+}
+
+// ReplicaLoad returns the number of shard replicas per node
+func (s *State) ReplicaLoad() map[string]int {
+	return s.replicaLoad()
+}
+
+// physicalBySize returns the name of the shard owning the most (largest is
+// true) or the fewest virtual shards
+func (s *State) physicalBySize(largest bool) string {
+	picked := ""
+	for _, name := range s.physicalNames() {
+		if picked == "" {
+			picked = name
+			continue
+		}
+		a, b := len(s.Physical[picked].OwnsVirtual), len(s.Physical[name].OwnsVirtual)
+		if (largest && b > a) || (!largest && b < a) {
+			picked = name
+		}
+	}
+	return picked
+}
+
+// assignVirtual assigns virtual shards to the physical shard name
+func (s *State) assignVirtual(name string, virtual []string) {
+	p := s.Physical[name]
+	for _, vname := range virtual {
+		if v := s.virtualByName(vname); v != nil {
+			v.AssignedToPhysical = name
+		}
+	}
+	p.OwnsVirtual = append(append([]string{}, p.OwnsVirtual...), virtual...)
+	sort.Strings(p.OwnsVirtual)
+	s.Physical[name] = p
+}
+
+func (s *State) updatePercentages() {
+	for name, p := range s.Physical {
+		p.OwnsPercentage = 0
+		for _, vname := range p.OwnsVirtual {
+			if v := s.virtualByName(vname); v != nil {
+				p.OwnsPercentage += v.OwnsPercentage
+			}
+		}
+		s.Physical[name] = p
+	}
+}
+
+// replicaLoad returns the number of shard replicas per node
+func (s *State) replicaLoad() map[string]int {
+	load := map[string]int{}
+	for _, p := range s.Physical {
+		for _, node := range p.BelongsToNodes {
+			load[node]++
+		}
+	}
+	return load
+}
+
+// physicalNames returns the sorted names of the physical shards
+func (s *State) physicalNames() []string {
+	names := make([]string, 0, len(s.Physical))
+	for name := range s.Physical {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *State) uniqueShardName() string {
+	for {
+		name := generateShardName()
+		if _, ok := s.Physical[name]; !ok {
+			return name
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
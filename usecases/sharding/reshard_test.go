@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spaolacci/murmur3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReshard(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{
+		"desiredCount": float64(2), "virtualPerPhysical": float64(8),
+	}, 3)
+	require.Nil(t, err)
+	s, err := InitState("my-index", cfg, fakeNodes{[]string{"A", "B", "C"}}, 2, false)
+	require.Nil(t, err)
+
+	// assertConsistent verifies that every virtual shard is owned by exactly
+	// one physical shard and that objects only map to existing shards
+	assertConsistent := func(t *testing.T, state *State, count int) {
+		require.Len(t, state.Physical, count)
+		assert.Equal(t, count, state.Config.DesiredCount)
+		assert.Equal(t, 16, state.Config.ActualVirtualCount)
+		owned := 0
+		total := 0.0
+		for name, p := range state.Physical {
+			for _, vname := range p.OwnsVirtual {
+				assert.Equal(t, name, state.virtualByName(vname).AssignedToPhysical)
+			}
+			owned += len(p.OwnsVirtual)
+			total += p.OwnsPercentage
+		}
+		assert.Equal(t, 16, owned)
+		assert.InDelta(t, 1.0, total, 1e-9)
+		for _, id := range []string{"a", "b", "c", "d", "e"} {
+			_, ok := state.Physical[state.PhysicalShard([]byte(id))]
+			assert.True(t, ok)
+		}
+	}
+
+	t.Run("Split", func(t *testing.T) {
+		state := s.DeepCopy()
+		splits, merges, err := state.Reshard(5)
+		require.Nil(t, err)
+		assert.Empty(t, merges)
+		require.Len(t, splits, 3)
+		assertConsistent(t, &state, 5)
+		for _, split := range splits {
+			_, existed := s.Physical[split.From]
+			assert.True(t, existed, "new shards are copies of existing shards")
+			assert.Equal(t, s.Physical[split.From].BelongsToNodes, state.Physical[split.To].BelongsToNodes)
+			for _, vname := range state.Physical[split.To].OwnsVirtual {
+				assert.Equal(t, split.From, s.virtualByName(vname).AssignedToPhysical)
+			}
+		}
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		state := s.DeepCopy()
+		splits, _, err := state.Reshard(4)
+		require.Nil(t, err)
+		require.Len(t, splits, 2)
+		before := state.DeepCopy()
+
+		splits, merges, err := state.Reshard(1)
+		require.Nil(t, err)
+		assert.Empty(t, splits)
+		require.Len(t, merges, 3)
+		assertConsistent(t, &state, 1)
+		for _, m := range merges {
+			_, ok := state.Physical[m.Into]
+			assert.True(t, ok, "shards are merged into remaining shards")
+			assert.Equal(t, before.Physical[m.Into].BelongsToNodes, state.Physical[m.Into].BelongsToNodes)
+		}
+	})
+
+	t.Run("Same", func(t *testing.T) {
+		state := s.DeepCopy()
+		splits, merges, err := state.Reshard(2)
+		require.Nil(t, err)
+		assert.Empty(t, splits)
+		assert.Empty(t, merges)
+		assert.Equal(t, s.Physical, state.Physical)
+	})
+
+	t.Run("TooManyShards", func(t *testing.T) {
+		state := s.DeepCopy()
+		_, _, err := state.Reshard(17)
+		assert.ErrorContains(t, err, "exceeds number of virtual shards")
+	})
+
+	t.Run("MultiTenancy", func(t *testing.T) {
+		state := State{PartitioningEnabled: true}
+		_, _, err := state.Reshard(2)
+		assert.ErrorContains(t, err, "multi-tenancy")
+	})
+}
+
+func TestReshardMovesTokens(t *testing.T) {
+	// with a single virtual shard per physical shard, a split must move
+	// exactly the tokens of the moved virtual shard
+	s := State{
+		Config: Config{DesiredCount: 1, ActualVirtualCount: 2},
+		Physical: map[string]Physical{
+			"P": {Name: "P", OwnsVirtual: []string{"v1", "v2"}, BelongsToNodes: []string{"A"}},
+		},
+		Virtual: []Virtual{
+			{Name: "v1", Upper: math.MaxUint64 / 2, OwnsPercentage: 0.5, AssignedToPhysical: "P"},
+			{Name: "v2", Upper: math.MaxUint64, OwnsPercentage: 0.5, AssignedToPhysical: "P"},
+		},
+	}
+	splits, _, err := s.Reshard(2)
+	require.Nil(t, err)
+	require.Len(t, splits, 1)
+	for _, id := range []string{"a", "b", "c", "d"} {
+		h := murmur3.New64()
+		h.Write([]byte(id))
+		want := "P"
+		if h.Sum64() > math.MaxUint64/2 {
+			want = splits[0].To
+		}
+		assert.Equal(t, want, s.PhysicalShard([]byte(id)), id)
+	}
+}
+
+func TestShrinkReplicas(t *testing.T) {
+	state := func() State {
+		return State{Physical: map[string]Physical{
+			"S1": {Name: "S1", BelongsToNodes: []string{"A", "B", "C"}},
+			"S2": {Name: "S2", BelongsToNodes: []string{"B", "C", "D"}},
+			"S3": {Name: "S3", BelongsToNodes: []string{"C", "D", "A"}},
+		}}
+	}
+
+	t.Run("Balanced", func(t *testing.T) {
+		s := state()
+		require.Nil(t, s.ShrinkReplicas(2, nil))
+		load := s.replicaLoad()
+		assert.Less(t, load["C"], 3, "the most loaded node gives up replicas first")
+		for _, p := range s.Physical {
+			assert.Len(t, p.BelongsToNodes, 2)
+		}
+	})
+
+	t.Run("Drain", func(t *testing.T) {
+		s := state()
+		require.Nil(t, s.ShrinkReplicas(2, []string{"A"}))
+		assert.Equal(t, []string{"B", "C"}, s.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"C", "D"}, s.Physical["S3"].BelongsToNodes)
+		assert.Len(t, s.Physical["S2"].BelongsToNodes, 2)
+	})
+
+	t.Run("Min", func(t *testing.T) {
+		s := state()
+		assert.NotNil(t, s.ShrinkReplicas(0, nil))
+	})
+}
+
+func TestMoveReplicas(t *testing.T) {
+	state := func() State {
+		return State{Physical: map[string]Physical{
+			"S1": {Name: "S1", BelongsToNodes: []string{"A", "B"}},
+			"S2": {Name: "S2", BelongsToNodes: []string{"B", "C"}},
+			"S3": {Name: "S3", BelongsToNodes: []string{"C", "A"}},
+		}}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		s := state()
+		moves, err := s.MoveReplicas([]string{"A"}, fakeNodes{[]string{"A", "B", "C", "D"}})
+		require.Nil(t, err)
+		assert.Equal(t, []ReplicaMove{
+			{Shard: "S1", From: "A", To: "D"},
+			{Shard: "S3", From: "A", To: "D"},
+		}, moves)
+		assert.Equal(t, []string{"D", "B"}, s.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"B", "C"}, s.Physical["S2"].BelongsToNodes)
+		assert.Equal(t, []string{"C", "D"}, s.Physical["S3"].BelongsToNodes)
+
+		applied := state()
+		require.Nil(t, applied.ApplyMoves(moves))
+		assert.Equal(t, s.Physical, applied.Physical)
+	})
+
+	t.Run("NoNodeLeft", func(t *testing.T) {
+		s := state()
+		_, err := s.MoveReplicas([]string{"A"}, fakeNodes{[]string{"A", "B"}})
+		assert.ErrorContains(t, err, "no node left")
+	})
+}
+
+func TestApplyMoves(t *testing.T) {
+	state := func() State {
+		return State{Physical: map[string]Physical{
+			"S1": {Name: "S1", BelongsToNodes: []string{"A", "B"}},
+			"S2": {Name: "S2", BelongsToNodes: []string{"B", "C"}},
+		}}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		s := state()
+		before := s.DeepCopy()
+		require.Nil(t, s.ApplyMoves([]ReplicaMove{
+			{Shard: "S1", From: "A", To: "C"},
+			{Shard: "S2", From: "C", To: "A"},
+		}))
+		assert.Equal(t, []string{"C", "B"}, s.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"B", "A"}, s.Physical["S2"].BelongsToNodes)
+		assert.Equal(t, []string{"A", "B"}, before.Physical["S1"].BelongsToNodes, "copies are not changed")
+	})
+
+	for name, move := range map[string]ReplicaMove{
+		"UnknownShard":  {Shard: "S3", From: "A", To: "C"},
+		"NoReplica":     {Shard: "S1", From: "C", To: "D"},
+		"ReplicaExists": {Shard: "S1", From: "A", To: "B"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := state()
+			assert.NotNil(t, s.ApplyMoves([]ReplicaMove{move}))
+		})
+	}
+}
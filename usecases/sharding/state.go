@@ -334,6 +334,29 @@ func (s *State) GetPartitions(nodes nodes, shards []string, replFactor int64) (m
 	return partitions, nil
 }
 
+// Reassign distributes the existing physical shards among the node
+// candidates with replFactor replicas each. Shard names and virtual shards
+// are kept, so that objects keep mapping to the same shards. It is used to
+// restore a backup on a cluster with a different topology.
+func (s *State) Reassign(nodes nodes, replFactor int64) error {
+	s.MigrateFromOldFormat()
+	names := make([]string, 0, len(s.Physical))
+	for name := range s.Physical {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	owners, err := (&State{}).GetPartitions(nodes, names, replFactor)
+	if err != nil {
+		return err
+	}
+	for name, p := range s.Physical {
+		p.BelongsToNodes = owners[name]
+		s.Physical[name] = p
+	}
+	s.localNodeName = nodes.LocalName()
+	return nil
+}
+
 // AddPartition to physical shards
 func (s *State) AddPartition(name string, nodes []string, status string) Physical {
 	p := Physical{
@@ -261,6 +261,45 @@ func TestGetPartitions(t *testing.T) {
 	})
 }
 
+func TestReassign(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{"desiredCount": float64(3)}, 14)
+	require.Nil(t, err)
+	s, err := InitState("my-index", cfg, fakeNodes{[]string{"A", "B", "C"}}, 3, false)
+	require.Nil(t, err)
+	virtual := s.DeepCopy().Virtual
+
+	t.Run("ShrinkCluster", func(t *testing.T) {
+		state := s.DeepCopy()
+		require.Nil(t, state.Reassign(fakeNodes{[]string{"N1"}}, 1))
+		require.Len(t, state.Physical, 3)
+		for _, p := range state.Physical {
+			assert.Equal(t, []string{"N1"}, p.BelongsToNodes)
+		}
+		assert.Equal(t, virtual, state.Virtual)
+		assert.Equal(t, "N1", state.localNodeName)
+		assert.ElementsMatch(t, s.AllPhysicalShards(), state.AllLocalPhysicalShards())
+	})
+
+	t.Run("GrowCluster", func(t *testing.T) {
+		state := s.DeepCopy()
+		nodes := []string{"N1", "N2", "N3", "N4", "N5"}
+		require.Nil(t, state.Reassign(fakeNodes{nodes}, 2))
+		used := map[string]bool{}
+		for _, p := range state.Physical {
+			require.Len(t, p.BelongsToNodes, 2)
+			assert.NotEqual(t, p.BelongsToNodes[0], p.BelongsToNodes[1])
+			used[p.BelongsToNodes[0]] = true
+		}
+		assert.Len(t, used, 3, "primary replicas are spread among nodes")
+	})
+
+	t.Run("NotEnoughReplicas", func(t *testing.T) {
+		state := s.DeepCopy()
+		err := state.Reassign(fakeNodes{[]string{"N1"}}, 2)
+		assert.ErrorContains(t, err, "not enough replicas")
+	})
+}
+
 func TestAddPartition(t *testing.T) {
 	var (
 		nodes1 = []string{"N", "M"}
@@ -23,7 +23,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -41,23 +45,23 @@ func Test_Traverser_Authorization(t *testing.T) {
 	tests := []testCase{
 		{
 			methodName:       "GetClass",
-			additionalArgs:   []interface{}{dto.GetParams{}},
+			additionalArgs:   []interface{}{dto.GetParams{ClassName: "Foo", Tenant: "t1"}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "data/collections/Foo/tenants/t1/objects/*",
 		},
 
 		{
 			methodName:       "Aggregate",
-			additionalArgs:   []interface{}{&aggregation.Params{}},
+			additionalArgs:   []interface{}{&aggregation.Params{ClassName: "Foo"}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "data/collections/Foo/tenants/*/objects/*",
 		},
 
 		{
 			methodName:       "Explore",
 			additionalArgs:   []interface{}{ExploreParams{}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "data/collections/*/tenants/*/objects/*",
 		},
 	}
 
@@ -97,6 +101,98 @@ func Test_Traverser_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Traverser_AuthorizationOfReferences(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	sch := &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{Class: "A"},
+			{Class: "B", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true}},
+		},
+	}}}
+
+	readerOf := func(resources ...string) *rbac.Authorizer {
+		name := "reader"
+		permissions := make([]*models.Permission, len(resources))
+		for i := range resources {
+			permissions[i] = &models.Permission{Actions: []string{"get"}, Resource: &resources[i]}
+		}
+		policy := rbac.NewPolicy()
+		policy.Roles[name] = &models.Role{Name: &name, Permissions: permissions}
+		policy.Users["alice"] = []string{name}
+		a := rbac.New(rbac.Config{Enabled: true})
+		a.SetPolicy(policy)
+		return a
+	}
+	selectRefToB := search.SelectProperties{{
+		Name: "toB",
+		Refs: []search.SelectClass{{
+			ClassName:     "B",
+			RefProperties: search.SelectProperties{{Name: "name", IsPrimitive: true}},
+		}},
+	}}
+	filterOnB := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On: &filters.Path{
+			Class: "A", Property: "toB",
+			Child: &filters.Path{Class: "B", Property: "name"},
+		},
+		Value: &filters.Value{Value: "foo", Type: schema.DataTypeText},
+	}}
+
+	tests := []struct {
+		name       string
+		authorizer *rbac.Authorizer
+		params     dto.GetParams
+		allowed    bool
+	}{
+		{
+			name:       "role limited to A reads A",
+			authorizer: readerOf("data/collections/A/*"),
+			params:     dto.GetParams{ClassName: "A"},
+			allowed:    true,
+		},
+		{
+			name:       "role limited to A selects reference into B",
+			authorizer: readerOf("data/collections/A/*"),
+			params:     dto.GetParams{ClassName: "A", Properties: selectRefToB},
+			allowed:    false,
+		},
+		{
+			name:       "role limited to A filters on reference into B",
+			authorizer: readerOf("data/collections/A/*"),
+			params:     dto.GetParams{ClassName: "A", Filters: filterOnB},
+			allowed:    false,
+		},
+		{
+			name:       "role for A and tenant of B selects reference into B",
+			authorizer: readerOf("data/collections/A/*", "data/collections/B/tenants/t1/*"),
+			params:     dto.GetParams{ClassName: "A", Tenant: "t1", Properties: selectRefToB},
+			allowed:    true,
+		},
+		{
+			name:       "role for A and other tenant of B selects reference into B",
+			authorizer: readerOf("data/collections/A/*", "data/collections/B/tenants/t2/*"),
+			params:     dto.GetParams{ClassName: "A", Tenant: "t1", Properties: selectRefToB},
+			allowed:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+				test.authorizer, &fakeVectorRepo{}, &fakeExplorer{}, sch, nil, nil, -1)
+
+			_, err := traverser.GetClass(context.Background(),
+				&models.Principal{Username: "alice"}, test.params)
+			if test.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -31,7 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
-	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	uc "github.com/weaviate/weaviate/usecases/schema"
@@ -60,7 +60,8 @@ type ModulesProvider interface {
 	ValidateSearchParam(name string, value interface{}, className string) error
 	CrossClassValidateSearchParam(name string, value interface{}) error
 	VectorFromSearchParam(ctx context.Context, className string, param string,
-		params interface{}, findVectorFn modulecapabilities.FindVectorFn, tenant string) ([]float32, error)
+		params interface{}, findVectorFn modulecapabilities.FindVectorFn, tenant string,
+		targetVector string) ([]float32, error)
 	CrossClassVectorFromSearchParam(ctx context.Context, param string,
 		params interface{}, findVectorFn modulecapabilities.FindVectorFn) ([]float32, error)
 	GetExploreAdditionalExtend(ctx context.Context, in []search.Result,
@@ -69,7 +70,7 @@ type ModulesProvider interface {
 	ListExploreAdditionalExtend(ctx context.Context, in []search.Result,
 		moduleParams map[string]interface{},
 		argumentModuleParams map[string]interface{}) ([]search.Result, error)
-	VectorFromInput(ctx context.Context, className string, input string) ([]float32, error)
+	VectorFromInput(ctx context.Context, className, input, targetVector string) ([]float32, error)
 }
 
 type objectsSearcher interface {
@@ -92,7 +93,7 @@ type objectsSearcher interface {
 
 type hybridSearcher interface {
 	SparseObjectSearch(ctx context.Context, params dto.GetParams) ([]*storobj.Object, []float32, error)
-	DenseObjectSearch(context.Context, string, []float32, int, int,
+	DenseObjectSearch(context.Context, string, []float32, string, int, int,
 		*filters.LocalFilter, additional.Properties, string) ([]*storobj.Object, []float32, error)
 	ResolveReferences(ctx context.Context, objs search.Results, props search.SelectProperties,
 		groupBy *searchparams.GroupBy, additional additional.Properties, tenant string) (search.Results, error)
@@ -142,6 +143,15 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return e.getClassKeywordBased(ctx, params)
 	}
 
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 ||
+		params.HybridSearch != nil {
+		targetVector, err := e.resolveTargetVector(params.ClassName, params.TargetVector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid target vector")
+		}
+		params.TargetVector = targetVector
+	}
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		return e.getClassVectorSearch(ctx, params)
 	}
@@ -288,6 +298,9 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 			Query:      params.HybridSearch.Query,
 			Type:       "bm25",
 			Properties: params.HybridSearch.Properties,
+			Fuzziness:  params.HybridSearch.Fuzziness,
+			Prefix:     params.HybridSearch.Prefix,
+			Highlight:  params.AdditionalProperties.Highlight,
 		}
 
 		if params.Pagination == nil {
@@ -322,7 +335,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 			hybridSearchLimit = baseSearchLimit
 		}
 		res, dists, err := e.searcher.DenseObjectSearch(ctx,
-			params.ClassName, vec, 0, hybridSearchLimit, params.Filters,
+			params.ClassName, vec, params.TargetVector, 0, hybridSearchLimit, params.Filters,
 			params.AdditionalProperties, params.Tenant)
 		if err != nil {
 			return nil, nil, err
@@ -353,6 +366,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		HybridSearch: params.HybridSearch,
 		Keyword:      params.KeywordRanking,
 		Class:        params.ClassName,
+		TargetVector: params.TargetVector,
 		Autocut:      params.Pagination.Autocut,
 	}, e.logger, sparseSearch, denseSearch, postProcess, e.modulesProvider)
 	if err != nil {
@@ -484,7 +498,7 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			}
 
 			if params.AdditionalProperties.Certainty {
-				if err := e.checkCertaintyCompatibility(params.ClassName); err != nil {
+				if err := e.checkCertaintyCompatibility(params.ClassName, params.TargetVector); err != nil {
 					return nil, errors.Errorf("additional: %s", err)
 				}
 				additionalProperties["certainty"] = additional.DistToCertainty(float64(res.Dist))
@@ -511,6 +525,14 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			additionalProperties["vector"] = res.Vector
 		}
 
+		if len(params.AdditionalProperties.Vectors) > 0 {
+			vectors := make(map[string][]float32, len(params.AdditionalProperties.Vectors))
+			for _, targetVector := range params.AdditionalProperties.Vectors {
+				vectors[targetVector] = res.Vectors[targetVector]
+			}
+			additionalProperties["vectors"] = vectors
+		}
+
 		if params.AdditionalProperties.CreationTimeUnix {
 			additionalProperties["creationTimeUnix"] = res.Created
 		}
@@ -687,7 +709,25 @@ func (e *Explorer) vectorFromParams(ctx context.Context,
 	params dto.GetParams,
 ) ([]float32, error) {
 	return e.nearParamsVector.vectorFromParams(ctx, params.NearVector,
-		params.NearObject, params.ModuleParams, params.ClassName, params.Tenant)
+		params.NearObject, params.ModuleParams, params.ClassName, params.Tenant,
+		params.TargetVector)
+}
+
+// resolveTargetVector validates the requested target vector against the
+// class and falls back to the only vector of the class if none was requested
+func (e *Explorer) resolveTargetVector(className, targetVector string) (string, error) {
+	if e.schemaGetter == nil {
+		return targetVector, nil
+	}
+
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		// unknown classes are reported by the searcher
+		return targetVector, nil
+	}
+
+	return schema.ResolveTargetVector(class, targetVector)
 }
 
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
@@ -739,7 +779,7 @@ func (e *Explorer) crossClassVectorFromModules(ctx context.Context,
 	return nil, errors.New("no modules defined")
 }
 
-func (e *Explorer) checkCertaintyCompatibility(className string) error {
+func (e *Explorer) checkCertaintyCompatibility(className, targetVector string) error {
 	s := e.schemaGetter.GetSchemaSkipAuth()
 	if s.Objects == nil {
 		return errors.Errorf("failed to get schema")
@@ -748,12 +788,12 @@ func (e *Explorer) checkCertaintyCompatibility(className string) error {
 	if class == nil {
 		return errors.Errorf("failed to get class: %s", className)
 	}
-	hnswConfig, err := hnsw.TypeAssertVectorIndex(class)
+	vectorConfig, err := schema.TypeAssertVectorIndex(class, targetVector)
 	if err != nil {
 		return err
 	}
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if dn := vectorConfig.DistanceName(); dn != common.DistanceCosine {
+		return certaintyUnsupportedError(dn)
 	}
 
 	return nil
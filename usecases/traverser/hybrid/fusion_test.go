@@ -19,6 +19,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/search"
 )
 
@@ -80,3 +81,27 @@ func TestFusionRelativeScoreExplain(t *testing.T) {
 	require.Contains(t, fused[0].ExplainScore, "keyword: original score 0.5, normalized score: 0.5")
 	require.Contains(t, fused[0].ExplainScore, "vector: original score 2, normalized score: 0.5 - keyword: original score 0.5, normalized score: 0.5")
 }
+
+func TestFusionKeepsHighlight(t *testing.T) {
+	highlight := []*additional.Highlight{{Property: "title", Fragments: []string{"<em>keyword</em>"}}}
+	newResults := func() [][]*Result {
+		keyword := []*Result{
+			{uint64(1), &search.Result{
+				SecondarySortValue: 0.5, ID: strfmt.UUID(fmt.Sprint(1)),
+				AdditionalProperties: map[string]interface{}{"highlight": highlight},
+			}},
+		}
+		vector := []*Result{
+			{uint64(1), &search.Result{SecondarySortValue: 2, ID: strfmt.UUID(fmt.Sprint(1))}},
+		}
+		return [][]*Result{keyword, vector}
+	}
+
+	fused := FusionRelativeScore([]float64{0.5, 0.5}, newResults())
+	require.Len(t, fused, 1)
+	assert.Equal(t, highlight, fused[0].AdditionalProperties["highlight"])
+
+	fused = FusionRanked([]float64{0.5, 0.5}, newResults())
+	require.Len(t, fused, 1)
+	assert.Equal(t, highlight, fused[0].AdditionalProperties["highlight"])
+}
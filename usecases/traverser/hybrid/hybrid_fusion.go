@@ -37,6 +37,7 @@ func FusionRanked(weights []float64, results [][]*Result) []*Result {
 					"%v\n(hybrid) Document %v contributed %v to the score",
 					previousResult.AdditionalProperties["explainScore"], tempResult.ID, score)
 				score += float64(previousResult.Score)
+				keepHighlight(tempResult, previousResult)
 			} else {
 				tempResult.AdditionalProperties["explainScore"] = fmt.Sprintf(
 					"%v\n(hybrid) Document %v contributed %v to the score",
@@ -129,6 +130,7 @@ func FusionRelativeScore(weights []float64, results [][]*Result) []*Result {
 			if ok {
 				score += previousResult.Score
 				explainScore += " - " + previousResult.ExplainScore
+				keepHighlight(res, previousResult)
 			}
 			res.Score = score
 			res.ExplainScore = explainScore
@@ -151,3 +153,18 @@ func FusionRelativeScore(weights []float64, results [][]*Result) []*Result {
 	})
 	return concat
 }
+
+// keepHighlight keeps the highlights of a keyword search result when it is
+// combined with the vector search result of the same object
+func keepHighlight(res, previous *Result) {
+	highlight, ok := previous.AdditionalProperties["highlight"]
+	if !ok {
+		return
+	}
+	if res.AdditionalProperties == nil {
+		res.AdditionalProperties = map[string]interface{}{}
+	}
+	if _, ok := res.AdditionalProperties["highlight"]; !ok {
+		res.AdditionalProperties["highlight"] = highlight
+	}
+}
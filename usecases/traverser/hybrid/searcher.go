@@ -30,9 +30,10 @@ const DefaultLimit = 100
 
 type Params struct {
 	*searchparams.HybridSearch
-	Keyword *searchparams.KeywordRanking
-	Class   string
-	Autocut int
+	Keyword      *searchparams.KeywordRanking
+	Class        string
+	TargetVector string
+	Autocut      int
 }
 
 // Result facilitates the pairing of a search result with its internal doc id.
@@ -74,7 +75,7 @@ type postProcFunc func(hybridResults Results) (postProcResults []search.Result,
 
 type modulesProvider interface {
 	VectorFromInput(ctx context.Context,
-		className string, input string) ([]float32, error)
+		className, input, targetVector string) ([]float32, error)
 }
 
 // Search executes sparse and dense searches and combines the result sets using Reciprocal Rank Fusion
@@ -241,7 +242,7 @@ func nearTextSubSearch(ctx context.Context, subsearch *searchparams.WeightedSear
 		return nil, 0, nil
 	}
 
-	vector, err := vectorFromModuleInput(ctx, params.Class, sp.Values[0], modules)
+	vector, err := vectorFromModuleInput(ctx, params.Class, sp.Values[0], params.TargetVector, modules)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -291,7 +292,7 @@ func decideSearchVector(ctx context.Context, params *Params, modules modulesProv
 		vector = params.Vector
 	} else {
 		if modules != nil {
-			vector, err = vectorFromModuleInput(ctx, params.Class, params.Query, modules)
+			vector, err = vectorFromModuleInput(ctx, params.Class, params.Query, params.TargetVector, modules)
 			if err != nil {
 				return nil, err
 			}
@@ -301,8 +302,10 @@ func decideSearchVector(ctx context.Context, params *Params, modules modulesProv
 	return vector, nil
 }
 
-func vectorFromModuleInput(ctx context.Context, class, input string, modules modulesProvider) ([]float32, error) {
-	vector, err := modules.VectorFromInput(ctx, class, input)
+func vectorFromModuleInput(ctx context.Context, class, input, targetVector string,
+	modules modulesProvider,
+) ([]float32, error) {
+	vector, err := modules.VectorFromInput(ctx, class, input, targetVector)
 	if err != nil {
 		return nil, fmt.Errorf("get vector input from modules provider: %w", err)
 	}
@@ -20,6 +20,8 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // Aggregate resolves meta queries
@@ -29,11 +31,17 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	err := t.authorizer.Authorize(principal, "get",
+		authorization.Objects(params.ClassName.String(), params.Tenant, ""))
 	if err != nil {
 		return nil, err
 	}
 
+	if err := t.authorizeReferences(principal, params.ClassName.String(), params.Tenant,
+		nil, params.Filters); err != nil {
+		return nil, err
+	}
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -42,6 +50,19 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	inspector := newTypeInspector(t.schemaGetter)
 
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 ||
+		params.Hybrid != nil {
+		sch := t.schemaGetter.GetSchemaSkipAuth()
+		class := sch.GetClass(params.ClassName)
+		if class != nil {
+			targetVector, err := schema.ResolveTargetVector(class, params.TargetVector)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid target vector")
+			}
+			params.TargetVector = targetVector
+		}
+	}
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		className := params.ClassName.String()
 		err = t.nearParamsVector.validateNearParams(params.NearVector,
@@ -50,7 +71,8 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 			return nil, err
 		}
 		searchVector, err := t.nearParamsVector.vectorFromParams(ctx,
-			params.NearVector, params.NearObject, params.ModuleParams, className, params.Tenant)
+			params.NearVector, params.NearObject, params.ModuleParams, className, params.Tenant,
+			params.TargetVector)
 		if err != nil {
 			return nil, err
 		}
@@ -66,7 +88,7 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	if params.Hybrid != nil && params.Hybrid.Vector == nil && params.Hybrid.Query != "" {
 		vec, err := t.nearParamsVector.modulesProvider.
-			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.Query)
+			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.Query, params.TargetVector)
 		if err != nil {
 			return nil, err
 		}
@@ -13,11 +13,16 @@ package traverser
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -39,11 +44,17 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	err := t.authorizer.Authorize(principal, "get",
+		authorization.Objects(params.ClassName, params.Tenant, ""))
 	if err != nil {
 		return nil, err
 	}
 
+	if err := t.authorizeReferences(principal, params.ClassName, params.Tenant,
+		params.Properties, params.Filters); err != nil {
+		return nil, err
+	}
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -62,3 +73,55 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 
 	return t.explorer.GetClass(ctx, params)
 }
+
+// authorizeReferences makes sure the principal may read every class which is
+// reached through a cross-reference, either by selecting a reference
+// property or by filtering on one. References are resolved by the database
+// without any further checks, so without this a principal could read the
+// objects of any class by selecting a reference into it. References of a
+// multi-tenant class are resolved in the tenant of the query.
+func (t *Traverser) authorizeReferences(principal *models.Principal,
+	className, tenant string, props search.SelectProperties, filter *filters.LocalFilter,
+) error {
+	classes := map[string]struct{}{}
+	collectSelectedRefClasses(props, classes)
+	if filter != nil {
+		for _, class := range filter.Root.ReferencedClasses() {
+			classes[string(class)] = struct{}{}
+		}
+	}
+	delete(classes, className)
+	if len(classes) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(classes))
+	for name := range classes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+	for _, name := range names {
+		refTenant := ""
+		if class := sch.FindClassByName(schema.ClassName(name)); class != nil &&
+			schema.MultiTenancyEnabled(class) {
+			refTenant = tenant
+		}
+		if err := t.authorizer.Authorize(principal, "get",
+			authorization.Objects(name, refTenant, "")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func collectSelectedRefClasses(props search.SelectProperties, into map[string]struct{}) {
+	for _, prop := range props {
+		for _, ref := range prop.Refs {
+			into[ref.ClassName] = struct{}{}
+			collectSelectedRefClasses(ref.RefProperties, into)
+		}
+	}
+}